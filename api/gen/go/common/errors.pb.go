@@ -39,6 +39,8 @@ const (
 	ErrorCode_TENANT_DISABLED       ErrorCode = 10103
 	ErrorCode_TENANT_PENDING        ErrorCode = 10104
 	ErrorCode_TENANT_REJECTED       ErrorCode = 10105
+	ErrorCode_TENANT_EXPIRED        ErrorCode = 10106
+	ErrorCode_TENANT_SUSPENDED      ErrorCode = 10107
 	// 权限相关错误 (10200-10299)
 	ErrorCode_PERMISSION_DENIED    ErrorCode = 10201
 	ErrorCode_ROLE_NOT_FOUND       ErrorCode = 10202
@@ -65,16 +67,19 @@ const (
 	ErrorCode_INVALID_EMAIL     ErrorCode = 10404
 	ErrorCode_INVALID_PHONE     ErrorCode = 10405
 	// 数据相关错误 (10500-10599)
-	ErrorCode_DATA_NOT_FOUND  ErrorCode = 10501
-	ErrorCode_DATA_CONFLICT   ErrorCode = 10502
-	ErrorCode_DATA_INVALID    ErrorCode = 10503
-	ErrorCode_DATA_DUPLICATE  ErrorCode = 10504
-	ErrorCode_DATA_CONSTRAINT ErrorCode = 10505
+	ErrorCode_DATA_NOT_FOUND           ErrorCode = 10501
+	ErrorCode_DATA_CONFLICT            ErrorCode = 10502
+	ErrorCode_DATA_INVALID             ErrorCode = 10503
+	ErrorCode_DATA_DUPLICATE           ErrorCode = 10504
+	ErrorCode_DATA_CONSTRAINT          ErrorCode = 10505
+	ErrorCode_IDEMPOTENCY_IN_PROGRESS  ErrorCode = 10506
+	ErrorCode_IDEMPOTENCY_KEY_MISMATCH ErrorCode = 10507
 	// 系统相关错误 (19900-19999)
 	ErrorCode_SYSTEM_ERROR        ErrorCode = 19901
 	ErrorCode_SERVICE_UNAVAILABLE ErrorCode = 19902
 	ErrorCode_DATABASE_ERROR      ErrorCode = 19903
 	ErrorCode_NETWORK_ERROR       ErrorCode = 19904
+	ErrorCode_TOO_MANY_REQUESTS   ErrorCode = 19905
 )
 
 // Enum value maps for ErrorCode.
@@ -91,6 +96,8 @@ var (
 		10103: "TENANT_DISABLED",
 		10104: "TENANT_PENDING",
 		10105: "TENANT_REJECTED",
+		10106: "TENANT_EXPIRED",
+		10107: "TENANT_SUSPENDED",
 		10201: "PERMISSION_DENIED",
 		10202: "ROLE_NOT_FOUND",
 		10203: "ROLE_DISABLED",
@@ -118,54 +125,62 @@ var (
 		10503: "DATA_INVALID",
 		10504: "DATA_DUPLICATE",
 		10505: "DATA_CONSTRAINT",
+		10506: "IDEMPOTENCY_IN_PROGRESS",
+		10507: "IDEMPOTENCY_KEY_MISMATCH",
 		19901: "SYSTEM_ERROR",
 		19902: "SERVICE_UNAVAILABLE",
 		19903: "DATABASE_ERROR",
 		19904: "NETWORK_ERROR",
+		19905: "TOO_MANY_REQUESTS",
 	}
 	ErrorCode_value = map[string]int32{
-		"SUCCESS":               0,
-		"USER_NOT_FOUND":        10001,
-		"USER_ALREADY_EXISTS":   10002,
-		"INVALID_PASSWORD":      10003,
-		"USER_DISABLED":         10004,
-		"USER_DELETED":          10005,
-		"TENANT_NOT_FOUND":      10101,
-		"TENANT_ALREADY_EXISTS": 10102,
-		"TENANT_DISABLED":       10103,
-		"TENANT_PENDING":        10104,
-		"TENANT_REJECTED":       10105,
-		"PERMISSION_DENIED":     10201,
-		"ROLE_NOT_FOUND":        10202,
-		"ROLE_DISABLED":         10203,
-		"PERMISSION_NOT_FOUND":  10204,
-		"INVALID_CREDENTIALS":   10301,
-		"TOKEN_EXPIRED":         10302,
-		"TOKEN_INVALID":         10303,
-		"TOKEN_REVOKED":         10304,
-		"ACCOUNT_LOCKED":        10305,
-		"AUTH_HEADER_MISSING":   10306,
-		"AUTH_HEADER_INVALID":   10307,
-		"AUTH_SERVICE_ERROR":    10308,
-		"USER_TYPE_UNDEFINED":   10309,
-		"ACCESS_FORBIDDEN":      10310,
-		"TENANT_MISSING":        10311,
-		"TENANT_INVALID":        10312,
-		"REGISTER_FAILED":       10313,
-		"INVALID_PARAMETER":     10401,
-		"MISSING_PARAMETER":     10402,
-		"INVALID_FORMAT":        10403,
-		"INVALID_EMAIL":         10404,
-		"INVALID_PHONE":         10405,
-		"DATA_NOT_FOUND":        10501,
-		"DATA_CONFLICT":         10502,
-		"DATA_INVALID":          10503,
-		"DATA_DUPLICATE":        10504,
-		"DATA_CONSTRAINT":       10505,
-		"SYSTEM_ERROR":          19901,
-		"SERVICE_UNAVAILABLE":   19902,
-		"DATABASE_ERROR":        19903,
-		"NETWORK_ERROR":         19904,
+		"SUCCESS":                  0,
+		"USER_NOT_FOUND":           10001,
+		"USER_ALREADY_EXISTS":      10002,
+		"INVALID_PASSWORD":         10003,
+		"USER_DISABLED":            10004,
+		"USER_DELETED":             10005,
+		"TENANT_NOT_FOUND":         10101,
+		"TENANT_ALREADY_EXISTS":    10102,
+		"TENANT_DISABLED":          10103,
+		"TENANT_PENDING":           10104,
+		"TENANT_REJECTED":          10105,
+		"TENANT_EXPIRED":           10106,
+		"TENANT_SUSPENDED":         10107,
+		"PERMISSION_DENIED":        10201,
+		"ROLE_NOT_FOUND":           10202,
+		"ROLE_DISABLED":            10203,
+		"PERMISSION_NOT_FOUND":     10204,
+		"INVALID_CREDENTIALS":      10301,
+		"TOKEN_EXPIRED":            10302,
+		"TOKEN_INVALID":            10303,
+		"TOKEN_REVOKED":            10304,
+		"ACCOUNT_LOCKED":           10305,
+		"AUTH_HEADER_MISSING":      10306,
+		"AUTH_HEADER_INVALID":      10307,
+		"AUTH_SERVICE_ERROR":       10308,
+		"USER_TYPE_UNDEFINED":      10309,
+		"ACCESS_FORBIDDEN":         10310,
+		"TENANT_MISSING":           10311,
+		"TENANT_INVALID":           10312,
+		"REGISTER_FAILED":          10313,
+		"INVALID_PARAMETER":        10401,
+		"MISSING_PARAMETER":        10402,
+		"INVALID_FORMAT":           10403,
+		"INVALID_EMAIL":            10404,
+		"INVALID_PHONE":            10405,
+		"DATA_NOT_FOUND":           10501,
+		"DATA_CONFLICT":            10502,
+		"DATA_INVALID":             10503,
+		"DATA_DUPLICATE":           10504,
+		"DATA_CONSTRAINT":          10505,
+		"IDEMPOTENCY_IN_PROGRESS":  10506,
+		"IDEMPOTENCY_KEY_MISMATCH": 10507,
+		"SYSTEM_ERROR":             19901,
+		"SERVICE_UNAVAILABLE":      19902,
+		"DATABASE_ERROR":           19903,
+		"NETWORK_ERROR":            19904,
+		"TOO_MANY_REQUESTS":        19905,
 	}
 )
 
@@ -372,7 +387,7 @@ const file_common_errors_proto_rawDesc = "" +
 	"\adetails\x18\x05 \x03(\v2\".common.ErrorResponse.DetailsEntryR\adetails\x1a:\n" +
 	"\fDetailsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\xac\a\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01*\xae\b\n" +
 	"\tErrorCode\x12\v\n" +
 	"\aSUCCESS\x10\x00\x12\x13\n" +
 	"\x0eUSER_NOT_FOUND\x10\x91N\x12\x18\n" +
@@ -384,7 +399,9 @@ const file_common_errors_proto_rawDesc = "" +
 	"\x15TENANT_ALREADY_EXISTS\x10\xf6N\x12\x14\n" +
 	"\x0fTENANT_DISABLED\x10\xf7N\x12\x13\n" +
 	"\x0eTENANT_PENDING\x10\xf8N\x12\x14\n" +
-	"\x0fTENANT_REJECTED\x10\xf9N\x12\x16\n" +
+	"\x0fTENANT_REJECTED\x10\xf9N\x12\x13\n" +
+	"\x0eTENANT_EXPIRED\x10\xfaN\x12\x15\n" +
+	"\x10TENANT_SUSPENDED\x10\xfbN\x12\x16\n" +
 	"\x11PERMISSION_DENIED\x10\xd9O\x12\x13\n" +
 	"\x0eROLE_NOT_FOUND\x10\xdaO\x12\x12\n" +
 	"\rROLE_DISABLED\x10\xdbO\x12\x19\n" +
@@ -411,11 +428,14 @@ const file_common_errors_proto_rawDesc = "" +
 	"\rDATA_CONFLICT\x10\x86R\x12\x11\n" +
 	"\fDATA_INVALID\x10\x87R\x12\x13\n" +
 	"\x0eDATA_DUPLICATE\x10\x88R\x12\x14\n" +
-	"\x0fDATA_CONSTRAINT\x10\x89R\x12\x12\n" +
+	"\x0fDATA_CONSTRAINT\x10\x89R\x12\x1c\n" +
+	"\x17IDEMPOTENCY_IN_PROGRESS\x10\x8aR\x12\x1d\n" +
+	"\x18IDEMPOTENCY_KEY_MISMATCH\x10\x8bR\x12\x12\n" +
 	"\fSYSTEM_ERROR\x10\xbd\x9b\x01\x12\x19\n" +
 	"\x13SERVICE_UNAVAILABLE\x10\xbe\x9b\x01\x12\x14\n" +
 	"\x0eDATABASE_ERROR\x10\xbf\x9b\x01\x12\x13\n" +
-	"\rNETWORK_ERROR\x10\xc0\x9b\x01B\x7f\n" +
+	"\rNETWORK_ERROR\x10\xc0\x9b\x01\x12\x17\n" +
+	"\x11TOO_MANY_REQUESTS\x10\xc1\x9b\x01B\x7f\n" +
 	"\n" +
 	"com.commonB\vErrorsProtoP\x01Z,github.com/heyinLab/common/api/gen/go/common\xa2\x02\x03CXX\xaa\x02\x06Common\xca\x02\x06Common\xe2\x02\x12Common\\GPBMetadata\xea\x02\x06Commonb\x06proto3"
 
@@ -9,7 +9,7 @@ package merchantv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/emptypb"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -222,6 +222,104 @@ func (InternalUserStatus) EnumDescriptor() ([]byte, []int) {
 	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{3}
 }
 
+type InternalAPIKeyStatus int32
+
+const (
+	InternalAPIKeyStatus_API_KEY_STATUS_ACTIVE  InternalAPIKeyStatus = 0
+	InternalAPIKeyStatus_API_KEY_STATUS_REVOKED InternalAPIKeyStatus = 1
+	InternalAPIKeyStatus_API_KEY_STATUS_EXPIRED InternalAPIKeyStatus = 2
+)
+
+// Enum value maps for InternalAPIKeyStatus.
+var (
+	InternalAPIKeyStatus_name = map[int32]string{
+		0: "API_KEY_STATUS_ACTIVE",
+		1: "API_KEY_STATUS_REVOKED",
+		2: "API_KEY_STATUS_EXPIRED",
+	}
+	InternalAPIKeyStatus_value = map[string]int32{
+		"API_KEY_STATUS_ACTIVE":  0,
+		"API_KEY_STATUS_REVOKED": 1,
+		"API_KEY_STATUS_EXPIRED": 2,
+	}
+)
+
+func (x InternalAPIKeyStatus) Enum() *InternalAPIKeyStatus {
+	p := new(InternalAPIKeyStatus)
+	*p = x
+	return p
+}
+
+func (x InternalAPIKeyStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InternalAPIKeyStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_merchant_v1_iam_integrate_proto_enumTypes[4].Descriptor()
+}
+
+func (InternalAPIKeyStatus) Type() protoreflect.EnumType {
+	return &file_merchant_v1_iam_integrate_proto_enumTypes[4]
+}
+
+func (x InternalAPIKeyStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InternalAPIKeyStatus.Descriptor instead.
+func (InternalAPIKeyStatus) EnumDescriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{4}
+}
+
+type InternalMemberStatus int32
+
+const (
+	InternalMemberStatus_MEMBER_STATUS_INVITED  InternalMemberStatus = 0
+	InternalMemberStatus_MEMBER_STATUS_ACTIVE   InternalMemberStatus = 1
+	InternalMemberStatus_MEMBER_STATUS_DISABLED InternalMemberStatus = 2
+)
+
+// Enum value maps for InternalMemberStatus.
+var (
+	InternalMemberStatus_name = map[int32]string{
+		0: "MEMBER_STATUS_INVITED",
+		1: "MEMBER_STATUS_ACTIVE",
+		2: "MEMBER_STATUS_DISABLED",
+	}
+	InternalMemberStatus_value = map[string]int32{
+		"MEMBER_STATUS_INVITED":  0,
+		"MEMBER_STATUS_ACTIVE":   1,
+		"MEMBER_STATUS_DISABLED": 2,
+	}
+)
+
+func (x InternalMemberStatus) Enum() *InternalMemberStatus {
+	p := new(InternalMemberStatus)
+	*p = x
+	return p
+}
+
+func (x InternalMemberStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InternalMemberStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_merchant_v1_iam_integrate_proto_enumTypes[5].Descriptor()
+}
+
+func (InternalMemberStatus) Type() protoreflect.EnumType {
+	return &file_merchant_v1_iam_integrate_proto_enumTypes[5]
+}
+
+func (x InternalMemberStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InternalMemberStatus.Descriptor instead.
+func (InternalMemberStatus) EnumDescriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{5}
+}
+
 type SetTenantPermissionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Codes         []string               `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
@@ -328,6 +426,95 @@ func (x *SetTenantPermissionsResponse) GetTotalCount() int32 {
 	return 0
 }
 
+type InternalGetTenantPermissionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetTenantPermissionsRequest) Reset() {
+	*x = InternalGetTenantPermissionsRequest{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetTenantPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetTenantPermissionsRequest) ProtoMessage() {}
+
+func (x *InternalGetTenantPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetTenantPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetTenantPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InternalGetTenantPermissionsRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+type InternalGetTenantPermissionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户当前生效的权限codes
+	Codes         []string `protobuf:"bytes,1,rep,name=codes,proto3" json:"codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetTenantPermissionsResponse) Reset() {
+	*x = InternalGetTenantPermissionsResponse{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetTenantPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetTenantPermissionsResponse) ProtoMessage() {}
+
+func (x *InternalGetTenantPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetTenantPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetTenantPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InternalGetTenantPermissionsResponse) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
 type InternalTenant struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
 	Code            string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                                                                  // 租户唯一标识码
@@ -347,7 +534,7 @@ type InternalTenant struct {
 
 func (x *InternalTenant) Reset() {
 	*x = InternalTenant{}
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[2]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +546,7 @@ func (x *InternalTenant) String() string {
 func (*InternalTenant) ProtoMessage() {}
 
 func (x *InternalTenant) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[2]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +559,7 @@ func (x *InternalTenant) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalTenant.ProtoReflect.Descriptor instead.
 func (*InternalTenant) Descriptor() ([]byte, []int) {
-	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{2}
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *InternalTenant) GetCode() string {
@@ -467,14 +654,20 @@ type InternalListTenantRequest struct {
 	// 类型
 	Type *TenantType `protobuf:"varint,6,opt,name=type,proto3,enum=common.merchant.v1.TenantType,oneof" json:"type,omitempty"`
 	// 访问等级
-	AccessLevel   *AccessLevel `protobuf:"varint,7,opt,name=access_level,json=accessLevel,proto3,enum=common.merchant.v1.AccessLevel,oneof" json:"access_level,omitempty"`
+	AccessLevel *AccessLevel `protobuf:"varint,7,opt,name=access_level,json=accessLevel,proto3,enum=common.merchant.v1.AccessLevel,oneof" json:"access_level,omitempty"`
+	// 租户code过滤，指定时按code批量查询
+	Codes []string `protobuf:"bytes,8,rep,name=codes,proto3" json:"codes,omitempty"`
+	// 排序字段（create_time, name, members_num）
+	SortBy *string `protobuf:"bytes,9,opt,name=sort_by,json=sortBy,proto3,oneof" json:"sort_by,omitempty"`
+	// 排序方向（asc, desc）
+	SortOrder     *string `protobuf:"bytes,10,opt,name=sort_order,json=sortOrder,proto3,oneof" json:"sort_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InternalListTenantRequest) Reset() {
 	*x = InternalListTenantRequest{}
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[3]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -486,7 +679,7 @@ func (x *InternalListTenantRequest) String() string {
 func (*InternalListTenantRequest) ProtoMessage() {}
 
 func (x *InternalListTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[3]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +692,7 @@ func (x *InternalListTenantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalListTenantRequest.ProtoReflect.Descriptor instead.
 func (*InternalListTenantRequest) Descriptor() ([]byte, []int) {
-	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{3}
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *InternalListTenantRequest) GetPage() int32 {
@@ -551,6 +744,27 @@ func (x *InternalListTenantRequest) GetAccessLevel() AccessLevel {
 	return AccessLevel_ACCESS_LEVEL_GA
 }
 
+func (x *InternalListTenantRequest) GetCodes() []string {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
+func (x *InternalListTenantRequest) GetSortBy() string {
+	if x != nil && x.SortBy != nil {
+		return *x.SortBy
+	}
+	return ""
+}
+
+func (x *InternalListTenantRequest) GetSortOrder() string {
+	if x != nil && x.SortOrder != nil {
+		return *x.SortOrder
+	}
+	return ""
+}
+
 type InternalListTenantResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*InternalTenant      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
@@ -561,7 +775,7 @@ type InternalListTenantResponse struct {
 
 func (x *InternalListTenantResponse) Reset() {
 	*x = InternalListTenantResponse{}
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[4]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -573,7 +787,7 @@ func (x *InternalListTenantResponse) String() string {
 func (*InternalListTenantResponse) ProtoMessage() {}
 
 func (x *InternalListTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[4]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +800,7 @@ func (x *InternalListTenantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalListTenantResponse.ProtoReflect.Descriptor instead.
 func (*InternalListTenantResponse) Descriptor() ([]byte, []int) {
-	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{4}
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *InternalListTenantResponse) GetItems() []*InternalTenant {
@@ -621,7 +835,7 @@ type InternalPlatformUser struct {
 
 func (x *InternalPlatformUser) Reset() {
 	*x = InternalPlatformUser{}
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[5]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -633,7 +847,7 @@ func (x *InternalPlatformUser) String() string {
 func (*InternalPlatformUser) ProtoMessage() {}
 
 func (x *InternalPlatformUser) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[5]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -646,7 +860,7 @@ func (x *InternalPlatformUser) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalPlatformUser.ProtoReflect.Descriptor instead.
 func (*InternalPlatformUser) Descriptor() ([]byte, []int) {
-	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{5}
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *InternalPlatformUser) GetUserCode() string {
@@ -730,7 +944,7 @@ type InternalAssociationInfo struct {
 
 func (x *InternalAssociationInfo) Reset() {
 	*x = InternalAssociationInfo{}
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[6]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -742,7 +956,7 @@ func (x *InternalAssociationInfo) String() string {
 func (*InternalAssociationInfo) ProtoMessage() {}
 
 func (x *InternalAssociationInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[6]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,7 +969,7 @@ func (x *InternalAssociationInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalAssociationInfo.ProtoReflect.Descriptor instead.
 func (*InternalAssociationInfo) Descriptor() ([]byte, []int) {
-	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{6}
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *InternalAssociationInfo) GetTenantCode() string {
@@ -792,7 +1006,7 @@ type InternalListPlatformUserRequest struct {
 
 func (x *InternalListPlatformUserRequest) Reset() {
 	*x = InternalListPlatformUserRequest{}
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[7]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -804,7 +1018,7 @@ func (x *InternalListPlatformUserRequest) String() string {
 func (*InternalListPlatformUserRequest) ProtoMessage() {}
 
 func (x *InternalListPlatformUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[7]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +1031,7 @@ func (x *InternalListPlatformUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalListPlatformUserRequest.ProtoReflect.Descriptor instead.
 func (*InternalListPlatformUserRequest) Descriptor() ([]byte, []int) {
-	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{7}
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *InternalListPlatformUserRequest) GetPage() int32 {
@@ -865,7 +1079,7 @@ type InternalListPlatformUserResponse struct {
 
 func (x *InternalListPlatformUserResponse) Reset() {
 	*x = InternalListPlatformUserResponse{}
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[8]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -877,7 +1091,7 @@ func (x *InternalListPlatformUserResponse) String() string {
 func (*InternalListPlatformUserResponse) ProtoMessage() {}
 
 func (x *InternalListPlatformUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[8]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -890,7 +1104,7 @@ func (x *InternalListPlatformUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalListPlatformUserResponse.ProtoReflect.Descriptor instead.
 func (*InternalListPlatformUserResponse) Descriptor() ([]byte, []int) {
-	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{8}
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *InternalListPlatformUserResponse) GetItems() []*InternalPlatformUser {
@@ -916,7 +1130,7 @@ type InternalGetTenantRequest struct {
 
 func (x *InternalGetTenantRequest) Reset() {
 	*x = InternalGetTenantRequest{}
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[9]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -928,7 +1142,7 @@ func (x *InternalGetTenantRequest) String() string {
 func (*InternalGetTenantRequest) ProtoMessage() {}
 
 func (x *InternalGetTenantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[9]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -941,7 +1155,7 @@ func (x *InternalGetTenantRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalGetTenantRequest.ProtoReflect.Descriptor instead.
 func (*InternalGetTenantRequest) Descriptor() ([]byte, []int) {
-	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{9}
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *InternalGetTenantRequest) GetTenantCode() string {
@@ -960,7 +1174,7 @@ type InternalGetTenantResponse struct {
 
 func (x *InternalGetTenantResponse) Reset() {
 	*x = InternalGetTenantResponse{}
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[10]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -972,7 +1186,7 @@ func (x *InternalGetTenantResponse) String() string {
 func (*InternalGetTenantResponse) ProtoMessage() {}
 
 func (x *InternalGetTenantResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[10]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -985,7 +1199,7 @@ func (x *InternalGetTenantResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalGetTenantResponse.ProtoReflect.Descriptor instead.
 func (*InternalGetTenantResponse) Descriptor() ([]byte, []int) {
-	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{10}
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *InternalGetTenantResponse) GetTenant() *InternalTenant {
@@ -1003,7 +1217,7 @@ type InternalGetTenantStatsRequest struct {
 
 func (x *InternalGetTenantStatsRequest) Reset() {
 	*x = InternalGetTenantStatsRequest{}
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[11]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1015,7 +1229,7 @@ func (x *InternalGetTenantStatsRequest) String() string {
 func (*InternalGetTenantStatsRequest) ProtoMessage() {}
 
 func (x *InternalGetTenantStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[11]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1028,7 +1242,7 @@ func (x *InternalGetTenantStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalGetTenantStatsRequest.ProtoReflect.Descriptor instead.
 func (*InternalGetTenantStatsRequest) Descriptor() ([]byte, []int) {
-	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{11}
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{13}
 }
 
 type InternalGetTenantStatsResponse struct {
@@ -1045,7 +1259,7 @@ type InternalGetTenantStatsResponse struct {
 
 func (x *InternalGetTenantStatsResponse) Reset() {
 	*x = InternalGetTenantStatsResponse{}
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[12]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1057,7 +1271,7 @@ func (x *InternalGetTenantStatsResponse) String() string {
 func (*InternalGetTenantStatsResponse) ProtoMessage() {}
 
 func (x *InternalGetTenantStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[12]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1070,7 +1284,7 @@ func (x *InternalGetTenantStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalGetTenantStatsResponse.ProtoReflect.Descriptor instead.
 func (*InternalGetTenantStatsResponse) Descriptor() ([]byte, []int) {
-	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{12}
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *InternalGetTenantStatsResponse) GetTotalTenants() int32 {
@@ -1123,7 +1337,7 @@ type InternalGetUserStatsRequest struct {
 
 func (x *InternalGetUserStatsRequest) Reset() {
 	*x = InternalGetUserStatsRequest{}
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[13]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1135,7 +1349,7 @@ func (x *InternalGetUserStatsRequest) String() string {
 func (*InternalGetUserStatsRequest) ProtoMessage() {}
 
 func (x *InternalGetUserStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[13]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1148,7 +1362,7 @@ func (x *InternalGetUserStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalGetUserStatsRequest.ProtoReflect.Descriptor instead.
 func (*InternalGetUserStatsRequest) Descriptor() ([]byte, []int) {
-	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{13}
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{15}
 }
 
 type InternalGetUserStatsResponse struct {
@@ -1163,7 +1377,7 @@ type InternalGetUserStatsResponse struct {
 
 func (x *InternalGetUserStatsResponse) Reset() {
 	*x = InternalGetUserStatsResponse{}
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[14]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1175,7 +1389,7 @@ func (x *InternalGetUserStatsResponse) String() string {
 func (*InternalGetUserStatsResponse) ProtoMessage() {}
 
 func (x *InternalGetUserStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[14]
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1188,7 +1402,7 @@ func (x *InternalGetUserStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalGetUserStatsResponse.ProtoReflect.Descriptor instead.
 func (*InternalGetUserStatsResponse) Descriptor() ([]byte, []int) {
-	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{14}
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *InternalGetUserStatsResponse) GetTotalUsers() int32 {
@@ -1212,57 +1426,1475 @@ func (x *InternalGetUserStatsResponse) GetDisabledUsers() int32 {
 	return 0
 }
 
-var File_merchant_v1_iam_integrate_proto protoreflect.FileDescriptor
+// 租户设置
+type InternalTenantSettings struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`                                                               // 租户code
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`                                                                     // 默认语言
+	Timezone      string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`                                                                     // 时区
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`                                                                     // 结算货币
+	CustomDomain  string                 `protobuf:"bytes,5,opt,name=custom_domain,proto3" json:"custom_domain,omitempty"`                                                           // 自定义域名
+	MfaRequired   bool                   `protobuf:"varint,6,opt,name=mfa_required,proto3" json:"mfa_required,omitempty"`                                                            // 是否强制MFA
+	Extra         map[string]string      `protobuf:"bytes,7,rep,name=extra,proto3" json:"extra,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // 扩展设置
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,proto3" json:"update_time,omitempty"`                                                               // 更新时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_merchant_v1_iam_integrate_proto_rawDesc = "" +
-	"\n" +
-	"\x1fmerchant/v1/iam_integrate.proto\x12\x12common.merchant.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"i\n" +
-	"\x1bSetTenantPermissionsRequest\x12\x14\n" +
-	"\x05codes\x18\x01 \x03(\tR\x05codes\x12$\n" +
-	"\vtenant_code\x18\x02 \x01(\tH\x00R\n" +
-	"tenantCode\x88\x01\x01B\x0e\n" +
-	"\f_tenant_code\"Y\n" +
-	"\x1cSetTenantPermissionsResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
-	"\vtotal_count\x18\x02 \x01(\x05R\n" +
-	"totalCount\"\xc1\x03\n" +
-	"\x0eInternalTenant\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x122\n" +
-	"\x04type\x18\x04 \x01(\x0e2\x1e.common.merchant.v1.TenantTypeR\x04type\x12\x18\n" +
-	"\acountry\x18\x06 \x01(\tR\acountry\x128\n" +
-	"\x06status\x18\a \x01(\x0e2 .common.merchant.v1.TenantStatusR\x06status\x12\x1f\n" +
-	"\vmembers_num\x18\b \x01(\x05R\n" +
-	"membersNum\x12)\n" +
-	"\x10subscription_num\x18\t \x01(\x05R\x0fsubscriptionNum\x12;\n" +
-	"\vcreate_time\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"createTime\x12D\n" +
-	"\raccess_levels\x18\v \x03(\x0e2\x1f.common.merchant.v1.AccessLevelR\faccessLevels\x12\x1a\n" +
-	"\blogo_url\x18\f \x01(\tR\blogo_url\"\xf8\x02\n" +
-	"\x19InternalListTenantRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
-	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x17\n" +
-	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12=\n" +
-	"\x06status\x18\x04 \x01(\x0e2 .common.merchant.v1.TenantStatusH\x01R\x06status\x88\x01\x01\x12\x1d\n" +
-	"\acountry\x18\x05 \x01(\tH\x02R\acountry\x88\x01\x01\x127\n" +
-	"\x04type\x18\x06 \x01(\x0e2\x1e.common.merchant.v1.TenantTypeH\x03R\x04type\x88\x01\x01\x12G\n" +
-	"\faccess_level\x18\a \x01(\x0e2\x1f.common.merchant.v1.AccessLevelH\x04R\vaccessLevel\x88\x01\x01B\a\n" +
-	"\x05_nameB\t\n" +
-	"\a_statusB\n" +
-	"\n" +
-	"\b_countryB\a\n" +
-	"\x05_typeB\x0f\n" +
-	"\r_access_level\"l\n" +
-	"\x1aInternalListTenantResponse\x128\n" +
-	"\x05items\x18\x01 \x03(\v2\".common.merchant.v1.InternalTenantR\x05items\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"\xbd\x03\n" +
-	"\x14InternalPlatformUser\x12\x1c\n" +
-	"\tuser_code\x18\x01 \x01(\tR\tuser_code\x12\x1a\n" +
-	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x14\n" +
-	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1e\n" +
-	"\n" +
+func (x *InternalTenantSettings) Reset() {
+	*x = InternalTenantSettings{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalTenantSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalTenantSettings) ProtoMessage() {}
+
+func (x *InternalTenantSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalTenantSettings.ProtoReflect.Descriptor instead.
+func (*InternalTenantSettings) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InternalTenantSettings) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalTenantSettings) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *InternalTenantSettings) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *InternalTenantSettings) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *InternalTenantSettings) GetCustomDomain() string {
+	if x != nil {
+		return x.CustomDomain
+	}
+	return ""
+}
+
+func (x *InternalTenantSettings) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *InternalTenantSettings) GetExtra() map[string]string {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+func (x *InternalTenantSettings) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+type InternalGetTenantSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetTenantSettingsRequest) Reset() {
+	*x = InternalGetTenantSettingsRequest{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetTenantSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetTenantSettingsRequest) ProtoMessage() {}
+
+func (x *InternalGetTenantSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetTenantSettingsRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetTenantSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *InternalGetTenantSettingsRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+type InternalGetTenantSettingsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Settings      *InternalTenantSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetTenantSettingsResponse) Reset() {
+	*x = InternalGetTenantSettingsResponse{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetTenantSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetTenantSettingsResponse) ProtoMessage() {}
+
+func (x *InternalGetTenantSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetTenantSettingsResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetTenantSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *InternalGetTenantSettingsResponse) GetSettings() *InternalTenantSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type InternalUpdateTenantStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
+	Status        TenantStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=common.merchant.v1.TenantStatus" json:"status,omitempty"` // 目标状态
+	Reason        *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`                                 // 变更原因（写入审计）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalUpdateTenantStatusRequest) Reset() {
+	*x = InternalUpdateTenantStatusRequest{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalUpdateTenantStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalUpdateTenantStatusRequest) ProtoMessage() {}
+
+func (x *InternalUpdateTenantStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalUpdateTenantStatusRequest.ProtoReflect.Descriptor instead.
+func (*InternalUpdateTenantStatusRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *InternalUpdateTenantStatusRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalUpdateTenantStatusRequest) GetStatus() TenantStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TenantStatus_TENANT_STATUS_PENDING
+}
+
+func (x *InternalUpdateTenantStatusRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type InternalUpdateTenantStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tenant        *InternalTenant        `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"` // 变更后的租户信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalUpdateTenantStatusResponse) Reset() {
+	*x = InternalUpdateTenantStatusResponse{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalUpdateTenantStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalUpdateTenantStatusResponse) ProtoMessage() {}
+
+func (x *InternalUpdateTenantStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalUpdateTenantStatusResponse.ProtoReflect.Descriptor instead.
+func (*InternalUpdateTenantStatusResponse) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InternalUpdateTenantStatusResponse) GetTenant() *InternalTenant {
+	if x != nil {
+		return x.Tenant
+	}
+	return nil
+}
+
+// OpenAPI 访问凭证（不含密钥）
+type InternalAPIKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,proto3" json:"key_id,omitempty"`                                               // 凭证ID
+	TenantCode    string                 `protobuf:"bytes,2,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`                                     // 租户code
+	ProductCode   string                 `protobuf:"bytes,3,opt,name=product_code,proto3" json:"product_code,omitempty"`                                   // 产品code
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                                                   // 名称
+	AccessKey     string                 `protobuf:"bytes,5,opt,name=access_key,proto3" json:"access_key,omitempty"`                                       // AccessKey
+	Scopes        []string               `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`                                               // 授权范围
+	Status        InternalAPIKeyStatus   `protobuf:"varint,7,opt,name=status,proto3,enum=common.merchant.v1.InternalAPIKeyStatus" json:"status,omitempty"` // 状态
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expire_time,proto3" json:"expire_time,omitempty"`                                     // 过期时间，为空表示永不过期
+	LastUsedTime  *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=last_used_time,proto3" json:"last_used_time,omitempty"`                               // 最后使用时间
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=create_time,proto3" json:"create_time,omitempty"`                                    // 创建时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalAPIKey) Reset() {
+	*x = InternalAPIKey{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalAPIKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalAPIKey) ProtoMessage() {}
+
+func (x *InternalAPIKey) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalAPIKey.ProtoReflect.Descriptor instead.
+func (*InternalAPIKey) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InternalAPIKey) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *InternalAPIKey) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalAPIKey) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InternalAPIKey) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InternalAPIKey) GetAccessKey() string {
+	if x != nil {
+		return x.AccessKey
+	}
+	return ""
+}
+
+func (x *InternalAPIKey) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *InternalAPIKey) GetStatus() InternalAPIKeyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InternalAPIKeyStatus_API_KEY_STATUS_ACTIVE
+}
+
+func (x *InternalAPIKey) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+func (x *InternalAPIKey) GetLastUsedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUsedTime
+	}
+	return nil
+}
+
+func (x *InternalAPIKey) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+type InternalCreateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
+	ProductCode   string                 `protobuf:"bytes,2,opt,name=product_code,proto3" json:"product_code,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,4,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpireTime    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expire_time,proto3,oneof" json:"expire_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateAPIKeyRequest) Reset() {
+	*x = InternalCreateAPIKeyRequest{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *InternalCreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*InternalCreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InternalCreateAPIKeyRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalCreateAPIKeyRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InternalCreateAPIKeyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InternalCreateAPIKeyRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *InternalCreateAPIKeyRequest) GetExpireTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpireTime
+	}
+	return nil
+}
+
+type InternalCreateAPIKeyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   *InternalAPIKey        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// 密钥明文，仅在创建时返回一次
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateAPIKeyResponse) Reset() {
+	*x = InternalCreateAPIKeyResponse{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *InternalCreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*InternalCreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *InternalCreateAPIKeyResponse) GetKey() *InternalAPIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *InternalCreateAPIKeyResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type InternalListAPIKeysRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
+	ProductCode   *string                `protobuf:"bytes,2,opt,name=product_code,proto3,oneof" json:"product_code,omitempty"`
+	Status        *InternalAPIKeyStatus  `protobuf:"varint,3,opt,name=status,proto3,enum=common.merchant.v1.InternalAPIKeyStatus,oneof" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListAPIKeysRequest) Reset() {
+	*x = InternalListAPIKeysRequest{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListAPIKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListAPIKeysRequest) ProtoMessage() {}
+
+func (x *InternalListAPIKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListAPIKeysRequest.ProtoReflect.Descriptor instead.
+func (*InternalListAPIKeysRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InternalListAPIKeysRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalListAPIKeysRequest) GetProductCode() string {
+	if x != nil && x.ProductCode != nil {
+		return *x.ProductCode
+	}
+	return ""
+}
+
+func (x *InternalListAPIKeysRequest) GetStatus() InternalAPIKeyStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return InternalAPIKeyStatus_API_KEY_STATUS_ACTIVE
+}
+
+func (x *InternalListAPIKeysRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *InternalListAPIKeysRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type InternalListAPIKeysResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*InternalAPIKey      `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListAPIKeysResponse) Reset() {
+	*x = InternalListAPIKeysResponse{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListAPIKeysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListAPIKeysResponse) ProtoMessage() {}
+
+func (x *InternalListAPIKeysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListAPIKeysResponse.ProtoReflect.Descriptor instead.
+func (*InternalListAPIKeysResponse) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *InternalListAPIKeysResponse) GetItems() []*InternalAPIKey {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *InternalListAPIKeysResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type InternalRevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
+	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,proto3" json:"key_id,omitempty"`
+	Reason        *string                `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"` // 吊销原因
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalRevokeAPIKeyRequest) Reset() {
+	*x = InternalRevokeAPIKeyRequest{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalRevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalRevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *InternalRevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalRevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*InternalRevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *InternalRevokeAPIKeyRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalRevokeAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *InternalRevokeAPIKeyRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+type InternalRevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *InternalAPIKey        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalRevokeAPIKeyResponse) Reset() {
+	*x = InternalRevokeAPIKeyResponse{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalRevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalRevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *InternalRevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalRevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*InternalRevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *InternalRevokeAPIKeyResponse) GetKey() *InternalAPIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+type InternalRotateAPIKeySecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
+	KeyId         string                 `protobuf:"bytes,2,opt,name=key_id,proto3" json:"key_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalRotateAPIKeySecretRequest) Reset() {
+	*x = InternalRotateAPIKeySecretRequest{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalRotateAPIKeySecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalRotateAPIKeySecretRequest) ProtoMessage() {}
+
+func (x *InternalRotateAPIKeySecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalRotateAPIKeySecretRequest.ProtoReflect.Descriptor instead.
+func (*InternalRotateAPIKeySecretRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InternalRotateAPIKeySecretRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalRotateAPIKeySecretRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+type InternalRotateAPIKeySecretResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   *InternalAPIKey        `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// 新密钥明文，仅返回一次，旧密钥立即失效
+	Secret        string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalRotateAPIKeySecretResponse) Reset() {
+	*x = InternalRotateAPIKeySecretResponse{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalRotateAPIKeySecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalRotateAPIKeySecretResponse) ProtoMessage() {}
+
+func (x *InternalRotateAPIKeySecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalRotateAPIKeySecretResponse.ProtoReflect.Descriptor instead.
+func (*InternalRotateAPIKeySecretResponse) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *InternalRotateAPIKeySecretResponse) GetKey() *InternalAPIKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *InternalRotateAPIKeySecretResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// 租户成员
+type InternalTenantMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`                                     // 租户code
+	UserCode      string                 `protobuf:"bytes,2,opt,name=user_code,proto3" json:"user_code,omitempty"`                                         // 用户code，邀请未接受时为空
+	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`                                           // 昵称
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                                                 // 邮箱
+	AvatarUrl     string                 `protobuf:"bytes,5,opt,name=avatar_url,proto3" json:"avatar_url,omitempty"`                                       // 头像URL
+	RoleCode      string                 `protobuf:"bytes,6,opt,name=role_code,proto3" json:"role_code,omitempty"`                                         // 角色code
+	Status        InternalMemberStatus   `protobuf:"varint,7,opt,name=status,proto3,enum=common.merchant.v1.InternalMemberStatus" json:"status,omitempty"` // 状态
+	InvitationId  string                 `protobuf:"bytes,8,opt,name=invitation_id,proto3" json:"invitation_id,omitempty"`                                 // 邀请ID
+	InviteTime    *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=invite_time,proto3" json:"invite_time,omitempty"`                                     // 邀请时间
+	JoinTime      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=join_time,proto3" json:"join_time,omitempty"`                                        // 加入时间
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalTenantMember) Reset() {
+	*x = InternalTenantMember{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalTenantMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalTenantMember) ProtoMessage() {}
+
+func (x *InternalTenantMember) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalTenantMember.ProtoReflect.Descriptor instead.
+func (*InternalTenantMember) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InternalTenantMember) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalTenantMember) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+func (x *InternalTenantMember) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *InternalTenantMember) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InternalTenantMember) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *InternalTenantMember) GetRoleCode() string {
+	if x != nil {
+		return x.RoleCode
+	}
+	return ""
+}
+
+func (x *InternalTenantMember) GetStatus() InternalMemberStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InternalMemberStatus_MEMBER_STATUS_INVITED
+}
+
+func (x *InternalTenantMember) GetInvitationId() string {
+	if x != nil {
+		return x.InvitationId
+	}
+	return ""
+}
+
+func (x *InternalTenantMember) GetInviteTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InviteTime
+	}
+	return nil
+}
+
+func (x *InternalTenantMember) GetJoinTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.JoinTime
+	}
+	return nil
+}
+
+type InternalInviteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                     // 被邀请人邮箱
+	RoleCode      string                 `protobuf:"bytes,3,opt,name=role_code,proto3" json:"role_code,omitempty"`             // 加入后的角色
+	InviterCode   *string                `protobuf:"bytes,4,opt,name=inviter_code,proto3,oneof" json:"inviter_code,omitempty"` // 邀请人用户code
+	Message       *string                `protobuf:"bytes,5,opt,name=message,proto3,oneof" json:"message,omitempty"`           // 邀请附言
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalInviteUserRequest) Reset() {
+	*x = InternalInviteUserRequest{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalInviteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalInviteUserRequest) ProtoMessage() {}
+
+func (x *InternalInviteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalInviteUserRequest.ProtoReflect.Descriptor instead.
+func (*InternalInviteUserRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InternalInviteUserRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalInviteUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *InternalInviteUserRequest) GetRoleCode() string {
+	if x != nil {
+		return x.RoleCode
+	}
+	return ""
+}
+
+func (x *InternalInviteUserRequest) GetInviterCode() string {
+	if x != nil && x.InviterCode != nil {
+		return *x.InviterCode
+	}
+	return ""
+}
+
+func (x *InternalInviteUserRequest) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+type InternalInviteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *InternalTenantMember  `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalInviteUserResponse) Reset() {
+	*x = InternalInviteUserResponse{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalInviteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalInviteUserResponse) ProtoMessage() {}
+
+func (x *InternalInviteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalInviteUserResponse.ProtoReflect.Descriptor instead.
+func (*InternalInviteUserResponse) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InternalInviteUserResponse) GetMember() *InternalTenantMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type InternalListMembersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Keyword       *string                `protobuf:"bytes,4,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`                                             // 昵称/邮箱关键词
+	RoleCode      *string                `protobuf:"bytes,5,opt,name=role_code,proto3,oneof" json:"role_code,omitempty"`                                         // 角色过滤
+	Status        *InternalMemberStatus  `protobuf:"varint,6,opt,name=status,proto3,enum=common.merchant.v1.InternalMemberStatus,oneof" json:"status,omitempty"` // 状态过滤
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListMembersRequest) Reset() {
+	*x = InternalListMembersRequest{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListMembersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListMembersRequest) ProtoMessage() {}
+
+func (x *InternalListMembersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListMembersRequest.ProtoReflect.Descriptor instead.
+func (*InternalListMembersRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InternalListMembersRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalListMembersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *InternalListMembersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *InternalListMembersRequest) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+func (x *InternalListMembersRequest) GetRoleCode() string {
+	if x != nil && x.RoleCode != nil {
+		return *x.RoleCode
+	}
+	return ""
+}
+
+func (x *InternalListMembersRequest) GetStatus() InternalMemberStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return InternalMemberStatus_MEMBER_STATUS_INVITED
+}
+
+type InternalListMembersResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Items         []*InternalTenantMember `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int64                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListMembersResponse) Reset() {
+	*x = InternalListMembersResponse{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListMembersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListMembersResponse) ProtoMessage() {}
+
+func (x *InternalListMembersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListMembersResponse.ProtoReflect.Descriptor instead.
+func (*InternalListMembersResponse) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *InternalListMembersResponse) GetItems() []*InternalTenantMember {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *InternalListMembersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type InternalRemoveMemberRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
+	UserCode      string                 `protobuf:"bytes,2,opt,name=user_code,proto3" json:"user_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalRemoveMemberRequest) Reset() {
+	*x = InternalRemoveMemberRequest{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalRemoveMemberRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalRemoveMemberRequest) ProtoMessage() {}
+
+func (x *InternalRemoveMemberRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalRemoveMemberRequest.ProtoReflect.Descriptor instead.
+func (*InternalRemoveMemberRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *InternalRemoveMemberRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalRemoveMemberRequest) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+type InternalChangeMemberRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,proto3" json:"tenant_code,omitempty"`
+	UserCode      string                 `protobuf:"bytes,2,opt,name=user_code,proto3" json:"user_code,omitempty"`
+	RoleCode      string                 `protobuf:"bytes,3,opt,name=role_code,proto3" json:"role_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalChangeMemberRoleRequest) Reset() {
+	*x = InternalChangeMemberRoleRequest{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalChangeMemberRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalChangeMemberRoleRequest) ProtoMessage() {}
+
+func (x *InternalChangeMemberRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalChangeMemberRoleRequest.ProtoReflect.Descriptor instead.
+func (*InternalChangeMemberRoleRequest) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *InternalChangeMemberRoleRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalChangeMemberRoleRequest) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+func (x *InternalChangeMemberRoleRequest) GetRoleCode() string {
+	if x != nil {
+		return x.RoleCode
+	}
+	return ""
+}
+
+type InternalChangeMemberRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Member        *InternalTenantMember  `protobuf:"bytes,1,opt,name=member,proto3" json:"member,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalChangeMemberRoleResponse) Reset() {
+	*x = InternalChangeMemberRoleResponse{}
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalChangeMemberRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalChangeMemberRoleResponse) ProtoMessage() {}
+
+func (x *InternalChangeMemberRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_merchant_v1_iam_integrate_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalChangeMemberRoleResponse.ProtoReflect.Descriptor instead.
+func (*InternalChangeMemberRoleResponse) Descriptor() ([]byte, []int) {
+	return file_merchant_v1_iam_integrate_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *InternalChangeMemberRoleResponse) GetMember() *InternalTenantMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+var File_merchant_v1_iam_integrate_proto protoreflect.FileDescriptor
+
+const file_merchant_v1_iam_integrate_proto_rawDesc = "" +
+	"\n" +
+	"\x1fmerchant/v1/iam_integrate.proto\x12\x12common.merchant.v1\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"i\n" +
+	"\x1bSetTenantPermissionsRequest\x12\x14\n" +
+	"\x05codes\x18\x01 \x03(\tR\x05codes\x12$\n" +
+	"\vtenant_code\x18\x02 \x01(\tH\x00R\n" +
+	"tenantCode\x88\x01\x01B\x0e\n" +
+	"\f_tenant_code\"Y\n" +
+	"\x1cSetTenantPermissionsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1f\n" +
+	"\vtotal_count\x18\x02 \x01(\x05R\n" +
+	"totalCount\"F\n" +
+	"#InternalGetTenantPermissionsRequest\x12\x1f\n" +
+	"\vtenant_code\x18\x01 \x01(\tR\n" +
+	"tenantCode\"<\n" +
+	"$InternalGetTenantPermissionsResponse\x12\x14\n" +
+	"\x05codes\x18\x01 \x03(\tR\x05codes\"\xc1\x03\n" +
+	"\x0eInternalTenant\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x122\n" +
+	"\x04type\x18\x04 \x01(\x0e2\x1e.common.merchant.v1.TenantTypeR\x04type\x12\x18\n" +
+	"\acountry\x18\x06 \x01(\tR\acountry\x128\n" +
+	"\x06status\x18\a \x01(\x0e2 .common.merchant.v1.TenantStatusR\x06status\x12\x1f\n" +
+	"\vmembers_num\x18\b \x01(\x05R\n" +
+	"membersNum\x12)\n" +
+	"\x10subscription_num\x18\t \x01(\x05R\x0fsubscriptionNum\x12;\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12D\n" +
+	"\raccess_levels\x18\v \x03(\x0e2\x1f.common.merchant.v1.AccessLevelR\faccessLevels\x12\x1a\n" +
+	"\blogo_url\x18\f \x01(\tR\blogo_url\"\xeb\x03\n" +
+	"\x19InternalListTenantRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x17\n" +
+	"\x04name\x18\x03 \x01(\tH\x00R\x04name\x88\x01\x01\x12=\n" +
+	"\x06status\x18\x04 \x01(\x0e2 .common.merchant.v1.TenantStatusH\x01R\x06status\x88\x01\x01\x12\x1d\n" +
+	"\acountry\x18\x05 \x01(\tH\x02R\acountry\x88\x01\x01\x127\n" +
+	"\x04type\x18\x06 \x01(\x0e2\x1e.common.merchant.v1.TenantTypeH\x03R\x04type\x88\x01\x01\x12G\n" +
+	"\faccess_level\x18\a \x01(\x0e2\x1f.common.merchant.v1.AccessLevelH\x04R\vaccessLevel\x88\x01\x01\x12\x14\n" +
+	"\x05codes\x18\b \x03(\tR\x05codes\x12\x1c\n" +
+	"\asort_by\x18\t \x01(\tH\x05R\x06sortBy\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"sort_order\x18\n" +
+	" \x01(\tH\x06R\tsortOrder\x88\x01\x01B\a\n" +
+	"\x05_nameB\t\n" +
+	"\a_statusB\n" +
+	"\n" +
+	"\b_countryB\a\n" +
+	"\x05_typeB\x0f\n" +
+	"\r_access_levelB\n" +
+	"\n" +
+	"\b_sort_byB\r\n" +
+	"\v_sort_order\"l\n" +
+	"\x1aInternalListTenantResponse\x128\n" +
+	"\x05items\x18\x01 \x03(\v2\".common.merchant.v1.InternalTenantR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xbd\x03\n" +
+	"\x14InternalPlatformUser\x12\x1c\n" +
+	"\tuser_code\x18\x01 \x01(\tR\tuser_code\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12\x1e\n" +
+	"\n" +
 	"avatar_url\x18\x04 \x01(\tR\n" +
 	"avatar_url\x12>\n" +
 	"\x06status\x18\x05 \x01(\x0e2&.common.merchant.v1.InternalUserStatusR\x06status\x12\x14\n" +
@@ -1306,7 +2938,128 @@ const file_merchant_v1_iam_integrate_proto_rawDesc = "" +
 	"\x1cInternalGetUserStatsResponse\x12 \n" +
 	"\vtotal_users\x18\x01 \x01(\x05R\vtotal_users\x12\"\n" +
 	"\factive_users\x18\x02 \x01(\x05R\factive_users\x12&\n" +
-	"\x0edisabled_users\x18\x04 \x01(\x05R\x0edisabled_users*\x9a\x01\n" +
+	"\x0edisabled_users\x18\x04 \x01(\x05R\x0edisabled_users\"\x9d\x03\n" +
+	"\x16InternalTenantSettings\x12 \n" +
+	"\vtenant_code\x18\x01 \x01(\tR\vtenant_code\x12\x1a\n" +
+	"\blanguage\x18\x02 \x01(\tR\blanguage\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12$\n" +
+	"\rcustom_domain\x18\x05 \x01(\tR\rcustom_domain\x12\"\n" +
+	"\fmfa_required\x18\x06 \x01(\bR\fmfa_required\x12K\n" +
+	"\x05extra\x18\a \x03(\v25.common.merchant.v1.InternalTenantSettings.ExtraEntryR\x05extra\x12<\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vupdate_time\x1a8\n" +
+	"\n" +
+	"ExtraEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"D\n" +
+	" InternalGetTenantSettingsRequest\x12 \n" +
+	"\vtenant_code\x18\x01 \x01(\tR\vtenant_code\"k\n" +
+	"!InternalGetTenantSettingsResponse\x12F\n" +
+	"\bsettings\x18\x01 \x01(\v2*.common.merchant.v1.InternalTenantSettingsR\bsettings\"\xa7\x01\n" +
+	"!InternalUpdateTenantStatusRequest\x12 \n" +
+	"\vtenant_code\x18\x01 \x01(\tR\vtenant_code\x128\n" +
+	"\x06status\x18\x02 \x01(\x0e2 .common.merchant.v1.TenantStatusR\x06status\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"`\n" +
+	"\"InternalUpdateTenantStatusResponse\x12:\n" +
+	"\x06tenant\x18\x01 \x01(\v2\".common.merchant.v1.InternalTenantR\x06tenant\"\xbc\x03\n" +
+	"\x0eInternalAPIKey\x12\x16\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x06key_id\x12 \n" +
+	"\vtenant_code\x18\x02 \x01(\tR\vtenant_code\x12\"\n" +
+	"\fproduct_code\x18\x03 \x01(\tR\fproduct_code\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x1e\n" +
+	"\n" +
+	"access_key\x18\x05 \x01(\tR\n" +
+	"access_key\x12\x16\n" +
+	"\x06scopes\x18\x06 \x03(\tR\x06scopes\x12@\n" +
+	"\x06status\x18\a \x01(\x0e2(.common.merchant.v1.InternalAPIKeyStatusR\x06status\x12<\n" +
+	"\vexpire_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vexpire_time\x12B\n" +
+	"\x0elast_used_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\x0elast_used_time\x12<\n" +
+	"\vcreate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcreate_time\"\xe2\x01\n" +
+	"\x1bInternalCreateAPIKeyRequest\x12 \n" +
+	"\vtenant_code\x18\x01 \x01(\tR\vtenant_code\x12\"\n" +
+	"\fproduct_code\x18\x02 \x01(\tR\fproduct_code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x04 \x03(\tR\x06scopes\x12A\n" +
+	"\vexpire_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\vexpire_time\x88\x01\x01B\x0e\n" +
+	"\f_expire_time\"l\n" +
+	"\x1cInternalCreateAPIKeyResponse\x124\n" +
+	"\x03key\x18\x01 \x01(\v2\".common.merchant.v1.InternalAPIKeyR\x03key\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\xf4\x01\n" +
+	"\x1aInternalListAPIKeysRequest\x12 \n" +
+	"\vtenant_code\x18\x01 \x01(\tR\vtenant_code\x12'\n" +
+	"\fproduct_code\x18\x02 \x01(\tH\x00R\fproduct_code\x88\x01\x01\x12E\n" +
+	"\x06status\x18\x03 \x01(\x0e2(.common.merchant.v1.InternalAPIKeyStatusH\x01R\x06status\x88\x01\x01\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limitB\x0f\n" +
+	"\r_product_codeB\t\n" +
+	"\a_status\"m\n" +
+	"\x1bInternalListAPIKeysResponse\x128\n" +
+	"\x05items\x18\x01 \x03(\v2\".common.merchant.v1.InternalAPIKeyR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\x7f\n" +
+	"\x1bInternalRevokeAPIKeyRequest\x12 \n" +
+	"\vtenant_code\x18\x01 \x01(\tR\vtenant_code\x12\x16\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x06key_id\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"T\n" +
+	"\x1cInternalRevokeAPIKeyResponse\x124\n" +
+	"\x03key\x18\x01 \x01(\v2\".common.merchant.v1.InternalAPIKeyR\x03key\"]\n" +
+	"!InternalRotateAPIKeySecretRequest\x12 \n" +
+	"\vtenant_code\x18\x01 \x01(\tR\vtenant_code\x12\x16\n" +
+	"\x06key_id\x18\x02 \x01(\tR\x06key_id\"r\n" +
+	"\"InternalRotateAPIKeySecretResponse\x124\n" +
+	"\x03key\x18\x01 \x01(\v2\".common.merchant.v1.InternalAPIKeyR\x03key\x12\x16\n" +
+	"\x06secret\x18\x02 \x01(\tR\x06secret\"\xa6\x03\n" +
+	"\x14InternalTenantMember\x12 \n" +
+	"\vtenant_code\x18\x01 \x01(\tR\vtenant_code\x12\x1c\n" +
+	"\tuser_code\x18\x02 \x01(\tR\tuser_code\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1e\n" +
+	"\n" +
+	"avatar_url\x18\x05 \x01(\tR\n" +
+	"avatar_url\x12\x1c\n" +
+	"\trole_code\x18\x06 \x01(\tR\trole_code\x12@\n" +
+	"\x06status\x18\a \x01(\x0e2(.common.merchant.v1.InternalMemberStatusR\x06status\x12$\n" +
+	"\rinvitation_id\x18\b \x01(\tR\rinvitation_id\x12<\n" +
+	"\vinvite_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\vinvite_time\x128\n" +
+	"\tjoin_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tjoin_time\"\xd6\x01\n" +
+	"\x19InternalInviteUserRequest\x12 \n" +
+	"\vtenant_code\x18\x01 \x01(\tR\vtenant_code\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1c\n" +
+	"\trole_code\x18\x03 \x01(\tR\trole_code\x12'\n" +
+	"\finviter_code\x18\x04 \x01(\tH\x00R\finviter_code\x88\x01\x01\x12\x1d\n" +
+	"\amessage\x18\x05 \x01(\tH\x01R\amessage\x88\x01\x01B\x0f\n" +
+	"\r_inviter_codeB\n" +
+	"\n" +
+	"\b_message\"^\n" +
+	"\x1aInternalInviteUserResponse\x12@\n" +
+	"\x06member\x18\x01 \x01(\v2(.common.merchant.v1.InternalTenantMemberR\x06member\"\x96\x02\n" +
+	"\x1aInternalListMembersRequest\x12 \n" +
+	"\vtenant_code\x18\x01 \x01(\tR\vtenant_code\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\akeyword\x18\x04 \x01(\tH\x00R\akeyword\x88\x01\x01\x12!\n" +
+	"\trole_code\x18\x05 \x01(\tH\x01R\trole_code\x88\x01\x01\x12E\n" +
+	"\x06status\x18\x06 \x01(\x0e2(.common.merchant.v1.InternalMemberStatusH\x02R\x06status\x88\x01\x01B\n" +
+	"\n" +
+	"\b_keywordB\f\n" +
+	"\n" +
+	"_role_codeB\t\n" +
+	"\a_status\"s\n" +
+	"\x1bInternalListMembersResponse\x12>\n" +
+	"\x05items\x18\x01 \x03(\v2(.common.merchant.v1.InternalTenantMemberR\x05items\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"]\n" +
+	"\x1bInternalRemoveMemberRequest\x12 \n" +
+	"\vtenant_code\x18\x01 \x01(\tR\vtenant_code\x12\x1c\n" +
+	"\tuser_code\x18\x02 \x01(\tR\tuser_code\"\x7f\n" +
+	"\x1fInternalChangeMemberRoleRequest\x12 \n" +
+	"\vtenant_code\x18\x01 \x01(\tR\vtenant_code\x12\x1c\n" +
+	"\tuser_code\x18\x02 \x01(\tR\tuser_code\x12\x1c\n" +
+	"\trole_code\x18\x03 \x01(\tR\trole_code\"d\n" +
+	" InternalChangeMemberRoleResponse\x12@\n" +
+	"\x06member\x18\x01 \x01(\v2(.common.merchant.v1.InternalTenantMemberR\x06member*\x9a\x01\n" +
 	"\fTenantStatus\x12\x19\n" +
 	"\x15TENANT_STATUS_PENDING\x10\x00\x12\x18\n" +
 	"\x14TENANT_STATUS_ACTIVE\x10\x01\x12\x1a\n" +
@@ -1324,14 +3077,33 @@ const file_merchant_v1_iam_integrate_proto_rawDesc = "" +
 	"\x12InternalUserStatus\x12\x17\n" +
 	"\x13USER_STATUS_PENDING\x10\x00\x12\x16\n" +
 	"\x12USER_STATUS_ACTIVE\x10\x01\x12\x18\n" +
-	"\x14USER_STATUS_DISABLED\x10\x022\xfa\x05\n" +
+	"\x14USER_STATUS_DISABLED\x10\x02*i\n" +
+	"\x14InternalAPIKeyStatus\x12\x19\n" +
+	"\x15API_KEY_STATUS_ACTIVE\x10\x00\x12\x1a\n" +
+	"\x16API_KEY_STATUS_REVOKED\x10\x01\x12\x1a\n" +
+	"\x16API_KEY_STATUS_EXPIRED\x10\x02*g\n" +
+	"\x14InternalMemberStatus\x12\x19\n" +
+	"\x15MEMBER_STATUS_INVITED\x10\x00\x12\x18\n" +
+	"\x14MEMBER_STATUS_ACTIVE\x10\x01\x12\x1a\n" +
+	"\x16MEMBER_STATUS_DISABLED\x10\x022\xf9\x10\n" +
 	"\x12merchantIamService\x12y\n" +
-	"\x14SetTenantPermissions\x12/.common.merchant.v1.SetTenantPermissionsRequest\x1a0.common.merchant.v1.SetTenantPermissionsResponse\x12s\n" +
+	"\x14SetTenantPermissions\x12/.common.merchant.v1.SetTenantPermissionsRequest\x1a0.common.merchant.v1.SetTenantPermissionsResponse\x12\x91\x01\n" +
+	"\x1cInternalGetTenantPermissions\x127.common.merchant.v1.InternalGetTenantPermissionsRequest\x1a8.common.merchant.v1.InternalGetTenantPermissionsResponse\x12s\n" +
 	"\x12InternalListTenant\x12-.common.merchant.v1.InternalListTenantRequest\x1a..common.merchant.v1.InternalListTenantResponse\x12\x85\x01\n" +
 	"\x18InternalListPlatformUser\x123.common.merchant.v1.InternalListPlatformUserRequest\x1a4.common.merchant.v1.InternalListPlatformUserResponse\x12p\n" +
 	"\x11InternalGetTenant\x12,.common.merchant.v1.InternalGetTenantRequest\x1a-.common.merchant.v1.InternalGetTenantResponse\x12\x7f\n" +
 	"\x16InternalGetTenantStats\x121.common.merchant.v1.InternalGetTenantStatsRequest\x1a2.common.merchant.v1.InternalGetTenantStatsResponse\x12y\n" +
-	"\x14InternalGetUserStats\x12/.common.merchant.v1.InternalGetUserStatsRequest\x1a0.common.merchant.v1.InternalGetUserStatsResponseB\xd3\x01\n" +
+	"\x14InternalGetUserStats\x12/.common.merchant.v1.InternalGetUserStatsRequest\x1a0.common.merchant.v1.InternalGetUserStatsResponse\x12\x88\x01\n" +
+	"\x19InternalGetTenantSettings\x124.common.merchant.v1.InternalGetTenantSettingsRequest\x1a5.common.merchant.v1.InternalGetTenantSettingsResponse\x12\x8b\x01\n" +
+	"\x1aInternalUpdateTenantStatus\x125.common.merchant.v1.InternalUpdateTenantStatusRequest\x1a6.common.merchant.v1.InternalUpdateTenantStatusResponse\x12y\n" +
+	"\x14InternalCreateAPIKey\x12/.common.merchant.v1.InternalCreateAPIKeyRequest\x1a0.common.merchant.v1.InternalCreateAPIKeyResponse\x12v\n" +
+	"\x13InternalListAPIKeys\x12..common.merchant.v1.InternalListAPIKeysRequest\x1a/.common.merchant.v1.InternalListAPIKeysResponse\x12y\n" +
+	"\x14InternalRevokeAPIKey\x12/.common.merchant.v1.InternalRevokeAPIKeyRequest\x1a0.common.merchant.v1.InternalRevokeAPIKeyResponse\x12\x8b\x01\n" +
+	"\x1aInternalRotateAPIKeySecret\x125.common.merchant.v1.InternalRotateAPIKeySecretRequest\x1a6.common.merchant.v1.InternalRotateAPIKeySecretResponse\x12s\n" +
+	"\x12InternalInviteUser\x12-.common.merchant.v1.InternalInviteUserRequest\x1a..common.merchant.v1.InternalInviteUserResponse\x12v\n" +
+	"\x13InternalListMembers\x12..common.merchant.v1.InternalListMembersRequest\x1a/.common.merchant.v1.InternalListMembersResponse\x12_\n" +
+	"\x14InternalRemoveMember\x12/.common.merchant.v1.InternalRemoveMemberRequest\x1a\x16.google.protobuf.Empty\x12\x85\x01\n" +
+	"\x18InternalChangeMemberRole\x123.common.merchant.v1.InternalChangeMemberRoleRequest\x1a4.common.merchant.v1.InternalChangeMemberRoleResponseB\xd3\x01\n" +
 	"\x16com.common.merchant.v1B\x11IamIntegrateProtoP\x01Z<github.com/heyinLab/common/api/gen/go/merchant/v1;merchantv1\xa2\x02\x03CMX\xaa\x02\x12Common.Merchant.V1\xca\x02\x12Common\\Merchant\\V1\xe2\x02\x1eCommon\\Merchant\\V1\\GPBMetadata\xea\x02\x14Common::Merchant::V1b\x06proto3"
 
 var (
@@ -1346,63 +3118,135 @@ func file_merchant_v1_iam_integrate_proto_rawDescGZIP() []byte {
 	return file_merchant_v1_iam_integrate_proto_rawDescData
 }
 
-var file_merchant_v1_iam_integrate_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_merchant_v1_iam_integrate_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_merchant_v1_iam_integrate_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_merchant_v1_iam_integrate_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_merchant_v1_iam_integrate_proto_goTypes = []any{
-	(TenantStatus)(0),                        // 0: common.merchant.v1.TenantStatus
-	(TenantType)(0),                          // 1: common.merchant.v1.TenantType
-	(AccessLevel)(0),                         // 2: common.merchant.v1.AccessLevel
-	(InternalUserStatus)(0),                  // 3: common.merchant.v1.InternalUserStatus
-	(*SetTenantPermissionsRequest)(nil),      // 4: common.merchant.v1.SetTenantPermissionsRequest
-	(*SetTenantPermissionsResponse)(nil),     // 5: common.merchant.v1.SetTenantPermissionsResponse
-	(*InternalTenant)(nil),                   // 6: common.merchant.v1.InternalTenant
-	(*InternalListTenantRequest)(nil),        // 7: common.merchant.v1.InternalListTenantRequest
-	(*InternalListTenantResponse)(nil),       // 8: common.merchant.v1.InternalListTenantResponse
-	(*InternalPlatformUser)(nil),             // 9: common.merchant.v1.InternalPlatformUser
-	(*InternalAssociationInfo)(nil),          // 10: common.merchant.v1.InternalAssociationInfo
-	(*InternalListPlatformUserRequest)(nil),  // 11: common.merchant.v1.InternalListPlatformUserRequest
-	(*InternalListPlatformUserResponse)(nil), // 12: common.merchant.v1.InternalListPlatformUserResponse
-	(*InternalGetTenantRequest)(nil),         // 13: common.merchant.v1.InternalGetTenantRequest
-	(*InternalGetTenantResponse)(nil),        // 14: common.merchant.v1.InternalGetTenantResponse
-	(*InternalGetTenantStatsRequest)(nil),    // 15: common.merchant.v1.InternalGetTenantStatsRequest
-	(*InternalGetTenantStatsResponse)(nil),   // 16: common.merchant.v1.InternalGetTenantStatsResponse
-	(*InternalGetUserStatsRequest)(nil),      // 17: common.merchant.v1.InternalGetUserStatsRequest
-	(*InternalGetUserStatsResponse)(nil),     // 18: common.merchant.v1.InternalGetUserStatsResponse
-	(*timestamppb.Timestamp)(nil),            // 19: google.protobuf.Timestamp
+	(TenantStatus)(0),                            // 0: common.merchant.v1.TenantStatus
+	(TenantType)(0),                              // 1: common.merchant.v1.TenantType
+	(AccessLevel)(0),                             // 2: common.merchant.v1.AccessLevel
+	(InternalUserStatus)(0),                      // 3: common.merchant.v1.InternalUserStatus
+	(InternalAPIKeyStatus)(0),                    // 4: common.merchant.v1.InternalAPIKeyStatus
+	(InternalMemberStatus)(0),                    // 5: common.merchant.v1.InternalMemberStatus
+	(*SetTenantPermissionsRequest)(nil),          // 6: common.merchant.v1.SetTenantPermissionsRequest
+	(*SetTenantPermissionsResponse)(nil),         // 7: common.merchant.v1.SetTenantPermissionsResponse
+	(*InternalGetTenantPermissionsRequest)(nil),  // 8: common.merchant.v1.InternalGetTenantPermissionsRequest
+	(*InternalGetTenantPermissionsResponse)(nil), // 9: common.merchant.v1.InternalGetTenantPermissionsResponse
+	(*InternalTenant)(nil),                       // 10: common.merchant.v1.InternalTenant
+	(*InternalListTenantRequest)(nil),            // 11: common.merchant.v1.InternalListTenantRequest
+	(*InternalListTenantResponse)(nil),           // 12: common.merchant.v1.InternalListTenantResponse
+	(*InternalPlatformUser)(nil),                 // 13: common.merchant.v1.InternalPlatformUser
+	(*InternalAssociationInfo)(nil),              // 14: common.merchant.v1.InternalAssociationInfo
+	(*InternalListPlatformUserRequest)(nil),      // 15: common.merchant.v1.InternalListPlatformUserRequest
+	(*InternalListPlatformUserResponse)(nil),     // 16: common.merchant.v1.InternalListPlatformUserResponse
+	(*InternalGetTenantRequest)(nil),             // 17: common.merchant.v1.InternalGetTenantRequest
+	(*InternalGetTenantResponse)(nil),            // 18: common.merchant.v1.InternalGetTenantResponse
+	(*InternalGetTenantStatsRequest)(nil),        // 19: common.merchant.v1.InternalGetTenantStatsRequest
+	(*InternalGetTenantStatsResponse)(nil),       // 20: common.merchant.v1.InternalGetTenantStatsResponse
+	(*InternalGetUserStatsRequest)(nil),          // 21: common.merchant.v1.InternalGetUserStatsRequest
+	(*InternalGetUserStatsResponse)(nil),         // 22: common.merchant.v1.InternalGetUserStatsResponse
+	(*InternalTenantSettings)(nil),               // 23: common.merchant.v1.InternalTenantSettings
+	(*InternalGetTenantSettingsRequest)(nil),     // 24: common.merchant.v1.InternalGetTenantSettingsRequest
+	(*InternalGetTenantSettingsResponse)(nil),    // 25: common.merchant.v1.InternalGetTenantSettingsResponse
+	(*InternalUpdateTenantStatusRequest)(nil),    // 26: common.merchant.v1.InternalUpdateTenantStatusRequest
+	(*InternalUpdateTenantStatusResponse)(nil),   // 27: common.merchant.v1.InternalUpdateTenantStatusResponse
+	(*InternalAPIKey)(nil),                       // 28: common.merchant.v1.InternalAPIKey
+	(*InternalCreateAPIKeyRequest)(nil),          // 29: common.merchant.v1.InternalCreateAPIKeyRequest
+	(*InternalCreateAPIKeyResponse)(nil),         // 30: common.merchant.v1.InternalCreateAPIKeyResponse
+	(*InternalListAPIKeysRequest)(nil),           // 31: common.merchant.v1.InternalListAPIKeysRequest
+	(*InternalListAPIKeysResponse)(nil),          // 32: common.merchant.v1.InternalListAPIKeysResponse
+	(*InternalRevokeAPIKeyRequest)(nil),          // 33: common.merchant.v1.InternalRevokeAPIKeyRequest
+	(*InternalRevokeAPIKeyResponse)(nil),         // 34: common.merchant.v1.InternalRevokeAPIKeyResponse
+	(*InternalRotateAPIKeySecretRequest)(nil),    // 35: common.merchant.v1.InternalRotateAPIKeySecretRequest
+	(*InternalRotateAPIKeySecretResponse)(nil),   // 36: common.merchant.v1.InternalRotateAPIKeySecretResponse
+	(*InternalTenantMember)(nil),                 // 37: common.merchant.v1.InternalTenantMember
+	(*InternalInviteUserRequest)(nil),            // 38: common.merchant.v1.InternalInviteUserRequest
+	(*InternalInviteUserResponse)(nil),           // 39: common.merchant.v1.InternalInviteUserResponse
+	(*InternalListMembersRequest)(nil),           // 40: common.merchant.v1.InternalListMembersRequest
+	(*InternalListMembersResponse)(nil),          // 41: common.merchant.v1.InternalListMembersResponse
+	(*InternalRemoveMemberRequest)(nil),          // 42: common.merchant.v1.InternalRemoveMemberRequest
+	(*InternalChangeMemberRoleRequest)(nil),      // 43: common.merchant.v1.InternalChangeMemberRoleRequest
+	(*InternalChangeMemberRoleResponse)(nil),     // 44: common.merchant.v1.InternalChangeMemberRoleResponse
+	nil,                                          // 45: common.merchant.v1.InternalTenantSettings.ExtraEntry
+	(*timestamppb.Timestamp)(nil),                // 46: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                        // 47: google.protobuf.Empty
 }
 var file_merchant_v1_iam_integrate_proto_depIdxs = []int32{
 	1,  // 0: common.merchant.v1.InternalTenant.type:type_name -> common.merchant.v1.TenantType
 	0,  // 1: common.merchant.v1.InternalTenant.status:type_name -> common.merchant.v1.TenantStatus
-	19, // 2: common.merchant.v1.InternalTenant.create_time:type_name -> google.protobuf.Timestamp
+	46, // 2: common.merchant.v1.InternalTenant.create_time:type_name -> google.protobuf.Timestamp
 	2,  // 3: common.merchant.v1.InternalTenant.access_levels:type_name -> common.merchant.v1.AccessLevel
 	0,  // 4: common.merchant.v1.InternalListTenantRequest.status:type_name -> common.merchant.v1.TenantStatus
 	1,  // 5: common.merchant.v1.InternalListTenantRequest.type:type_name -> common.merchant.v1.TenantType
 	2,  // 6: common.merchant.v1.InternalListTenantRequest.access_level:type_name -> common.merchant.v1.AccessLevel
-	6,  // 7: common.merchant.v1.InternalListTenantResponse.items:type_name -> common.merchant.v1.InternalTenant
+	10, // 7: common.merchant.v1.InternalListTenantResponse.items:type_name -> common.merchant.v1.InternalTenant
 	3,  // 8: common.merchant.v1.InternalPlatformUser.status:type_name -> common.merchant.v1.InternalUserStatus
-	19, // 9: common.merchant.v1.InternalPlatformUser.last_login_time:type_name -> google.protobuf.Timestamp
-	19, // 10: common.merchant.v1.InternalPlatformUser.create_time:type_name -> google.protobuf.Timestamp
-	10, // 11: common.merchant.v1.InternalPlatformUser.association:type_name -> common.merchant.v1.InternalAssociationInfo
+	46, // 9: common.merchant.v1.InternalPlatformUser.last_login_time:type_name -> google.protobuf.Timestamp
+	46, // 10: common.merchant.v1.InternalPlatformUser.create_time:type_name -> google.protobuf.Timestamp
+	14, // 11: common.merchant.v1.InternalPlatformUser.association:type_name -> common.merchant.v1.InternalAssociationInfo
 	3,  // 12: common.merchant.v1.InternalListPlatformUserRequest.status:type_name -> common.merchant.v1.InternalUserStatus
-	9,  // 13: common.merchant.v1.InternalListPlatformUserResponse.items:type_name -> common.merchant.v1.InternalPlatformUser
-	6,  // 14: common.merchant.v1.InternalGetTenantResponse.tenant:type_name -> common.merchant.v1.InternalTenant
-	4,  // 15: common.merchant.v1.merchantIamService.SetTenantPermissions:input_type -> common.merchant.v1.SetTenantPermissionsRequest
-	7,  // 16: common.merchant.v1.merchantIamService.InternalListTenant:input_type -> common.merchant.v1.InternalListTenantRequest
-	11, // 17: common.merchant.v1.merchantIamService.InternalListPlatformUser:input_type -> common.merchant.v1.InternalListPlatformUserRequest
-	13, // 18: common.merchant.v1.merchantIamService.InternalGetTenant:input_type -> common.merchant.v1.InternalGetTenantRequest
-	15, // 19: common.merchant.v1.merchantIamService.InternalGetTenantStats:input_type -> common.merchant.v1.InternalGetTenantStatsRequest
-	17, // 20: common.merchant.v1.merchantIamService.InternalGetUserStats:input_type -> common.merchant.v1.InternalGetUserStatsRequest
-	5,  // 21: common.merchant.v1.merchantIamService.SetTenantPermissions:output_type -> common.merchant.v1.SetTenantPermissionsResponse
-	8,  // 22: common.merchant.v1.merchantIamService.InternalListTenant:output_type -> common.merchant.v1.InternalListTenantResponse
-	12, // 23: common.merchant.v1.merchantIamService.InternalListPlatformUser:output_type -> common.merchant.v1.InternalListPlatformUserResponse
-	14, // 24: common.merchant.v1.merchantIamService.InternalGetTenant:output_type -> common.merchant.v1.InternalGetTenantResponse
-	16, // 25: common.merchant.v1.merchantIamService.InternalGetTenantStats:output_type -> common.merchant.v1.InternalGetTenantStatsResponse
-	18, // 26: common.merchant.v1.merchantIamService.InternalGetUserStats:output_type -> common.merchant.v1.InternalGetUserStatsResponse
-	21, // [21:27] is the sub-list for method output_type
-	15, // [15:21] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	13, // 13: common.merchant.v1.InternalListPlatformUserResponse.items:type_name -> common.merchant.v1.InternalPlatformUser
+	10, // 14: common.merchant.v1.InternalGetTenantResponse.tenant:type_name -> common.merchant.v1.InternalTenant
+	45, // 15: common.merchant.v1.InternalTenantSettings.extra:type_name -> common.merchant.v1.InternalTenantSettings.ExtraEntry
+	46, // 16: common.merchant.v1.InternalTenantSettings.update_time:type_name -> google.protobuf.Timestamp
+	23, // 17: common.merchant.v1.InternalGetTenantSettingsResponse.settings:type_name -> common.merchant.v1.InternalTenantSettings
+	0,  // 18: common.merchant.v1.InternalUpdateTenantStatusRequest.status:type_name -> common.merchant.v1.TenantStatus
+	10, // 19: common.merchant.v1.InternalUpdateTenantStatusResponse.tenant:type_name -> common.merchant.v1.InternalTenant
+	4,  // 20: common.merchant.v1.InternalAPIKey.status:type_name -> common.merchant.v1.InternalAPIKeyStatus
+	46, // 21: common.merchant.v1.InternalAPIKey.expire_time:type_name -> google.protobuf.Timestamp
+	46, // 22: common.merchant.v1.InternalAPIKey.last_used_time:type_name -> google.protobuf.Timestamp
+	46, // 23: common.merchant.v1.InternalAPIKey.create_time:type_name -> google.protobuf.Timestamp
+	46, // 24: common.merchant.v1.InternalCreateAPIKeyRequest.expire_time:type_name -> google.protobuf.Timestamp
+	28, // 25: common.merchant.v1.InternalCreateAPIKeyResponse.key:type_name -> common.merchant.v1.InternalAPIKey
+	4,  // 26: common.merchant.v1.InternalListAPIKeysRequest.status:type_name -> common.merchant.v1.InternalAPIKeyStatus
+	28, // 27: common.merchant.v1.InternalListAPIKeysResponse.items:type_name -> common.merchant.v1.InternalAPIKey
+	28, // 28: common.merchant.v1.InternalRevokeAPIKeyResponse.key:type_name -> common.merchant.v1.InternalAPIKey
+	28, // 29: common.merchant.v1.InternalRotateAPIKeySecretResponse.key:type_name -> common.merchant.v1.InternalAPIKey
+	5,  // 30: common.merchant.v1.InternalTenantMember.status:type_name -> common.merchant.v1.InternalMemberStatus
+	46, // 31: common.merchant.v1.InternalTenantMember.invite_time:type_name -> google.protobuf.Timestamp
+	46, // 32: common.merchant.v1.InternalTenantMember.join_time:type_name -> google.protobuf.Timestamp
+	37, // 33: common.merchant.v1.InternalInviteUserResponse.member:type_name -> common.merchant.v1.InternalTenantMember
+	5,  // 34: common.merchant.v1.InternalListMembersRequest.status:type_name -> common.merchant.v1.InternalMemberStatus
+	37, // 35: common.merchant.v1.InternalListMembersResponse.items:type_name -> common.merchant.v1.InternalTenantMember
+	37, // 36: common.merchant.v1.InternalChangeMemberRoleResponse.member:type_name -> common.merchant.v1.InternalTenantMember
+	6,  // 37: common.merchant.v1.merchantIamService.SetTenantPermissions:input_type -> common.merchant.v1.SetTenantPermissionsRequest
+	8,  // 38: common.merchant.v1.merchantIamService.InternalGetTenantPermissions:input_type -> common.merchant.v1.InternalGetTenantPermissionsRequest
+	11, // 39: common.merchant.v1.merchantIamService.InternalListTenant:input_type -> common.merchant.v1.InternalListTenantRequest
+	15, // 40: common.merchant.v1.merchantIamService.InternalListPlatformUser:input_type -> common.merchant.v1.InternalListPlatformUserRequest
+	17, // 41: common.merchant.v1.merchantIamService.InternalGetTenant:input_type -> common.merchant.v1.InternalGetTenantRequest
+	19, // 42: common.merchant.v1.merchantIamService.InternalGetTenantStats:input_type -> common.merchant.v1.InternalGetTenantStatsRequest
+	21, // 43: common.merchant.v1.merchantIamService.InternalGetUserStats:input_type -> common.merchant.v1.InternalGetUserStatsRequest
+	24, // 44: common.merchant.v1.merchantIamService.InternalGetTenantSettings:input_type -> common.merchant.v1.InternalGetTenantSettingsRequest
+	26, // 45: common.merchant.v1.merchantIamService.InternalUpdateTenantStatus:input_type -> common.merchant.v1.InternalUpdateTenantStatusRequest
+	29, // 46: common.merchant.v1.merchantIamService.InternalCreateAPIKey:input_type -> common.merchant.v1.InternalCreateAPIKeyRequest
+	31, // 47: common.merchant.v1.merchantIamService.InternalListAPIKeys:input_type -> common.merchant.v1.InternalListAPIKeysRequest
+	33, // 48: common.merchant.v1.merchantIamService.InternalRevokeAPIKey:input_type -> common.merchant.v1.InternalRevokeAPIKeyRequest
+	35, // 49: common.merchant.v1.merchantIamService.InternalRotateAPIKeySecret:input_type -> common.merchant.v1.InternalRotateAPIKeySecretRequest
+	38, // 50: common.merchant.v1.merchantIamService.InternalInviteUser:input_type -> common.merchant.v1.InternalInviteUserRequest
+	40, // 51: common.merchant.v1.merchantIamService.InternalListMembers:input_type -> common.merchant.v1.InternalListMembersRequest
+	42, // 52: common.merchant.v1.merchantIamService.InternalRemoveMember:input_type -> common.merchant.v1.InternalRemoveMemberRequest
+	43, // 53: common.merchant.v1.merchantIamService.InternalChangeMemberRole:input_type -> common.merchant.v1.InternalChangeMemberRoleRequest
+	7,  // 54: common.merchant.v1.merchantIamService.SetTenantPermissions:output_type -> common.merchant.v1.SetTenantPermissionsResponse
+	9,  // 55: common.merchant.v1.merchantIamService.InternalGetTenantPermissions:output_type -> common.merchant.v1.InternalGetTenantPermissionsResponse
+	12, // 56: common.merchant.v1.merchantIamService.InternalListTenant:output_type -> common.merchant.v1.InternalListTenantResponse
+	16, // 57: common.merchant.v1.merchantIamService.InternalListPlatformUser:output_type -> common.merchant.v1.InternalListPlatformUserResponse
+	18, // 58: common.merchant.v1.merchantIamService.InternalGetTenant:output_type -> common.merchant.v1.InternalGetTenantResponse
+	20, // 59: common.merchant.v1.merchantIamService.InternalGetTenantStats:output_type -> common.merchant.v1.InternalGetTenantStatsResponse
+	22, // 60: common.merchant.v1.merchantIamService.InternalGetUserStats:output_type -> common.merchant.v1.InternalGetUserStatsResponse
+	25, // 61: common.merchant.v1.merchantIamService.InternalGetTenantSettings:output_type -> common.merchant.v1.InternalGetTenantSettingsResponse
+	27, // 62: common.merchant.v1.merchantIamService.InternalUpdateTenantStatus:output_type -> common.merchant.v1.InternalUpdateTenantStatusResponse
+	30, // 63: common.merchant.v1.merchantIamService.InternalCreateAPIKey:output_type -> common.merchant.v1.InternalCreateAPIKeyResponse
+	32, // 64: common.merchant.v1.merchantIamService.InternalListAPIKeys:output_type -> common.merchant.v1.InternalListAPIKeysResponse
+	34, // 65: common.merchant.v1.merchantIamService.InternalRevokeAPIKey:output_type -> common.merchant.v1.InternalRevokeAPIKeyResponse
+	36, // 66: common.merchant.v1.merchantIamService.InternalRotateAPIKeySecret:output_type -> common.merchant.v1.InternalRotateAPIKeySecretResponse
+	39, // 67: common.merchant.v1.merchantIamService.InternalInviteUser:output_type -> common.merchant.v1.InternalInviteUserResponse
+	41, // 68: common.merchant.v1.merchantIamService.InternalListMembers:output_type -> common.merchant.v1.InternalListMembersResponse
+	47, // 69: common.merchant.v1.merchantIamService.InternalRemoveMember:output_type -> google.protobuf.Empty
+	44, // 70: common.merchant.v1.merchantIamService.InternalChangeMemberRole:output_type -> common.merchant.v1.InternalChangeMemberRoleResponse
+	54, // [54:71] is the sub-list for method output_type
+	37, // [37:54] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_merchant_v1_iam_integrate_proto_init() }
@@ -1411,15 +3255,21 @@ func file_merchant_v1_iam_integrate_proto_init() {
 		return
 	}
 	file_merchant_v1_iam_integrate_proto_msgTypes[0].OneofWrappers = []any{}
-	file_merchant_v1_iam_integrate_proto_msgTypes[3].OneofWrappers = []any{}
-	file_merchant_v1_iam_integrate_proto_msgTypes[7].OneofWrappers = []any{}
+	file_merchant_v1_iam_integrate_proto_msgTypes[5].OneofWrappers = []any{}
+	file_merchant_v1_iam_integrate_proto_msgTypes[9].OneofWrappers = []any{}
+	file_merchant_v1_iam_integrate_proto_msgTypes[20].OneofWrappers = []any{}
+	file_merchant_v1_iam_integrate_proto_msgTypes[23].OneofWrappers = []any{}
+	file_merchant_v1_iam_integrate_proto_msgTypes[25].OneofWrappers = []any{}
+	file_merchant_v1_iam_integrate_proto_msgTypes[27].OneofWrappers = []any{}
+	file_merchant_v1_iam_integrate_proto_msgTypes[32].OneofWrappers = []any{}
+	file_merchant_v1_iam_integrate_proto_msgTypes[34].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_merchant_v1_iam_integrate_proto_rawDesc), len(file_merchant_v1_iam_integrate_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   15,
+			NumEnums:      6,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
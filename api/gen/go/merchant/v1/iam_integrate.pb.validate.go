@@ -249,6 +249,218 @@ var _ interface {
 	ErrorName() string
 } = SetTenantPermissionsResponseValidationError{}
 
+// Validate checks the field values on InternalGetTenantPermissionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalGetTenantPermissionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetTenantPermissionsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalGetTenantPermissionsRequestMultiError, or nil if none found.
+func (m *InternalGetTenantPermissionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetTenantPermissionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	if len(errors) > 0 {
+		return InternalGetTenantPermissionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetTenantPermissionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalGetTenantPermissionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetTenantPermissionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetTenantPermissionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetTenantPermissionsRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetTenantPermissionsRequestValidationError is the validation error
+// returned by InternalGetTenantPermissionsRequest.Validate if the designated
+// constraints aren't met.
+type InternalGetTenantPermissionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetTenantPermissionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetTenantPermissionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetTenantPermissionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetTenantPermissionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetTenantPermissionsRequestValidationError) ErrorName() string {
+	return "InternalGetTenantPermissionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetTenantPermissionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetTenantPermissionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetTenantPermissionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetTenantPermissionsRequestValidationError{}
+
+// Validate checks the field values on InternalGetTenantPermissionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *InternalGetTenantPermissionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetTenantPermissionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalGetTenantPermissionsResponseMultiError, or nil if none found.
+func (m *InternalGetTenantPermissionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetTenantPermissionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return InternalGetTenantPermissionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetTenantPermissionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalGetTenantPermissionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetTenantPermissionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetTenantPermissionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetTenantPermissionsResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetTenantPermissionsResponseValidationError is the validation error
+// returned by InternalGetTenantPermissionsResponse.Validate if the designated
+// constraints aren't met.
+type InternalGetTenantPermissionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetTenantPermissionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetTenantPermissionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetTenantPermissionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetTenantPermissionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetTenantPermissionsResponseValidationError) ErrorName() string {
+	return "InternalGetTenantPermissionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetTenantPermissionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetTenantPermissionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetTenantPermissionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetTenantPermissionsResponseValidationError{}
+
 // Validate checks the field values on InternalTenant with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -442,6 +654,14 @@ func (m *InternalListTenantRequest) validate(all bool) error {
 		// no validation rules for AccessLevel
 	}
 
+	if m.SortBy != nil {
+		// no validation rules for SortBy
+	}
+
+	if m.SortOrder != nil {
+		// no validation rules for SortOrder
+	}
+
 	if len(errors) > 0 {
 		return InternalListTenantRequestMultiError(errors)
 	}
@@ -1898,3 +2118,2886 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = InternalGetUserStatsResponseValidationError{}
+
+// Validate checks the field values on InternalTenantSettings with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalTenantSettings) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalTenantSettings with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalTenantSettingsMultiError, or nil if none found.
+func (m *InternalTenantSettings) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalTenantSettings) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for Language
+
+	// no validation rules for Timezone
+
+	// no validation rules for Currency
+
+	// no validation rules for CustomDomain
+
+	// no validation rules for MfaRequired
+
+	// no validation rules for Extra
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalTenantSettingsValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalTenantSettingsValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalTenantSettingsValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalTenantSettingsMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalTenantSettingsMultiError is an error wrapping multiple validation
+// errors returned by InternalTenantSettings.ValidateAll() if the designated
+// constraints aren't met.
+type InternalTenantSettingsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalTenantSettingsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalTenantSettingsMultiError) AllErrors() []error { return m }
+
+// InternalTenantSettingsValidationError is the validation error returned by
+// InternalTenantSettings.Validate if the designated constraints aren't met.
+type InternalTenantSettingsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalTenantSettingsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalTenantSettingsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalTenantSettingsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalTenantSettingsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalTenantSettingsValidationError) ErrorName() string {
+	return "InternalTenantSettingsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalTenantSettingsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalTenantSettings.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalTenantSettingsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalTenantSettingsValidationError{}
+
+// Validate checks the field values on InternalGetTenantSettingsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalGetTenantSettingsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetTenantSettingsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetTenantSettingsRequestMultiError, or nil if none found.
+func (m *InternalGetTenantSettingsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetTenantSettingsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	if len(errors) > 0 {
+		return InternalGetTenantSettingsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetTenantSettingsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalGetTenantSettingsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetTenantSettingsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetTenantSettingsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetTenantSettingsRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetTenantSettingsRequestValidationError is the validation error
+// returned by InternalGetTenantSettingsRequest.Validate if the designated
+// constraints aren't met.
+type InternalGetTenantSettingsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetTenantSettingsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetTenantSettingsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetTenantSettingsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetTenantSettingsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetTenantSettingsRequestValidationError) ErrorName() string {
+	return "InternalGetTenantSettingsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetTenantSettingsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetTenantSettingsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetTenantSettingsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetTenantSettingsRequestValidationError{}
+
+// Validate checks the field values on InternalGetTenantSettingsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalGetTenantSettingsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetTenantSettingsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalGetTenantSettingsResponseMultiError, or nil if none found.
+func (m *InternalGetTenantSettingsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetTenantSettingsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSettings()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalGetTenantSettingsResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalGetTenantSettingsResponseValidationError{
+					field:  "Settings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSettings()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalGetTenantSettingsResponseValidationError{
+				field:  "Settings",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalGetTenantSettingsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetTenantSettingsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalGetTenantSettingsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetTenantSettingsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetTenantSettingsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetTenantSettingsResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetTenantSettingsResponseValidationError is the validation error
+// returned by InternalGetTenantSettingsResponse.Validate if the designated
+// constraints aren't met.
+type InternalGetTenantSettingsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetTenantSettingsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetTenantSettingsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetTenantSettingsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetTenantSettingsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetTenantSettingsResponseValidationError) ErrorName() string {
+	return "InternalGetTenantSettingsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetTenantSettingsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetTenantSettingsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetTenantSettingsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetTenantSettingsResponseValidationError{}
+
+// Validate checks the field values on InternalUpdateTenantStatusRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalUpdateTenantStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalUpdateTenantStatusRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalUpdateTenantStatusRequestMultiError, or nil if none found.
+func (m *InternalUpdateTenantStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalUpdateTenantStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for Status
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if len(errors) > 0 {
+		return InternalUpdateTenantStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalUpdateTenantStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalUpdateTenantStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalUpdateTenantStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalUpdateTenantStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalUpdateTenantStatusRequestMultiError) AllErrors() []error { return m }
+
+// InternalUpdateTenantStatusRequestValidationError is the validation error
+// returned by InternalUpdateTenantStatusRequest.Validate if the designated
+// constraints aren't met.
+type InternalUpdateTenantStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalUpdateTenantStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalUpdateTenantStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalUpdateTenantStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalUpdateTenantStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalUpdateTenantStatusRequestValidationError) ErrorName() string {
+	return "InternalUpdateTenantStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalUpdateTenantStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalUpdateTenantStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalUpdateTenantStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalUpdateTenantStatusRequestValidationError{}
+
+// Validate checks the field values on InternalUpdateTenantStatusResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalUpdateTenantStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalUpdateTenantStatusResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalUpdateTenantStatusResponseMultiError, or nil if none found.
+func (m *InternalUpdateTenantStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalUpdateTenantStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTenant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalUpdateTenantStatusResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalUpdateTenantStatusResponseValidationError{
+					field:  "Tenant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTenant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalUpdateTenantStatusResponseValidationError{
+				field:  "Tenant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalUpdateTenantStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalUpdateTenantStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalUpdateTenantStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalUpdateTenantStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalUpdateTenantStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalUpdateTenantStatusResponseMultiError) AllErrors() []error { return m }
+
+// InternalUpdateTenantStatusResponseValidationError is the validation error
+// returned by InternalUpdateTenantStatusResponse.Validate if the designated
+// constraints aren't met.
+type InternalUpdateTenantStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalUpdateTenantStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalUpdateTenantStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalUpdateTenantStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalUpdateTenantStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalUpdateTenantStatusResponseValidationError) ErrorName() string {
+	return "InternalUpdateTenantStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalUpdateTenantStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalUpdateTenantStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalUpdateTenantStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalUpdateTenantStatusResponseValidationError{}
+
+// Validate checks the field values on InternalAPIKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InternalAPIKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalAPIKey with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InternalAPIKeyMultiError,
+// or nil if none found.
+func (m *InternalAPIKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalAPIKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for KeyId
+
+	// no validation rules for TenantCode
+
+	// no validation rules for ProductCode
+
+	// no validation rules for Name
+
+	// no validation rules for AccessKey
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetExpireTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalAPIKeyValidationError{
+					field:  "ExpireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalAPIKeyValidationError{
+					field:  "ExpireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpireTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalAPIKeyValidationError{
+				field:  "ExpireTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastUsedTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalAPIKeyValidationError{
+					field:  "LastUsedTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalAPIKeyValidationError{
+					field:  "LastUsedTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastUsedTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalAPIKeyValidationError{
+				field:  "LastUsedTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalAPIKeyValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalAPIKeyValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalAPIKeyValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalAPIKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalAPIKeyMultiError is an error wrapping multiple validation errors
+// returned by InternalAPIKey.ValidateAll() if the designated constraints
+// aren't met.
+type InternalAPIKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalAPIKeyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalAPIKeyMultiError) AllErrors() []error { return m }
+
+// InternalAPIKeyValidationError is the validation error returned by
+// InternalAPIKey.Validate if the designated constraints aren't met.
+type InternalAPIKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalAPIKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalAPIKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalAPIKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalAPIKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalAPIKeyValidationError) ErrorName() string { return "InternalAPIKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InternalAPIKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalAPIKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalAPIKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalAPIKeyValidationError{}
+
+// Validate checks the field values on InternalCreateAPIKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalCreateAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateAPIKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalCreateAPIKeyRequestMultiError, or nil if none found.
+func (m *InternalCreateAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for ProductCode
+
+	// no validation rules for Name
+
+	if m.ExpireTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetExpireTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalCreateAPIKeyRequestValidationError{
+						field:  "ExpireTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalCreateAPIKeyRequestValidationError{
+						field:  "ExpireTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExpireTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalCreateAPIKeyRequestValidationError{
+					field:  "ExpireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalCreateAPIKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateAPIKeyRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateAPIKeyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalCreateAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateAPIKeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// InternalCreateAPIKeyRequestValidationError is the validation error returned
+// by InternalCreateAPIKeyRequest.Validate if the designated constraints
+// aren't met.
+type InternalCreateAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateAPIKeyRequestValidationError) ErrorName() string {
+	return "InternalCreateAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateAPIKeyRequestValidationError{}
+
+// Validate checks the field values on InternalCreateAPIKeyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalCreateAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateAPIKeyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalCreateAPIKeyResponseMultiError, or nil if none found.
+func (m *InternalCreateAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalCreateAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalCreateAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalCreateAPIKeyResponseValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return InternalCreateAPIKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateAPIKeyResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateAPIKeyResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalCreateAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateAPIKeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// InternalCreateAPIKeyResponseValidationError is the validation error returned
+// by InternalCreateAPIKeyResponse.Validate if the designated constraints
+// aren't met.
+type InternalCreateAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateAPIKeyResponseValidationError) ErrorName() string {
+	return "InternalCreateAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateAPIKeyResponseValidationError{}
+
+// Validate checks the field values on InternalListAPIKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListAPIKeysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListAPIKeysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListAPIKeysRequestMultiError, or nil if none found.
+func (m *InternalListAPIKeysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListAPIKeysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for Page
+
+	// no validation rules for Limit
+
+	if m.ProductCode != nil {
+		// no validation rules for ProductCode
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if len(errors) > 0 {
+		return InternalListAPIKeysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListAPIKeysRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalListAPIKeysRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListAPIKeysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListAPIKeysRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListAPIKeysRequestMultiError) AllErrors() []error { return m }
+
+// InternalListAPIKeysRequestValidationError is the validation error returned
+// by InternalListAPIKeysRequest.Validate if the designated constraints aren't met.
+type InternalListAPIKeysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListAPIKeysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListAPIKeysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListAPIKeysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListAPIKeysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListAPIKeysRequestValidationError) ErrorName() string {
+	return "InternalListAPIKeysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListAPIKeysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListAPIKeysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListAPIKeysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListAPIKeysRequestValidationError{}
+
+// Validate checks the field values on InternalListAPIKeysResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListAPIKeysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListAPIKeysResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListAPIKeysResponseMultiError, or nil if none found.
+func (m *InternalListAPIKeysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListAPIKeysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalListAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalListAPIKeysResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalListAPIKeysResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return InternalListAPIKeysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListAPIKeysResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalListAPIKeysResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListAPIKeysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListAPIKeysResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListAPIKeysResponseMultiError) AllErrors() []error { return m }
+
+// InternalListAPIKeysResponseValidationError is the validation error returned
+// by InternalListAPIKeysResponse.Validate if the designated constraints
+// aren't met.
+type InternalListAPIKeysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListAPIKeysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListAPIKeysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListAPIKeysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListAPIKeysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListAPIKeysResponseValidationError) ErrorName() string {
+	return "InternalListAPIKeysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListAPIKeysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListAPIKeysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListAPIKeysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListAPIKeysResponseValidationError{}
+
+// Validate checks the field values on InternalRevokeAPIKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalRevokeAPIKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalRevokeAPIKeyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalRevokeAPIKeyRequestMultiError, or nil if none found.
+func (m *InternalRevokeAPIKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalRevokeAPIKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for KeyId
+
+	if m.Reason != nil {
+		// no validation rules for Reason
+	}
+
+	if len(errors) > 0 {
+		return InternalRevokeAPIKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalRevokeAPIKeyRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalRevokeAPIKeyRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalRevokeAPIKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalRevokeAPIKeyRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalRevokeAPIKeyRequestMultiError) AllErrors() []error { return m }
+
+// InternalRevokeAPIKeyRequestValidationError is the validation error returned
+// by InternalRevokeAPIKeyRequest.Validate if the designated constraints
+// aren't met.
+type InternalRevokeAPIKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalRevokeAPIKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalRevokeAPIKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalRevokeAPIKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalRevokeAPIKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalRevokeAPIKeyRequestValidationError) ErrorName() string {
+	return "InternalRevokeAPIKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalRevokeAPIKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalRevokeAPIKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalRevokeAPIKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalRevokeAPIKeyRequestValidationError{}
+
+// Validate checks the field values on InternalRevokeAPIKeyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalRevokeAPIKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalRevokeAPIKeyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalRevokeAPIKeyResponseMultiError, or nil if none found.
+func (m *InternalRevokeAPIKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalRevokeAPIKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalRevokeAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalRevokeAPIKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalRevokeAPIKeyResponseValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalRevokeAPIKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalRevokeAPIKeyResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalRevokeAPIKeyResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalRevokeAPIKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalRevokeAPIKeyResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalRevokeAPIKeyResponseMultiError) AllErrors() []error { return m }
+
+// InternalRevokeAPIKeyResponseValidationError is the validation error returned
+// by InternalRevokeAPIKeyResponse.Validate if the designated constraints
+// aren't met.
+type InternalRevokeAPIKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalRevokeAPIKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalRevokeAPIKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalRevokeAPIKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalRevokeAPIKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalRevokeAPIKeyResponseValidationError) ErrorName() string {
+	return "InternalRevokeAPIKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalRevokeAPIKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalRevokeAPIKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalRevokeAPIKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalRevokeAPIKeyResponseValidationError{}
+
+// Validate checks the field values on InternalRotateAPIKeySecretRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalRotateAPIKeySecretRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalRotateAPIKeySecretRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalRotateAPIKeySecretRequestMultiError, or nil if none found.
+func (m *InternalRotateAPIKeySecretRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalRotateAPIKeySecretRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for KeyId
+
+	if len(errors) > 0 {
+		return InternalRotateAPIKeySecretRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalRotateAPIKeySecretRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalRotateAPIKeySecretRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalRotateAPIKeySecretRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalRotateAPIKeySecretRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalRotateAPIKeySecretRequestMultiError) AllErrors() []error { return m }
+
+// InternalRotateAPIKeySecretRequestValidationError is the validation error
+// returned by InternalRotateAPIKeySecretRequest.Validate if the designated
+// constraints aren't met.
+type InternalRotateAPIKeySecretRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalRotateAPIKeySecretRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalRotateAPIKeySecretRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalRotateAPIKeySecretRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalRotateAPIKeySecretRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalRotateAPIKeySecretRequestValidationError) ErrorName() string {
+	return "InternalRotateAPIKeySecretRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalRotateAPIKeySecretRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalRotateAPIKeySecretRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalRotateAPIKeySecretRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalRotateAPIKeySecretRequestValidationError{}
+
+// Validate checks the field values on InternalRotateAPIKeySecretResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalRotateAPIKeySecretResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalRotateAPIKeySecretResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalRotateAPIKeySecretResponseMultiError, or nil if none found.
+func (m *InternalRotateAPIKeySecretResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalRotateAPIKeySecretResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalRotateAPIKeySecretResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalRotateAPIKeySecretResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalRotateAPIKeySecretResponseValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Secret
+
+	if len(errors) > 0 {
+		return InternalRotateAPIKeySecretResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalRotateAPIKeySecretResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalRotateAPIKeySecretResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalRotateAPIKeySecretResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalRotateAPIKeySecretResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalRotateAPIKeySecretResponseMultiError) AllErrors() []error { return m }
+
+// InternalRotateAPIKeySecretResponseValidationError is the validation error
+// returned by InternalRotateAPIKeySecretResponse.Validate if the designated
+// constraints aren't met.
+type InternalRotateAPIKeySecretResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalRotateAPIKeySecretResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalRotateAPIKeySecretResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalRotateAPIKeySecretResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalRotateAPIKeySecretResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalRotateAPIKeySecretResponseValidationError) ErrorName() string {
+	return "InternalRotateAPIKeySecretResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalRotateAPIKeySecretResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalRotateAPIKeySecretResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalRotateAPIKeySecretResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalRotateAPIKeySecretResponseValidationError{}
+
+// Validate checks the field values on InternalTenantMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalTenantMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalTenantMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalTenantMemberMultiError, or nil if none found.
+func (m *InternalTenantMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalTenantMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for UserCode
+
+	// no validation rules for Nickname
+
+	// no validation rules for Email
+
+	// no validation rules for AvatarUrl
+
+	// no validation rules for RoleCode
+
+	// no validation rules for Status
+
+	// no validation rules for InvitationId
+
+	if all {
+		switch v := interface{}(m.GetInviteTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalTenantMemberValidationError{
+					field:  "InviteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalTenantMemberValidationError{
+					field:  "InviteTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInviteTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalTenantMemberValidationError{
+				field:  "InviteTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetJoinTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalTenantMemberValidationError{
+					field:  "JoinTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalTenantMemberValidationError{
+					field:  "JoinTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJoinTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalTenantMemberValidationError{
+				field:  "JoinTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalTenantMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalTenantMemberMultiError is an error wrapping multiple validation
+// errors returned by InternalTenantMember.ValidateAll() if the designated
+// constraints aren't met.
+type InternalTenantMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalTenantMemberMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalTenantMemberMultiError) AllErrors() []error { return m }
+
+// InternalTenantMemberValidationError is the validation error returned by
+// InternalTenantMember.Validate if the designated constraints aren't met.
+type InternalTenantMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalTenantMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalTenantMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalTenantMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalTenantMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalTenantMemberValidationError) ErrorName() string {
+	return "InternalTenantMemberValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalTenantMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalTenantMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalTenantMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalTenantMemberValidationError{}
+
+// Validate checks the field values on InternalInviteUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalInviteUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalInviteUserRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalInviteUserRequestMultiError, or nil if none found.
+func (m *InternalInviteUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalInviteUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for Email
+
+	// no validation rules for RoleCode
+
+	if m.InviterCode != nil {
+		// no validation rules for InviterCode
+	}
+
+	if m.Message != nil {
+		// no validation rules for Message
+	}
+
+	if len(errors) > 0 {
+		return InternalInviteUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalInviteUserRequestMultiError is an error wrapping multiple validation
+// errors returned by InternalInviteUserRequest.ValidateAll() if the
+// designated constraints aren't met.
+type InternalInviteUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalInviteUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalInviteUserRequestMultiError) AllErrors() []error { return m }
+
+// InternalInviteUserRequestValidationError is the validation error returned by
+// InternalInviteUserRequest.Validate if the designated constraints aren't met.
+type InternalInviteUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalInviteUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalInviteUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalInviteUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalInviteUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalInviteUserRequestValidationError) ErrorName() string {
+	return "InternalInviteUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalInviteUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalInviteUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalInviteUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalInviteUserRequestValidationError{}
+
+// Validate checks the field values on InternalInviteUserResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalInviteUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalInviteUserResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalInviteUserResponseMultiError, or nil if none found.
+func (m *InternalInviteUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalInviteUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalInviteUserResponseValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalInviteUserResponseValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalInviteUserResponseValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalInviteUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalInviteUserResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalInviteUserResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalInviteUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalInviteUserResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalInviteUserResponseMultiError) AllErrors() []error { return m }
+
+// InternalInviteUserResponseValidationError is the validation error returned
+// by InternalInviteUserResponse.Validate if the designated constraints aren't met.
+type InternalInviteUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalInviteUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalInviteUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalInviteUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalInviteUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalInviteUserResponseValidationError) ErrorName() string {
+	return "InternalInviteUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalInviteUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalInviteUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalInviteUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalInviteUserResponseValidationError{}
+
+// Validate checks the field values on InternalListMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListMembersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListMembersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListMembersRequestMultiError, or nil if none found.
+func (m *InternalListMembersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListMembersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for Page
+
+	// no validation rules for Limit
+
+	if m.Keyword != nil {
+		// no validation rules for Keyword
+	}
+
+	if m.RoleCode != nil {
+		// no validation rules for RoleCode
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if len(errors) > 0 {
+		return InternalListMembersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListMembersRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalListMembersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListMembersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListMembersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListMembersRequestMultiError) AllErrors() []error { return m }
+
+// InternalListMembersRequestValidationError is the validation error returned
+// by InternalListMembersRequest.Validate if the designated constraints aren't met.
+type InternalListMembersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListMembersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListMembersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListMembersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListMembersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListMembersRequestValidationError) ErrorName() string {
+	return "InternalListMembersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListMembersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListMembersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListMembersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListMembersRequestValidationError{}
+
+// Validate checks the field values on InternalListMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListMembersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListMembersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListMembersResponseMultiError, or nil if none found.
+func (m *InternalListMembersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListMembersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalListMembersResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalListMembersResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalListMembersResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return InternalListMembersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListMembersResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalListMembersResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListMembersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListMembersResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListMembersResponseMultiError) AllErrors() []error { return m }
+
+// InternalListMembersResponseValidationError is the validation error returned
+// by InternalListMembersResponse.Validate if the designated constraints
+// aren't met.
+type InternalListMembersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListMembersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListMembersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListMembersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListMembersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListMembersResponseValidationError) ErrorName() string {
+	return "InternalListMembersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListMembersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListMembersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListMembersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListMembersResponseValidationError{}
+
+// Validate checks the field values on InternalRemoveMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalRemoveMemberRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalRemoveMemberRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalRemoveMemberRequestMultiError, or nil if none found.
+func (m *InternalRemoveMemberRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalRemoveMemberRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for UserCode
+
+	if len(errors) > 0 {
+		return InternalRemoveMemberRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalRemoveMemberRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalRemoveMemberRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalRemoveMemberRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalRemoveMemberRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalRemoveMemberRequestMultiError) AllErrors() []error { return m }
+
+// InternalRemoveMemberRequestValidationError is the validation error returned
+// by InternalRemoveMemberRequest.Validate if the designated constraints
+// aren't met.
+type InternalRemoveMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalRemoveMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalRemoveMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalRemoveMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalRemoveMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalRemoveMemberRequestValidationError) ErrorName() string {
+	return "InternalRemoveMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalRemoveMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalRemoveMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalRemoveMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalRemoveMemberRequestValidationError{}
+
+// Validate checks the field values on InternalChangeMemberRoleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalChangeMemberRoleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalChangeMemberRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalChangeMemberRoleRequestMultiError, or nil if none found.
+func (m *InternalChangeMemberRoleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalChangeMemberRoleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for UserCode
+
+	// no validation rules for RoleCode
+
+	if len(errors) > 0 {
+		return InternalChangeMemberRoleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalChangeMemberRoleRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalChangeMemberRoleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalChangeMemberRoleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalChangeMemberRoleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalChangeMemberRoleRequestMultiError) AllErrors() []error { return m }
+
+// InternalChangeMemberRoleRequestValidationError is the validation error
+// returned by InternalChangeMemberRoleRequest.Validate if the designated
+// constraints aren't met.
+type InternalChangeMemberRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalChangeMemberRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalChangeMemberRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalChangeMemberRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalChangeMemberRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalChangeMemberRoleRequestValidationError) ErrorName() string {
+	return "InternalChangeMemberRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalChangeMemberRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalChangeMemberRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalChangeMemberRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalChangeMemberRoleRequestValidationError{}
+
+// Validate checks the field values on InternalChangeMemberRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalChangeMemberRoleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalChangeMemberRoleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalChangeMemberRoleResponseMultiError, or nil if none found.
+func (m *InternalChangeMemberRoleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalChangeMemberRoleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMember()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalChangeMemberRoleResponseValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalChangeMemberRoleResponseValidationError{
+					field:  "Member",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMember()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalChangeMemberRoleResponseValidationError{
+				field:  "Member",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalChangeMemberRoleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalChangeMemberRoleResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalChangeMemberRoleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalChangeMemberRoleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalChangeMemberRoleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalChangeMemberRoleResponseMultiError) AllErrors() []error { return m }
+
+// InternalChangeMemberRoleResponseValidationError is the validation error
+// returned by InternalChangeMemberRoleResponse.Validate if the designated
+// constraints aren't met.
+type InternalChangeMemberRoleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalChangeMemberRoleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalChangeMemberRoleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalChangeMemberRoleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalChangeMemberRoleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalChangeMemberRoleResponseValidationError) ErrorName() string {
+	return "InternalChangeMemberRoleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalChangeMemberRoleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalChangeMemberRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalChangeMemberRoleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalChangeMemberRoleResponseValidationError{}
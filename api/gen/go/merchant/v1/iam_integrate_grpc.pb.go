@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -19,12 +20,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MerchantIamService_SetTenantPermissions_FullMethodName     = "/common.merchant.v1.merchantIamService/SetTenantPermissions"
-	MerchantIamService_InternalListTenant_FullMethodName       = "/common.merchant.v1.merchantIamService/InternalListTenant"
-	MerchantIamService_InternalListPlatformUser_FullMethodName = "/common.merchant.v1.merchantIamService/InternalListPlatformUser"
-	MerchantIamService_InternalGetTenant_FullMethodName        = "/common.merchant.v1.merchantIamService/InternalGetTenant"
-	MerchantIamService_InternalGetTenantStats_FullMethodName   = "/common.merchant.v1.merchantIamService/InternalGetTenantStats"
-	MerchantIamService_InternalGetUserStats_FullMethodName     = "/common.merchant.v1.merchantIamService/InternalGetUserStats"
+	MerchantIamService_SetTenantPermissions_FullMethodName         = "/common.merchant.v1.merchantIamService/SetTenantPermissions"
+	MerchantIamService_InternalGetTenantPermissions_FullMethodName = "/common.merchant.v1.merchantIamService/InternalGetTenantPermissions"
+	MerchantIamService_InternalListTenant_FullMethodName           = "/common.merchant.v1.merchantIamService/InternalListTenant"
+	MerchantIamService_InternalListPlatformUser_FullMethodName     = "/common.merchant.v1.merchantIamService/InternalListPlatformUser"
+	MerchantIamService_InternalGetTenant_FullMethodName            = "/common.merchant.v1.merchantIamService/InternalGetTenant"
+	MerchantIamService_InternalGetTenantStats_FullMethodName       = "/common.merchant.v1.merchantIamService/InternalGetTenantStats"
+	MerchantIamService_InternalGetUserStats_FullMethodName         = "/common.merchant.v1.merchantIamService/InternalGetUserStats"
+	MerchantIamService_InternalGetTenantSettings_FullMethodName    = "/common.merchant.v1.merchantIamService/InternalGetTenantSettings"
+	MerchantIamService_InternalUpdateTenantStatus_FullMethodName   = "/common.merchant.v1.merchantIamService/InternalUpdateTenantStatus"
+	MerchantIamService_InternalCreateAPIKey_FullMethodName         = "/common.merchant.v1.merchantIamService/InternalCreateAPIKey"
+	MerchantIamService_InternalListAPIKeys_FullMethodName          = "/common.merchant.v1.merchantIamService/InternalListAPIKeys"
+	MerchantIamService_InternalRevokeAPIKey_FullMethodName         = "/common.merchant.v1.merchantIamService/InternalRevokeAPIKey"
+	MerchantIamService_InternalRotateAPIKeySecret_FullMethodName   = "/common.merchant.v1.merchantIamService/InternalRotateAPIKeySecret"
+	MerchantIamService_InternalInviteUser_FullMethodName           = "/common.merchant.v1.merchantIamService/InternalInviteUser"
+	MerchantIamService_InternalListMembers_FullMethodName          = "/common.merchant.v1.merchantIamService/InternalListMembers"
+	MerchantIamService_InternalRemoveMember_FullMethodName         = "/common.merchant.v1.merchantIamService/InternalRemoveMember"
+	MerchantIamService_InternalChangeMemberRole_FullMethodName     = "/common.merchant.v1.merchantIamService/InternalChangeMemberRole"
 )
 
 // MerchantIamServiceClient is the client API for MerchantIamService service.
@@ -35,6 +47,8 @@ const (
 type MerchantIamServiceClient interface {
 	// 将codes(string) set permission
 	SetTenantPermissions(ctx context.Context, in *SetTenantPermissionsRequest, opts ...grpc.CallOption) (*SetTenantPermissionsResponse, error)
+	// 获取租户当前权限codes
+	InternalGetTenantPermissions(ctx context.Context, in *InternalGetTenantPermissionsRequest, opts ...grpc.CallOption) (*InternalGetTenantPermissionsResponse, error)
 	// 获取商户列表
 	InternalListTenant(ctx context.Context, in *InternalListTenantRequest, opts ...grpc.CallOption) (*InternalListTenantResponse, error)
 	// 平台获取用户列表
@@ -45,6 +59,26 @@ type MerchantIamServiceClient interface {
 	InternalGetTenantStats(ctx context.Context, in *InternalGetTenantStatsRequest, opts ...grpc.CallOption) (*InternalGetTenantStatsResponse, error)
 	// 获取用户统计信息
 	InternalGetUserStats(ctx context.Context, in *InternalGetUserStatsRequest, opts ...grpc.CallOption) (*InternalGetUserStatsResponse, error)
+	// 获取商户设置
+	InternalGetTenantSettings(ctx context.Context, in *InternalGetTenantSettingsRequest, opts ...grpc.CallOption) (*InternalGetTenantSettingsResponse, error)
+	// 更新商户状态
+	InternalUpdateTenantStatus(ctx context.Context, in *InternalUpdateTenantStatusRequest, opts ...grpc.CallOption) (*InternalUpdateTenantStatusResponse, error)
+	// 创建 OpenAPI 凭证
+	InternalCreateAPIKey(ctx context.Context, in *InternalCreateAPIKeyRequest, opts ...grpc.CallOption) (*InternalCreateAPIKeyResponse, error)
+	// 获取 OpenAPI 凭证列表
+	InternalListAPIKeys(ctx context.Context, in *InternalListAPIKeysRequest, opts ...grpc.CallOption) (*InternalListAPIKeysResponse, error)
+	// 吊销 OpenAPI 凭证
+	InternalRevokeAPIKey(ctx context.Context, in *InternalRevokeAPIKeyRequest, opts ...grpc.CallOption) (*InternalRevokeAPIKeyResponse, error)
+	// 轮换 OpenAPI 凭证密钥
+	InternalRotateAPIKeySecret(ctx context.Context, in *InternalRotateAPIKeySecretRequest, opts ...grpc.CallOption) (*InternalRotateAPIKeySecretResponse, error)
+	// 邀请用户加入租户
+	InternalInviteUser(ctx context.Context, in *InternalInviteUserRequest, opts ...grpc.CallOption) (*InternalInviteUserResponse, error)
+	// 获取租户成员列表
+	InternalListMembers(ctx context.Context, in *InternalListMembersRequest, opts ...grpc.CallOption) (*InternalListMembersResponse, error)
+	// 移除租户成员
+	InternalRemoveMember(ctx context.Context, in *InternalRemoveMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// 变更租户成员角色
+	InternalChangeMemberRole(ctx context.Context, in *InternalChangeMemberRoleRequest, opts ...grpc.CallOption) (*InternalChangeMemberRoleResponse, error)
 }
 
 type merchantIamServiceClient struct {
@@ -65,6 +99,16 @@ func (c *merchantIamServiceClient) SetTenantPermissions(ctx context.Context, in
 	return out, nil
 }
 
+func (c *merchantIamServiceClient) InternalGetTenantPermissions(ctx context.Context, in *InternalGetTenantPermissionsRequest, opts ...grpc.CallOption) (*InternalGetTenantPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGetTenantPermissionsResponse)
+	err := c.cc.Invoke(ctx, MerchantIamService_InternalGetTenantPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *merchantIamServiceClient) InternalListTenant(ctx context.Context, in *InternalListTenantRequest, opts ...grpc.CallOption) (*InternalListTenantResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InternalListTenantResponse)
@@ -115,6 +159,106 @@ func (c *merchantIamServiceClient) InternalGetUserStats(ctx context.Context, in
 	return out, nil
 }
 
+func (c *merchantIamServiceClient) InternalGetTenantSettings(ctx context.Context, in *InternalGetTenantSettingsRequest, opts ...grpc.CallOption) (*InternalGetTenantSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGetTenantSettingsResponse)
+	err := c.cc.Invoke(ctx, MerchantIamService_InternalGetTenantSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantIamServiceClient) InternalUpdateTenantStatus(ctx context.Context, in *InternalUpdateTenantStatusRequest, opts ...grpc.CallOption) (*InternalUpdateTenantStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalUpdateTenantStatusResponse)
+	err := c.cc.Invoke(ctx, MerchantIamService_InternalUpdateTenantStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantIamServiceClient) InternalCreateAPIKey(ctx context.Context, in *InternalCreateAPIKeyRequest, opts ...grpc.CallOption) (*InternalCreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalCreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, MerchantIamService_InternalCreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantIamServiceClient) InternalListAPIKeys(ctx context.Context, in *InternalListAPIKeysRequest, opts ...grpc.CallOption) (*InternalListAPIKeysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalListAPIKeysResponse)
+	err := c.cc.Invoke(ctx, MerchantIamService_InternalListAPIKeys_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantIamServiceClient) InternalRevokeAPIKey(ctx context.Context, in *InternalRevokeAPIKeyRequest, opts ...grpc.CallOption) (*InternalRevokeAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalRevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, MerchantIamService_InternalRevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantIamServiceClient) InternalRotateAPIKeySecret(ctx context.Context, in *InternalRotateAPIKeySecretRequest, opts ...grpc.CallOption) (*InternalRotateAPIKeySecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalRotateAPIKeySecretResponse)
+	err := c.cc.Invoke(ctx, MerchantIamService_InternalRotateAPIKeySecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantIamServiceClient) InternalInviteUser(ctx context.Context, in *InternalInviteUserRequest, opts ...grpc.CallOption) (*InternalInviteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalInviteUserResponse)
+	err := c.cc.Invoke(ctx, MerchantIamService_InternalInviteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantIamServiceClient) InternalListMembers(ctx context.Context, in *InternalListMembersRequest, opts ...grpc.CallOption) (*InternalListMembersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalListMembersResponse)
+	err := c.cc.Invoke(ctx, MerchantIamService_InternalListMembers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantIamServiceClient) InternalRemoveMember(ctx context.Context, in *InternalRemoveMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, MerchantIamService_InternalRemoveMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *merchantIamServiceClient) InternalChangeMemberRole(ctx context.Context, in *InternalChangeMemberRoleRequest, opts ...grpc.CallOption) (*InternalChangeMemberRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalChangeMemberRoleResponse)
+	err := c.cc.Invoke(ctx, MerchantIamService_InternalChangeMemberRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MerchantIamServiceServer is the server API for MerchantIamService service.
 // All implementations must embed UnimplementedMerchantIamServiceServer
 // for forward compatibility.
@@ -123,6 +267,8 @@ func (c *merchantIamServiceClient) InternalGetUserStats(ctx context.Context, in
 type MerchantIamServiceServer interface {
 	// 将codes(string) set permission
 	SetTenantPermissions(context.Context, *SetTenantPermissionsRequest) (*SetTenantPermissionsResponse, error)
+	// 获取租户当前权限codes
+	InternalGetTenantPermissions(context.Context, *InternalGetTenantPermissionsRequest) (*InternalGetTenantPermissionsResponse, error)
 	// 获取商户列表
 	InternalListTenant(context.Context, *InternalListTenantRequest) (*InternalListTenantResponse, error)
 	// 平台获取用户列表
@@ -133,6 +279,26 @@ type MerchantIamServiceServer interface {
 	InternalGetTenantStats(context.Context, *InternalGetTenantStatsRequest) (*InternalGetTenantStatsResponse, error)
 	// 获取用户统计信息
 	InternalGetUserStats(context.Context, *InternalGetUserStatsRequest) (*InternalGetUserStatsResponse, error)
+	// 获取商户设置
+	InternalGetTenantSettings(context.Context, *InternalGetTenantSettingsRequest) (*InternalGetTenantSettingsResponse, error)
+	// 更新商户状态
+	InternalUpdateTenantStatus(context.Context, *InternalUpdateTenantStatusRequest) (*InternalUpdateTenantStatusResponse, error)
+	// 创建 OpenAPI 凭证
+	InternalCreateAPIKey(context.Context, *InternalCreateAPIKeyRequest) (*InternalCreateAPIKeyResponse, error)
+	// 获取 OpenAPI 凭证列表
+	InternalListAPIKeys(context.Context, *InternalListAPIKeysRequest) (*InternalListAPIKeysResponse, error)
+	// 吊销 OpenAPI 凭证
+	InternalRevokeAPIKey(context.Context, *InternalRevokeAPIKeyRequest) (*InternalRevokeAPIKeyResponse, error)
+	// 轮换 OpenAPI 凭证密钥
+	InternalRotateAPIKeySecret(context.Context, *InternalRotateAPIKeySecretRequest) (*InternalRotateAPIKeySecretResponse, error)
+	// 邀请用户加入租户
+	InternalInviteUser(context.Context, *InternalInviteUserRequest) (*InternalInviteUserResponse, error)
+	// 获取租户成员列表
+	InternalListMembers(context.Context, *InternalListMembersRequest) (*InternalListMembersResponse, error)
+	// 移除租户成员
+	InternalRemoveMember(context.Context, *InternalRemoveMemberRequest) (*emptypb.Empty, error)
+	// 变更租户成员角色
+	InternalChangeMemberRole(context.Context, *InternalChangeMemberRoleRequest) (*InternalChangeMemberRoleResponse, error)
 	mustEmbedUnimplementedMerchantIamServiceServer()
 }
 
@@ -146,6 +312,9 @@ type UnimplementedMerchantIamServiceServer struct{}
 func (UnimplementedMerchantIamServiceServer) SetTenantPermissions(context.Context, *SetTenantPermissionsRequest) (*SetTenantPermissionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetTenantPermissions not implemented")
 }
+func (UnimplementedMerchantIamServiceServer) InternalGetTenantPermissions(context.Context, *InternalGetTenantPermissionsRequest) (*InternalGetTenantPermissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalGetTenantPermissions not implemented")
+}
 func (UnimplementedMerchantIamServiceServer) InternalListTenant(context.Context, *InternalListTenantRequest) (*InternalListTenantResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalListTenant not implemented")
 }
@@ -161,6 +330,36 @@ func (UnimplementedMerchantIamServiceServer) InternalGetTenantStats(context.Cont
 func (UnimplementedMerchantIamServiceServer) InternalGetUserStats(context.Context, *InternalGetUserStatsRequest) (*InternalGetUserStatsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalGetUserStats not implemented")
 }
+func (UnimplementedMerchantIamServiceServer) InternalGetTenantSettings(context.Context, *InternalGetTenantSettingsRequest) (*InternalGetTenantSettingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalGetTenantSettings not implemented")
+}
+func (UnimplementedMerchantIamServiceServer) InternalUpdateTenantStatus(context.Context, *InternalUpdateTenantStatusRequest) (*InternalUpdateTenantStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalUpdateTenantStatus not implemented")
+}
+func (UnimplementedMerchantIamServiceServer) InternalCreateAPIKey(context.Context, *InternalCreateAPIKeyRequest) (*InternalCreateAPIKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalCreateAPIKey not implemented")
+}
+func (UnimplementedMerchantIamServiceServer) InternalListAPIKeys(context.Context, *InternalListAPIKeysRequest) (*InternalListAPIKeysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalListAPIKeys not implemented")
+}
+func (UnimplementedMerchantIamServiceServer) InternalRevokeAPIKey(context.Context, *InternalRevokeAPIKeyRequest) (*InternalRevokeAPIKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalRevokeAPIKey not implemented")
+}
+func (UnimplementedMerchantIamServiceServer) InternalRotateAPIKeySecret(context.Context, *InternalRotateAPIKeySecretRequest) (*InternalRotateAPIKeySecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalRotateAPIKeySecret not implemented")
+}
+func (UnimplementedMerchantIamServiceServer) InternalInviteUser(context.Context, *InternalInviteUserRequest) (*InternalInviteUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalInviteUser not implemented")
+}
+func (UnimplementedMerchantIamServiceServer) InternalListMembers(context.Context, *InternalListMembersRequest) (*InternalListMembersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalListMembers not implemented")
+}
+func (UnimplementedMerchantIamServiceServer) InternalRemoveMember(context.Context, *InternalRemoveMemberRequest) (*emptypb.Empty, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalRemoveMember not implemented")
+}
+func (UnimplementedMerchantIamServiceServer) InternalChangeMemberRole(context.Context, *InternalChangeMemberRoleRequest) (*InternalChangeMemberRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalChangeMemberRole not implemented")
+}
 func (UnimplementedMerchantIamServiceServer) mustEmbedUnimplementedMerchantIamServiceServer() {}
 func (UnimplementedMerchantIamServiceServer) testEmbeddedByValue()                            {}
 
@@ -200,6 +399,24 @@ func _MerchantIamService_SetTenantPermissions_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MerchantIamService_InternalGetTenantPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGetTenantPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantIamServiceServer).InternalGetTenantPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantIamService_InternalGetTenantPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantIamServiceServer).InternalGetTenantPermissions(ctx, req.(*InternalGetTenantPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MerchantIamService_InternalListTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InternalListTenantRequest)
 	if err := dec(in); err != nil {
@@ -290,6 +507,186 @@ func _MerchantIamService_InternalGetUserStats_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MerchantIamService_InternalGetTenantSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGetTenantSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantIamServiceServer).InternalGetTenantSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantIamService_InternalGetTenantSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantIamServiceServer).InternalGetTenantSettings(ctx, req.(*InternalGetTenantSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantIamService_InternalUpdateTenantStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalUpdateTenantStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantIamServiceServer).InternalUpdateTenantStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantIamService_InternalUpdateTenantStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantIamServiceServer).InternalUpdateTenantStatus(ctx, req.(*InternalUpdateTenantStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantIamService_InternalCreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalCreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantIamServiceServer).InternalCreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantIamService_InternalCreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantIamServiceServer).InternalCreateAPIKey(ctx, req.(*InternalCreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantIamService_InternalListAPIKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalListAPIKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantIamServiceServer).InternalListAPIKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantIamService_InternalListAPIKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantIamServiceServer).InternalListAPIKeys(ctx, req.(*InternalListAPIKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantIamService_InternalRevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalRevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantIamServiceServer).InternalRevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantIamService_InternalRevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantIamServiceServer).InternalRevokeAPIKey(ctx, req.(*InternalRevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantIamService_InternalRotateAPIKeySecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalRotateAPIKeySecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantIamServiceServer).InternalRotateAPIKeySecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantIamService_InternalRotateAPIKeySecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantIamServiceServer).InternalRotateAPIKeySecret(ctx, req.(*InternalRotateAPIKeySecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantIamService_InternalInviteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalInviteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantIamServiceServer).InternalInviteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantIamService_InternalInviteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantIamServiceServer).InternalInviteUser(ctx, req.(*InternalInviteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantIamService_InternalListMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalListMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantIamServiceServer).InternalListMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantIamService_InternalListMembers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantIamServiceServer).InternalListMembers(ctx, req.(*InternalListMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantIamService_InternalRemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalRemoveMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantIamServiceServer).InternalRemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantIamService_InternalRemoveMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantIamServiceServer).InternalRemoveMember(ctx, req.(*InternalRemoveMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MerchantIamService_InternalChangeMemberRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalChangeMemberRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MerchantIamServiceServer).InternalChangeMemberRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MerchantIamService_InternalChangeMemberRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MerchantIamServiceServer).InternalChangeMemberRole(ctx, req.(*InternalChangeMemberRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MerchantIamService_ServiceDesc is the grpc.ServiceDesc for MerchantIamService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -301,6 +698,10 @@ var MerchantIamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetTenantPermissions",
 			Handler:    _MerchantIamService_SetTenantPermissions_Handler,
 		},
+		{
+			MethodName: "InternalGetTenantPermissions",
+			Handler:    _MerchantIamService_InternalGetTenantPermissions_Handler,
+		},
 		{
 			MethodName: "InternalListTenant",
 			Handler:    _MerchantIamService_InternalListTenant_Handler,
@@ -321,6 +722,46 @@ var MerchantIamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InternalGetUserStats",
 			Handler:    _MerchantIamService_InternalGetUserStats_Handler,
 		},
+		{
+			MethodName: "InternalGetTenantSettings",
+			Handler:    _MerchantIamService_InternalGetTenantSettings_Handler,
+		},
+		{
+			MethodName: "InternalUpdateTenantStatus",
+			Handler:    _MerchantIamService_InternalUpdateTenantStatus_Handler,
+		},
+		{
+			MethodName: "InternalCreateAPIKey",
+			Handler:    _MerchantIamService_InternalCreateAPIKey_Handler,
+		},
+		{
+			MethodName: "InternalListAPIKeys",
+			Handler:    _MerchantIamService_InternalListAPIKeys_Handler,
+		},
+		{
+			MethodName: "InternalRevokeAPIKey",
+			Handler:    _MerchantIamService_InternalRevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "InternalRotateAPIKeySecret",
+			Handler:    _MerchantIamService_InternalRotateAPIKeySecret_Handler,
+		},
+		{
+			MethodName: "InternalInviteUser",
+			Handler:    _MerchantIamService_InternalInviteUser_Handler,
+		},
+		{
+			MethodName: "InternalListMembers",
+			Handler:    _MerchantIamService_InternalListMembers_Handler,
+		},
+		{
+			MethodName: "InternalRemoveMember",
+			Handler:    _MerchantIamService_InternalRemoveMember_Handler,
+		},
+		{
+			MethodName: "InternalChangeMemberRole",
+			Handler:    _MerchantIamService_InternalChangeMemberRole_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "merchant/v1/iam_integrate.proto",
@@ -1640,6 +1640,1536 @@ func (x *GetCodeComponentByProductResponse) GetCode() string {
 	return ""
 }
 
+// 检查用户权限请求
+type CheckPermissionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户编码
+	UserCode string `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	// 待检查的权限codes
+	PermissionCodes []string `protobuf:"bytes,2,rep,name=permission_codes,json=permissionCodes,proto3" json:"permission_codes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CheckPermissionsRequest) Reset() {
+	*x = CheckPermissionsRequest{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionsRequest) ProtoMessage() {}
+
+func (x *CheckPermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionsRequest.ProtoReflect.Descriptor instead.
+func (*CheckPermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CheckPermissionsRequest) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+func (x *CheckPermissionsRequest) GetPermissionCodes() []string {
+	if x != nil {
+		return x.PermissionCodes
+	}
+	return nil
+}
+
+// 检查用户权限响应
+type CheckPermissionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 权限code -> 是否拥有
+	Results       map[string]bool `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionsResponse) Reset() {
+	*x = CheckPermissionsResponse{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionsResponse) ProtoMessage() {}
+
+func (x *CheckPermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionsResponse.ProtoReflect.Descriptor instead.
+func (*CheckPermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CheckPermissionsResponse) GetResults() map[string]bool {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// 用户信息
+type UserInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 用户编码
+	UserCode string `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	// 用户名
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// 昵称
+	Nickname *string `protobuf:"bytes,3,opt,name=nickname,proto3,oneof" json:"nickname,omitempty"`
+	// 邮箱
+	Email *string `protobuf:"bytes,4,opt,name=email,proto3,oneof" json:"email,omitempty"`
+	// 手机号
+	Phone *string `protobuf:"bytes,5,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
+	// 所属租户编码
+	TenantCode *string `protobuf:"bytes,6,opt,name=tenant_code,json=tenantCode,proto3,oneof" json:"tenant_code,omitempty"`
+	// 状态
+	Status string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	// 角色编码列表
+	RoleCodes []string `protobuf:"bytes,8,rep,name=role_codes,json=roleCodes,proto3" json:"role_codes,omitempty"`
+	// 创建时间
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserInfo) Reset() {
+	*x = UserInfo{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserInfo) ProtoMessage() {}
+
+func (x *UserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserInfo.ProtoReflect.Descriptor instead.
+func (*UserInfo) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UserInfo) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+func (x *UserInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserInfo) GetNickname() string {
+	if x != nil && x.Nickname != nil {
+		return *x.Nickname
+	}
+	return ""
+}
+
+func (x *UserInfo) GetEmail() string {
+	if x != nil && x.Email != nil {
+		return *x.Email
+	}
+	return ""
+}
+
+func (x *UserInfo) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
+func (x *UserInfo) GetTenantCode() string {
+	if x != nil && x.TenantCode != nil {
+		return *x.TenantCode
+	}
+	return ""
+}
+
+func (x *UserInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserInfo) GetRoleCodes() []string {
+	if x != nil {
+		return x.RoleCodes
+	}
+	return nil
+}
+
+func (x *UserInfo) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *UserInfo) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// 角色信息
+type RoleInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 角色编码
+	RoleCode string `protobuf:"bytes,1,opt,name=role_code,json=roleCode,proto3" json:"role_code,omitempty"`
+	// 角色名称
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 描述
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// 所属租户编码（为空表示平台角色）
+	TenantCode *string `protobuf:"bytes,4,opt,name=tenant_code,json=tenantCode,proto3,oneof" json:"tenant_code,omitempty"`
+	// 权限codes
+	PermissionCodes []string `protobuf:"bytes,5,rep,name=permission_codes,json=permissionCodes,proto3" json:"permission_codes,omitempty"`
+	// 是否系统内置角色
+	IsSystem bool `protobuf:"varint,6,opt,name=is_system,json=isSystem,proto3" json:"is_system,omitempty"`
+	// 创建时间
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// 更新时间
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleInfo) Reset() {
+	*x = RoleInfo{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleInfo) ProtoMessage() {}
+
+func (x *RoleInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleInfo.ProtoReflect.Descriptor instead.
+func (*RoleInfo) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RoleInfo) GetRoleCode() string {
+	if x != nil {
+		return x.RoleCode
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetTenantCode() string {
+	if x != nil && x.TenantCode != nil {
+		return *x.TenantCode
+	}
+	return ""
+}
+
+func (x *RoleInfo) GetPermissionCodes() []string {
+	if x != nil {
+		return x.PermissionCodes
+	}
+	return nil
+}
+
+func (x *RoleInfo) GetIsSystem() bool {
+	if x != nil {
+		return x.IsSystem
+	}
+	return false
+}
+
+func (x *RoleInfo) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *RoleInfo) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+// 获取用户请求
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserCode      string                 `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetUserRequest) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+// 获取用户响应
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *UserInfo              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetUserResponse) GetUser() *UserInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+// 获取用户列表请求
+type ListUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 页码
+	Page *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	// 每页数量
+	PageSize *int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`
+	// 租户编码筛选
+	TenantCode *string `protobuf:"bytes,3,opt,name=tenant_code,json=tenantCode,proto3,oneof" json:"tenant_code,omitempty"`
+	// 状态筛选
+	Status *string `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	// 关键词（用户名/昵称/邮箱/手机号）
+	Keyword *string `protobuf:"bytes,5,opt,name=keyword,proto3,oneof" json:"keyword,omitempty"`
+	// 角色编码筛选
+	RoleCode      *string `protobuf:"bytes,6,opt,name=role_code,json=roleCode,proto3,oneof" json:"role_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListUsersRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetTenantCode() string {
+	if x != nil && x.TenantCode != nil {
+		return *x.TenantCode
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetKeyword() string {
+	if x != nil && x.Keyword != nil {
+		return *x.Keyword
+	}
+	return ""
+}
+
+func (x *ListUsersRequest) GetRoleCode() string {
+	if x != nil && x.RoleCode != nil {
+		return *x.RoleCode
+	}
+	return ""
+}
+
+// 获取用户列表响应
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserInfo            `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         uint32                 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListUsersResponse) GetUsers() []*UserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// 获取用户角色请求
+type GetUserRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserCode      string                 `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRolesRequest) Reset() {
+	*x = GetUserRolesRequest{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRolesRequest) ProtoMessage() {}
+
+func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetUserRolesRequest) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+// 获取用户角色响应
+type GetUserRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*RoleInfo            `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRolesResponse) Reset() {
+	*x = GetUserRolesResponse{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRolesResponse) ProtoMessage() {}
+
+func (x *GetUserRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRolesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserRolesResponse) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetUserRolesResponse) GetRoles() []*RoleInfo {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// 创建角色请求
+type CreateRoleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 角色编码（为空时由服务端生成）
+	RoleCode *string `protobuf:"bytes,1,opt,name=role_code,json=roleCode,proto3,oneof" json:"role_code,omitempty"`
+	// 角色名称
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 描述
+	Description *string `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// 所属租户编码
+	TenantCode *string `protobuf:"bytes,4,opt,name=tenant_code,json=tenantCode,proto3,oneof" json:"tenant_code,omitempty"`
+	// 初始权限codes
+	PermissionCodes []string `protobuf:"bytes,5,rep,name=permission_codes,json=permissionCodes,proto3" json:"permission_codes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateRoleRequest) Reset() {
+	*x = CreateRoleRequest{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleRequest) ProtoMessage() {}
+
+func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateRoleRequest) GetRoleCode() string {
+	if x != nil && x.RoleCode != nil {
+		return *x.RoleCode
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetTenantCode() string {
+	if x != nil && x.TenantCode != nil {
+		return *x.TenantCode
+	}
+	return ""
+}
+
+func (x *CreateRoleRequest) GetPermissionCodes() []string {
+	if x != nil {
+		return x.PermissionCodes
+	}
+	return nil
+}
+
+// 创建角色响应
+type CreateRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *RoleInfo              `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoleResponse) Reset() {
+	*x = CreateRoleResponse{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleResponse) ProtoMessage() {}
+
+func (x *CreateRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoleResponse) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateRoleResponse) GetRole() *RoleInfo {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+// 分配角色权限请求
+type AssignRolePermissionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RoleCode        string                 `protobuf:"bytes,1,opt,name=role_code,json=roleCode,proto3" json:"role_code,omitempty"`
+	PermissionCodes []string               `protobuf:"bytes,2,rep,name=permission_codes,json=permissionCodes,proto3" json:"permission_codes,omitempty"`
+	// 是否替换现有权限（false 为追加）
+	Replace       bool `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRolePermissionsRequest) Reset() {
+	*x = AssignRolePermissionsRequest{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRolePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRolePermissionsRequest) ProtoMessage() {}
+
+func (x *AssignRolePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRolePermissionsRequest.ProtoReflect.Descriptor instead.
+func (*AssignRolePermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AssignRolePermissionsRequest) GetRoleCode() string {
+	if x != nil {
+		return x.RoleCode
+	}
+	return ""
+}
+
+func (x *AssignRolePermissionsRequest) GetPermissionCodes() []string {
+	if x != nil {
+		return x.PermissionCodes
+	}
+	return nil
+}
+
+func (x *AssignRolePermissionsRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+// 分配角色权限响应
+type AssignRolePermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          *RoleInfo              `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignRolePermissionsResponse) Reset() {
+	*x = AssignRolePermissionsResponse{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignRolePermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignRolePermissionsResponse) ProtoMessage() {}
+
+func (x *AssignRolePermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignRolePermissionsResponse.ProtoReflect.Descriptor instead.
+func (*AssignRolePermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AssignRolePermissionsResponse) GetRole() *RoleInfo {
+	if x != nil {
+		return x.Role
+	}
+	return nil
+}
+
+// 分配用户角色请求
+type AssignUserRolesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserCode  string                 `protobuf:"bytes,1,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	RoleCodes []string               `protobuf:"bytes,2,rep,name=role_codes,json=roleCodes,proto3" json:"role_codes,omitempty"`
+	// 是否替换现有角色（false 为追加）
+	Replace       bool `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignUserRolesRequest) Reset() {
+	*x = AssignUserRolesRequest{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignUserRolesRequest) ProtoMessage() {}
+
+func (x *AssignUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*AssignUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AssignUserRolesRequest) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+func (x *AssignUserRolesRequest) GetRoleCodes() []string {
+	if x != nil {
+		return x.RoleCodes
+	}
+	return nil
+}
+
+func (x *AssignUserRolesRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+// 分配用户角色响应
+type AssignUserRolesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 分配后用户的全部角色
+	Roles         []*RoleInfo `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignUserRolesResponse) Reset() {
+	*x = AssignUserRolesResponse{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignUserRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignUserRolesResponse) ProtoMessage() {}
+
+func (x *AssignUserRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignUserRolesResponse.ProtoReflect.Descriptor instead.
+func (*AssignUserRolesResponse) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AssignUserRolesResponse) GetRoles() []*RoleInfo {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// 令牌校验请求
+type IntrospectTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectTokenRequest) Reset() {
+	*x = IntrospectTokenRequest{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectTokenRequest) ProtoMessage() {}
+
+func (x *IntrospectTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectTokenRequest.ProtoReflect.Descriptor instead.
+func (*IntrospectTokenRequest) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *IntrospectTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// 令牌校验响应
+type IntrospectTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 令牌是否有效（未过期、未吊销）
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// 令牌类型，如 access_token、api_key
+	TokenType string `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	// 用户编码
+	UserCode string `protobuf:"bytes,3,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	// 租户编码
+	TenantCode string `protobuf:"bytes,4,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 区域名称
+	RegionName string `protobuf:"bytes,5,opt,name=region_name,json=regionName,proto3" json:"region_name,omitempty"`
+	// 客户端ID（API Key 等机器令牌）
+	ClientId string `protobuf:"bytes,6,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// 授权范围
+	Scopes []string `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// 签发时间
+	IssuedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// 过期时间（为空表示永不过期）
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IntrospectTokenResponse) Reset() {
+	*x = IntrospectTokenResponse{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IntrospectTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IntrospectTokenResponse) ProtoMessage() {}
+
+func (x *IntrospectTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IntrospectTokenResponse.ProtoReflect.Descriptor instead.
+func (*IntrospectTokenResponse) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *IntrospectTokenResponse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *IntrospectTokenResponse) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+func (x *IntrospectTokenResponse) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+func (x *IntrospectTokenResponse) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *IntrospectTokenResponse) GetRegionName() string {
+	if x != nil {
+		return x.RegionName
+	}
+	return ""
+}
+
+func (x *IntrospectTokenResponse) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *IntrospectTokenResponse) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *IntrospectTokenResponse) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+func (x *IntrospectTokenResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+// 创建代操作授权请求（实际操作者取自调用方转发的用户身份）
+type CreateImpersonationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 目标租户编码
+	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 被代操作的用户编码
+	UserCode string `protobuf:"bytes,2,opt,name=user_code,json=userCode,proto3" json:"user_code,omitempty"`
+	// 代操作原因（写入审计）
+	Reason        *string `protobuf:"bytes,3,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateImpersonationRequest) Reset() {
+	*x = CreateImpersonationRequest{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateImpersonationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateImpersonationRequest) ProtoMessage() {}
+
+func (x *CreateImpersonationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateImpersonationRequest.ProtoReflect.Descriptor instead.
+func (*CreateImpersonationRequest) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CreateImpersonationRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *CreateImpersonationRequest) GetUserCode() string {
+	if x != nil {
+		return x.UserCode
+	}
+	return ""
+}
+
+func (x *CreateImpersonationRequest) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+// 创建代操作授权响应
+type CreateImpersonationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 代操作授权令牌，随请求转发给下游服务校验
+	DelegationToken string `protobuf:"bytes,1,opt,name=delegation_token,json=delegationToken,proto3" json:"delegation_token,omitempty"`
+	// 过期时间
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// 被代操作用户所在区域
+	RegionName    string `protobuf:"bytes,3,opt,name=region_name,json=regionName,proto3" json:"region_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateImpersonationResponse) Reset() {
+	*x = CreateImpersonationResponse{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateImpersonationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateImpersonationResponse) ProtoMessage() {}
+
+func (x *CreateImpersonationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateImpersonationResponse.ProtoReflect.Descriptor instead.
+func (*CreateImpersonationResponse) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateImpersonationResponse) GetDelegationToken() string {
+	if x != nil {
+		return x.DelegationToken
+	}
+	return ""
+}
+
+func (x *CreateImpersonationResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateImpersonationResponse) GetRegionName() string {
+	if x != nil {
+		return x.RegionName
+	}
+	return ""
+}
+
+// 审计日志
+type AuditLogEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 事件ID（客户端生成，用于服务端去重）
+	EventId string `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// 操作者类型：user、api_key、system
+	ActorType string `protobuf:"bytes,2,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	// 操作者ID（API Key ID）
+	ActorId uint64 `protobuf:"varint,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	// 操作者用户编码
+	ActorUserCode string `protobuf:"bytes,4,opt,name=actor_user_code,json=actorUserCode,proto3" json:"actor_user_code,omitempty"`
+	// 租户编码
+	TenantCode string `protobuf:"bytes,5,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 操作，如 tenant.suspend
+	Action string `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	// 资源，如 tenant/T001
+	Resource string `protobuf:"bytes,7,opt,name=resource,proto3" json:"resource,omitempty"`
+	// 变更前内容（JSON）
+	Before string `protobuf:"bytes,8,opt,name=before,proto3" json:"before,omitempty"`
+	// 变更后内容（JSON）
+	After string `protobuf:"bytes,9,opt,name=after,proto3" json:"after,omitempty"`
+	// 来源服务
+	Service string `protobuf:"bytes,10,opt,name=service,proto3" json:"service,omitempty"`
+	// 扩展信息
+	Metadata map[string]string `protobuf:"bytes,11,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 发生时间
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditLogEntry) Reset() {
+	*x = AuditLogEntry{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogEntry) ProtoMessage() {}
+
+func (x *AuditLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogEntry.ProtoReflect.Descriptor instead.
+func (*AuditLogEntry) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AuditLogEntry) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetActorId() uint64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *AuditLogEntry) GetActorUserCode() string {
+	if x != nil {
+		return x.ActorUserCode
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetResource() string {
+	if x != nil {
+		return x.Resource
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetBefore() string {
+	if x != nil {
+		return x.Before
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *AuditLogEntry) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AuditLogEntry) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// 批量写入审计日志请求
+type RecordAuditLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*AuditLogEntry       `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAuditLogsRequest) Reset() {
+	*x = RecordAuditLogsRequest{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAuditLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAuditLogsRequest) ProtoMessage() {}
+
+func (x *RecordAuditLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAuditLogsRequest.ProtoReflect.Descriptor instead.
+func (*RecordAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RecordAuditLogsRequest) GetEntries() []*AuditLogEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// 批量写入审计日志响应
+type RecordAuditLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成功写入的数量
+	Accepted      int32 `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordAuditLogsResponse) Reset() {
+	*x = RecordAuditLogsResponse{}
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordAuditLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordAuditLogsResponse) ProtoMessage() {}
+
+func (x *RecordAuditLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_platform_v1_iam_integrate_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordAuditLogsResponse.ProtoReflect.Descriptor instead.
+func (*RecordAuditLogsResponse) Descriptor() ([]byte, []int) {
+	return file_platform_v1_iam_integrate_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RecordAuditLogsResponse) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
 var File_platform_v1_iam_integrate_proto protoreflect.FileDescriptor
 
 const file_platform_v1_iam_integrate_proto_rawDesc = "" +
@@ -1847,7 +3377,155 @@ const file_platform_v1_iam_integrate_proto_rawDesc = "" +
 	" GetCodeComponentByProductRequest\x12!\n" +
 	"\fproduct_code\x18\x01 \x01(\tR\vproductCode\"7\n" +
 	"!GetCodeComponentByProductResponse\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code*\\\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"f\n" +
+	"\x17CheckPermissionsRequest\x12 \n" +
+	"\tuser_code\x18\x01 \x01(\tB\x03\xe0A\x02R\buserCode\x12)\n" +
+	"\x10permission_codes\x18\x02 \x03(\tR\x0fpermissionCodes\"\xab\x01\n" +
+	"\x18CheckPermissionsResponse\x12S\n" +
+	"\aresults\x18\x01 \x03(\v29.common.platform.v1.CheckPermissionsResponse.ResultsEntryR\aresults\x1a:\n" +
+	"\fResultsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\xa2\x03\n" +
+	"\bUserInfo\x12\x1b\n" +
+	"\tuser_code\x18\x01 \x01(\tR\buserCode\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1f\n" +
+	"\bnickname\x18\x03 \x01(\tH\x00R\bnickname\x88\x01\x01\x12\x19\n" +
+	"\x05email\x18\x04 \x01(\tH\x01R\x05email\x88\x01\x01\x12\x19\n" +
+	"\x05phone\x18\x05 \x01(\tH\x02R\x05phone\x88\x01\x01\x12$\n" +
+	"\vtenant_code\x18\x06 \x01(\tH\x03R\n" +
+	"tenantCode\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\a \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"role_codes\x18\b \x03(\tR\troleCodes\x12;\n" +
+	"\vcreate_time\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTimeB\v\n" +
+	"\t_nicknameB\b\n" +
+	"\x06_emailB\b\n" +
+	"\x06_phoneB\x0e\n" +
+	"\f_tenant_code\"\xea\x02\n" +
+	"\bRoleInfo\x12\x1b\n" +
+	"\trole_code\x18\x01 \x01(\tR\broleCode\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x00R\vdescription\x88\x01\x01\x12$\n" +
+	"\vtenant_code\x18\x04 \x01(\tH\x01R\n" +
+	"tenantCode\x88\x01\x01\x12)\n" +
+	"\x10permission_codes\x18\x05 \x03(\tR\x0fpermissionCodes\x12\x1b\n" +
+	"\tis_system\x18\x06 \x01(\bR\bisSystem\x12;\n" +
+	"\vcreate_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12;\n" +
+	"\vupdate_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTimeB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_tenant_code\"2\n" +
+	"\x0eGetUserRequest\x12 \n" +
+	"\tuser_code\x18\x01 \x01(\tB\x03\xe0A\x02R\buserCode\"C\n" +
+	"\x0fGetUserResponse\x120\n" +
+	"\x04user\x18\x01 \x01(\v2\x1c.common.platform.v1.UserInfoR\x04user\"\x9d\x02\n" +
+	"\x10ListUsersRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12$\n" +
+	"\vtenant_code\x18\x03 \x01(\tH\x02R\n" +
+	"tenantCode\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x03R\x06status\x88\x01\x01\x12\x1d\n" +
+	"\akeyword\x18\x05 \x01(\tH\x04R\akeyword\x88\x01\x01\x12 \n" +
+	"\trole_code\x18\x06 \x01(\tH\x05R\broleCode\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\x0e\n" +
+	"\f_tenant_codeB\t\n" +
+	"\a_statusB\n" +
+	"\n" +
+	"\b_keywordB\f\n" +
+	"\n" +
+	"_role_code\"]\n" +
+	"\x11ListUsersResponse\x122\n" +
+	"\x05users\x18\x01 \x03(\v2\x1c.common.platform.v1.UserInfoR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\rR\x05total\"7\n" +
+	"\x13GetUserRolesRequest\x12 \n" +
+	"\tuser_code\x18\x01 \x01(\tB\x03\xe0A\x02R\buserCode\"J\n" +
+	"\x14GetUserRolesResponse\x122\n" +
+	"\x05roles\x18\x01 \x03(\v2\x1c.common.platform.v1.RoleInfoR\x05roles\"\xf4\x01\n" +
+	"\x11CreateRoleRequest\x12 \n" +
+	"\trole_code\x18\x01 \x01(\tH\x00R\broleCode\x88\x01\x01\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tB\x03\xe0A\x02R\x04name\x12%\n" +
+	"\vdescription\x18\x03 \x01(\tH\x01R\vdescription\x88\x01\x01\x12$\n" +
+	"\vtenant_code\x18\x04 \x01(\tH\x02R\n" +
+	"tenantCode\x88\x01\x01\x12)\n" +
+	"\x10permission_codes\x18\x05 \x03(\tR\x0fpermissionCodesB\f\n" +
+	"\n" +
+	"_role_codeB\x0e\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_tenant_code\"F\n" +
+	"\x12CreateRoleResponse\x120\n" +
+	"\x04role\x18\x01 \x01(\v2\x1c.common.platform.v1.RoleInfoR\x04role\"\x85\x01\n" +
+	"\x1cAssignRolePermissionsRequest\x12 \n" +
+	"\trole_code\x18\x01 \x01(\tB\x03\xe0A\x02R\broleCode\x12)\n" +
+	"\x10permission_codes\x18\x02 \x03(\tR\x0fpermissionCodes\x12\x18\n" +
+	"\areplace\x18\x03 \x01(\bR\areplace\"Q\n" +
+	"\x1dAssignRolePermissionsResponse\x120\n" +
+	"\x04role\x18\x01 \x01(\v2\x1c.common.platform.v1.RoleInfoR\x04role\"s\n" +
+	"\x16AssignUserRolesRequest\x12 \n" +
+	"\tuser_code\x18\x01 \x01(\tB\x03\xe0A\x02R\buserCode\x12\x1d\n" +
+	"\n" +
+	"role_codes\x18\x02 \x03(\tR\troleCodes\x12\x18\n" +
+	"\areplace\x18\x03 \x01(\bR\areplace\"M\n" +
+	"\x17AssignUserRolesResponse\x122\n" +
+	"\x05roles\x18\x01 \x03(\v2\x1c.common.platform.v1.RoleInfoR\x05roles\"3\n" +
+	"\x16IntrospectTokenRequest\x12\x19\n" +
+	"\x05token\x18\x01 \x01(\tB\x03\xe0A\x02R\x05token\"\xd8\x02\n" +
+	"\x17IntrospectTokenResponse\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x02 \x01(\tR\ttokenType\x12\x1b\n" +
+	"\tuser_code\x18\x03 \x01(\tR\buserCode\x12\x1f\n" +
+	"\vtenant_code\x18\x04 \x01(\tR\n" +
+	"tenantCode\x12\x1f\n" +
+	"\vregion_name\x18\x05 \x01(\tR\n" +
+	"regionName\x12\x1b\n" +
+	"\tclient_id\x18\x06 \x01(\tR\bclientId\x12\x16\n" +
+	"\x06scopes\x18\a \x03(\tR\x06scopes\x127\n" +
+	"\tissued_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\x82\x01\n" +
+	"\x1aCreateImpersonationRequest\x12\x1f\n" +
+	"\vtenant_code\x18\x01 \x01(\tR\n" +
+	"tenantCode\x12\x1b\n" +
+	"\tuser_code\x18\x02 \x01(\tR\buserCode\x12\x1b\n" +
+	"\x06reason\x18\x03 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"\xa4\x01\n" +
+	"\x1bCreateImpersonationResponse\x12)\n" +
+	"\x10delegation_token\x18\x01 \x01(\tR\x0fdelegationToken\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1f\n" +
+	"\vregion_name\x18\x03 \x01(\tR\n" +
+	"regionName\"\xf0\x03\n" +
+	"\rAuditLogEntry\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\x02 \x01(\tR\tactorType\x12\x19\n" +
+	"\bactor_id\x18\x03 \x01(\x04R\aactorId\x12&\n" +
+	"\x0factor_user_code\x18\x04 \x01(\tR\ractorUserCode\x12\x1f\n" +
+	"\vtenant_code\x18\x05 \x01(\tR\n" +
+	"tenantCode\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12\x1a\n" +
+	"\bresource\x18\a \x01(\tR\bresource\x12\x16\n" +
+	"\x06before\x18\b \x01(\tR\x06before\x12\x14\n" +
+	"\x05after\x18\t \x01(\tR\x05after\x12\x18\n" +
+	"\aservice\x18\n" +
+	" \x01(\tR\aservice\x12K\n" +
+	"\bmetadata\x18\v \x03(\v2/.common.platform.v1.AuditLogEntry.MetadataEntryR\bmetadata\x12;\n" +
+	"\voccurred_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"U\n" +
+	"\x16RecordAuditLogsRequest\x12;\n" +
+	"\aentries\x18\x01 \x03(\v2!.common.platform.v1.AuditLogEntryR\aentries\"5\n" +
+	"\x17RecordAuditLogsResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x05R\baccepted*\\\n" +
 	"\tCPriority\x12\x10\n" +
 	"\fPRIORITY_LOW\x10\x00\x12\x15\n" +
 	"\x11PRIORITY_ORDINARY\x10\x01\x12\x11\n" +
@@ -1869,13 +3547,24 @@ const file_platform_v1_iam_integrate_proto_rawDesc = "" +
 	"\x1bANNOUNCEMENT_STATUS_PENDING\x10\x01\x12 \n" +
 	"\x1cANNOUNCEMENT_STATUS_RELEASED\x10\x02\x12\x1f\n" +
 	"\x1bANNOUNCEMENT_STATUS_EXPIRED\x10\x03\x12!\n" +
-	"\x1dANNOUNCEMENT_STATUS_WITHDRAWN\x10\x042\xaa\x05\n" +
+	"\x1dANNOUNCEMENT_STATUS_WITHDRAWN\x10\x042\xc1\r\n" +
 	"\x12PlatformIamService\x12\x85\x01\n" +
 	"\x18GetTenantPermissionsTree\x123.common.platform.v1.GetTenantPermissionsTreeRequest\x1a4.common.platform.v1.GetTenantPermissionsTreeResponse\x12\x8e\x01\n" +
 	"\x1bGetPermissionCodesByProduct\x126.common.platform.v1.GetPermissionCodesByProductRequest\x1a7.common.platform.v1.GetPermissionCodesByProductResponse\x12r\n" +
 	"\x11ListAnnouncements\x12-.common.platform.v1.CListAnnouncementsRequest\x1a..common.platform.v1.CListAnnouncementsResponse\x12|\n" +
 	"\x15PushAnnouncementsRead\x120.common.platform.v1.PushAnnouncementsReadRequest\x1a1.common.platform.v1.PushAnnouncementsReadResponse\x12\x88\x01\n" +
-	"\x19GetCodeComponentByProduct\x124.common.platform.v1.GetCodeComponentByProductRequest\x1a5.common.platform.v1.GetCodeComponentByProductResponseB\xd3\x01\n" +
+	"\x19GetCodeComponentByProduct\x124.common.platform.v1.GetCodeComponentByProductRequest\x1a5.common.platform.v1.GetCodeComponentByProductResponse\x12m\n" +
+	"\x10CheckPermissions\x12+.common.platform.v1.CheckPermissionsRequest\x1a,.common.platform.v1.CheckPermissionsResponse\x12R\n" +
+	"\aGetUser\x12\".common.platform.v1.GetUserRequest\x1a#.common.platform.v1.GetUserResponse\x12X\n" +
+	"\tListUsers\x12$.common.platform.v1.ListUsersRequest\x1a%.common.platform.v1.ListUsersResponse\x12a\n" +
+	"\fGetUserRoles\x12'.common.platform.v1.GetUserRolesRequest\x1a(.common.platform.v1.GetUserRolesResponse\x12[\n" +
+	"\n" +
+	"CreateRole\x12%.common.platform.v1.CreateRoleRequest\x1a&.common.platform.v1.CreateRoleResponse\x12|\n" +
+	"\x15AssignRolePermissions\x120.common.platform.v1.AssignRolePermissionsRequest\x1a1.common.platform.v1.AssignRolePermissionsResponse\x12j\n" +
+	"\x0fAssignUserRoles\x12*.common.platform.v1.AssignUserRolesRequest\x1a+.common.platform.v1.AssignUserRolesResponse\x12j\n" +
+	"\x0fIntrospectToken\x12*.common.platform.v1.IntrospectTokenRequest\x1a+.common.platform.v1.IntrospectTokenResponse\x12v\n" +
+	"\x13CreateImpersonation\x12..common.platform.v1.CreateImpersonationRequest\x1a/.common.platform.v1.CreateImpersonationResponse\x12j\n" +
+	"\x0fRecordAuditLogs\x12*.common.platform.v1.RecordAuditLogsRequest\x1a+.common.platform.v1.RecordAuditLogsResponseB\xd3\x01\n" +
 	"\x16com.common.platform.v1B\x11IamIntegrateProtoP\x01Z<github.com/heyinLab/common/api/gen/go/platform/v1;platformv1\xa2\x02\x03CPX\xaa\x02\x12Common.Platform.V1\xca\x02\x12Common\\Platform\\V1\xe2\x02\x1eCommon\\Platform\\V1\\GPBMetadata\xea\x02\x14Common::Platform::V1b\x06proto3"
 
 var (
@@ -1891,7 +3580,7 @@ func file_platform_v1_iam_integrate_proto_rawDescGZIP() []byte {
 }
 
 var file_platform_v1_iam_integrate_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_platform_v1_iam_integrate_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_platform_v1_iam_integrate_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_platform_v1_iam_integrate_proto_goTypes = []any{
 	(CPriority)(0),                              // 0: common.platform.v1.CPriority
 	(CAnnouncementType)(0),                      // 1: common.platform.v1.CAnnouncementType
@@ -1912,48 +3601,110 @@ var file_platform_v1_iam_integrate_proto_goTypes = []any{
 	(*PushAnnouncementsReadResponse)(nil),       // 16: common.platform.v1.PushAnnouncementsReadResponse
 	(*GetCodeComponentByProductRequest)(nil),    // 17: common.platform.v1.GetCodeComponentByProductRequest
 	(*GetCodeComponentByProductResponse)(nil),   // 18: common.platform.v1.GetCodeComponentByProductResponse
-	(*timestamppb.Timestamp)(nil),               // 19: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),                     // 20: google.protobuf.Struct
+	(*CheckPermissionsRequest)(nil),             // 19: common.platform.v1.CheckPermissionsRequest
+	(*CheckPermissionsResponse)(nil),            // 20: common.platform.v1.CheckPermissionsResponse
+	(*UserInfo)(nil),                            // 21: common.platform.v1.UserInfo
+	(*RoleInfo)(nil),                            // 22: common.platform.v1.RoleInfo
+	(*GetUserRequest)(nil),                      // 23: common.platform.v1.GetUserRequest
+	(*GetUserResponse)(nil),                     // 24: common.platform.v1.GetUserResponse
+	(*ListUsersRequest)(nil),                    // 25: common.platform.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),                   // 26: common.platform.v1.ListUsersResponse
+	(*GetUserRolesRequest)(nil),                 // 27: common.platform.v1.GetUserRolesRequest
+	(*GetUserRolesResponse)(nil),                // 28: common.platform.v1.GetUserRolesResponse
+	(*CreateRoleRequest)(nil),                   // 29: common.platform.v1.CreateRoleRequest
+	(*CreateRoleResponse)(nil),                  // 30: common.platform.v1.CreateRoleResponse
+	(*AssignRolePermissionsRequest)(nil),        // 31: common.platform.v1.AssignRolePermissionsRequest
+	(*AssignRolePermissionsResponse)(nil),       // 32: common.platform.v1.AssignRolePermissionsResponse
+	(*AssignUserRolesRequest)(nil),              // 33: common.platform.v1.AssignUserRolesRequest
+	(*AssignUserRolesResponse)(nil),             // 34: common.platform.v1.AssignUserRolesResponse
+	(*IntrospectTokenRequest)(nil),              // 35: common.platform.v1.IntrospectTokenRequest
+	(*IntrospectTokenResponse)(nil),             // 36: common.platform.v1.IntrospectTokenResponse
+	(*CreateImpersonationRequest)(nil),          // 37: common.platform.v1.CreateImpersonationRequest
+	(*CreateImpersonationResponse)(nil),         // 38: common.platform.v1.CreateImpersonationResponse
+	(*AuditLogEntry)(nil),                       // 39: common.platform.v1.AuditLogEntry
+	(*RecordAuditLogsRequest)(nil),              // 40: common.platform.v1.RecordAuditLogsRequest
+	(*RecordAuditLogsResponse)(nil),             // 41: common.platform.v1.RecordAuditLogsResponse
+	nil,                                         // 42: common.platform.v1.CheckPermissionsResponse.ResultsEntry
+	nil,                                         // 43: common.platform.v1.AuditLogEntry.MetadataEntry
+	(*timestamppb.Timestamp)(nil),               // 44: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                     // 45: google.protobuf.Struct
 }
 var file_platform_v1_iam_integrate_proto_depIdxs = []int32{
 	5,  // 0: common.platform.v1.Permission.children:type_name -> common.platform.v1.Permission
 	4,  // 1: common.platform.v1.Permission.meta:type_name -> common.platform.v1.RouteMeta
-	19, // 2: common.platform.v1.Permission.create_time:type_name -> google.protobuf.Timestamp
-	19, // 3: common.platform.v1.Permission.update_time:type_name -> google.protobuf.Timestamp
+	44, // 2: common.platform.v1.Permission.create_time:type_name -> google.protobuf.Timestamp
+	44, // 3: common.platform.v1.Permission.update_time:type_name -> google.protobuf.Timestamp
 	4,  // 4: common.platform.v1.TenantPermissionTreeNode.meta:type_name -> common.platform.v1.RouteMeta
 	6,  // 5: common.platform.v1.TenantPermissionTreeNode.children:type_name -> common.platform.v1.TenantPermissionTreeNode
 	6,  // 6: common.platform.v1.GetTenantPermissionsTreeResponse.tree:type_name -> common.platform.v1.TenantPermissionTreeNode
-	20, // 7: common.platform.v1.CAnnouncement.title:type_name -> google.protobuf.Struct
+	45, // 7: common.platform.v1.CAnnouncement.title:type_name -> google.protobuf.Struct
 	0,  // 8: common.platform.v1.CAnnouncement.priority:type_name -> common.platform.v1.CPriority
 	1,  // 9: common.platform.v1.CAnnouncement.type:type_name -> common.platform.v1.CAnnouncementType
-	20, // 10: common.platform.v1.CAnnouncement.summary:type_name -> google.protobuf.Struct
-	20, // 11: common.platform.v1.CAnnouncement.content:type_name -> google.protobuf.Struct
+	45, // 10: common.platform.v1.CAnnouncement.summary:type_name -> google.protobuf.Struct
+	45, // 11: common.platform.v1.CAnnouncement.content:type_name -> google.protobuf.Struct
 	2,  // 12: common.platform.v1.CAnnouncement.scope:type_name -> common.platform.v1.CAnnouncementScope
-	19, // 13: common.platform.v1.CAnnouncement.release_time:type_name -> google.protobuf.Timestamp
-	19, // 14: common.platform.v1.CAnnouncement.expire_time:type_name -> google.protobuf.Timestamp
-	19, // 15: common.platform.v1.CAnnouncement.create_time:type_name -> google.protobuf.Timestamp
-	19, // 16: common.platform.v1.CAnnouncement.update_time:type_name -> google.protobuf.Timestamp
+	44, // 13: common.platform.v1.CAnnouncement.release_time:type_name -> google.protobuf.Timestamp
+	44, // 14: common.platform.v1.CAnnouncement.expire_time:type_name -> google.protobuf.Timestamp
+	44, // 15: common.platform.v1.CAnnouncement.create_time:type_name -> google.protobuf.Timestamp
+	44, // 16: common.platform.v1.CAnnouncement.update_time:type_name -> google.protobuf.Timestamp
 	3,  // 17: common.platform.v1.CAnnouncement.status:type_name -> common.platform.v1.CAnnouncementStatus
 	0,  // 18: common.platform.v1.CListAnnouncementsRequest.priority:type_name -> common.platform.v1.CPriority
 	1,  // 19: common.platform.v1.CListAnnouncementsRequest.type:type_name -> common.platform.v1.CAnnouncementType
 	3,  // 20: common.platform.v1.CListAnnouncementsRequest.status:type_name -> common.platform.v1.CAnnouncementStatus
 	9,  // 21: common.platform.v1.CListAnnouncementsResponse.items:type_name -> common.platform.v1.CAnnouncement
 	15, // 22: common.platform.v1.PushAnnouncementsReadRequest.items:type_name -> common.platform.v1.PushAnnouncementsRead
-	7,  // 23: common.platform.v1.PlatformIamService.GetTenantPermissionsTree:input_type -> common.platform.v1.GetTenantPermissionsTreeRequest
-	10, // 24: common.platform.v1.PlatformIamService.GetPermissionCodesByProduct:input_type -> common.platform.v1.GetPermissionCodesByProductRequest
-	12, // 25: common.platform.v1.PlatformIamService.ListAnnouncements:input_type -> common.platform.v1.CListAnnouncementsRequest
-	14, // 26: common.platform.v1.PlatformIamService.PushAnnouncementsRead:input_type -> common.platform.v1.PushAnnouncementsReadRequest
-	17, // 27: common.platform.v1.PlatformIamService.GetCodeComponentByProduct:input_type -> common.platform.v1.GetCodeComponentByProductRequest
-	8,  // 28: common.platform.v1.PlatformIamService.GetTenantPermissionsTree:output_type -> common.platform.v1.GetTenantPermissionsTreeResponse
-	11, // 29: common.platform.v1.PlatformIamService.GetPermissionCodesByProduct:output_type -> common.platform.v1.GetPermissionCodesByProductResponse
-	13, // 30: common.platform.v1.PlatformIamService.ListAnnouncements:output_type -> common.platform.v1.CListAnnouncementsResponse
-	16, // 31: common.platform.v1.PlatformIamService.PushAnnouncementsRead:output_type -> common.platform.v1.PushAnnouncementsReadResponse
-	18, // 32: common.platform.v1.PlatformIamService.GetCodeComponentByProduct:output_type -> common.platform.v1.GetCodeComponentByProductResponse
-	28, // [28:33] is the sub-list for method output_type
-	23, // [23:28] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	42, // 23: common.platform.v1.CheckPermissionsResponse.results:type_name -> common.platform.v1.CheckPermissionsResponse.ResultsEntry
+	44, // 24: common.platform.v1.UserInfo.create_time:type_name -> google.protobuf.Timestamp
+	44, // 25: common.platform.v1.UserInfo.update_time:type_name -> google.protobuf.Timestamp
+	44, // 26: common.platform.v1.RoleInfo.create_time:type_name -> google.protobuf.Timestamp
+	44, // 27: common.platform.v1.RoleInfo.update_time:type_name -> google.protobuf.Timestamp
+	21, // 28: common.platform.v1.GetUserResponse.user:type_name -> common.platform.v1.UserInfo
+	21, // 29: common.platform.v1.ListUsersResponse.users:type_name -> common.platform.v1.UserInfo
+	22, // 30: common.platform.v1.GetUserRolesResponse.roles:type_name -> common.platform.v1.RoleInfo
+	22, // 31: common.platform.v1.CreateRoleResponse.role:type_name -> common.platform.v1.RoleInfo
+	22, // 32: common.platform.v1.AssignRolePermissionsResponse.role:type_name -> common.platform.v1.RoleInfo
+	22, // 33: common.platform.v1.AssignUserRolesResponse.roles:type_name -> common.platform.v1.RoleInfo
+	44, // 34: common.platform.v1.IntrospectTokenResponse.issued_at:type_name -> google.protobuf.Timestamp
+	44, // 35: common.platform.v1.IntrospectTokenResponse.expires_at:type_name -> google.protobuf.Timestamp
+	44, // 36: common.platform.v1.CreateImpersonationResponse.expires_at:type_name -> google.protobuf.Timestamp
+	43, // 37: common.platform.v1.AuditLogEntry.metadata:type_name -> common.platform.v1.AuditLogEntry.MetadataEntry
+	44, // 38: common.platform.v1.AuditLogEntry.occurred_at:type_name -> google.protobuf.Timestamp
+	39, // 39: common.platform.v1.RecordAuditLogsRequest.entries:type_name -> common.platform.v1.AuditLogEntry
+	7,  // 40: common.platform.v1.PlatformIamService.GetTenantPermissionsTree:input_type -> common.platform.v1.GetTenantPermissionsTreeRequest
+	10, // 41: common.platform.v1.PlatformIamService.GetPermissionCodesByProduct:input_type -> common.platform.v1.GetPermissionCodesByProductRequest
+	12, // 42: common.platform.v1.PlatformIamService.ListAnnouncements:input_type -> common.platform.v1.CListAnnouncementsRequest
+	14, // 43: common.platform.v1.PlatformIamService.PushAnnouncementsRead:input_type -> common.platform.v1.PushAnnouncementsReadRequest
+	17, // 44: common.platform.v1.PlatformIamService.GetCodeComponentByProduct:input_type -> common.platform.v1.GetCodeComponentByProductRequest
+	19, // 45: common.platform.v1.PlatformIamService.CheckPermissions:input_type -> common.platform.v1.CheckPermissionsRequest
+	23, // 46: common.platform.v1.PlatformIamService.GetUser:input_type -> common.platform.v1.GetUserRequest
+	25, // 47: common.platform.v1.PlatformIamService.ListUsers:input_type -> common.platform.v1.ListUsersRequest
+	27, // 48: common.platform.v1.PlatformIamService.GetUserRoles:input_type -> common.platform.v1.GetUserRolesRequest
+	29, // 49: common.platform.v1.PlatformIamService.CreateRole:input_type -> common.platform.v1.CreateRoleRequest
+	31, // 50: common.platform.v1.PlatformIamService.AssignRolePermissions:input_type -> common.platform.v1.AssignRolePermissionsRequest
+	33, // 51: common.platform.v1.PlatformIamService.AssignUserRoles:input_type -> common.platform.v1.AssignUserRolesRequest
+	35, // 52: common.platform.v1.PlatformIamService.IntrospectToken:input_type -> common.platform.v1.IntrospectTokenRequest
+	37, // 53: common.platform.v1.PlatformIamService.CreateImpersonation:input_type -> common.platform.v1.CreateImpersonationRequest
+	40, // 54: common.platform.v1.PlatformIamService.RecordAuditLogs:input_type -> common.platform.v1.RecordAuditLogsRequest
+	8,  // 55: common.platform.v1.PlatformIamService.GetTenantPermissionsTree:output_type -> common.platform.v1.GetTenantPermissionsTreeResponse
+	11, // 56: common.platform.v1.PlatformIamService.GetPermissionCodesByProduct:output_type -> common.platform.v1.GetPermissionCodesByProductResponse
+	13, // 57: common.platform.v1.PlatformIamService.ListAnnouncements:output_type -> common.platform.v1.CListAnnouncementsResponse
+	16, // 58: common.platform.v1.PlatformIamService.PushAnnouncementsRead:output_type -> common.platform.v1.PushAnnouncementsReadResponse
+	18, // 59: common.platform.v1.PlatformIamService.GetCodeComponentByProduct:output_type -> common.platform.v1.GetCodeComponentByProductResponse
+	20, // 60: common.platform.v1.PlatformIamService.CheckPermissions:output_type -> common.platform.v1.CheckPermissionsResponse
+	24, // 61: common.platform.v1.PlatformIamService.GetUser:output_type -> common.platform.v1.GetUserResponse
+	26, // 62: common.platform.v1.PlatformIamService.ListUsers:output_type -> common.platform.v1.ListUsersResponse
+	28, // 63: common.platform.v1.PlatformIamService.GetUserRoles:output_type -> common.platform.v1.GetUserRolesResponse
+	30, // 64: common.platform.v1.PlatformIamService.CreateRole:output_type -> common.platform.v1.CreateRoleResponse
+	32, // 65: common.platform.v1.PlatformIamService.AssignRolePermissions:output_type -> common.platform.v1.AssignRolePermissionsResponse
+	34, // 66: common.platform.v1.PlatformIamService.AssignUserRoles:output_type -> common.platform.v1.AssignUserRolesResponse
+	36, // 67: common.platform.v1.PlatformIamService.IntrospectToken:output_type -> common.platform.v1.IntrospectTokenResponse
+	38, // 68: common.platform.v1.PlatformIamService.CreateImpersonation:output_type -> common.platform.v1.CreateImpersonationResponse
+	41, // 69: common.platform.v1.PlatformIamService.RecordAuditLogs:output_type -> common.platform.v1.RecordAuditLogsResponse
+	55, // [55:70] is the sub-list for method output_type
+	40, // [40:55] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_platform_v1_iam_integrate_proto_init() }
@@ -1968,13 +3719,18 @@ func file_platform_v1_iam_integrate_proto_init() {
 	file_platform_v1_iam_integrate_proto_msgTypes[5].OneofWrappers = []any{}
 	file_platform_v1_iam_integrate_proto_msgTypes[6].OneofWrappers = []any{}
 	file_platform_v1_iam_integrate_proto_msgTypes[8].OneofWrappers = []any{}
+	file_platform_v1_iam_integrate_proto_msgTypes[17].OneofWrappers = []any{}
+	file_platform_v1_iam_integrate_proto_msgTypes[18].OneofWrappers = []any{}
+	file_platform_v1_iam_integrate_proto_msgTypes[21].OneofWrappers = []any{}
+	file_platform_v1_iam_integrate_proto_msgTypes[25].OneofWrappers = []any{}
+	file_platform_v1_iam_integrate_proto_msgTypes[33].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_platform_v1_iam_integrate_proto_rawDesc), len(file_platform_v1_iam_integrate_proto_rawDesc)),
 			NumEnums:      4,
-			NumMessages:   15,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,16 @@ const (
 	PlatformIamService_ListAnnouncements_FullMethodName           = "/common.platform.v1.PlatformIamService/ListAnnouncements"
 	PlatformIamService_PushAnnouncementsRead_FullMethodName       = "/common.platform.v1.PlatformIamService/PushAnnouncementsRead"
 	PlatformIamService_GetCodeComponentByProduct_FullMethodName   = "/common.platform.v1.PlatformIamService/GetCodeComponentByProduct"
+	PlatformIamService_CheckPermissions_FullMethodName            = "/common.platform.v1.PlatformIamService/CheckPermissions"
+	PlatformIamService_GetUser_FullMethodName                     = "/common.platform.v1.PlatformIamService/GetUser"
+	PlatformIamService_ListUsers_FullMethodName                   = "/common.platform.v1.PlatformIamService/ListUsers"
+	PlatformIamService_GetUserRoles_FullMethodName                = "/common.platform.v1.PlatformIamService/GetUserRoles"
+	PlatformIamService_CreateRole_FullMethodName                  = "/common.platform.v1.PlatformIamService/CreateRole"
+	PlatformIamService_AssignRolePermissions_FullMethodName       = "/common.platform.v1.PlatformIamService/AssignRolePermissions"
+	PlatformIamService_AssignUserRoles_FullMethodName             = "/common.platform.v1.PlatformIamService/AssignUserRoles"
+	PlatformIamService_IntrospectToken_FullMethodName             = "/common.platform.v1.PlatformIamService/IntrospectToken"
+	PlatformIamService_CreateImpersonation_FullMethodName         = "/common.platform.v1.PlatformIamService/CreateImpersonation"
+	PlatformIamService_RecordAuditLogs_FullMethodName             = "/common.platform.v1.PlatformIamService/RecordAuditLogs"
 )
 
 // PlatformIamServiceClient is the client API for PlatformIamService service.
@@ -42,6 +52,26 @@ type PlatformIamServiceClient interface {
 	PushAnnouncementsRead(ctx context.Context, in *PushAnnouncementsReadRequest, opts ...grpc.CallOption) (*PushAnnouncementsReadResponse, error)
 	// 产品code获取组件权限
 	GetCodeComponentByProduct(ctx context.Context, in *GetCodeComponentByProductRequest, opts ...grpc.CallOption) (*GetCodeComponentByProductResponse, error)
+	// 批量检查用户是否拥有权限（用于服务端细粒度鉴权）
+	CheckPermissions(ctx context.Context, in *CheckPermissionsRequest, opts ...grpc.CallOption) (*CheckPermissionsResponse, error)
+	// 获取用户
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
+	// 获取用户列表
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	// 获取用户角色
+	GetUserRoles(ctx context.Context, in *GetUserRolesRequest, opts ...grpc.CallOption) (*GetUserRolesResponse, error)
+	// 创建角色
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error)
+	// 分配角色权限
+	AssignRolePermissions(ctx context.Context, in *AssignRolePermissionsRequest, opts ...grpc.CallOption) (*AssignRolePermissionsResponse, error)
+	// 分配用户角色
+	AssignUserRoles(ctx context.Context, in *AssignUserRolesRequest, opts ...grpc.CallOption) (*AssignUserRolesResponse, error)
+	// 校验令牌并返回令牌信息
+	IntrospectToken(ctx context.Context, in *IntrospectTokenRequest, opts ...grpc.CallOption) (*IntrospectTokenResponse, error)
+	// 创建代操作授权（平台管理员以指定用户身份操作）
+	CreateImpersonation(ctx context.Context, in *CreateImpersonationRequest, opts ...grpc.CallOption) (*CreateImpersonationResponse, error)
+	// 批量写入审计日志
+	RecordAuditLogs(ctx context.Context, in *RecordAuditLogsRequest, opts ...grpc.CallOption) (*RecordAuditLogsResponse, error)
 }
 
 type platformIamServiceClient struct {
@@ -102,6 +132,106 @@ func (c *platformIamServiceClient) GetCodeComponentByProduct(ctx context.Context
 	return out, nil
 }
 
+func (c *platformIamServiceClient) CheckPermissions(ctx context.Context, in *CheckPermissionsRequest, opts ...grpc.CallOption) (*CheckPermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPermissionsResponse)
+	err := c.cc.Invoke(ctx, PlatformIamService_CheckPermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIamServiceClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserResponse)
+	err := c.cc.Invoke(ctx, PlatformIamService_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIamServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, PlatformIamService_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIamServiceClient) GetUserRoles(ctx context.Context, in *GetUserRolesRequest, opts ...grpc.CallOption) (*GetUserRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUserRolesResponse)
+	err := c.cc.Invoke(ctx, PlatformIamService_GetUserRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIamServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*CreateRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRoleResponse)
+	err := c.cc.Invoke(ctx, PlatformIamService_CreateRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIamServiceClient) AssignRolePermissions(ctx context.Context, in *AssignRolePermissionsRequest, opts ...grpc.CallOption) (*AssignRolePermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignRolePermissionsResponse)
+	err := c.cc.Invoke(ctx, PlatformIamService_AssignRolePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIamServiceClient) AssignUserRoles(ctx context.Context, in *AssignUserRolesRequest, opts ...grpc.CallOption) (*AssignUserRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AssignUserRolesResponse)
+	err := c.cc.Invoke(ctx, PlatformIamService_AssignUserRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIamServiceClient) IntrospectToken(ctx context.Context, in *IntrospectTokenRequest, opts ...grpc.CallOption) (*IntrospectTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IntrospectTokenResponse)
+	err := c.cc.Invoke(ctx, PlatformIamService_IntrospectToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIamServiceClient) CreateImpersonation(ctx context.Context, in *CreateImpersonationRequest, opts ...grpc.CallOption) (*CreateImpersonationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateImpersonationResponse)
+	err := c.cc.Invoke(ctx, PlatformIamService_CreateImpersonation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *platformIamServiceClient) RecordAuditLogs(ctx context.Context, in *RecordAuditLogsRequest, opts ...grpc.CallOption) (*RecordAuditLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordAuditLogsResponse)
+	err := c.cc.Invoke(ctx, PlatformIamService_RecordAuditLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PlatformIamServiceServer is the server API for PlatformIamService service.
 // All implementations must embed UnimplementedPlatformIamServiceServer
 // for forward compatibility.
@@ -118,6 +248,26 @@ type PlatformIamServiceServer interface {
 	PushAnnouncementsRead(context.Context, *PushAnnouncementsReadRequest) (*PushAnnouncementsReadResponse, error)
 	// 产品code获取组件权限
 	GetCodeComponentByProduct(context.Context, *GetCodeComponentByProductRequest) (*GetCodeComponentByProductResponse, error)
+	// 批量检查用户是否拥有权限（用于服务端细粒度鉴权）
+	CheckPermissions(context.Context, *CheckPermissionsRequest) (*CheckPermissionsResponse, error)
+	// 获取用户
+	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// 获取用户列表
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	// 获取用户角色
+	GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error)
+	// 创建角色
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
+	// 分配角色权限
+	AssignRolePermissions(context.Context, *AssignRolePermissionsRequest) (*AssignRolePermissionsResponse, error)
+	// 分配用户角色
+	AssignUserRoles(context.Context, *AssignUserRolesRequest) (*AssignUserRolesResponse, error)
+	// 校验令牌并返回令牌信息
+	IntrospectToken(context.Context, *IntrospectTokenRequest) (*IntrospectTokenResponse, error)
+	// 创建代操作授权（平台管理员以指定用户身份操作）
+	CreateImpersonation(context.Context, *CreateImpersonationRequest) (*CreateImpersonationResponse, error)
+	// 批量写入审计日志
+	RecordAuditLogs(context.Context, *RecordAuditLogsRequest) (*RecordAuditLogsResponse, error)
 	mustEmbedUnimplementedPlatformIamServiceServer()
 }
 
@@ -143,6 +293,36 @@ func (UnimplementedPlatformIamServiceServer) PushAnnouncementsRead(context.Conte
 func (UnimplementedPlatformIamServiceServer) GetCodeComponentByProduct(context.Context, *GetCodeComponentByProductRequest) (*GetCodeComponentByProductResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCodeComponentByProduct not implemented")
 }
+func (UnimplementedPlatformIamServiceServer) CheckPermissions(context.Context, *CheckPermissionsRequest) (*CheckPermissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckPermissions not implemented")
+}
+func (UnimplementedPlatformIamServiceServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedPlatformIamServiceServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedPlatformIamServiceServer) GetUserRoles(context.Context, *GetUserRolesRequest) (*GetUserRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetUserRoles not implemented")
+}
+func (UnimplementedPlatformIamServiceServer) CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (UnimplementedPlatformIamServiceServer) AssignRolePermissions(context.Context, *AssignRolePermissionsRequest) (*AssignRolePermissionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignRolePermissions not implemented")
+}
+func (UnimplementedPlatformIamServiceServer) AssignUserRoles(context.Context, *AssignUserRolesRequest) (*AssignUserRolesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AssignUserRoles not implemented")
+}
+func (UnimplementedPlatformIamServiceServer) IntrospectToken(context.Context, *IntrospectTokenRequest) (*IntrospectTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IntrospectToken not implemented")
+}
+func (UnimplementedPlatformIamServiceServer) CreateImpersonation(context.Context, *CreateImpersonationRequest) (*CreateImpersonationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateImpersonation not implemented")
+}
+func (UnimplementedPlatformIamServiceServer) RecordAuditLogs(context.Context, *RecordAuditLogsRequest) (*RecordAuditLogsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordAuditLogs not implemented")
+}
 func (UnimplementedPlatformIamServiceServer) mustEmbedUnimplementedPlatformIamServiceServer() {}
 func (UnimplementedPlatformIamServiceServer) testEmbeddedByValue()                            {}
 
@@ -254,6 +434,186 @@ func _PlatformIamService_GetCodeComponentByProduct_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlatformIamService_CheckPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIamServiceServer).CheckPermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIamService_CheckPermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIamServiceServer).CheckPermissions(ctx, req.(*CheckPermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIamService_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIamServiceServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIamService_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIamServiceServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIamService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIamServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIamService_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIamServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIamService_GetUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIamServiceServer).GetUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIamService_GetUserRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIamServiceServer).GetUserRoles(ctx, req.(*GetUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIamService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIamServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIamService_CreateRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIamServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIamService_AssignRolePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignRolePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIamServiceServer).AssignRolePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIamService_AssignRolePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIamServiceServer).AssignRolePermissions(ctx, req.(*AssignRolePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIamService_AssignUserRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignUserRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIamServiceServer).AssignUserRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIamService_AssignUserRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIamServiceServer).AssignUserRoles(ctx, req.(*AssignUserRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIamService_IntrospectToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IntrospectTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIamServiceServer).IntrospectToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIamService_IntrospectToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIamServiceServer).IntrospectToken(ctx, req.(*IntrospectTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIamService_CreateImpersonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateImpersonationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIamServiceServer).CreateImpersonation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIamService_CreateImpersonation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIamServiceServer).CreateImpersonation(ctx, req.(*CreateImpersonationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlatformIamService_RecordAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlatformIamServiceServer).RecordAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PlatformIamService_RecordAuditLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlatformIamServiceServer).RecordAuditLogs(ctx, req.(*RecordAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PlatformIamService_ServiceDesc is the grpc.ServiceDesc for PlatformIamService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -281,6 +641,46 @@ var PlatformIamService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCodeComponentByProduct",
 			Handler:    _PlatformIamService_GetCodeComponentByProduct_Handler,
 		},
+		{
+			MethodName: "CheckPermissions",
+			Handler:    _PlatformIamService_CheckPermissions_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _PlatformIamService_GetUser_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _PlatformIamService_ListUsers_Handler,
+		},
+		{
+			MethodName: "GetUserRoles",
+			Handler:    _PlatformIamService_GetUserRoles_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _PlatformIamService_CreateRole_Handler,
+		},
+		{
+			MethodName: "AssignRolePermissions",
+			Handler:    _PlatformIamService_AssignRolePermissions_Handler,
+		},
+		{
+			MethodName: "AssignUserRoles",
+			Handler:    _PlatformIamService_AssignUserRoles_Handler,
+		},
+		{
+			MethodName: "IntrospectToken",
+			Handler:    _PlatformIamService_IntrospectToken_Handler,
+		},
+		{
+			MethodName: "CreateImpersonation",
+			Handler:    _PlatformIamService_CreateImpersonation_Handler,
+		},
+		{
+			MethodName: "RecordAuditLogs",
+			Handler:    _PlatformIamService_RecordAuditLogs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "platform/v1/iam_integrate.proto",
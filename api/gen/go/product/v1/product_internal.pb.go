@@ -1153,6 +1153,96 @@ func (x *InternalListPricingRulesResponse) GetSuccess() bool {
 	return false
 }
 
+// 获取定价规则请求
+type InternalGetPricingRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleKey       string                 `protobuf:"bytes,1,opt,name=rule_key,json=ruleKey,proto3" json:"rule_key,omitempty"` // 规则键名
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetPricingRuleRequest) Reset() {
+	*x = InternalGetPricingRuleRequest{}
+	mi := &file_product_v1_product_internal_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetPricingRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetPricingRuleRequest) ProtoMessage() {}
+
+func (x *InternalGetPricingRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_internal_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetPricingRuleRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetPricingRuleRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InternalGetPricingRuleRequest) GetRuleKey() string {
+	if x != nil {
+		return x.RuleKey
+	}
+	return ""
+}
+
+// 获取定价规则响应
+type InternalGetPricingRuleResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Rule          *InternalPricingRuleInfo `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"` // 规则信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetPricingRuleResponse) Reset() {
+	*x = InternalGetPricingRuleResponse{}
+	mi := &file_product_v1_product_internal_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetPricingRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetPricingRuleResponse) ProtoMessage() {}
+
+func (x *InternalGetPricingRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_internal_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetPricingRuleResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetPricingRuleResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InternalGetPricingRuleResponse) GetRule() *InternalPricingRuleInfo {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
 // 产品信息
 type InternalProductInfo struct {
 	state             protoimpl.MessageState `protogen:"open.v1"`
@@ -1178,7 +1268,7 @@ type InternalProductInfo struct {
 
 func (x *InternalProductInfo) Reset() {
 	*x = InternalProductInfo{}
-	mi := &file_product_v1_product_internal_proto_msgTypes[9]
+	mi := &file_product_v1_product_internal_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1190,7 +1280,7 @@ func (x *InternalProductInfo) String() string {
 func (*InternalProductInfo) ProtoMessage() {}
 
 func (x *InternalProductInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_internal_proto_msgTypes[9]
+	mi := &file_product_v1_product_internal_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1203,7 +1293,7 @@ func (x *InternalProductInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalProductInfo.ProtoReflect.Descriptor instead.
 func (*InternalProductInfo) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{9}
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *InternalProductInfo) GetId() uint32 {
@@ -1322,7 +1412,7 @@ type InternalGetProductRequest struct {
 
 func (x *InternalGetProductRequest) Reset() {
 	*x = InternalGetProductRequest{}
-	mi := &file_product_v1_product_internal_proto_msgTypes[10]
+	mi := &file_product_v1_product_internal_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1334,7 +1424,7 @@ func (x *InternalGetProductRequest) String() string {
 func (*InternalGetProductRequest) ProtoMessage() {}
 
 func (x *InternalGetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_internal_proto_msgTypes[10]
+	mi := &file_product_v1_product_internal_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,7 +1437,7 @@ func (x *InternalGetProductRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalGetProductRequest.ProtoReflect.Descriptor instead.
 func (*InternalGetProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{10}
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *InternalGetProductRequest) GetProductCode() string {
@@ -1374,7 +1464,7 @@ type InternalGetProductResponse struct {
 
 func (x *InternalGetProductResponse) Reset() {
 	*x = InternalGetProductResponse{}
-	mi := &file_product_v1_product_internal_proto_msgTypes[11]
+	mi := &file_product_v1_product_internal_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1386,7 +1476,7 @@ func (x *InternalGetProductResponse) String() string {
 func (*InternalGetProductResponse) ProtoMessage() {}
 
 func (x *InternalGetProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_internal_proto_msgTypes[11]
+	mi := &file_product_v1_product_internal_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1399,7 +1489,7 @@ func (x *InternalGetProductResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalGetProductResponse.ProtoReflect.Descriptor instead.
 func (*InternalGetProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{11}
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *InternalGetProductResponse) GetProduct() *InternalProductInfo {
@@ -1420,7 +1510,7 @@ type InternalMerchantGetProductRequest struct {
 
 func (x *InternalMerchantGetProductRequest) Reset() {
 	*x = InternalMerchantGetProductRequest{}
-	mi := &file_product_v1_product_internal_proto_msgTypes[12]
+	mi := &file_product_v1_product_internal_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1432,7 +1522,7 @@ func (x *InternalMerchantGetProductRequest) String() string {
 func (*InternalMerchantGetProductRequest) ProtoMessage() {}
 
 func (x *InternalMerchantGetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_internal_proto_msgTypes[12]
+	mi := &file_product_v1_product_internal_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1445,7 +1535,7 @@ func (x *InternalMerchantGetProductRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InternalMerchantGetProductRequest.ProtoReflect.Descriptor instead.
 func (*InternalMerchantGetProductRequest) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{12}
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *InternalMerchantGetProductRequest) GetProductCode() string {
@@ -1472,7 +1562,7 @@ type InternalMerchantGetProductResponse struct {
 
 func (x *InternalMerchantGetProductResponse) Reset() {
 	*x = InternalMerchantGetProductResponse{}
-	mi := &file_product_v1_product_internal_proto_msgTypes[13]
+	mi := &file_product_v1_product_internal_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1484,7 +1574,7 @@ func (x *InternalMerchantGetProductResponse) String() string {
 func (*InternalMerchantGetProductResponse) ProtoMessage() {}
 
 func (x *InternalMerchantGetProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_product_v1_product_internal_proto_msgTypes[13]
+	mi := &file_product_v1_product_internal_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1497,7 +1587,7 @@ func (x *InternalMerchantGetProductResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InternalMerchantGetProductResponse.ProtoReflect.Descriptor instead.
 func (*InternalMerchantGetProductResponse) Descriptor() ([]byte, []int) {
-	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{13}
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *InternalMerchantGetProductResponse) GetProduct() *InternalProductInfo {
@@ -1507,6 +1597,529 @@ func (x *InternalMerchantGetProductResponse) GetProduct() *InternalProductInfo {
 	return nil
 }
 
+// 商户获取产品目录请求
+type InternalMerchantListProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locale        *string                `protobuf:"bytes,1,opt,name=locale,proto3,oneof" json:"locale,omitempty"`                                  // 语言，如 zh-CN、en-US
+	IncludePlans  *bool                  `protobuf:"varint,2,opt,name=include_plans,json=includePlans,proto3,oneof" json:"include_plans,omitempty"` // 是否包含套餐列表
+	CategoryId    *uint32                `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`       // 分类ID筛选
+	Page          *int32                 `protobuf:"varint,4,opt,name=page,proto3,oneof" json:"page,omitempty"`                                     // 页码
+	PageSize      *int32                 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`             // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalMerchantListProductsRequest) Reset() {
+	*x = InternalMerchantListProductsRequest{}
+	mi := &file_product_v1_product_internal_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalMerchantListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalMerchantListProductsRequest) ProtoMessage() {}
+
+func (x *InternalMerchantListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_internal_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalMerchantListProductsRequest.ProtoReflect.Descriptor instead.
+func (*InternalMerchantListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InternalMerchantListProductsRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+func (x *InternalMerchantListProductsRequest) GetIncludePlans() bool {
+	if x != nil && x.IncludePlans != nil {
+		return *x.IncludePlans
+	}
+	return false
+}
+
+func (x *InternalMerchantListProductsRequest) GetCategoryId() uint32 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *InternalMerchantListProductsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *InternalMerchantListProductsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+// 商户产品目录项
+type InternalMerchantCatalogProduct struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Product       *InternalProductInfo       `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`         // 产品信息（名称已按 locale 本地化）
+	Description   string                     `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // 本地化的产品描述
+	Plans         []*InternalProductPlanInfo `protobuf:"bytes,3,rep,name=plans,proto3" json:"plans,omitempty"`             // 上架套餐（仅当 include_plans=true 时返回）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalMerchantCatalogProduct) Reset() {
+	*x = InternalMerchantCatalogProduct{}
+	mi := &file_product_v1_product_internal_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalMerchantCatalogProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalMerchantCatalogProduct) ProtoMessage() {}
+
+func (x *InternalMerchantCatalogProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_internal_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalMerchantCatalogProduct.ProtoReflect.Descriptor instead.
+func (*InternalMerchantCatalogProduct) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InternalMerchantCatalogProduct) GetProduct() *InternalProductInfo {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *InternalMerchantCatalogProduct) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InternalMerchantCatalogProduct) GetPlans() []*InternalProductPlanInfo {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// 商户获取产品目录响应
+type InternalMerchantListProductsResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Products      []*InternalMerchantCatalogProduct `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`                  // 产品列表
+	Total         int32                             `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
+	Page          int32                             `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 当前页码
+	PageSize      int32                             `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalMerchantListProductsResponse) Reset() {
+	*x = InternalMerchantListProductsResponse{}
+	mi := &file_product_v1_product_internal_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalMerchantListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalMerchantListProductsResponse) ProtoMessage() {}
+
+func (x *InternalMerchantListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_internal_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalMerchantListProductsResponse.ProtoReflect.Descriptor instead.
+func (*InternalMerchantListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *InternalMerchantListProductsResponse) GetProducts() []*InternalMerchantCatalogProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *InternalMerchantListProductsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *InternalMerchantListProductsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *InternalMerchantListProductsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 获取产品列表请求
+type InternalListProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *int32                 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`                                               // 页码
+	PageSize      *int32                 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`                       // 每页数量
+	Status        *InternalProductStatus `protobuf:"varint,3,opt,name=status,proto3,enum=api.product.v1.InternalProductStatus,oneof" json:"status,omitempty"` // 状态筛选
+	CategoryId    *uint32                `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`                 // 分类ID筛选
+	Search        *string                `protobuf:"bytes,5,opt,name=search,proto3,oneof" json:"search,omitempty"`                                            // 关键词搜索
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListProductsRequest) Reset() {
+	*x = InternalListProductsRequest{}
+	mi := &file_product_v1_product_internal_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListProductsRequest) ProtoMessage() {}
+
+func (x *InternalListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_internal_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListProductsRequest.ProtoReflect.Descriptor instead.
+func (*InternalListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *InternalListProductsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *InternalListProductsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *InternalListProductsRequest) GetStatus() InternalProductStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return InternalProductStatus_INTERNAL_PRODUCT_STATUS_UNSPECIFIED
+}
+
+func (x *InternalListProductsRequest) GetCategoryId() uint32 {
+	if x != nil && x.CategoryId != nil {
+		return *x.CategoryId
+	}
+	return 0
+}
+
+func (x *InternalListProductsRequest) GetSearch() string {
+	if x != nil && x.Search != nil {
+		return *x.Search
+	}
+	return ""
+}
+
+// 获取产品列表响应
+type InternalListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*InternalProductInfo `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`                  // 产品列表
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 当前页码
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListProductsResponse) Reset() {
+	*x = InternalListProductsResponse{}
+	mi := &file_product_v1_product_internal_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListProductsResponse) ProtoMessage() {}
+
+func (x *InternalListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_internal_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListProductsResponse.ProtoReflect.Descriptor instead.
+func (*InternalListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *InternalListProductsResponse) GetProducts() []*InternalProductInfo {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *InternalListProductsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *InternalListProductsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *InternalListProductsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 获取套餐列表请求
+type InternalListPlansRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProductCode       string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                          // 产品编码
+	Page              *int32                 `protobuf:"varint,2,opt,name=page,proto3,oneof" json:"page,omitempty"`                                                    // 页码
+	PageSize          *int32                 `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`                            // 每页数量
+	Status            *InternalPlanStatus    `protobuf:"varint,4,opt,name=status,proto3,enum=api.product.v1.InternalPlanStatus,oneof" json:"status,omitempty"`         // 状态筛选
+	IncludeParameters *bool                  `protobuf:"varint,5,opt,name=include_parameters,json=includeParameters,proto3,oneof" json:"include_parameters,omitempty"` // 是否包含规则配置
+	IfNoneMatch       *string                `protobuf:"bytes,6,opt,name=if_none_match,json=ifNoneMatch,proto3,oneof" json:"if_none_match,omitempty"`                  // 客户端已知的套餐版本，未变化时不返回列表
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InternalListPlansRequest) Reset() {
+	*x = InternalListPlansRequest{}
+	mi := &file_product_v1_product_internal_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListPlansRequest) ProtoMessage() {}
+
+func (x *InternalListPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_internal_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListPlansRequest.ProtoReflect.Descriptor instead.
+func (*InternalListPlansRequest) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InternalListPlansRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InternalListPlansRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *InternalListPlansRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *InternalListPlansRequest) GetStatus() InternalPlanStatus {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return InternalPlanStatus_INTERNAL_PLAN_STATUS_UNSPECIFIED
+}
+
+func (x *InternalListPlansRequest) GetIncludeParameters() bool {
+	if x != nil && x.IncludeParameters != nil {
+		return *x.IncludeParameters
+	}
+	return false
+}
+
+func (x *InternalListPlansRequest) GetIfNoneMatch() string {
+	if x != nil && x.IfNoneMatch != nil {
+		return *x.IfNoneMatch
+	}
+	return ""
+}
+
+// 获取套餐列表响应
+type InternalListPlansResponse struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Plans         []*InternalProductPlanInfo `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`                                 // 套餐列表
+	Total         int32                      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                                // 总数
+	Page          int32                      `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                                  // 当前页码
+	PageSize      int32                      `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`          // 每页数量
+	Version       string                     `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`                             // 产品套餐版本（任一套餐变更时变化）
+	NotModified   bool                       `protobuf:"varint,6,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"` // 版本与 if_none_match 一致，未返回列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListPlansResponse) Reset() {
+	*x = InternalListPlansResponse{}
+	mi := &file_product_v1_product_internal_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListPlansResponse) ProtoMessage() {}
+
+func (x *InternalListPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_product_v1_product_internal_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListPlansResponse.ProtoReflect.Descriptor instead.
+func (*InternalListPlansResponse) Descriptor() ([]byte, []int) {
+	return file_product_v1_product_internal_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InternalListPlansResponse) GetPlans() []*InternalProductPlanInfo {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+func (x *InternalListPlansResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *InternalListPlansResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *InternalListPlansResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *InternalListPlansResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *InternalListPlansResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
 var File_product_v1_product_internal_proto protoreflect.FileDescriptor
 
 const file_product_v1_product_internal_proto_rawDesc = "" +
@@ -1609,7 +2222,11 @@ const file_product_v1_product_internal_proto_rawDesc = "" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x18\n" +
 	"\amessage\x18\x05 \x01(\tR\amessage\x12\x18\n" +
-	"\asuccess\x18\x06 \x01(\bR\asuccess\"\x97\x05\n" +
+	"\asuccess\x18\x06 \x01(\bR\asuccess\":\n" +
+	"\x1dInternalGetPricingRuleRequest\x12\x19\n" +
+	"\brule_key\x18\x01 \x01(\tR\aruleKey\"]\n" +
+	"\x1eInternalGetPricingRuleResponse\x12;\n" +
+	"\x04rule\x18\x01 \x01(\v2'.api.product.v1.InternalPricingRuleInfoR\x04rule\"\x97\x05\n" +
 	"\x13InternalProductInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12!\n" +
 	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12!\n" +
@@ -1647,7 +2264,67 @@ const file_product_v1_product_internal_proto_rawDesc = "" +
 	"\rinclude_plans\x18\x02 \x01(\bH\x00R\fincludePlans\x88\x01\x01B\x10\n" +
 	"\x0e_include_plans\"c\n" +
 	"\"InternalMerchantGetProductResponse\x12=\n" +
-	"\aproduct\x18\x01 \x01(\v2#.api.product.v1.InternalProductInfoR\aproduct*\xc5\x01\n" +
+	"\aproduct\x18\x01 \x01(\v2#.api.product.v1.InternalProductInfoR\aproduct\"\x91\x02\n" +
+	"#InternalMerchantListProductsRequest\x12\x1b\n" +
+	"\x06locale\x18\x01 \x01(\tH\x00R\x06locale\x88\x01\x01\x12(\n" +
+	"\rinclude_plans\x18\x02 \x01(\bH\x01R\fincludePlans\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x03 \x01(\rH\x02R\n" +
+	"categoryId\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x04 \x01(\x05H\x03R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x05 \x01(\x05H\x04R\bpageSize\x88\x01\x01B\t\n" +
+	"\a_localeB\x10\n" +
+	"\x0e_include_plansB\x0e\n" +
+	"\f_category_idB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"\xc0\x01\n" +
+	"\x1eInternalMerchantCatalogProduct\x12=\n" +
+	"\aproduct\x18\x01 \x01(\v2#.api.product.v1.InternalProductInfoR\aproduct\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12=\n" +
+	"\x05plans\x18\x03 \x03(\v2'.api.product.v1.InternalProductPlanInfoR\x05plans\"\xb9\x01\n" +
+	"$InternalMerchantListProductsResponse\x12J\n" +
+	"\bproducts\x18\x01 \x03(\v2..api.product.v1.InternalMerchantCatalogProductR\bproducts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x9c\x02\n" +
+	"\x1bInternalListProductsRequest\x12\x17\n" +
+	"\x04page\x18\x01 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x02 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12B\n" +
+	"\x06status\x18\x03 \x01(\x0e2%.api.product.v1.InternalProductStatusH\x02R\x06status\x88\x01\x01\x12$\n" +
+	"\vcategory_id\x18\x04 \x01(\rH\x03R\n" +
+	"categoryId\x88\x01\x01\x12\x1b\n" +
+	"\x06search\x18\x05 \x01(\tH\x04R\x06search\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\t\n" +
+	"\a_statusB\x0e\n" +
+	"\f_category_idB\t\n" +
+	"\a_search\"\xa6\x01\n" +
+	"\x1cInternalListProductsResponse\x12?\n" +
+	"\bproducts\x18\x01 \x03(\v2#.api.product.v1.InternalProductInfoR\bproducts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xe1\x02\n" +
+	"\x18InternalListPlansRequest\x12!\n" +
+	"\fproduct_code\x18\x01 \x01(\tR\vproductCode\x12\x17\n" +
+	"\x04page\x18\x02 \x01(\x05H\x00R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x03 \x01(\x05H\x01R\bpageSize\x88\x01\x01\x12?\n" +
+	"\x06status\x18\x04 \x01(\x0e2\".api.product.v1.InternalPlanStatusH\x02R\x06status\x88\x01\x01\x122\n" +
+	"\x12include_parameters\x18\x05 \x01(\bH\x03R\x11includeParameters\x88\x01\x01\x12'\n" +
+	"\rif_none_match\x18\x06 \x01(\tH\x04R\vifNoneMatch\x88\x01\x01B\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_sizeB\t\n" +
+	"\a_statusB\x15\n" +
+	"\x13_include_parametersB\x10\n" +
+	"\x0e_if_none_match\"\xde\x01\n" +
+	"\x19InternalListPlansResponse\x12=\n" +
+	"\x05plans\x18\x01 \x03(\v2'.api.product.v1.InternalProductPlanInfoR\x05plans\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x18\n" +
+	"\aversion\x18\x05 \x01(\tR\aversion\x12!\n" +
+	"\fnot_modified\x18\x06 \x01(\bR\vnotModified*\xc5\x01\n" +
 	"\x12InternalPlanStatus\x12$\n" +
 	" INTERNAL_PLAN_STATUS_UNSPECIFIED\x10\x00\x12\x1e\n" +
 	"\x1aINTERNAL_PLAN_STATUS_DRAFT\x10\x01\x12\x1f\n" +
@@ -1681,13 +2358,17 @@ const file_product_v1_product_internal_proto_rawDesc = "" +
 	"\x1dINTERNAL_PRODUCT_STATUS_DRAFT\x10\x01\x12\"\n" +
 	"\x1eINTERNAL_PRODUCT_STATUS_ACTIVE\x10\x02\x12$\n" +
 	" INTERNAL_PRODUCT_STATUS_INACTIVE\x10\x03\x12(\n" +
-	"$INTERNAL_PRODUCT_STATUS_DISCONTINUED\x10\x042\xea\x04\n" +
+	"$INTERNAL_PRODUCT_STATUS_DISCONTINUED\x10\x042\xcc\b\n" +
 	"\x16ProductInternalService\x12b\n" +
 	"\x0fInternalGetPlan\x12&.api.product.v1.InternalGetPlanRequest\x1a'.api.product.v1.InternalGetPlanResponse\x12z\n" +
 	"\x17InternalMerchantGetPlan\x12..api.product.v1.InternalMerchantGetPlanRequest\x1a/.api.product.v1.InternalMerchantGetPlanResponse\x12}\n" +
-	"\x18InternalListPricingRules\x12/.api.product.v1.InternalListPricingRulesRequest\x1a0.api.product.v1.InternalListPricingRulesResponse\x12k\n" +
+	"\x18InternalListPricingRules\x12/.api.product.v1.InternalListPricingRulesRequest\x1a0.api.product.v1.InternalListPricingRulesResponse\x12w\n" +
+	"\x16InternalGetPricingRule\x12-.api.product.v1.InternalGetPricingRuleRequest\x1a..api.product.v1.InternalGetPricingRuleResponse\x12k\n" +
 	"\x12InternalGetProduct\x12).api.product.v1.InternalGetProductRequest\x1a*.api.product.v1.InternalGetProductResponse\x12\x83\x01\n" +
-	"\x1aInternalMerchantGetProduct\x121.api.product.v1.InternalMerchantGetProductRequest\x1a2.api.product.v1.InternalMerchantGetProductResponseB\xc0\x01\n" +
+	"\x1aInternalMerchantGetProduct\x121.api.product.v1.InternalMerchantGetProductRequest\x1a2.api.product.v1.InternalMerchantGetProductResponse\x12\x89\x01\n" +
+	"\x1cInternalMerchantListProducts\x123.api.product.v1.InternalMerchantListProductsRequest\x1a4.api.product.v1.InternalMerchantListProductsResponse\x12q\n" +
+	"\x14InternalListProducts\x12+.api.product.v1.InternalListProductsRequest\x1a,.api.product.v1.InternalListProductsResponse\x12h\n" +
+	"\x11InternalListPlans\x12(.api.product.v1.InternalListPlansRequest\x1a).api.product.v1.InternalListPlansResponseB\xc0\x01\n" +
 	"\x12com.api.product.v1B\x14ProductInternalProtoP\x01Z:github.com/heyinLab/common/api/gen/go/product/v1;productv1\xa2\x02\x03APX\xaa\x02\x0eApi.Product.V1\xca\x02\x0eApi\\Product\\V1\xe2\x02\x1aApi\\Product\\V1\\GPBMetadata\xea\x02\x10Api::Product::V1b\x06proto3"
 
 var (
@@ -1703,71 +2384,96 @@ func file_product_v1_product_internal_proto_rawDescGZIP() []byte {
 }
 
 var file_product_v1_product_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_product_v1_product_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_product_v1_product_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_product_v1_product_internal_proto_goTypes = []any{
-	(InternalPlanStatus)(0),                    // 0: api.product.v1.InternalPlanStatus
-	(InternalValueType)(0),                     // 1: api.product.v1.InternalValueType
-	(InternalRuleType)(0),                      // 2: api.product.v1.InternalRuleType
-	(InternalRuleStatus)(0),                    // 3: api.product.v1.InternalRuleStatus
-	(InternalResetPeriod)(0),                   // 4: api.product.v1.InternalResetPeriod
-	(InternalProductStatus)(0),                 // 5: api.product.v1.InternalProductStatus
-	(*InternalPlanParameter)(nil),              // 6: api.product.v1.InternalPlanParameter
-	(*InternalProductPlanInfo)(nil),            // 7: api.product.v1.InternalProductPlanInfo
-	(*InternalGetPlanRequest)(nil),             // 8: api.product.v1.InternalGetPlanRequest
-	(*InternalGetPlanResponse)(nil),            // 9: api.product.v1.InternalGetPlanResponse
-	(*InternalMerchantGetPlanRequest)(nil),     // 10: api.product.v1.InternalMerchantGetPlanRequest
-	(*InternalMerchantGetPlanResponse)(nil),    // 11: api.product.v1.InternalMerchantGetPlanResponse
-	(*InternalPricingRuleInfo)(nil),            // 12: api.product.v1.InternalPricingRuleInfo
-	(*InternalListPricingRulesRequest)(nil),    // 13: api.product.v1.InternalListPricingRulesRequest
-	(*InternalListPricingRulesResponse)(nil),   // 14: api.product.v1.InternalListPricingRulesResponse
-	(*InternalProductInfo)(nil),                // 15: api.product.v1.InternalProductInfo
-	(*InternalGetProductRequest)(nil),          // 16: api.product.v1.InternalGetProductRequest
-	(*InternalGetProductResponse)(nil),         // 17: api.product.v1.InternalGetProductResponse
-	(*InternalMerchantGetProductRequest)(nil),  // 18: api.product.v1.InternalMerchantGetProductRequest
-	(*InternalMerchantGetProductResponse)(nil), // 19: api.product.v1.InternalMerchantGetProductResponse
-	(*structpb.Struct)(nil),                    // 20: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),              // 21: google.protobuf.Timestamp
+	(InternalPlanStatus)(0),                      // 0: api.product.v1.InternalPlanStatus
+	(InternalValueType)(0),                       // 1: api.product.v1.InternalValueType
+	(InternalRuleType)(0),                        // 2: api.product.v1.InternalRuleType
+	(InternalRuleStatus)(0),                      // 3: api.product.v1.InternalRuleStatus
+	(InternalResetPeriod)(0),                     // 4: api.product.v1.InternalResetPeriod
+	(InternalProductStatus)(0),                   // 5: api.product.v1.InternalProductStatus
+	(*InternalPlanParameter)(nil),                // 6: api.product.v1.InternalPlanParameter
+	(*InternalProductPlanInfo)(nil),              // 7: api.product.v1.InternalProductPlanInfo
+	(*InternalGetPlanRequest)(nil),               // 8: api.product.v1.InternalGetPlanRequest
+	(*InternalGetPlanResponse)(nil),              // 9: api.product.v1.InternalGetPlanResponse
+	(*InternalMerchantGetPlanRequest)(nil),       // 10: api.product.v1.InternalMerchantGetPlanRequest
+	(*InternalMerchantGetPlanResponse)(nil),      // 11: api.product.v1.InternalMerchantGetPlanResponse
+	(*InternalPricingRuleInfo)(nil),              // 12: api.product.v1.InternalPricingRuleInfo
+	(*InternalListPricingRulesRequest)(nil),      // 13: api.product.v1.InternalListPricingRulesRequest
+	(*InternalListPricingRulesResponse)(nil),     // 14: api.product.v1.InternalListPricingRulesResponse
+	(*InternalGetPricingRuleRequest)(nil),        // 15: api.product.v1.InternalGetPricingRuleRequest
+	(*InternalGetPricingRuleResponse)(nil),       // 16: api.product.v1.InternalGetPricingRuleResponse
+	(*InternalProductInfo)(nil),                  // 17: api.product.v1.InternalProductInfo
+	(*InternalGetProductRequest)(nil),            // 18: api.product.v1.InternalGetProductRequest
+	(*InternalGetProductResponse)(nil),           // 19: api.product.v1.InternalGetProductResponse
+	(*InternalMerchantGetProductRequest)(nil),    // 20: api.product.v1.InternalMerchantGetProductRequest
+	(*InternalMerchantGetProductResponse)(nil),   // 21: api.product.v1.InternalMerchantGetProductResponse
+	(*InternalMerchantListProductsRequest)(nil),  // 22: api.product.v1.InternalMerchantListProductsRequest
+	(*InternalMerchantCatalogProduct)(nil),       // 23: api.product.v1.InternalMerchantCatalogProduct
+	(*InternalMerchantListProductsResponse)(nil), // 24: api.product.v1.InternalMerchantListProductsResponse
+	(*InternalListProductsRequest)(nil),          // 25: api.product.v1.InternalListProductsRequest
+	(*InternalListProductsResponse)(nil),         // 26: api.product.v1.InternalListProductsResponse
+	(*InternalListPlansRequest)(nil),             // 27: api.product.v1.InternalListPlansRequest
+	(*InternalListPlansResponse)(nil),            // 28: api.product.v1.InternalListPlansResponse
+	(*structpb.Struct)(nil),                      // 29: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),                // 30: google.protobuf.Timestamp
 }
 var file_product_v1_product_internal_proto_depIdxs = []int32{
 	1,  // 0: api.product.v1.InternalPlanParameter.value_type:type_name -> api.product.v1.InternalValueType
-	20, // 1: api.product.v1.InternalPlanParameter.rule_i18n:type_name -> google.protobuf.Struct
-	20, // 2: api.product.v1.InternalProductPlanInfo.i18n:type_name -> google.protobuf.Struct
+	29, // 1: api.product.v1.InternalPlanParameter.rule_i18n:type_name -> google.protobuf.Struct
+	29, // 2: api.product.v1.InternalProductPlanInfo.i18n:type_name -> google.protobuf.Struct
 	0,  // 3: api.product.v1.InternalProductPlanInfo.status:type_name -> api.product.v1.InternalPlanStatus
-	21, // 4: api.product.v1.InternalProductPlanInfo.create_time:type_name -> google.protobuf.Timestamp
-	21, // 5: api.product.v1.InternalProductPlanInfo.update_time:type_name -> google.protobuf.Timestamp
+	30, // 4: api.product.v1.InternalProductPlanInfo.create_time:type_name -> google.protobuf.Timestamp
+	30, // 5: api.product.v1.InternalProductPlanInfo.update_time:type_name -> google.protobuf.Timestamp
 	6,  // 6: api.product.v1.InternalProductPlanInfo.parameters:type_name -> api.product.v1.InternalPlanParameter
 	7,  // 7: api.product.v1.InternalGetPlanResponse.plan:type_name -> api.product.v1.InternalProductPlanInfo
 	7,  // 8: api.product.v1.InternalMerchantGetPlanResponse.plan:type_name -> api.product.v1.InternalProductPlanInfo
-	20, // 9: api.product.v1.InternalPricingRuleInfo.i18n:type_name -> google.protobuf.Struct
+	29, // 9: api.product.v1.InternalPricingRuleInfo.i18n:type_name -> google.protobuf.Struct
 	2,  // 10: api.product.v1.InternalPricingRuleInfo.rule_type:type_name -> api.product.v1.InternalRuleType
 	4,  // 11: api.product.v1.InternalPricingRuleInfo.reset_period:type_name -> api.product.v1.InternalResetPeriod
 	3,  // 12: api.product.v1.InternalPricingRuleInfo.status:type_name -> api.product.v1.InternalRuleStatus
-	21, // 13: api.product.v1.InternalPricingRuleInfo.create_time:type_name -> google.protobuf.Timestamp
-	21, // 14: api.product.v1.InternalPricingRuleInfo.update_time:type_name -> google.protobuf.Timestamp
+	30, // 13: api.product.v1.InternalPricingRuleInfo.create_time:type_name -> google.protobuf.Timestamp
+	30, // 14: api.product.v1.InternalPricingRuleInfo.update_time:type_name -> google.protobuf.Timestamp
 	2,  // 15: api.product.v1.InternalListPricingRulesRequest.rule_type:type_name -> api.product.v1.InternalRuleType
 	3,  // 16: api.product.v1.InternalListPricingRulesRequest.status:type_name -> api.product.v1.InternalRuleStatus
 	12, // 17: api.product.v1.InternalListPricingRulesResponse.rules:type_name -> api.product.v1.InternalPricingRuleInfo
-	20, // 18: api.product.v1.InternalProductInfo.i18n:type_name -> google.protobuf.Struct
-	5,  // 19: api.product.v1.InternalProductInfo.status:type_name -> api.product.v1.InternalProductStatus
-	21, // 20: api.product.v1.InternalProductInfo.create_time:type_name -> google.protobuf.Timestamp
-	21, // 21: api.product.v1.InternalProductInfo.update_time:type_name -> google.protobuf.Timestamp
-	15, // 22: api.product.v1.InternalGetProductResponse.product:type_name -> api.product.v1.InternalProductInfo
-	15, // 23: api.product.v1.InternalMerchantGetProductResponse.product:type_name -> api.product.v1.InternalProductInfo
-	8,  // 24: api.product.v1.ProductInternalService.InternalGetPlan:input_type -> api.product.v1.InternalGetPlanRequest
-	10, // 25: api.product.v1.ProductInternalService.InternalMerchantGetPlan:input_type -> api.product.v1.InternalMerchantGetPlanRequest
-	13, // 26: api.product.v1.ProductInternalService.InternalListPricingRules:input_type -> api.product.v1.InternalListPricingRulesRequest
-	16, // 27: api.product.v1.ProductInternalService.InternalGetProduct:input_type -> api.product.v1.InternalGetProductRequest
-	18, // 28: api.product.v1.ProductInternalService.InternalMerchantGetProduct:input_type -> api.product.v1.InternalMerchantGetProductRequest
-	9,  // 29: api.product.v1.ProductInternalService.InternalGetPlan:output_type -> api.product.v1.InternalGetPlanResponse
-	11, // 30: api.product.v1.ProductInternalService.InternalMerchantGetPlan:output_type -> api.product.v1.InternalMerchantGetPlanResponse
-	14, // 31: api.product.v1.ProductInternalService.InternalListPricingRules:output_type -> api.product.v1.InternalListPricingRulesResponse
-	17, // 32: api.product.v1.ProductInternalService.InternalGetProduct:output_type -> api.product.v1.InternalGetProductResponse
-	19, // 33: api.product.v1.ProductInternalService.InternalMerchantGetProduct:output_type -> api.product.v1.InternalMerchantGetProductResponse
-	29, // [29:34] is the sub-list for method output_type
-	24, // [24:29] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	12, // 18: api.product.v1.InternalGetPricingRuleResponse.rule:type_name -> api.product.v1.InternalPricingRuleInfo
+	29, // 19: api.product.v1.InternalProductInfo.i18n:type_name -> google.protobuf.Struct
+	5,  // 20: api.product.v1.InternalProductInfo.status:type_name -> api.product.v1.InternalProductStatus
+	30, // 21: api.product.v1.InternalProductInfo.create_time:type_name -> google.protobuf.Timestamp
+	30, // 22: api.product.v1.InternalProductInfo.update_time:type_name -> google.protobuf.Timestamp
+	17, // 23: api.product.v1.InternalGetProductResponse.product:type_name -> api.product.v1.InternalProductInfo
+	17, // 24: api.product.v1.InternalMerchantGetProductResponse.product:type_name -> api.product.v1.InternalProductInfo
+	17, // 25: api.product.v1.InternalMerchantCatalogProduct.product:type_name -> api.product.v1.InternalProductInfo
+	7,  // 26: api.product.v1.InternalMerchantCatalogProduct.plans:type_name -> api.product.v1.InternalProductPlanInfo
+	23, // 27: api.product.v1.InternalMerchantListProductsResponse.products:type_name -> api.product.v1.InternalMerchantCatalogProduct
+	5,  // 28: api.product.v1.InternalListProductsRequest.status:type_name -> api.product.v1.InternalProductStatus
+	17, // 29: api.product.v1.InternalListProductsResponse.products:type_name -> api.product.v1.InternalProductInfo
+	0,  // 30: api.product.v1.InternalListPlansRequest.status:type_name -> api.product.v1.InternalPlanStatus
+	7,  // 31: api.product.v1.InternalListPlansResponse.plans:type_name -> api.product.v1.InternalProductPlanInfo
+	8,  // 32: api.product.v1.ProductInternalService.InternalGetPlan:input_type -> api.product.v1.InternalGetPlanRequest
+	10, // 33: api.product.v1.ProductInternalService.InternalMerchantGetPlan:input_type -> api.product.v1.InternalMerchantGetPlanRequest
+	13, // 34: api.product.v1.ProductInternalService.InternalListPricingRules:input_type -> api.product.v1.InternalListPricingRulesRequest
+	15, // 35: api.product.v1.ProductInternalService.InternalGetPricingRule:input_type -> api.product.v1.InternalGetPricingRuleRequest
+	18, // 36: api.product.v1.ProductInternalService.InternalGetProduct:input_type -> api.product.v1.InternalGetProductRequest
+	20, // 37: api.product.v1.ProductInternalService.InternalMerchantGetProduct:input_type -> api.product.v1.InternalMerchantGetProductRequest
+	22, // 38: api.product.v1.ProductInternalService.InternalMerchantListProducts:input_type -> api.product.v1.InternalMerchantListProductsRequest
+	25, // 39: api.product.v1.ProductInternalService.InternalListProducts:input_type -> api.product.v1.InternalListProductsRequest
+	27, // 40: api.product.v1.ProductInternalService.InternalListPlans:input_type -> api.product.v1.InternalListPlansRequest
+	9,  // 41: api.product.v1.ProductInternalService.InternalGetPlan:output_type -> api.product.v1.InternalGetPlanResponse
+	11, // 42: api.product.v1.ProductInternalService.InternalMerchantGetPlan:output_type -> api.product.v1.InternalMerchantGetPlanResponse
+	14, // 43: api.product.v1.ProductInternalService.InternalListPricingRules:output_type -> api.product.v1.InternalListPricingRulesResponse
+	16, // 44: api.product.v1.ProductInternalService.InternalGetPricingRule:output_type -> api.product.v1.InternalGetPricingRuleResponse
+	19, // 45: api.product.v1.ProductInternalService.InternalGetProduct:output_type -> api.product.v1.InternalGetProductResponse
+	21, // 46: api.product.v1.ProductInternalService.InternalMerchantGetProduct:output_type -> api.product.v1.InternalMerchantGetProductResponse
+	24, // 47: api.product.v1.ProductInternalService.InternalMerchantListProducts:output_type -> api.product.v1.InternalMerchantListProductsResponse
+	26, // 48: api.product.v1.ProductInternalService.InternalListProducts:output_type -> api.product.v1.InternalListProductsResponse
+	28, // 49: api.product.v1.ProductInternalService.InternalListPlans:output_type -> api.product.v1.InternalListPlansResponse
+	41, // [41:50] is the sub-list for method output_type
+	32, // [32:41] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
 }
 
 func init() { file_product_v1_product_internal_proto_init() }
@@ -1781,16 +2487,19 @@ func file_product_v1_product_internal_proto_init() {
 	file_product_v1_product_internal_proto_msgTypes[4].OneofWrappers = []any{}
 	file_product_v1_product_internal_proto_msgTypes[6].OneofWrappers = []any{}
 	file_product_v1_product_internal_proto_msgTypes[7].OneofWrappers = []any{}
-	file_product_v1_product_internal_proto_msgTypes[9].OneofWrappers = []any{}
-	file_product_v1_product_internal_proto_msgTypes[10].OneofWrappers = []any{}
+	file_product_v1_product_internal_proto_msgTypes[11].OneofWrappers = []any{}
 	file_product_v1_product_internal_proto_msgTypes[12].OneofWrappers = []any{}
+	file_product_v1_product_internal_proto_msgTypes[14].OneofWrappers = []any{}
+	file_product_v1_product_internal_proto_msgTypes[16].OneofWrappers = []any{}
+	file_product_v1_product_internal_proto_msgTypes[19].OneofWrappers = []any{}
+	file_product_v1_product_internal_proto_msgTypes[21].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_product_v1_product_internal_proto_rawDesc), len(file_product_v1_product_internal_proto_rawDesc)),
 			NumEnums:      6,
-			NumMessages:   14,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1404,6 +1404,243 @@ var _ interface {
 	ErrorName() string
 } = InternalListPricingRulesResponseValidationError{}
 
+// Validate checks the field values on InternalGetPricingRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetPricingRuleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetPricingRuleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetPricingRuleRequestMultiError, or nil if none found.
+func (m *InternalGetPricingRuleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetPricingRuleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RuleKey
+
+	if len(errors) > 0 {
+		return InternalGetPricingRuleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetPricingRuleRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalGetPricingRuleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalGetPricingRuleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetPricingRuleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetPricingRuleRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetPricingRuleRequestValidationError is the validation error
+// returned by InternalGetPricingRuleRequest.Validate if the designated
+// constraints aren't met.
+type InternalGetPricingRuleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetPricingRuleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetPricingRuleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetPricingRuleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetPricingRuleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetPricingRuleRequestValidationError) ErrorName() string {
+	return "InternalGetPricingRuleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetPricingRuleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetPricingRuleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetPricingRuleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetPricingRuleRequestValidationError{}
+
+// Validate checks the field values on InternalGetPricingRuleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetPricingRuleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetPricingRuleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetPricingRuleResponseMultiError, or nil if none found.
+func (m *InternalGetPricingRuleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetPricingRuleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalGetPricingRuleResponseValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalGetPricingRuleResponseValidationError{
+					field:  "Rule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalGetPricingRuleResponseValidationError{
+				field:  "Rule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalGetPricingRuleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetPricingRuleResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalGetPricingRuleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalGetPricingRuleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetPricingRuleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetPricingRuleResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetPricingRuleResponseValidationError is the validation error
+// returned by InternalGetPricingRuleResponse.Validate if the designated
+// constraints aren't met.
+type InternalGetPricingRuleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetPricingRuleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetPricingRuleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetPricingRuleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetPricingRuleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetPricingRuleResponseValidationError) ErrorName() string {
+	return "InternalGetPricingRuleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetPricingRuleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetPricingRuleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetPricingRuleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetPricingRuleResponseValidationError{}
+
 // Validate checks the field values on InternalProductInfo with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -2102,3 +2339,977 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = InternalMerchantGetProductResponseValidationError{}
+
+// Validate checks the field values on InternalMerchantListProductsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalMerchantListProductsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalMerchantListProductsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalMerchantListProductsRequestMultiError, or nil if none found.
+func (m *InternalMerchantListProductsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalMerchantListProductsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if m.IncludePlans != nil {
+		// no validation rules for IncludePlans
+	}
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if len(errors) > 0 {
+		return InternalMerchantListProductsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalMerchantListProductsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalMerchantListProductsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalMerchantListProductsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalMerchantListProductsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalMerchantListProductsRequestMultiError) AllErrors() []error { return m }
+
+// InternalMerchantListProductsRequestValidationError is the validation error
+// returned by InternalMerchantListProductsRequest.Validate if the designated
+// constraints aren't met.
+type InternalMerchantListProductsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalMerchantListProductsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalMerchantListProductsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalMerchantListProductsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalMerchantListProductsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalMerchantListProductsRequestValidationError) ErrorName() string {
+	return "InternalMerchantListProductsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalMerchantListProductsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalMerchantListProductsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalMerchantListProductsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalMerchantListProductsRequestValidationError{}
+
+// Validate checks the field values on InternalMerchantCatalogProduct with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalMerchantCatalogProduct) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalMerchantCatalogProduct with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalMerchantCatalogProductMultiError, or nil if none found.
+func (m *InternalMerchantCatalogProduct) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalMerchantCatalogProduct) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalMerchantCatalogProductValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalMerchantCatalogProductValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalMerchantCatalogProductValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Description
+
+	for idx, item := range m.GetPlans() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalMerchantCatalogProductValidationError{
+						field:  fmt.Sprintf("Plans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalMerchantCatalogProductValidationError{
+						field:  fmt.Sprintf("Plans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalMerchantCatalogProductValidationError{
+					field:  fmt.Sprintf("Plans[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalMerchantCatalogProductMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalMerchantCatalogProductMultiError is an error wrapping multiple
+// validation errors returned by InternalMerchantCatalogProduct.ValidateAll()
+// if the designated constraints aren't met.
+type InternalMerchantCatalogProductMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalMerchantCatalogProductMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalMerchantCatalogProductMultiError) AllErrors() []error { return m }
+
+// InternalMerchantCatalogProductValidationError is the validation error
+// returned by InternalMerchantCatalogProduct.Validate if the designated
+// constraints aren't met.
+type InternalMerchantCatalogProductValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalMerchantCatalogProductValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalMerchantCatalogProductValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalMerchantCatalogProductValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalMerchantCatalogProductValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalMerchantCatalogProductValidationError) ErrorName() string {
+	return "InternalMerchantCatalogProductValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalMerchantCatalogProductValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalMerchantCatalogProduct.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalMerchantCatalogProductValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalMerchantCatalogProductValidationError{}
+
+// Validate checks the field values on InternalMerchantListProductsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *InternalMerchantListProductsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalMerchantListProductsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalMerchantListProductsResponseMultiError, or nil if none found.
+func (m *InternalMerchantListProductsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalMerchantListProductsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProducts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalMerchantListProductsResponseValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalMerchantListProductsResponseValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalMerchantListProductsResponseValidationError{
+					field:  fmt.Sprintf("Products[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return InternalMerchantListProductsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalMerchantListProductsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalMerchantListProductsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalMerchantListProductsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalMerchantListProductsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalMerchantListProductsResponseMultiError) AllErrors() []error { return m }
+
+// InternalMerchantListProductsResponseValidationError is the validation error
+// returned by InternalMerchantListProductsResponse.Validate if the designated
+// constraints aren't met.
+type InternalMerchantListProductsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalMerchantListProductsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalMerchantListProductsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalMerchantListProductsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalMerchantListProductsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalMerchantListProductsResponseValidationError) ErrorName() string {
+	return "InternalMerchantListProductsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalMerchantListProductsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalMerchantListProductsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalMerchantListProductsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalMerchantListProductsResponseValidationError{}
+
+// Validate checks the field values on InternalListProductsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListProductsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListProductsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListProductsRequestMultiError, or nil if none found.
+func (m *InternalListProductsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListProductsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.CategoryId != nil {
+		// no validation rules for CategoryId
+	}
+
+	if m.Search != nil {
+		// no validation rules for Search
+	}
+
+	if len(errors) > 0 {
+		return InternalListProductsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListProductsRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalListProductsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListProductsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListProductsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListProductsRequestMultiError) AllErrors() []error { return m }
+
+// InternalListProductsRequestValidationError is the validation error returned
+// by InternalListProductsRequest.Validate if the designated constraints
+// aren't met.
+type InternalListProductsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListProductsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListProductsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListProductsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListProductsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListProductsRequestValidationError) ErrorName() string {
+	return "InternalListProductsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListProductsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListProductsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListProductsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListProductsRequestValidationError{}
+
+// Validate checks the field values on InternalListProductsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListProductsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListProductsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListProductsResponseMultiError, or nil if none found.
+func (m *InternalListProductsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListProductsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetProducts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalListProductsResponseValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalListProductsResponseValidationError{
+						field:  fmt.Sprintf("Products[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalListProductsResponseValidationError{
+					field:  fmt.Sprintf("Products[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return InternalListProductsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListProductsResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalListProductsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListProductsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListProductsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListProductsResponseMultiError) AllErrors() []error { return m }
+
+// InternalListProductsResponseValidationError is the validation error returned
+// by InternalListProductsResponse.Validate if the designated constraints
+// aren't met.
+type InternalListProductsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListProductsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListProductsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListProductsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListProductsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListProductsResponseValidationError) ErrorName() string {
+	return "InternalListProductsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListProductsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListProductsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListProductsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListProductsResponseValidationError{}
+
+// Validate checks the field values on InternalListPlansRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListPlansRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListPlansRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListPlansRequestMultiError, or nil if none found.
+func (m *InternalListPlansRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListPlansRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductCode
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
+	if m.Status != nil {
+		// no validation rules for Status
+	}
+
+	if m.IncludeParameters != nil {
+		// no validation rules for IncludeParameters
+	}
+
+	if m.IfNoneMatch != nil {
+		// no validation rules for IfNoneMatch
+	}
+
+	if len(errors) > 0 {
+		return InternalListPlansRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListPlansRequestMultiError is an error wrapping multiple validation
+// errors returned by InternalListPlansRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalListPlansRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListPlansRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListPlansRequestMultiError) AllErrors() []error { return m }
+
+// InternalListPlansRequestValidationError is the validation error returned by
+// InternalListPlansRequest.Validate if the designated constraints aren't met.
+type InternalListPlansRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListPlansRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListPlansRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListPlansRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListPlansRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListPlansRequestValidationError) ErrorName() string {
+	return "InternalListPlansRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListPlansRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListPlansRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListPlansRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListPlansRequestValidationError{}
+
+// Validate checks the field values on InternalListPlansResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListPlansResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListPlansResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListPlansResponseMultiError, or nil if none found.
+func (m *InternalListPlansResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListPlansResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPlans() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalListPlansResponseValidationError{
+						field:  fmt.Sprintf("Plans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalListPlansResponseValidationError{
+						field:  fmt.Sprintf("Plans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalListPlansResponseValidationError{
+					field:  fmt.Sprintf("Plans[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	// no validation rules for Page
+
+	// no validation rules for PageSize
+
+	// no validation rules for Version
+
+	// no validation rules for NotModified
+
+	if len(errors) > 0 {
+		return InternalListPlansResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListPlansResponseMultiError is an error wrapping multiple validation
+// errors returned by InternalListPlansResponse.ValidateAll() if the
+// designated constraints aren't met.
+type InternalListPlansResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListPlansResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListPlansResponseMultiError) AllErrors() []error { return m }
+
+// InternalListPlansResponseValidationError is the validation error returned by
+// InternalListPlansResponse.Validate if the designated constraints aren't met.
+type InternalListPlansResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListPlansResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListPlansResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListPlansResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListPlansResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListPlansResponseValidationError) ErrorName() string {
+	return "InternalListPlansResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListPlansResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListPlansResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListPlansResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListPlansResponseValidationError{}
@@ -19,11 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductInternalService_InternalGetPlan_FullMethodName            = "/api.product.v1.ProductInternalService/InternalGetPlan"
-	ProductInternalService_InternalMerchantGetPlan_FullMethodName    = "/api.product.v1.ProductInternalService/InternalMerchantGetPlan"
-	ProductInternalService_InternalListPricingRules_FullMethodName   = "/api.product.v1.ProductInternalService/InternalListPricingRules"
-	ProductInternalService_InternalGetProduct_FullMethodName         = "/api.product.v1.ProductInternalService/InternalGetProduct"
-	ProductInternalService_InternalMerchantGetProduct_FullMethodName = "/api.product.v1.ProductInternalService/InternalMerchantGetProduct"
+	ProductInternalService_InternalGetPlan_FullMethodName              = "/api.product.v1.ProductInternalService/InternalGetPlan"
+	ProductInternalService_InternalMerchantGetPlan_FullMethodName      = "/api.product.v1.ProductInternalService/InternalMerchantGetPlan"
+	ProductInternalService_InternalListPricingRules_FullMethodName     = "/api.product.v1.ProductInternalService/InternalListPricingRules"
+	ProductInternalService_InternalGetPricingRule_FullMethodName       = "/api.product.v1.ProductInternalService/InternalGetPricingRule"
+	ProductInternalService_InternalGetProduct_FullMethodName           = "/api.product.v1.ProductInternalService/InternalGetProduct"
+	ProductInternalService_InternalMerchantGetProduct_FullMethodName   = "/api.product.v1.ProductInternalService/InternalMerchantGetProduct"
+	ProductInternalService_InternalMerchantListProducts_FullMethodName = "/api.product.v1.ProductInternalService/InternalMerchantListProducts"
+	ProductInternalService_InternalListProducts_FullMethodName         = "/api.product.v1.ProductInternalService/InternalListProducts"
+	ProductInternalService_InternalListPlans_FullMethodName            = "/api.product.v1.ProductInternalService/InternalListPlans"
 )
 
 // ProductInternalServiceClient is the client API for ProductInternalService service.
@@ -36,10 +40,18 @@ type ProductInternalServiceClient interface {
 	InternalMerchantGetPlan(ctx context.Context, in *InternalMerchantGetPlanRequest, opts ...grpc.CallOption) (*InternalMerchantGetPlanResponse, error)
 	// 获取定价规则列表
 	InternalListPricingRules(ctx context.Context, in *InternalListPricingRulesRequest, opts ...grpc.CallOption) (*InternalListPricingRulesResponse, error)
+	// 获取定价规则详情
+	InternalGetPricingRule(ctx context.Context, in *InternalGetPricingRuleRequest, opts ...grpc.CallOption) (*InternalGetPricingRuleResponse, error)
 	// 获取产品详情
 	InternalGetProduct(ctx context.Context, in *InternalGetProductRequest, opts ...grpc.CallOption) (*InternalGetProductResponse, error)
 	// 商户获取产品详情
 	InternalMerchantGetProduct(ctx context.Context, in *InternalMerchantGetProductRequest, opts ...grpc.CallOption) (*InternalMerchantGetProductResponse, error)
+	// 商户获取产品目录（仅返回已上架且对商户可见的产品）
+	InternalMerchantListProducts(ctx context.Context, in *InternalMerchantListProductsRequest, opts ...grpc.CallOption) (*InternalMerchantListProductsResponse, error)
+	// 获取产品列表
+	InternalListProducts(ctx context.Context, in *InternalListProductsRequest, opts ...grpc.CallOption) (*InternalListProductsResponse, error)
+	// 获取产品套餐列表
+	InternalListPlans(ctx context.Context, in *InternalListPlansRequest, opts ...grpc.CallOption) (*InternalListPlansResponse, error)
 }
 
 type productInternalServiceClient struct {
@@ -80,6 +92,16 @@ func (c *productInternalServiceClient) InternalListPricingRules(ctx context.Cont
 	return out, nil
 }
 
+func (c *productInternalServiceClient) InternalGetPricingRule(ctx context.Context, in *InternalGetPricingRuleRequest, opts ...grpc.CallOption) (*InternalGetPricingRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGetPricingRuleResponse)
+	err := c.cc.Invoke(ctx, ProductInternalService_InternalGetPricingRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productInternalServiceClient) InternalGetProduct(ctx context.Context, in *InternalGetProductRequest, opts ...grpc.CallOption) (*InternalGetProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InternalGetProductResponse)
@@ -100,6 +122,36 @@ func (c *productInternalServiceClient) InternalMerchantGetProduct(ctx context.Co
 	return out, nil
 }
 
+func (c *productInternalServiceClient) InternalMerchantListProducts(ctx context.Context, in *InternalMerchantListProductsRequest, opts ...grpc.CallOption) (*InternalMerchantListProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalMerchantListProductsResponse)
+	err := c.cc.Invoke(ctx, ProductInternalService_InternalMerchantListProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productInternalServiceClient) InternalListProducts(ctx context.Context, in *InternalListProductsRequest, opts ...grpc.CallOption) (*InternalListProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalListProductsResponse)
+	err := c.cc.Invoke(ctx, ProductInternalService_InternalListProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productInternalServiceClient) InternalListPlans(ctx context.Context, in *InternalListPlansRequest, opts ...grpc.CallOption) (*InternalListPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalListPlansResponse)
+	err := c.cc.Invoke(ctx, ProductInternalService_InternalListPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductInternalServiceServer is the server API for ProductInternalService service.
 // All implementations must embed UnimplementedProductInternalServiceServer
 // for forward compatibility.
@@ -110,10 +162,18 @@ type ProductInternalServiceServer interface {
 	InternalMerchantGetPlan(context.Context, *InternalMerchantGetPlanRequest) (*InternalMerchantGetPlanResponse, error)
 	// 获取定价规则列表
 	InternalListPricingRules(context.Context, *InternalListPricingRulesRequest) (*InternalListPricingRulesResponse, error)
+	// 获取定价规则详情
+	InternalGetPricingRule(context.Context, *InternalGetPricingRuleRequest) (*InternalGetPricingRuleResponse, error)
 	// 获取产品详情
 	InternalGetProduct(context.Context, *InternalGetProductRequest) (*InternalGetProductResponse, error)
 	// 商户获取产品详情
 	InternalMerchantGetProduct(context.Context, *InternalMerchantGetProductRequest) (*InternalMerchantGetProductResponse, error)
+	// 商户获取产品目录（仅返回已上架且对商户可见的产品）
+	InternalMerchantListProducts(context.Context, *InternalMerchantListProductsRequest) (*InternalMerchantListProductsResponse, error)
+	// 获取产品列表
+	InternalListProducts(context.Context, *InternalListProductsRequest) (*InternalListProductsResponse, error)
+	// 获取产品套餐列表
+	InternalListPlans(context.Context, *InternalListPlansRequest) (*InternalListPlansResponse, error)
 	mustEmbedUnimplementedProductInternalServiceServer()
 }
 
@@ -133,12 +193,24 @@ func (UnimplementedProductInternalServiceServer) InternalMerchantGetPlan(context
 func (UnimplementedProductInternalServiceServer) InternalListPricingRules(context.Context, *InternalListPricingRulesRequest) (*InternalListPricingRulesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalListPricingRules not implemented")
 }
+func (UnimplementedProductInternalServiceServer) InternalGetPricingRule(context.Context, *InternalGetPricingRuleRequest) (*InternalGetPricingRuleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalGetPricingRule not implemented")
+}
 func (UnimplementedProductInternalServiceServer) InternalGetProduct(context.Context, *InternalGetProductRequest) (*InternalGetProductResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalGetProduct not implemented")
 }
 func (UnimplementedProductInternalServiceServer) InternalMerchantGetProduct(context.Context, *InternalMerchantGetProductRequest) (*InternalMerchantGetProductResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalMerchantGetProduct not implemented")
 }
+func (UnimplementedProductInternalServiceServer) InternalMerchantListProducts(context.Context, *InternalMerchantListProductsRequest) (*InternalMerchantListProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalMerchantListProducts not implemented")
+}
+func (UnimplementedProductInternalServiceServer) InternalListProducts(context.Context, *InternalListProductsRequest) (*InternalListProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalListProducts not implemented")
+}
+func (UnimplementedProductInternalServiceServer) InternalListPlans(context.Context, *InternalListPlansRequest) (*InternalListPlansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalListPlans not implemented")
+}
 func (UnimplementedProductInternalServiceServer) mustEmbedUnimplementedProductInternalServiceServer() {
 }
 func (UnimplementedProductInternalServiceServer) testEmbeddedByValue() {}
@@ -215,6 +287,24 @@ func _ProductInternalService_InternalListPricingRules_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductInternalService_InternalGetPricingRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGetPricingRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductInternalServiceServer).InternalGetPricingRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductInternalService_InternalGetPricingRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductInternalServiceServer).InternalGetPricingRule(ctx, req.(*InternalGetPricingRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductInternalService_InternalGetProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InternalGetProductRequest)
 	if err := dec(in); err != nil {
@@ -251,6 +341,60 @@ func _ProductInternalService_InternalMerchantGetProduct_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductInternalService_InternalMerchantListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalMerchantListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductInternalServiceServer).InternalMerchantListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductInternalService_InternalMerchantListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductInternalServiceServer).InternalMerchantListProducts(ctx, req.(*InternalMerchantListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductInternalService_InternalListProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalListProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductInternalServiceServer).InternalListProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductInternalService_InternalListProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductInternalServiceServer).InternalListProducts(ctx, req.(*InternalListProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductInternalService_InternalListPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalListPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductInternalServiceServer).InternalListPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductInternalService_InternalListPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductInternalServiceServer).InternalListPlans(ctx, req.(*InternalListPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductInternalService_ServiceDesc is the grpc.ServiceDesc for ProductInternalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -270,6 +414,10 @@ var ProductInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InternalListPricingRules",
 			Handler:    _ProductInternalService_InternalListPricingRules_Handler,
 		},
+		{
+			MethodName: "InternalGetPricingRule",
+			Handler:    _ProductInternalService_InternalGetPricingRule_Handler,
+		},
 		{
 			MethodName: "InternalGetProduct",
 			Handler:    _ProductInternalService_InternalGetProduct_Handler,
@@ -278,6 +426,18 @@ var ProductInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InternalMerchantGetProduct",
 			Handler:    _ProductInternalService_InternalMerchantGetProduct_Handler,
 		},
+		{
+			MethodName: "InternalMerchantListProducts",
+			Handler:    _ProductInternalService_InternalMerchantListProducts_Handler,
+		},
+		{
+			MethodName: "InternalListProducts",
+			Handler:    _ProductInternalService_InternalListProducts_Handler,
+		},
+		{
+			MethodName: "InternalListPlans",
+			Handler:    _ProductInternalService_InternalListPlans_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "product/v1/product_internal.proto",
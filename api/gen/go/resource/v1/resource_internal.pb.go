@@ -46,7 +46,11 @@ type InternalFileInfo struct {
 	// 创建时间
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// 更新时间
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// 标签
+	Tags []string `protobuf:"bytes,11,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 是否公开访问
+	IsPublic      bool `protobuf:"varint,12,opt,name=is_public,json=isPublic,proto3" json:"is_public,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -151,6 +155,20 @@ func (x *InternalFileInfo) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *InternalFileInfo) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *InternalFileInfo) GetIsPublic() bool {
+	if x != nil {
+		return x.IsPublic
+	}
+	return false
+}
+
 // InternalFileUrlInfo 内部文件URL信息
 type InternalFileUrlInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -729,7 +747,9 @@ type InternalGetFileUrlsRequest struct {
 	// 是否包含变体URL（可选，默认false）
 	IncludeVariants bool `protobuf:"varint,3,opt,name=include_variants,json=includeVariants,proto3" json:"include_variants,omitempty"`
 	// URL有效期（秒，可选），默认3600
-	ExpiresIn     int64 `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	ExpiresIn int64 `protobuf:"varint,4,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// 只返回指定变体的URL（可选），设置后无需 include_variants
+	VariantIds    []string `protobuf:"bytes,5,rep,name=variant_ids,json=variantIds,proto3" json:"variant_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -793,6 +813,13 @@ func (x *InternalGetFileUrlsRequest) GetExpiresIn() int64 {
 	return 0
 }
 
+func (x *InternalGetFileUrlsRequest) GetVariantIds() []string {
+	if x != nil {
+		return x.VariantIds
+	}
+	return nil
+}
+
 // InternalGetFileUrlsResponse 内部批量获取文件URL响应
 type InternalGetFileUrlsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1150,29 +1177,31 @@ func (x *InternalCheckFileExistsResponse) GetFile() *InternalFileInfo {
 	return nil
 }
 
-// InternalGetQuotaRequest 内部获取配额请求
-type InternalGetQuotaRequest struct {
+// InternalChecksumItem 校验和检查项
+type InternalChecksumItem struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 租户ID（必填）
-	TenantCode    string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// SHA256校验和（必填）
+	ChecksumSha256 string `protobuf:"bytes,1,opt,name=checksum_sha256,json=checksumSha256,proto3" json:"checksum_sha256,omitempty"`
+	// 文件大小（字节，可选但推荐）
+	Size          int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InternalGetQuotaRequest) Reset() {
-	*x = InternalGetQuotaRequest{}
+func (x *InternalChecksumItem) Reset() {
+	*x = InternalChecksumItem{}
 	mi := &file_resource_v1_resource_internal_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalGetQuotaRequest) String() string {
+func (x *InternalChecksumItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalGetQuotaRequest) ProtoMessage() {}
+func (*InternalChecksumItem) ProtoMessage() {}
 
-func (x *InternalGetQuotaRequest) ProtoReflect() protoreflect.Message {
+func (x *InternalChecksumItem) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_v1_resource_internal_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1184,41 +1213,50 @@ func (x *InternalGetQuotaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalGetQuotaRequest.ProtoReflect.Descriptor instead.
-func (*InternalGetQuotaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InternalChecksumItem.ProtoReflect.Descriptor instead.
+func (*InternalChecksumItem) Descriptor() ([]byte, []int) {
 	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *InternalGetQuotaRequest) GetTenantCode() string {
+func (x *InternalChecksumItem) GetChecksumSha256() string {
 	if x != nil {
-		return x.TenantCode
+		return x.ChecksumSha256
 	}
 	return ""
 }
 
-// InternalGetQuotaResponse 内部获取配额响应
-type InternalGetQuotaResponse struct {
+func (x *InternalChecksumItem) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// InternalCheckFilesExistRequest 内部批量检查文件存在请求
+type InternalCheckFilesExistRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 配额信息
-	Quota         *InternalQuotaInfo `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	// 租户ID（必填）
+	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 检查项列表（最多100个）
+	Items         []*InternalChecksumItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InternalGetQuotaResponse) Reset() {
-	*x = InternalGetQuotaResponse{}
+func (x *InternalCheckFilesExistRequest) Reset() {
+	*x = InternalCheckFilesExistRequest{}
 	mi := &file_resource_v1_resource_internal_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalGetQuotaResponse) String() string {
+func (x *InternalCheckFilesExistRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalGetQuotaResponse) ProtoMessage() {}
+func (*InternalCheckFilesExistRequest) ProtoMessage() {}
 
-func (x *InternalGetQuotaResponse) ProtoReflect() protoreflect.Message {
+func (x *InternalCheckFilesExistRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_v1_resource_internal_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1230,45 +1268,48 @@ func (x *InternalGetQuotaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalGetQuotaResponse.ProtoReflect.Descriptor instead.
-func (*InternalGetQuotaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InternalCheckFilesExistRequest.ProtoReflect.Descriptor instead.
+func (*InternalCheckFilesExistRequest) Descriptor() ([]byte, []int) {
 	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *InternalGetQuotaResponse) GetQuota() *InternalQuotaInfo {
+func (x *InternalCheckFilesExistRequest) GetTenantCode() string {
 	if x != nil {
-		return x.Quota
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalCheckFilesExistRequest) GetItems() []*InternalChecksumItem {
+	if x != nil {
+		return x.Items
 	}
 	return nil
 }
 
-// InternalCheckQuotaRequest 内部检查配额请求
-type InternalCheckQuotaRequest struct {
+// InternalCheckFilesExistResponse 内部批量检查文件存在响应
+type InternalCheckFilesExistResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 租户ID（必填）
-	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
-	// 检查类型（必填）：upload, download, storage
-	CheckType string `protobuf:"bytes,2,opt,name=check_type,json=checkType,proto3" json:"check_type,omitempty"`
-	// 预计使用量（字节，用于upload和storage检查）
-	Size          int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// 已存在的文件（校验和 -> 文件信息），不存在的校验和不返回
+	Files         map[string]*InternalFileInfo `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InternalCheckQuotaRequest) Reset() {
-	*x = InternalCheckQuotaRequest{}
+func (x *InternalCheckFilesExistResponse) Reset() {
+	*x = InternalCheckFilesExistResponse{}
 	mi := &file_resource_v1_resource_internal_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalCheckQuotaRequest) String() string {
+func (x *InternalCheckFilesExistResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalCheckQuotaRequest) ProtoMessage() {}
+func (*InternalCheckFilesExistResponse) ProtoMessage() {}
 
-func (x *InternalCheckQuotaRequest) ProtoReflect() protoreflect.Message {
+func (x *InternalCheckFilesExistResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_v1_resource_internal_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1280,59 +1321,49 @@ func (x *InternalCheckQuotaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalCheckQuotaRequest.ProtoReflect.Descriptor instead.
-func (*InternalCheckQuotaRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InternalCheckFilesExistResponse.ProtoReflect.Descriptor instead.
+func (*InternalCheckFilesExistResponse) Descriptor() ([]byte, []int) {
 	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *InternalCheckQuotaRequest) GetTenantCode() string {
-	if x != nil {
-		return x.TenantCode
-	}
-	return ""
-}
-
-func (x *InternalCheckQuotaRequest) GetCheckType() string {
-	if x != nil {
-		return x.CheckType
-	}
-	return ""
-}
-
-func (x *InternalCheckQuotaRequest) GetSize() int64 {
+func (x *InternalCheckFilesExistResponse) GetFiles() map[string]*InternalFileInfo {
 	if x != nil {
-		return x.Size
+		return x.Files
 	}
-	return 0
+	return nil
 }
 
-// InternalCheckQuotaResponse 内部检查配额响应
-type InternalCheckQuotaResponse struct {
+// InternalCopyFileRequest 内部复制文件请求
+type InternalCopyFileRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 是否允许操作
-	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
-	// 不允许的原因（allowed=false时）
-	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
-	// 当前配额信息
-	Quota         *InternalQuotaInfo `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *InternalCheckQuotaResponse) Reset() {
-	*x = InternalCheckQuotaResponse{}
+	// 源租户ID（必填）
+	SrcTenantCode string `protobuf:"bytes,1,opt,name=src_tenant_code,json=srcTenantCode,proto3" json:"src_tenant_code,omitempty"`
+	// 源文件ID（必填）
+	FileId string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 目标租户ID（必填）
+	DstTenantCode string `protobuf:"bytes,3,opt,name=dst_tenant_code,json=dstTenantCode,proto3" json:"dst_tenant_code,omitempty"`
+	// 新文件名（可选，默认沿用源文件名）
+	Filename string `protobuf:"bytes,4,opt,name=filename,proto3" json:"filename,omitempty"`
+	// 是否同时复制变体（如缩略图，可选，默认false）
+	IncludeVariants bool `protobuf:"varint,5,opt,name=include_variants,json=includeVariants,proto3" json:"include_variants,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InternalCopyFileRequest) Reset() {
+	*x = InternalCopyFileRequest{}
 	mi := &file_resource_v1_resource_internal_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalCheckQuotaResponse) String() string {
+func (x *InternalCopyFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalCheckQuotaResponse) ProtoMessage() {}
+func (*InternalCopyFileRequest) ProtoMessage() {}
 
-func (x *InternalCheckQuotaResponse) ProtoReflect() protoreflect.Message {
+func (x *InternalCopyFileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_v1_resource_internal_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1344,65 +1375,71 @@ func (x *InternalCheckQuotaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalCheckQuotaResponse.ProtoReflect.Descriptor instead.
-func (*InternalCheckQuotaResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InternalCopyFileRequest.ProtoReflect.Descriptor instead.
+func (*InternalCopyFileRequest) Descriptor() ([]byte, []int) {
 	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *InternalCheckQuotaResponse) GetAllowed() bool {
+func (x *InternalCopyFileRequest) GetSrcTenantCode() string {
 	if x != nil {
-		return x.Allowed
+		return x.SrcTenantCode
 	}
-	return false
+	return ""
 }
 
-func (x *InternalCheckQuotaResponse) GetReason() string {
+func (x *InternalCopyFileRequest) GetFileId() string {
 	if x != nil {
-		return x.Reason
+		return x.FileId
 	}
 	return ""
 }
 
-func (x *InternalCheckQuotaResponse) GetQuota() *InternalQuotaInfo {
+func (x *InternalCopyFileRequest) GetDstTenantCode() string {
 	if x != nil {
-		return x.Quota
+		return x.DstTenantCode
 	}
-	return nil
+	return ""
 }
 
-// InternalInitTenantRequest 内部初始化租户请求
-type InternalInitTenantRequest struct {
+func (x *InternalCopyFileRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *InternalCopyFileRequest) GetIncludeVariants() bool {
+	if x != nil {
+		return x.IncludeVariants
+	}
+	return false
+}
+
+// InternalCopyFileResponse 内部复制文件响应
+type InternalCopyFileResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 租户ID（必填）
-	// 说明：
-	// - 必须为正整数
-	// - 不能为0（平台保留）
-	// - 同一租户不能重复初始化
-	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
-	// 存储区域（可选）
-	// 说明：
-	// - 可选值：cn|sea|us|eu
-	// - 默认值：sea（东南亚）
-	// - 区域必须有可用的Provider
-	Region        string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	// 新文件ID
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 新文件信息
+	File          *InternalFileInfo `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InternalInitTenantRequest) Reset() {
-	*x = InternalInitTenantRequest{}
+func (x *InternalCopyFileResponse) Reset() {
+	*x = InternalCopyFileResponse{}
 	mi := &file_resource_v1_resource_internal_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalInitTenantRequest) String() string {
+func (x *InternalCopyFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalInitTenantRequest) ProtoMessage() {}
+func (*InternalCopyFileResponse) ProtoMessage() {}
 
-func (x *InternalInitTenantRequest) ProtoReflect() protoreflect.Message {
+func (x *InternalCopyFileResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_v1_resource_internal_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1414,60 +1451,60 @@ func (x *InternalInitTenantRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalInitTenantRequest.ProtoReflect.Descriptor instead.
-func (*InternalInitTenantRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InternalCopyFileResponse.ProtoReflect.Descriptor instead.
+func (*InternalCopyFileResponse) Descriptor() ([]byte, []int) {
 	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *InternalInitTenantRequest) GetTenantCode() string {
+func (x *InternalCopyFileResponse) GetFileId() string {
 	if x != nil {
-		return x.TenantCode
+		return x.FileId
 	}
 	return ""
 }
 
-func (x *InternalInitTenantRequest) GetRegion() string {
+func (x *InternalCopyFileResponse) GetFile() *InternalFileInfo {
 	if x != nil {
-		return x.Region
+		return x.File
 	}
-	return ""
+	return nil
 }
 
-// InternalInitTenantResponse 内部初始化租户响应
-type InternalInitTenantResponse struct {
+// InternalUpdateFileMetadataRequest 内部更新文件元数据请求
+//
+// 未设置的字段保持不变
+type InternalUpdateFileMetadataRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 是否成功
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	// 创建的存储桶ID
-	BucketId string `protobuf:"bytes,2,opt,name=bucket_id,json=bucketId,proto3" json:"bucket_id,omitempty"`
-	// 创建的存储桶名称
-	BucketName string `protobuf:"bytes,3,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
-	// 存储配额（字节）
-	StorageQuota int64 `protobuf:"varint,4,opt,name=storage_quota,json=storageQuota,proto3" json:"storage_quota,omitempty"`
-	// 文件数配额
-	FileCountQuota int64 `protobuf:"varint,5,opt,name=file_count_quota,json=fileCountQuota,proto3" json:"file_count_quota,omitempty"`
-	// 提示信息
-	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
-	// 错误信息（success=false时）
-	Error         string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// 租户ID（必填）
+	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 文件ID（必填）
+	FileId string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 显示文件名（可选）
+	Filename *string `protobuf:"bytes,3,opt,name=filename,proto3,oneof" json:"filename,omitempty"`
+	// 标签列表（update_tags=true时整体替换）
+	Tags []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	// 是否更新标签（为true且tags为空时清空标签）
+	UpdateTags bool `protobuf:"varint,5,opt,name=update_tags,json=updateTags,proto3" json:"update_tags,omitempty"`
+	// 是否公开访问（可选）
+	IsPublic      *bool `protobuf:"varint,6,opt,name=is_public,json=isPublic,proto3,oneof" json:"is_public,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InternalInitTenantResponse) Reset() {
-	*x = InternalInitTenantResponse{}
+func (x *InternalUpdateFileMetadataRequest) Reset() {
+	*x = InternalUpdateFileMetadataRequest{}
 	mi := &file_resource_v1_resource_internal_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalInitTenantResponse) String() string {
+func (x *InternalUpdateFileMetadataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalInitTenantResponse) ProtoMessage() {}
+func (*InternalUpdateFileMetadataRequest) ProtoMessage() {}
 
-func (x *InternalInitTenantResponse) ProtoReflect() protoreflect.Message {
+func (x *InternalUpdateFileMetadataRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_resource_v1_resource_internal_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1479,68 +1516,1828 @@ func (x *InternalInitTenantResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalInitTenantResponse.ProtoReflect.Descriptor instead.
-func (*InternalInitTenantResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use InternalUpdateFileMetadataRequest.ProtoReflect.Descriptor instead.
+func (*InternalUpdateFileMetadataRequest) Descriptor() ([]byte, []int) {
 	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *InternalInitTenantResponse) GetSuccess() bool {
+func (x *InternalUpdateFileMetadataRequest) GetTenantCode() string {
 	if x != nil {
-		return x.Success
+		return x.TenantCode
 	}
-	return false
+	return ""
 }
 
-func (x *InternalInitTenantResponse) GetBucketId() string {
+func (x *InternalUpdateFileMetadataRequest) GetFileId() string {
 	if x != nil {
-		return x.BucketId
+		return x.FileId
 	}
 	return ""
 }
 
-func (x *InternalInitTenantResponse) GetBucketName() string {
-	if x != nil {
-		return x.BucketName
+func (x *InternalUpdateFileMetadataRequest) GetFilename() string {
+	if x != nil && x.Filename != nil {
+		return *x.Filename
 	}
 	return ""
 }
 
-func (x *InternalInitTenantResponse) GetStorageQuota() int64 {
+func (x *InternalUpdateFileMetadataRequest) GetTags() []string {
 	if x != nil {
-		return x.StorageQuota
+		return x.Tags
 	}
-	return 0
+	return nil
 }
 
-func (x *InternalInitTenantResponse) GetFileCountQuota() int64 {
+func (x *InternalUpdateFileMetadataRequest) GetUpdateTags() bool {
 	if x != nil {
-		return x.FileCountQuota
+		return x.UpdateTags
 	}
-	return 0
+	return false
 }
 
-func (x *InternalInitTenantResponse) GetMessage() string {
+func (x *InternalUpdateFileMetadataRequest) GetIsPublic() bool {
+	if x != nil && x.IsPublic != nil {
+		return *x.IsPublic
+	}
+	return false
+}
+
+// InternalUpdateFileMetadataResponse 内部更新文件元数据响应
+type InternalUpdateFileMetadataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 更新后的文件信息
+	File          *InternalFileInfo `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalUpdateFileMetadataResponse) Reset() {
+	*x = InternalUpdateFileMetadataResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalUpdateFileMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalUpdateFileMetadataResponse) ProtoMessage() {}
+
+func (x *InternalUpdateFileMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[21]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *InternalInitTenantResponse) GetError() string {
+// Deprecated: Use InternalUpdateFileMetadataResponse.ProtoReflect.Descriptor instead.
+func (*InternalUpdateFileMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InternalUpdateFileMetadataResponse) GetFile() *InternalFileInfo {
 	if x != nil {
-		return x.Error
+		return x.File
 	}
-	return ""
+	return nil
 }
 
-var File_resource_v1_resource_internal_proto protoreflect.FileDescriptor
+// InternalVariantSpec 变体规格
+type InternalVariantSpec struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 变体ID（必填），如 thumbnail_200x200
+	VariantId string `protobuf:"bytes,1,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	// 变体类型（必填）：thumbnail, resize, watermark
+	Type string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// 目标宽度（像素，可选）
+	Width int32 `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`
+	// 目标高度（像素，可选）
+	Height int32 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// 输出格式（可选）：jpeg, png, webp，默认与原图一致
+	Format string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
+	// 输出质量（1-100，可选），默认85
+	Quality int32 `protobuf:"varint,6,opt,name=quality,proto3" json:"quality,omitempty"`
+	// 水印文字（type=watermark时可选）
+	WatermarkText string `protobuf:"bytes,7,opt,name=watermark_text,json=watermarkText,proto3" json:"watermark_text,omitempty"`
+	// 水印图片文件ID（type=watermark时可选）
+	WatermarkFileId string `protobuf:"bytes,8,opt,name=watermark_file_id,json=watermarkFileId,proto3" json:"watermark_file_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InternalVariantSpec) Reset() {
+	*x = InternalVariantSpec{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-const file_resource_v1_resource_internal_proto_rawDesc = "" +
-	"\n" +
-	"#resource/v1/resource_internal.proto\x12\vresource.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf2\x02\n" +
-	"\x10InternalFileInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
-	"\vtenant_code\x18\x02 \x01(\tR\n" +
+func (x *InternalVariantSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalVariantSpec) ProtoMessage() {}
+
+func (x *InternalVariantSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalVariantSpec.ProtoReflect.Descriptor instead.
+func (*InternalVariantSpec) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InternalVariantSpec) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *InternalVariantSpec) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InternalVariantSpec) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *InternalVariantSpec) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *InternalVariantSpec) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *InternalVariantSpec) GetQuality() int32 {
+	if x != nil {
+		return x.Quality
+	}
+	return 0
+}
+
+func (x *InternalVariantSpec) GetWatermarkText() string {
+	if x != nil {
+		return x.WatermarkText
+	}
+	return ""
+}
+
+func (x *InternalVariantSpec) GetWatermarkFileId() string {
+	if x != nil {
+		return x.WatermarkFileId
+	}
+	return ""
+}
+
+// InternalVariantStatus 变体生成状态
+type InternalVariantStatus struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 变体ID
+	VariantId string `protobuf:"bytes,1,opt,name=variant_id,json=variantId,proto3" json:"variant_id,omitempty"`
+	// 生成状态：pending, processing, completed, failed
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// 变体URL（status=completed时）
+	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	// 错误信息（status=failed时）
+	Error         string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalVariantStatus) Reset() {
+	*x = InternalVariantStatus{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalVariantStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalVariantStatus) ProtoMessage() {}
+
+func (x *InternalVariantStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalVariantStatus.ProtoReflect.Descriptor instead.
+func (*InternalVariantStatus) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InternalVariantStatus) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *InternalVariantStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InternalVariantStatus) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *InternalVariantStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// InternalCreateVariantsRequest 内部生成变体请求
+type InternalCreateVariantsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 文件ID（必填）
+	FileId string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 变体规格列表（必填，最多20个）
+	Specs         []*InternalVariantSpec `protobuf:"bytes,3,rep,name=specs,proto3" json:"specs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateVariantsRequest) Reset() {
+	*x = InternalCreateVariantsRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateVariantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateVariantsRequest) ProtoMessage() {}
+
+func (x *InternalCreateVariantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateVariantsRequest.ProtoReflect.Descriptor instead.
+func (*InternalCreateVariantsRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *InternalCreateVariantsRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalCreateVariantsRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *InternalCreateVariantsRequest) GetSpecs() []*InternalVariantSpec {
+	if x != nil {
+		return x.Specs
+	}
+	return nil
+}
+
+// InternalCreateVariantsResponse 内部生成变体响应
+type InternalCreateVariantsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 各变体的当前状态
+	Variants      []*InternalVariantStatus `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateVariantsResponse) Reset() {
+	*x = InternalCreateVariantsResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateVariantsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateVariantsResponse) ProtoMessage() {}
+
+func (x *InternalCreateVariantsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateVariantsResponse.ProtoReflect.Descriptor instead.
+func (*InternalCreateVariantsResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InternalCreateVariantsResponse) GetVariants() []*InternalVariantStatus {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// InternalGetVariantStatusRequest 内部查询变体状态请求
+type InternalGetVariantStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 文件ID（必填）
+	FileId string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 变体ID列表（可选，为空时返回全部变体）
+	VariantIds    []string `protobuf:"bytes,3,rep,name=variant_ids,json=variantIds,proto3" json:"variant_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetVariantStatusRequest) Reset() {
+	*x = InternalGetVariantStatusRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetVariantStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetVariantStatusRequest) ProtoMessage() {}
+
+func (x *InternalGetVariantStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetVariantStatusRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetVariantStatusRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *InternalGetVariantStatusRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalGetVariantStatusRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *InternalGetVariantStatusRequest) GetVariantIds() []string {
+	if x != nil {
+		return x.VariantIds
+	}
+	return nil
+}
+
+// InternalGetVariantStatusResponse 内部查询变体状态响应
+type InternalGetVariantStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 各变体的当前状态
+	Variants      []*InternalVariantStatus `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetVariantStatusResponse) Reset() {
+	*x = InternalGetVariantStatusResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetVariantStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetVariantStatusResponse) ProtoMessage() {}
+
+func (x *InternalGetVariantStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetVariantStatusResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetVariantStatusResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *InternalGetVariantStatusResponse) GetVariants() []*InternalVariantStatus {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+// InternalArchiveJob 打包任务信息
+type InternalArchiveJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 任务ID
+	JobId string `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// 任务状态：pending|processing|completed|failed
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// 压缩包文件名
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// 压缩包下载URL（status=completed时）
+	DownloadUrl string `protobuf:"bytes,4,opt,name=download_url,json=downloadUrl,proto3" json:"download_url,omitempty"`
+	// 下载URL有效期（秒）
+	ExpiresIn int64 `protobuf:"varint,5,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	// 压缩包大小（字节）
+	Size int64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	// 包含的文件数
+	FileCount int32 `protobuf:"varint,7,opt,name=file_count,json=fileCount,proto3" json:"file_count,omitempty"`
+	// 打包失败的文件ID（文件不存在或无权访问）
+	FailedFileIds []string `protobuf:"bytes,8,rep,name=failed_file_ids,json=failedFileIds,proto3" json:"failed_file_ids,omitempty"`
+	// 错误信息（status=failed时）
+	Error         string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalArchiveJob) Reset() {
+	*x = InternalArchiveJob{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalArchiveJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalArchiveJob) ProtoMessage() {}
+
+func (x *InternalArchiveJob) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalArchiveJob.ProtoReflect.Descriptor instead.
+func (*InternalArchiveJob) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *InternalArchiveJob) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *InternalArchiveJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InternalArchiveJob) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InternalArchiveJob) GetDownloadUrl() string {
+	if x != nil {
+		return x.DownloadUrl
+	}
+	return ""
+}
+
+func (x *InternalArchiveJob) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+func (x *InternalArchiveJob) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *InternalArchiveJob) GetFileCount() int32 {
+	if x != nil {
+		return x.FileCount
+	}
+	return 0
+}
+
+func (x *InternalArchiveJob) GetFailedFileIds() []string {
+	if x != nil {
+		return x.FailedFileIds
+	}
+	return nil
+}
+
+func (x *InternalArchiveJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// InternalCreateArchiveRequest 内部创建打包任务请求
+type InternalCreateArchiveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 文件ID列表（必填，最多1000个）
+	FileIds []string `protobuf:"bytes,2,rep,name=file_ids,json=fileIds,proto3" json:"file_ids,omitempty"`
+	// 压缩包文件名（可选，默认按时间生成）
+	Name          string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateArchiveRequest) Reset() {
+	*x = InternalCreateArchiveRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateArchiveRequest) ProtoMessage() {}
+
+func (x *InternalCreateArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateArchiveRequest.ProtoReflect.Descriptor instead.
+func (*InternalCreateArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InternalCreateArchiveRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalCreateArchiveRequest) GetFileIds() []string {
+	if x != nil {
+		return x.FileIds
+	}
+	return nil
+}
+
+func (x *InternalCreateArchiveRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// InternalCreateArchiveResponse 内部创建打包任务响应
+type InternalCreateArchiveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 打包任务
+	Job           *InternalArchiveJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCreateArchiveResponse) Reset() {
+	*x = InternalCreateArchiveResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCreateArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCreateArchiveResponse) ProtoMessage() {}
+
+func (x *InternalCreateArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCreateArchiveResponse.ProtoReflect.Descriptor instead.
+func (*InternalCreateArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *InternalCreateArchiveResponse) GetJob() *InternalArchiveJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// InternalGetArchiveStatusRequest 内部查询打包任务状态请求
+type InternalGetArchiveStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 任务ID（必填）
+	JobId string `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	// 下载URL有效期（秒，可选），默认3600
+	ExpiresIn     int64 `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetArchiveStatusRequest) Reset() {
+	*x = InternalGetArchiveStatusRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetArchiveStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetArchiveStatusRequest) ProtoMessage() {}
+
+func (x *InternalGetArchiveStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetArchiveStatusRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetArchiveStatusRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *InternalGetArchiveStatusRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalGetArchiveStatusRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *InternalGetArchiveStatusRequest) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+// InternalGetArchiveStatusResponse 内部查询打包任务状态响应
+type InternalGetArchiveStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 打包任务
+	Job           *InternalArchiveJob `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetArchiveStatusResponse) Reset() {
+	*x = InternalGetArchiveStatusResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetArchiveStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetArchiveStatusResponse) ProtoMessage() {}
+
+func (x *InternalGetArchiveStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetArchiveStatusResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetArchiveStatusResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *InternalGetArchiveStatusResponse) GetJob() *InternalArchiveJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+// InternalFileReference 文件引用
+type InternalFileReference struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 文件ID
+	FileId string `protobuf:"bytes,1,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 引用方类型，如 product、order、article
+	RefType string `protobuf:"bytes,2,opt,name=ref_type,json=refType,proto3" json:"ref_type,omitempty"`
+	// 引用方ID
+	RefId string `protobuf:"bytes,3,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	// 引用创建时间
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalFileReference) Reset() {
+	*x = InternalFileReference{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalFileReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalFileReference) ProtoMessage() {}
+
+func (x *InternalFileReference) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalFileReference.ProtoReflect.Descriptor instead.
+func (*InternalFileReference) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InternalFileReference) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *InternalFileReference) GetRefType() string {
+	if x != nil {
+		return x.RefType
+	}
+	return ""
+}
+
+func (x *InternalFileReference) GetRefId() string {
+	if x != nil {
+		return x.RefId
+	}
+	return ""
+}
+
+func (x *InternalFileReference) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// InternalAttachReferenceRequest 内部记录文件引用请求
+type InternalAttachReferenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 文件ID（必填）
+	FileId string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 引用方类型（必填）
+	RefType string `protobuf:"bytes,3,opt,name=ref_type,json=refType,proto3" json:"ref_type,omitempty"`
+	// 引用方ID（必填）
+	RefId         string `protobuf:"bytes,4,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalAttachReferenceRequest) Reset() {
+	*x = InternalAttachReferenceRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalAttachReferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalAttachReferenceRequest) ProtoMessage() {}
+
+func (x *InternalAttachReferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalAttachReferenceRequest.ProtoReflect.Descriptor instead.
+func (*InternalAttachReferenceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InternalAttachReferenceRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalAttachReferenceRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *InternalAttachReferenceRequest) GetRefType() string {
+	if x != nil {
+		return x.RefType
+	}
+	return ""
+}
+
+func (x *InternalAttachReferenceRequest) GetRefId() string {
+	if x != nil {
+		return x.RefId
+	}
+	return ""
+}
+
+// InternalAttachReferenceResponse 内部记录文件引用响应
+type InternalAttachReferenceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 文件当前的引用数
+	ReferenceCount int32 `protobuf:"varint,1,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InternalAttachReferenceResponse) Reset() {
+	*x = InternalAttachReferenceResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalAttachReferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalAttachReferenceResponse) ProtoMessage() {}
+
+func (x *InternalAttachReferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalAttachReferenceResponse.ProtoReflect.Descriptor instead.
+func (*InternalAttachReferenceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *InternalAttachReferenceResponse) GetReferenceCount() int32 {
+	if x != nil {
+		return x.ReferenceCount
+	}
+	return 0
+}
+
+// InternalDetachReferenceRequest 内部删除文件引用请求
+type InternalDetachReferenceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 文件ID（必填）
+	FileId string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	// 引用方类型（必填）
+	RefType string `protobuf:"bytes,3,opt,name=ref_type,json=refType,proto3" json:"ref_type,omitempty"`
+	// 引用方ID（必填）
+	RefId         string `protobuf:"bytes,4,opt,name=ref_id,json=refId,proto3" json:"ref_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalDetachReferenceRequest) Reset() {
+	*x = InternalDetachReferenceRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalDetachReferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalDetachReferenceRequest) ProtoMessage() {}
+
+func (x *InternalDetachReferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalDetachReferenceRequest.ProtoReflect.Descriptor instead.
+func (*InternalDetachReferenceRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *InternalDetachReferenceRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalDetachReferenceRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+func (x *InternalDetachReferenceRequest) GetRefType() string {
+	if x != nil {
+		return x.RefType
+	}
+	return ""
+}
+
+func (x *InternalDetachReferenceRequest) GetRefId() string {
+	if x != nil {
+		return x.RefId
+	}
+	return ""
+}
+
+// InternalDetachReferenceResponse 内部删除文件引用响应
+type InternalDetachReferenceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 文件当前的引用数
+	ReferenceCount int32 `protobuf:"varint,1,opt,name=reference_count,json=referenceCount,proto3" json:"reference_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InternalDetachReferenceResponse) Reset() {
+	*x = InternalDetachReferenceResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalDetachReferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalDetachReferenceResponse) ProtoMessage() {}
+
+func (x *InternalDetachReferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalDetachReferenceResponse.ProtoReflect.Descriptor instead.
+func (*InternalDetachReferenceResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *InternalDetachReferenceResponse) GetReferenceCount() int32 {
+	if x != nil {
+		return x.ReferenceCount
+	}
+	return 0
+}
+
+// InternalListReferencesRequest 内部查询文件引用请求
+type InternalListReferencesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 文件ID（必填）
+	FileId        string `protobuf:"bytes,2,opt,name=file_id,json=fileId,proto3" json:"file_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListReferencesRequest) Reset() {
+	*x = InternalListReferencesRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListReferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListReferencesRequest) ProtoMessage() {}
+
+func (x *InternalListReferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListReferencesRequest.ProtoReflect.Descriptor instead.
+func (*InternalListReferencesRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *InternalListReferencesRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalListReferencesRequest) GetFileId() string {
+	if x != nil {
+		return x.FileId
+	}
+	return ""
+}
+
+// InternalListReferencesResponse 内部查询文件引用响应
+type InternalListReferencesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 引用列表
+	References    []*InternalFileReference `protobuf:"bytes,1,rep,name=references,proto3" json:"references,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListReferencesResponse) Reset() {
+	*x = InternalListReferencesResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListReferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListReferencesResponse) ProtoMessage() {}
+
+func (x *InternalListReferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListReferencesResponse.ProtoReflect.Descriptor instead.
+func (*InternalListReferencesResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *InternalListReferencesResponse) GetReferences() []*InternalFileReference {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+// InternalGetQuotaRequest 内部获取配额请求
+type InternalGetQuotaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantCode    string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetQuotaRequest) Reset() {
+	*x = InternalGetQuotaRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetQuotaRequest) ProtoMessage() {}
+
+func (x *InternalGetQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetQuotaRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *InternalGetQuotaRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+// InternalGetQuotaResponse 内部获取配额响应
+type InternalGetQuotaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 配额信息
+	Quota         *InternalQuotaInfo `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetQuotaResponse) Reset() {
+	*x = InternalGetQuotaResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetQuotaResponse) ProtoMessage() {}
+
+func (x *InternalGetQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetQuotaResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *InternalGetQuotaResponse) GetQuota() *InternalQuotaInfo {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// InternalCheckQuotaRequest 内部检查配额请求
+type InternalCheckQuotaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 检查类型（必填）：upload, download, storage
+	CheckType string `protobuf:"bytes,2,opt,name=check_type,json=checkType,proto3" json:"check_type,omitempty"`
+	// 预计使用量（字节，用于upload和storage检查）
+	Size          int64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCheckQuotaRequest) Reset() {
+	*x = InternalCheckQuotaRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCheckQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCheckQuotaRequest) ProtoMessage() {}
+
+func (x *InternalCheckQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCheckQuotaRequest.ProtoReflect.Descriptor instead.
+func (*InternalCheckQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *InternalCheckQuotaRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalCheckQuotaRequest) GetCheckType() string {
+	if x != nil {
+		return x.CheckType
+	}
+	return ""
+}
+
+func (x *InternalCheckQuotaRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// InternalCheckQuotaResponse 内部检查配额响应
+type InternalCheckQuotaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否允许操作
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// 不允许的原因（allowed=false时）
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// 当前配额信息
+	Quota         *InternalQuotaInfo `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCheckQuotaResponse) Reset() {
+	*x = InternalCheckQuotaResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCheckQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCheckQuotaResponse) ProtoMessage() {}
+
+func (x *InternalCheckQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCheckQuotaResponse.ProtoReflect.Descriptor instead.
+func (*InternalCheckQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *InternalCheckQuotaResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *InternalCheckQuotaResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *InternalCheckQuotaResponse) GetQuota() *InternalQuotaInfo {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// InternalInitTenantRequest 内部初始化租户请求
+type InternalInitTenantRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	// 说明：
+	// - 必须为正整数
+	// - 不能为0（平台保留）
+	// - 同一租户不能重复初始化
+	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 存储区域（可选）
+	// 说明：
+	// - 可选值：cn|sea|us|eu
+	// - 默认值：sea（东南亚）
+	// - 区域必须有可用的Provider
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	// 幂等键（可选）
+	// 说明：
+	// - 相同幂等键的重复请求返回首次初始化的结果，不再报错
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// 租户已初始化时是否视为成功（可选）
+	// 说明：
+	// - true 时重复初始化返回已有存储桶和配额，already_initialized=true
+	AllowExisting bool `protobuf:"varint,4,opt,name=allow_existing,json=allowExisting,proto3" json:"allow_existing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalInitTenantRequest) Reset() {
+	*x = InternalInitTenantRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalInitTenantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalInitTenantRequest) ProtoMessage() {}
+
+func (x *InternalInitTenantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalInitTenantRequest.ProtoReflect.Descriptor instead.
+func (*InternalInitTenantRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *InternalInitTenantRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalInitTenantRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *InternalInitTenantRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *InternalInitTenantRequest) GetAllowExisting() bool {
+	if x != nil {
+		return x.AllowExisting
+	}
+	return false
+}
+
+// InternalInitTenantResponse 内部初始化租户响应
+type InternalInitTenantResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// 创建的存储桶ID
+	BucketId string `protobuf:"bytes,2,opt,name=bucket_id,json=bucketId,proto3" json:"bucket_id,omitempty"`
+	// 创建的存储桶名称
+	BucketName string `protobuf:"bytes,3,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// 存储配额（字节）
+	StorageQuota int64 `protobuf:"varint,4,opt,name=storage_quota,json=storageQuota,proto3" json:"storage_quota,omitempty"`
+	// 文件数配额
+	FileCountQuota int64 `protobuf:"varint,5,opt,name=file_count_quota,json=fileCountQuota,proto3" json:"file_count_quota,omitempty"`
+	// 提示信息
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	// 错误信息（success=false时）
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	// 租户此前已完成初始化（幂等返回时为true）
+	AlreadyInitialized bool `protobuf:"varint,8,opt,name=already_initialized,json=alreadyInitialized,proto3" json:"already_initialized,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *InternalInitTenantResponse) Reset() {
+	*x = InternalInitTenantResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalInitTenantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalInitTenantResponse) ProtoMessage() {}
+
+func (x *InternalInitTenantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalInitTenantResponse.ProtoReflect.Descriptor instead.
+func (*InternalInitTenantResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *InternalInitTenantResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *InternalInitTenantResponse) GetBucketId() string {
+	if x != nil {
+		return x.BucketId
+	}
+	return ""
+}
+
+func (x *InternalInitTenantResponse) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *InternalInitTenantResponse) GetStorageQuota() int64 {
+	if x != nil {
+		return x.StorageQuota
+	}
+	return 0
+}
+
+func (x *InternalInitTenantResponse) GetFileCountQuota() int64 {
+	if x != nil {
+		return x.FileCountQuota
+	}
+	return 0
+}
+
+func (x *InternalInitTenantResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *InternalInitTenantResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *InternalInitTenantResponse) GetAlreadyInitialized() bool {
+	if x != nil {
+		return x.AlreadyInitialized
+	}
+	return false
+}
+
+// InternalGetTenantInitStatusRequest 查询租户初始化状态请求
+type InternalGetTenantInitStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户ID（必填）
+	TenantCode    string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetTenantInitStatusRequest) Reset() {
+	*x = InternalGetTenantInitStatusRequest{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetTenantInitStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetTenantInitStatusRequest) ProtoMessage() {}
+
+func (x *InternalGetTenantInitStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetTenantInitStatusRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetTenantInitStatusRequest) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *InternalGetTenantInitStatusRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+// InternalGetTenantInitStatusResponse 查询租户初始化状态响应
+type InternalGetTenantInitStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 初始化状态：not_initialized|initializing|initialized|failed
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// 存储区域
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	// 存储桶ID
+	BucketId string `protobuf:"bytes,3,opt,name=bucket_id,json=bucketId,proto3" json:"bucket_id,omitempty"`
+	// 存储桶名称
+	BucketName string `protobuf:"bytes,4,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	// 存储配额（字节）
+	StorageQuota int64 `protobuf:"varint,5,opt,name=storage_quota,json=storageQuota,proto3" json:"storage_quota,omitempty"`
+	// 文件数配额
+	FileCountQuota int64 `protobuf:"varint,6,opt,name=file_count_quota,json=fileCountQuota,proto3" json:"file_count_quota,omitempty"`
+	// 初始化完成时间
+	InitializedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=initialized_at,json=initializedAt,proto3" json:"initialized_at,omitempty"`
+	// 失败原因（status=failed时）
+	Error         string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetTenantInitStatusResponse) Reset() {
+	*x = InternalGetTenantInitStatusResponse{}
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetTenantInitStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetTenantInitStatusResponse) ProtoMessage() {}
+
+func (x *InternalGetTenantInitStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_resource_v1_resource_internal_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetTenantInitStatusResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetTenantInitStatusResponse) Descriptor() ([]byte, []int) {
+	return file_resource_v1_resource_internal_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *InternalGetTenantInitStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InternalGetTenantInitStatusResponse) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *InternalGetTenantInitStatusResponse) GetBucketId() string {
+	if x != nil {
+		return x.BucketId
+	}
+	return ""
+}
+
+func (x *InternalGetTenantInitStatusResponse) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *InternalGetTenantInitStatusResponse) GetStorageQuota() int64 {
+	if x != nil {
+		return x.StorageQuota
+	}
+	return 0
+}
+
+func (x *InternalGetTenantInitStatusResponse) GetFileCountQuota() int64 {
+	if x != nil {
+		return x.FileCountQuota
+	}
+	return 0
+}
+
+func (x *InternalGetTenantInitStatusResponse) GetInitializedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.InitializedAt
+	}
+	return nil
+}
+
+func (x *InternalGetTenantInitStatusResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+var File_resource_v1_resource_internal_proto protoreflect.FileDescriptor
+
+const file_resource_v1_resource_internal_proto_rawDesc = "" +
+	"\n" +
+	"#resource/v1/resource_internal.proto\x12\vresource.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa3\x03\n" +
+	"\x10InternalFileInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vtenant_code\x18\x02 \x01(\tR\n" +
 	"tenantCode\x12\x1a\n" +
 	"\bfilename\x18\x03 \x01(\tR\bfilename\x12\x12\n" +
 	"\x04size\x18\x04 \x01(\x03R\x04size\x12!\n" +
@@ -1552,7 +3349,9 @@ const file_resource_v1_resource_internal_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xfc\x02\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x12\n" +
+	"\x04tags\x18\v \x03(\tR\x04tags\x12\x1b\n" +
+	"\tis_public\x18\f \x01(\bR\bisPublic\"\xfc\x02\n" +
 	"\x13InternalFileUrlInfo\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03url\x12T\n" +
 	"\fvariant_urls\x18\x02 \x03(\v21.resource.v1.InternalFileUrlInfo.VariantUrlsEntryR\vvariantUrls\x12\x1b\n" +
@@ -1607,14 +3406,16 @@ const file_resource_v1_resource_internal_proto_rawDesc = "" +
 	"\n" +
 	"FilesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
-	"\x05value\x18\x02 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x05value:\x028\x01\"\xa6\x01\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x05value:\x028\x01\"\xc7\x01\n" +
 	"\x1aInternalGetFileUrlsRequest\x12#\n" +
 	"\vtenant_code\x18\x01 \x01(\tB\x02\x18\x01R\n" +
 	"tenantCode\x12\x19\n" +
 	"\bfile_ids\x18\x02 \x03(\tR\afileIds\x12)\n" +
 	"\x10include_variants\x18\x03 \x01(\bR\x0fincludeVariants\x12\x1d\n" +
 	"\n" +
-	"expires_in\x18\x04 \x01(\x03R\texpiresIn\"\xeb\x01\n" +
+	"expires_in\x18\x04 \x01(\x03R\texpiresIn\x12\x1f\n" +
+	"\vvariant_ids\x18\x05 \x03(\tR\n" +
+	"variantIds\"\xeb\x01\n" +
 	"\x1bInternalGetFileUrlsResponse\x12O\n" +
 	"\aresults\x18\x01 \x03(\v25.resource.v1.InternalGetFileUrlsResponse.ResultsEntryR\aresults\x12\x1d\n" +
 	"\n" +
@@ -1647,7 +3448,131 @@ const file_resource_v1_resource_internal_proto_rawDesc = "" +
 	"\x04size\x18\x03 \x01(\x03R\x04size\"l\n" +
 	"\x1fInternalCheckFileExistsResponse\x12\x16\n" +
 	"\x06exists\x18\x01 \x01(\bR\x06exists\x121\n" +
-	"\x04file\x18\x02 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x04file\":\n" +
+	"\x04file\x18\x02 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x04file\"S\n" +
+	"\x14InternalChecksumItem\x12'\n" +
+	"\x0fchecksum_sha256\x18\x01 \x01(\tR\x0echecksumSha256\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\"z\n" +
+	"\x1eInternalCheckFilesExistRequest\x12\x1f\n" +
+	"\vtenant_code\x18\x01 \x01(\tR\n" +
+	"tenantCode\x127\n" +
+	"\x05items\x18\x02 \x03(\v2!.resource.v1.InternalChecksumItemR\x05items\"\xc9\x01\n" +
+	"\x1fInternalCheckFilesExistResponse\x12M\n" +
+	"\x05files\x18\x01 \x03(\v27.resource.v1.InternalCheckFilesExistResponse.FilesEntryR\x05files\x1aW\n" +
+	"\n" +
+	"FilesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x123\n" +
+	"\x05value\x18\x02 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x05value:\x028\x01\"\xc9\x01\n" +
+	"\x17InternalCopyFileRequest\x12&\n" +
+	"\x0fsrc_tenant_code\x18\x01 \x01(\tR\rsrcTenantCode\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12&\n" +
+	"\x0fdst_tenant_code\x18\x03 \x01(\tR\rdstTenantCode\x12\x1a\n" +
+	"\bfilename\x18\x04 \x01(\tR\bfilename\x12)\n" +
+	"\x10include_variants\x18\x05 \x01(\bR\x0fincludeVariants\"f\n" +
+	"\x18InternalCopyFileResponse\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x121\n" +
+	"\x04file\x18\x02 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x04file\"\xf0\x01\n" +
+	"!InternalUpdateFileMetadataRequest\x12\x1f\n" +
+	"\vtenant_code\x18\x01 \x01(\tR\n" +
+	"tenantCode\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1f\n" +
+	"\bfilename\x18\x03 \x01(\tH\x00R\bfilename\x88\x01\x01\x12\x12\n" +
+	"\x04tags\x18\x04 \x03(\tR\x04tags\x12\x1f\n" +
+	"\vupdate_tags\x18\x05 \x01(\bR\n" +
+	"updateTags\x12 \n" +
+	"\tis_public\x18\x06 \x01(\bH\x01R\bisPublic\x88\x01\x01B\v\n" +
+	"\t_filenameB\f\n" +
+	"\n" +
+	"_is_public\"W\n" +
+	"\"InternalUpdateFileMetadataResponse\x121\n" +
+	"\x04file\x18\x01 \x01(\v2\x1d.resource.v1.InternalFileInfoR\x04file\"\xfb\x01\n" +
+	"\x13InternalVariantSpec\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x01 \x01(\tR\tvariantId\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x14\n" +
+	"\x05width\x18\x03 \x01(\x05R\x05width\x12\x16\n" +
+	"\x06height\x18\x04 \x01(\x05R\x06height\x12\x16\n" +
+	"\x06format\x18\x05 \x01(\tR\x06format\x12\x18\n" +
+	"\aquality\x18\x06 \x01(\x05R\aquality\x12%\n" +
+	"\x0ewatermark_text\x18\a \x01(\tR\rwatermarkText\x12*\n" +
+	"\x11watermark_file_id\x18\b \x01(\tR\x0fwatermarkFileId\"v\n" +
+	"\x15InternalVariantStatus\x12\x1d\n" +
+	"\n" +
+	"variant_id\x18\x01 \x01(\tR\tvariantId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x10\n" +
+	"\x03url\x18\x03 \x01(\tR\x03url\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"\x91\x01\n" +
+	"\x1dInternalCreateVariantsRequest\x12\x1f\n" +
+	"\vtenant_code\x18\x01 \x01(\tR\n" +
+	"tenantCode\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x126\n" +
+	"\x05specs\x18\x03 \x03(\v2 .resource.v1.InternalVariantSpecR\x05specs\"`\n" +
+	"\x1eInternalCreateVariantsResponse\x12>\n" +
+	"\bvariants\x18\x01 \x03(\v2\".resource.v1.InternalVariantStatusR\bvariants\"|\n" +
+	"\x1fInternalGetVariantStatusRequest\x12\x1f\n" +
+	"\vtenant_code\x18\x01 \x01(\tR\n" +
+	"tenantCode\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x1f\n" +
+	"\vvariant_ids\x18\x03 \x03(\tR\n" +
+	"variantIds\"b\n" +
+	" InternalGetVariantStatusResponse\x12>\n" +
+	"\bvariants\x18\x01 \x03(\v2\".resource.v1.InternalVariantStatusR\bvariants\"\x8a\x02\n" +
+	"\x12InternalArchiveJob\x12\x15\n" +
+	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\fdownload_url\x18\x04 \x01(\tR\vdownloadUrl\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x05 \x01(\x03R\texpiresIn\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x1d\n" +
+	"\n" +
+	"file_count\x18\a \x01(\x05R\tfileCount\x12&\n" +
+	"\x0ffailed_file_ids\x18\b \x03(\tR\rfailedFileIds\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\"n\n" +
+	"\x1cInternalCreateArchiveRequest\x12\x1f\n" +
+	"\vtenant_code\x18\x01 \x01(\tR\n" +
+	"tenantCode\x12\x19\n" +
+	"\bfile_ids\x18\x02 \x03(\tR\afileIds\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\"R\n" +
+	"\x1dInternalCreateArchiveResponse\x121\n" +
+	"\x03job\x18\x01 \x01(\v2\x1f.resource.v1.InternalArchiveJobR\x03job\"x\n" +
+	"\x1fInternalGetArchiveStatusRequest\x12\x1f\n" +
+	"\vtenant_code\x18\x01 \x01(\tR\n" +
+	"tenantCode\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\tR\x05jobId\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn\"U\n" +
+	" InternalGetArchiveStatusResponse\x121\n" +
+	"\x03job\x18\x01 \x01(\v2\x1f.resource.v1.InternalArchiveJobR\x03job\"\x9d\x01\n" +
+	"\x15InternalFileReference\x12\x17\n" +
+	"\afile_id\x18\x01 \x01(\tR\x06fileId\x12\x19\n" +
+	"\bref_type\x18\x02 \x01(\tR\arefType\x12\x15\n" +
+	"\x06ref_id\x18\x03 \x01(\tR\x05refId\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8c\x01\n" +
+	"\x1eInternalAttachReferenceRequest\x12\x1f\n" +
+	"\vtenant_code\x18\x01 \x01(\tR\n" +
+	"tenantCode\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x19\n" +
+	"\bref_type\x18\x03 \x01(\tR\arefType\x12\x15\n" +
+	"\x06ref_id\x18\x04 \x01(\tR\x05refId\"J\n" +
+	"\x1fInternalAttachReferenceResponse\x12'\n" +
+	"\x0freference_count\x18\x01 \x01(\x05R\x0ereferenceCount\"\x8c\x01\n" +
+	"\x1eInternalDetachReferenceRequest\x12\x1f\n" +
+	"\vtenant_code\x18\x01 \x01(\tR\n" +
+	"tenantCode\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\x12\x19\n" +
+	"\bref_type\x18\x03 \x01(\tR\arefType\x12\x15\n" +
+	"\x06ref_id\x18\x04 \x01(\tR\x05refId\"J\n" +
+	"\x1fInternalDetachReferenceResponse\x12'\n" +
+	"\x0freference_count\x18\x01 \x01(\x05R\x0ereferenceCount\"Y\n" +
+	"\x1dInternalListReferencesRequest\x12\x1f\n" +
+	"\vtenant_code\x18\x01 \x01(\tR\n" +
+	"tenantCode\x12\x17\n" +
+	"\afile_id\x18\x02 \x01(\tR\x06fileId\"d\n" +
+	"\x1eInternalListReferencesResponse\x12B\n" +
+	"\n" +
+	"references\x18\x01 \x03(\v2\".resource.v1.InternalFileReferenceR\n" +
+	"references\":\n" +
 	"\x17InternalGetQuotaRequest\x12\x1f\n" +
 	"\vtenant_code\x18\x01 \x01(\tR\n" +
 	"tenantCode\"P\n" +
@@ -1662,11 +3587,13 @@ const file_resource_v1_resource_internal_proto_rawDesc = "" +
 	"\x1aInternalCheckQuotaResponse\x12\x18\n" +
 	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\x124\n" +
-	"\x05quota\x18\x03 \x01(\v2\x1e.resource.v1.InternalQuotaInfoR\x05quota\"T\n" +
+	"\x05quota\x18\x03 \x01(\v2\x1e.resource.v1.InternalQuotaInfoR\x05quota\"\xa4\x01\n" +
 	"\x19InternalInitTenantRequest\x12\x1f\n" +
 	"\vtenant_code\x18\x01 \x01(\tR\n" +
 	"tenantCode\x12\x16\n" +
-	"\x06region\x18\x02 \x01(\tR\x06region\"\xf3\x01\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12'\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tR\x0eidempotencyKey\x12%\n" +
+	"\x0eallow_existing\x18\x04 \x01(\bR\rallowExisting\"\xa4\x02\n" +
 	"\x1aInternalInitTenantResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
 	"\tbucket_id\x18\x02 \x01(\tR\bbucketId\x12\x1f\n" +
@@ -1675,16 +3602,41 @@ const file_resource_v1_resource_internal_proto_rawDesc = "" +
 	"\rstorage_quota\x18\x04 \x01(\x03R\fstorageQuota\x12(\n" +
 	"\x10file_count_quota\x18\x05 \x01(\x03R\x0efileCountQuota\x12\x18\n" +
 	"\amessage\x18\x06 \x01(\tR\amessage\x12\x14\n" +
-	"\x05error\x18\a \x01(\tR\x05error2\xdd\x06\n" +
+	"\x05error\x18\a \x01(\tR\x05error\x12/\n" +
+	"\x13already_initialized\x18\b \x01(\bR\x12alreadyInitialized\"E\n" +
+	"\"InternalGetTenantInitStatusRequest\x12\x1f\n" +
+	"\vtenant_code\x18\x01 \x01(\tR\n" +
+	"tenantCode\"\xbb\x02\n" +
+	"#InternalGetTenantInitStatusResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12\x1b\n" +
+	"\tbucket_id\x18\x03 \x01(\tR\bbucketId\x12\x1f\n" +
+	"\vbucket_name\x18\x04 \x01(\tR\n" +
+	"bucketName\x12#\n" +
+	"\rstorage_quota\x18\x05 \x01(\x03R\fstorageQuota\x12(\n" +
+	"\x10file_count_quota\x18\x06 \x01(\x03R\x0efileCountQuota\x12A\n" +
+	"\x0einitialized_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\rinitializedAt\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error2\xea\x10\n" +
 	"\x17ResourceInternalService\x12\\\n" +
 	"\x0fInternalGetFile\x12#.resource.v1.InternalGetFileRequest\x1a$.resource.v1.InternalGetFileResponse\x12_\n" +
 	"\x10InternalGetFiles\x12$.resource.v1.InternalGetFilesRequest\x1a%.resource.v1.InternalGetFilesResponse\x12h\n" +
 	"\x13InternalGetFileUrls\x12'.resource.v1.InternalGetFileUrlsRequest\x1a(.resource.v1.InternalGetFileUrlsResponse\x12t\n" +
 	"\x17InternalGetDownloadUrls\x12+.resource.v1.InternalGetDownloadUrlsRequest\x1a,.resource.v1.InternalGetDownloadUrlsResponse\x12t\n" +
-	"\x17InternalCheckFileExists\x12+.resource.v1.InternalCheckFileExistsRequest\x1a,.resource.v1.InternalCheckFileExistsResponse\x12_\n" +
+	"\x17InternalCheckFileExists\x12+.resource.v1.InternalCheckFileExistsRequest\x1a,.resource.v1.InternalCheckFileExistsResponse\x12t\n" +
+	"\x17InternalCheckFilesExist\x12+.resource.v1.InternalCheckFilesExistRequest\x1a,.resource.v1.InternalCheckFilesExistResponse\x12_\n" +
+	"\x10InternalCopyFile\x12$.resource.v1.InternalCopyFileRequest\x1a%.resource.v1.InternalCopyFileResponse\x12}\n" +
+	"\x1aInternalUpdateFileMetadata\x12..resource.v1.InternalUpdateFileMetadataRequest\x1a/.resource.v1.InternalUpdateFileMetadataResponse\x12q\n" +
+	"\x16InternalCreateVariants\x12*.resource.v1.InternalCreateVariantsRequest\x1a+.resource.v1.InternalCreateVariantsResponse\x12w\n" +
+	"\x18InternalGetVariantStatus\x12,.resource.v1.InternalGetVariantStatusRequest\x1a-.resource.v1.InternalGetVariantStatusResponse\x12n\n" +
+	"\x15InternalCreateArchive\x12).resource.v1.InternalCreateArchiveRequest\x1a*.resource.v1.InternalCreateArchiveResponse\x12w\n" +
+	"\x18InternalGetArchiveStatus\x12,.resource.v1.InternalGetArchiveStatusRequest\x1a-.resource.v1.InternalGetArchiveStatusResponse\x12t\n" +
+	"\x17InternalAttachReference\x12+.resource.v1.InternalAttachReferenceRequest\x1a,.resource.v1.InternalAttachReferenceResponse\x12t\n" +
+	"\x17InternalDetachReference\x12+.resource.v1.InternalDetachReferenceRequest\x1a,.resource.v1.InternalDetachReferenceResponse\x12q\n" +
+	"\x16InternalListReferences\x12*.resource.v1.InternalListReferencesRequest\x1a+.resource.v1.InternalListReferencesResponse\x12_\n" +
 	"\x10InternalGetQuota\x12$.resource.v1.InternalGetQuotaRequest\x1a%.resource.v1.InternalGetQuotaResponse\x12e\n" +
 	"\x12InternalCheckQuota\x12&.resource.v1.InternalCheckQuotaRequest\x1a'.resource.v1.InternalCheckQuotaResponse\x12e\n" +
-	"\x12InternalInitTenant\x12&.resource.v1.InternalInitTenantRequest\x1a'.resource.v1.InternalInitTenantResponseB\xb3\x01\n" +
+	"\x12InternalInitTenant\x12&.resource.v1.InternalInitTenantRequest\x1a'.resource.v1.InternalInitTenantResponse\x12\x80\x01\n" +
+	"\x1bInternalGetTenantInitStatus\x12/.resource.v1.InternalGetTenantInitStatusRequest\x1a0.resource.v1.InternalGetTenantInitStatusResponseB\xb3\x01\n" +
 	"\x0fcom.resource.v1B\x15ResourceInternalProtoP\x01Z<github.com/heyinLab/common/api/gen/go/resource/v1;resourcev1\xa2\x02\x03RXX\xaa\x02\vResource.V1\xca\x02\vResource\\V1\xe2\x02\x17Resource\\V1\\GPBMetadata\xea\x02\fResource::V1b\x06proto3"
 
 var (
@@ -1699,71 +3651,134 @@ func file_resource_v1_resource_internal_proto_rawDescGZIP() []byte {
 	return file_resource_v1_resource_internal_proto_rawDescData
 }
 
-var file_resource_v1_resource_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 25)
+var file_resource_v1_resource_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_resource_v1_resource_internal_proto_goTypes = []any{
-	(*InternalFileInfo)(nil),                // 0: resource.v1.InternalFileInfo
-	(*InternalFileUrlInfo)(nil),             // 1: resource.v1.InternalFileUrlInfo
-	(*InternalFileDownloadInfo)(nil),        // 2: resource.v1.InternalFileDownloadInfo
-	(*InternalQuotaInfo)(nil),               // 3: resource.v1.InternalQuotaInfo
-	(*InternalGetFileRequest)(nil),          // 4: resource.v1.InternalGetFileRequest
-	(*InternalGetFileResponse)(nil),         // 5: resource.v1.InternalGetFileResponse
-	(*InternalGetFilesRequest)(nil),         // 6: resource.v1.InternalGetFilesRequest
-	(*InternalGetFilesResponse)(nil),        // 7: resource.v1.InternalGetFilesResponse
-	(*InternalGetFileUrlsRequest)(nil),      // 8: resource.v1.InternalGetFileUrlsRequest
-	(*InternalGetFileUrlsResponse)(nil),     // 9: resource.v1.InternalGetFileUrlsResponse
-	(*InternalFileDownloadRequest)(nil),     // 10: resource.v1.InternalFileDownloadRequest
-	(*InternalGetDownloadUrlsRequest)(nil),  // 11: resource.v1.InternalGetDownloadUrlsRequest
-	(*InternalGetDownloadUrlsResponse)(nil), // 12: resource.v1.InternalGetDownloadUrlsResponse
-	(*InternalCheckFileExistsRequest)(nil),  // 13: resource.v1.InternalCheckFileExistsRequest
-	(*InternalCheckFileExistsResponse)(nil), // 14: resource.v1.InternalCheckFileExistsResponse
-	(*InternalGetQuotaRequest)(nil),         // 15: resource.v1.InternalGetQuotaRequest
-	(*InternalGetQuotaResponse)(nil),        // 16: resource.v1.InternalGetQuotaResponse
-	(*InternalCheckQuotaRequest)(nil),       // 17: resource.v1.InternalCheckQuotaRequest
-	(*InternalCheckQuotaResponse)(nil),      // 18: resource.v1.InternalCheckQuotaResponse
-	(*InternalInitTenantRequest)(nil),       // 19: resource.v1.InternalInitTenantRequest
-	(*InternalInitTenantResponse)(nil),      // 20: resource.v1.InternalInitTenantResponse
-	nil,                                     // 21: resource.v1.InternalFileUrlInfo.VariantUrlsEntry
-	nil,                                     // 22: resource.v1.InternalGetFilesResponse.FilesEntry
-	nil,                                     // 23: resource.v1.InternalGetFileUrlsResponse.ResultsEntry
-	nil,                                     // 24: resource.v1.InternalGetDownloadUrlsResponse.ResultsEntry
-	(*timestamppb.Timestamp)(nil),           // 25: google.protobuf.Timestamp
+	(*InternalFileInfo)(nil),                    // 0: resource.v1.InternalFileInfo
+	(*InternalFileUrlInfo)(nil),                 // 1: resource.v1.InternalFileUrlInfo
+	(*InternalFileDownloadInfo)(nil),            // 2: resource.v1.InternalFileDownloadInfo
+	(*InternalQuotaInfo)(nil),                   // 3: resource.v1.InternalQuotaInfo
+	(*InternalGetFileRequest)(nil),              // 4: resource.v1.InternalGetFileRequest
+	(*InternalGetFileResponse)(nil),             // 5: resource.v1.InternalGetFileResponse
+	(*InternalGetFilesRequest)(nil),             // 6: resource.v1.InternalGetFilesRequest
+	(*InternalGetFilesResponse)(nil),            // 7: resource.v1.InternalGetFilesResponse
+	(*InternalGetFileUrlsRequest)(nil),          // 8: resource.v1.InternalGetFileUrlsRequest
+	(*InternalGetFileUrlsResponse)(nil),         // 9: resource.v1.InternalGetFileUrlsResponse
+	(*InternalFileDownloadRequest)(nil),         // 10: resource.v1.InternalFileDownloadRequest
+	(*InternalGetDownloadUrlsRequest)(nil),      // 11: resource.v1.InternalGetDownloadUrlsRequest
+	(*InternalGetDownloadUrlsResponse)(nil),     // 12: resource.v1.InternalGetDownloadUrlsResponse
+	(*InternalCheckFileExistsRequest)(nil),      // 13: resource.v1.InternalCheckFileExistsRequest
+	(*InternalCheckFileExistsResponse)(nil),     // 14: resource.v1.InternalCheckFileExistsResponse
+	(*InternalChecksumItem)(nil),                // 15: resource.v1.InternalChecksumItem
+	(*InternalCheckFilesExistRequest)(nil),      // 16: resource.v1.InternalCheckFilesExistRequest
+	(*InternalCheckFilesExistResponse)(nil),     // 17: resource.v1.InternalCheckFilesExistResponse
+	(*InternalCopyFileRequest)(nil),             // 18: resource.v1.InternalCopyFileRequest
+	(*InternalCopyFileResponse)(nil),            // 19: resource.v1.InternalCopyFileResponse
+	(*InternalUpdateFileMetadataRequest)(nil),   // 20: resource.v1.InternalUpdateFileMetadataRequest
+	(*InternalUpdateFileMetadataResponse)(nil),  // 21: resource.v1.InternalUpdateFileMetadataResponse
+	(*InternalVariantSpec)(nil),                 // 22: resource.v1.InternalVariantSpec
+	(*InternalVariantStatus)(nil),               // 23: resource.v1.InternalVariantStatus
+	(*InternalCreateVariantsRequest)(nil),       // 24: resource.v1.InternalCreateVariantsRequest
+	(*InternalCreateVariantsResponse)(nil),      // 25: resource.v1.InternalCreateVariantsResponse
+	(*InternalGetVariantStatusRequest)(nil),     // 26: resource.v1.InternalGetVariantStatusRequest
+	(*InternalGetVariantStatusResponse)(nil),    // 27: resource.v1.InternalGetVariantStatusResponse
+	(*InternalArchiveJob)(nil),                  // 28: resource.v1.InternalArchiveJob
+	(*InternalCreateArchiveRequest)(nil),        // 29: resource.v1.InternalCreateArchiveRequest
+	(*InternalCreateArchiveResponse)(nil),       // 30: resource.v1.InternalCreateArchiveResponse
+	(*InternalGetArchiveStatusRequest)(nil),     // 31: resource.v1.InternalGetArchiveStatusRequest
+	(*InternalGetArchiveStatusResponse)(nil),    // 32: resource.v1.InternalGetArchiveStatusResponse
+	(*InternalFileReference)(nil),               // 33: resource.v1.InternalFileReference
+	(*InternalAttachReferenceRequest)(nil),      // 34: resource.v1.InternalAttachReferenceRequest
+	(*InternalAttachReferenceResponse)(nil),     // 35: resource.v1.InternalAttachReferenceResponse
+	(*InternalDetachReferenceRequest)(nil),      // 36: resource.v1.InternalDetachReferenceRequest
+	(*InternalDetachReferenceResponse)(nil),     // 37: resource.v1.InternalDetachReferenceResponse
+	(*InternalListReferencesRequest)(nil),       // 38: resource.v1.InternalListReferencesRequest
+	(*InternalListReferencesResponse)(nil),      // 39: resource.v1.InternalListReferencesResponse
+	(*InternalGetQuotaRequest)(nil),             // 40: resource.v1.InternalGetQuotaRequest
+	(*InternalGetQuotaResponse)(nil),            // 41: resource.v1.InternalGetQuotaResponse
+	(*InternalCheckQuotaRequest)(nil),           // 42: resource.v1.InternalCheckQuotaRequest
+	(*InternalCheckQuotaResponse)(nil),          // 43: resource.v1.InternalCheckQuotaResponse
+	(*InternalInitTenantRequest)(nil),           // 44: resource.v1.InternalInitTenantRequest
+	(*InternalInitTenantResponse)(nil),          // 45: resource.v1.InternalInitTenantResponse
+	(*InternalGetTenantInitStatusRequest)(nil),  // 46: resource.v1.InternalGetTenantInitStatusRequest
+	(*InternalGetTenantInitStatusResponse)(nil), // 47: resource.v1.InternalGetTenantInitStatusResponse
+	nil,                           // 48: resource.v1.InternalFileUrlInfo.VariantUrlsEntry
+	nil,                           // 49: resource.v1.InternalGetFilesResponse.FilesEntry
+	nil,                           // 50: resource.v1.InternalGetFileUrlsResponse.ResultsEntry
+	nil,                           // 51: resource.v1.InternalGetDownloadUrlsResponse.ResultsEntry
+	nil,                           // 52: resource.v1.InternalCheckFilesExistResponse.FilesEntry
+	(*timestamppb.Timestamp)(nil), // 53: google.protobuf.Timestamp
 }
 var file_resource_v1_resource_internal_proto_depIdxs = []int32{
-	25, // 0: resource.v1.InternalFileInfo.created_at:type_name -> google.protobuf.Timestamp
-	25, // 1: resource.v1.InternalFileInfo.updated_at:type_name -> google.protobuf.Timestamp
-	21, // 2: resource.v1.InternalFileUrlInfo.variant_urls:type_name -> resource.v1.InternalFileUrlInfo.VariantUrlsEntry
+	53, // 0: resource.v1.InternalFileInfo.created_at:type_name -> google.protobuf.Timestamp
+	53, // 1: resource.v1.InternalFileInfo.updated_at:type_name -> google.protobuf.Timestamp
+	48, // 2: resource.v1.InternalFileUrlInfo.variant_urls:type_name -> resource.v1.InternalFileUrlInfo.VariantUrlsEntry
 	0,  // 3: resource.v1.InternalGetFileResponse.file:type_name -> resource.v1.InternalFileInfo
-	22, // 4: resource.v1.InternalGetFilesResponse.files:type_name -> resource.v1.InternalGetFilesResponse.FilesEntry
-	23, // 5: resource.v1.InternalGetFileUrlsResponse.results:type_name -> resource.v1.InternalGetFileUrlsResponse.ResultsEntry
+	49, // 4: resource.v1.InternalGetFilesResponse.files:type_name -> resource.v1.InternalGetFilesResponse.FilesEntry
+	50, // 5: resource.v1.InternalGetFileUrlsResponse.results:type_name -> resource.v1.InternalGetFileUrlsResponse.ResultsEntry
 	10, // 6: resource.v1.InternalGetDownloadUrlsRequest.files:type_name -> resource.v1.InternalFileDownloadRequest
-	24, // 7: resource.v1.InternalGetDownloadUrlsResponse.results:type_name -> resource.v1.InternalGetDownloadUrlsResponse.ResultsEntry
+	51, // 7: resource.v1.InternalGetDownloadUrlsResponse.results:type_name -> resource.v1.InternalGetDownloadUrlsResponse.ResultsEntry
 	0,  // 8: resource.v1.InternalCheckFileExistsResponse.file:type_name -> resource.v1.InternalFileInfo
-	3,  // 9: resource.v1.InternalGetQuotaResponse.quota:type_name -> resource.v1.InternalQuotaInfo
-	3,  // 10: resource.v1.InternalCheckQuotaResponse.quota:type_name -> resource.v1.InternalQuotaInfo
-	0,  // 11: resource.v1.InternalGetFilesResponse.FilesEntry.value:type_name -> resource.v1.InternalFileInfo
-	1,  // 12: resource.v1.InternalGetFileUrlsResponse.ResultsEntry.value:type_name -> resource.v1.InternalFileUrlInfo
-	2,  // 13: resource.v1.InternalGetDownloadUrlsResponse.ResultsEntry.value:type_name -> resource.v1.InternalFileDownloadInfo
-	4,  // 14: resource.v1.ResourceInternalService.InternalGetFile:input_type -> resource.v1.InternalGetFileRequest
-	6,  // 15: resource.v1.ResourceInternalService.InternalGetFiles:input_type -> resource.v1.InternalGetFilesRequest
-	8,  // 16: resource.v1.ResourceInternalService.InternalGetFileUrls:input_type -> resource.v1.InternalGetFileUrlsRequest
-	11, // 17: resource.v1.ResourceInternalService.InternalGetDownloadUrls:input_type -> resource.v1.InternalGetDownloadUrlsRequest
-	13, // 18: resource.v1.ResourceInternalService.InternalCheckFileExists:input_type -> resource.v1.InternalCheckFileExistsRequest
-	15, // 19: resource.v1.ResourceInternalService.InternalGetQuota:input_type -> resource.v1.InternalGetQuotaRequest
-	17, // 20: resource.v1.ResourceInternalService.InternalCheckQuota:input_type -> resource.v1.InternalCheckQuotaRequest
-	19, // 21: resource.v1.ResourceInternalService.InternalInitTenant:input_type -> resource.v1.InternalInitTenantRequest
-	5,  // 22: resource.v1.ResourceInternalService.InternalGetFile:output_type -> resource.v1.InternalGetFileResponse
-	7,  // 23: resource.v1.ResourceInternalService.InternalGetFiles:output_type -> resource.v1.InternalGetFilesResponse
-	9,  // 24: resource.v1.ResourceInternalService.InternalGetFileUrls:output_type -> resource.v1.InternalGetFileUrlsResponse
-	12, // 25: resource.v1.ResourceInternalService.InternalGetDownloadUrls:output_type -> resource.v1.InternalGetDownloadUrlsResponse
-	14, // 26: resource.v1.ResourceInternalService.InternalCheckFileExists:output_type -> resource.v1.InternalCheckFileExistsResponse
-	16, // 27: resource.v1.ResourceInternalService.InternalGetQuota:output_type -> resource.v1.InternalGetQuotaResponse
-	18, // 28: resource.v1.ResourceInternalService.InternalCheckQuota:output_type -> resource.v1.InternalCheckQuotaResponse
-	20, // 29: resource.v1.ResourceInternalService.InternalInitTenant:output_type -> resource.v1.InternalInitTenantResponse
-	22, // [22:30] is the sub-list for method output_type
-	14, // [14:22] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	15, // 9: resource.v1.InternalCheckFilesExistRequest.items:type_name -> resource.v1.InternalChecksumItem
+	52, // 10: resource.v1.InternalCheckFilesExistResponse.files:type_name -> resource.v1.InternalCheckFilesExistResponse.FilesEntry
+	0,  // 11: resource.v1.InternalCopyFileResponse.file:type_name -> resource.v1.InternalFileInfo
+	0,  // 12: resource.v1.InternalUpdateFileMetadataResponse.file:type_name -> resource.v1.InternalFileInfo
+	22, // 13: resource.v1.InternalCreateVariantsRequest.specs:type_name -> resource.v1.InternalVariantSpec
+	23, // 14: resource.v1.InternalCreateVariantsResponse.variants:type_name -> resource.v1.InternalVariantStatus
+	23, // 15: resource.v1.InternalGetVariantStatusResponse.variants:type_name -> resource.v1.InternalVariantStatus
+	28, // 16: resource.v1.InternalCreateArchiveResponse.job:type_name -> resource.v1.InternalArchiveJob
+	28, // 17: resource.v1.InternalGetArchiveStatusResponse.job:type_name -> resource.v1.InternalArchiveJob
+	53, // 18: resource.v1.InternalFileReference.created_at:type_name -> google.protobuf.Timestamp
+	33, // 19: resource.v1.InternalListReferencesResponse.references:type_name -> resource.v1.InternalFileReference
+	3,  // 20: resource.v1.InternalGetQuotaResponse.quota:type_name -> resource.v1.InternalQuotaInfo
+	3,  // 21: resource.v1.InternalCheckQuotaResponse.quota:type_name -> resource.v1.InternalQuotaInfo
+	53, // 22: resource.v1.InternalGetTenantInitStatusResponse.initialized_at:type_name -> google.protobuf.Timestamp
+	0,  // 23: resource.v1.InternalGetFilesResponse.FilesEntry.value:type_name -> resource.v1.InternalFileInfo
+	1,  // 24: resource.v1.InternalGetFileUrlsResponse.ResultsEntry.value:type_name -> resource.v1.InternalFileUrlInfo
+	2,  // 25: resource.v1.InternalGetDownloadUrlsResponse.ResultsEntry.value:type_name -> resource.v1.InternalFileDownloadInfo
+	0,  // 26: resource.v1.InternalCheckFilesExistResponse.FilesEntry.value:type_name -> resource.v1.InternalFileInfo
+	4,  // 27: resource.v1.ResourceInternalService.InternalGetFile:input_type -> resource.v1.InternalGetFileRequest
+	6,  // 28: resource.v1.ResourceInternalService.InternalGetFiles:input_type -> resource.v1.InternalGetFilesRequest
+	8,  // 29: resource.v1.ResourceInternalService.InternalGetFileUrls:input_type -> resource.v1.InternalGetFileUrlsRequest
+	11, // 30: resource.v1.ResourceInternalService.InternalGetDownloadUrls:input_type -> resource.v1.InternalGetDownloadUrlsRequest
+	13, // 31: resource.v1.ResourceInternalService.InternalCheckFileExists:input_type -> resource.v1.InternalCheckFileExistsRequest
+	16, // 32: resource.v1.ResourceInternalService.InternalCheckFilesExist:input_type -> resource.v1.InternalCheckFilesExistRequest
+	18, // 33: resource.v1.ResourceInternalService.InternalCopyFile:input_type -> resource.v1.InternalCopyFileRequest
+	20, // 34: resource.v1.ResourceInternalService.InternalUpdateFileMetadata:input_type -> resource.v1.InternalUpdateFileMetadataRequest
+	24, // 35: resource.v1.ResourceInternalService.InternalCreateVariants:input_type -> resource.v1.InternalCreateVariantsRequest
+	26, // 36: resource.v1.ResourceInternalService.InternalGetVariantStatus:input_type -> resource.v1.InternalGetVariantStatusRequest
+	29, // 37: resource.v1.ResourceInternalService.InternalCreateArchive:input_type -> resource.v1.InternalCreateArchiveRequest
+	31, // 38: resource.v1.ResourceInternalService.InternalGetArchiveStatus:input_type -> resource.v1.InternalGetArchiveStatusRequest
+	34, // 39: resource.v1.ResourceInternalService.InternalAttachReference:input_type -> resource.v1.InternalAttachReferenceRequest
+	36, // 40: resource.v1.ResourceInternalService.InternalDetachReference:input_type -> resource.v1.InternalDetachReferenceRequest
+	38, // 41: resource.v1.ResourceInternalService.InternalListReferences:input_type -> resource.v1.InternalListReferencesRequest
+	40, // 42: resource.v1.ResourceInternalService.InternalGetQuota:input_type -> resource.v1.InternalGetQuotaRequest
+	42, // 43: resource.v1.ResourceInternalService.InternalCheckQuota:input_type -> resource.v1.InternalCheckQuotaRequest
+	44, // 44: resource.v1.ResourceInternalService.InternalInitTenant:input_type -> resource.v1.InternalInitTenantRequest
+	46, // 45: resource.v1.ResourceInternalService.InternalGetTenantInitStatus:input_type -> resource.v1.InternalGetTenantInitStatusRequest
+	5,  // 46: resource.v1.ResourceInternalService.InternalGetFile:output_type -> resource.v1.InternalGetFileResponse
+	7,  // 47: resource.v1.ResourceInternalService.InternalGetFiles:output_type -> resource.v1.InternalGetFilesResponse
+	9,  // 48: resource.v1.ResourceInternalService.InternalGetFileUrls:output_type -> resource.v1.InternalGetFileUrlsResponse
+	12, // 49: resource.v1.ResourceInternalService.InternalGetDownloadUrls:output_type -> resource.v1.InternalGetDownloadUrlsResponse
+	14, // 50: resource.v1.ResourceInternalService.InternalCheckFileExists:output_type -> resource.v1.InternalCheckFileExistsResponse
+	17, // 51: resource.v1.ResourceInternalService.InternalCheckFilesExist:output_type -> resource.v1.InternalCheckFilesExistResponse
+	19, // 52: resource.v1.ResourceInternalService.InternalCopyFile:output_type -> resource.v1.InternalCopyFileResponse
+	21, // 53: resource.v1.ResourceInternalService.InternalUpdateFileMetadata:output_type -> resource.v1.InternalUpdateFileMetadataResponse
+	25, // 54: resource.v1.ResourceInternalService.InternalCreateVariants:output_type -> resource.v1.InternalCreateVariantsResponse
+	27, // 55: resource.v1.ResourceInternalService.InternalGetVariantStatus:output_type -> resource.v1.InternalGetVariantStatusResponse
+	30, // 56: resource.v1.ResourceInternalService.InternalCreateArchive:output_type -> resource.v1.InternalCreateArchiveResponse
+	32, // 57: resource.v1.ResourceInternalService.InternalGetArchiveStatus:output_type -> resource.v1.InternalGetArchiveStatusResponse
+	35, // 58: resource.v1.ResourceInternalService.InternalAttachReference:output_type -> resource.v1.InternalAttachReferenceResponse
+	37, // 59: resource.v1.ResourceInternalService.InternalDetachReference:output_type -> resource.v1.InternalDetachReferenceResponse
+	39, // 60: resource.v1.ResourceInternalService.InternalListReferences:output_type -> resource.v1.InternalListReferencesResponse
+	41, // 61: resource.v1.ResourceInternalService.InternalGetQuota:output_type -> resource.v1.InternalGetQuotaResponse
+	43, // 62: resource.v1.ResourceInternalService.InternalCheckQuota:output_type -> resource.v1.InternalCheckQuotaResponse
+	45, // 63: resource.v1.ResourceInternalService.InternalInitTenant:output_type -> resource.v1.InternalInitTenantResponse
+	47, // 64: resource.v1.ResourceInternalService.InternalGetTenantInitStatus:output_type -> resource.v1.InternalGetTenantInitStatusResponse
+	46, // [46:65] is the sub-list for method output_type
+	27, // [27:46] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_resource_v1_resource_internal_proto_init() }
@@ -1771,13 +3786,14 @@ func file_resource_v1_resource_internal_proto_init() {
 	if File_resource_v1_resource_internal_proto != nil {
 		return
 	}
+	file_resource_v1_resource_internal_proto_msgTypes[20].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resource_v1_resource_internal_proto_rawDesc), len(file_resource_v1_resource_internal_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   25,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -131,6 +131,8 @@ func (m *InternalFileInfo) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for IsPublic
+
 	if len(errors) > 0 {
 		return InternalFileInfoMultiError(errors)
 	}
@@ -1961,44 +1963,46 @@ var _ interface {
 	ErrorName() string
 } = InternalCheckFileExistsResponseValidationError{}
 
-// Validate checks the field values on InternalGetQuotaRequest with the rules
+// Validate checks the field values on InternalChecksumItem with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InternalGetQuotaRequest) Validate() error {
+func (m *InternalChecksumItem) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InternalGetQuotaRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on InternalChecksumItem with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InternalGetQuotaRequestMultiError, or nil if none found.
-func (m *InternalGetQuotaRequest) ValidateAll() error {
+// InternalChecksumItemMultiError, or nil if none found.
+func (m *InternalChecksumItem) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InternalGetQuotaRequest) validate(all bool) error {
+func (m *InternalChecksumItem) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantCode
+	// no validation rules for ChecksumSha256
+
+	// no validation rules for Size
 
 	if len(errors) > 0 {
-		return InternalGetQuotaRequestMultiError(errors)
+		return InternalChecksumItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// InternalGetQuotaRequestMultiError is an error wrapping multiple validation
-// errors returned by InternalGetQuotaRequest.ValidateAll() if the designated
+// InternalChecksumItemMultiError is an error wrapping multiple validation
+// errors returned by InternalChecksumItem.ValidateAll() if the designated
 // constraints aren't met.
-type InternalGetQuotaRequestMultiError []error
+type InternalChecksumItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InternalGetQuotaRequestMultiError) Error() string {
+func (m InternalChecksumItemMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2007,11 +2011,11 @@ func (m InternalGetQuotaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InternalGetQuotaRequestMultiError) AllErrors() []error { return m }
+func (m InternalChecksumItemMultiError) AllErrors() []error { return m }
 
-// InternalGetQuotaRequestValidationError is the validation error returned by
-// InternalGetQuotaRequest.Validate if the designated constraints aren't met.
-type InternalGetQuotaRequestValidationError struct {
+// InternalChecksumItemValidationError is the validation error returned by
+// InternalChecksumItem.Validate if the designated constraints aren't met.
+type InternalChecksumItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2019,24 +2023,24 @@ type InternalGetQuotaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InternalGetQuotaRequestValidationError) Field() string { return e.field }
+func (e InternalChecksumItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InternalGetQuotaRequestValidationError) Reason() string { return e.reason }
+func (e InternalChecksumItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InternalGetQuotaRequestValidationError) Cause() error { return e.cause }
+func (e InternalChecksumItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InternalGetQuotaRequestValidationError) Key() bool { return e.key }
+func (e InternalChecksumItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InternalGetQuotaRequestValidationError) ErrorName() string {
-	return "InternalGetQuotaRequestValidationError"
+func (e InternalChecksumItemValidationError) ErrorName() string {
+	return "InternalChecksumItemValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InternalGetQuotaRequestValidationError) Error() string {
+func (e InternalChecksumItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2048,14 +2052,14 @@ func (e InternalGetQuotaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInternalGetQuotaRequest.%s: %s%s",
+		"invalid %sInternalChecksumItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InternalGetQuotaRequestValidationError{}
+var _ error = InternalChecksumItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -2063,73 +2067,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InternalGetQuotaRequestValidationError{}
+} = InternalChecksumItemValidationError{}
 
-// Validate checks the field values on InternalGetQuotaResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on InternalCheckFilesExistRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InternalGetQuotaResponse) Validate() error {
+func (m *InternalCheckFilesExistRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InternalGetQuotaResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// InternalGetQuotaResponseMultiError, or nil if none found.
-func (m *InternalGetQuotaResponse) ValidateAll() error {
+// ValidateAll checks the field values on InternalCheckFilesExistRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalCheckFilesExistRequestMultiError, or nil if none found.
+func (m *InternalCheckFilesExistRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InternalGetQuotaResponse) validate(all bool) error {
+func (m *InternalCheckFilesExistRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetQuota()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InternalGetQuotaResponseValidationError{
-					field:  "Quota",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for TenantCode
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalCheckFilesExistRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalCheckFilesExistRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, InternalGetQuotaResponseValidationError{
-					field:  "Quota",
+				return InternalCheckFilesExistRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InternalGetQuotaResponseValidationError{
-				field:  "Quota",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return InternalGetQuotaResponseMultiError(errors)
+		return InternalCheckFilesExistRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// InternalGetQuotaResponseMultiError is an error wrapping multiple validation
-// errors returned by InternalGetQuotaResponse.ValidateAll() if the designated
-// constraints aren't met.
-type InternalGetQuotaResponseMultiError []error
+// InternalCheckFilesExistRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalCheckFilesExistRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalCheckFilesExistRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InternalGetQuotaResponseMultiError) Error() string {
+func (m InternalCheckFilesExistRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2138,11 +2149,12 @@ func (m InternalGetQuotaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InternalGetQuotaResponseMultiError) AllErrors() []error { return m }
+func (m InternalCheckFilesExistRequestMultiError) AllErrors() []error { return m }
 
-// InternalGetQuotaResponseValidationError is the validation error returned by
-// InternalGetQuotaResponse.Validate if the designated constraints aren't met.
-type InternalGetQuotaResponseValidationError struct {
+// InternalCheckFilesExistRequestValidationError is the validation error
+// returned by InternalCheckFilesExistRequest.Validate if the designated
+// constraints aren't met.
+type InternalCheckFilesExistRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2150,24 +2162,24 @@ type InternalGetQuotaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e InternalGetQuotaResponseValidationError) Field() string { return e.field }
+func (e InternalCheckFilesExistRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InternalGetQuotaResponseValidationError) Reason() string { return e.reason }
+func (e InternalCheckFilesExistRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InternalGetQuotaResponseValidationError) Cause() error { return e.cause }
+func (e InternalCheckFilesExistRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InternalGetQuotaResponseValidationError) Key() bool { return e.key }
+func (e InternalCheckFilesExistRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InternalGetQuotaResponseValidationError) ErrorName() string {
-	return "InternalGetQuotaResponseValidationError"
+func (e InternalCheckFilesExistRequestValidationError) ErrorName() string {
+	return "InternalCheckFilesExistRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InternalGetQuotaResponseValidationError) Error() string {
+func (e InternalCheckFilesExistRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2179,14 +2191,14 @@ func (e InternalGetQuotaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInternalGetQuotaResponse.%s: %s%s",
+		"invalid %sInternalCheckFilesExistRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InternalGetQuotaResponseValidationError{}
+var _ error = InternalCheckFilesExistRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2194,50 +2206,90 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InternalGetQuotaResponseValidationError{}
+} = InternalCheckFilesExistRequestValidationError{}
 
-// Validate checks the field values on InternalCheckQuotaRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on InternalCheckFilesExistResponse with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InternalCheckQuotaRequest) Validate() error {
+func (m *InternalCheckFilesExistResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InternalCheckQuotaRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// InternalCheckQuotaRequestMultiError, or nil if none found.
-func (m *InternalCheckQuotaRequest) ValidateAll() error {
+// ValidateAll checks the field values on InternalCheckFilesExistResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalCheckFilesExistResponseMultiError, or nil if none found.
+func (m *InternalCheckFilesExistResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InternalCheckQuotaRequest) validate(all bool) error {
+func (m *InternalCheckFilesExistResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantCode
+	{
+		sorted_keys := make([]string, len(m.GetFiles()))
+		i := 0
+		for key := range m.GetFiles() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetFiles()[key]
+			_ = val
 
-	// no validation rules for CheckType
+			// no validation rules for Files[key]
 
-	// no validation rules for Size
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, InternalCheckFilesExistResponseValidationError{
+							field:  fmt.Sprintf("Files[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, InternalCheckFilesExistResponseValidationError{
+							field:  fmt.Sprintf("Files[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return InternalCheckFilesExistResponseValidationError{
+						field:  fmt.Sprintf("Files[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
 
 	if len(errors) > 0 {
-		return InternalCheckQuotaRequestMultiError(errors)
+		return InternalCheckFilesExistResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// InternalCheckQuotaRequestMultiError is an error wrapping multiple validation
-// errors returned by InternalCheckQuotaRequest.ValidateAll() if the
-// designated constraints aren't met.
-type InternalCheckQuotaRequestMultiError []error
+// InternalCheckFilesExistResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalCheckFilesExistResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalCheckFilesExistResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InternalCheckQuotaRequestMultiError) Error() string {
+func (m InternalCheckFilesExistResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2246,11 +2298,12 @@ func (m InternalCheckQuotaRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InternalCheckQuotaRequestMultiError) AllErrors() []error { return m }
+func (m InternalCheckFilesExistResponseMultiError) AllErrors() []error { return m }
 
-// InternalCheckQuotaRequestValidationError is the validation error returned by
-// InternalCheckQuotaRequest.Validate if the designated constraints aren't met.
-type InternalCheckQuotaRequestValidationError struct {
+// InternalCheckFilesExistResponseValidationError is the validation error
+// returned by InternalCheckFilesExistResponse.Validate if the designated
+// constraints aren't met.
+type InternalCheckFilesExistResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2258,24 +2311,24 @@ type InternalCheckQuotaRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InternalCheckQuotaRequestValidationError) Field() string { return e.field }
+func (e InternalCheckFilesExistResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InternalCheckQuotaRequestValidationError) Reason() string { return e.reason }
+func (e InternalCheckFilesExistResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InternalCheckQuotaRequestValidationError) Cause() error { return e.cause }
+func (e InternalCheckFilesExistResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InternalCheckQuotaRequestValidationError) Key() bool { return e.key }
+func (e InternalCheckFilesExistResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InternalCheckQuotaRequestValidationError) ErrorName() string {
-	return "InternalCheckQuotaRequestValidationError"
+func (e InternalCheckFilesExistResponseValidationError) ErrorName() string {
+	return "InternalCheckFilesExistResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InternalCheckQuotaRequestValidationError) Error() string {
+func (e InternalCheckFilesExistResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2287,14 +2340,14 @@ func (e InternalCheckQuotaRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInternalCheckQuotaRequest.%s: %s%s",
+		"invalid %sInternalCheckFilesExistResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InternalCheckQuotaRequestValidationError{}
+var _ error = InternalCheckFilesExistResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2302,77 +2355,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InternalCheckQuotaRequestValidationError{}
+} = InternalCheckFilesExistResponseValidationError{}
 
-// Validate checks the field values on InternalCheckQuotaResponse with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on InternalCopyFileRequest with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InternalCheckQuotaResponse) Validate() error {
+func (m *InternalCopyFileRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InternalCheckQuotaResponse with the
+// ValidateAll checks the field values on InternalCopyFileRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InternalCheckQuotaResponseMultiError, or nil if none found.
-func (m *InternalCheckQuotaResponse) ValidateAll() error {
+// InternalCopyFileRequestMultiError, or nil if none found.
+func (m *InternalCopyFileRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InternalCheckQuotaResponse) validate(all bool) error {
+func (m *InternalCopyFileRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Allowed
+	// no validation rules for SrcTenantCode
 
-	// no validation rules for Reason
+	// no validation rules for FileId
 
-	if all {
-		switch v := interface{}(m.GetQuota()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, InternalCheckQuotaResponseValidationError{
-					field:  "Quota",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, InternalCheckQuotaResponseValidationError{
-					field:  "Quota",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return InternalCheckQuotaResponseValidationError{
-				field:  "Quota",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for DstTenantCode
+
+	// no validation rules for Filename
+
+	// no validation rules for IncludeVariants
 
 	if len(errors) > 0 {
-		return InternalCheckQuotaResponseMultiError(errors)
+		return InternalCopyFileRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// InternalCheckQuotaResponseMultiError is an error wrapping multiple
-// validation errors returned by InternalCheckQuotaResponse.ValidateAll() if
-// the designated constraints aren't met.
-type InternalCheckQuotaResponseMultiError []error
+// InternalCopyFileRequestMultiError is an error wrapping multiple validation
+// errors returned by InternalCopyFileRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalCopyFileRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InternalCheckQuotaResponseMultiError) Error() string {
+func (m InternalCopyFileRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2381,11 +2411,11 @@ func (m InternalCheckQuotaResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InternalCheckQuotaResponseMultiError) AllErrors() []error { return m }
+func (m InternalCopyFileRequestMultiError) AllErrors() []error { return m }
 
-// InternalCheckQuotaResponseValidationError is the validation error returned
-// by InternalCheckQuotaResponse.Validate if the designated constraints aren't met.
-type InternalCheckQuotaResponseValidationError struct {
+// InternalCopyFileRequestValidationError is the validation error returned by
+// InternalCopyFileRequest.Validate if the designated constraints aren't met.
+type InternalCopyFileRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2393,24 +2423,24 @@ type InternalCheckQuotaResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e InternalCheckQuotaResponseValidationError) Field() string { return e.field }
+func (e InternalCopyFileRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InternalCheckQuotaResponseValidationError) Reason() string { return e.reason }
+func (e InternalCopyFileRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InternalCheckQuotaResponseValidationError) Cause() error { return e.cause }
+func (e InternalCopyFileRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InternalCheckQuotaResponseValidationError) Key() bool { return e.key }
+func (e InternalCopyFileRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InternalCheckQuotaResponseValidationError) ErrorName() string {
-	return "InternalCheckQuotaResponseValidationError"
+func (e InternalCopyFileRequestValidationError) ErrorName() string {
+	return "InternalCopyFileRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InternalCheckQuotaResponseValidationError) Error() string {
+func (e InternalCopyFileRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2422,14 +2452,14 @@ func (e InternalCheckQuotaResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInternalCheckQuotaResponse.%s: %s%s",
+		"invalid %sInternalCopyFileRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InternalCheckQuotaResponseValidationError{}
+var _ error = InternalCopyFileRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2437,24 +2467,3053 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InternalCheckQuotaResponseValidationError{}
+} = InternalCopyFileRequestValidationError{}
 
-// Validate checks the field values on InternalInitTenantRequest with the rules
+// Validate checks the field values on InternalCopyFileResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *InternalInitTenantRequest) Validate() error {
+func (m *InternalCopyFileResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InternalInitTenantRequest with the
+// ValidateAll checks the field values on InternalCopyFileResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// InternalInitTenantRequestMultiError, or nil if none found.
-func (m *InternalInitTenantRequest) ValidateAll() error {
+// InternalCopyFileResponseMultiError, or nil if none found.
+func (m *InternalCopyFileResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InternalInitTenantRequest) validate(all bool) error {
+func (m *InternalCopyFileResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileId
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalCopyFileResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalCopyFileResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalCopyFileResponseValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalCopyFileResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCopyFileResponseMultiError is an error wrapping multiple validation
+// errors returned by InternalCopyFileResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalCopyFileResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCopyFileResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCopyFileResponseMultiError) AllErrors() []error { return m }
+
+// InternalCopyFileResponseValidationError is the validation error returned by
+// InternalCopyFileResponse.Validate if the designated constraints aren't met.
+type InternalCopyFileResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCopyFileResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCopyFileResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCopyFileResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCopyFileResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCopyFileResponseValidationError) ErrorName() string {
+	return "InternalCopyFileResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCopyFileResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCopyFileResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCopyFileResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCopyFileResponseValidationError{}
+
+// Validate checks the field values on InternalUpdateFileMetadataRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalUpdateFileMetadataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalUpdateFileMetadataRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalUpdateFileMetadataRequestMultiError, or nil if none found.
+func (m *InternalUpdateFileMetadataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalUpdateFileMetadataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for FileId
+
+	// no validation rules for UpdateTags
+
+	if m.Filename != nil {
+		// no validation rules for Filename
+	}
+
+	if m.IsPublic != nil {
+		// no validation rules for IsPublic
+	}
+
+	if len(errors) > 0 {
+		return InternalUpdateFileMetadataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalUpdateFileMetadataRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalUpdateFileMetadataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalUpdateFileMetadataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalUpdateFileMetadataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalUpdateFileMetadataRequestMultiError) AllErrors() []error { return m }
+
+// InternalUpdateFileMetadataRequestValidationError is the validation error
+// returned by InternalUpdateFileMetadataRequest.Validate if the designated
+// constraints aren't met.
+type InternalUpdateFileMetadataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalUpdateFileMetadataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalUpdateFileMetadataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalUpdateFileMetadataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalUpdateFileMetadataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalUpdateFileMetadataRequestValidationError) ErrorName() string {
+	return "InternalUpdateFileMetadataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalUpdateFileMetadataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalUpdateFileMetadataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalUpdateFileMetadataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalUpdateFileMetadataRequestValidationError{}
+
+// Validate checks the field values on InternalUpdateFileMetadataResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalUpdateFileMetadataResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalUpdateFileMetadataResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalUpdateFileMetadataResponseMultiError, or nil if none found.
+func (m *InternalUpdateFileMetadataResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalUpdateFileMetadataResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFile()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalUpdateFileMetadataResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalUpdateFileMetadataResponseValidationError{
+					field:  "File",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFile()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalUpdateFileMetadataResponseValidationError{
+				field:  "File",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalUpdateFileMetadataResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalUpdateFileMetadataResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalUpdateFileMetadataResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalUpdateFileMetadataResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalUpdateFileMetadataResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalUpdateFileMetadataResponseMultiError) AllErrors() []error { return m }
+
+// InternalUpdateFileMetadataResponseValidationError is the validation error
+// returned by InternalUpdateFileMetadataResponse.Validate if the designated
+// constraints aren't met.
+type InternalUpdateFileMetadataResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalUpdateFileMetadataResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalUpdateFileMetadataResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalUpdateFileMetadataResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalUpdateFileMetadataResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalUpdateFileMetadataResponseValidationError) ErrorName() string {
+	return "InternalUpdateFileMetadataResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalUpdateFileMetadataResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalUpdateFileMetadataResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalUpdateFileMetadataResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalUpdateFileMetadataResponseValidationError{}
+
+// Validate checks the field values on InternalVariantSpec with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalVariantSpec) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalVariantSpec with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalVariantSpecMultiError, or nil if none found.
+func (m *InternalVariantSpec) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalVariantSpec) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VariantId
+
+	// no validation rules for Type
+
+	// no validation rules for Width
+
+	// no validation rules for Height
+
+	// no validation rules for Format
+
+	// no validation rules for Quality
+
+	// no validation rules for WatermarkText
+
+	// no validation rules for WatermarkFileId
+
+	if len(errors) > 0 {
+		return InternalVariantSpecMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalVariantSpecMultiError is an error wrapping multiple validation
+// errors returned by InternalVariantSpec.ValidateAll() if the designated
+// constraints aren't met.
+type InternalVariantSpecMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalVariantSpecMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalVariantSpecMultiError) AllErrors() []error { return m }
+
+// InternalVariantSpecValidationError is the validation error returned by
+// InternalVariantSpec.Validate if the designated constraints aren't met.
+type InternalVariantSpecValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalVariantSpecValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalVariantSpecValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalVariantSpecValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalVariantSpecValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalVariantSpecValidationError) ErrorName() string {
+	return "InternalVariantSpecValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalVariantSpecValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalVariantSpec.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalVariantSpecValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalVariantSpecValidationError{}
+
+// Validate checks the field values on InternalVariantStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalVariantStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalVariantStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalVariantStatusMultiError, or nil if none found.
+func (m *InternalVariantStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalVariantStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VariantId
+
+	// no validation rules for Status
+
+	// no validation rules for Url
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return InternalVariantStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalVariantStatusMultiError is an error wrapping multiple validation
+// errors returned by InternalVariantStatus.ValidateAll() if the designated
+// constraints aren't met.
+type InternalVariantStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalVariantStatusMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalVariantStatusMultiError) AllErrors() []error { return m }
+
+// InternalVariantStatusValidationError is the validation error returned by
+// InternalVariantStatus.Validate if the designated constraints aren't met.
+type InternalVariantStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalVariantStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalVariantStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalVariantStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalVariantStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalVariantStatusValidationError) ErrorName() string {
+	return "InternalVariantStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalVariantStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalVariantStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalVariantStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalVariantStatusValidationError{}
+
+// Validate checks the field values on InternalCreateVariantsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalCreateVariantsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateVariantsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalCreateVariantsRequestMultiError, or nil if none found.
+func (m *InternalCreateVariantsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateVariantsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for FileId
+
+	for idx, item := range m.GetSpecs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalCreateVariantsRequestValidationError{
+						field:  fmt.Sprintf("Specs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalCreateVariantsRequestValidationError{
+						field:  fmt.Sprintf("Specs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalCreateVariantsRequestValidationError{
+					field:  fmt.Sprintf("Specs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalCreateVariantsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateVariantsRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateVariantsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalCreateVariantsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateVariantsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateVariantsRequestMultiError) AllErrors() []error { return m }
+
+// InternalCreateVariantsRequestValidationError is the validation error
+// returned by InternalCreateVariantsRequest.Validate if the designated
+// constraints aren't met.
+type InternalCreateVariantsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateVariantsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateVariantsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateVariantsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateVariantsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateVariantsRequestValidationError) ErrorName() string {
+	return "InternalCreateVariantsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateVariantsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateVariantsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateVariantsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateVariantsRequestValidationError{}
+
+// Validate checks the field values on InternalCreateVariantsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalCreateVariantsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateVariantsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalCreateVariantsResponseMultiError, or nil if none found.
+func (m *InternalCreateVariantsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateVariantsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVariants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalCreateVariantsResponseValidationError{
+						field:  fmt.Sprintf("Variants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalCreateVariantsResponseValidationError{
+						field:  fmt.Sprintf("Variants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalCreateVariantsResponseValidationError{
+					field:  fmt.Sprintf("Variants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalCreateVariantsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateVariantsResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateVariantsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalCreateVariantsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateVariantsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateVariantsResponseMultiError) AllErrors() []error { return m }
+
+// InternalCreateVariantsResponseValidationError is the validation error
+// returned by InternalCreateVariantsResponse.Validate if the designated
+// constraints aren't met.
+type InternalCreateVariantsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateVariantsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateVariantsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateVariantsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateVariantsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateVariantsResponseValidationError) ErrorName() string {
+	return "InternalCreateVariantsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateVariantsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateVariantsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateVariantsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateVariantsResponseValidationError{}
+
+// Validate checks the field values on InternalGetVariantStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetVariantStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetVariantStatusRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetVariantStatusRequestMultiError, or nil if none found.
+func (m *InternalGetVariantStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetVariantStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for FileId
+
+	if len(errors) > 0 {
+		return InternalGetVariantStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetVariantStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalGetVariantStatusRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalGetVariantStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetVariantStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetVariantStatusRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetVariantStatusRequestValidationError is the validation error
+// returned by InternalGetVariantStatusRequest.Validate if the designated
+// constraints aren't met.
+type InternalGetVariantStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetVariantStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetVariantStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetVariantStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetVariantStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetVariantStatusRequestValidationError) ErrorName() string {
+	return "InternalGetVariantStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetVariantStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetVariantStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetVariantStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetVariantStatusRequestValidationError{}
+
+// Validate checks the field values on InternalGetVariantStatusResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalGetVariantStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetVariantStatusResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetVariantStatusResponseMultiError, or nil if none found.
+func (m *InternalGetVariantStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetVariantStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetVariants() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalGetVariantStatusResponseValidationError{
+						field:  fmt.Sprintf("Variants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalGetVariantStatusResponseValidationError{
+						field:  fmt.Sprintf("Variants[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalGetVariantStatusResponseValidationError{
+					field:  fmt.Sprintf("Variants[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalGetVariantStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetVariantStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalGetVariantStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetVariantStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetVariantStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetVariantStatusResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetVariantStatusResponseValidationError is the validation error
+// returned by InternalGetVariantStatusResponse.Validate if the designated
+// constraints aren't met.
+type InternalGetVariantStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetVariantStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetVariantStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetVariantStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetVariantStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetVariantStatusResponseValidationError) ErrorName() string {
+	return "InternalGetVariantStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetVariantStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetVariantStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetVariantStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetVariantStatusResponseValidationError{}
+
+// Validate checks the field values on InternalArchiveJob with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalArchiveJob) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalArchiveJob with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalArchiveJobMultiError, or nil if none found.
+func (m *InternalArchiveJob) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalArchiveJob) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for JobId
+
+	// no validation rules for Status
+
+	// no validation rules for Name
+
+	// no validation rules for DownloadUrl
+
+	// no validation rules for ExpiresIn
+
+	// no validation rules for Size
+
+	// no validation rules for FileCount
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return InternalArchiveJobMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalArchiveJobMultiError is an error wrapping multiple validation errors
+// returned by InternalArchiveJob.ValidateAll() if the designated constraints
+// aren't met.
+type InternalArchiveJobMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalArchiveJobMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalArchiveJobMultiError) AllErrors() []error { return m }
+
+// InternalArchiveJobValidationError is the validation error returned by
+// InternalArchiveJob.Validate if the designated constraints aren't met.
+type InternalArchiveJobValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalArchiveJobValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalArchiveJobValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalArchiveJobValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalArchiveJobValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalArchiveJobValidationError) ErrorName() string {
+	return "InternalArchiveJobValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalArchiveJobValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalArchiveJob.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalArchiveJobValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalArchiveJobValidationError{}
+
+// Validate checks the field values on InternalCreateArchiveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalCreateArchiveRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateArchiveRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalCreateArchiveRequestMultiError, or nil if none found.
+func (m *InternalCreateArchiveRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateArchiveRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for Name
+
+	if len(errors) > 0 {
+		return InternalCreateArchiveRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateArchiveRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateArchiveRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalCreateArchiveRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateArchiveRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateArchiveRequestMultiError) AllErrors() []error { return m }
+
+// InternalCreateArchiveRequestValidationError is the validation error returned
+// by InternalCreateArchiveRequest.Validate if the designated constraints
+// aren't met.
+type InternalCreateArchiveRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateArchiveRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateArchiveRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateArchiveRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateArchiveRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateArchiveRequestValidationError) ErrorName() string {
+	return "InternalCreateArchiveRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateArchiveRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateArchiveRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateArchiveRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateArchiveRequestValidationError{}
+
+// Validate checks the field values on InternalCreateArchiveResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalCreateArchiveResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCreateArchiveResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalCreateArchiveResponseMultiError, or nil if none found.
+func (m *InternalCreateArchiveResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCreateArchiveResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalCreateArchiveResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalCreateArchiveResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalCreateArchiveResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalCreateArchiveResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCreateArchiveResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalCreateArchiveResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalCreateArchiveResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCreateArchiveResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCreateArchiveResponseMultiError) AllErrors() []error { return m }
+
+// InternalCreateArchiveResponseValidationError is the validation error
+// returned by InternalCreateArchiveResponse.Validate if the designated
+// constraints aren't met.
+type InternalCreateArchiveResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCreateArchiveResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCreateArchiveResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCreateArchiveResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCreateArchiveResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCreateArchiveResponseValidationError) ErrorName() string {
+	return "InternalCreateArchiveResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCreateArchiveResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCreateArchiveResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCreateArchiveResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCreateArchiveResponseValidationError{}
+
+// Validate checks the field values on InternalGetArchiveStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetArchiveStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetArchiveStatusRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetArchiveStatusRequestMultiError, or nil if none found.
+func (m *InternalGetArchiveStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetArchiveStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for JobId
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return InternalGetArchiveStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetArchiveStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalGetArchiveStatusRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalGetArchiveStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetArchiveStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetArchiveStatusRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetArchiveStatusRequestValidationError is the validation error
+// returned by InternalGetArchiveStatusRequest.Validate if the designated
+// constraints aren't met.
+type InternalGetArchiveStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetArchiveStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetArchiveStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetArchiveStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetArchiveStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetArchiveStatusRequestValidationError) ErrorName() string {
+	return "InternalGetArchiveStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetArchiveStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetArchiveStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetArchiveStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetArchiveStatusRequestValidationError{}
+
+// Validate checks the field values on InternalGetArchiveStatusResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalGetArchiveStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetArchiveStatusResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetArchiveStatusResponseMultiError, or nil if none found.
+func (m *InternalGetArchiveStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetArchiveStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetJob()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalGetArchiveStatusResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalGetArchiveStatusResponseValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalGetArchiveStatusResponseValidationError{
+				field:  "Job",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalGetArchiveStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetArchiveStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalGetArchiveStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetArchiveStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetArchiveStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetArchiveStatusResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetArchiveStatusResponseValidationError is the validation error
+// returned by InternalGetArchiveStatusResponse.Validate if the designated
+// constraints aren't met.
+type InternalGetArchiveStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetArchiveStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetArchiveStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetArchiveStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetArchiveStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetArchiveStatusResponseValidationError) ErrorName() string {
+	return "InternalGetArchiveStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetArchiveStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetArchiveStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetArchiveStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetArchiveStatusResponseValidationError{}
+
+// Validate checks the field values on InternalFileReference with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalFileReference) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalFileReference with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalFileReferenceMultiError, or nil if none found.
+func (m *InternalFileReference) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalFileReference) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FileId
+
+	// no validation rules for RefType
+
+	// no validation rules for RefId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalFileReferenceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalFileReferenceValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalFileReferenceValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalFileReferenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalFileReferenceMultiError is an error wrapping multiple validation
+// errors returned by InternalFileReference.ValidateAll() if the designated
+// constraints aren't met.
+type InternalFileReferenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalFileReferenceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalFileReferenceMultiError) AllErrors() []error { return m }
+
+// InternalFileReferenceValidationError is the validation error returned by
+// InternalFileReference.Validate if the designated constraints aren't met.
+type InternalFileReferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalFileReferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalFileReferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalFileReferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalFileReferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalFileReferenceValidationError) ErrorName() string {
+	return "InternalFileReferenceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalFileReferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalFileReference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalFileReferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalFileReferenceValidationError{}
+
+// Validate checks the field values on InternalAttachReferenceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalAttachReferenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalAttachReferenceRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalAttachReferenceRequestMultiError, or nil if none found.
+func (m *InternalAttachReferenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalAttachReferenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for FileId
+
+	// no validation rules for RefType
+
+	// no validation rules for RefId
+
+	if len(errors) > 0 {
+		return InternalAttachReferenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalAttachReferenceRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalAttachReferenceRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalAttachReferenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalAttachReferenceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalAttachReferenceRequestMultiError) AllErrors() []error { return m }
+
+// InternalAttachReferenceRequestValidationError is the validation error
+// returned by InternalAttachReferenceRequest.Validate if the designated
+// constraints aren't met.
+type InternalAttachReferenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalAttachReferenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalAttachReferenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalAttachReferenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalAttachReferenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalAttachReferenceRequestValidationError) ErrorName() string {
+	return "InternalAttachReferenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalAttachReferenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalAttachReferenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalAttachReferenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalAttachReferenceRequestValidationError{}
+
+// Validate checks the field values on InternalAttachReferenceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalAttachReferenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalAttachReferenceResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalAttachReferenceResponseMultiError, or nil if none found.
+func (m *InternalAttachReferenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalAttachReferenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReferenceCount
+
+	if len(errors) > 0 {
+		return InternalAttachReferenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalAttachReferenceResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalAttachReferenceResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalAttachReferenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalAttachReferenceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalAttachReferenceResponseMultiError) AllErrors() []error { return m }
+
+// InternalAttachReferenceResponseValidationError is the validation error
+// returned by InternalAttachReferenceResponse.Validate if the designated
+// constraints aren't met.
+type InternalAttachReferenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalAttachReferenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalAttachReferenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalAttachReferenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalAttachReferenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalAttachReferenceResponseValidationError) ErrorName() string {
+	return "InternalAttachReferenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalAttachReferenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalAttachReferenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalAttachReferenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalAttachReferenceResponseValidationError{}
+
+// Validate checks the field values on InternalDetachReferenceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalDetachReferenceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalDetachReferenceRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalDetachReferenceRequestMultiError, or nil if none found.
+func (m *InternalDetachReferenceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalDetachReferenceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for FileId
+
+	// no validation rules for RefType
+
+	// no validation rules for RefId
+
+	if len(errors) > 0 {
+		return InternalDetachReferenceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalDetachReferenceRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalDetachReferenceRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalDetachReferenceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalDetachReferenceRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalDetachReferenceRequestMultiError) AllErrors() []error { return m }
+
+// InternalDetachReferenceRequestValidationError is the validation error
+// returned by InternalDetachReferenceRequest.Validate if the designated
+// constraints aren't met.
+type InternalDetachReferenceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalDetachReferenceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalDetachReferenceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalDetachReferenceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalDetachReferenceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalDetachReferenceRequestValidationError) ErrorName() string {
+	return "InternalDetachReferenceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalDetachReferenceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalDetachReferenceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalDetachReferenceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalDetachReferenceRequestValidationError{}
+
+// Validate checks the field values on InternalDetachReferenceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalDetachReferenceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalDetachReferenceResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalDetachReferenceResponseMultiError, or nil if none found.
+func (m *InternalDetachReferenceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalDetachReferenceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ReferenceCount
+
+	if len(errors) > 0 {
+		return InternalDetachReferenceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalDetachReferenceResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalDetachReferenceResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalDetachReferenceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalDetachReferenceResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalDetachReferenceResponseMultiError) AllErrors() []error { return m }
+
+// InternalDetachReferenceResponseValidationError is the validation error
+// returned by InternalDetachReferenceResponse.Validate if the designated
+// constraints aren't met.
+type InternalDetachReferenceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalDetachReferenceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalDetachReferenceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalDetachReferenceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalDetachReferenceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalDetachReferenceResponseValidationError) ErrorName() string {
+	return "InternalDetachReferenceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalDetachReferenceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalDetachReferenceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalDetachReferenceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalDetachReferenceResponseValidationError{}
+
+// Validate checks the field values on InternalListReferencesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListReferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListReferencesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalListReferencesRequestMultiError, or nil if none found.
+func (m *InternalListReferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListReferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for FileId
+
+	if len(errors) > 0 {
+		return InternalListReferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListReferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalListReferencesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalListReferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListReferencesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListReferencesRequestMultiError) AllErrors() []error { return m }
+
+// InternalListReferencesRequestValidationError is the validation error
+// returned by InternalListReferencesRequest.Validate if the designated
+// constraints aren't met.
+type InternalListReferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListReferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListReferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListReferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListReferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListReferencesRequestValidationError) ErrorName() string {
+	return "InternalListReferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListReferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListReferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListReferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListReferencesRequestValidationError{}
+
+// Validate checks the field values on InternalListReferencesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListReferencesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListReferencesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalListReferencesResponseMultiError, or nil if none found.
+func (m *InternalListReferencesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListReferencesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetReferences() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalListReferencesResponseValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalListReferencesResponseValidationError{
+						field:  fmt.Sprintf("References[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalListReferencesResponseValidationError{
+					field:  fmt.Sprintf("References[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalListReferencesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListReferencesResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalListReferencesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalListReferencesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListReferencesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListReferencesResponseMultiError) AllErrors() []error { return m }
+
+// InternalListReferencesResponseValidationError is the validation error
+// returned by InternalListReferencesResponse.Validate if the designated
+// constraints aren't met.
+type InternalListReferencesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListReferencesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListReferencesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListReferencesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListReferencesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListReferencesResponseValidationError) ErrorName() string {
+	return "InternalListReferencesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListReferencesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListReferencesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListReferencesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListReferencesResponseValidationError{}
+
+// Validate checks the field values on InternalGetQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetQuotaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalGetQuotaRequestMultiError, or nil if none found.
+func (m *InternalGetQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	if len(errors) > 0 {
+		return InternalGetQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by InternalGetQuotaRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetQuotaRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetQuotaRequestValidationError is the validation error returned by
+// InternalGetQuotaRequest.Validate if the designated constraints aren't met.
+type InternalGetQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetQuotaRequestValidationError) ErrorName() string {
+	return "InternalGetQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetQuotaRequestValidationError{}
+
+// Validate checks the field values on InternalGetQuotaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetQuotaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalGetQuotaResponseMultiError, or nil if none found.
+func (m *InternalGetQuotaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetQuotaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalGetQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalGetQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalGetQuotaResponseValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalGetQuotaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetQuotaResponseMultiError is an error wrapping multiple validation
+// errors returned by InternalGetQuotaResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetQuotaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetQuotaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetQuotaResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetQuotaResponseValidationError is the validation error returned by
+// InternalGetQuotaResponse.Validate if the designated constraints aren't met.
+type InternalGetQuotaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetQuotaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetQuotaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetQuotaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetQuotaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetQuotaResponseValidationError) ErrorName() string {
+	return "InternalGetQuotaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetQuotaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetQuotaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetQuotaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetQuotaResponseValidationError{}
+
+// Validate checks the field values on InternalCheckQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalCheckQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCheckQuotaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalCheckQuotaRequestMultiError, or nil if none found.
+func (m *InternalCheckQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCheckQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for CheckType
+
+	// no validation rules for Size
+
+	if len(errors) > 0 {
+		return InternalCheckQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCheckQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by InternalCheckQuotaRequest.ValidateAll() if the
+// designated constraints aren't met.
+type InternalCheckQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCheckQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCheckQuotaRequestMultiError) AllErrors() []error { return m }
+
+// InternalCheckQuotaRequestValidationError is the validation error returned by
+// InternalCheckQuotaRequest.Validate if the designated constraints aren't met.
+type InternalCheckQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCheckQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCheckQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCheckQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCheckQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCheckQuotaRequestValidationError) ErrorName() string {
+	return "InternalCheckQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCheckQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCheckQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCheckQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCheckQuotaRequestValidationError{}
+
+// Validate checks the field values on InternalCheckQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalCheckQuotaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCheckQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalCheckQuotaResponseMultiError, or nil if none found.
+func (m *InternalCheckQuotaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCheckQuotaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetQuota()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalCheckQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalCheckQuotaResponseValidationError{
+					field:  "Quota",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuota()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalCheckQuotaResponseValidationError{
+				field:  "Quota",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalCheckQuotaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCheckQuotaResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalCheckQuotaResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalCheckQuotaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCheckQuotaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCheckQuotaResponseMultiError) AllErrors() []error { return m }
+
+// InternalCheckQuotaResponseValidationError is the validation error returned
+// by InternalCheckQuotaResponse.Validate if the designated constraints aren't met.
+type InternalCheckQuotaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCheckQuotaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCheckQuotaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCheckQuotaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCheckQuotaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCheckQuotaResponseValidationError) ErrorName() string {
+	return "InternalCheckQuotaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCheckQuotaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCheckQuotaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCheckQuotaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCheckQuotaResponseValidationError{}
+
+// Validate checks the field values on InternalInitTenantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalInitTenantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalInitTenantRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalInitTenantRequestMultiError, or nil if none found.
+func (m *InternalInitTenantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalInitTenantRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2465,6 +5524,10 @@ func (m *InternalInitTenantRequest) validate(all bool) error {
 
 	// no validation rules for Region
 
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for AllowExisting
+
 	if len(errors) > 0 {
 		return InternalInitTenantRequestMultiError(errors)
 	}
@@ -2581,6 +5644,8 @@ func (m *InternalInitTenantResponse) validate(all bool) error {
 
 	// no validation rules for Error
 
+	// no validation rules for AlreadyInitialized
+
 	if len(errors) > 0 {
 		return InternalInitTenantResponseMultiError(errors)
 	}
@@ -2660,3 +5725,258 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = InternalInitTenantResponseValidationError{}
+
+// Validate checks the field values on InternalGetTenantInitStatusRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalGetTenantInitStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetTenantInitStatusRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalGetTenantInitStatusRequestMultiError, or nil if none found.
+func (m *InternalGetTenantInitStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetTenantInitStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	if len(errors) > 0 {
+		return InternalGetTenantInitStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetTenantInitStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalGetTenantInitStatusRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetTenantInitStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetTenantInitStatusRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetTenantInitStatusRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetTenantInitStatusRequestValidationError is the validation error
+// returned by InternalGetTenantInitStatusRequest.Validate if the designated
+// constraints aren't met.
+type InternalGetTenantInitStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetTenantInitStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetTenantInitStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetTenantInitStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetTenantInitStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetTenantInitStatusRequestValidationError) ErrorName() string {
+	return "InternalGetTenantInitStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetTenantInitStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetTenantInitStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetTenantInitStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetTenantInitStatusRequestValidationError{}
+
+// Validate checks the field values on InternalGetTenantInitStatusResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalGetTenantInitStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetTenantInitStatusResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalGetTenantInitStatusResponseMultiError, or nil if none found.
+func (m *InternalGetTenantInitStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetTenantInitStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for Region
+
+	// no validation rules for BucketId
+
+	// no validation rules for BucketName
+
+	// no validation rules for StorageQuota
+
+	// no validation rules for FileCountQuota
+
+	if all {
+		switch v := interface{}(m.GetInitializedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalGetTenantInitStatusResponseValidationError{
+					field:  "InitializedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalGetTenantInitStatusResponseValidationError{
+					field:  "InitializedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInitializedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalGetTenantInitStatusResponseValidationError{
+				field:  "InitializedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return InternalGetTenantInitStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetTenantInitStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalGetTenantInitStatusResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetTenantInitStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetTenantInitStatusResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetTenantInitStatusResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetTenantInitStatusResponseValidationError is the validation error
+// returned by InternalGetTenantInitStatusResponse.Validate if the designated
+// constraints aren't met.
+type InternalGetTenantInitStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetTenantInitStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetTenantInitStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetTenantInitStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetTenantInitStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetTenantInitStatusResponseValidationError) ErrorName() string {
+	return "InternalGetTenantInitStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetTenantInitStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetTenantInitStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetTenantInitStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetTenantInitStatusResponseValidationError{}
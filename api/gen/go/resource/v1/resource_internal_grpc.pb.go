@@ -19,14 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ResourceInternalService_InternalGetFile_FullMethodName         = "/resource.v1.ResourceInternalService/InternalGetFile"
-	ResourceInternalService_InternalGetFiles_FullMethodName        = "/resource.v1.ResourceInternalService/InternalGetFiles"
-	ResourceInternalService_InternalGetFileUrls_FullMethodName     = "/resource.v1.ResourceInternalService/InternalGetFileUrls"
-	ResourceInternalService_InternalGetDownloadUrls_FullMethodName = "/resource.v1.ResourceInternalService/InternalGetDownloadUrls"
-	ResourceInternalService_InternalCheckFileExists_FullMethodName = "/resource.v1.ResourceInternalService/InternalCheckFileExists"
-	ResourceInternalService_InternalGetQuota_FullMethodName        = "/resource.v1.ResourceInternalService/InternalGetQuota"
-	ResourceInternalService_InternalCheckQuota_FullMethodName      = "/resource.v1.ResourceInternalService/InternalCheckQuota"
-	ResourceInternalService_InternalInitTenant_FullMethodName      = "/resource.v1.ResourceInternalService/InternalInitTenant"
+	ResourceInternalService_InternalGetFile_FullMethodName             = "/resource.v1.ResourceInternalService/InternalGetFile"
+	ResourceInternalService_InternalGetFiles_FullMethodName            = "/resource.v1.ResourceInternalService/InternalGetFiles"
+	ResourceInternalService_InternalGetFileUrls_FullMethodName         = "/resource.v1.ResourceInternalService/InternalGetFileUrls"
+	ResourceInternalService_InternalGetDownloadUrls_FullMethodName     = "/resource.v1.ResourceInternalService/InternalGetDownloadUrls"
+	ResourceInternalService_InternalCheckFileExists_FullMethodName     = "/resource.v1.ResourceInternalService/InternalCheckFileExists"
+	ResourceInternalService_InternalCheckFilesExist_FullMethodName     = "/resource.v1.ResourceInternalService/InternalCheckFilesExist"
+	ResourceInternalService_InternalCopyFile_FullMethodName            = "/resource.v1.ResourceInternalService/InternalCopyFile"
+	ResourceInternalService_InternalUpdateFileMetadata_FullMethodName  = "/resource.v1.ResourceInternalService/InternalUpdateFileMetadata"
+	ResourceInternalService_InternalCreateVariants_FullMethodName      = "/resource.v1.ResourceInternalService/InternalCreateVariants"
+	ResourceInternalService_InternalGetVariantStatus_FullMethodName    = "/resource.v1.ResourceInternalService/InternalGetVariantStatus"
+	ResourceInternalService_InternalCreateArchive_FullMethodName       = "/resource.v1.ResourceInternalService/InternalCreateArchive"
+	ResourceInternalService_InternalGetArchiveStatus_FullMethodName    = "/resource.v1.ResourceInternalService/InternalGetArchiveStatus"
+	ResourceInternalService_InternalAttachReference_FullMethodName     = "/resource.v1.ResourceInternalService/InternalAttachReference"
+	ResourceInternalService_InternalDetachReference_FullMethodName     = "/resource.v1.ResourceInternalService/InternalDetachReference"
+	ResourceInternalService_InternalListReferences_FullMethodName      = "/resource.v1.ResourceInternalService/InternalListReferences"
+	ResourceInternalService_InternalGetQuota_FullMethodName            = "/resource.v1.ResourceInternalService/InternalGetQuota"
+	ResourceInternalService_InternalCheckQuota_FullMethodName          = "/resource.v1.ResourceInternalService/InternalCheckQuota"
+	ResourceInternalService_InternalInitTenant_FullMethodName          = "/resource.v1.ResourceInternalService/InternalInitTenant"
+	ResourceInternalService_InternalGetTenantInitStatus_FullMethodName = "/resource.v1.ResourceInternalService/InternalGetTenantInitStatus"
 )
 
 // ResourceInternalServiceClient is the client API for ResourceInternalService service.
@@ -86,6 +97,70 @@ type ResourceInternalServiceClient interface {
 	// - 验证业务数据关联的文件是否有效
 	// - 秒传检查
 	InternalCheckFileExists(ctx context.Context, in *InternalCheckFileExistsRequest, opts ...grpc.CallOption) (*InternalCheckFileExistsResponse, error)
+	// InternalCheckFilesExist 批量检查文件是否存在（内部接口）
+	//
+	// 使用场景：
+	// - 批量导入时按校验和去重（单次最多100个）
+	InternalCheckFilesExist(ctx context.Context, in *InternalCheckFilesExistRequest, opts ...grpc.CallOption) (*InternalCheckFilesExistResponse, error)
+	// InternalCopyFile 复制文件（内部接口）
+	//
+	// 将文件复制到目标租户的存储桶中，生成新的文件ID
+	//
+	// 使用场景：
+	// - 克隆店铺模板时将平台模板图片复制到商户租户
+	// - 跨租户共享素材
+	//
+	// 注意：
+	// - 源租户与目标租户可以相同（租户内复制）
+	// - 复制会占用目标租户的存储配额
+	InternalCopyFile(ctx context.Context, in *InternalCopyFileRequest, opts ...grpc.CallOption) (*InternalCopyFileResponse, error)
+	// InternalUpdateFileMetadata 更新文件元数据（内部接口）
+	//
+	// 用于其他微服务修改文件的显示名称、标签和可见性
+	//
+	// 使用场景：
+	// - 内容管理系统重命名用户上传的文件
+	// - 内容发布时将文件切换为公开访问
+	InternalUpdateFileMetadata(ctx context.Context, in *InternalUpdateFileMetadataRequest, opts ...grpc.CallOption) (*InternalUpdateFileMetadataResponse, error)
+	// InternalCreateVariants 按需生成文件变体（内部接口）
+	//
+	// 为已存在的文件触发缩略图/缩放/水印等变体生成，异步处理
+	//
+	// 使用场景：
+	// - 列表渲染前确保缩略图已生成
+	// - 为历史文件补生成新规格的变体
+	//
+	// 注意：
+	// - 已存在的同ID变体不会重复生成
+	InternalCreateVariants(ctx context.Context, in *InternalCreateVariantsRequest, opts ...grpc.CallOption) (*InternalCreateVariantsResponse, error)
+	// InternalGetVariantStatus 查询文件变体生成状态（内部接口）
+	//
+	// 用于轮询 InternalCreateVariants 触发的变体生成进度
+	InternalGetVariantStatus(ctx context.Context, in *InternalGetVariantStatusRequest, opts ...grpc.CallOption) (*InternalGetVariantStatusResponse, error)
+	// InternalCreateArchive 创建文件打包任务（内部接口）
+	//
+	// 将多个文件异步打包为zip，完成后可通过 InternalGetArchiveStatus 获取下载URL
+	//
+	// 使用场景：
+	// - 订单/工单"下载全部附件"
+	InternalCreateArchive(ctx context.Context, in *InternalCreateArchiveRequest, opts ...grpc.CallOption) (*InternalCreateArchiveResponse, error)
+	// InternalGetArchiveStatus 查询打包任务状态（内部接口）
+	InternalGetArchiveStatus(ctx context.Context, in *InternalGetArchiveStatusRequest, opts ...grpc.CallOption) (*InternalGetArchiveStatusResponse, error)
+	// InternalAttachReference 记录文件引用（内部接口）
+	//
+	// 业务数据使用文件时记录引用关系，无引用的上传文件可被平台安全回收
+	//
+	// 使用场景：
+	// - 商品保存时记录商品图片引用
+	// - 重复记录同一引用是幂等的
+	InternalAttachReference(ctx context.Context, in *InternalAttachReferenceRequest, opts ...grpc.CallOption) (*InternalAttachReferenceResponse, error)
+	// InternalDetachReference 删除文件引用（内部接口）
+	//
+	// 使用场景：
+	// - 商品删除或更换图片时解除引用
+	InternalDetachReference(ctx context.Context, in *InternalDetachReferenceRequest, opts ...grpc.CallOption) (*InternalDetachReferenceResponse, error)
+	// InternalListReferences 查询文件的引用列表（内部接口）
+	InternalListReferences(ctx context.Context, in *InternalListReferencesRequest, opts ...grpc.CallOption) (*InternalListReferencesResponse, error)
 	// InternalGetQuota 获取租户配额（内部接口）
 	//
 	// 用于其他微服务获取租户配额信息
@@ -117,8 +192,14 @@ type ResourceInternalServiceClient interface {
 	//
 	// 注意：
 	// - 一个租户只能初始化一次
-	// - 重复调用会返回错误
+	// - 重复调用会返回错误（携带相同 idempotency_key 或 allow_existing=true 时返回已有结果）
 	InternalInitTenant(ctx context.Context, in *InternalInitTenantRequest, opts ...grpc.CallOption) (*InternalInitTenantResponse, error)
+	// InternalGetTenantInitStatus 查询租户初始化状态（内部接口）
+	//
+	// 使用场景：
+	// - 租户开通流程重试前判断是否已初始化
+	// - 对账任务修复初始化失败的租户
+	InternalGetTenantInitStatus(ctx context.Context, in *InternalGetTenantInitStatusRequest, opts ...grpc.CallOption) (*InternalGetTenantInitStatusResponse, error)
 }
 
 type resourceInternalServiceClient struct {
@@ -179,6 +260,106 @@ func (c *resourceInternalServiceClient) InternalCheckFileExists(ctx context.Cont
 	return out, nil
 }
 
+func (c *resourceInternalServiceClient) InternalCheckFilesExist(ctx context.Context, in *InternalCheckFilesExistRequest, opts ...grpc.CallOption) (*InternalCheckFilesExistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalCheckFilesExistResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalCheckFilesExist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalCopyFile(ctx context.Context, in *InternalCopyFileRequest, opts ...grpc.CallOption) (*InternalCopyFileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalCopyFileResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalCopyFile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalUpdateFileMetadata(ctx context.Context, in *InternalUpdateFileMetadataRequest, opts ...grpc.CallOption) (*InternalUpdateFileMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalUpdateFileMetadataResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalUpdateFileMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalCreateVariants(ctx context.Context, in *InternalCreateVariantsRequest, opts ...grpc.CallOption) (*InternalCreateVariantsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalCreateVariantsResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalCreateVariants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalGetVariantStatus(ctx context.Context, in *InternalGetVariantStatusRequest, opts ...grpc.CallOption) (*InternalGetVariantStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGetVariantStatusResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalGetVariantStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalCreateArchive(ctx context.Context, in *InternalCreateArchiveRequest, opts ...grpc.CallOption) (*InternalCreateArchiveResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalCreateArchiveResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalCreateArchive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalGetArchiveStatus(ctx context.Context, in *InternalGetArchiveStatusRequest, opts ...grpc.CallOption) (*InternalGetArchiveStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGetArchiveStatusResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalGetArchiveStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalAttachReference(ctx context.Context, in *InternalAttachReferenceRequest, opts ...grpc.CallOption) (*InternalAttachReferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalAttachReferenceResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalAttachReference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalDetachReference(ctx context.Context, in *InternalDetachReferenceRequest, opts ...grpc.CallOption) (*InternalDetachReferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalDetachReferenceResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalDetachReference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceInternalServiceClient) InternalListReferences(ctx context.Context, in *InternalListReferencesRequest, opts ...grpc.CallOption) (*InternalListReferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalListReferencesResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalListReferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *resourceInternalServiceClient) InternalGetQuota(ctx context.Context, in *InternalGetQuotaRequest, opts ...grpc.CallOption) (*InternalGetQuotaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InternalGetQuotaResponse)
@@ -209,6 +390,16 @@ func (c *resourceInternalServiceClient) InternalInitTenant(ctx context.Context,
 	return out, nil
 }
 
+func (c *resourceInternalServiceClient) InternalGetTenantInitStatus(ctx context.Context, in *InternalGetTenantInitStatusRequest, opts ...grpc.CallOption) (*InternalGetTenantInitStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGetTenantInitStatusResponse)
+	err := c.cc.Invoke(ctx, ResourceInternalService_InternalGetTenantInitStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResourceInternalServiceServer is the server API for ResourceInternalService service.
 // All implementations must embed UnimplementedResourceInternalServiceServer
 // for forward compatibility.
@@ -266,6 +457,70 @@ type ResourceInternalServiceServer interface {
 	// - 验证业务数据关联的文件是否有效
 	// - 秒传检查
 	InternalCheckFileExists(context.Context, *InternalCheckFileExistsRequest) (*InternalCheckFileExistsResponse, error)
+	// InternalCheckFilesExist 批量检查文件是否存在（内部接口）
+	//
+	// 使用场景：
+	// - 批量导入时按校验和去重（单次最多100个）
+	InternalCheckFilesExist(context.Context, *InternalCheckFilesExistRequest) (*InternalCheckFilesExistResponse, error)
+	// InternalCopyFile 复制文件（内部接口）
+	//
+	// 将文件复制到目标租户的存储桶中，生成新的文件ID
+	//
+	// 使用场景：
+	// - 克隆店铺模板时将平台模板图片复制到商户租户
+	// - 跨租户共享素材
+	//
+	// 注意：
+	// - 源租户与目标租户可以相同（租户内复制）
+	// - 复制会占用目标租户的存储配额
+	InternalCopyFile(context.Context, *InternalCopyFileRequest) (*InternalCopyFileResponse, error)
+	// InternalUpdateFileMetadata 更新文件元数据（内部接口）
+	//
+	// 用于其他微服务修改文件的显示名称、标签和可见性
+	//
+	// 使用场景：
+	// - 内容管理系统重命名用户上传的文件
+	// - 内容发布时将文件切换为公开访问
+	InternalUpdateFileMetadata(context.Context, *InternalUpdateFileMetadataRequest) (*InternalUpdateFileMetadataResponse, error)
+	// InternalCreateVariants 按需生成文件变体（内部接口）
+	//
+	// 为已存在的文件触发缩略图/缩放/水印等变体生成，异步处理
+	//
+	// 使用场景：
+	// - 列表渲染前确保缩略图已生成
+	// - 为历史文件补生成新规格的变体
+	//
+	// 注意：
+	// - 已存在的同ID变体不会重复生成
+	InternalCreateVariants(context.Context, *InternalCreateVariantsRequest) (*InternalCreateVariantsResponse, error)
+	// InternalGetVariantStatus 查询文件变体生成状态（内部接口）
+	//
+	// 用于轮询 InternalCreateVariants 触发的变体生成进度
+	InternalGetVariantStatus(context.Context, *InternalGetVariantStatusRequest) (*InternalGetVariantStatusResponse, error)
+	// InternalCreateArchive 创建文件打包任务（内部接口）
+	//
+	// 将多个文件异步打包为zip，完成后可通过 InternalGetArchiveStatus 获取下载URL
+	//
+	// 使用场景：
+	// - 订单/工单"下载全部附件"
+	InternalCreateArchive(context.Context, *InternalCreateArchiveRequest) (*InternalCreateArchiveResponse, error)
+	// InternalGetArchiveStatus 查询打包任务状态（内部接口）
+	InternalGetArchiveStatus(context.Context, *InternalGetArchiveStatusRequest) (*InternalGetArchiveStatusResponse, error)
+	// InternalAttachReference 记录文件引用（内部接口）
+	//
+	// 业务数据使用文件时记录引用关系，无引用的上传文件可被平台安全回收
+	//
+	// 使用场景：
+	// - 商品保存时记录商品图片引用
+	// - 重复记录同一引用是幂等的
+	InternalAttachReference(context.Context, *InternalAttachReferenceRequest) (*InternalAttachReferenceResponse, error)
+	// InternalDetachReference 删除文件引用（内部接口）
+	//
+	// 使用场景：
+	// - 商品删除或更换图片时解除引用
+	InternalDetachReference(context.Context, *InternalDetachReferenceRequest) (*InternalDetachReferenceResponse, error)
+	// InternalListReferences 查询文件的引用列表（内部接口）
+	InternalListReferences(context.Context, *InternalListReferencesRequest) (*InternalListReferencesResponse, error)
 	// InternalGetQuota 获取租户配额（内部接口）
 	//
 	// 用于其他微服务获取租户配额信息
@@ -297,8 +552,14 @@ type ResourceInternalServiceServer interface {
 	//
 	// 注意：
 	// - 一个租户只能初始化一次
-	// - 重复调用会返回错误
+	// - 重复调用会返回错误（携带相同 idempotency_key 或 allow_existing=true 时返回已有结果）
 	InternalInitTenant(context.Context, *InternalInitTenantRequest) (*InternalInitTenantResponse, error)
+	// InternalGetTenantInitStatus 查询租户初始化状态（内部接口）
+	//
+	// 使用场景：
+	// - 租户开通流程重试前判断是否已初始化
+	// - 对账任务修复初始化失败的租户
+	InternalGetTenantInitStatus(context.Context, *InternalGetTenantInitStatusRequest) (*InternalGetTenantInitStatusResponse, error)
 	mustEmbedUnimplementedResourceInternalServiceServer()
 }
 
@@ -324,6 +585,36 @@ func (UnimplementedResourceInternalServiceServer) InternalGetDownloadUrls(contex
 func (UnimplementedResourceInternalServiceServer) InternalCheckFileExists(context.Context, *InternalCheckFileExistsRequest) (*InternalCheckFileExistsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalCheckFileExists not implemented")
 }
+func (UnimplementedResourceInternalServiceServer) InternalCheckFilesExist(context.Context, *InternalCheckFilesExistRequest) (*InternalCheckFilesExistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalCheckFilesExist not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalCopyFile(context.Context, *InternalCopyFileRequest) (*InternalCopyFileResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalCopyFile not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalUpdateFileMetadata(context.Context, *InternalUpdateFileMetadataRequest) (*InternalUpdateFileMetadataResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalUpdateFileMetadata not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalCreateVariants(context.Context, *InternalCreateVariantsRequest) (*InternalCreateVariantsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalCreateVariants not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalGetVariantStatus(context.Context, *InternalGetVariantStatusRequest) (*InternalGetVariantStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalGetVariantStatus not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalCreateArchive(context.Context, *InternalCreateArchiveRequest) (*InternalCreateArchiveResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalCreateArchive not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalGetArchiveStatus(context.Context, *InternalGetArchiveStatusRequest) (*InternalGetArchiveStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalGetArchiveStatus not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalAttachReference(context.Context, *InternalAttachReferenceRequest) (*InternalAttachReferenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalAttachReference not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalDetachReference(context.Context, *InternalDetachReferenceRequest) (*InternalDetachReferenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalDetachReference not implemented")
+}
+func (UnimplementedResourceInternalServiceServer) InternalListReferences(context.Context, *InternalListReferencesRequest) (*InternalListReferencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalListReferences not implemented")
+}
 func (UnimplementedResourceInternalServiceServer) InternalGetQuota(context.Context, *InternalGetQuotaRequest) (*InternalGetQuotaResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalGetQuota not implemented")
 }
@@ -333,6 +624,9 @@ func (UnimplementedResourceInternalServiceServer) InternalCheckQuota(context.Con
 func (UnimplementedResourceInternalServiceServer) InternalInitTenant(context.Context, *InternalInitTenantRequest) (*InternalInitTenantResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalInitTenant not implemented")
 }
+func (UnimplementedResourceInternalServiceServer) InternalGetTenantInitStatus(context.Context, *InternalGetTenantInitStatusRequest) (*InternalGetTenantInitStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalGetTenantInitStatus not implemented")
+}
 func (UnimplementedResourceInternalServiceServer) mustEmbedUnimplementedResourceInternalServiceServer() {
 }
 func (UnimplementedResourceInternalServiceServer) testEmbeddedByValue() {}
@@ -445,6 +739,186 @@ func _ResourceInternalService_InternalCheckFileExists_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceInternalService_InternalCheckFilesExist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalCheckFilesExistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalCheckFilesExist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalCheckFilesExist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalCheckFilesExist(ctx, req.(*InternalCheckFilesExistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalCopyFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalCopyFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalCopyFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalCopyFile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalCopyFile(ctx, req.(*InternalCopyFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalUpdateFileMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalUpdateFileMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalUpdateFileMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalUpdateFileMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalUpdateFileMetadata(ctx, req.(*InternalUpdateFileMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalCreateVariants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalCreateVariantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalCreateVariants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalCreateVariants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalCreateVariants(ctx, req.(*InternalCreateVariantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalGetVariantStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGetVariantStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalGetVariantStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalGetVariantStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalGetVariantStatus(ctx, req.(*InternalGetVariantStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalCreateArchive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalCreateArchiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalCreateArchive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalCreateArchive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalCreateArchive(ctx, req.(*InternalCreateArchiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalGetArchiveStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGetArchiveStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalGetArchiveStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalGetArchiveStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalGetArchiveStatus(ctx, req.(*InternalGetArchiveStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalAttachReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalAttachReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalAttachReference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalAttachReference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalAttachReference(ctx, req.(*InternalAttachReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalDetachReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalDetachReferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalDetachReference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalDetachReference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalDetachReference(ctx, req.(*InternalDetachReferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceInternalService_InternalListReferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalListReferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalListReferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalListReferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalListReferences(ctx, req.(*InternalListReferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ResourceInternalService_InternalGetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InternalGetQuotaRequest)
 	if err := dec(in); err != nil {
@@ -499,6 +973,24 @@ func _ResourceInternalService_InternalInitTenant_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourceInternalService_InternalGetTenantInitStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGetTenantInitStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceInternalServiceServer).InternalGetTenantInitStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceInternalService_InternalGetTenantInitStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceInternalServiceServer).InternalGetTenantInitStatus(ctx, req.(*InternalGetTenantInitStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ResourceInternalService_ServiceDesc is the grpc.ServiceDesc for ResourceInternalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -526,6 +1018,46 @@ var ResourceInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InternalCheckFileExists",
 			Handler:    _ResourceInternalService_InternalCheckFileExists_Handler,
 		},
+		{
+			MethodName: "InternalCheckFilesExist",
+			Handler:    _ResourceInternalService_InternalCheckFilesExist_Handler,
+		},
+		{
+			MethodName: "InternalCopyFile",
+			Handler:    _ResourceInternalService_InternalCopyFile_Handler,
+		},
+		{
+			MethodName: "InternalUpdateFileMetadata",
+			Handler:    _ResourceInternalService_InternalUpdateFileMetadata_Handler,
+		},
+		{
+			MethodName: "InternalCreateVariants",
+			Handler:    _ResourceInternalService_InternalCreateVariants_Handler,
+		},
+		{
+			MethodName: "InternalGetVariantStatus",
+			Handler:    _ResourceInternalService_InternalGetVariantStatus_Handler,
+		},
+		{
+			MethodName: "InternalCreateArchive",
+			Handler:    _ResourceInternalService_InternalCreateArchive_Handler,
+		},
+		{
+			MethodName: "InternalGetArchiveStatus",
+			Handler:    _ResourceInternalService_InternalGetArchiveStatus_Handler,
+		},
+		{
+			MethodName: "InternalAttachReference",
+			Handler:    _ResourceInternalService_InternalAttachReference_Handler,
+		},
+		{
+			MethodName: "InternalDetachReference",
+			Handler:    _ResourceInternalService_InternalDetachReference_Handler,
+		},
+		{
+			MethodName: "InternalListReferences",
+			Handler:    _ResourceInternalService_InternalListReferences_Handler,
+		},
 		{
 			MethodName: "InternalGetQuota",
 			Handler:    _ResourceInternalService_InternalGetQuota_Handler,
@@ -538,6 +1070,10 @@ var ResourceInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InternalInitTenant",
 			Handler:    _ResourceInternalService_InternalInitTenant_Handler,
 		},
+		{
+			MethodName: "InternalGetTenantInitStatus",
+			Handler:    _ResourceInternalService_InternalGetTenantInitStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "resource/v1/resource_internal.proto",
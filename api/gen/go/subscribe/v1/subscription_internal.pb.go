@@ -372,37 +372,199 @@ func (InternalQuotaErrorCode) EnumDescriptor() ([]byte, []int) {
 	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{5}
 }
 
-// 订阅信息
-type InternalSubscriptionInfo struct {
-	state            protoimpl.MessageState     `protogen:"open.v1"`
-	Id               uint32                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                              // 订阅ID
-	SubscriptionCode string                     `protobuf:"bytes,2,opt,name=subscription_code,json=subscriptionCode,proto3" json:"subscription_code,omitempty"`           // 订阅编号
-	TenantCode       string                     `protobuf:"bytes,3,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`                             // 租户Code
-	TenantName       string                     `protobuf:"bytes,4,opt,name=tenant_name,json=tenantName,proto3" json:"tenant_name,omitempty"`                             // 租户名称
-	ProductCode      string                     `protobuf:"bytes,6,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                          // 产品编码
-	ProductI18N      *structpb.Struct           `protobuf:"bytes,5,opt,name=product_i18n,json=productI18n,proto3" json:"product_i18n,omitempty"`                          // 产品多语言内容
-	PlanCode         string                     `protobuf:"bytes,9,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`                                   // 套餐编码
-	PlanI18N         *structpb.Struct           `protobuf:"bytes,7,opt,name=plan_i18n,json=planI18n,proto3" json:"plan_i18n,omitempty"`                                   // 套餐多语言内容
-	Status           InternalSubscriptionStatus `protobuf:"varint,11,opt,name=status,proto3,enum=api.subscription.v1.InternalSubscriptionStatus" json:"status,omitempty"` // 订阅状态
-	AutomaticRenewal bool                       `protobuf:"varint,12,opt,name=automatic_renewal,json=automaticRenewal,proto3" json:"automatic_renewal,omitempty"`         // 是否自动续费
-	StartDate        *timestamppb.Timestamp     `protobuf:"bytes,13,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                               // 订阅开始时间
-	EndDate          *timestamppb.Timestamp     `protobuf:"bytes,14,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                     // 订阅结束时间
-	IsTrial          bool                       `protobuf:"varint,16,opt,name=is_trial,json=isTrial,proto3" json:"is_trial,omitempty"`                                    // 是否试用期
-	TrialDays        int32                      `protobuf:"varint,17,opt,name=trial_days,json=trialDays,proto3" json:"trial_days,omitempty"`                              // 试用天数
-	TrialEndDate     *timestamppb.Timestamp     `protobuf:"bytes,18,opt,name=trial_end_date,json=trialEndDate,proto3" json:"trial_end_date,omitempty"`                    // 试用结束时间
-	QuotaSnapshot    *structpb.Struct           `protobuf:"bytes,19,opt,name=quota_snapshot,json=quotaSnapshot,proto3" json:"quota_snapshot,omitempty"`                   // 配额上限快照
-	QuotaUsages      []*InternalQuotaUsageInfo  `protobuf:"bytes,20,rep,name=quota_usages,json=quotaUsages,proto3" json:"quota_usages,omitempty"`                         // 配额使用列表
-	CreateTime       *timestamppb.Timestamp     `protobuf:"bytes,21,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                            // 创建时间
-	UpdateTime       *timestamppb.Timestamp     `protobuf:"bytes,22,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`                            // 更新时间
-	CreatedBy        *string                    `protobuf:"bytes,23,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                         // 创建人
-	UpdatedBy        *string                    `protobuf:"bytes,24,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`                         // 更新人
+// 订阅事件类型
+type InternalSubscriptionEventType int32
+
+const (
+	InternalSubscriptionEventType_INTERNAL_SUBSCRIPTION_EVENT_TYPE_UNSPECIFIED   InternalSubscriptionEventType = 0
+	InternalSubscriptionEventType_INTERNAL_SUBSCRIPTION_EVENT_TYPE_CREATED       InternalSubscriptionEventType = 1 // 订阅创建
+	InternalSubscriptionEventType_INTERNAL_SUBSCRIPTION_EVENT_TYPE_RENEWED       InternalSubscriptionEventType = 2 // 订阅续费
+	InternalSubscriptionEventType_INTERNAL_SUBSCRIPTION_EVENT_TYPE_EXPIRED       InternalSubscriptionEventType = 3 // 订阅过期
+	InternalSubscriptionEventType_INTERNAL_SUBSCRIPTION_EVENT_TYPE_QUOTA_CHANGED InternalSubscriptionEventType = 4 // 配额变更（升降级、管理员调整）
+)
+
+// Enum value maps for InternalSubscriptionEventType.
+var (
+	InternalSubscriptionEventType_name = map[int32]string{
+		0: "INTERNAL_SUBSCRIPTION_EVENT_TYPE_UNSPECIFIED",
+		1: "INTERNAL_SUBSCRIPTION_EVENT_TYPE_CREATED",
+		2: "INTERNAL_SUBSCRIPTION_EVENT_TYPE_RENEWED",
+		3: "INTERNAL_SUBSCRIPTION_EVENT_TYPE_EXPIRED",
+		4: "INTERNAL_SUBSCRIPTION_EVENT_TYPE_QUOTA_CHANGED",
+	}
+	InternalSubscriptionEventType_value = map[string]int32{
+		"INTERNAL_SUBSCRIPTION_EVENT_TYPE_UNSPECIFIED":   0,
+		"INTERNAL_SUBSCRIPTION_EVENT_TYPE_CREATED":       1,
+		"INTERNAL_SUBSCRIPTION_EVENT_TYPE_RENEWED":       2,
+		"INTERNAL_SUBSCRIPTION_EVENT_TYPE_EXPIRED":       3,
+		"INTERNAL_SUBSCRIPTION_EVENT_TYPE_QUOTA_CHANGED": 4,
+	}
+)
+
+func (x InternalSubscriptionEventType) Enum() *InternalSubscriptionEventType {
+	p := new(InternalSubscriptionEventType)
+	*p = x
+	return p
+}
+
+func (x InternalSubscriptionEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InternalSubscriptionEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_subscribe_v1_subscription_internal_proto_enumTypes[6].Descriptor()
+}
+
+func (InternalSubscriptionEventType) Type() protoreflect.EnumType {
+	return &file_subscribe_v1_subscription_internal_proto_enumTypes[6]
+}
+
+func (x InternalSubscriptionEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InternalSubscriptionEventType.Descriptor instead.
+func (InternalSubscriptionEventType) EnumDescriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{6}
+}
+
+// 订阅生命周期事件（订阅服务通过消息队列发布，JSON 编码）
+type InternalSubscriptionEvent struct {
+	state            protoimpl.MessageState        `protogen:"open.v1"`
+	EventId          string                        `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                                                               // 事件ID（用于去重）
+	EventType        InternalSubscriptionEventType `protobuf:"varint,2,opt,name=event_type,json=eventType,proto3,enum=api.subscription.v1.InternalSubscriptionEventType" json:"event_type,omitempty"` // 事件类型
+	TenantCode       string                        `protobuf:"bytes,3,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`                                                      // 租户Code
+	ProductCode      string                        `protobuf:"bytes,4,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                                                   // 产品编码
+	SubscriptionCode string                        `protobuf:"bytes,5,opt,name=subscription_code,json=subscriptionCode,proto3" json:"subscription_code,omitempty"`                                    // 订阅编号
+	OccurredAt       *timestamppb.Timestamp        `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`                                                      // 发生时间
+	Subscription     *InternalSubscriptionInfo     `protobuf:"bytes,7,opt,name=subscription,proto3" json:"subscription,omitempty"`                                                                    // 事件发生后的订阅信息
+	QuotaUsages      []*InternalQuotaUsageItem     `protobuf:"bytes,8,rep,name=quota_usages,json=quotaUsages,proto3" json:"quota_usages,omitempty"`                                                   // 变更后的配额（quota_changed 时）
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
+func (x *InternalSubscriptionEvent) Reset() {
+	*x = InternalSubscriptionEvent{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalSubscriptionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalSubscriptionEvent) ProtoMessage() {}
+
+func (x *InternalSubscriptionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalSubscriptionEvent.ProtoReflect.Descriptor instead.
+func (*InternalSubscriptionEvent) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InternalSubscriptionEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *InternalSubscriptionEvent) GetEventType() InternalSubscriptionEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return InternalSubscriptionEventType_INTERNAL_SUBSCRIPTION_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *InternalSubscriptionEvent) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalSubscriptionEvent) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InternalSubscriptionEvent) GetSubscriptionCode() string {
+	if x != nil {
+		return x.SubscriptionCode
+	}
+	return ""
+}
+
+func (x *InternalSubscriptionEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *InternalSubscriptionEvent) GetSubscription() *InternalSubscriptionInfo {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *InternalSubscriptionEvent) GetQuotaUsages() []*InternalQuotaUsageItem {
+	if x != nil {
+		return x.QuotaUsages
+	}
+	return nil
+}
+
+// 订阅信息
+type InternalSubscriptionInfo struct {
+	state             protoimpl.MessageState     `protogen:"open.v1"`
+	Id                uint32                     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                                              // 订阅ID
+	SubscriptionCode  string                     `protobuf:"bytes,2,opt,name=subscription_code,json=subscriptionCode,proto3" json:"subscription_code,omitempty"`           // 订阅编号
+	TenantCode        string                     `protobuf:"bytes,3,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`                             // 租户Code
+	TenantName        string                     `protobuf:"bytes,4,opt,name=tenant_name,json=tenantName,proto3" json:"tenant_name,omitempty"`                             // 租户名称
+	ProductCode       string                     `protobuf:"bytes,6,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                          // 产品编码
+	ProductI18N       *structpb.Struct           `protobuf:"bytes,5,opt,name=product_i18n,json=productI18n,proto3" json:"product_i18n,omitempty"`                          // 产品多语言内容
+	PlanCode          string                     `protobuf:"bytes,9,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`                                   // 套餐编码
+	PlanI18N          *structpb.Struct           `protobuf:"bytes,7,opt,name=plan_i18n,json=planI18n,proto3" json:"plan_i18n,omitempty"`                                   // 套餐多语言内容
+	Status            InternalSubscriptionStatus `protobuf:"varint,11,opt,name=status,proto3,enum=api.subscription.v1.InternalSubscriptionStatus" json:"status,omitempty"` // 订阅状态
+	AutomaticRenewal  bool                       `protobuf:"varint,12,opt,name=automatic_renewal,json=automaticRenewal,proto3" json:"automatic_renewal,omitempty"`         // 是否自动续费
+	StartDate         *timestamppb.Timestamp     `protobuf:"bytes,13,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`                               // 订阅开始时间
+	EndDate           *timestamppb.Timestamp     `protobuf:"bytes,14,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`                                     // 订阅结束时间
+	IsTrial           bool                       `protobuf:"varint,16,opt,name=is_trial,json=isTrial,proto3" json:"is_trial,omitempty"`                                    // 是否试用期
+	TrialDays         int32                      `protobuf:"varint,17,opt,name=trial_days,json=trialDays,proto3" json:"trial_days,omitempty"`                              // 试用天数
+	TrialEndDate      *timestamppb.Timestamp     `protobuf:"bytes,18,opt,name=trial_end_date,json=trialEndDate,proto3" json:"trial_end_date,omitempty"`                    // 试用结束时间
+	QuotaSnapshot     *structpb.Struct           `protobuf:"bytes,19,opt,name=quota_snapshot,json=quotaSnapshot,proto3" json:"quota_snapshot,omitempty"`                   // 配额上限快照
+	QuotaUsages       []*InternalQuotaUsageInfo  `protobuf:"bytes,20,rep,name=quota_usages,json=quotaUsages,proto3" json:"quota_usages,omitempty"`                         // 配额使用列表
+	CreateTime        *timestamppb.Timestamp     `protobuf:"bytes,21,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`                            // 创建时间
+	UpdateTime        *timestamppb.Timestamp     `protobuf:"bytes,22,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`                            // 更新时间
+	CreatedBy         *string                    `protobuf:"bytes,23,opt,name=created_by,json=createdBy,proto3,oneof" json:"created_by,omitempty"`                         // 创建人
+	UpdatedBy         *string                    `protobuf:"bytes,24,opt,name=updated_by,json=updatedBy,proto3,oneof" json:"updated_by,omitempty"`                         // 更新人
+	CancelAtPeriodEnd bool                       `protobuf:"varint,25,opt,name=cancel_at_period_end,json=cancelAtPeriodEnd,proto3" json:"cancel_at_period_end,omitempty"`  // 是否在当前周期结束时终止
+	CancelledAt       *timestamppb.Timestamp     `protobuf:"bytes,26,opt,name=cancelled_at,json=cancelledAt,proto3,oneof" json:"cancelled_at,omitempty"`                   // 取消时间
+	CancelReason      *string                    `protobuf:"bytes,27,opt,name=cancel_reason,json=cancelReason,proto3,oneof" json:"cancel_reason,omitempty"`                // 取消原因
+	PausedAt          *timestamppb.Timestamp     `protobuf:"bytes,28,opt,name=paused_at,json=pausedAt,proto3,oneof" json:"paused_at,omitempty"`                            // 暂停生效时间
+	ResumeAt          *timestamppb.Timestamp     `protobuf:"bytes,29,opt,name=resume_at,json=resumeAt,proto3,oneof" json:"resume_at,omitempty"`                            // 计划恢复时间
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
 func (x *InternalSubscriptionInfo) Reset() {
 	*x = InternalSubscriptionInfo{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[0]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -414,7 +576,7 @@ func (x *InternalSubscriptionInfo) String() string {
 func (*InternalSubscriptionInfo) ProtoMessage() {}
 
 func (x *InternalSubscriptionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[0]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,7 +589,7 @@ func (x *InternalSubscriptionInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalSubscriptionInfo.ProtoReflect.Descriptor instead.
 func (*InternalSubscriptionInfo) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{0}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *InternalSubscriptionInfo) GetId() uint32 {
@@ -577,6 +739,41 @@ func (x *InternalSubscriptionInfo) GetUpdatedBy() string {
 	return ""
 }
 
+func (x *InternalSubscriptionInfo) GetCancelAtPeriodEnd() bool {
+	if x != nil {
+		return x.CancelAtPeriodEnd
+	}
+	return false
+}
+
+func (x *InternalSubscriptionInfo) GetCancelledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CancelledAt
+	}
+	return nil
+}
+
+func (x *InternalSubscriptionInfo) GetCancelReason() string {
+	if x != nil && x.CancelReason != nil {
+		return *x.CancelReason
+	}
+	return ""
+}
+
+func (x *InternalSubscriptionInfo) GetPausedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedAt
+	}
+	return nil
+}
+
+func (x *InternalSubscriptionInfo) GetResumeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResumeAt
+	}
+	return nil
+}
+
 // 配额使用信息
 type InternalQuotaUsageInfo struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
@@ -596,7 +793,7 @@ type InternalQuotaUsageInfo struct {
 
 func (x *InternalQuotaUsageInfo) Reset() {
 	*x = InternalQuotaUsageInfo{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[1]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -608,7 +805,7 @@ func (x *InternalQuotaUsageInfo) String() string {
 func (*InternalQuotaUsageInfo) ProtoMessage() {}
 
 func (x *InternalQuotaUsageInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[1]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,7 +818,7 @@ func (x *InternalQuotaUsageInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalQuotaUsageInfo.ProtoReflect.Descriptor instead.
 func (*InternalQuotaUsageInfo) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{1}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *InternalQuotaUsageInfo) GetSubscriptionCode() string {
@@ -725,7 +922,7 @@ type InternalSubscriptionOrderInfo struct {
 
 func (x *InternalSubscriptionOrderInfo) Reset() {
 	*x = InternalSubscriptionOrderInfo{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[2]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -737,7 +934,7 @@ func (x *InternalSubscriptionOrderInfo) String() string {
 func (*InternalSubscriptionOrderInfo) ProtoMessage() {}
 
 func (x *InternalSubscriptionOrderInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[2]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -750,7 +947,7 @@ func (x *InternalSubscriptionOrderInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalSubscriptionOrderInfo.ProtoReflect.Descriptor instead.
 func (*InternalSubscriptionOrderInfo) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{2}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *InternalSubscriptionOrderInfo) GetOrderNo() string {
@@ -925,7 +1122,7 @@ type InternalListSubscriptionsRequest struct {
 
 func (x *InternalListSubscriptionsRequest) Reset() {
 	*x = InternalListSubscriptionsRequest{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[3]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -937,7 +1134,7 @@ func (x *InternalListSubscriptionsRequest) String() string {
 func (*InternalListSubscriptionsRequest) ProtoMessage() {}
 
 func (x *InternalListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[3]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,7 +1147,7 @@ func (x *InternalListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalListSubscriptionsRequest.ProtoReflect.Descriptor instead.
 func (*InternalListSubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{3}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *InternalListSubscriptionsRequest) GetPage() int32 {
@@ -1029,7 +1226,7 @@ type InternalListSubscriptionsResponse struct {
 
 func (x *InternalListSubscriptionsResponse) Reset() {
 	*x = InternalListSubscriptionsResponse{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[4]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1041,7 +1238,7 @@ func (x *InternalListSubscriptionsResponse) String() string {
 func (*InternalListSubscriptionsResponse) ProtoMessage() {}
 
 func (x *InternalListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[4]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1054,7 +1251,7 @@ func (x *InternalListSubscriptionsResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InternalListSubscriptionsResponse.ProtoReflect.Descriptor instead.
 func (*InternalListSubscriptionsResponse) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{4}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *InternalListSubscriptionsResponse) GetSubscriptions() []*InternalSubscriptionInfo {
@@ -1085,6 +1282,96 @@ func (x *InternalListSubscriptionsResponse) GetPageSize() int32 {
 	return 0
 }
 
+// 获取订阅详情请求
+type InternalGetSubscriptionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionCode string                 `protobuf:"bytes,1,opt,name=subscription_code,json=subscriptionCode,proto3" json:"subscription_code,omitempty"` // 订阅Code
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InternalGetSubscriptionRequest) Reset() {
+	*x = InternalGetSubscriptionRequest{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetSubscriptionRequest) ProtoMessage() {}
+
+func (x *InternalGetSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InternalGetSubscriptionRequest) GetSubscriptionCode() string {
+	if x != nil {
+		return x.SubscriptionCode
+	}
+	return ""
+}
+
+// 获取订阅详情响应
+type InternalGetSubscriptionResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Subscription  *InternalSubscriptionInfo `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"` // 订阅信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetSubscriptionResponse) Reset() {
+	*x = InternalGetSubscriptionResponse{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetSubscriptionResponse) ProtoMessage() {}
+
+func (x *InternalGetSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InternalGetSubscriptionResponse) GetSubscription() *InternalSubscriptionInfo {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
 // 创建订阅请求
 type InternalCreateSubscriptionRequest struct {
 	state            protoimpl.MessageState         `protogen:"open.v1"`
@@ -1101,7 +1388,7 @@ type InternalCreateSubscriptionRequest struct {
 
 func (x *InternalCreateSubscriptionRequest) Reset() {
 	*x = InternalCreateSubscriptionRequest{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[5]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1113,7 +1400,7 @@ func (x *InternalCreateSubscriptionRequest) String() string {
 func (*InternalCreateSubscriptionRequest) ProtoMessage() {}
 
 func (x *InternalCreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[5]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1126,7 +1413,7 @@ func (x *InternalCreateSubscriptionRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InternalCreateSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*InternalCreateSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{5}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *InternalCreateSubscriptionRequest) GetProductCode() string {
@@ -1188,7 +1475,7 @@ type InternalCreateSubscriptionResponse struct {
 
 func (x *InternalCreateSubscriptionResponse) Reset() {
 	*x = InternalCreateSubscriptionResponse{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[6]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1200,7 +1487,7 @@ func (x *InternalCreateSubscriptionResponse) String() string {
 func (*InternalCreateSubscriptionResponse) ProtoMessage() {}
 
 func (x *InternalCreateSubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[6]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1213,7 +1500,7 @@ func (x *InternalCreateSubscriptionResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InternalCreateSubscriptionResponse.ProtoReflect.Descriptor instead.
 func (*InternalCreateSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{6}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *InternalCreateSubscriptionResponse) GetSubscription() *InternalSubscriptionInfo {
@@ -1237,7 +1524,7 @@ type InternalReNewSubscriptionRequest struct {
 
 func (x *InternalReNewSubscriptionRequest) Reset() {
 	*x = InternalReNewSubscriptionRequest{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[7]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1249,7 +1536,7 @@ func (x *InternalReNewSubscriptionRequest) String() string {
 func (*InternalReNewSubscriptionRequest) ProtoMessage() {}
 
 func (x *InternalReNewSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[7]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1262,7 +1549,7 @@ func (x *InternalReNewSubscriptionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalReNewSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*InternalReNewSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{7}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *InternalReNewSubscriptionRequest) GetSubscriptionCode() string {
@@ -1310,7 +1597,7 @@ type InternalReNewSubscriptionResponse struct {
 
 func (x *InternalReNewSubscriptionResponse) Reset() {
 	*x = InternalReNewSubscriptionResponse{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[8]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1322,7 +1609,7 @@ func (x *InternalReNewSubscriptionResponse) String() string {
 func (*InternalReNewSubscriptionResponse) ProtoMessage() {}
 
 func (x *InternalReNewSubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[8]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1335,7 +1622,7 @@ func (x *InternalReNewSubscriptionResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InternalReNewSubscriptionResponse.ProtoReflect.Descriptor instead.
 func (*InternalReNewSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{8}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *InternalReNewSubscriptionResponse) GetSubscription() *InternalSubscriptionInfo {
@@ -1360,7 +1647,7 @@ type InternalUpgradeSubscriptionRequest struct {
 
 func (x *InternalUpgradeSubscriptionRequest) Reset() {
 	*x = InternalUpgradeSubscriptionRequest{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[9]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1372,7 +1659,7 @@ func (x *InternalUpgradeSubscriptionRequest) String() string {
 func (*InternalUpgradeSubscriptionRequest) ProtoMessage() {}
 
 func (x *InternalUpgradeSubscriptionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[9]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1385,7 +1672,7 @@ func (x *InternalUpgradeSubscriptionRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use InternalUpgradeSubscriptionRequest.ProtoReflect.Descriptor instead.
 func (*InternalUpgradeSubscriptionRequest) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{9}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *InternalUpgradeSubscriptionRequest) GetSubscriptionCode() string {
@@ -1440,7 +1727,7 @@ type InternalUpgradeSubscriptionResponse struct {
 
 func (x *InternalUpgradeSubscriptionResponse) Reset() {
 	*x = InternalUpgradeSubscriptionResponse{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[10]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1452,7 +1739,552 @@ func (x *InternalUpgradeSubscriptionResponse) String() string {
 func (*InternalUpgradeSubscriptionResponse) ProtoMessage() {}
 
 func (x *InternalUpgradeSubscriptionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[10]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalUpgradeSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*InternalUpgradeSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *InternalUpgradeSubscriptionResponse) GetSubscription() *InternalSubscriptionInfo {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// 降级订阅请求
+type InternalDowngradeSubscriptionRequest struct {
+	state            protoimpl.MessageState         `protogen:"open.v1"`
+	SubscriptionCode string                         `protobuf:"bytes,1,opt,name=subscription_code,json=subscriptionCode,proto3" json:"subscription_code,omitempty"` // 订阅Code
+	ProductCode      string                         `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                // 产品Code
+	PlanCode         string                         `protobuf:"bytes,3,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`                         // 目标套餐Code
+	Immediate        bool                           `protobuf:"varint,4,opt,name=immediate,proto3" json:"immediate,omitempty"`                                      // 是否立即生效（false 时在当前计费周期结束时生效）
+	EffectiveDate    *timestamppb.Timestamp         `protobuf:"bytes,5,opt,name=effective_date,json=effectiveDate,proto3,oneof" json:"effective_date,omitempty"`    // 指定生效时间（优先于 immediate）
+	Order            *InternalSubscriptionOrderInfo `protobuf:"bytes,6,opt,name=order,proto3" json:"order,omitempty"`                                               // 订单信息
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InternalDowngradeSubscriptionRequest) Reset() {
+	*x = InternalDowngradeSubscriptionRequest{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalDowngradeSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalDowngradeSubscriptionRequest) ProtoMessage() {}
+
+func (x *InternalDowngradeSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalDowngradeSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*InternalDowngradeSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *InternalDowngradeSubscriptionRequest) GetSubscriptionCode() string {
+	if x != nil {
+		return x.SubscriptionCode
+	}
+	return ""
+}
+
+func (x *InternalDowngradeSubscriptionRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InternalDowngradeSubscriptionRequest) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *InternalDowngradeSubscriptionRequest) GetImmediate() bool {
+	if x != nil {
+		return x.Immediate
+	}
+	return false
+}
+
+func (x *InternalDowngradeSubscriptionRequest) GetEffectiveDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return nil
+}
+
+func (x *InternalDowngradeSubscriptionRequest) GetOrder() *InternalSubscriptionOrderInfo {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// 降级订阅回复
+type InternalDowngradeSubscriptionResponse struct {
+	state          protoimpl.MessageState    `protogen:"open.v1"`
+	Subscription   *InternalSubscriptionInfo `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`                            // 订阅信息
+	EffectiveDate  *timestamppb.Timestamp    `protobuf:"bytes,2,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"`     // 降级生效时间
+	ProratedCredit int64                     `protobuf:"varint,3,opt,name=prorated_credit,json=proratedCredit,proto3" json:"prorated_credit,omitempty"` // 按比例折算的抵扣金额（分）
+	Currency       string                    `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`                                    // 货币单位
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InternalDowngradeSubscriptionResponse) Reset() {
+	*x = InternalDowngradeSubscriptionResponse{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalDowngradeSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalDowngradeSubscriptionResponse) ProtoMessage() {}
+
+func (x *InternalDowngradeSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalDowngradeSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*InternalDowngradeSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InternalDowngradeSubscriptionResponse) GetSubscription() *InternalSubscriptionInfo {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *InternalDowngradeSubscriptionResponse) GetEffectiveDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return nil
+}
+
+func (x *InternalDowngradeSubscriptionResponse) GetProratedCredit() int64 {
+	if x != nil {
+		return x.ProratedCredit
+	}
+	return 0
+}
+
+func (x *InternalDowngradeSubscriptionResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// 取消订阅请求
+type InternalCancelSubscriptionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionCode string                 `protobuf:"bytes,1,opt,name=subscription_code,json=subscriptionCode,proto3" json:"subscription_code,omitempty"` // 订阅Code
+	ProductCode      string                 `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                // 产品Code
+	Immediate        bool                   `protobuf:"varint,3,opt,name=immediate,proto3" json:"immediate,omitempty"`                                      // 是否立即终止（false 时在当前周期结束时终止）
+	Reason           string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                                             // 取消原因
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InternalCancelSubscriptionRequest) Reset() {
+	*x = InternalCancelSubscriptionRequest{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCancelSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCancelSubscriptionRequest) ProtoMessage() {}
+
+func (x *InternalCancelSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCancelSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*InternalCancelSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InternalCancelSubscriptionRequest) GetSubscriptionCode() string {
+	if x != nil {
+		return x.SubscriptionCode
+	}
+	return ""
+}
+
+func (x *InternalCancelSubscriptionRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InternalCancelSubscriptionRequest) GetImmediate() bool {
+	if x != nil {
+		return x.Immediate
+	}
+	return false
+}
+
+func (x *InternalCancelSubscriptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 取消订阅回复
+type InternalCancelSubscriptionResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Subscription  *InternalSubscriptionInfo `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"` // 订阅信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCancelSubscriptionResponse) Reset() {
+	*x = InternalCancelSubscriptionResponse{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCancelSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCancelSubscriptionResponse) ProtoMessage() {}
+
+func (x *InternalCancelSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCancelSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*InternalCancelSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InternalCancelSubscriptionResponse) GetSubscription() *InternalSubscriptionInfo {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// 暂停订阅请求
+type InternalPauseSubscriptionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionCode string                 `protobuf:"bytes,1,opt,name=subscription_code,json=subscriptionCode,proto3" json:"subscription_code,omitempty"` // 订阅Code
+	ProductCode      string                 `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                // 产品Code
+	EffectiveDate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_date,json=effectiveDate,proto3,oneof" json:"effective_date,omitempty"`    // 暂停生效时间（不填立即生效）
+	ResumeDate       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=resume_date,json=resumeDate,proto3,oneof" json:"resume_date,omitempty"`             // 自动恢复时间（不填需手动恢复）
+	Reason           string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                             // 暂停原因
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InternalPauseSubscriptionRequest) Reset() {
+	*x = InternalPauseSubscriptionRequest{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalPauseSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalPauseSubscriptionRequest) ProtoMessage() {}
+
+func (x *InternalPauseSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalPauseSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*InternalPauseSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *InternalPauseSubscriptionRequest) GetSubscriptionCode() string {
+	if x != nil {
+		return x.SubscriptionCode
+	}
+	return ""
+}
+
+func (x *InternalPauseSubscriptionRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InternalPauseSubscriptionRequest) GetEffectiveDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return nil
+}
+
+func (x *InternalPauseSubscriptionRequest) GetResumeDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResumeDate
+	}
+	return nil
+}
+
+func (x *InternalPauseSubscriptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 暂停订阅回复
+type InternalPauseSubscriptionResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Subscription  *InternalSubscriptionInfo `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"` // 订阅信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalPauseSubscriptionResponse) Reset() {
+	*x = InternalPauseSubscriptionResponse{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalPauseSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalPauseSubscriptionResponse) ProtoMessage() {}
+
+func (x *InternalPauseSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalPauseSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*InternalPauseSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *InternalPauseSubscriptionResponse) GetSubscription() *InternalSubscriptionInfo {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// 恢复订阅请求
+type InternalResumeSubscriptionRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionCode string                 `protobuf:"bytes,1,opt,name=subscription_code,json=subscriptionCode,proto3" json:"subscription_code,omitempty"` // 订阅Code
+	ProductCode      string                 `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                // 产品Code
+	EffectiveDate    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_date,json=effectiveDate,proto3,oneof" json:"effective_date,omitempty"`    // 恢复生效时间（不填立即生效）
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InternalResumeSubscriptionRequest) Reset() {
+	*x = InternalResumeSubscriptionRequest{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalResumeSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalResumeSubscriptionRequest) ProtoMessage() {}
+
+func (x *InternalResumeSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalResumeSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*InternalResumeSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *InternalResumeSubscriptionRequest) GetSubscriptionCode() string {
+	if x != nil {
+		return x.SubscriptionCode
+	}
+	return ""
+}
+
+func (x *InternalResumeSubscriptionRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InternalResumeSubscriptionRequest) GetEffectiveDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return nil
+}
+
+// 恢复订阅回复
+type InternalResumeSubscriptionResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Subscription  *InternalSubscriptionInfo `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"` // 订阅信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalResumeSubscriptionResponse) Reset() {
+	*x = InternalResumeSubscriptionResponse{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalResumeSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalResumeSubscriptionResponse) ProtoMessage() {}
+
+func (x *InternalResumeSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalResumeSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*InternalResumeSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InternalResumeSubscriptionResponse) GetSubscription() *InternalSubscriptionInfo {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// 获取商户订阅状态请求
+// 试用转正式请求
+type InternalConvertTrialRequest struct {
+	state            protoimpl.MessageState         `protogen:"open.v1"`
+	SubscriptionCode string                         `protobuf:"bytes,1,opt,name=subscription_code,json=subscriptionCode,proto3" json:"subscription_code,omitempty"` // 订阅Code
+	ProductCode      string                         `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                // 产品Code
+	PlanCode         string                         `protobuf:"bytes,3,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`                         // 正式套餐Code
+	Order            *InternalSubscriptionOrderInfo `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`                                               // 订单信息
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InternalConvertTrialRequest) Reset() {
+	*x = InternalConvertTrialRequest{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalConvertTrialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalConvertTrialRequest) ProtoMessage() {}
+
+func (x *InternalConvertTrialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1463,19 +2295,190 @@ func (x *InternalUpgradeSubscriptionResponse) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalUpgradeSubscriptionResponse.ProtoReflect.Descriptor instead.
-func (*InternalUpgradeSubscriptionResponse) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use InternalConvertTrialRequest.ProtoReflect.Descriptor instead.
+func (*InternalConvertTrialRequest) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *InternalUpgradeSubscriptionResponse) GetSubscription() *InternalSubscriptionInfo {
+func (x *InternalConvertTrialRequest) GetSubscriptionCode() string {
+	if x != nil {
+		return x.SubscriptionCode
+	}
+	return ""
+}
+
+func (x *InternalConvertTrialRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InternalConvertTrialRequest) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *InternalConvertTrialRequest) GetOrder() *InternalSubscriptionOrderInfo {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+// 试用转正式回复
+type InternalConvertTrialResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Subscription  *InternalSubscriptionInfo `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"` // 订阅信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalConvertTrialResponse) Reset() {
+	*x = InternalConvertTrialResponse{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalConvertTrialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalConvertTrialResponse) ProtoMessage() {}
+
+func (x *InternalConvertTrialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalConvertTrialResponse.ProtoReflect.Descriptor instead.
+func (*InternalConvertTrialResponse) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InternalConvertTrialResponse) GetSubscription() *InternalSubscriptionInfo {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// 延长试用期请求
+type InternalExtendTrialRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	SubscriptionCode string                 `protobuf:"bytes,1,opt,name=subscription_code,json=subscriptionCode,proto3" json:"subscription_code,omitempty"` // 订阅Code
+	ProductCode      string                 `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`                // 产品Code
+	ExtendTime       *durationpb.Duration   `protobuf:"bytes,3,opt,name=extend_time,json=extendTime,proto3" json:"extend_time,omitempty"`                   // 延长时长
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *InternalExtendTrialRequest) Reset() {
+	*x = InternalExtendTrialRequest{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalExtendTrialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalExtendTrialRequest) ProtoMessage() {}
+
+func (x *InternalExtendTrialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalExtendTrialRequest.ProtoReflect.Descriptor instead.
+func (*InternalExtendTrialRequest) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *InternalExtendTrialRequest) GetSubscriptionCode() string {
+	if x != nil {
+		return x.SubscriptionCode
+	}
+	return ""
+}
+
+func (x *InternalExtendTrialRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InternalExtendTrialRequest) GetExtendTime() *durationpb.Duration {
+	if x != nil {
+		return x.ExtendTime
+	}
+	return nil
+}
+
+// 延长试用期回复
+type InternalExtendTrialResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Subscription  *InternalSubscriptionInfo `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"` // 订阅信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalExtendTrialResponse) Reset() {
+	*x = InternalExtendTrialResponse{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalExtendTrialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalExtendTrialResponse) ProtoMessage() {}
+
+func (x *InternalExtendTrialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalExtendTrialResponse.ProtoReflect.Descriptor instead.
+func (*InternalExtendTrialResponse) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InternalExtendTrialResponse) GetSubscription() *InternalSubscriptionInfo {
 	if x != nil {
 		return x.Subscription
 	}
 	return nil
 }
 
-// 获取商户订阅状态请求
 type InternalGetSubscriptionStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TenantCode    string                 `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"` // 商户code
@@ -1485,7 +2488,7 @@ type InternalGetSubscriptionStatsRequest struct {
 
 func (x *InternalGetSubscriptionStatsRequest) Reset() {
 	*x = InternalGetSubscriptionStatsRequest{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[11]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1497,7 +2500,7 @@ func (x *InternalGetSubscriptionStatsRequest) String() string {
 func (*InternalGetSubscriptionStatsRequest) ProtoMessage() {}
 
 func (x *InternalGetSubscriptionStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[11]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1510,7 +2513,7 @@ func (x *InternalGetSubscriptionStatsRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use InternalGetSubscriptionStatsRequest.ProtoReflect.Descriptor instead.
 func (*InternalGetSubscriptionStatsRequest) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{11}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *InternalGetSubscriptionStatsRequest) GetTenantCode() string {
@@ -1533,7 +2536,7 @@ type InternalGetSubscriptionStatsResponse struct {
 
 func (x *InternalGetSubscriptionStatsResponse) Reset() {
 	*x = InternalGetSubscriptionStatsResponse{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[12]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1545,7 +2548,7 @@ func (x *InternalGetSubscriptionStatsResponse) String() string {
 func (*InternalGetSubscriptionStatsResponse) ProtoMessage() {}
 
 func (x *InternalGetSubscriptionStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[12]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1558,7 +2561,7 @@ func (x *InternalGetSubscriptionStatsResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use InternalGetSubscriptionStatsResponse.ProtoReflect.Descriptor instead.
 func (*InternalGetSubscriptionStatsResponse) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{12}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *InternalGetSubscriptionStatsResponse) GetActiveCount() int32 {
@@ -1592,13 +2595,17 @@ func (x *InternalGetSubscriptionStatsResponse) GetMonthPrice() int64 {
 type InternalGetSubscriptionStatsByProductCodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductCode   string                 `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"` // 产品code
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"` // 统计开始时间（新增、流失数量的统计区间）
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`       // 统计结束时间
+	Page          *int32                 `protobuf:"varint,4,opt,name=page,proto3,oneof" json:"page,omitempty"`                           // 套餐统计页码
+	PageSize      *int32                 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3,oneof" json:"page_size,omitempty"`   // 套餐统计每页数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InternalGetSubscriptionStatsByProductCodeRequest) Reset() {
 	*x = InternalGetSubscriptionStatsByProductCodeRequest{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[13]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1610,52 +2617,168 @@ func (x *InternalGetSubscriptionStatsByProductCodeRequest) String() string {
 func (*InternalGetSubscriptionStatsByProductCodeRequest) ProtoMessage() {}
 
 func (x *InternalGetSubscriptionStatsByProductCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[13]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetSubscriptionStatsByProductCodeRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetSubscriptionStatsByProductCodeRequest) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *InternalGetSubscriptionStatsByProductCodeRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InternalGetSubscriptionStatsByProductCodeRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *InternalGetSubscriptionStatsByProductCodeRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *InternalGetSubscriptionStatsByProductCodeRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *InternalGetSubscriptionStatsByProductCodeRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+type InternalGetSubscriptionStatsByProductCodeResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	ActiveCount   int32                            `protobuf:"varint,1,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"`    // 已订阅数量
+	TrialCount    int32                            `protobuf:"varint,2,opt,name=trial_count,json=trialCount,proto3" json:"trial_count,omitempty"`       // 试用中数量
+	NewCount      int32                            `protobuf:"varint,3,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`             // 统计区间内新增数量
+	ChurnedCount  int32                            `protobuf:"varint,4,opt,name=churned_count,json=churnedCount,proto3" json:"churned_count,omitempty"` // 统计区间内流失数量（过期未续费、取消）
+	PlanStats     []*InternalPlanSubscriptionStats `protobuf:"bytes,5,rep,name=plan_stats,json=planStats,proto3" json:"plan_stats,omitempty"`           // 按套餐统计
+	PlanTotal     int32                            `protobuf:"varint,6,opt,name=plan_total,json=planTotal,proto3" json:"plan_total,omitempty"`          // 套餐总数
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetSubscriptionStatsByProductCodeResponse) Reset() {
+	*x = InternalGetSubscriptionStatsByProductCodeResponse{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetSubscriptionStatsByProductCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetSubscriptionStatsByProductCodeResponse) ProtoMessage() {}
+
+func (x *InternalGetSubscriptionStatsByProductCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetSubscriptionStatsByProductCodeResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetSubscriptionStatsByProductCodeResponse) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InternalGetSubscriptionStatsByProductCodeResponse) GetActiveCount() int32 {
+	if x != nil {
+		return x.ActiveCount
+	}
+	return 0
+}
+
+func (x *InternalGetSubscriptionStatsByProductCodeResponse) GetTrialCount() int32 {
+	if x != nil {
+		return x.TrialCount
+	}
+	return 0
+}
+
+func (x *InternalGetSubscriptionStatsByProductCodeResponse) GetNewCount() int32 {
+	if x != nil {
+		return x.NewCount
+	}
+	return 0
+}
+
+func (x *InternalGetSubscriptionStatsByProductCodeResponse) GetChurnedCount() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ChurnedCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use InternalGetSubscriptionStatsByProductCodeRequest.ProtoReflect.Descriptor instead.
-func (*InternalGetSubscriptionStatsByProductCodeRequest) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{13}
+func (x *InternalGetSubscriptionStatsByProductCodeResponse) GetPlanStats() []*InternalPlanSubscriptionStats {
+	if x != nil {
+		return x.PlanStats
+	}
+	return nil
 }
 
-func (x *InternalGetSubscriptionStatsByProductCodeRequest) GetProductCode() string {
+func (x *InternalGetSubscriptionStatsByProductCodeResponse) GetPlanTotal() int32 {
 	if x != nil {
-		return x.ProductCode
+		return x.PlanTotal
 	}
-	return ""
+	return 0
 }
 
-type InternalGetSubscriptionStatsByProductCodeResponse struct {
+// 套餐订阅统计
+type InternalPlanSubscriptionStats struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActiveCount   int32                  `protobuf:"varint,1,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"` // 已订阅数量
-	TrialCount    int32                  `protobuf:"varint,2,opt,name=trial_count,json=trialCount,proto3" json:"trial_count,omitempty"`    // 试用中数量
+	PlanCode      string                 `protobuf:"bytes,1,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`              // 套餐编码
+	ActiveCount   int32                  `protobuf:"varint,2,opt,name=active_count,json=activeCount,proto3" json:"active_count,omitempty"`    // 已订阅数量
+	TrialCount    int32                  `protobuf:"varint,3,opt,name=trial_count,json=trialCount,proto3" json:"trial_count,omitempty"`       // 试用中数量
+	NewCount      int32                  `protobuf:"varint,4,opt,name=new_count,json=newCount,proto3" json:"new_count,omitempty"`             // 统计区间内新增数量
+	ChurnedCount  int32                  `protobuf:"varint,5,opt,name=churned_count,json=churnedCount,proto3" json:"churned_count,omitempty"` // 统计区间内流失数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InternalGetSubscriptionStatsByProductCodeResponse) Reset() {
-	*x = InternalGetSubscriptionStatsByProductCodeResponse{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[14]
+func (x *InternalPlanSubscriptionStats) Reset() {
+	*x = InternalPlanSubscriptionStats{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InternalGetSubscriptionStatsByProductCodeResponse) String() string {
+func (x *InternalPlanSubscriptionStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InternalGetSubscriptionStatsByProductCodeResponse) ProtoMessage() {}
+func (*InternalPlanSubscriptionStats) ProtoMessage() {}
 
-func (x *InternalGetSubscriptionStatsByProductCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[14]
+func (x *InternalPlanSubscriptionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1666,25 +2789,46 @@ func (x *InternalGetSubscriptionStatsByProductCodeResponse) ProtoReflect() proto
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InternalGetSubscriptionStatsByProductCodeResponse.ProtoReflect.Descriptor instead.
-func (*InternalGetSubscriptionStatsByProductCodeResponse) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use InternalPlanSubscriptionStats.ProtoReflect.Descriptor instead.
+func (*InternalPlanSubscriptionStats) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *InternalGetSubscriptionStatsByProductCodeResponse) GetActiveCount() int32 {
+func (x *InternalPlanSubscriptionStats) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *InternalPlanSubscriptionStats) GetActiveCount() int32 {
 	if x != nil {
 		return x.ActiveCount
 	}
 	return 0
 }
 
-func (x *InternalGetSubscriptionStatsByProductCodeResponse) GetTrialCount() int32 {
+func (x *InternalPlanSubscriptionStats) GetTrialCount() int32 {
 	if x != nil {
 		return x.TrialCount
 	}
 	return 0
 }
 
+func (x *InternalPlanSubscriptionStats) GetNewCount() int32 {
+	if x != nil {
+		return x.NewCount
+	}
+	return 0
+}
+
+func (x *InternalPlanSubscriptionStats) GetChurnedCount() int32 {
+	if x != nil {
+		return x.ChurnedCount
+	}
+	return 0
+}
+
 // InternalCheckAndUseQuotaRequest 检查并使用配额请求
 type InternalCheckAndUseQuotaRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1695,14 +2839,16 @@ type InternalCheckAndUseQuotaRequest struct {
 	// 维度键（必填），如 "goods_count"
 	DimensionKey string `protobuf:"bytes,3,opt,name=dimension_key,json=dimensionKey,proto3" json:"dimension_key,omitempty"`
 	// 使用数量，默认为 1
-	Amount        int32 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Amount int32 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 幂等键（可选），相同幂等键的重复请求只扣减一次并返回首次结果
+	IdempotencyKey *string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *InternalCheckAndUseQuotaRequest) Reset() {
 	*x = InternalCheckAndUseQuotaRequest{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[15]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1714,7 +2860,7 @@ func (x *InternalCheckAndUseQuotaRequest) String() string {
 func (*InternalCheckAndUseQuotaRequest) ProtoMessage() {}
 
 func (x *InternalCheckAndUseQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[15]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1727,7 +2873,7 @@ func (x *InternalCheckAndUseQuotaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalCheckAndUseQuotaRequest.ProtoReflect.Descriptor instead.
 func (*InternalCheckAndUseQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{15}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *InternalCheckAndUseQuotaRequest) GetTenantCode() string {
@@ -1758,6 +2904,13 @@ func (x *InternalCheckAndUseQuotaRequest) GetAmount() int32 {
 	return 0
 }
 
+func (x *InternalCheckAndUseQuotaRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
 // InternalCheckAndUseQuotaResponse 检查并使用配额响应
 type InternalCheckAndUseQuotaResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1778,14 +2931,16 @@ type InternalCheckAndUseQuotaResponse struct {
 	// 错误信息（失败时）
 	ErrorMessage string `protobuf:"bytes,8,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	// 错误码
-	ErrorCode     InternalQuotaErrorCode `protobuf:"varint,9,opt,name=error_code,json=errorCode,proto3,enum=api.subscription.v1.InternalQuotaErrorCode" json:"error_code,omitempty"`
+	ErrorCode InternalQuotaErrorCode `protobuf:"varint,9,opt,name=error_code,json=errorCode,proto3,enum=api.subscription.v1.InternalQuotaErrorCode" json:"error_code,omitempty"`
+	// 是否为幂等重放（命中已处理的幂等键，本次未重复扣减）
+	Replayed      bool `protobuf:"varint,10,opt,name=replayed,proto3" json:"replayed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InternalCheckAndUseQuotaResponse) Reset() {
 	*x = InternalCheckAndUseQuotaResponse{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[16]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1797,7 +2952,7 @@ func (x *InternalCheckAndUseQuotaResponse) String() string {
 func (*InternalCheckAndUseQuotaResponse) ProtoMessage() {}
 
 func (x *InternalCheckAndUseQuotaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[16]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1810,7 +2965,7 @@ func (x *InternalCheckAndUseQuotaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalCheckAndUseQuotaResponse.ProtoReflect.Descriptor instead.
 func (*InternalCheckAndUseQuotaResponse) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{16}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *InternalCheckAndUseQuotaResponse) GetSuccess() bool {
@@ -1876,8 +3031,15 @@ func (x *InternalCheckAndUseQuotaResponse) GetErrorCode() InternalQuotaErrorCode
 	return InternalQuotaErrorCode_INTERNAL_QUOTA_ERROR_UNKNOWN
 }
 
-// InternalReleaseQuotaRequest 释放配额请求
-type InternalReleaseQuotaRequest struct {
+func (x *InternalCheckAndUseQuotaResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
+// InternalCheckQuotaRequest 预检查配额请求
+type InternalCheckQuotaRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 租户编码（必填）
 	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
@@ -1885,15 +3047,199 @@ type InternalReleaseQuotaRequest struct {
 	ProductCode string `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
 	// 维度键（必填），如 "goods_count"
 	DimensionKey string `protobuf:"bytes,3,opt,name=dimension_key,json=dimensionKey,proto3" json:"dimension_key,omitempty"`
-	// 释放数量，默认为 1
+	// 预计使用数量，默认为 1
 	Amount        int32 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *InternalCheckQuotaRequest) Reset() {
+	*x = InternalCheckQuotaRequest{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCheckQuotaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCheckQuotaRequest) ProtoMessage() {}
+
+func (x *InternalCheckQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCheckQuotaRequest.ProtoReflect.Descriptor instead.
+func (*InternalCheckQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *InternalCheckQuotaRequest) GetTenantCode() string {
+	if x != nil {
+		return x.TenantCode
+	}
+	return ""
+}
+
+func (x *InternalCheckQuotaRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InternalCheckQuotaRequest) GetDimensionKey() string {
+	if x != nil {
+		return x.DimensionKey
+	}
+	return ""
+}
+
+func (x *InternalCheckQuotaRequest) GetAmount() int32 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// InternalCheckQuotaResponse 预检查配额响应
+type InternalCheckQuotaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否允许使用
+	Allowed bool `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// 维度键
+	DimensionKey string `protobuf:"bytes,2,opt,name=dimension_key,json=dimensionKey,proto3" json:"dimension_key,omitempty"`
+	// 配额上限
+	QuotaLimit int32 `protobuf:"varint,3,opt,name=quota_limit,json=quotaLimit,proto3" json:"quota_limit,omitempty"`
+	// 当前已用量
+	QuotaUsed int32 `protobuf:"varint,4,opt,name=quota_used,json=quotaUsed,proto3" json:"quota_used,omitempty"`
+	// 剩余配额
+	QuotaRemaining int32 `protobuf:"varint,5,opt,name=quota_remaining,json=quotaRemaining,proto3" json:"quota_remaining,omitempty"`
+	// 是否无限制
+	IsUnlimited bool `protobuf:"varint,6,opt,name=is_unlimited,json=isUnlimited,proto3" json:"is_unlimited,omitempty"`
+	// 错误信息（不允许时）
+	ErrorMessage string `protobuf:"bytes,7,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// 错误码
+	ErrorCode     InternalQuotaErrorCode `protobuf:"varint,8,opt,name=error_code,json=errorCode,proto3,enum=api.subscription.v1.InternalQuotaErrorCode" json:"error_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalCheckQuotaResponse) Reset() {
+	*x = InternalCheckQuotaResponse{}
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCheckQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCheckQuotaResponse) ProtoMessage() {}
+
+func (x *InternalCheckQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCheckQuotaResponse.ProtoReflect.Descriptor instead.
+func (*InternalCheckQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *InternalCheckQuotaResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *InternalCheckQuotaResponse) GetDimensionKey() string {
+	if x != nil {
+		return x.DimensionKey
+	}
+	return ""
+}
+
+func (x *InternalCheckQuotaResponse) GetQuotaLimit() int32 {
+	if x != nil {
+		return x.QuotaLimit
+	}
+	return 0
+}
+
+func (x *InternalCheckQuotaResponse) GetQuotaUsed() int32 {
+	if x != nil {
+		return x.QuotaUsed
+	}
+	return 0
+}
+
+func (x *InternalCheckQuotaResponse) GetQuotaRemaining() int32 {
+	if x != nil {
+		return x.QuotaRemaining
+	}
+	return 0
+}
+
+func (x *InternalCheckQuotaResponse) GetIsUnlimited() bool {
+	if x != nil {
+		return x.IsUnlimited
+	}
+	return false
+}
+
+func (x *InternalCheckQuotaResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *InternalCheckQuotaResponse) GetErrorCode() InternalQuotaErrorCode {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return InternalQuotaErrorCode_INTERNAL_QUOTA_ERROR_UNKNOWN
+}
+
+// InternalReleaseQuotaRequest 释放配额请求
+type InternalReleaseQuotaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 租户编码（必填）
+	TenantCode string `protobuf:"bytes,1,opt,name=tenant_code,json=tenantCode,proto3" json:"tenant_code,omitempty"`
+	// 产品编码（必填）
+	ProductCode string `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	// 维度键（必填），如 "goods_count"
+	DimensionKey string `protobuf:"bytes,3,opt,name=dimension_key,json=dimensionKey,proto3" json:"dimension_key,omitempty"`
+	// 释放数量，默认为 1
+	Amount int32 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// 幂等键（可选），相同幂等键的重复请求只释放一次并返回首次结果
+	IdempotencyKey *string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *InternalReleaseQuotaRequest) Reset() {
 	*x = InternalReleaseQuotaRequest{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[17]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1905,7 +3251,7 @@ func (x *InternalReleaseQuotaRequest) String() string {
 func (*InternalReleaseQuotaRequest) ProtoMessage() {}
 
 func (x *InternalReleaseQuotaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[17]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1918,7 +3264,7 @@ func (x *InternalReleaseQuotaRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalReleaseQuotaRequest.ProtoReflect.Descriptor instead.
 func (*InternalReleaseQuotaRequest) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{17}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *InternalReleaseQuotaRequest) GetTenantCode() string {
@@ -1949,6 +3295,13 @@ func (x *InternalReleaseQuotaRequest) GetAmount() int32 {
 	return 0
 }
 
+func (x *InternalReleaseQuotaRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
 // InternalReleaseQuotaResponse 释放配额响应
 type InternalReleaseQuotaResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1961,14 +3314,16 @@ type InternalReleaseQuotaResponse struct {
 	// 释放后已用量
 	QuotaUsedAfter int32 `protobuf:"varint,4,opt,name=quota_used_after,json=quotaUsedAfter,proto3" json:"quota_used_after,omitempty"`
 	// 错误信息
-	ErrorMessage  string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ErrorMessage string `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	// 是否为幂等重放（命中已处理的幂等键，本次未重复释放）
+	Replayed      bool `protobuf:"varint,6,opt,name=replayed,proto3" json:"replayed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *InternalReleaseQuotaResponse) Reset() {
 	*x = InternalReleaseQuotaResponse{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[18]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1980,7 +3335,7 @@ func (x *InternalReleaseQuotaResponse) String() string {
 func (*InternalReleaseQuotaResponse) ProtoMessage() {}
 
 func (x *InternalReleaseQuotaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[18]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1993,7 +3348,7 @@ func (x *InternalReleaseQuotaResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalReleaseQuotaResponse.ProtoReflect.Descriptor instead.
 func (*InternalReleaseQuotaResponse) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{18}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *InternalReleaseQuotaResponse) GetSuccess() bool {
@@ -2031,6 +3386,13 @@ func (x *InternalReleaseQuotaResponse) GetErrorMessage() string {
 	return ""
 }
 
+func (x *InternalReleaseQuotaResponse) GetReplayed() bool {
+	if x != nil {
+		return x.Replayed
+	}
+	return false
+}
+
 // InternalGetQuotaUsageRequest 查询配额使用情况请求
 type InternalGetQuotaUsageRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2046,7 +3408,7 @@ type InternalGetQuotaUsageRequest struct {
 
 func (x *InternalGetQuotaUsageRequest) Reset() {
 	*x = InternalGetQuotaUsageRequest{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[19]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2058,7 +3420,7 @@ func (x *InternalGetQuotaUsageRequest) String() string {
 func (*InternalGetQuotaUsageRequest) ProtoMessage() {}
 
 func (x *InternalGetQuotaUsageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[19]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2071,7 +3433,7 @@ func (x *InternalGetQuotaUsageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalGetQuotaUsageRequest.ProtoReflect.Descriptor instead.
 func (*InternalGetQuotaUsageRequest) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{19}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *InternalGetQuotaUsageRequest) GetTenantCode() string {
@@ -2108,7 +3470,7 @@ type InternalGetQuotaUsageResponse struct {
 
 func (x *InternalGetQuotaUsageResponse) Reset() {
 	*x = InternalGetQuotaUsageResponse{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[20]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2120,7 +3482,7 @@ func (x *InternalGetQuotaUsageResponse) String() string {
 func (*InternalGetQuotaUsageResponse) ProtoMessage() {}
 
 func (x *InternalGetQuotaUsageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[20]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2133,7 +3495,7 @@ func (x *InternalGetQuotaUsageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalGetQuotaUsageResponse.ProtoReflect.Descriptor instead.
 func (*InternalGetQuotaUsageResponse) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{20}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *InternalGetQuotaUsageResponse) GetSubscriptionCode() string {
@@ -2173,7 +3535,7 @@ type InternalQuotaUsageItem struct {
 
 func (x *InternalQuotaUsageItem) Reset() {
 	*x = InternalQuotaUsageItem{}
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[21]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2185,7 +3547,7 @@ func (x *InternalQuotaUsageItem) String() string {
 func (*InternalQuotaUsageItem) ProtoMessage() {}
 
 func (x *InternalQuotaUsageItem) ProtoReflect() protoreflect.Message {
-	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[21]
+	mi := &file_subscribe_v1_subscription_internal_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2198,7 +3560,7 @@ func (x *InternalQuotaUsageItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalQuotaUsageItem.ProtoReflect.Descriptor instead.
 func (*InternalQuotaUsageItem) Descriptor() ([]byte, []int) {
-	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{21}
+	return file_subscribe_v1_subscription_internal_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *InternalQuotaUsageItem) GetDimensionKey() string {
@@ -2254,7 +3616,20 @@ var File_subscribe_v1_subscription_internal_proto protoreflect.FileDescriptor
 
 const file_subscribe_v1_subscription_internal_proto_rawDesc = "" +
 	"\n" +
-	"(subscribe/v1/subscription_internal.proto\x12\x13api.subscription.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\"\x9f\b\n" +
+	"(subscribe/v1/subscription_internal.proto\x12\x13api.subscription.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/duration.proto\"\xda\x03\n" +
+	"\x19InternalSubscriptionEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12Q\n" +
+	"\n" +
+	"event_type\x18\x02 \x01(\x0e22.api.subscription.v1.InternalSubscriptionEventTypeR\teventType\x12\x1f\n" +
+	"\vtenant_code\x18\x03 \x01(\tR\n" +
+	"tenantCode\x12!\n" +
+	"\fproduct_code\x18\x04 \x01(\tR\vproductCode\x12+\n" +
+	"\x11subscription_code\x18\x05 \x01(\tR\x10subscriptionCode\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\x12Q\n" +
+	"\fsubscription\x18\a \x01(\v2-.api.subscription.v1.InternalSubscriptionInfoR\fsubscription\x12N\n" +
+	"\fquota_usages\x18\b \x03(\v2+.api.subscription.v1.InternalQuotaUsageItemR\vquotaUsages\"\xf9\n" +
+	"\n" +
 	"\x18InternalSubscriptionInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12+\n" +
 	"\x11subscription_code\x18\x02 \x01(\tR\x10subscriptionCode\x12\x1f\n" +
@@ -2284,9 +3659,20 @@ const file_subscribe_v1_subscription_internal_proto_rawDesc = "" +
 	"\n" +
 	"created_by\x18\x17 \x01(\tH\x00R\tcreatedBy\x88\x01\x01\x12\"\n" +
 	"\n" +
-	"updated_by\x18\x18 \x01(\tH\x01R\tupdatedBy\x88\x01\x01B\r\n" +
+	"updated_by\x18\x18 \x01(\tH\x01R\tupdatedBy\x88\x01\x01\x12/\n" +
+	"\x14cancel_at_period_end\x18\x19 \x01(\bR\x11cancelAtPeriodEnd\x12B\n" +
+	"\fcancelled_at\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampH\x02R\vcancelledAt\x88\x01\x01\x12(\n" +
+	"\rcancel_reason\x18\x1b \x01(\tH\x03R\fcancelReason\x88\x01\x01\x12<\n" +
+	"\tpaused_at\x18\x1c \x01(\v2\x1a.google.protobuf.TimestampH\x04R\bpausedAt\x88\x01\x01\x12<\n" +
+	"\tresume_at\x18\x1d \x01(\v2\x1a.google.protobuf.TimestampH\x05R\bresumeAt\x88\x01\x01B\r\n" +
 	"\v_created_byB\r\n" +
-	"\v_updated_by\"\xca\x03\n" +
+	"\v_updated_byB\x0f\n" +
+	"\r_cancelled_atB\x10\n" +
+	"\x0e_cancel_reasonB\f\n" +
+	"\n" +
+	"_paused_atB\f\n" +
+	"\n" +
+	"_resume_at\"\xca\x03\n" +
 	"\x16InternalQuotaUsageInfo\x12+\n" +
 	"\x11subscription_code\x18\x01 \x01(\tR\x10subscriptionCode\x12#\n" +
 	"\rdimension_key\x18\x02 \x01(\tR\fdimensionKey\x12>\n" +
@@ -2375,7 +3761,11 @@ const file_subscribe_v1_subscription_internal_proto_rawDesc = "" +
 	"\rsubscriptions\x18\x01 \x03(\v2-.api.subscription.v1.InternalSubscriptionInfoR\rsubscriptions\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xf9\x02\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"M\n" +
+	"\x1eInternalGetSubscriptionRequest\x12+\n" +
+	"\x11subscription_code\x18\x01 \x01(\tR\x10subscriptionCode\"t\n" +
+	"\x1fInternalGetSubscriptionResponse\x12Q\n" +
+	"\fsubscription\x18\x01 \x01(\v2-.api.subscription.v1.InternalSubscriptionInfoR\fsubscription\"\xf9\x02\n" +
 	"!InternalCreateSubscriptionRequest\x12!\n" +
 	"\fproduct_code\x18\x01 \x01(\tR\vproductCode\x12\x1b\n" +
 	"\tplan_code\x18\x02 \x01(\tR\bplanCode\x12+\n" +
@@ -2406,6 +3796,58 @@ const file_subscribe_v1_subscription_internal_proto_rawDesc = "" +
 	"\x05order\x18\x06 \x01(\v22.api.subscription.v1.InternalSubscriptionOrderInfoR\x05orderB\v\n" +
 	"\t_end_date\"x\n" +
 	"#InternalUpgradeSubscriptionResponse\x12Q\n" +
+	"\fsubscription\x18\x01 \x01(\v2-.api.subscription.v1.InternalSubscriptionInfoR\fsubscription\"\xd6\x02\n" +
+	"$InternalDowngradeSubscriptionRequest\x12+\n" +
+	"\x11subscription_code\x18\x01 \x01(\tR\x10subscriptionCode\x12!\n" +
+	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12\x1b\n" +
+	"\tplan_code\x18\x03 \x01(\tR\bplanCode\x12\x1c\n" +
+	"\timmediate\x18\x04 \x01(\bR\timmediate\x12F\n" +
+	"\x0eeffective_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\reffectiveDate\x88\x01\x01\x12H\n" +
+	"\x05order\x18\x06 \x01(\v22.api.subscription.v1.InternalSubscriptionOrderInfoR\x05orderB\x11\n" +
+	"\x0f_effective_date\"\x82\x02\n" +
+	"%InternalDowngradeSubscriptionResponse\x12Q\n" +
+	"\fsubscription\x18\x01 \x01(\v2-.api.subscription.v1.InternalSubscriptionInfoR\fsubscription\x12A\n" +
+	"\x0eeffective_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveDate\x12'\n" +
+	"\x0fprorated_credit\x18\x03 \x01(\x03R\x0eproratedCredit\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"\xa9\x01\n" +
+	"!InternalCancelSubscriptionRequest\x12+\n" +
+	"\x11subscription_code\x18\x01 \x01(\tR\x10subscriptionCode\x12!\n" +
+	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12\x1c\n" +
+	"\timmediate\x18\x03 \x01(\bR\timmediate\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"w\n" +
+	"\"InternalCancelSubscriptionResponse\x12Q\n" +
+	"\fsubscription\x18\x01 \x01(\v2-.api.subscription.v1.InternalSubscriptionInfoR\fsubscription\"\xb7\x02\n" +
+	" InternalPauseSubscriptionRequest\x12+\n" +
+	"\x11subscription_code\x18\x01 \x01(\tR\x10subscriptionCode\x12!\n" +
+	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12F\n" +
+	"\x0eeffective_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\reffectiveDate\x88\x01\x01\x12@\n" +
+	"\vresume_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\n" +
+	"resumeDate\x88\x01\x01\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reasonB\x11\n" +
+	"\x0f_effective_dateB\x0e\n" +
+	"\f_resume_date\"v\n" +
+	"!InternalPauseSubscriptionResponse\x12Q\n" +
+	"\fsubscription\x18\x01 \x01(\v2-.api.subscription.v1.InternalSubscriptionInfoR\fsubscription\"\xce\x01\n" +
+	"!InternalResumeSubscriptionRequest\x12+\n" +
+	"\x11subscription_code\x18\x01 \x01(\tR\x10subscriptionCode\x12!\n" +
+	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12F\n" +
+	"\x0eeffective_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\reffectiveDate\x88\x01\x01B\x11\n" +
+	"\x0f_effective_date\"w\n" +
+	"\"InternalResumeSubscriptionResponse\x12Q\n" +
+	"\fsubscription\x18\x01 \x01(\v2-.api.subscription.v1.InternalSubscriptionInfoR\fsubscription\"\xd4\x01\n" +
+	"\x1bInternalConvertTrialRequest\x12+\n" +
+	"\x11subscription_code\x18\x01 \x01(\tR\x10subscriptionCode\x12!\n" +
+	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12\x1b\n" +
+	"\tplan_code\x18\x03 \x01(\tR\bplanCode\x12H\n" +
+	"\x05order\x18\x04 \x01(\v22.api.subscription.v1.InternalSubscriptionOrderInfoR\x05order\"q\n" +
+	"\x1cInternalConvertTrialResponse\x12Q\n" +
+	"\fsubscription\x18\x01 \x01(\v2-.api.subscription.v1.InternalSubscriptionInfoR\fsubscription\"\xa8\x01\n" +
+	"\x1aInternalExtendTrialRequest\x12+\n" +
+	"\x11subscription_code\x18\x01 \x01(\tR\x10subscriptionCode\x12!\n" +
+	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12:\n" +
+	"\vextend_time\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"extendTime\"p\n" +
+	"\x1bInternalExtendTrialResponse\x12Q\n" +
 	"\fsubscription\x18\x01 \x01(\v2-.api.subscription.v1.InternalSubscriptionInfoR\fsubscription\"F\n" +
 	"#InternalGetSubscriptionStatsRequest\x12\x1f\n" +
 	"\vtenant_code\x18\x01 \x01(\tR\n" +
@@ -2416,19 +3858,44 @@ const file_subscribe_v1_subscription_internal_proto_rawDesc = "" +
 	"trialCount\x12.\n" +
 	"\x13expiring_soon_count\x18\x03 \x01(\x05R\x11expiringSooncount\x12\x1f\n" +
 	"\vmonth_price\x18\x04 \x01(\x03R\n" +
-	"monthPrice\"U\n" +
+	"monthPrice\"\xbf\x02\n" +
 	"0InternalGetSubscriptionStatsByProductCodeRequest\x12!\n" +
-	"\fproduct_code\x18\x01 \x01(\tR\vproductCode\"w\n" +
+	"\fproduct_code\x18\x01 \x01(\tR\vproductCode\x12>\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\tstartTime\x88\x01\x01\x12:\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x01R\aendTime\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x04 \x01(\x05H\x02R\x04page\x88\x01\x01\x12 \n" +
+	"\tpage_size\x18\x05 \x01(\x05H\x03R\bpageSize\x88\x01\x01B\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\a\n" +
+	"\x05_pageB\f\n" +
+	"\n" +
+	"_page_size\"\xab\x02\n" +
 	"1InternalGetSubscriptionStatsByProductCodeResponse\x12!\n" +
 	"\factive_count\x18\x01 \x01(\x05R\vactiveCount\x12\x1f\n" +
 	"\vtrial_count\x18\x02 \x01(\x05R\n" +
-	"trialCount\"\xa2\x01\n" +
+	"trialCount\x12\x1b\n" +
+	"\tnew_count\x18\x03 \x01(\x05R\bnewCount\x12#\n" +
+	"\rchurned_count\x18\x04 \x01(\x05R\fchurnedCount\x12Q\n" +
+	"\n" +
+	"plan_stats\x18\x05 \x03(\v22.api.subscription.v1.InternalPlanSubscriptionStatsR\tplanStats\x12\x1d\n" +
+	"\n" +
+	"plan_total\x18\x06 \x01(\x05R\tplanTotal\"\xc2\x01\n" +
+	"\x1dInternalPlanSubscriptionStats\x12\x1b\n" +
+	"\tplan_code\x18\x01 \x01(\tR\bplanCode\x12!\n" +
+	"\factive_count\x18\x02 \x01(\x05R\vactiveCount\x12\x1f\n" +
+	"\vtrial_count\x18\x03 \x01(\x05R\n" +
+	"trialCount\x12\x1b\n" +
+	"\tnew_count\x18\x04 \x01(\x05R\bnewCount\x12#\n" +
+	"\rchurned_count\x18\x05 \x01(\x05R\fchurnedCount\"\xe4\x01\n" +
 	"\x1fInternalCheckAndUseQuotaRequest\x12\x1f\n" +
 	"\vtenant_code\x18\x01 \x01(\tR\n" +
 	"tenantCode\x12!\n" +
 	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12#\n" +
 	"\rdimension_key\x18\x03 \x01(\tR\fdimensionKey\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x05R\x06amount\"\x95\x03\n" +
+	"\x06amount\x18\x04 \x01(\x05R\x06amount\x12,\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tH\x00R\x0eidempotencyKey\x88\x01\x01B\x12\n" +
+	"\x10_idempotency_key\"\xb1\x03\n" +
 	" InternalCheckAndUseQuotaResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
 	"\rdimension_key\x18\x02 \x01(\tR\fdimensionKey\x12\x1f\n" +
@@ -2440,19 +3907,42 @@ const file_subscribe_v1_subscription_internal_proto_rawDesc = "" +
 	"\fis_unlimited\x18\a \x01(\bR\visUnlimited\x12#\n" +
 	"\rerror_message\x18\b \x01(\tR\ferrorMessage\x12J\n" +
 	"\n" +
-	"error_code\x18\t \x01(\x0e2+.api.subscription.v1.InternalQuotaErrorCodeR\terrorCode\"\x9e\x01\n" +
+	"error_code\x18\t \x01(\x0e2+.api.subscription.v1.InternalQuotaErrorCodeR\terrorCode\x12\x1a\n" +
+	"\breplayed\x18\n" +
+	" \x01(\bR\breplayed\"\x9c\x01\n" +
+	"\x19InternalCheckQuotaRequest\x12\x1f\n" +
+	"\vtenant_code\x18\x01 \x01(\tR\n" +
+	"tenantCode\x12!\n" +
+	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12#\n" +
+	"\rdimension_key\x18\x03 \x01(\tR\fdimensionKey\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x05R\x06amount\"\xd8\x02\n" +
+	"\x1aInternalCheckQuotaResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12#\n" +
+	"\rdimension_key\x18\x02 \x01(\tR\fdimensionKey\x12\x1f\n" +
+	"\vquota_limit\x18\x03 \x01(\x05R\n" +
+	"quotaLimit\x12\x1d\n" +
+	"\n" +
+	"quota_used\x18\x04 \x01(\x05R\tquotaUsed\x12'\n" +
+	"\x0fquota_remaining\x18\x05 \x01(\x05R\x0equotaRemaining\x12!\n" +
+	"\fis_unlimited\x18\x06 \x01(\bR\visUnlimited\x12#\n" +
+	"\rerror_message\x18\a \x01(\tR\ferrorMessage\x12J\n" +
+	"\n" +
+	"error_code\x18\b \x01(\x0e2+.api.subscription.v1.InternalQuotaErrorCodeR\terrorCode\"\xe0\x01\n" +
 	"\x1bInternalReleaseQuotaRequest\x12\x1f\n" +
 	"\vtenant_code\x18\x01 \x01(\tR\n" +
 	"tenantCode\x12!\n" +
 	"\fproduct_code\x18\x02 \x01(\tR\vproductCode\x12#\n" +
 	"\rdimension_key\x18\x03 \x01(\tR\fdimensionKey\x12\x16\n" +
-	"\x06amount\x18\x04 \x01(\x05R\x06amount\"\xd8\x01\n" +
+	"\x06amount\x18\x04 \x01(\x05R\x06amount\x12,\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tH\x00R\x0eidempotencyKey\x88\x01\x01B\x12\n" +
+	"\x10_idempotency_key\"\xf4\x01\n" +
 	"\x1cInternalReleaseQuotaResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
 	"\rdimension_key\x18\x02 \x01(\tR\fdimensionKey\x12*\n" +
 	"\x11quota_used_before\x18\x03 \x01(\x05R\x0fquotaUsedBefore\x12(\n" +
 	"\x10quota_used_after\x18\x04 \x01(\x05R\x0equotaUsedAfter\x12#\n" +
-	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"\x9e\x01\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\x12\x1a\n" +
+	"\breplayed\x18\x06 \x01(\bR\breplayed\"\x9e\x01\n" +
 	"\x1cInternalGetQuotaUsageRequest\x12\x1f\n" +
 	"\vtenant_code\x18\x01 \x01(\tR\n" +
 	"tenantCode\x12!\n" +
@@ -2510,16 +4000,29 @@ const file_subscribe_v1_subscription_internal_proto_rawDesc = "" +
 	"+INTERNAL_QUOTA_ERROR_SUBSCRIPTION_NOT_FOUND\x10\x02\x12,\n" +
 	"(INTERNAL_QUOTA_ERROR_DIMENSION_NOT_FOUND\x10\x03\x12-\n" +
 	")INTERNAL_QUOTA_ERROR_CHECKPOINT_NOT_FOUND\x10\x04\x12-\n" +
-	")INTERNAL_QUOTA_ERROR_SUBSCRIPTION_EXPIRED\x10\x052\xb4\n" +
-	"\n" +
+	")INTERNAL_QUOTA_ERROR_SUBSCRIPTION_EXPIRED\x10\x05*\x8f\x02\n" +
+	"\x1dInternalSubscriptionEventType\x120\n" +
+	",INTERNAL_SUBSCRIPTION_EVENT_TYPE_UNSPECIFIED\x10\x00\x12,\n" +
+	"(INTERNAL_SUBSCRIPTION_EVENT_TYPE_CREATED\x10\x01\x12,\n" +
+	"(INTERNAL_SUBSCRIPTION_EVENT_TYPE_RENEWED\x10\x02\x12,\n" +
+	"(INTERNAL_SUBSCRIPTION_EVENT_TYPE_EXPIRED\x10\x03\x122\n" +
+	".INTERNAL_SUBSCRIPTION_EVENT_TYPE_QUOTA_CHANGED\x10\x042\xef\x12\n" +
 	"\x1bSubscriptionInternalService\x12\x8a\x01\n" +
-	"\x19InternalListSubscriptions\x125.api.subscription.v1.InternalListSubscriptionsRequest\x1a6.api.subscription.v1.InternalListSubscriptionsResponse\x12\x8d\x01\n" +
+	"\x19InternalListSubscriptions\x125.api.subscription.v1.InternalListSubscriptionsRequest\x1a6.api.subscription.v1.InternalListSubscriptionsResponse\x12\x84\x01\n" +
+	"\x17InternalGetSubscription\x123.api.subscription.v1.InternalGetSubscriptionRequest\x1a4.api.subscription.v1.InternalGetSubscriptionResponse\x12\x8d\x01\n" +
 	"\x1aInternalCreateSubscription\x126.api.subscription.v1.InternalCreateSubscriptionRequest\x1a7.api.subscription.v1.InternalCreateSubscriptionResponse\x12\x8a\x01\n" +
 	"\x19InternalReNewSubscription\x125.api.subscription.v1.InternalReNewSubscriptionRequest\x1a6.api.subscription.v1.InternalReNewSubscriptionResponse\x12\x90\x01\n" +
-	"\x1bInternalUpgradeSubscription\x127.api.subscription.v1.InternalUpgradeSubscriptionRequest\x1a8.api.subscription.v1.InternalUpgradeSubscriptionResponse\x12\x93\x01\n" +
+	"\x1bInternalUpgradeSubscription\x127.api.subscription.v1.InternalUpgradeSubscriptionRequest\x1a8.api.subscription.v1.InternalUpgradeSubscriptionResponse\x12\x96\x01\n" +
+	"\x1dInternalDowngradeSubscription\x129.api.subscription.v1.InternalDowngradeSubscriptionRequest\x1a:.api.subscription.v1.InternalDowngradeSubscriptionResponse\x12\x8d\x01\n" +
+	"\x1aInternalCancelSubscription\x126.api.subscription.v1.InternalCancelSubscriptionRequest\x1a7.api.subscription.v1.InternalCancelSubscriptionResponse\x12\x8a\x01\n" +
+	"\x19InternalPauseSubscription\x125.api.subscription.v1.InternalPauseSubscriptionRequest\x1a6.api.subscription.v1.InternalPauseSubscriptionResponse\x12\x8d\x01\n" +
+	"\x1aInternalResumeSubscription\x126.api.subscription.v1.InternalResumeSubscriptionRequest\x1a7.api.subscription.v1.InternalResumeSubscriptionResponse\x12{\n" +
+	"\x14InternalConvertTrial\x120.api.subscription.v1.InternalConvertTrialRequest\x1a1.api.subscription.v1.InternalConvertTrialResponse\x12x\n" +
+	"\x13InternalExtendTrial\x12/.api.subscription.v1.InternalExtendTrialRequest\x1a0.api.subscription.v1.InternalExtendTrialResponse\x12\x93\x01\n" +
 	"\x1cInternalGetSubscriptionStats\x128.api.subscription.v1.InternalGetSubscriptionStatsRequest\x1a9.api.subscription.v1.InternalGetSubscriptionStatsResponse\x12\xba\x01\n" +
 	")InternalGetSubscriptionStatsByProductCode\x12E.api.subscription.v1.InternalGetSubscriptionStatsByProductCodeRequest\x1aF.api.subscription.v1.InternalGetSubscriptionStatsByProductCodeResponse\x12\x87\x01\n" +
-	"\x18InternalCheckAndUseQuota\x124.api.subscription.v1.InternalCheckAndUseQuotaRequest\x1a5.api.subscription.v1.InternalCheckAndUseQuotaResponse\x12{\n" +
+	"\x18InternalCheckAndUseQuota\x124.api.subscription.v1.InternalCheckAndUseQuotaRequest\x1a5.api.subscription.v1.InternalCheckAndUseQuotaResponse\x12u\n" +
+	"\x12InternalCheckQuota\x12..api.subscription.v1.InternalCheckQuotaRequest\x1a/.api.subscription.v1.InternalCheckQuotaResponse\x12{\n" +
 	"\x14InternalReleaseQuota\x120.api.subscription.v1.InternalReleaseQuotaRequest\x1a1.api.subscription.v1.InternalReleaseQuotaResponse\x12~\n" +
 	"\x15InternalGetQuotaUsage\x121.api.subscription.v1.InternalGetQuotaUsageRequest\x1a2.api.subscription.v1.InternalGetQuotaUsageResponseB\xe5\x01\n" +
 	"\x17com.api.subscription.v1B\x19SubscriptionInternalProtoP\x01ZAgithub.com/heyinLab/common/api/gen/go/subscribe/v1;subscriptionv1\xa2\x02\x03ASX\xaa\x02\x13Api.Subscription.V1\xca\x02\x13Api\\Subscription\\V1\xe2\x02\x1fApi\\Subscription\\V1\\GPBMetadata\xea\x02\x15Api::Subscription::V1b\x06proto3"
@@ -2536,8 +4039,8 @@ func file_subscribe_v1_subscription_internal_proto_rawDescGZIP() []byte {
 	return file_subscribe_v1_subscription_internal_proto_rawDescData
 }
 
-var file_subscribe_v1_subscription_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_subscribe_v1_subscription_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_subscribe_v1_subscription_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_subscribe_v1_subscription_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
 var file_subscribe_v1_subscription_internal_proto_goTypes = []any{
 	(InternalSubscriptionStatus)(0),                           // 0: api.subscription.v1.InternalSubscriptionStatus
 	(InternalQuotaType)(0),                                    // 1: api.subscription.v1.InternalQuotaType
@@ -2545,92 +4048,153 @@ var file_subscribe_v1_subscription_internal_proto_goTypes = []any{
 	(InternalBillingCycle)(0),                                 // 3: api.subscription.v1.InternalBillingCycle
 	(InternalOrderStatus)(0),                                  // 4: api.subscription.v1.InternalOrderStatus
 	(InternalQuotaErrorCode)(0),                               // 5: api.subscription.v1.InternalQuotaErrorCode
-	(*InternalSubscriptionInfo)(nil),                          // 6: api.subscription.v1.InternalSubscriptionInfo
-	(*InternalQuotaUsageInfo)(nil),                            // 7: api.subscription.v1.InternalQuotaUsageInfo
-	(*InternalSubscriptionOrderInfo)(nil),                     // 8: api.subscription.v1.InternalSubscriptionOrderInfo
-	(*InternalListSubscriptionsRequest)(nil),                  // 9: api.subscription.v1.InternalListSubscriptionsRequest
-	(*InternalListSubscriptionsResponse)(nil),                 // 10: api.subscription.v1.InternalListSubscriptionsResponse
-	(*InternalCreateSubscriptionRequest)(nil),                 // 11: api.subscription.v1.InternalCreateSubscriptionRequest
-	(*InternalCreateSubscriptionResponse)(nil),                // 12: api.subscription.v1.InternalCreateSubscriptionResponse
-	(*InternalReNewSubscriptionRequest)(nil),                  // 13: api.subscription.v1.InternalReNewSubscriptionRequest
-	(*InternalReNewSubscriptionResponse)(nil),                 // 14: api.subscription.v1.InternalReNewSubscriptionResponse
-	(*InternalUpgradeSubscriptionRequest)(nil),                // 15: api.subscription.v1.InternalUpgradeSubscriptionRequest
-	(*InternalUpgradeSubscriptionResponse)(nil),               // 16: api.subscription.v1.InternalUpgradeSubscriptionResponse
-	(*InternalGetSubscriptionStatsRequest)(nil),               // 17: api.subscription.v1.InternalGetSubscriptionStatsRequest
-	(*InternalGetSubscriptionStatsResponse)(nil),              // 18: api.subscription.v1.InternalGetSubscriptionStatsResponse
-	(*InternalGetSubscriptionStatsByProductCodeRequest)(nil),  // 19: api.subscription.v1.InternalGetSubscriptionStatsByProductCodeRequest
-	(*InternalGetSubscriptionStatsByProductCodeResponse)(nil), // 20: api.subscription.v1.InternalGetSubscriptionStatsByProductCodeResponse
-	(*InternalCheckAndUseQuotaRequest)(nil),                   // 21: api.subscription.v1.InternalCheckAndUseQuotaRequest
-	(*InternalCheckAndUseQuotaResponse)(nil),                  // 22: api.subscription.v1.InternalCheckAndUseQuotaResponse
-	(*InternalReleaseQuotaRequest)(nil),                       // 23: api.subscription.v1.InternalReleaseQuotaRequest
-	(*InternalReleaseQuotaResponse)(nil),                      // 24: api.subscription.v1.InternalReleaseQuotaResponse
-	(*InternalGetQuotaUsageRequest)(nil),                      // 25: api.subscription.v1.InternalGetQuotaUsageRequest
-	(*InternalGetQuotaUsageResponse)(nil),                     // 26: api.subscription.v1.InternalGetQuotaUsageResponse
-	(*InternalQuotaUsageItem)(nil),                            // 27: api.subscription.v1.InternalQuotaUsageItem
-	(*structpb.Struct)(nil),                                   // 28: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil),                             // 29: google.protobuf.Timestamp
-	(*durationpb.Duration)(nil),                               // 30: google.protobuf.Duration
+	(InternalSubscriptionEventType)(0),                        // 6: api.subscription.v1.InternalSubscriptionEventType
+	(*InternalSubscriptionEvent)(nil),                         // 7: api.subscription.v1.InternalSubscriptionEvent
+	(*InternalSubscriptionInfo)(nil),                          // 8: api.subscription.v1.InternalSubscriptionInfo
+	(*InternalQuotaUsageInfo)(nil),                            // 9: api.subscription.v1.InternalQuotaUsageInfo
+	(*InternalSubscriptionOrderInfo)(nil),                     // 10: api.subscription.v1.InternalSubscriptionOrderInfo
+	(*InternalListSubscriptionsRequest)(nil),                  // 11: api.subscription.v1.InternalListSubscriptionsRequest
+	(*InternalListSubscriptionsResponse)(nil),                 // 12: api.subscription.v1.InternalListSubscriptionsResponse
+	(*InternalGetSubscriptionRequest)(nil),                    // 13: api.subscription.v1.InternalGetSubscriptionRequest
+	(*InternalGetSubscriptionResponse)(nil),                   // 14: api.subscription.v1.InternalGetSubscriptionResponse
+	(*InternalCreateSubscriptionRequest)(nil),                 // 15: api.subscription.v1.InternalCreateSubscriptionRequest
+	(*InternalCreateSubscriptionResponse)(nil),                // 16: api.subscription.v1.InternalCreateSubscriptionResponse
+	(*InternalReNewSubscriptionRequest)(nil),                  // 17: api.subscription.v1.InternalReNewSubscriptionRequest
+	(*InternalReNewSubscriptionResponse)(nil),                 // 18: api.subscription.v1.InternalReNewSubscriptionResponse
+	(*InternalUpgradeSubscriptionRequest)(nil),                // 19: api.subscription.v1.InternalUpgradeSubscriptionRequest
+	(*InternalUpgradeSubscriptionResponse)(nil),               // 20: api.subscription.v1.InternalUpgradeSubscriptionResponse
+	(*InternalDowngradeSubscriptionRequest)(nil),              // 21: api.subscription.v1.InternalDowngradeSubscriptionRequest
+	(*InternalDowngradeSubscriptionResponse)(nil),             // 22: api.subscription.v1.InternalDowngradeSubscriptionResponse
+	(*InternalCancelSubscriptionRequest)(nil),                 // 23: api.subscription.v1.InternalCancelSubscriptionRequest
+	(*InternalCancelSubscriptionResponse)(nil),                // 24: api.subscription.v1.InternalCancelSubscriptionResponse
+	(*InternalPauseSubscriptionRequest)(nil),                  // 25: api.subscription.v1.InternalPauseSubscriptionRequest
+	(*InternalPauseSubscriptionResponse)(nil),                 // 26: api.subscription.v1.InternalPauseSubscriptionResponse
+	(*InternalResumeSubscriptionRequest)(nil),                 // 27: api.subscription.v1.InternalResumeSubscriptionRequest
+	(*InternalResumeSubscriptionResponse)(nil),                // 28: api.subscription.v1.InternalResumeSubscriptionResponse
+	(*InternalConvertTrialRequest)(nil),                       // 29: api.subscription.v1.InternalConvertTrialRequest
+	(*InternalConvertTrialResponse)(nil),                      // 30: api.subscription.v1.InternalConvertTrialResponse
+	(*InternalExtendTrialRequest)(nil),                        // 31: api.subscription.v1.InternalExtendTrialRequest
+	(*InternalExtendTrialResponse)(nil),                       // 32: api.subscription.v1.InternalExtendTrialResponse
+	(*InternalGetSubscriptionStatsRequest)(nil),               // 33: api.subscription.v1.InternalGetSubscriptionStatsRequest
+	(*InternalGetSubscriptionStatsResponse)(nil),              // 34: api.subscription.v1.InternalGetSubscriptionStatsResponse
+	(*InternalGetSubscriptionStatsByProductCodeRequest)(nil),  // 35: api.subscription.v1.InternalGetSubscriptionStatsByProductCodeRequest
+	(*InternalGetSubscriptionStatsByProductCodeResponse)(nil), // 36: api.subscription.v1.InternalGetSubscriptionStatsByProductCodeResponse
+	(*InternalPlanSubscriptionStats)(nil),                     // 37: api.subscription.v1.InternalPlanSubscriptionStats
+	(*InternalCheckAndUseQuotaRequest)(nil),                   // 38: api.subscription.v1.InternalCheckAndUseQuotaRequest
+	(*InternalCheckAndUseQuotaResponse)(nil),                  // 39: api.subscription.v1.InternalCheckAndUseQuotaResponse
+	(*InternalCheckQuotaRequest)(nil),                         // 40: api.subscription.v1.InternalCheckQuotaRequest
+	(*InternalCheckQuotaResponse)(nil),                        // 41: api.subscription.v1.InternalCheckQuotaResponse
+	(*InternalReleaseQuotaRequest)(nil),                       // 42: api.subscription.v1.InternalReleaseQuotaRequest
+	(*InternalReleaseQuotaResponse)(nil),                      // 43: api.subscription.v1.InternalReleaseQuotaResponse
+	(*InternalGetQuotaUsageRequest)(nil),                      // 44: api.subscription.v1.InternalGetQuotaUsageRequest
+	(*InternalGetQuotaUsageResponse)(nil),                     // 45: api.subscription.v1.InternalGetQuotaUsageResponse
+	(*InternalQuotaUsageItem)(nil),                            // 46: api.subscription.v1.InternalQuotaUsageItem
+	(*timestamppb.Timestamp)(nil),                             // 47: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                                   // 48: google.protobuf.Struct
+	(*durationpb.Duration)(nil),                               // 49: google.protobuf.Duration
 }
 var file_subscribe_v1_subscription_internal_proto_depIdxs = []int32{
-	28, // 0: api.subscription.v1.InternalSubscriptionInfo.product_i18n:type_name -> google.protobuf.Struct
-	28, // 1: api.subscription.v1.InternalSubscriptionInfo.plan_i18n:type_name -> google.protobuf.Struct
-	0,  // 2: api.subscription.v1.InternalSubscriptionInfo.status:type_name -> api.subscription.v1.InternalSubscriptionStatus
-	29, // 3: api.subscription.v1.InternalSubscriptionInfo.start_date:type_name -> google.protobuf.Timestamp
-	29, // 4: api.subscription.v1.InternalSubscriptionInfo.end_date:type_name -> google.protobuf.Timestamp
-	29, // 5: api.subscription.v1.InternalSubscriptionInfo.trial_end_date:type_name -> google.protobuf.Timestamp
-	28, // 6: api.subscription.v1.InternalSubscriptionInfo.quota_snapshot:type_name -> google.protobuf.Struct
-	7,  // 7: api.subscription.v1.InternalSubscriptionInfo.quota_usages:type_name -> api.subscription.v1.InternalQuotaUsageInfo
-	29, // 8: api.subscription.v1.InternalSubscriptionInfo.create_time:type_name -> google.protobuf.Timestamp
-	29, // 9: api.subscription.v1.InternalSubscriptionInfo.update_time:type_name -> google.protobuf.Timestamp
-	28, // 10: api.subscription.v1.InternalQuotaUsageInfo.dimension_i18n:type_name -> google.protobuf.Struct
-	1,  // 11: api.subscription.v1.InternalQuotaUsageInfo.quota_type:type_name -> api.subscription.v1.InternalQuotaType
-	2,  // 12: api.subscription.v1.InternalSubscriptionOrderInfo.order_type:type_name -> api.subscription.v1.InternalOrderType
-	3,  // 13: api.subscription.v1.InternalSubscriptionOrderInfo.billing_cycle:type_name -> api.subscription.v1.InternalBillingCycle
-	4,  // 14: api.subscription.v1.InternalSubscriptionOrderInfo.status:type_name -> api.subscription.v1.InternalOrderStatus
-	29, // 15: api.subscription.v1.InternalSubscriptionOrderInfo.paid_at:type_name -> google.protobuf.Timestamp
-	29, // 16: api.subscription.v1.InternalSubscriptionOrderInfo.cancelled_at:type_name -> google.protobuf.Timestamp
-	29, // 17: api.subscription.v1.InternalSubscriptionOrderInfo.refunded_at:type_name -> google.protobuf.Timestamp
-	29, // 18: api.subscription.v1.InternalSubscriptionOrderInfo.service_start_date:type_name -> google.protobuf.Timestamp
-	29, // 19: api.subscription.v1.InternalSubscriptionOrderInfo.service_end_date:type_name -> google.protobuf.Timestamp
-	28, // 20: api.subscription.v1.InternalSubscriptionOrderInfo.invoice_info:type_name -> google.protobuf.Struct
-	0,  // 21: api.subscription.v1.InternalListSubscriptionsRequest.status:type_name -> api.subscription.v1.InternalSubscriptionStatus
-	6,  // 22: api.subscription.v1.InternalListSubscriptionsResponse.subscriptions:type_name -> api.subscription.v1.InternalSubscriptionInfo
-	29, // 23: api.subscription.v1.InternalCreateSubscriptionRequest.start_date:type_name -> google.protobuf.Timestamp
-	29, // 24: api.subscription.v1.InternalCreateSubscriptionRequest.end_date:type_name -> google.protobuf.Timestamp
-	8,  // 25: api.subscription.v1.InternalCreateSubscriptionRequest.order:type_name -> api.subscription.v1.InternalSubscriptionOrderInfo
-	6,  // 26: api.subscription.v1.InternalCreateSubscriptionResponse.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
-	30, // 27: api.subscription.v1.InternalReNewSubscriptionRequest.re_new_time:type_name -> google.protobuf.Duration
-	8,  // 28: api.subscription.v1.InternalReNewSubscriptionRequest.order:type_name -> api.subscription.v1.InternalSubscriptionOrderInfo
-	6,  // 29: api.subscription.v1.InternalReNewSubscriptionResponse.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
-	29, // 30: api.subscription.v1.InternalUpgradeSubscriptionRequest.start_date:type_name -> google.protobuf.Timestamp
-	29, // 31: api.subscription.v1.InternalUpgradeSubscriptionRequest.end_date:type_name -> google.protobuf.Timestamp
-	8,  // 32: api.subscription.v1.InternalUpgradeSubscriptionRequest.order:type_name -> api.subscription.v1.InternalSubscriptionOrderInfo
-	6,  // 33: api.subscription.v1.InternalUpgradeSubscriptionResponse.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
-	5,  // 34: api.subscription.v1.InternalCheckAndUseQuotaResponse.error_code:type_name -> api.subscription.v1.InternalQuotaErrorCode
-	27, // 35: api.subscription.v1.InternalGetQuotaUsageResponse.usages:type_name -> api.subscription.v1.InternalQuotaUsageItem
-	9,  // 36: api.subscription.v1.SubscriptionInternalService.InternalListSubscriptions:input_type -> api.subscription.v1.InternalListSubscriptionsRequest
-	11, // 37: api.subscription.v1.SubscriptionInternalService.InternalCreateSubscription:input_type -> api.subscription.v1.InternalCreateSubscriptionRequest
-	13, // 38: api.subscription.v1.SubscriptionInternalService.InternalReNewSubscription:input_type -> api.subscription.v1.InternalReNewSubscriptionRequest
-	15, // 39: api.subscription.v1.SubscriptionInternalService.InternalUpgradeSubscription:input_type -> api.subscription.v1.InternalUpgradeSubscriptionRequest
-	17, // 40: api.subscription.v1.SubscriptionInternalService.InternalGetSubscriptionStats:input_type -> api.subscription.v1.InternalGetSubscriptionStatsRequest
-	19, // 41: api.subscription.v1.SubscriptionInternalService.InternalGetSubscriptionStatsByProductCode:input_type -> api.subscription.v1.InternalGetSubscriptionStatsByProductCodeRequest
-	21, // 42: api.subscription.v1.SubscriptionInternalService.InternalCheckAndUseQuota:input_type -> api.subscription.v1.InternalCheckAndUseQuotaRequest
-	23, // 43: api.subscription.v1.SubscriptionInternalService.InternalReleaseQuota:input_type -> api.subscription.v1.InternalReleaseQuotaRequest
-	25, // 44: api.subscription.v1.SubscriptionInternalService.InternalGetQuotaUsage:input_type -> api.subscription.v1.InternalGetQuotaUsageRequest
-	10, // 45: api.subscription.v1.SubscriptionInternalService.InternalListSubscriptions:output_type -> api.subscription.v1.InternalListSubscriptionsResponse
-	12, // 46: api.subscription.v1.SubscriptionInternalService.InternalCreateSubscription:output_type -> api.subscription.v1.InternalCreateSubscriptionResponse
-	14, // 47: api.subscription.v1.SubscriptionInternalService.InternalReNewSubscription:output_type -> api.subscription.v1.InternalReNewSubscriptionResponse
-	16, // 48: api.subscription.v1.SubscriptionInternalService.InternalUpgradeSubscription:output_type -> api.subscription.v1.InternalUpgradeSubscriptionResponse
-	18, // 49: api.subscription.v1.SubscriptionInternalService.InternalGetSubscriptionStats:output_type -> api.subscription.v1.InternalGetSubscriptionStatsResponse
-	20, // 50: api.subscription.v1.SubscriptionInternalService.InternalGetSubscriptionStatsByProductCode:output_type -> api.subscription.v1.InternalGetSubscriptionStatsByProductCodeResponse
-	22, // 51: api.subscription.v1.SubscriptionInternalService.InternalCheckAndUseQuota:output_type -> api.subscription.v1.InternalCheckAndUseQuotaResponse
-	24, // 52: api.subscription.v1.SubscriptionInternalService.InternalReleaseQuota:output_type -> api.subscription.v1.InternalReleaseQuotaResponse
-	26, // 53: api.subscription.v1.SubscriptionInternalService.InternalGetQuotaUsage:output_type -> api.subscription.v1.InternalGetQuotaUsageResponse
-	45, // [45:54] is the sub-list for method output_type
-	36, // [36:45] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	6,  // 0: api.subscription.v1.InternalSubscriptionEvent.event_type:type_name -> api.subscription.v1.InternalSubscriptionEventType
+	47, // 1: api.subscription.v1.InternalSubscriptionEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	8,  // 2: api.subscription.v1.InternalSubscriptionEvent.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
+	46, // 3: api.subscription.v1.InternalSubscriptionEvent.quota_usages:type_name -> api.subscription.v1.InternalQuotaUsageItem
+	48, // 4: api.subscription.v1.InternalSubscriptionInfo.product_i18n:type_name -> google.protobuf.Struct
+	48, // 5: api.subscription.v1.InternalSubscriptionInfo.plan_i18n:type_name -> google.protobuf.Struct
+	0,  // 6: api.subscription.v1.InternalSubscriptionInfo.status:type_name -> api.subscription.v1.InternalSubscriptionStatus
+	47, // 7: api.subscription.v1.InternalSubscriptionInfo.start_date:type_name -> google.protobuf.Timestamp
+	47, // 8: api.subscription.v1.InternalSubscriptionInfo.end_date:type_name -> google.protobuf.Timestamp
+	47, // 9: api.subscription.v1.InternalSubscriptionInfo.trial_end_date:type_name -> google.protobuf.Timestamp
+	48, // 10: api.subscription.v1.InternalSubscriptionInfo.quota_snapshot:type_name -> google.protobuf.Struct
+	9,  // 11: api.subscription.v1.InternalSubscriptionInfo.quota_usages:type_name -> api.subscription.v1.InternalQuotaUsageInfo
+	47, // 12: api.subscription.v1.InternalSubscriptionInfo.create_time:type_name -> google.protobuf.Timestamp
+	47, // 13: api.subscription.v1.InternalSubscriptionInfo.update_time:type_name -> google.protobuf.Timestamp
+	47, // 14: api.subscription.v1.InternalSubscriptionInfo.cancelled_at:type_name -> google.protobuf.Timestamp
+	47, // 15: api.subscription.v1.InternalSubscriptionInfo.paused_at:type_name -> google.protobuf.Timestamp
+	47, // 16: api.subscription.v1.InternalSubscriptionInfo.resume_at:type_name -> google.protobuf.Timestamp
+	48, // 17: api.subscription.v1.InternalQuotaUsageInfo.dimension_i18n:type_name -> google.protobuf.Struct
+	1,  // 18: api.subscription.v1.InternalQuotaUsageInfo.quota_type:type_name -> api.subscription.v1.InternalQuotaType
+	2,  // 19: api.subscription.v1.InternalSubscriptionOrderInfo.order_type:type_name -> api.subscription.v1.InternalOrderType
+	3,  // 20: api.subscription.v1.InternalSubscriptionOrderInfo.billing_cycle:type_name -> api.subscription.v1.InternalBillingCycle
+	4,  // 21: api.subscription.v1.InternalSubscriptionOrderInfo.status:type_name -> api.subscription.v1.InternalOrderStatus
+	47, // 22: api.subscription.v1.InternalSubscriptionOrderInfo.paid_at:type_name -> google.protobuf.Timestamp
+	47, // 23: api.subscription.v1.InternalSubscriptionOrderInfo.cancelled_at:type_name -> google.protobuf.Timestamp
+	47, // 24: api.subscription.v1.InternalSubscriptionOrderInfo.refunded_at:type_name -> google.protobuf.Timestamp
+	47, // 25: api.subscription.v1.InternalSubscriptionOrderInfo.service_start_date:type_name -> google.protobuf.Timestamp
+	47, // 26: api.subscription.v1.InternalSubscriptionOrderInfo.service_end_date:type_name -> google.protobuf.Timestamp
+	48, // 27: api.subscription.v1.InternalSubscriptionOrderInfo.invoice_info:type_name -> google.protobuf.Struct
+	0,  // 28: api.subscription.v1.InternalListSubscriptionsRequest.status:type_name -> api.subscription.v1.InternalSubscriptionStatus
+	8,  // 29: api.subscription.v1.InternalListSubscriptionsResponse.subscriptions:type_name -> api.subscription.v1.InternalSubscriptionInfo
+	8,  // 30: api.subscription.v1.InternalGetSubscriptionResponse.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
+	47, // 31: api.subscription.v1.InternalCreateSubscriptionRequest.start_date:type_name -> google.protobuf.Timestamp
+	47, // 32: api.subscription.v1.InternalCreateSubscriptionRequest.end_date:type_name -> google.protobuf.Timestamp
+	10, // 33: api.subscription.v1.InternalCreateSubscriptionRequest.order:type_name -> api.subscription.v1.InternalSubscriptionOrderInfo
+	8,  // 34: api.subscription.v1.InternalCreateSubscriptionResponse.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
+	49, // 35: api.subscription.v1.InternalReNewSubscriptionRequest.re_new_time:type_name -> google.protobuf.Duration
+	10, // 36: api.subscription.v1.InternalReNewSubscriptionRequest.order:type_name -> api.subscription.v1.InternalSubscriptionOrderInfo
+	8,  // 37: api.subscription.v1.InternalReNewSubscriptionResponse.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
+	47, // 38: api.subscription.v1.InternalUpgradeSubscriptionRequest.start_date:type_name -> google.protobuf.Timestamp
+	47, // 39: api.subscription.v1.InternalUpgradeSubscriptionRequest.end_date:type_name -> google.protobuf.Timestamp
+	10, // 40: api.subscription.v1.InternalUpgradeSubscriptionRequest.order:type_name -> api.subscription.v1.InternalSubscriptionOrderInfo
+	8,  // 41: api.subscription.v1.InternalUpgradeSubscriptionResponse.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
+	47, // 42: api.subscription.v1.InternalDowngradeSubscriptionRequest.effective_date:type_name -> google.protobuf.Timestamp
+	10, // 43: api.subscription.v1.InternalDowngradeSubscriptionRequest.order:type_name -> api.subscription.v1.InternalSubscriptionOrderInfo
+	8,  // 44: api.subscription.v1.InternalDowngradeSubscriptionResponse.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
+	47, // 45: api.subscription.v1.InternalDowngradeSubscriptionResponse.effective_date:type_name -> google.protobuf.Timestamp
+	8,  // 46: api.subscription.v1.InternalCancelSubscriptionResponse.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
+	47, // 47: api.subscription.v1.InternalPauseSubscriptionRequest.effective_date:type_name -> google.protobuf.Timestamp
+	47, // 48: api.subscription.v1.InternalPauseSubscriptionRequest.resume_date:type_name -> google.protobuf.Timestamp
+	8,  // 49: api.subscription.v1.InternalPauseSubscriptionResponse.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
+	47, // 50: api.subscription.v1.InternalResumeSubscriptionRequest.effective_date:type_name -> google.protobuf.Timestamp
+	8,  // 51: api.subscription.v1.InternalResumeSubscriptionResponse.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
+	10, // 52: api.subscription.v1.InternalConvertTrialRequest.order:type_name -> api.subscription.v1.InternalSubscriptionOrderInfo
+	8,  // 53: api.subscription.v1.InternalConvertTrialResponse.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
+	49, // 54: api.subscription.v1.InternalExtendTrialRequest.extend_time:type_name -> google.protobuf.Duration
+	8,  // 55: api.subscription.v1.InternalExtendTrialResponse.subscription:type_name -> api.subscription.v1.InternalSubscriptionInfo
+	47, // 56: api.subscription.v1.InternalGetSubscriptionStatsByProductCodeRequest.start_time:type_name -> google.protobuf.Timestamp
+	47, // 57: api.subscription.v1.InternalGetSubscriptionStatsByProductCodeRequest.end_time:type_name -> google.protobuf.Timestamp
+	37, // 58: api.subscription.v1.InternalGetSubscriptionStatsByProductCodeResponse.plan_stats:type_name -> api.subscription.v1.InternalPlanSubscriptionStats
+	5,  // 59: api.subscription.v1.InternalCheckAndUseQuotaResponse.error_code:type_name -> api.subscription.v1.InternalQuotaErrorCode
+	5,  // 60: api.subscription.v1.InternalCheckQuotaResponse.error_code:type_name -> api.subscription.v1.InternalQuotaErrorCode
+	46, // 61: api.subscription.v1.InternalGetQuotaUsageResponse.usages:type_name -> api.subscription.v1.InternalQuotaUsageItem
+	11, // 62: api.subscription.v1.SubscriptionInternalService.InternalListSubscriptions:input_type -> api.subscription.v1.InternalListSubscriptionsRequest
+	13, // 63: api.subscription.v1.SubscriptionInternalService.InternalGetSubscription:input_type -> api.subscription.v1.InternalGetSubscriptionRequest
+	15, // 64: api.subscription.v1.SubscriptionInternalService.InternalCreateSubscription:input_type -> api.subscription.v1.InternalCreateSubscriptionRequest
+	17, // 65: api.subscription.v1.SubscriptionInternalService.InternalReNewSubscription:input_type -> api.subscription.v1.InternalReNewSubscriptionRequest
+	19, // 66: api.subscription.v1.SubscriptionInternalService.InternalUpgradeSubscription:input_type -> api.subscription.v1.InternalUpgradeSubscriptionRequest
+	21, // 67: api.subscription.v1.SubscriptionInternalService.InternalDowngradeSubscription:input_type -> api.subscription.v1.InternalDowngradeSubscriptionRequest
+	23, // 68: api.subscription.v1.SubscriptionInternalService.InternalCancelSubscription:input_type -> api.subscription.v1.InternalCancelSubscriptionRequest
+	25, // 69: api.subscription.v1.SubscriptionInternalService.InternalPauseSubscription:input_type -> api.subscription.v1.InternalPauseSubscriptionRequest
+	27, // 70: api.subscription.v1.SubscriptionInternalService.InternalResumeSubscription:input_type -> api.subscription.v1.InternalResumeSubscriptionRequest
+	29, // 71: api.subscription.v1.SubscriptionInternalService.InternalConvertTrial:input_type -> api.subscription.v1.InternalConvertTrialRequest
+	31, // 72: api.subscription.v1.SubscriptionInternalService.InternalExtendTrial:input_type -> api.subscription.v1.InternalExtendTrialRequest
+	33, // 73: api.subscription.v1.SubscriptionInternalService.InternalGetSubscriptionStats:input_type -> api.subscription.v1.InternalGetSubscriptionStatsRequest
+	35, // 74: api.subscription.v1.SubscriptionInternalService.InternalGetSubscriptionStatsByProductCode:input_type -> api.subscription.v1.InternalGetSubscriptionStatsByProductCodeRequest
+	38, // 75: api.subscription.v1.SubscriptionInternalService.InternalCheckAndUseQuota:input_type -> api.subscription.v1.InternalCheckAndUseQuotaRequest
+	40, // 76: api.subscription.v1.SubscriptionInternalService.InternalCheckQuota:input_type -> api.subscription.v1.InternalCheckQuotaRequest
+	42, // 77: api.subscription.v1.SubscriptionInternalService.InternalReleaseQuota:input_type -> api.subscription.v1.InternalReleaseQuotaRequest
+	44, // 78: api.subscription.v1.SubscriptionInternalService.InternalGetQuotaUsage:input_type -> api.subscription.v1.InternalGetQuotaUsageRequest
+	12, // 79: api.subscription.v1.SubscriptionInternalService.InternalListSubscriptions:output_type -> api.subscription.v1.InternalListSubscriptionsResponse
+	14, // 80: api.subscription.v1.SubscriptionInternalService.InternalGetSubscription:output_type -> api.subscription.v1.InternalGetSubscriptionResponse
+	16, // 81: api.subscription.v1.SubscriptionInternalService.InternalCreateSubscription:output_type -> api.subscription.v1.InternalCreateSubscriptionResponse
+	18, // 82: api.subscription.v1.SubscriptionInternalService.InternalReNewSubscription:output_type -> api.subscription.v1.InternalReNewSubscriptionResponse
+	20, // 83: api.subscription.v1.SubscriptionInternalService.InternalUpgradeSubscription:output_type -> api.subscription.v1.InternalUpgradeSubscriptionResponse
+	22, // 84: api.subscription.v1.SubscriptionInternalService.InternalDowngradeSubscription:output_type -> api.subscription.v1.InternalDowngradeSubscriptionResponse
+	24, // 85: api.subscription.v1.SubscriptionInternalService.InternalCancelSubscription:output_type -> api.subscription.v1.InternalCancelSubscriptionResponse
+	26, // 86: api.subscription.v1.SubscriptionInternalService.InternalPauseSubscription:output_type -> api.subscription.v1.InternalPauseSubscriptionResponse
+	28, // 87: api.subscription.v1.SubscriptionInternalService.InternalResumeSubscription:output_type -> api.subscription.v1.InternalResumeSubscriptionResponse
+	30, // 88: api.subscription.v1.SubscriptionInternalService.InternalConvertTrial:output_type -> api.subscription.v1.InternalConvertTrialResponse
+	32, // 89: api.subscription.v1.SubscriptionInternalService.InternalExtendTrial:output_type -> api.subscription.v1.InternalExtendTrialResponse
+	34, // 90: api.subscription.v1.SubscriptionInternalService.InternalGetSubscriptionStats:output_type -> api.subscription.v1.InternalGetSubscriptionStatsResponse
+	36, // 91: api.subscription.v1.SubscriptionInternalService.InternalGetSubscriptionStatsByProductCode:output_type -> api.subscription.v1.InternalGetSubscriptionStatsByProductCodeResponse
+	39, // 92: api.subscription.v1.SubscriptionInternalService.InternalCheckAndUseQuota:output_type -> api.subscription.v1.InternalCheckAndUseQuotaResponse
+	41, // 93: api.subscription.v1.SubscriptionInternalService.InternalCheckQuota:output_type -> api.subscription.v1.InternalCheckQuotaResponse
+	43, // 94: api.subscription.v1.SubscriptionInternalService.InternalReleaseQuota:output_type -> api.subscription.v1.InternalReleaseQuotaResponse
+	45, // 95: api.subscription.v1.SubscriptionInternalService.InternalGetQuotaUsage:output_type -> api.subscription.v1.InternalGetQuotaUsageResponse
+	79, // [79:96] is the sub-list for method output_type
+	62, // [62:79] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
 }
 
 func init() { file_subscribe_v1_subscription_internal_proto_init() }
@@ -2638,21 +4202,27 @@ func file_subscribe_v1_subscription_internal_proto_init() {
 	if File_subscribe_v1_subscription_internal_proto != nil {
 		return
 	}
-	file_subscribe_v1_subscription_internal_proto_msgTypes[0].OneofWrappers = []any{}
 	file_subscribe_v1_subscription_internal_proto_msgTypes[1].OneofWrappers = []any{}
 	file_subscribe_v1_subscription_internal_proto_msgTypes[2].OneofWrappers = []any{}
 	file_subscribe_v1_subscription_internal_proto_msgTypes[3].OneofWrappers = []any{}
-	file_subscribe_v1_subscription_internal_proto_msgTypes[5].OneofWrappers = []any{}
-	file_subscribe_v1_subscription_internal_proto_msgTypes[9].OneofWrappers = []any{}
-	file_subscribe_v1_subscription_internal_proto_msgTypes[19].OneofWrappers = []any{}
-	file_subscribe_v1_subscription_internal_proto_msgTypes[21].OneofWrappers = []any{}
+	file_subscribe_v1_subscription_internal_proto_msgTypes[4].OneofWrappers = []any{}
+	file_subscribe_v1_subscription_internal_proto_msgTypes[8].OneofWrappers = []any{}
+	file_subscribe_v1_subscription_internal_proto_msgTypes[12].OneofWrappers = []any{}
+	file_subscribe_v1_subscription_internal_proto_msgTypes[14].OneofWrappers = []any{}
+	file_subscribe_v1_subscription_internal_proto_msgTypes[18].OneofWrappers = []any{}
+	file_subscribe_v1_subscription_internal_proto_msgTypes[20].OneofWrappers = []any{}
+	file_subscribe_v1_subscription_internal_proto_msgTypes[28].OneofWrappers = []any{}
+	file_subscribe_v1_subscription_internal_proto_msgTypes[31].OneofWrappers = []any{}
+	file_subscribe_v1_subscription_internal_proto_msgTypes[35].OneofWrappers = []any{}
+	file_subscribe_v1_subscription_internal_proto_msgTypes[37].OneofWrappers = []any{}
+	file_subscribe_v1_subscription_internal_proto_msgTypes[39].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_subscribe_v1_subscription_internal_proto_rawDesc), len(file_subscribe_v1_subscription_internal_proto_rawDesc)),
-			NumEnums:      6,
-			NumMessages:   22,
+			NumEnums:      7,
+			NumMessages:   40,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -35,6 +35,210 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on InternalSubscriptionEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalSubscriptionEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalSubscriptionEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalSubscriptionEventMultiError, or nil if none found.
+func (m *InternalSubscriptionEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalSubscriptionEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for EventType
+
+	// no validation rules for TenantCode
+
+	// no validation rules for ProductCode
+
+	// no validation rules for SubscriptionCode
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalSubscriptionEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalSubscriptionEventValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalSubscriptionEventValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalSubscriptionEventValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalSubscriptionEventValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalSubscriptionEventValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetQuotaUsages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalSubscriptionEventValidationError{
+						field:  fmt.Sprintf("QuotaUsages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalSubscriptionEventValidationError{
+						field:  fmt.Sprintf("QuotaUsages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalSubscriptionEventValidationError{
+					field:  fmt.Sprintf("QuotaUsages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalSubscriptionEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalSubscriptionEventMultiError is an error wrapping multiple validation
+// errors returned by InternalSubscriptionEvent.ValidateAll() if the
+// designated constraints aren't met.
+type InternalSubscriptionEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalSubscriptionEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalSubscriptionEventMultiError) AllErrors() []error { return m }
+
+// InternalSubscriptionEventValidationError is the validation error returned by
+// InternalSubscriptionEvent.Validate if the designated constraints aren't met.
+type InternalSubscriptionEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalSubscriptionEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalSubscriptionEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalSubscriptionEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalSubscriptionEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalSubscriptionEventValidationError) ErrorName() string {
+	return "InternalSubscriptionEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalSubscriptionEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalSubscriptionEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalSubscriptionEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalSubscriptionEventValidationError{}
+
 // Validate checks the field values on InternalSubscriptionInfo with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -343,6 +547,8 @@ func (m *InternalSubscriptionInfo) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for CancelAtPeriodEnd
+
 	if m.CreatedBy != nil {
 		// no validation rules for CreatedBy
 	}
@@ -351,6 +557,109 @@ func (m *InternalSubscriptionInfo) validate(all bool) error {
 		// no validation rules for UpdatedBy
 	}
 
+	if m.CancelledAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetCancelledAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalSubscriptionInfoValidationError{
+						field:  "CancelledAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalSubscriptionInfoValidationError{
+						field:  "CancelledAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCancelledAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalSubscriptionInfoValidationError{
+					field:  "CancelledAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.CancelReason != nil {
+		// no validation rules for CancelReason
+	}
+
+	if m.PausedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetPausedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalSubscriptionInfoValidationError{
+						field:  "PausedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalSubscriptionInfoValidationError{
+						field:  "PausedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPausedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalSubscriptionInfoValidationError{
+					field:  "PausedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ResumeAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetResumeAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalSubscriptionInfoValidationError{
+						field:  "ResumeAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalSubscriptionInfoValidationError{
+						field:  "ResumeAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetResumeAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalSubscriptionInfoValidationError{
+					field:  "ResumeAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return InternalSubscriptionInfoMultiError(errors)
 	}
@@ -1211,9 +1520,246 @@ var _ interface {
 	ErrorName() string
 } = InternalListSubscriptionsResponseValidationError{}
 
-// Validate checks the field values on InternalCreateSubscriptionRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
+// Validate checks the field values on InternalGetSubscriptionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetSubscriptionRequestMultiError, or nil if none found.
+func (m *InternalGetSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubscriptionCode
+
+	if len(errors) > 0 {
+		return InternalGetSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalGetSubscriptionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalGetSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetSubscriptionRequestValidationError is the validation error
+// returned by InternalGetSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type InternalGetSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetSubscriptionRequestValidationError) ErrorName() string {
+	return "InternalGetSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetSubscriptionRequestValidationError{}
+
+// Validate checks the field values on InternalGetSubscriptionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetSubscriptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetSubscriptionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetSubscriptionResponseMultiError, or nil if none found.
+func (m *InternalGetSubscriptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetSubscriptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalGetSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalGetSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalGetSubscriptionResponseValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalGetSubscriptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetSubscriptionResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalGetSubscriptionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalGetSubscriptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetSubscriptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetSubscriptionResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetSubscriptionResponseValidationError is the validation error
+// returned by InternalGetSubscriptionResponse.Validate if the designated
+// constraints aren't met.
+type InternalGetSubscriptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetSubscriptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetSubscriptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetSubscriptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetSubscriptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetSubscriptionResponseValidationError) ErrorName() string {
+	return "InternalGetSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetSubscriptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetSubscriptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetSubscriptionResponseValidationError{}
+
+// Validate checks the field values on InternalCreateSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
 // no violations.
 func (m *InternalCreateSubscriptionRequest) Validate() error {
 	return m.validate(false)
@@ -2188,65 +2734,1778 @@ var _ interface {
 	ErrorName() string
 } = InternalUpgradeSubscriptionResponseValidationError{}
 
-// Validate checks the field values on InternalGetSubscriptionStatsRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *InternalGetSubscriptionStatsRequest) Validate() error {
+// Validate checks the field values on InternalDowngradeSubscriptionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *InternalDowngradeSubscriptionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on InternalGetSubscriptionStatsRequest
+// ValidateAll checks the field values on InternalDowngradeSubscriptionRequest
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// InternalGetSubscriptionStatsRequestMultiError, or nil if none found.
-func (m *InternalGetSubscriptionStatsRequest) ValidateAll() error {
+// InternalDowngradeSubscriptionRequestMultiError, or nil if none found.
+func (m *InternalDowngradeSubscriptionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InternalGetSubscriptionStatsRequest) validate(all bool) error {
+func (m *InternalDowngradeSubscriptionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TenantCode
+	// no validation rules for SubscriptionCode
 
-	if len(errors) > 0 {
-		return InternalGetSubscriptionStatsRequestMultiError(errors)
-	}
+	// no validation rules for ProductCode
 
-	return nil
-}
+	// no validation rules for PlanCode
 
-// InternalGetSubscriptionStatsRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// InternalGetSubscriptionStatsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type InternalGetSubscriptionStatsRequestMultiError []error
+	// no validation rules for Immediate
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m InternalGetSubscriptionStatsRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalDowngradeSubscriptionRequestValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalDowngradeSubscriptionRequestValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalDowngradeSubscriptionRequestValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m InternalGetSubscriptionStatsRequestMultiError) AllErrors() []error { return m }
-
-// InternalGetSubscriptionStatsRequestValidationError is the validation error
-// returned by InternalGetSubscriptionStatsRequest.Validate if the designated
-// constraints aren't met.
-type InternalGetSubscriptionStatsRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+	if m.EffectiveDate != nil {
+
+		if all {
+			switch v := interface{}(m.GetEffectiveDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalDowngradeSubscriptionRequestValidationError{
+						field:  "EffectiveDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalDowngradeSubscriptionRequestValidationError{
+						field:  "EffectiveDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEffectiveDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalDowngradeSubscriptionRequestValidationError{
+					field:  "EffectiveDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalDowngradeSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalDowngradeSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalDowngradeSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalDowngradeSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalDowngradeSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalDowngradeSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// InternalDowngradeSubscriptionRequestValidationError is the validation error
+// returned by InternalDowngradeSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type InternalDowngradeSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalDowngradeSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalDowngradeSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalDowngradeSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalDowngradeSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalDowngradeSubscriptionRequestValidationError) ErrorName() string {
+	return "InternalDowngradeSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalDowngradeSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalDowngradeSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalDowngradeSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalDowngradeSubscriptionRequestValidationError{}
+
+// Validate checks the field values on InternalDowngradeSubscriptionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *InternalDowngradeSubscriptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalDowngradeSubscriptionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalDowngradeSubscriptionResponseMultiError, or nil if none found.
+func (m *InternalDowngradeSubscriptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalDowngradeSubscriptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalDowngradeSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalDowngradeSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalDowngradeSubscriptionResponseValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEffectiveDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalDowngradeSubscriptionResponseValidationError{
+					field:  "EffectiveDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalDowngradeSubscriptionResponseValidationError{
+					field:  "EffectiveDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEffectiveDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalDowngradeSubscriptionResponseValidationError{
+				field:  "EffectiveDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ProratedCredit
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return InternalDowngradeSubscriptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalDowngradeSubscriptionResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// InternalDowngradeSubscriptionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalDowngradeSubscriptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalDowngradeSubscriptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalDowngradeSubscriptionResponseMultiError) AllErrors() []error { return m }
+
+// InternalDowngradeSubscriptionResponseValidationError is the validation error
+// returned by InternalDowngradeSubscriptionResponse.Validate if the
+// designated constraints aren't met.
+type InternalDowngradeSubscriptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalDowngradeSubscriptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalDowngradeSubscriptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalDowngradeSubscriptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalDowngradeSubscriptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalDowngradeSubscriptionResponseValidationError) ErrorName() string {
+	return "InternalDowngradeSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalDowngradeSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalDowngradeSubscriptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalDowngradeSubscriptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalDowngradeSubscriptionResponseValidationError{}
+
+// Validate checks the field values on InternalCancelSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalCancelSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCancelSubscriptionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalCancelSubscriptionRequestMultiError, or nil if none found.
+func (m *InternalCancelSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCancelSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubscriptionCode
+
+	// no validation rules for ProductCode
+
+	// no validation rules for Immediate
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return InternalCancelSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCancelSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalCancelSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalCancelSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCancelSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCancelSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// InternalCancelSubscriptionRequestValidationError is the validation error
+// returned by InternalCancelSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type InternalCancelSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCancelSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCancelSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCancelSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCancelSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCancelSubscriptionRequestValidationError) ErrorName() string {
+	return "InternalCancelSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCancelSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCancelSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCancelSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCancelSubscriptionRequestValidationError{}
+
+// Validate checks the field values on InternalCancelSubscriptionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalCancelSubscriptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCancelSubscriptionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalCancelSubscriptionResponseMultiError, or nil if none found.
+func (m *InternalCancelSubscriptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCancelSubscriptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalCancelSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalCancelSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalCancelSubscriptionResponseValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalCancelSubscriptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCancelSubscriptionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalCancelSubscriptionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalCancelSubscriptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCancelSubscriptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCancelSubscriptionResponseMultiError) AllErrors() []error { return m }
+
+// InternalCancelSubscriptionResponseValidationError is the validation error
+// returned by InternalCancelSubscriptionResponse.Validate if the designated
+// constraints aren't met.
+type InternalCancelSubscriptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCancelSubscriptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCancelSubscriptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCancelSubscriptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCancelSubscriptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCancelSubscriptionResponseValidationError) ErrorName() string {
+	return "InternalCancelSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCancelSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCancelSubscriptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCancelSubscriptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCancelSubscriptionResponseValidationError{}
+
+// Validate checks the field values on InternalPauseSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalPauseSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalPauseSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalPauseSubscriptionRequestMultiError, or nil if none found.
+func (m *InternalPauseSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalPauseSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubscriptionCode
+
+	// no validation rules for ProductCode
+
+	// no validation rules for Reason
+
+	if m.EffectiveDate != nil {
+
+		if all {
+			switch v := interface{}(m.GetEffectiveDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalPauseSubscriptionRequestValidationError{
+						field:  "EffectiveDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalPauseSubscriptionRequestValidationError{
+						field:  "EffectiveDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEffectiveDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalPauseSubscriptionRequestValidationError{
+					field:  "EffectiveDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ResumeDate != nil {
+
+		if all {
+			switch v := interface{}(m.GetResumeDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalPauseSubscriptionRequestValidationError{
+						field:  "ResumeDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalPauseSubscriptionRequestValidationError{
+						field:  "ResumeDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetResumeDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalPauseSubscriptionRequestValidationError{
+					field:  "ResumeDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalPauseSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalPauseSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalPauseSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalPauseSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalPauseSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalPauseSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// InternalPauseSubscriptionRequestValidationError is the validation error
+// returned by InternalPauseSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type InternalPauseSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalPauseSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalPauseSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalPauseSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalPauseSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalPauseSubscriptionRequestValidationError) ErrorName() string {
+	return "InternalPauseSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalPauseSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalPauseSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalPauseSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalPauseSubscriptionRequestValidationError{}
+
+// Validate checks the field values on InternalPauseSubscriptionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalPauseSubscriptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalPauseSubscriptionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalPauseSubscriptionResponseMultiError, or nil if none found.
+func (m *InternalPauseSubscriptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalPauseSubscriptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalPauseSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalPauseSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalPauseSubscriptionResponseValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalPauseSubscriptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalPauseSubscriptionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalPauseSubscriptionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalPauseSubscriptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalPauseSubscriptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalPauseSubscriptionResponseMultiError) AllErrors() []error { return m }
+
+// InternalPauseSubscriptionResponseValidationError is the validation error
+// returned by InternalPauseSubscriptionResponse.Validate if the designated
+// constraints aren't met.
+type InternalPauseSubscriptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalPauseSubscriptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalPauseSubscriptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalPauseSubscriptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalPauseSubscriptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalPauseSubscriptionResponseValidationError) ErrorName() string {
+	return "InternalPauseSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalPauseSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalPauseSubscriptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalPauseSubscriptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalPauseSubscriptionResponseValidationError{}
+
+// Validate checks the field values on InternalResumeSubscriptionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalResumeSubscriptionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalResumeSubscriptionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalResumeSubscriptionRequestMultiError, or nil if none found.
+func (m *InternalResumeSubscriptionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalResumeSubscriptionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubscriptionCode
+
+	// no validation rules for ProductCode
+
+	if m.EffectiveDate != nil {
+
+		if all {
+			switch v := interface{}(m.GetEffectiveDate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalResumeSubscriptionRequestValidationError{
+						field:  "EffectiveDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalResumeSubscriptionRequestValidationError{
+						field:  "EffectiveDate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEffectiveDate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalResumeSubscriptionRequestValidationError{
+					field:  "EffectiveDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalResumeSubscriptionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalResumeSubscriptionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalResumeSubscriptionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalResumeSubscriptionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalResumeSubscriptionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalResumeSubscriptionRequestMultiError) AllErrors() []error { return m }
+
+// InternalResumeSubscriptionRequestValidationError is the validation error
+// returned by InternalResumeSubscriptionRequest.Validate if the designated
+// constraints aren't met.
+type InternalResumeSubscriptionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalResumeSubscriptionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalResumeSubscriptionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalResumeSubscriptionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalResumeSubscriptionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalResumeSubscriptionRequestValidationError) ErrorName() string {
+	return "InternalResumeSubscriptionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalResumeSubscriptionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalResumeSubscriptionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalResumeSubscriptionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalResumeSubscriptionRequestValidationError{}
+
+// Validate checks the field values on InternalResumeSubscriptionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalResumeSubscriptionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalResumeSubscriptionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalResumeSubscriptionResponseMultiError, or nil if none found.
+func (m *InternalResumeSubscriptionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalResumeSubscriptionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalResumeSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalResumeSubscriptionResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalResumeSubscriptionResponseValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalResumeSubscriptionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalResumeSubscriptionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalResumeSubscriptionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalResumeSubscriptionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalResumeSubscriptionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalResumeSubscriptionResponseMultiError) AllErrors() []error { return m }
+
+// InternalResumeSubscriptionResponseValidationError is the validation error
+// returned by InternalResumeSubscriptionResponse.Validate if the designated
+// constraints aren't met.
+type InternalResumeSubscriptionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalResumeSubscriptionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalResumeSubscriptionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalResumeSubscriptionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalResumeSubscriptionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalResumeSubscriptionResponseValidationError) ErrorName() string {
+	return "InternalResumeSubscriptionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalResumeSubscriptionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalResumeSubscriptionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalResumeSubscriptionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalResumeSubscriptionResponseValidationError{}
+
+// Validate checks the field values on InternalConvertTrialRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalConvertTrialRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalConvertTrialRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalConvertTrialRequestMultiError, or nil if none found.
+func (m *InternalConvertTrialRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalConvertTrialRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubscriptionCode
+
+	// no validation rules for ProductCode
+
+	// no validation rules for PlanCode
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalConvertTrialRequestValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalConvertTrialRequestValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalConvertTrialRequestValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalConvertTrialRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalConvertTrialRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalConvertTrialRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalConvertTrialRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalConvertTrialRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalConvertTrialRequestMultiError) AllErrors() []error { return m }
+
+// InternalConvertTrialRequestValidationError is the validation error returned
+// by InternalConvertTrialRequest.Validate if the designated constraints
+// aren't met.
+type InternalConvertTrialRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalConvertTrialRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalConvertTrialRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalConvertTrialRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalConvertTrialRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalConvertTrialRequestValidationError) ErrorName() string {
+	return "InternalConvertTrialRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalConvertTrialRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalConvertTrialRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalConvertTrialRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalConvertTrialRequestValidationError{}
+
+// Validate checks the field values on InternalConvertTrialResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalConvertTrialResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalConvertTrialResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalConvertTrialResponseMultiError, or nil if none found.
+func (m *InternalConvertTrialResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalConvertTrialResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalConvertTrialResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalConvertTrialResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalConvertTrialResponseValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalConvertTrialResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalConvertTrialResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalConvertTrialResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalConvertTrialResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalConvertTrialResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalConvertTrialResponseMultiError) AllErrors() []error { return m }
+
+// InternalConvertTrialResponseValidationError is the validation error returned
+// by InternalConvertTrialResponse.Validate if the designated constraints
+// aren't met.
+type InternalConvertTrialResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalConvertTrialResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalConvertTrialResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalConvertTrialResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalConvertTrialResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalConvertTrialResponseValidationError) ErrorName() string {
+	return "InternalConvertTrialResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalConvertTrialResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalConvertTrialResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalConvertTrialResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalConvertTrialResponseValidationError{}
+
+// Validate checks the field values on InternalExtendTrialRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalExtendTrialRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalExtendTrialRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalExtendTrialRequestMultiError, or nil if none found.
+func (m *InternalExtendTrialRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalExtendTrialRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubscriptionCode
+
+	// no validation rules for ProductCode
+
+	if all {
+		switch v := interface{}(m.GetExtendTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalExtendTrialRequestValidationError{
+					field:  "ExtendTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalExtendTrialRequestValidationError{
+					field:  "ExtendTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExtendTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalExtendTrialRequestValidationError{
+				field:  "ExtendTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalExtendTrialRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalExtendTrialRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalExtendTrialRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalExtendTrialRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalExtendTrialRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalExtendTrialRequestMultiError) AllErrors() []error { return m }
+
+// InternalExtendTrialRequestValidationError is the validation error returned
+// by InternalExtendTrialRequest.Validate if the designated constraints aren't met.
+type InternalExtendTrialRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalExtendTrialRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalExtendTrialRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalExtendTrialRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalExtendTrialRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalExtendTrialRequestValidationError) ErrorName() string {
+	return "InternalExtendTrialRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalExtendTrialRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalExtendTrialRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalExtendTrialRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalExtendTrialRequestValidationError{}
+
+// Validate checks the field values on InternalExtendTrialResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalExtendTrialResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalExtendTrialResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalExtendTrialResponseMultiError, or nil if none found.
+func (m *InternalExtendTrialResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalExtendTrialResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubscription()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalExtendTrialResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalExtendTrialResponseValidationError{
+					field:  "Subscription",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubscription()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalExtendTrialResponseValidationError{
+				field:  "Subscription",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalExtendTrialResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalExtendTrialResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalExtendTrialResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalExtendTrialResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalExtendTrialResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalExtendTrialResponseMultiError) AllErrors() []error { return m }
+
+// InternalExtendTrialResponseValidationError is the validation error returned
+// by InternalExtendTrialResponse.Validate if the designated constraints
+// aren't met.
+type InternalExtendTrialResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalExtendTrialResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalExtendTrialResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalExtendTrialResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalExtendTrialResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalExtendTrialResponseValidationError) ErrorName() string {
+	return "InternalExtendTrialResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalExtendTrialResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalExtendTrialResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalExtendTrialResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalExtendTrialResponseValidationError{}
+
+// Validate checks the field values on InternalGetSubscriptionStatsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *InternalGetSubscriptionStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetSubscriptionStatsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// InternalGetSubscriptionStatsRequestMultiError, or nil if none found.
+func (m *InternalGetSubscriptionStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetSubscriptionStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	if len(errors) > 0 {
+		return InternalGetSubscriptionStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetSubscriptionStatsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// InternalGetSubscriptionStatsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetSubscriptionStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetSubscriptionStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetSubscriptionStatsRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetSubscriptionStatsRequestValidationError is the validation error
+// returned by InternalGetSubscriptionStatsRequest.Validate if the designated
+// constraints aren't met.
+type InternalGetSubscriptionStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
 // Field function returns field value.
 func (e InternalGetSubscriptionStatsRequestValidationError) Field() string { return e.field }
@@ -2434,6 +4693,80 @@ func (m *InternalGetSubscriptionStatsByProductCodeRequest) validate(all bool) er
 
 	// no validation rules for ProductCode
 
+	if m.StartTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetStartTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalGetSubscriptionStatsByProductCodeRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalGetSubscriptionStatsByProductCodeRequestValidationError{
+						field:  "StartTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalGetSubscriptionStatsByProductCodeRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.EndTime != nil {
+
+		if all {
+			switch v := interface{}(m.GetEndTime()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalGetSubscriptionStatsByProductCodeRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalGetSubscriptionStatsByProductCodeRequestValidationError{
+						field:  "EndTime",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalGetSubscriptionStatsByProductCodeRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Page != nil {
+		// no validation rules for Page
+	}
+
+	if m.PageSize != nil {
+		// no validation rules for PageSize
+	}
+
 	if len(errors) > 0 {
 		return InternalGetSubscriptionStatsByProductCodeRequestMultiError(errors)
 	}
@@ -2471,30 +4804,187 @@ type InternalGetSubscriptionStatsByProductCodeRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e InternalGetSubscriptionStatsByProductCodeRequestValidationError) Field() string {
+func (e InternalGetSubscriptionStatsByProductCodeRequestValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e InternalGetSubscriptionStatsByProductCodeRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e InternalGetSubscriptionStatsByProductCodeRequestValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e InternalGetSubscriptionStatsByProductCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetSubscriptionStatsByProductCodeRequestValidationError) ErrorName() string {
+	return "InternalGetSubscriptionStatsByProductCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetSubscriptionStatsByProductCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetSubscriptionStatsByProductCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetSubscriptionStatsByProductCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetSubscriptionStatsByProductCodeRequestValidationError{}
+
+// Validate checks the field values on
+// InternalGetSubscriptionStatsByProductCodeResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InternalGetSubscriptionStatsByProductCodeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// InternalGetSubscriptionStatsByProductCodeResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// InternalGetSubscriptionStatsByProductCodeResponseMultiError, or nil if none found.
+func (m *InternalGetSubscriptionStatsByProductCodeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetSubscriptionStatsByProductCodeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ActiveCount
+
+	// no validation rules for TrialCount
+
+	// no validation rules for NewCount
+
+	// no validation rules for ChurnedCount
+
+	for idx, item := range m.GetPlanStats() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalGetSubscriptionStatsByProductCodeResponseValidationError{
+						field:  fmt.Sprintf("PlanStats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalGetSubscriptionStatsByProductCodeResponseValidationError{
+						field:  fmt.Sprintf("PlanStats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalGetSubscriptionStatsByProductCodeResponseValidationError{
+					field:  fmt.Sprintf("PlanStats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PlanTotal
+
+	if len(errors) > 0 {
+		return InternalGetSubscriptionStatsByProductCodeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetSubscriptionStatsByProductCodeResponseMultiError is an error
+// wrapping multiple validation errors returned by
+// InternalGetSubscriptionStatsByProductCodeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type InternalGetSubscriptionStatsByProductCodeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetSubscriptionStatsByProductCodeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetSubscriptionStatsByProductCodeResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetSubscriptionStatsByProductCodeResponseValidationError is the
+// validation error returned by
+// InternalGetSubscriptionStatsByProductCodeResponse.Validate if the
+// designated constraints aren't met.
+type InternalGetSubscriptionStatsByProductCodeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetSubscriptionStatsByProductCodeResponseValidationError) Field() string {
 	return e.field
 }
 
 // Reason function returns reason value.
-func (e InternalGetSubscriptionStatsByProductCodeRequestValidationError) Reason() string {
+func (e InternalGetSubscriptionStatsByProductCodeResponseValidationError) Reason() string {
 	return e.reason
 }
 
 // Cause function returns cause value.
-func (e InternalGetSubscriptionStatsByProductCodeRequestValidationError) Cause() error {
+func (e InternalGetSubscriptionStatsByProductCodeResponseValidationError) Cause() error {
 	return e.cause
 }
 
 // Key function returns key value.
-func (e InternalGetSubscriptionStatsByProductCodeRequestValidationError) Key() bool { return e.key }
+func (e InternalGetSubscriptionStatsByProductCodeResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InternalGetSubscriptionStatsByProductCodeRequestValidationError) ErrorName() string {
-	return "InternalGetSubscriptionStatsByProductCodeRequestValidationError"
+func (e InternalGetSubscriptionStatsByProductCodeResponseValidationError) ErrorName() string {
+	return "InternalGetSubscriptionStatsByProductCodeResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InternalGetSubscriptionStatsByProductCodeRequestValidationError) Error() string {
+func (e InternalGetSubscriptionStatsByProductCodeResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2506,14 +4996,14 @@ func (e InternalGetSubscriptionStatsByProductCodeRequestValidationError) Error()
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInternalGetSubscriptionStatsByProductCodeRequest.%s: %s%s",
+		"invalid %sInternalGetSubscriptionStatsByProductCodeResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InternalGetSubscriptionStatsByProductCodeRequestValidationError{}
+var _ error = InternalGetSubscriptionStatsByProductCodeResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2521,51 +5011,54 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InternalGetSubscriptionStatsByProductCodeRequestValidationError{}
+} = InternalGetSubscriptionStatsByProductCodeResponseValidationError{}
 
-// Validate checks the field values on
-// InternalGetSubscriptionStatsByProductCodeResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *InternalGetSubscriptionStatsByProductCodeResponse) Validate() error {
+// Validate checks the field values on InternalPlanSubscriptionStats with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalPlanSubscriptionStats) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// InternalGetSubscriptionStatsByProductCodeResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in
-// InternalGetSubscriptionStatsByProductCodeResponseMultiError, or nil if none found.
-func (m *InternalGetSubscriptionStatsByProductCodeResponse) ValidateAll() error {
+// ValidateAll checks the field values on InternalPlanSubscriptionStats with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalPlanSubscriptionStatsMultiError, or nil if none found.
+func (m *InternalPlanSubscriptionStats) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *InternalGetSubscriptionStatsByProductCodeResponse) validate(all bool) error {
+func (m *InternalPlanSubscriptionStats) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PlanCode
+
 	// no validation rules for ActiveCount
 
 	// no validation rules for TrialCount
 
+	// no validation rules for NewCount
+
+	// no validation rules for ChurnedCount
+
 	if len(errors) > 0 {
-		return InternalGetSubscriptionStatsByProductCodeResponseMultiError(errors)
+		return InternalPlanSubscriptionStatsMultiError(errors)
 	}
 
 	return nil
 }
 
-// InternalGetSubscriptionStatsByProductCodeResponseMultiError is an error
-// wrapping multiple validation errors returned by
-// InternalGetSubscriptionStatsByProductCodeResponse.ValidateAll() if the
-// designated constraints aren't met.
-type InternalGetSubscriptionStatsByProductCodeResponseMultiError []error
+// InternalPlanSubscriptionStatsMultiError is an error wrapping multiple
+// validation errors returned by InternalPlanSubscriptionStats.ValidateAll()
+// if the designated constraints aren't met.
+type InternalPlanSubscriptionStatsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m InternalGetSubscriptionStatsByProductCodeResponseMultiError) Error() string {
+func (m InternalPlanSubscriptionStatsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2574,13 +5067,12 @@ func (m InternalGetSubscriptionStatsByProductCodeResponseMultiError) Error() str
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m InternalGetSubscriptionStatsByProductCodeResponseMultiError) AllErrors() []error { return m }
+func (m InternalPlanSubscriptionStatsMultiError) AllErrors() []error { return m }
 
-// InternalGetSubscriptionStatsByProductCodeResponseValidationError is the
-// validation error returned by
-// InternalGetSubscriptionStatsByProductCodeResponse.Validate if the
-// designated constraints aren't met.
-type InternalGetSubscriptionStatsByProductCodeResponseValidationError struct {
+// InternalPlanSubscriptionStatsValidationError is the validation error
+// returned by InternalPlanSubscriptionStats.Validate if the designated
+// constraints aren't met.
+type InternalPlanSubscriptionStatsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2588,30 +5080,24 @@ type InternalGetSubscriptionStatsByProductCodeResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e InternalGetSubscriptionStatsByProductCodeResponseValidationError) Field() string {
-	return e.field
-}
+func (e InternalPlanSubscriptionStatsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e InternalGetSubscriptionStatsByProductCodeResponseValidationError) Reason() string {
-	return e.reason
-}
+func (e InternalPlanSubscriptionStatsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e InternalGetSubscriptionStatsByProductCodeResponseValidationError) Cause() error {
-	return e.cause
-}
+func (e InternalPlanSubscriptionStatsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e InternalGetSubscriptionStatsByProductCodeResponseValidationError) Key() bool { return e.key }
+func (e InternalPlanSubscriptionStatsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e InternalGetSubscriptionStatsByProductCodeResponseValidationError) ErrorName() string {
-	return "InternalGetSubscriptionStatsByProductCodeResponseValidationError"
+func (e InternalPlanSubscriptionStatsValidationError) ErrorName() string {
+	return "InternalPlanSubscriptionStatsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e InternalGetSubscriptionStatsByProductCodeResponseValidationError) Error() string {
+func (e InternalPlanSubscriptionStatsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2623,14 +5109,14 @@ func (e InternalGetSubscriptionStatsByProductCodeResponseValidationError) Error(
 	}
 
 	return fmt.Sprintf(
-		"invalid %sInternalGetSubscriptionStatsByProductCodeResponse.%s: %s%s",
+		"invalid %sInternalPlanSubscriptionStats.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = InternalGetSubscriptionStatsByProductCodeResponseValidationError{}
+var _ error = InternalPlanSubscriptionStatsValidationError{}
 
 var _ interface {
 	Field() string
@@ -2638,7 +5124,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = InternalGetSubscriptionStatsByProductCodeResponseValidationError{}
+} = InternalPlanSubscriptionStatsValidationError{}
 
 // Validate checks the field values on InternalCheckAndUseQuotaRequest with the
 // rules defined in the proto definition for this message. If any rules are
@@ -2670,6 +5156,10 @@ func (m *InternalCheckAndUseQuotaRequest) validate(all bool) error {
 
 	// no validation rules for Amount
 
+	if m.IdempotencyKey != nil {
+		// no validation rules for IdempotencyKey
+	}
+
 	if len(errors) > 0 {
 		return InternalCheckAndUseQuotaRequestMultiError(errors)
 	}
@@ -2792,6 +5282,8 @@ func (m *InternalCheckAndUseQuotaResponse) validate(all bool) error {
 
 	// no validation rules for ErrorCode
 
+	// no validation rules for Replayed
+
 	if len(errors) > 0 {
 		return InternalCheckAndUseQuotaResponseMultiError(errors)
 	}
@@ -2874,6 +5366,234 @@ var _ interface {
 	ErrorName() string
 } = InternalCheckAndUseQuotaResponseValidationError{}
 
+// Validate checks the field values on InternalCheckQuotaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalCheckQuotaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCheckQuotaRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalCheckQuotaRequestMultiError, or nil if none found.
+func (m *InternalCheckQuotaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCheckQuotaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TenantCode
+
+	// no validation rules for ProductCode
+
+	// no validation rules for DimensionKey
+
+	// no validation rules for Amount
+
+	if len(errors) > 0 {
+		return InternalCheckQuotaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCheckQuotaRequestMultiError is an error wrapping multiple validation
+// errors returned by InternalCheckQuotaRequest.ValidateAll() if the
+// designated constraints aren't met.
+type InternalCheckQuotaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCheckQuotaRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCheckQuotaRequestMultiError) AllErrors() []error { return m }
+
+// InternalCheckQuotaRequestValidationError is the validation error returned by
+// InternalCheckQuotaRequest.Validate if the designated constraints aren't met.
+type InternalCheckQuotaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCheckQuotaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCheckQuotaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCheckQuotaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCheckQuotaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCheckQuotaRequestValidationError) ErrorName() string {
+	return "InternalCheckQuotaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCheckQuotaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCheckQuotaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCheckQuotaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCheckQuotaRequestValidationError{}
+
+// Validate checks the field values on InternalCheckQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalCheckQuotaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCheckQuotaResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalCheckQuotaResponseMultiError, or nil if none found.
+func (m *InternalCheckQuotaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCheckQuotaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	// no validation rules for DimensionKey
+
+	// no validation rules for QuotaLimit
+
+	// no validation rules for QuotaUsed
+
+	// no validation rules for QuotaRemaining
+
+	// no validation rules for IsUnlimited
+
+	// no validation rules for ErrorMessage
+
+	// no validation rules for ErrorCode
+
+	if len(errors) > 0 {
+		return InternalCheckQuotaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCheckQuotaResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalCheckQuotaResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalCheckQuotaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCheckQuotaResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCheckQuotaResponseMultiError) AllErrors() []error { return m }
+
+// InternalCheckQuotaResponseValidationError is the validation error returned
+// by InternalCheckQuotaResponse.Validate if the designated constraints aren't met.
+type InternalCheckQuotaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCheckQuotaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCheckQuotaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCheckQuotaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCheckQuotaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCheckQuotaResponseValidationError) ErrorName() string {
+	return "InternalCheckQuotaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalCheckQuotaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCheckQuotaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCheckQuotaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCheckQuotaResponseValidationError{}
+
 // Validate checks the field values on InternalReleaseQuotaRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -2904,6 +5624,10 @@ func (m *InternalReleaseQuotaRequest) validate(all bool) error {
 
 	// no validation rules for Amount
 
+	if m.IdempotencyKey != nil {
+		// no validation rules for IdempotencyKey
+	}
+
 	if len(errors) > 0 {
 		return InternalReleaseQuotaRequestMultiError(errors)
 	}
@@ -3017,6 +5741,8 @@ func (m *InternalReleaseQuotaResponse) validate(all bool) error {
 
 	// no validation rules for ErrorMessage
 
+	// no validation rules for Replayed
+
 	if len(errors) > 0 {
 		return InternalReleaseQuotaResponseMultiError(errors)
 	}
@@ -20,12 +20,20 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	SubscriptionInternalService_InternalListSubscriptions_FullMethodName                 = "/api.subscription.v1.SubscriptionInternalService/InternalListSubscriptions"
+	SubscriptionInternalService_InternalGetSubscription_FullMethodName                   = "/api.subscription.v1.SubscriptionInternalService/InternalGetSubscription"
 	SubscriptionInternalService_InternalCreateSubscription_FullMethodName                = "/api.subscription.v1.SubscriptionInternalService/InternalCreateSubscription"
 	SubscriptionInternalService_InternalReNewSubscription_FullMethodName                 = "/api.subscription.v1.SubscriptionInternalService/InternalReNewSubscription"
 	SubscriptionInternalService_InternalUpgradeSubscription_FullMethodName               = "/api.subscription.v1.SubscriptionInternalService/InternalUpgradeSubscription"
+	SubscriptionInternalService_InternalDowngradeSubscription_FullMethodName             = "/api.subscription.v1.SubscriptionInternalService/InternalDowngradeSubscription"
+	SubscriptionInternalService_InternalCancelSubscription_FullMethodName                = "/api.subscription.v1.SubscriptionInternalService/InternalCancelSubscription"
+	SubscriptionInternalService_InternalPauseSubscription_FullMethodName                 = "/api.subscription.v1.SubscriptionInternalService/InternalPauseSubscription"
+	SubscriptionInternalService_InternalResumeSubscription_FullMethodName                = "/api.subscription.v1.SubscriptionInternalService/InternalResumeSubscription"
+	SubscriptionInternalService_InternalConvertTrial_FullMethodName                      = "/api.subscription.v1.SubscriptionInternalService/InternalConvertTrial"
+	SubscriptionInternalService_InternalExtendTrial_FullMethodName                       = "/api.subscription.v1.SubscriptionInternalService/InternalExtendTrial"
 	SubscriptionInternalService_InternalGetSubscriptionStats_FullMethodName              = "/api.subscription.v1.SubscriptionInternalService/InternalGetSubscriptionStats"
 	SubscriptionInternalService_InternalGetSubscriptionStatsByProductCode_FullMethodName = "/api.subscription.v1.SubscriptionInternalService/InternalGetSubscriptionStatsByProductCode"
 	SubscriptionInternalService_InternalCheckAndUseQuota_FullMethodName                  = "/api.subscription.v1.SubscriptionInternalService/InternalCheckAndUseQuota"
+	SubscriptionInternalService_InternalCheckQuota_FullMethodName                        = "/api.subscription.v1.SubscriptionInternalService/InternalCheckQuota"
 	SubscriptionInternalService_InternalReleaseQuota_FullMethodName                      = "/api.subscription.v1.SubscriptionInternalService/InternalReleaseQuota"
 	SubscriptionInternalService_InternalGetQuotaUsage_FullMethodName                     = "/api.subscription.v1.SubscriptionInternalService/InternalGetQuotaUsage"
 )
@@ -38,12 +46,26 @@ const (
 type SubscriptionInternalServiceClient interface {
 	// ListSubscriptions 获取订阅列表
 	InternalListSubscriptions(ctx context.Context, in *InternalListSubscriptionsRequest, opts ...grpc.CallOption) (*InternalListSubscriptionsResponse, error)
+	// InternalGetSubscription 通过订阅Code获取订阅详情
+	InternalGetSubscription(ctx context.Context, in *InternalGetSubscriptionRequest, opts ...grpc.CallOption) (*InternalGetSubscriptionResponse, error)
 	// CreateSubscription 商户创建订阅
 	InternalCreateSubscription(ctx context.Context, in *InternalCreateSubscriptionRequest, opts ...grpc.CallOption) (*InternalCreateSubscriptionResponse, error)
 	// ReNewSubscription 商户续订订阅
 	InternalReNewSubscription(ctx context.Context, in *InternalReNewSubscriptionRequest, opts ...grpc.CallOption) (*InternalReNewSubscriptionResponse, error)
 	// UpgradeSubscription 商户升级订阅
 	InternalUpgradeSubscription(ctx context.Context, in *InternalUpgradeSubscriptionRequest, opts ...grpc.CallOption) (*InternalUpgradeSubscriptionResponse, error)
+	// InternalDowngradeSubscription 商户降级订阅（返回生效时间和按比例折算金额）
+	InternalDowngradeSubscription(ctx context.Context, in *InternalDowngradeSubscriptionRequest, opts ...grpc.CallOption) (*InternalDowngradeSubscriptionResponse, error)
+	// InternalCancelSubscription 商户取消订阅（立即终止或到期后终止）
+	InternalCancelSubscription(ctx context.Context, in *InternalCancelSubscriptionRequest, opts ...grpc.CallOption) (*InternalCancelSubscriptionResponse, error)
+	// InternalPauseSubscription 商户暂停订阅（暂停期间不计用量、不计时长）
+	InternalPauseSubscription(ctx context.Context, in *InternalPauseSubscriptionRequest, opts ...grpc.CallOption) (*InternalPauseSubscriptionResponse, error)
+	// InternalResumeSubscription 商户恢复已暂停的订阅
+	InternalResumeSubscription(ctx context.Context, in *InternalResumeSubscriptionRequest, opts ...grpc.CallOption) (*InternalResumeSubscriptionResponse, error)
+	// InternalConvertTrial 试用订阅转为正式订阅
+	InternalConvertTrial(ctx context.Context, in *InternalConvertTrialRequest, opts ...grpc.CallOption) (*InternalConvertTrialResponse, error)
+	// InternalExtendTrial 延长试用期
+	InternalExtendTrial(ctx context.Context, in *InternalExtendTrialRequest, opts ...grpc.CallOption) (*InternalExtendTrialResponse, error)
 	// InternalGetSubscriptionStats 获取商户订阅状态
 	InternalGetSubscriptionStats(ctx context.Context, in *InternalGetSubscriptionStatsRequest, opts ...grpc.CallOption) (*InternalGetSubscriptionStatsResponse, error)
 	// InternalGetSubscriptionStatsByProductCode 通过产品code获取订阅状态
@@ -51,6 +73,9 @@ type SubscriptionInternalServiceClient interface {
 	// InternalCheckAndUseQuota 检查并使用配额
 	// 检查点 → 维度 → 检查配额 → 使用成功则 +1
 	InternalCheckAndUseQuota(ctx context.Context, in *InternalCheckAndUseQuotaRequest, opts ...grpc.CallOption) (*InternalCheckAndUseQuotaResponse, error)
+	// InternalCheckQuota 预检查配额（不扣减）
+	// 用于表单校验、向导预检等场景，返回是否允许及剩余量
+	InternalCheckQuota(ctx context.Context, in *InternalCheckQuotaRequest, opts ...grpc.CallOption) (*InternalCheckQuotaResponse, error)
 	// InternalReleaseQuota 释放配额
 	// 删除资源时调用，quota_used -1
 	InternalReleaseQuota(ctx context.Context, in *InternalReleaseQuotaRequest, opts ...grpc.CallOption) (*InternalReleaseQuotaResponse, error)
@@ -76,6 +101,16 @@ func (c *subscriptionInternalServiceClient) InternalListSubscriptions(ctx contex
 	return out, nil
 }
 
+func (c *subscriptionInternalServiceClient) InternalGetSubscription(ctx context.Context, in *InternalGetSubscriptionRequest, opts ...grpc.CallOption) (*InternalGetSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGetSubscriptionResponse)
+	err := c.cc.Invoke(ctx, SubscriptionInternalService_InternalGetSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *subscriptionInternalServiceClient) InternalCreateSubscription(ctx context.Context, in *InternalCreateSubscriptionRequest, opts ...grpc.CallOption) (*InternalCreateSubscriptionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InternalCreateSubscriptionResponse)
@@ -106,6 +141,66 @@ func (c *subscriptionInternalServiceClient) InternalUpgradeSubscription(ctx cont
 	return out, nil
 }
 
+func (c *subscriptionInternalServiceClient) InternalDowngradeSubscription(ctx context.Context, in *InternalDowngradeSubscriptionRequest, opts ...grpc.CallOption) (*InternalDowngradeSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalDowngradeSubscriptionResponse)
+	err := c.cc.Invoke(ctx, SubscriptionInternalService_InternalDowngradeSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionInternalServiceClient) InternalCancelSubscription(ctx context.Context, in *InternalCancelSubscriptionRequest, opts ...grpc.CallOption) (*InternalCancelSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalCancelSubscriptionResponse)
+	err := c.cc.Invoke(ctx, SubscriptionInternalService_InternalCancelSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionInternalServiceClient) InternalPauseSubscription(ctx context.Context, in *InternalPauseSubscriptionRequest, opts ...grpc.CallOption) (*InternalPauseSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalPauseSubscriptionResponse)
+	err := c.cc.Invoke(ctx, SubscriptionInternalService_InternalPauseSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionInternalServiceClient) InternalResumeSubscription(ctx context.Context, in *InternalResumeSubscriptionRequest, opts ...grpc.CallOption) (*InternalResumeSubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalResumeSubscriptionResponse)
+	err := c.cc.Invoke(ctx, SubscriptionInternalService_InternalResumeSubscription_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionInternalServiceClient) InternalConvertTrial(ctx context.Context, in *InternalConvertTrialRequest, opts ...grpc.CallOption) (*InternalConvertTrialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalConvertTrialResponse)
+	err := c.cc.Invoke(ctx, SubscriptionInternalService_InternalConvertTrial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionInternalServiceClient) InternalExtendTrial(ctx context.Context, in *InternalExtendTrialRequest, opts ...grpc.CallOption) (*InternalExtendTrialResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalExtendTrialResponse)
+	err := c.cc.Invoke(ctx, SubscriptionInternalService_InternalExtendTrial_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *subscriptionInternalServiceClient) InternalGetSubscriptionStats(ctx context.Context, in *InternalGetSubscriptionStatsRequest, opts ...grpc.CallOption) (*InternalGetSubscriptionStatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InternalGetSubscriptionStatsResponse)
@@ -136,6 +231,16 @@ func (c *subscriptionInternalServiceClient) InternalCheckAndUseQuota(ctx context
 	return out, nil
 }
 
+func (c *subscriptionInternalServiceClient) InternalCheckQuota(ctx context.Context, in *InternalCheckQuotaRequest, opts ...grpc.CallOption) (*InternalCheckQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalCheckQuotaResponse)
+	err := c.cc.Invoke(ctx, SubscriptionInternalService_InternalCheckQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *subscriptionInternalServiceClient) InternalReleaseQuota(ctx context.Context, in *InternalReleaseQuotaRequest, opts ...grpc.CallOption) (*InternalReleaseQuotaResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(InternalReleaseQuotaResponse)
@@ -164,12 +269,26 @@ func (c *subscriptionInternalServiceClient) InternalGetQuotaUsage(ctx context.Co
 type SubscriptionInternalServiceServer interface {
 	// ListSubscriptions 获取订阅列表
 	InternalListSubscriptions(context.Context, *InternalListSubscriptionsRequest) (*InternalListSubscriptionsResponse, error)
+	// InternalGetSubscription 通过订阅Code获取订阅详情
+	InternalGetSubscription(context.Context, *InternalGetSubscriptionRequest) (*InternalGetSubscriptionResponse, error)
 	// CreateSubscription 商户创建订阅
 	InternalCreateSubscription(context.Context, *InternalCreateSubscriptionRequest) (*InternalCreateSubscriptionResponse, error)
 	// ReNewSubscription 商户续订订阅
 	InternalReNewSubscription(context.Context, *InternalReNewSubscriptionRequest) (*InternalReNewSubscriptionResponse, error)
 	// UpgradeSubscription 商户升级订阅
 	InternalUpgradeSubscription(context.Context, *InternalUpgradeSubscriptionRequest) (*InternalUpgradeSubscriptionResponse, error)
+	// InternalDowngradeSubscription 商户降级订阅（返回生效时间和按比例折算金额）
+	InternalDowngradeSubscription(context.Context, *InternalDowngradeSubscriptionRequest) (*InternalDowngradeSubscriptionResponse, error)
+	// InternalCancelSubscription 商户取消订阅（立即终止或到期后终止）
+	InternalCancelSubscription(context.Context, *InternalCancelSubscriptionRequest) (*InternalCancelSubscriptionResponse, error)
+	// InternalPauseSubscription 商户暂停订阅（暂停期间不计用量、不计时长）
+	InternalPauseSubscription(context.Context, *InternalPauseSubscriptionRequest) (*InternalPauseSubscriptionResponse, error)
+	// InternalResumeSubscription 商户恢复已暂停的订阅
+	InternalResumeSubscription(context.Context, *InternalResumeSubscriptionRequest) (*InternalResumeSubscriptionResponse, error)
+	// InternalConvertTrial 试用订阅转为正式订阅
+	InternalConvertTrial(context.Context, *InternalConvertTrialRequest) (*InternalConvertTrialResponse, error)
+	// InternalExtendTrial 延长试用期
+	InternalExtendTrial(context.Context, *InternalExtendTrialRequest) (*InternalExtendTrialResponse, error)
 	// InternalGetSubscriptionStats 获取商户订阅状态
 	InternalGetSubscriptionStats(context.Context, *InternalGetSubscriptionStatsRequest) (*InternalGetSubscriptionStatsResponse, error)
 	// InternalGetSubscriptionStatsByProductCode 通过产品code获取订阅状态
@@ -177,6 +296,9 @@ type SubscriptionInternalServiceServer interface {
 	// InternalCheckAndUseQuota 检查并使用配额
 	// 检查点 → 维度 → 检查配额 → 使用成功则 +1
 	InternalCheckAndUseQuota(context.Context, *InternalCheckAndUseQuotaRequest) (*InternalCheckAndUseQuotaResponse, error)
+	// InternalCheckQuota 预检查配额（不扣减）
+	// 用于表单校验、向导预检等场景，返回是否允许及剩余量
+	InternalCheckQuota(context.Context, *InternalCheckQuotaRequest) (*InternalCheckQuotaResponse, error)
 	// InternalReleaseQuota 释放配额
 	// 删除资源时调用，quota_used -1
 	InternalReleaseQuota(context.Context, *InternalReleaseQuotaRequest) (*InternalReleaseQuotaResponse, error)
@@ -195,6 +317,9 @@ type UnimplementedSubscriptionInternalServiceServer struct{}
 func (UnimplementedSubscriptionInternalServiceServer) InternalListSubscriptions(context.Context, *InternalListSubscriptionsRequest) (*InternalListSubscriptionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalListSubscriptions not implemented")
 }
+func (UnimplementedSubscriptionInternalServiceServer) InternalGetSubscription(context.Context, *InternalGetSubscriptionRequest) (*InternalGetSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalGetSubscription not implemented")
+}
 func (UnimplementedSubscriptionInternalServiceServer) InternalCreateSubscription(context.Context, *InternalCreateSubscriptionRequest) (*InternalCreateSubscriptionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalCreateSubscription not implemented")
 }
@@ -204,6 +329,24 @@ func (UnimplementedSubscriptionInternalServiceServer) InternalReNewSubscription(
 func (UnimplementedSubscriptionInternalServiceServer) InternalUpgradeSubscription(context.Context, *InternalUpgradeSubscriptionRequest) (*InternalUpgradeSubscriptionResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalUpgradeSubscription not implemented")
 }
+func (UnimplementedSubscriptionInternalServiceServer) InternalDowngradeSubscription(context.Context, *InternalDowngradeSubscriptionRequest) (*InternalDowngradeSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalDowngradeSubscription not implemented")
+}
+func (UnimplementedSubscriptionInternalServiceServer) InternalCancelSubscription(context.Context, *InternalCancelSubscriptionRequest) (*InternalCancelSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalCancelSubscription not implemented")
+}
+func (UnimplementedSubscriptionInternalServiceServer) InternalPauseSubscription(context.Context, *InternalPauseSubscriptionRequest) (*InternalPauseSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalPauseSubscription not implemented")
+}
+func (UnimplementedSubscriptionInternalServiceServer) InternalResumeSubscription(context.Context, *InternalResumeSubscriptionRequest) (*InternalResumeSubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalResumeSubscription not implemented")
+}
+func (UnimplementedSubscriptionInternalServiceServer) InternalConvertTrial(context.Context, *InternalConvertTrialRequest) (*InternalConvertTrialResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalConvertTrial not implemented")
+}
+func (UnimplementedSubscriptionInternalServiceServer) InternalExtendTrial(context.Context, *InternalExtendTrialRequest) (*InternalExtendTrialResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalExtendTrial not implemented")
+}
 func (UnimplementedSubscriptionInternalServiceServer) InternalGetSubscriptionStats(context.Context, *InternalGetSubscriptionStatsRequest) (*InternalGetSubscriptionStatsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalGetSubscriptionStats not implemented")
 }
@@ -213,6 +356,9 @@ func (UnimplementedSubscriptionInternalServiceServer) InternalGetSubscriptionSta
 func (UnimplementedSubscriptionInternalServiceServer) InternalCheckAndUseQuota(context.Context, *InternalCheckAndUseQuotaRequest) (*InternalCheckAndUseQuotaResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalCheckAndUseQuota not implemented")
 }
+func (UnimplementedSubscriptionInternalServiceServer) InternalCheckQuota(context.Context, *InternalCheckQuotaRequest) (*InternalCheckQuotaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalCheckQuota not implemented")
+}
 func (UnimplementedSubscriptionInternalServiceServer) InternalReleaseQuota(context.Context, *InternalReleaseQuotaRequest) (*InternalReleaseQuotaResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalReleaseQuota not implemented")
 }
@@ -259,6 +405,24 @@ func _SubscriptionInternalService_InternalListSubscriptions_Handler(srv interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriptionInternalService_InternalGetSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGetSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionInternalServiceServer).InternalGetSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionInternalService_InternalGetSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionInternalServiceServer).InternalGetSubscription(ctx, req.(*InternalGetSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SubscriptionInternalService_InternalCreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InternalCreateSubscriptionRequest)
 	if err := dec(in); err != nil {
@@ -313,6 +477,114 @@ func _SubscriptionInternalService_InternalUpgradeSubscription_Handler(srv interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriptionInternalService_InternalDowngradeSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalDowngradeSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionInternalServiceServer).InternalDowngradeSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionInternalService_InternalDowngradeSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionInternalServiceServer).InternalDowngradeSubscription(ctx, req.(*InternalDowngradeSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionInternalService_InternalCancelSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalCancelSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionInternalServiceServer).InternalCancelSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionInternalService_InternalCancelSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionInternalServiceServer).InternalCancelSubscription(ctx, req.(*InternalCancelSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionInternalService_InternalPauseSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalPauseSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionInternalServiceServer).InternalPauseSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionInternalService_InternalPauseSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionInternalServiceServer).InternalPauseSubscription(ctx, req.(*InternalPauseSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionInternalService_InternalResumeSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalResumeSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionInternalServiceServer).InternalResumeSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionInternalService_InternalResumeSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionInternalServiceServer).InternalResumeSubscription(ctx, req.(*InternalResumeSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionInternalService_InternalConvertTrial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalConvertTrialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionInternalServiceServer).InternalConvertTrial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionInternalService_InternalConvertTrial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionInternalServiceServer).InternalConvertTrial(ctx, req.(*InternalConvertTrialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionInternalService_InternalExtendTrial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalExtendTrialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionInternalServiceServer).InternalExtendTrial(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionInternalService_InternalExtendTrial_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionInternalServiceServer).InternalExtendTrial(ctx, req.(*InternalExtendTrialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SubscriptionInternalService_InternalGetSubscriptionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InternalGetSubscriptionStatsRequest)
 	if err := dec(in); err != nil {
@@ -367,6 +639,24 @@ func _SubscriptionInternalService_InternalCheckAndUseQuota_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SubscriptionInternalService_InternalCheckQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalCheckQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionInternalServiceServer).InternalCheckQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionInternalService_InternalCheckQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionInternalServiceServer).InternalCheckQuota(ctx, req.(*InternalCheckQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SubscriptionInternalService_InternalReleaseQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(InternalReleaseQuotaRequest)
 	if err := dec(in); err != nil {
@@ -414,6 +704,10 @@ var SubscriptionInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InternalListSubscriptions",
 			Handler:    _SubscriptionInternalService_InternalListSubscriptions_Handler,
 		},
+		{
+			MethodName: "InternalGetSubscription",
+			Handler:    _SubscriptionInternalService_InternalGetSubscription_Handler,
+		},
 		{
 			MethodName: "InternalCreateSubscription",
 			Handler:    _SubscriptionInternalService_InternalCreateSubscription_Handler,
@@ -426,6 +720,30 @@ var SubscriptionInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InternalUpgradeSubscription",
 			Handler:    _SubscriptionInternalService_InternalUpgradeSubscription_Handler,
 		},
+		{
+			MethodName: "InternalDowngradeSubscription",
+			Handler:    _SubscriptionInternalService_InternalDowngradeSubscription_Handler,
+		},
+		{
+			MethodName: "InternalCancelSubscription",
+			Handler:    _SubscriptionInternalService_InternalCancelSubscription_Handler,
+		},
+		{
+			MethodName: "InternalPauseSubscription",
+			Handler:    _SubscriptionInternalService_InternalPauseSubscription_Handler,
+		},
+		{
+			MethodName: "InternalResumeSubscription",
+			Handler:    _SubscriptionInternalService_InternalResumeSubscription_Handler,
+		},
+		{
+			MethodName: "InternalConvertTrial",
+			Handler:    _SubscriptionInternalService_InternalConvertTrial_Handler,
+		},
+		{
+			MethodName: "InternalExtendTrial",
+			Handler:    _SubscriptionInternalService_InternalExtendTrial_Handler,
+		},
 		{
 			MethodName: "InternalGetSubscriptionStats",
 			Handler:    _SubscriptionInternalService_InternalGetSubscriptionStats_Handler,
@@ -438,6 +756,10 @@ var SubscriptionInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InternalCheckAndUseQuota",
 			Handler:    _SubscriptionInternalService_InternalCheckAndUseQuota_Handler,
 		},
+		{
+			MethodName: "InternalCheckQuota",
+			Handler:    _SubscriptionInternalService_InternalCheckQuota_Handler,
+		},
 		{
 			MethodName: "InternalReleaseQuota",
 			Handler:    _SubscriptionInternalService_InternalReleaseQuota_Handler,
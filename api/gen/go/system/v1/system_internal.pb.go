@@ -9,7 +9,7 @@ package systemv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	_ "google.golang.org/protobuf/types/known/structpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -23,6 +23,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 节假日类型
+type InternalHolidayType int32
+
+const (
+	InternalHolidayType_INTERNAL_HOLIDAY_TYPE_UNSPECIFIED InternalHolidayType = 0
+	InternalHolidayType_INTERNAL_HOLIDAY_TYPE_HOLIDAY     InternalHolidayType = 1 // 法定节假日（休息）
+	InternalHolidayType_INTERNAL_HOLIDAY_TYPE_WORKDAY     InternalHolidayType = 2 // 调休上班日
+)
+
+// Enum value maps for InternalHolidayType.
+var (
+	InternalHolidayType_name = map[int32]string{
+		0: "INTERNAL_HOLIDAY_TYPE_UNSPECIFIED",
+		1: "INTERNAL_HOLIDAY_TYPE_HOLIDAY",
+		2: "INTERNAL_HOLIDAY_TYPE_WORKDAY",
+	}
+	InternalHolidayType_value = map[string]int32{
+		"INTERNAL_HOLIDAY_TYPE_UNSPECIFIED": 0,
+		"INTERNAL_HOLIDAY_TYPE_HOLIDAY":     1,
+		"INTERNAL_HOLIDAY_TYPE_WORKDAY":     2,
+	}
+)
+
+func (x InternalHolidayType) Enum() *InternalHolidayType {
+	p := new(InternalHolidayType)
+	*p = x
+	return p
+}
+
+func (x InternalHolidayType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InternalHolidayType) Descriptor() protoreflect.EnumDescriptor {
+	return file_system_v1_system_internal_proto_enumTypes[0].Descriptor()
+}
+
+func (InternalHolidayType) Type() protoreflect.EnumType {
+	return &file_system_v1_system_internal_proto_enumTypes[0]
+}
+
+func (x InternalHolidayType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InternalHolidayType.Descriptor instead.
+func (InternalHolidayType) EnumDescriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{0}
+}
+
 // 区域枚举
 type InternalRegion int32
 
@@ -72,11 +122,11 @@ func (x InternalRegion) String() string {
 }
 
 func (InternalRegion) Descriptor() protoreflect.EnumDescriptor {
-	return file_system_v1_system_internal_proto_enumTypes[0].Descriptor()
+	return file_system_v1_system_internal_proto_enumTypes[1].Descriptor()
 }
 
 func (InternalRegion) Type() protoreflect.EnumType {
-	return &file_system_v1_system_internal_proto_enumTypes[0]
+	return &file_system_v1_system_internal_proto_enumTypes[1]
 }
 
 func (x InternalRegion) Number() protoreflect.EnumNumber {
@@ -85,7 +135,7 @@ func (x InternalRegion) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InternalRegion.Descriptor instead.
 func (InternalRegion) EnumDescriptor() ([]byte, []int) {
-	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{0}
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{1}
 }
 
 type InternalGetCountryInfoRequest struct {
@@ -192,6 +242,95 @@ func (x *InternalGetCountryInfoResponse) GetCountry() *InternalCountry {
 	return nil
 }
 
+type InternalListCountriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 语言（如 zh-CN、en-US），用于返回本地化名称，为空时使用默认语言
+	Locale        *string `protobuf:"bytes,1,opt,name=locale,proto3,oneof" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListCountriesRequest) Reset() {
+	*x = InternalListCountriesRequest{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListCountriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListCountriesRequest) ProtoMessage() {}
+
+func (x *InternalListCountriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListCountriesRequest.ProtoReflect.Descriptor instead.
+func (*InternalListCountriesRequest) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InternalListCountriesRequest) GetLocale() string {
+	if x != nil && x.Locale != nil {
+		return *x.Locale
+	}
+	return ""
+}
+
+type InternalListCountriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Countries     []*InternalCountry     `protobuf:"bytes,1,rep,name=countries,proto3" json:"countries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListCountriesResponse) Reset() {
+	*x = InternalListCountriesResponse{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListCountriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListCountriesResponse) ProtoMessage() {}
+
+func (x *InternalListCountriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListCountriesResponse.ProtoReflect.Descriptor instead.
+func (*InternalListCountriesResponse) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InternalListCountriesResponse) GetCountries() []*InternalCountry {
+	if x != nil {
+		return x.Countries
+	}
+	return nil
+}
+
 // 国家
 type InternalCountry struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -228,7 +367,7 @@ type InternalCountry struct {
 
 func (x *InternalCountry) Reset() {
 	*x = InternalCountry{}
-	mi := &file_system_v1_system_internal_proto_msgTypes[2]
+	mi := &file_system_v1_system_internal_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -240,7 +379,7 @@ func (x *InternalCountry) String() string {
 func (*InternalCountry) ProtoMessage() {}
 
 func (x *InternalCountry) ProtoReflect() protoreflect.Message {
-	mi := &file_system_v1_system_internal_proto_msgTypes[2]
+	mi := &file_system_v1_system_internal_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -253,7 +392,7 @@ func (x *InternalCountry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InternalCountry.ProtoReflect.Descriptor instead.
 func (*InternalCountry) Descriptor() ([]byte, []int) {
-	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{2}
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *InternalCountry) GetId() uint32 {
@@ -354,45 +493,1406 @@ func (x *InternalCountry) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-var File_system_v1_system_internal_proto protoreflect.FileDescriptor
+// 货币
+type InternalCurrency struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 货币代码 (ISO 4217)
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// 名称
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 符号
+	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// 小数位数（最小货币单位，如 CNY 为 2，JPY 为 0）
+	DecimalPlaces uint32 `protobuf:"varint,4,opt,name=decimal_places,json=decimalPlaces,proto3" json:"decimal_places,omitempty"`
+	// 是否启用
+	IsActive bool `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// 排序号
+	Sort          int32 `protobuf:"varint,6,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_system_v1_system_internal_proto_rawDesc = "" +
-	"\n" +
-	"\x1fsystem/v1/system_internal.proto\x12\rapi.system.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xad\x01\n" +
-	"\x1dInternalGetCountryInfoRequest\x12\x13\n" +
-	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12&\n" +
-	"\fcountry_code\x18\x02 \x01(\tH\x01R\vcountryCode\x88\x01\x01\x12&\n" +
-	"\fcountry_name\x18\x03 \x01(\tH\x02R\vcountryName\x88\x01\x01B\x05\n" +
-	"\x03_idB\x0f\n" +
-	"\r_country_codeB\x0f\n" +
-	"\r_country_name\"k\n" +
-	"\x1eInternalGetCountryInfoResponse\x12=\n" +
-	"\acountry\x18\x01 \x01(\v2\x1e.api.system.v1.InternalCountryH\x00R\acountry\x88\x01\x01B\n" +
-	"\n" +
-	"\b_country\"\xa0\x04\n" +
-	"\x0fInternalCountry\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
-	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
-	"\x04name\x18\x03 \x01(\tR\x04name\x12\x17\n" +
-	"\x04flag\x18\x04 \x01(\tH\x00R\x04flag\x88\x01\x01\x12&\n" +
-	"\fphone_prefix\x18\x05 \x01(\tH\x01R\vphonePrefix\x88\x01\x01\x12\x1f\n" +
-	"\bcurrency\x18\x06 \x01(\tH\x02R\bcurrency\x88\x01\x01\x12.\n" +
-	"\x13default_language_id\x18\a \x01(\rR\x11defaultLanguageId\x12\x1d\n" +
-	"\n" +
-	"is_default\x18\b \x01(\bR\tisDefault\x12\x12\n" +
-	"\x04sort\x18\t \x01(\x05R\x04sort\x125\n" +
-	"\x06region\x18\n" +
-	" \x01(\x0e2\x1d.api.system.v1.InternalRegionR\x06region\x12\x1b\n" +
-	"\tis_active\x18\v \x01(\bR\bisActive\x12\x1f\n" +
-	"\vtenant_used\x18\f \x01(\rR\n" +
-	"tenantUsed\x129\n" +
-	"\n" +
-	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
-	"\n" +
-	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\a\n" +
-	"\x05_flagB\x0f\n" +
-	"\r_phone_prefixB\v\n" +
-	"\t_currency*\xd5\x01\n" +
+func (x *InternalCurrency) Reset() {
+	*x = InternalCurrency{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalCurrency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalCurrency) ProtoMessage() {}
+
+func (x *InternalCurrency) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalCurrency.ProtoReflect.Descriptor instead.
+func (*InternalCurrency) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InternalCurrency) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InternalCurrency) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InternalCurrency) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *InternalCurrency) GetDecimalPlaces() uint32 {
+	if x != nil {
+		return x.DecimalPlaces
+	}
+	return 0
+}
+
+func (x *InternalCurrency) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *InternalCurrency) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type InternalListCurrenciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListCurrenciesRequest) Reset() {
+	*x = InternalListCurrenciesRequest{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListCurrenciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListCurrenciesRequest) ProtoMessage() {}
+
+func (x *InternalListCurrenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListCurrenciesRequest.ProtoReflect.Descriptor instead.
+func (*InternalListCurrenciesRequest) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{6}
+}
+
+type InternalListCurrenciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currencies    []*InternalCurrency    `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListCurrenciesResponse) Reset() {
+	*x = InternalListCurrenciesResponse{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListCurrenciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListCurrenciesResponse) ProtoMessage() {}
+
+func (x *InternalListCurrenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListCurrenciesResponse.ProtoReflect.Descriptor instead.
+func (*InternalListCurrenciesResponse) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InternalListCurrenciesResponse) GetCurrencies() []*InternalCurrency {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+type InternalGetExchangeRateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 源货币代码
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// 目标货币代码
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// 查询时间点，为空时返回最新汇率
+	At            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=at,proto3,oneof" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetExchangeRateRequest) Reset() {
+	*x = InternalGetExchangeRateRequest{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetExchangeRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetExchangeRateRequest) ProtoMessage() {}
+
+func (x *InternalGetExchangeRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetExchangeRateRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetExchangeRateRequest) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InternalGetExchangeRateRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *InternalGetExchangeRateRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *InternalGetExchangeRateRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type InternalGetExchangeRateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 汇率（十进制字符串，1 单位源货币可兑换的目标货币数量）
+	Rate string `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	// 汇率生效时间
+	EffectiveAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=effective_at,json=effectiveAt,proto3" json:"effective_at,omitempty"`
+	// 汇率来源
+	Source        string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetExchangeRateResponse) Reset() {
+	*x = InternalGetExchangeRateResponse{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetExchangeRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetExchangeRateResponse) ProtoMessage() {}
+
+func (x *InternalGetExchangeRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetExchangeRateResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetExchangeRateResponse) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InternalGetExchangeRateResponse) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+func (x *InternalGetExchangeRateResponse) GetEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveAt
+	}
+	return nil
+}
+
+func (x *InternalGetExchangeRateResponse) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+// 时区
+type InternalTimezone struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// IANA 时区ID，如 Asia/Shanghai
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 显示名称
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 当前 UTC 偏移，如 +08:00
+	UtcOffset string `protobuf:"bytes,3,opt,name=utc_offset,json=utcOffset,proto3" json:"utc_offset,omitempty"`
+	// 当前 UTC 偏移秒数
+	OffsetSeconds int32 `protobuf:"varint,4,opt,name=offset_seconds,json=offsetSeconds,proto3" json:"offset_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalTimezone) Reset() {
+	*x = InternalTimezone{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalTimezone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalTimezone) ProtoMessage() {}
+
+func (x *InternalTimezone) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalTimezone.ProtoReflect.Descriptor instead.
+func (*InternalTimezone) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *InternalTimezone) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InternalTimezone) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InternalTimezone) GetUtcOffset() string {
+	if x != nil {
+		return x.UtcOffset
+	}
+	return ""
+}
+
+func (x *InternalTimezone) GetOffsetSeconds() int32 {
+	if x != nil {
+		return x.OffsetSeconds
+	}
+	return 0
+}
+
+type InternalListTimezonesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListTimezonesRequest) Reset() {
+	*x = InternalListTimezonesRequest{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListTimezonesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListTimezonesRequest) ProtoMessage() {}
+
+func (x *InternalListTimezonesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListTimezonesRequest.ProtoReflect.Descriptor instead.
+func (*InternalListTimezonesRequest) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{11}
+}
+
+type InternalListTimezonesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timezones     []*InternalTimezone    `protobuf:"bytes,1,rep,name=timezones,proto3" json:"timezones,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListTimezonesResponse) Reset() {
+	*x = InternalListTimezonesResponse{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListTimezonesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListTimezonesResponse) ProtoMessage() {}
+
+func (x *InternalListTimezonesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListTimezonesResponse.ProtoReflect.Descriptor instead.
+func (*InternalListTimezonesResponse) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InternalListTimezonesResponse) GetTimezones() []*InternalTimezone {
+	if x != nil {
+		return x.Timezones
+	}
+	return nil
+}
+
+// 语言
+type InternalLocale struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ID
+	Id uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 语言代码 (BCP 47)，如 zh-CN
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// 本地名称，如 简体中文
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// 英文名称，如 Chinese (Simplified)
+	EnglishName string `protobuf:"bytes,4,opt,name=english_name,json=englishName,proto3" json:"english_name,omitempty"`
+	// 是否从右到左书写
+	Rtl bool `protobuf:"varint,5,opt,name=rtl,proto3" json:"rtl,omitempty"`
+	// 是否默认语言
+	IsDefault bool `protobuf:"varint,6,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	// 是否启用
+	IsActive bool `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// 排序号
+	Sort          int32 `protobuf:"varint,8,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalLocale) Reset() {
+	*x = InternalLocale{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalLocale) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalLocale) ProtoMessage() {}
+
+func (x *InternalLocale) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalLocale.ProtoReflect.Descriptor instead.
+func (*InternalLocale) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *InternalLocale) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *InternalLocale) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InternalLocale) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InternalLocale) GetEnglishName() string {
+	if x != nil {
+		return x.EnglishName
+	}
+	return ""
+}
+
+func (x *InternalLocale) GetRtl() bool {
+	if x != nil {
+		return x.Rtl
+	}
+	return false
+}
+
+func (x *InternalLocale) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *InternalLocale) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *InternalLocale) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+type InternalListLocalesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListLocalesRequest) Reset() {
+	*x = InternalListLocalesRequest{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListLocalesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListLocalesRequest) ProtoMessage() {}
+
+func (x *InternalListLocalesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListLocalesRequest.ProtoReflect.Descriptor instead.
+func (*InternalListLocalesRequest) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{14}
+}
+
+type InternalListLocalesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Locales       []*InternalLocale      `protobuf:"bytes,1,rep,name=locales,proto3" json:"locales,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListLocalesResponse) Reset() {
+	*x = InternalListLocalesResponse{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListLocalesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListLocalesResponse) ProtoMessage() {}
+
+func (x *InternalListLocalesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListLocalesResponse.ProtoReflect.Descriptor instead.
+func (*InternalListLocalesResponse) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *InternalListLocalesResponse) GetLocales() []*InternalLocale {
+	if x != nil {
+		return x.Locales
+	}
+	return nil
+}
+
+// 字典项
+type InternalDictItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 值
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// 显示名称
+	Label string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// 多语言名称，如 {"en-US": "Out of stock"}
+	I18N map[string]string `protobuf:"bytes,3,rep,name=i18n,proto3" json:"i18n,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 排序号
+	Sort int32 `protobuf:"varint,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	// 是否启用
+	IsActive bool `protobuf:"varint,5,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// 扩展属性
+	Extra         *structpb.Struct `protobuf:"bytes,6,opt,name=extra,proto3,oneof" json:"extra,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalDictItem) Reset() {
+	*x = InternalDictItem{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalDictItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalDictItem) ProtoMessage() {}
+
+func (x *InternalDictItem) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalDictItem.ProtoReflect.Descriptor instead.
+func (*InternalDictItem) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *InternalDictItem) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *InternalDictItem) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *InternalDictItem) GetI18N() map[string]string {
+	if x != nil {
+		return x.I18N
+	}
+	return nil
+}
+
+func (x *InternalDictItem) GetSort() int32 {
+	if x != nil {
+		return x.Sort
+	}
+	return 0
+}
+
+func (x *InternalDictItem) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *InternalDictItem) GetExtra() *structpb.Struct {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+// 字典
+type InternalDict struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 字典编码，如 order_cancel_reason
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// 名称
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 字典项
+	Items []*InternalDictItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	// 版本号，字典或字典项变更时变化
+	Version string `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	// 更新时间
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalDict) Reset() {
+	*x = InternalDict{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalDict) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalDict) ProtoMessage() {}
+
+func (x *InternalDict) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalDict.ProtoReflect.Descriptor instead.
+func (*InternalDict) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *InternalDict) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InternalDict) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InternalDict) GetItems() []*InternalDictItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *InternalDict) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *InternalDict) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type InternalGetDictRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DictCode string                 `protobuf:"bytes,1,opt,name=dict_code,json=dictCode,proto3" json:"dict_code,omitempty"`
+	// 客户端已有的版本号，与服务端一致时仅返回 not_modified
+	IfNoneMatch   *string `protobuf:"bytes,2,opt,name=if_none_match,json=ifNoneMatch,proto3,oneof" json:"if_none_match,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetDictRequest) Reset() {
+	*x = InternalGetDictRequest{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetDictRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetDictRequest) ProtoMessage() {}
+
+func (x *InternalGetDictRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetDictRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetDictRequest) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *InternalGetDictRequest) GetDictCode() string {
+	if x != nil {
+		return x.DictCode
+	}
+	return ""
+}
+
+func (x *InternalGetDictRequest) GetIfNoneMatch() string {
+	if x != nil && x.IfNoneMatch != nil {
+		return *x.IfNoneMatch
+	}
+	return ""
+}
+
+type InternalGetDictResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Dict  *InternalDict          `protobuf:"bytes,1,opt,name=dict,proto3,oneof" json:"dict,omitempty"`
+	// 版本未变化
+	NotModified   bool `protobuf:"varint,2,opt,name=not_modified,json=notModified,proto3" json:"not_modified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetDictResponse) Reset() {
+	*x = InternalGetDictResponse{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetDictResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetDictResponse) ProtoMessage() {}
+
+func (x *InternalGetDictResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetDictResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetDictResponse) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *InternalGetDictResponse) GetDict() *InternalDict {
+	if x != nil {
+		return x.Dict
+	}
+	return nil
+}
+
+func (x *InternalGetDictResponse) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+// 节假日
+type InternalHoliday struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 日期，当地日期，格式 2006-01-02
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// 名称，如 国庆节
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// 类型
+	Type          InternalHolidayType `protobuf:"varint,3,opt,name=type,proto3,enum=api.system.v1.InternalHolidayType" json:"type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalHoliday) Reset() {
+	*x = InternalHoliday{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalHoliday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalHoliday) ProtoMessage() {}
+
+func (x *InternalHoliday) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalHoliday.ProtoReflect.Descriptor instead.
+func (*InternalHoliday) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *InternalHoliday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *InternalHoliday) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InternalHoliday) GetType() InternalHolidayType {
+	if x != nil {
+		return x.Type
+	}
+	return InternalHolidayType_INTERNAL_HOLIDAY_TYPE_UNSPECIFIED
+}
+
+type InternalListHolidaysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 国家代码 (ISO 3166-1 alpha-2)
+	CountryCode string `protobuf:"bytes,1,opt,name=country_code,json=countryCode,proto3" json:"country_code,omitempty"`
+	// 年份
+	Year          int32 `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListHolidaysRequest) Reset() {
+	*x = InternalListHolidaysRequest{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListHolidaysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListHolidaysRequest) ProtoMessage() {}
+
+func (x *InternalListHolidaysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListHolidaysRequest.ProtoReflect.Descriptor instead.
+func (*InternalListHolidaysRequest) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *InternalListHolidaysRequest) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *InternalListHolidaysRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+type InternalListHolidaysResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 按日期升序
+	Holidays      []*InternalHoliday `protobuf:"bytes,1,rep,name=holidays,proto3" json:"holidays,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalListHolidaysResponse) Reset() {
+	*x = InternalListHolidaysResponse{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalListHolidaysResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalListHolidaysResponse) ProtoMessage() {}
+
+func (x *InternalListHolidaysResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalListHolidaysResponse.ProtoReflect.Descriptor instead.
+func (*InternalListHolidaysResponse) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *InternalListHolidaysResponse) GetHolidays() []*InternalHoliday {
+	if x != nil {
+		return x.Holidays
+	}
+	return nil
+}
+
+// 部署区域信息
+type InternalRegionInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 区域名称，与认证信息中的 RegionName 一致，如 cn-east
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// 显示名称
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// 数据驻留区，如 CN、EU
+	DataResidencyZone string `protobuf:"bytes,3,opt,name=data_residency_zone,json=dataResidencyZone,proto3" json:"data_residency_zone,omitempty"`
+	// 默认货币代码 (ISO 4217)
+	DefaultCurrency string `protobuf:"bytes,4,opt,name=default_currency,json=defaultCurrency,proto3" json:"default_currency,omitempty"`
+	// CDN 域名
+	CdnDomain string `protobuf:"bytes,5,opt,name=cdn_domain,json=cdnDomain,proto3" json:"cdn_domain,omitempty"`
+	// 默认时区 (IANA)
+	DefaultTimezone string `protobuf:"bytes,6,opt,name=default_timezone,json=defaultTimezone,proto3" json:"default_timezone,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InternalRegionInfo) Reset() {
+	*x = InternalRegionInfo{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalRegionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalRegionInfo) ProtoMessage() {}
+
+func (x *InternalRegionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalRegionInfo.ProtoReflect.Descriptor instead.
+func (*InternalRegionInfo) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *InternalRegionInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InternalRegionInfo) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *InternalRegionInfo) GetDataResidencyZone() string {
+	if x != nil {
+		return x.DataResidencyZone
+	}
+	return ""
+}
+
+func (x *InternalRegionInfo) GetDefaultCurrency() string {
+	if x != nil {
+		return x.DefaultCurrency
+	}
+	return ""
+}
+
+func (x *InternalRegionInfo) GetCdnDomain() string {
+	if x != nil {
+		return x.CdnDomain
+	}
+	return ""
+}
+
+func (x *InternalRegionInfo) GetDefaultTimezone() string {
+	if x != nil {
+		return x.DefaultTimezone
+	}
+	return ""
+}
+
+type InternalGetRegionInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RegionName    string                 `protobuf:"bytes,1,opt,name=region_name,json=regionName,proto3" json:"region_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetRegionInfoRequest) Reset() {
+	*x = InternalGetRegionInfoRequest{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetRegionInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetRegionInfoRequest) ProtoMessage() {}
+
+func (x *InternalGetRegionInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetRegionInfoRequest.ProtoReflect.Descriptor instead.
+func (*InternalGetRegionInfoRequest) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *InternalGetRegionInfoRequest) GetRegionName() string {
+	if x != nil {
+		return x.RegionName
+	}
+	return ""
+}
+
+type InternalGetRegionInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Region        *InternalRegionInfo    `protobuf:"bytes,1,opt,name=region,proto3,oneof" json:"region,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InternalGetRegionInfoResponse) Reset() {
+	*x = InternalGetRegionInfoResponse{}
+	mi := &file_system_v1_system_internal_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InternalGetRegionInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InternalGetRegionInfoResponse) ProtoMessage() {}
+
+func (x *InternalGetRegionInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_system_v1_system_internal_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InternalGetRegionInfoResponse.ProtoReflect.Descriptor instead.
+func (*InternalGetRegionInfoResponse) Descriptor() ([]byte, []int) {
+	return file_system_v1_system_internal_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InternalGetRegionInfoResponse) GetRegion() *InternalRegionInfo {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+var File_system_v1_system_internal_proto protoreflect.FileDescriptor
+
+const file_system_v1_system_internal_proto_rawDesc = "" +
+	"\n" +
+	"\x1fsystem/v1/system_internal.proto\x12\rapi.system.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xad\x01\n" +
+	"\x1dInternalGetCountryInfoRequest\x12\x13\n" +
+	"\x02id\x18\x01 \x01(\rH\x00R\x02id\x88\x01\x01\x12&\n" +
+	"\fcountry_code\x18\x02 \x01(\tH\x01R\vcountryCode\x88\x01\x01\x12&\n" +
+	"\fcountry_name\x18\x03 \x01(\tH\x02R\vcountryName\x88\x01\x01B\x05\n" +
+	"\x03_idB\x0f\n" +
+	"\r_country_codeB\x0f\n" +
+	"\r_country_name\"k\n" +
+	"\x1eInternalGetCountryInfoResponse\x12=\n" +
+	"\acountry\x18\x01 \x01(\v2\x1e.api.system.v1.InternalCountryH\x00R\acountry\x88\x01\x01B\n" +
+	"\n" +
+	"\b_country\"F\n" +
+	"\x1cInternalListCountriesRequest\x12\x1b\n" +
+	"\x06locale\x18\x01 \x01(\tH\x00R\x06locale\x88\x01\x01B\t\n" +
+	"\a_locale\"]\n" +
+	"\x1dInternalListCountriesResponse\x12<\n" +
+	"\tcountries\x18\x01 \x03(\v2\x1e.api.system.v1.InternalCountryR\tcountries\"\xa0\x04\n" +
+	"\x0fInternalCountry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x17\n" +
+	"\x04flag\x18\x04 \x01(\tH\x00R\x04flag\x88\x01\x01\x12&\n" +
+	"\fphone_prefix\x18\x05 \x01(\tH\x01R\vphonePrefix\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x06 \x01(\tH\x02R\bcurrency\x88\x01\x01\x12.\n" +
+	"\x13default_language_id\x18\a \x01(\rR\x11defaultLanguageId\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\b \x01(\bR\tisDefault\x12\x12\n" +
+	"\x04sort\x18\t \x01(\x05R\x04sort\x125\n" +
+	"\x06region\x18\n" +
+	" \x01(\x0e2\x1d.api.system.v1.InternalRegionR\x06region\x12\x1b\n" +
+	"\tis_active\x18\v \x01(\bR\bisActive\x12\x1f\n" +
+	"\vtenant_used\x18\f \x01(\rR\n" +
+	"tenantUsed\x129\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\a\n" +
+	"\x05_flagB\x0f\n" +
+	"\r_phone_prefixB\v\n" +
+	"\t_currency\"\xaa\x01\n" +
+	"\x10InternalCurrency\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12%\n" +
+	"\x0edecimal_places\x18\x04 \x01(\rR\rdecimalPlaces\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x12\x12\n" +
+	"\x04sort\x18\x06 \x01(\x05R\x04sort\"\x1f\n" +
+	"\x1dInternalListCurrenciesRequest\"a\n" +
+	"\x1eInternalListCurrenciesResponse\x12?\n" +
+	"\n" +
+	"currencies\x18\x01 \x03(\v2\x1f.api.system.v1.InternalCurrencyR\n" +
+	"currencies\"|\n" +
+	"\x1eInternalGetExchangeRateRequest\x12\x12\n" +
+	"\x04from\x18\x01 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x02 \x01(\tR\x02to\x12/\n" +
+	"\x02at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampH\x00R\x02at\x88\x01\x01B\x05\n" +
+	"\x03_at\"\x8c\x01\n" +
+	"\x1fInternalGetExchangeRateResponse\x12\x12\n" +
+	"\x04rate\x18\x01 \x01(\tR\x04rate\x12=\n" +
+	"\feffective_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\veffectiveAt\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\"|\n" +
+	"\x10InternalTimezone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"utc_offset\x18\x03 \x01(\tR\tutcOffset\x12%\n" +
+	"\x0eoffset_seconds\x18\x04 \x01(\x05R\roffsetSeconds\"\x1e\n" +
+	"\x1cInternalListTimezonesRequest\"^\n" +
+	"\x1dInternalListTimezonesResponse\x12=\n" +
+	"\ttimezones\x18\x01 \x03(\v2\x1f.api.system.v1.InternalTimezoneR\ttimezones\"\xcd\x01\n" +
+	"\x0eInternalLocale\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12!\n" +
+	"\fenglish_name\x18\x04 \x01(\tR\venglishName\x12\x10\n" +
+	"\x03rtl\x18\x05 \x01(\bR\x03rtl\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\x06 \x01(\bR\tisDefault\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\x12\x12\n" +
+	"\x04sort\x18\b \x01(\x05R\x04sort\"\x1c\n" +
+	"\x1aInternalListLocalesRequest\"V\n" +
+	"\x1bInternalListLocalesResponse\x127\n" +
+	"\alocales\x18\x01 \x03(\v2\x1d.api.system.v1.InternalLocaleR\alocales\"\xa5\x02\n" +
+	"\x10InternalDictItem\x12\x14\n" +
+	"\x05value\x18\x01 \x01(\tR\x05value\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12=\n" +
+	"\x04i18n\x18\x03 \x03(\v2).api.system.v1.InternalDictItem.I18nEntryR\x04i18n\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\x05R\x04sort\x12\x1b\n" +
+	"\tis_active\x18\x05 \x01(\bR\bisActive\x122\n" +
+	"\x05extra\x18\x06 \x01(\v2\x17.google.protobuf.StructH\x00R\x05extra\x88\x01\x01\x1a7\n" +
+	"\tI18nEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
+	"\x06_extra\"\xc2\x01\n" +
+	"\fInternalDict\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x125\n" +
+	"\x05items\x18\x03 \x03(\v2\x1f.api.system.v1.InternalDictItemR\x05items\x12\x18\n" +
+	"\aversion\x18\x04 \x01(\tR\aversion\x129\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"p\n" +
+	"\x16InternalGetDictRequest\x12\x1b\n" +
+	"\tdict_code\x18\x01 \x01(\tR\bdictCode\x12'\n" +
+	"\rif_none_match\x18\x02 \x01(\tH\x00R\vifNoneMatch\x88\x01\x01B\x10\n" +
+	"\x0e_if_none_match\"{\n" +
+	"\x17InternalGetDictResponse\x124\n" +
+	"\x04dict\x18\x01 \x01(\v2\x1b.api.system.v1.InternalDictH\x00R\x04dict\x88\x01\x01\x12!\n" +
+	"\fnot_modified\x18\x02 \x01(\bR\vnotModifiedB\a\n" +
+	"\x05_dict\"q\n" +
+	"\x0fInternalHoliday\x12\x12\n" +
+	"\x04date\x18\x01 \x01(\tR\x04date\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x126\n" +
+	"\x04type\x18\x03 \x01(\x0e2\".api.system.v1.InternalHolidayTypeR\x04type\"T\n" +
+	"\x1bInternalListHolidaysRequest\x12!\n" +
+	"\fcountry_code\x18\x01 \x01(\tR\vcountryCode\x12\x12\n" +
+	"\x04year\x18\x02 \x01(\x05R\x04year\"Z\n" +
+	"\x1cInternalListHolidaysResponse\x12:\n" +
+	"\bholidays\x18\x01 \x03(\v2\x1e.api.system.v1.InternalHolidayR\bholidays\"\xf0\x01\n" +
+	"\x12InternalRegionInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x12.\n" +
+	"\x13data_residency_zone\x18\x03 \x01(\tR\x11dataResidencyZone\x12)\n" +
+	"\x10default_currency\x18\x04 \x01(\tR\x0fdefaultCurrency\x12\x1d\n" +
+	"\n" +
+	"cdn_domain\x18\x05 \x01(\tR\tcdnDomain\x12)\n" +
+	"\x10default_timezone\x18\x06 \x01(\tR\x0fdefaultTimezone\"?\n" +
+	"\x1cInternalGetRegionInfoRequest\x12\x1f\n" +
+	"\vregion_name\x18\x01 \x01(\tR\n" +
+	"regionName\"j\n" +
+	"\x1dInternalGetRegionInfoResponse\x12>\n" +
+	"\x06region\x18\x01 \x01(\v2!.api.system.v1.InternalRegionInfoH\x00R\x06region\x88\x01\x01B\t\n" +
+	"\a_region*\x82\x01\n" +
+	"\x13InternalHolidayType\x12%\n" +
+	"!INTERNAL_HOLIDAY_TYPE_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dINTERNAL_HOLIDAY_TYPE_HOLIDAY\x10\x01\x12!\n" +
+	"\x1dINTERNAL_HOLIDAY_TYPE_WORKDAY\x10\x02*\xd5\x01\n" +
 	"\x0eInternalRegion\x12\x1f\n" +
 	"\x1bINTERNAL_REGION_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rINTERNAL_ASIA\x10\x01\x12\x13\n" +
@@ -401,9 +1901,17 @@ const file_system_v1_system_internal_proto_rawDesc = "" +
 	"\x16INTERNAL_SOUTH_AMERICA\x10\x04\x12\x14\n" +
 	"\x10INTERNAL_OCEANIA\x10\x05\x12\x13\n" +
 	"\x0fINTERNAL_AFRICA\x10\x06\x12\x17\n" +
-	"\x13INTERNAL_Antarctica\x10\a2\x8e\x01\n" +
+	"\x13INTERNAL_Antarctica\x10\a2\x9c\b\n" +
 	"\x15SystemInternalService\x12u\n" +
-	"\x16InternalGetCountryInfo\x12,.api.system.v1.InternalGetCountryInfoRequest\x1a-.api.system.v1.InternalGetCountryInfoResponseB\xb8\x01\n" +
+	"\x16InternalGetCountryInfo\x12,.api.system.v1.InternalGetCountryInfoRequest\x1a-.api.system.v1.InternalGetCountryInfoResponse\x12r\n" +
+	"\x15InternalListCountries\x12+.api.system.v1.InternalListCountriesRequest\x1a,.api.system.v1.InternalListCountriesResponse\x12u\n" +
+	"\x16InternalListCurrencies\x12,.api.system.v1.InternalListCurrenciesRequest\x1a-.api.system.v1.InternalListCurrenciesResponse\x12x\n" +
+	"\x17InternalGetExchangeRate\x12-.api.system.v1.InternalGetExchangeRateRequest\x1a..api.system.v1.InternalGetExchangeRateResponse\x12r\n" +
+	"\x15InternalListTimezones\x12+.api.system.v1.InternalListTimezonesRequest\x1a,.api.system.v1.InternalListTimezonesResponse\x12l\n" +
+	"\x13InternalListLocales\x12).api.system.v1.InternalListLocalesRequest\x1a*.api.system.v1.InternalListLocalesResponse\x12`\n" +
+	"\x0fInternalGetDict\x12%.api.system.v1.InternalGetDictRequest\x1a&.api.system.v1.InternalGetDictResponse\x12o\n" +
+	"\x14InternalListHolidays\x12*.api.system.v1.InternalListHolidaysRequest\x1a+.api.system.v1.InternalListHolidaysResponse\x12r\n" +
+	"\x15InternalGetRegionInfo\x12+.api.system.v1.InternalGetRegionInfoRequest\x1a,.api.system.v1.InternalGetRegionInfoResponseB\xb8\x01\n" +
 	"\x11com.api.system.v1B\x13SystemInternalProtoP\x01Z8github.com/heyinLab/common/api/gen/go/system/v1;systemv1\xa2\x02\x03ASX\xaa\x02\rApi.System.V1\xca\x02\rApi\\System\\V1\xe2\x02\x19Api\\System\\V1\\GPBMetadata\xea\x02\x0fApi::System::V1b\x06proto3"
 
 var (
@@ -418,27 +1926,83 @@ func file_system_v1_system_internal_proto_rawDescGZIP() []byte {
 	return file_system_v1_system_internal_proto_rawDescData
 }
 
-var file_system_v1_system_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_system_v1_system_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_system_v1_system_internal_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_system_v1_system_internal_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_system_v1_system_internal_proto_goTypes = []any{
-	(InternalRegion)(0),                    // 0: api.system.v1.InternalRegion
-	(*InternalGetCountryInfoRequest)(nil),  // 1: api.system.v1.InternalGetCountryInfoRequest
-	(*InternalGetCountryInfoResponse)(nil), // 2: api.system.v1.InternalGetCountryInfoResponse
-	(*InternalCountry)(nil),                // 3: api.system.v1.InternalCountry
-	(*timestamppb.Timestamp)(nil),          // 4: google.protobuf.Timestamp
+	(InternalHolidayType)(0),                // 0: api.system.v1.InternalHolidayType
+	(InternalRegion)(0),                     // 1: api.system.v1.InternalRegion
+	(*InternalGetCountryInfoRequest)(nil),   // 2: api.system.v1.InternalGetCountryInfoRequest
+	(*InternalGetCountryInfoResponse)(nil),  // 3: api.system.v1.InternalGetCountryInfoResponse
+	(*InternalListCountriesRequest)(nil),    // 4: api.system.v1.InternalListCountriesRequest
+	(*InternalListCountriesResponse)(nil),   // 5: api.system.v1.InternalListCountriesResponse
+	(*InternalCountry)(nil),                 // 6: api.system.v1.InternalCountry
+	(*InternalCurrency)(nil),                // 7: api.system.v1.InternalCurrency
+	(*InternalListCurrenciesRequest)(nil),   // 8: api.system.v1.InternalListCurrenciesRequest
+	(*InternalListCurrenciesResponse)(nil),  // 9: api.system.v1.InternalListCurrenciesResponse
+	(*InternalGetExchangeRateRequest)(nil),  // 10: api.system.v1.InternalGetExchangeRateRequest
+	(*InternalGetExchangeRateResponse)(nil), // 11: api.system.v1.InternalGetExchangeRateResponse
+	(*InternalTimezone)(nil),                // 12: api.system.v1.InternalTimezone
+	(*InternalListTimezonesRequest)(nil),    // 13: api.system.v1.InternalListTimezonesRequest
+	(*InternalListTimezonesResponse)(nil),   // 14: api.system.v1.InternalListTimezonesResponse
+	(*InternalLocale)(nil),                  // 15: api.system.v1.InternalLocale
+	(*InternalListLocalesRequest)(nil),      // 16: api.system.v1.InternalListLocalesRequest
+	(*InternalListLocalesResponse)(nil),     // 17: api.system.v1.InternalListLocalesResponse
+	(*InternalDictItem)(nil),                // 18: api.system.v1.InternalDictItem
+	(*InternalDict)(nil),                    // 19: api.system.v1.InternalDict
+	(*InternalGetDictRequest)(nil),          // 20: api.system.v1.InternalGetDictRequest
+	(*InternalGetDictResponse)(nil),         // 21: api.system.v1.InternalGetDictResponse
+	(*InternalHoliday)(nil),                 // 22: api.system.v1.InternalHoliday
+	(*InternalListHolidaysRequest)(nil),     // 23: api.system.v1.InternalListHolidaysRequest
+	(*InternalListHolidaysResponse)(nil),    // 24: api.system.v1.InternalListHolidaysResponse
+	(*InternalRegionInfo)(nil),              // 25: api.system.v1.InternalRegionInfo
+	(*InternalGetRegionInfoRequest)(nil),    // 26: api.system.v1.InternalGetRegionInfoRequest
+	(*InternalGetRegionInfoResponse)(nil),   // 27: api.system.v1.InternalGetRegionInfoResponse
+	nil,                                     // 28: api.system.v1.InternalDictItem.I18nEntry
+	(*timestamppb.Timestamp)(nil),           // 29: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),                 // 30: google.protobuf.Struct
 }
 var file_system_v1_system_internal_proto_depIdxs = []int32{
-	3, // 0: api.system.v1.InternalGetCountryInfoResponse.country:type_name -> api.system.v1.InternalCountry
-	0, // 1: api.system.v1.InternalCountry.region:type_name -> api.system.v1.InternalRegion
-	4, // 2: api.system.v1.InternalCountry.created_at:type_name -> google.protobuf.Timestamp
-	4, // 3: api.system.v1.InternalCountry.updated_at:type_name -> google.protobuf.Timestamp
-	1, // 4: api.system.v1.SystemInternalService.InternalGetCountryInfo:input_type -> api.system.v1.InternalGetCountryInfoRequest
-	2, // 5: api.system.v1.SystemInternalService.InternalGetCountryInfo:output_type -> api.system.v1.InternalGetCountryInfoResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6,  // 0: api.system.v1.InternalGetCountryInfoResponse.country:type_name -> api.system.v1.InternalCountry
+	6,  // 1: api.system.v1.InternalListCountriesResponse.countries:type_name -> api.system.v1.InternalCountry
+	1,  // 2: api.system.v1.InternalCountry.region:type_name -> api.system.v1.InternalRegion
+	29, // 3: api.system.v1.InternalCountry.created_at:type_name -> google.protobuf.Timestamp
+	29, // 4: api.system.v1.InternalCountry.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: api.system.v1.InternalListCurrenciesResponse.currencies:type_name -> api.system.v1.InternalCurrency
+	29, // 6: api.system.v1.InternalGetExchangeRateRequest.at:type_name -> google.protobuf.Timestamp
+	29, // 7: api.system.v1.InternalGetExchangeRateResponse.effective_at:type_name -> google.protobuf.Timestamp
+	12, // 8: api.system.v1.InternalListTimezonesResponse.timezones:type_name -> api.system.v1.InternalTimezone
+	15, // 9: api.system.v1.InternalListLocalesResponse.locales:type_name -> api.system.v1.InternalLocale
+	28, // 10: api.system.v1.InternalDictItem.i18n:type_name -> api.system.v1.InternalDictItem.I18nEntry
+	30, // 11: api.system.v1.InternalDictItem.extra:type_name -> google.protobuf.Struct
+	18, // 12: api.system.v1.InternalDict.items:type_name -> api.system.v1.InternalDictItem
+	29, // 13: api.system.v1.InternalDict.updated_at:type_name -> google.protobuf.Timestamp
+	19, // 14: api.system.v1.InternalGetDictResponse.dict:type_name -> api.system.v1.InternalDict
+	0,  // 15: api.system.v1.InternalHoliday.type:type_name -> api.system.v1.InternalHolidayType
+	22, // 16: api.system.v1.InternalListHolidaysResponse.holidays:type_name -> api.system.v1.InternalHoliday
+	25, // 17: api.system.v1.InternalGetRegionInfoResponse.region:type_name -> api.system.v1.InternalRegionInfo
+	2,  // 18: api.system.v1.SystemInternalService.InternalGetCountryInfo:input_type -> api.system.v1.InternalGetCountryInfoRequest
+	4,  // 19: api.system.v1.SystemInternalService.InternalListCountries:input_type -> api.system.v1.InternalListCountriesRequest
+	8,  // 20: api.system.v1.SystemInternalService.InternalListCurrencies:input_type -> api.system.v1.InternalListCurrenciesRequest
+	10, // 21: api.system.v1.SystemInternalService.InternalGetExchangeRate:input_type -> api.system.v1.InternalGetExchangeRateRequest
+	13, // 22: api.system.v1.SystemInternalService.InternalListTimezones:input_type -> api.system.v1.InternalListTimezonesRequest
+	16, // 23: api.system.v1.SystemInternalService.InternalListLocales:input_type -> api.system.v1.InternalListLocalesRequest
+	20, // 24: api.system.v1.SystemInternalService.InternalGetDict:input_type -> api.system.v1.InternalGetDictRequest
+	23, // 25: api.system.v1.SystemInternalService.InternalListHolidays:input_type -> api.system.v1.InternalListHolidaysRequest
+	26, // 26: api.system.v1.SystemInternalService.InternalGetRegionInfo:input_type -> api.system.v1.InternalGetRegionInfoRequest
+	3,  // 27: api.system.v1.SystemInternalService.InternalGetCountryInfo:output_type -> api.system.v1.InternalGetCountryInfoResponse
+	5,  // 28: api.system.v1.SystemInternalService.InternalListCountries:output_type -> api.system.v1.InternalListCountriesResponse
+	9,  // 29: api.system.v1.SystemInternalService.InternalListCurrencies:output_type -> api.system.v1.InternalListCurrenciesResponse
+	11, // 30: api.system.v1.SystemInternalService.InternalGetExchangeRate:output_type -> api.system.v1.InternalGetExchangeRateResponse
+	14, // 31: api.system.v1.SystemInternalService.InternalListTimezones:output_type -> api.system.v1.InternalListTimezonesResponse
+	17, // 32: api.system.v1.SystemInternalService.InternalListLocales:output_type -> api.system.v1.InternalListLocalesResponse
+	21, // 33: api.system.v1.SystemInternalService.InternalGetDict:output_type -> api.system.v1.InternalGetDictResponse
+	24, // 34: api.system.v1.SystemInternalService.InternalListHolidays:output_type -> api.system.v1.InternalListHolidaysResponse
+	27, // 35: api.system.v1.SystemInternalService.InternalGetRegionInfo:output_type -> api.system.v1.InternalGetRegionInfoResponse
+	27, // [27:36] is the sub-list for method output_type
+	18, // [18:27] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_system_v1_system_internal_proto_init() }
@@ -449,13 +2013,19 @@ func file_system_v1_system_internal_proto_init() {
 	file_system_v1_system_internal_proto_msgTypes[0].OneofWrappers = []any{}
 	file_system_v1_system_internal_proto_msgTypes[1].OneofWrappers = []any{}
 	file_system_v1_system_internal_proto_msgTypes[2].OneofWrappers = []any{}
+	file_system_v1_system_internal_proto_msgTypes[4].OneofWrappers = []any{}
+	file_system_v1_system_internal_proto_msgTypes[8].OneofWrappers = []any{}
+	file_system_v1_system_internal_proto_msgTypes[16].OneofWrappers = []any{}
+	file_system_v1_system_internal_proto_msgTypes[18].OneofWrappers = []any{}
+	file_system_v1_system_internal_proto_msgTypes[19].OneofWrappers = []any{}
+	file_system_v1_system_internal_proto_msgTypes[25].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_system_v1_system_internal_proto_rawDesc), len(file_system_v1_system_internal_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -286,6 +286,250 @@ var _ interface {
 	ErrorName() string
 } = InternalGetCountryInfoResponseValidationError{}
 
+// Validate checks the field values on InternalListCountriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListCountriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListCountriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListCountriesRequestMultiError, or nil if none found.
+func (m *InternalListCountriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListCountriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Locale != nil {
+		// no validation rules for Locale
+	}
+
+	if len(errors) > 0 {
+		return InternalListCountriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListCountriesRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalListCountriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListCountriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListCountriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListCountriesRequestMultiError) AllErrors() []error { return m }
+
+// InternalListCountriesRequestValidationError is the validation error returned
+// by InternalListCountriesRequest.Validate if the designated constraints
+// aren't met.
+type InternalListCountriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListCountriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListCountriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListCountriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListCountriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListCountriesRequestValidationError) ErrorName() string {
+	return "InternalListCountriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListCountriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListCountriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListCountriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListCountriesRequestValidationError{}
+
+// Validate checks the field values on InternalListCountriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListCountriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListCountriesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalListCountriesResponseMultiError, or nil if none found.
+func (m *InternalListCountriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListCountriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCountries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalListCountriesResponseValidationError{
+						field:  fmt.Sprintf("Countries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalListCountriesResponseValidationError{
+						field:  fmt.Sprintf("Countries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalListCountriesResponseValidationError{
+					field:  fmt.Sprintf("Countries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalListCountriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListCountriesResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalListCountriesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalListCountriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListCountriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListCountriesResponseMultiError) AllErrors() []error { return m }
+
+// InternalListCountriesResponseValidationError is the validation error
+// returned by InternalListCountriesResponse.Validate if the designated
+// constraints aren't met.
+type InternalListCountriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListCountriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListCountriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListCountriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListCountriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListCountriesResponseValidationError) ErrorName() string {
+	return "InternalListCountriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListCountriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListCountriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListCountriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListCountriesResponseValidationError{}
+
 // Validate checks the field values on InternalCountry with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -473,3 +717,2595 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = InternalCountryValidationError{}
+
+// Validate checks the field values on InternalCurrency with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InternalCurrency) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalCurrency with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalCurrencyMultiError, or nil if none found.
+func (m *InternalCurrency) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalCurrency) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Name
+
+	// no validation rules for Symbol
+
+	// no validation rules for DecimalPlaces
+
+	// no validation rules for IsActive
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return InternalCurrencyMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalCurrencyMultiError is an error wrapping multiple validation errors
+// returned by InternalCurrency.ValidateAll() if the designated constraints
+// aren't met.
+type InternalCurrencyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalCurrencyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalCurrencyMultiError) AllErrors() []error { return m }
+
+// InternalCurrencyValidationError is the validation error returned by
+// InternalCurrency.Validate if the designated constraints aren't met.
+type InternalCurrencyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalCurrencyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalCurrencyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalCurrencyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalCurrencyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalCurrencyValidationError) ErrorName() string { return "InternalCurrencyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InternalCurrencyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalCurrency.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalCurrencyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalCurrencyValidationError{}
+
+// Validate checks the field values on InternalListCurrenciesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListCurrenciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListCurrenciesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalListCurrenciesRequestMultiError, or nil if none found.
+func (m *InternalListCurrenciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListCurrenciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return InternalListCurrenciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListCurrenciesRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalListCurrenciesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalListCurrenciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListCurrenciesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListCurrenciesRequestMultiError) AllErrors() []error { return m }
+
+// InternalListCurrenciesRequestValidationError is the validation error
+// returned by InternalListCurrenciesRequest.Validate if the designated
+// constraints aren't met.
+type InternalListCurrenciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListCurrenciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListCurrenciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListCurrenciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListCurrenciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListCurrenciesRequestValidationError) ErrorName() string {
+	return "InternalListCurrenciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListCurrenciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListCurrenciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListCurrenciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListCurrenciesRequestValidationError{}
+
+// Validate checks the field values on InternalListCurrenciesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListCurrenciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListCurrenciesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalListCurrenciesResponseMultiError, or nil if none found.
+func (m *InternalListCurrenciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListCurrenciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCurrencies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalListCurrenciesResponseValidationError{
+						field:  fmt.Sprintf("Currencies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalListCurrenciesResponseValidationError{
+						field:  fmt.Sprintf("Currencies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalListCurrenciesResponseValidationError{
+					field:  fmt.Sprintf("Currencies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalListCurrenciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListCurrenciesResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalListCurrenciesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalListCurrenciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListCurrenciesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListCurrenciesResponseMultiError) AllErrors() []error { return m }
+
+// InternalListCurrenciesResponseValidationError is the validation error
+// returned by InternalListCurrenciesResponse.Validate if the designated
+// constraints aren't met.
+type InternalListCurrenciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListCurrenciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListCurrenciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListCurrenciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListCurrenciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListCurrenciesResponseValidationError) ErrorName() string {
+	return "InternalListCurrenciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListCurrenciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListCurrenciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListCurrenciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListCurrenciesResponseValidationError{}
+
+// Validate checks the field values on InternalGetExchangeRateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetExchangeRateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetExchangeRateRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetExchangeRateRequestMultiError, or nil if none found.
+func (m *InternalGetExchangeRateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetExchangeRateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for From
+
+	// no validation rules for To
+
+	if m.At != nil {
+
+		if all {
+			switch v := interface{}(m.GetAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalGetExchangeRateRequestValidationError{
+						field:  "At",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalGetExchangeRateRequestValidationError{
+						field:  "At",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalGetExchangeRateRequestValidationError{
+					field:  "At",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalGetExchangeRateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetExchangeRateRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalGetExchangeRateRequest.ValidateAll()
+// if the designated constraints aren't met.
+type InternalGetExchangeRateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetExchangeRateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetExchangeRateRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetExchangeRateRequestValidationError is the validation error
+// returned by InternalGetExchangeRateRequest.Validate if the designated
+// constraints aren't met.
+type InternalGetExchangeRateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetExchangeRateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetExchangeRateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetExchangeRateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetExchangeRateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetExchangeRateRequestValidationError) ErrorName() string {
+	return "InternalGetExchangeRateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetExchangeRateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetExchangeRateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetExchangeRateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetExchangeRateRequestValidationError{}
+
+// Validate checks the field values on InternalGetExchangeRateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetExchangeRateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetExchangeRateResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetExchangeRateResponseMultiError, or nil if none found.
+func (m *InternalGetExchangeRateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetExchangeRateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Rate
+
+	if all {
+		switch v := interface{}(m.GetEffectiveAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalGetExchangeRateResponseValidationError{
+					field:  "EffectiveAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalGetExchangeRateResponseValidationError{
+					field:  "EffectiveAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEffectiveAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalGetExchangeRateResponseValidationError{
+				field:  "EffectiveAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Source
+
+	if len(errors) > 0 {
+		return InternalGetExchangeRateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetExchangeRateResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalGetExchangeRateResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalGetExchangeRateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetExchangeRateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetExchangeRateResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetExchangeRateResponseValidationError is the validation error
+// returned by InternalGetExchangeRateResponse.Validate if the designated
+// constraints aren't met.
+type InternalGetExchangeRateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetExchangeRateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetExchangeRateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetExchangeRateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetExchangeRateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetExchangeRateResponseValidationError) ErrorName() string {
+	return "InternalGetExchangeRateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetExchangeRateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetExchangeRateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetExchangeRateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetExchangeRateResponseValidationError{}
+
+// Validate checks the field values on InternalTimezone with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InternalTimezone) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalTimezone with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalTimezoneMultiError, or nil if none found.
+func (m *InternalTimezone) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalTimezone) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Name
+
+	// no validation rules for UtcOffset
+
+	// no validation rules for OffsetSeconds
+
+	if len(errors) > 0 {
+		return InternalTimezoneMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalTimezoneMultiError is an error wrapping multiple validation errors
+// returned by InternalTimezone.ValidateAll() if the designated constraints
+// aren't met.
+type InternalTimezoneMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalTimezoneMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalTimezoneMultiError) AllErrors() []error { return m }
+
+// InternalTimezoneValidationError is the validation error returned by
+// InternalTimezone.Validate if the designated constraints aren't met.
+type InternalTimezoneValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalTimezoneValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalTimezoneValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalTimezoneValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalTimezoneValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalTimezoneValidationError) ErrorName() string { return "InternalTimezoneValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InternalTimezoneValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalTimezone.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalTimezoneValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalTimezoneValidationError{}
+
+// Validate checks the field values on InternalListTimezonesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListTimezonesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListTimezonesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListTimezonesRequestMultiError, or nil if none found.
+func (m *InternalListTimezonesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListTimezonesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return InternalListTimezonesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListTimezonesRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalListTimezonesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListTimezonesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListTimezonesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListTimezonesRequestMultiError) AllErrors() []error { return m }
+
+// InternalListTimezonesRequestValidationError is the validation error returned
+// by InternalListTimezonesRequest.Validate if the designated constraints
+// aren't met.
+type InternalListTimezonesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListTimezonesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListTimezonesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListTimezonesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListTimezonesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListTimezonesRequestValidationError) ErrorName() string {
+	return "InternalListTimezonesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListTimezonesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListTimezonesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListTimezonesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListTimezonesRequestValidationError{}
+
+// Validate checks the field values on InternalListTimezonesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListTimezonesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListTimezonesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalListTimezonesResponseMultiError, or nil if none found.
+func (m *InternalListTimezonesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListTimezonesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTimezones() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalListTimezonesResponseValidationError{
+						field:  fmt.Sprintf("Timezones[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalListTimezonesResponseValidationError{
+						field:  fmt.Sprintf("Timezones[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalListTimezonesResponseValidationError{
+					field:  fmt.Sprintf("Timezones[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalListTimezonesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListTimezonesResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalListTimezonesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalListTimezonesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListTimezonesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListTimezonesResponseMultiError) AllErrors() []error { return m }
+
+// InternalListTimezonesResponseValidationError is the validation error
+// returned by InternalListTimezonesResponse.Validate if the designated
+// constraints aren't met.
+type InternalListTimezonesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListTimezonesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListTimezonesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListTimezonesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListTimezonesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListTimezonesResponseValidationError) ErrorName() string {
+	return "InternalListTimezonesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListTimezonesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListTimezonesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListTimezonesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListTimezonesResponseValidationError{}
+
+// Validate checks the field values on InternalLocale with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InternalLocale) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalLocale with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InternalLocaleMultiError,
+// or nil if none found.
+func (m *InternalLocale) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalLocale) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Code
+
+	// no validation rules for Name
+
+	// no validation rules for EnglishName
+
+	// no validation rules for Rtl
+
+	// no validation rules for IsDefault
+
+	// no validation rules for IsActive
+
+	// no validation rules for Sort
+
+	if len(errors) > 0 {
+		return InternalLocaleMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalLocaleMultiError is an error wrapping multiple validation errors
+// returned by InternalLocale.ValidateAll() if the designated constraints
+// aren't met.
+type InternalLocaleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalLocaleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalLocaleMultiError) AllErrors() []error { return m }
+
+// InternalLocaleValidationError is the validation error returned by
+// InternalLocale.Validate if the designated constraints aren't met.
+type InternalLocaleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalLocaleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalLocaleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalLocaleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalLocaleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalLocaleValidationError) ErrorName() string { return "InternalLocaleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InternalLocaleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalLocale.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalLocaleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalLocaleValidationError{}
+
+// Validate checks the field values on InternalListLocalesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListLocalesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListLocalesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListLocalesRequestMultiError, or nil if none found.
+func (m *InternalListLocalesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListLocalesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return InternalListLocalesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListLocalesRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalListLocalesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListLocalesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListLocalesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListLocalesRequestMultiError) AllErrors() []error { return m }
+
+// InternalListLocalesRequestValidationError is the validation error returned
+// by InternalListLocalesRequest.Validate if the designated constraints aren't met.
+type InternalListLocalesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListLocalesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListLocalesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListLocalesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListLocalesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListLocalesRequestValidationError) ErrorName() string {
+	return "InternalListLocalesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListLocalesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListLocalesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListLocalesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListLocalesRequestValidationError{}
+
+// Validate checks the field values on InternalListLocalesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListLocalesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListLocalesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListLocalesResponseMultiError, or nil if none found.
+func (m *InternalListLocalesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListLocalesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLocales() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalListLocalesResponseValidationError{
+						field:  fmt.Sprintf("Locales[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalListLocalesResponseValidationError{
+						field:  fmt.Sprintf("Locales[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalListLocalesResponseValidationError{
+					field:  fmt.Sprintf("Locales[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalListLocalesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListLocalesResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalListLocalesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListLocalesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListLocalesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListLocalesResponseMultiError) AllErrors() []error { return m }
+
+// InternalListLocalesResponseValidationError is the validation error returned
+// by InternalListLocalesResponse.Validate if the designated constraints
+// aren't met.
+type InternalListLocalesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListLocalesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListLocalesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListLocalesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListLocalesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListLocalesResponseValidationError) ErrorName() string {
+	return "InternalListLocalesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListLocalesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListLocalesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListLocalesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListLocalesResponseValidationError{}
+
+// Validate checks the field values on InternalDictItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InternalDictItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalDictItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalDictItemMultiError, or nil if none found.
+func (m *InternalDictItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalDictItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Value
+
+	// no validation rules for Label
+
+	// no validation rules for I18N
+
+	// no validation rules for Sort
+
+	// no validation rules for IsActive
+
+	if m.Extra != nil {
+
+		if all {
+			switch v := interface{}(m.GetExtra()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalDictItemValidationError{
+						field:  "Extra",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalDictItemValidationError{
+						field:  "Extra",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExtra()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalDictItemValidationError{
+					field:  "Extra",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalDictItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalDictItemMultiError is an error wrapping multiple validation errors
+// returned by InternalDictItem.ValidateAll() if the designated constraints
+// aren't met.
+type InternalDictItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalDictItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalDictItemMultiError) AllErrors() []error { return m }
+
+// InternalDictItemValidationError is the validation error returned by
+// InternalDictItem.Validate if the designated constraints aren't met.
+type InternalDictItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalDictItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalDictItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalDictItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalDictItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalDictItemValidationError) ErrorName() string { return "InternalDictItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InternalDictItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalDictItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalDictItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalDictItemValidationError{}
+
+// Validate checks the field values on InternalDict with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InternalDict) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalDict with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InternalDictMultiError, or
+// nil if none found.
+func (m *InternalDict) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalDict) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalDictValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalDictValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalDictValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Version
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InternalDictValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InternalDictValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InternalDictValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InternalDictMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalDictMultiError is an error wrapping multiple validation errors
+// returned by InternalDict.ValidateAll() if the designated constraints aren't met.
+type InternalDictMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalDictMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalDictMultiError) AllErrors() []error { return m }
+
+// InternalDictValidationError is the validation error returned by
+// InternalDict.Validate if the designated constraints aren't met.
+type InternalDictValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalDictValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalDictValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalDictValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalDictValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalDictValidationError) ErrorName() string { return "InternalDictValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InternalDictValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalDict.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalDictValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalDictValidationError{}
+
+// Validate checks the field values on InternalGetDictRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetDictRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetDictRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalGetDictRequestMultiError, or nil if none found.
+func (m *InternalGetDictRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetDictRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DictCode
+
+	if m.IfNoneMatch != nil {
+		// no validation rules for IfNoneMatch
+	}
+
+	if len(errors) > 0 {
+		return InternalGetDictRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetDictRequestMultiError is an error wrapping multiple validation
+// errors returned by InternalGetDictRequest.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetDictRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetDictRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetDictRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetDictRequestValidationError is the validation error returned by
+// InternalGetDictRequest.Validate if the designated constraints aren't met.
+type InternalGetDictRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetDictRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetDictRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetDictRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetDictRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetDictRequestValidationError) ErrorName() string {
+	return "InternalGetDictRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetDictRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetDictRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetDictRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetDictRequestValidationError{}
+
+// Validate checks the field values on InternalGetDictResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetDictResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetDictResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalGetDictResponseMultiError, or nil if none found.
+func (m *InternalGetDictResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetDictResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotModified
+
+	if m.Dict != nil {
+
+		if all {
+			switch v := interface{}(m.GetDict()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalGetDictResponseValidationError{
+						field:  "Dict",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalGetDictResponseValidationError{
+						field:  "Dict",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDict()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalGetDictResponseValidationError{
+					field:  "Dict",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalGetDictResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetDictResponseMultiError is an error wrapping multiple validation
+// errors returned by InternalGetDictResponse.ValidateAll() if the designated
+// constraints aren't met.
+type InternalGetDictResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetDictResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetDictResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetDictResponseValidationError is the validation error returned by
+// InternalGetDictResponse.Validate if the designated constraints aren't met.
+type InternalGetDictResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetDictResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetDictResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetDictResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetDictResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetDictResponseValidationError) ErrorName() string {
+	return "InternalGetDictResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetDictResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetDictResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetDictResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetDictResponseValidationError{}
+
+// Validate checks the field values on InternalHoliday with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InternalHoliday) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalHoliday with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalHolidayMultiError, or nil if none found.
+func (m *InternalHoliday) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalHoliday) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	// no validation rules for Name
+
+	// no validation rules for Type
+
+	if len(errors) > 0 {
+		return InternalHolidayMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalHolidayMultiError is an error wrapping multiple validation errors
+// returned by InternalHoliday.ValidateAll() if the designated constraints
+// aren't met.
+type InternalHolidayMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalHolidayMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalHolidayMultiError) AllErrors() []error { return m }
+
+// InternalHolidayValidationError is the validation error returned by
+// InternalHoliday.Validate if the designated constraints aren't met.
+type InternalHolidayValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalHolidayValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalHolidayValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalHolidayValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalHolidayValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalHolidayValidationError) ErrorName() string { return "InternalHolidayValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InternalHolidayValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalHoliday.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalHolidayValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalHolidayValidationError{}
+
+// Validate checks the field values on InternalListHolidaysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListHolidaysRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListHolidaysRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListHolidaysRequestMultiError, or nil if none found.
+func (m *InternalListHolidaysRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListHolidaysRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CountryCode
+
+	// no validation rules for Year
+
+	if len(errors) > 0 {
+		return InternalListHolidaysRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListHolidaysRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalListHolidaysRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListHolidaysRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListHolidaysRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListHolidaysRequestMultiError) AllErrors() []error { return m }
+
+// InternalListHolidaysRequestValidationError is the validation error returned
+// by InternalListHolidaysRequest.Validate if the designated constraints
+// aren't met.
+type InternalListHolidaysRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListHolidaysRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListHolidaysRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListHolidaysRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListHolidaysRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListHolidaysRequestValidationError) ErrorName() string {
+	return "InternalListHolidaysRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListHolidaysRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListHolidaysRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListHolidaysRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListHolidaysRequestValidationError{}
+
+// Validate checks the field values on InternalListHolidaysResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalListHolidaysResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalListHolidaysResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalListHolidaysResponseMultiError, or nil if none found.
+func (m *InternalListHolidaysResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalListHolidaysResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetHolidays() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalListHolidaysResponseValidationError{
+						field:  fmt.Sprintf("Holidays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalListHolidaysResponseValidationError{
+						field:  fmt.Sprintf("Holidays[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalListHolidaysResponseValidationError{
+					field:  fmt.Sprintf("Holidays[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalListHolidaysResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalListHolidaysResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalListHolidaysResponse.ValidateAll() if
+// the designated constraints aren't met.
+type InternalListHolidaysResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalListHolidaysResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalListHolidaysResponseMultiError) AllErrors() []error { return m }
+
+// InternalListHolidaysResponseValidationError is the validation error returned
+// by InternalListHolidaysResponse.Validate if the designated constraints
+// aren't met.
+type InternalListHolidaysResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalListHolidaysResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalListHolidaysResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalListHolidaysResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalListHolidaysResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalListHolidaysResponseValidationError) ErrorName() string {
+	return "InternalListHolidaysResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalListHolidaysResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalListHolidaysResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalListHolidaysResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalListHolidaysResponseValidationError{}
+
+// Validate checks the field values on InternalRegionInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalRegionInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalRegionInfo with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalRegionInfoMultiError, or nil if none found.
+func (m *InternalRegionInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalRegionInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for DisplayName
+
+	// no validation rules for DataResidencyZone
+
+	// no validation rules for DefaultCurrency
+
+	// no validation rules for CdnDomain
+
+	// no validation rules for DefaultTimezone
+
+	if len(errors) > 0 {
+		return InternalRegionInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalRegionInfoMultiError is an error wrapping multiple validation errors
+// returned by InternalRegionInfo.ValidateAll() if the designated constraints
+// aren't met.
+type InternalRegionInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalRegionInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalRegionInfoMultiError) AllErrors() []error { return m }
+
+// InternalRegionInfoValidationError is the validation error returned by
+// InternalRegionInfo.Validate if the designated constraints aren't met.
+type InternalRegionInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalRegionInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalRegionInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalRegionInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalRegionInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalRegionInfoValidationError) ErrorName() string {
+	return "InternalRegionInfoValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalRegionInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalRegionInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalRegionInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalRegionInfoValidationError{}
+
+// Validate checks the field values on InternalGetRegionInfoRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetRegionInfoRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetRegionInfoRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InternalGetRegionInfoRequestMultiError, or nil if none found.
+func (m *InternalGetRegionInfoRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetRegionInfoRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RegionName
+
+	if len(errors) > 0 {
+		return InternalGetRegionInfoRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetRegionInfoRequestMultiError is an error wrapping multiple
+// validation errors returned by InternalGetRegionInfoRequest.ValidateAll() if
+// the designated constraints aren't met.
+type InternalGetRegionInfoRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetRegionInfoRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetRegionInfoRequestMultiError) AllErrors() []error { return m }
+
+// InternalGetRegionInfoRequestValidationError is the validation error returned
+// by InternalGetRegionInfoRequest.Validate if the designated constraints
+// aren't met.
+type InternalGetRegionInfoRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetRegionInfoRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetRegionInfoRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetRegionInfoRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetRegionInfoRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetRegionInfoRequestValidationError) ErrorName() string {
+	return "InternalGetRegionInfoRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetRegionInfoRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetRegionInfoRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetRegionInfoRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetRegionInfoRequestValidationError{}
+
+// Validate checks the field values on InternalGetRegionInfoResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InternalGetRegionInfoResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InternalGetRegionInfoResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// InternalGetRegionInfoResponseMultiError, or nil if none found.
+func (m *InternalGetRegionInfoResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InternalGetRegionInfoResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.Region != nil {
+
+		if all {
+			switch v := interface{}(m.GetRegion()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InternalGetRegionInfoResponseValidationError{
+						field:  "Region",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InternalGetRegionInfoResponseValidationError{
+						field:  "Region",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRegion()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InternalGetRegionInfoResponseValidationError{
+					field:  "Region",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InternalGetRegionInfoResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// InternalGetRegionInfoResponseMultiError is an error wrapping multiple
+// validation errors returned by InternalGetRegionInfoResponse.ValidateAll()
+// if the designated constraints aren't met.
+type InternalGetRegionInfoResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InternalGetRegionInfoResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InternalGetRegionInfoResponseMultiError) AllErrors() []error { return m }
+
+// InternalGetRegionInfoResponseValidationError is the validation error
+// returned by InternalGetRegionInfoResponse.Validate if the designated
+// constraints aren't met.
+type InternalGetRegionInfoResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InternalGetRegionInfoResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InternalGetRegionInfoResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InternalGetRegionInfoResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InternalGetRegionInfoResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InternalGetRegionInfoResponseValidationError) ErrorName() string {
+	return "InternalGetRegionInfoResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InternalGetRegionInfoResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInternalGetRegionInfoResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InternalGetRegionInfoResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InternalGetRegionInfoResponseValidationError{}
@@ -19,7 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SystemInternalService_InternalGetCountryInfo_FullMethodName = "/api.system.v1.SystemInternalService/InternalGetCountryInfo"
+	SystemInternalService_InternalGetCountryInfo_FullMethodName  = "/api.system.v1.SystemInternalService/InternalGetCountryInfo"
+	SystemInternalService_InternalListCountries_FullMethodName   = "/api.system.v1.SystemInternalService/InternalListCountries"
+	SystemInternalService_InternalListCurrencies_FullMethodName  = "/api.system.v1.SystemInternalService/InternalListCurrencies"
+	SystemInternalService_InternalGetExchangeRate_FullMethodName = "/api.system.v1.SystemInternalService/InternalGetExchangeRate"
+	SystemInternalService_InternalListTimezones_FullMethodName   = "/api.system.v1.SystemInternalService/InternalListTimezones"
+	SystemInternalService_InternalListLocales_FullMethodName     = "/api.system.v1.SystemInternalService/InternalListLocales"
+	SystemInternalService_InternalGetDict_FullMethodName         = "/api.system.v1.SystemInternalService/InternalGetDict"
+	SystemInternalService_InternalListHolidays_FullMethodName    = "/api.system.v1.SystemInternalService/InternalListHolidays"
+	SystemInternalService_InternalGetRegionInfo_FullMethodName   = "/api.system.v1.SystemInternalService/InternalGetRegionInfo"
 )
 
 // SystemInternalServiceClient is the client API for SystemInternalService service.
@@ -28,6 +36,22 @@ const (
 type SystemInternalServiceClient interface {
 	// 获取详情
 	InternalGetCountryInfo(ctx context.Context, in *InternalGetCountryInfoRequest, opts ...grpc.CallOption) (*InternalGetCountryInfoResponse, error)
+	// 获取国家列表（包含未启用的国家）
+	InternalListCountries(ctx context.Context, in *InternalListCountriesRequest, opts ...grpc.CallOption) (*InternalListCountriesResponse, error)
+	// 获取货币列表
+	InternalListCurrencies(ctx context.Context, in *InternalListCurrenciesRequest, opts ...grpc.CallOption) (*InternalListCurrenciesResponse, error)
+	// 获取汇率
+	InternalGetExchangeRate(ctx context.Context, in *InternalGetExchangeRateRequest, opts ...grpc.CallOption) (*InternalGetExchangeRateResponse, error)
+	// 获取时区列表
+	InternalListTimezones(ctx context.Context, in *InternalListTimezonesRequest, opts ...grpc.CallOption) (*InternalListTimezonesResponse, error)
+	// 获取语言列表
+	InternalListLocales(ctx context.Context, in *InternalListLocalesRequest, opts ...grpc.CallOption) (*InternalListLocalesResponse, error)
+	// 获取字典
+	InternalGetDict(ctx context.Context, in *InternalGetDictRequest, opts ...grpc.CallOption) (*InternalGetDictResponse, error)
+	// 获取节假日列表
+	InternalListHolidays(ctx context.Context, in *InternalListHolidaysRequest, opts ...grpc.CallOption) (*InternalListHolidaysResponse, error)
+	// 获取部署区域信息
+	InternalGetRegionInfo(ctx context.Context, in *InternalGetRegionInfoRequest, opts ...grpc.CallOption) (*InternalGetRegionInfoResponse, error)
 }
 
 type systemInternalServiceClient struct {
@@ -48,12 +72,108 @@ func (c *systemInternalServiceClient) InternalGetCountryInfo(ctx context.Context
 	return out, nil
 }
 
+func (c *systemInternalServiceClient) InternalListCountries(ctx context.Context, in *InternalListCountriesRequest, opts ...grpc.CallOption) (*InternalListCountriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalListCountriesResponse)
+	err := c.cc.Invoke(ctx, SystemInternalService_InternalListCountries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemInternalServiceClient) InternalListCurrencies(ctx context.Context, in *InternalListCurrenciesRequest, opts ...grpc.CallOption) (*InternalListCurrenciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalListCurrenciesResponse)
+	err := c.cc.Invoke(ctx, SystemInternalService_InternalListCurrencies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemInternalServiceClient) InternalGetExchangeRate(ctx context.Context, in *InternalGetExchangeRateRequest, opts ...grpc.CallOption) (*InternalGetExchangeRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGetExchangeRateResponse)
+	err := c.cc.Invoke(ctx, SystemInternalService_InternalGetExchangeRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemInternalServiceClient) InternalListTimezones(ctx context.Context, in *InternalListTimezonesRequest, opts ...grpc.CallOption) (*InternalListTimezonesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalListTimezonesResponse)
+	err := c.cc.Invoke(ctx, SystemInternalService_InternalListTimezones_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemInternalServiceClient) InternalListLocales(ctx context.Context, in *InternalListLocalesRequest, opts ...grpc.CallOption) (*InternalListLocalesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalListLocalesResponse)
+	err := c.cc.Invoke(ctx, SystemInternalService_InternalListLocales_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemInternalServiceClient) InternalGetDict(ctx context.Context, in *InternalGetDictRequest, opts ...grpc.CallOption) (*InternalGetDictResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGetDictResponse)
+	err := c.cc.Invoke(ctx, SystemInternalService_InternalGetDict_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemInternalServiceClient) InternalListHolidays(ctx context.Context, in *InternalListHolidaysRequest, opts ...grpc.CallOption) (*InternalListHolidaysResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalListHolidaysResponse)
+	err := c.cc.Invoke(ctx, SystemInternalService_InternalListHolidays_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *systemInternalServiceClient) InternalGetRegionInfo(ctx context.Context, in *InternalGetRegionInfoRequest, opts ...grpc.CallOption) (*InternalGetRegionInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InternalGetRegionInfoResponse)
+	err := c.cc.Invoke(ctx, SystemInternalService_InternalGetRegionInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SystemInternalServiceServer is the server API for SystemInternalService service.
 // All implementations must embed UnimplementedSystemInternalServiceServer
 // for forward compatibility.
 type SystemInternalServiceServer interface {
 	// 获取详情
 	InternalGetCountryInfo(context.Context, *InternalGetCountryInfoRequest) (*InternalGetCountryInfoResponse, error)
+	// 获取国家列表（包含未启用的国家）
+	InternalListCountries(context.Context, *InternalListCountriesRequest) (*InternalListCountriesResponse, error)
+	// 获取货币列表
+	InternalListCurrencies(context.Context, *InternalListCurrenciesRequest) (*InternalListCurrenciesResponse, error)
+	// 获取汇率
+	InternalGetExchangeRate(context.Context, *InternalGetExchangeRateRequest) (*InternalGetExchangeRateResponse, error)
+	// 获取时区列表
+	InternalListTimezones(context.Context, *InternalListTimezonesRequest) (*InternalListTimezonesResponse, error)
+	// 获取语言列表
+	InternalListLocales(context.Context, *InternalListLocalesRequest) (*InternalListLocalesResponse, error)
+	// 获取字典
+	InternalGetDict(context.Context, *InternalGetDictRequest) (*InternalGetDictResponse, error)
+	// 获取节假日列表
+	InternalListHolidays(context.Context, *InternalListHolidaysRequest) (*InternalListHolidaysResponse, error)
+	// 获取部署区域信息
+	InternalGetRegionInfo(context.Context, *InternalGetRegionInfoRequest) (*InternalGetRegionInfoResponse, error)
 	mustEmbedUnimplementedSystemInternalServiceServer()
 }
 
@@ -67,6 +187,30 @@ type UnimplementedSystemInternalServiceServer struct{}
 func (UnimplementedSystemInternalServiceServer) InternalGetCountryInfo(context.Context, *InternalGetCountryInfoRequest) (*InternalGetCountryInfoResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method InternalGetCountryInfo not implemented")
 }
+func (UnimplementedSystemInternalServiceServer) InternalListCountries(context.Context, *InternalListCountriesRequest) (*InternalListCountriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalListCountries not implemented")
+}
+func (UnimplementedSystemInternalServiceServer) InternalListCurrencies(context.Context, *InternalListCurrenciesRequest) (*InternalListCurrenciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalListCurrencies not implemented")
+}
+func (UnimplementedSystemInternalServiceServer) InternalGetExchangeRate(context.Context, *InternalGetExchangeRateRequest) (*InternalGetExchangeRateResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalGetExchangeRate not implemented")
+}
+func (UnimplementedSystemInternalServiceServer) InternalListTimezones(context.Context, *InternalListTimezonesRequest) (*InternalListTimezonesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalListTimezones not implemented")
+}
+func (UnimplementedSystemInternalServiceServer) InternalListLocales(context.Context, *InternalListLocalesRequest) (*InternalListLocalesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalListLocales not implemented")
+}
+func (UnimplementedSystemInternalServiceServer) InternalGetDict(context.Context, *InternalGetDictRequest) (*InternalGetDictResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalGetDict not implemented")
+}
+func (UnimplementedSystemInternalServiceServer) InternalListHolidays(context.Context, *InternalListHolidaysRequest) (*InternalListHolidaysResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalListHolidays not implemented")
+}
+func (UnimplementedSystemInternalServiceServer) InternalGetRegionInfo(context.Context, *InternalGetRegionInfoRequest) (*InternalGetRegionInfoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InternalGetRegionInfo not implemented")
+}
 func (UnimplementedSystemInternalServiceServer) mustEmbedUnimplementedSystemInternalServiceServer() {}
 func (UnimplementedSystemInternalServiceServer) testEmbeddedByValue()                               {}
 
@@ -106,6 +250,150 @@ func _SystemInternalService_InternalGetCountryInfo_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SystemInternalService_InternalListCountries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalListCountriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemInternalServiceServer).InternalListCountries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemInternalService_InternalListCountries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemInternalServiceServer).InternalListCountries(ctx, req.(*InternalListCountriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemInternalService_InternalListCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalListCurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemInternalServiceServer).InternalListCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemInternalService_InternalListCurrencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemInternalServiceServer).InternalListCurrencies(ctx, req.(*InternalListCurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemInternalService_InternalGetExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGetExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemInternalServiceServer).InternalGetExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemInternalService_InternalGetExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemInternalServiceServer).InternalGetExchangeRate(ctx, req.(*InternalGetExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemInternalService_InternalListTimezones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalListTimezonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemInternalServiceServer).InternalListTimezones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemInternalService_InternalListTimezones_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemInternalServiceServer).InternalListTimezones(ctx, req.(*InternalListTimezonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemInternalService_InternalListLocales_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalListLocalesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemInternalServiceServer).InternalListLocales(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemInternalService_InternalListLocales_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemInternalServiceServer).InternalListLocales(ctx, req.(*InternalListLocalesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemInternalService_InternalGetDict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGetDictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemInternalServiceServer).InternalGetDict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemInternalService_InternalGetDict_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemInternalServiceServer).InternalGetDict(ctx, req.(*InternalGetDictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemInternalService_InternalListHolidays_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalListHolidaysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemInternalServiceServer).InternalListHolidays(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemInternalService_InternalListHolidays_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemInternalServiceServer).InternalListHolidays(ctx, req.(*InternalListHolidaysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SystemInternalService_InternalGetRegionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InternalGetRegionInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SystemInternalServiceServer).InternalGetRegionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SystemInternalService_InternalGetRegionInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SystemInternalServiceServer).InternalGetRegionInfo(ctx, req.(*InternalGetRegionInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SystemInternalService_ServiceDesc is the grpc.ServiceDesc for SystemInternalService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -117,6 +405,38 @@ var SystemInternalService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "InternalGetCountryInfo",
 			Handler:    _SystemInternalService_InternalGetCountryInfo_Handler,
 		},
+		{
+			MethodName: "InternalListCountries",
+			Handler:    _SystemInternalService_InternalListCountries_Handler,
+		},
+		{
+			MethodName: "InternalListCurrencies",
+			Handler:    _SystemInternalService_InternalListCurrencies_Handler,
+		},
+		{
+			MethodName: "InternalGetExchangeRate",
+			Handler:    _SystemInternalService_InternalGetExchangeRate_Handler,
+		},
+		{
+			MethodName: "InternalListTimezones",
+			Handler:    _SystemInternalService_InternalListTimezones_Handler,
+		},
+		{
+			MethodName: "InternalListLocales",
+			Handler:    _SystemInternalService_InternalListLocales_Handler,
+		},
+		{
+			MethodName: "InternalGetDict",
+			Handler:    _SystemInternalService_InternalGetDict_Handler,
+		},
+		{
+			MethodName: "InternalListHolidays",
+			Handler:    _SystemInternalService_InternalListHolidays_Handler,
+		},
+		{
+			MethodName: "InternalGetRegionInfo",
+			Handler:    _SystemInternalService_InternalGetRegionInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "system/v1/system_internal.proto",
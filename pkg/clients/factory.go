@@ -0,0 +1,295 @@
+// Package clients 提供服务客户端工厂，统一管理各服务的 gRPC 连接
+//
+// 工厂位于独立的包中，因为各服务客户端包（resource、subscribe 等）都依赖 pkg/common，
+// 放在 pkg/common 中会产生循环依赖
+package clients
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/heyinLab/common/pkg/common"
+	"github.com/heyinLab/common/pkg/merchant"
+	middleware "github.com/heyinLab/common/pkg/middleware/grpc"
+	"github.com/heyinLab/common/pkg/platform"
+	"github.com/heyinLab/common/pkg/product"
+	"github.com/heyinLab/common/pkg/resource"
+	"github.com/heyinLab/common/pkg/subscribe"
+	"github.com/heyinLab/common/pkg/system"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// 服务配置的键名，与 common.LoadServiceConfigsFromFile 配置文件中的服务名对应
+const (
+	ServiceResource  = "resource"
+	ServiceSubscribe = "subscribe"
+	ServiceProduct   = "product"
+	ServiceMerchant  = "merchant"
+	ServicePlatform  = "platform"
+	ServiceSystem    = "system"
+)
+
+// Factory 服务客户端工厂
+//
+// 每个服务在首次获取客户端时建立一个 gRPC 连接并缓存，同一服务的客户端共享连接，
+// 通过 Close 统一关闭所有连接。配置变更时通过 UpdateConfigs 重新建立连接，
+// 已创建的客户端自动切换到新连接。可并发使用
+//
+// 使用示例:
+//
+//	configs, err := common.LoadServiceConfigsFromFile("configs/services.yaml")
+//	if err != nil {
+//	    return err
+//	}
+//	factory := clients.NewClientFactory(discovery, configs)
+//	defer factory.Close()
+//
+//	resourceClient, err := factory.Resource()
+//	subscribeClient, err := factory.Subscribe()
+type Factory struct {
+	discovery registry.Discovery
+	configs   map[string]*common.ServiceConfig
+	logger    *log.Helper
+
+	mu      sync.Mutex
+	conns   map[string]*sharedConn
+	clients map[string]interface{}
+	closed  bool
+}
+
+// NewClientFactory 创建服务客户端工厂
+//
+// 参数:
+//   - discovery: 服务发现实例，为 nil 时直连配置中的 Endpoint
+//   - configs: 服务名到配置的映射（键名见 ServiceResource 等常量），未配置的服务使用各包的默认配置
+//
+// 返回:
+//   - *Factory: 工厂实例，连接在首次获取客户端时建立
+func NewClientFactory(discovery registry.Discovery, configs map[string]*common.ServiceConfig) *Factory {
+	return &Factory{
+		discovery: discovery,
+		configs:   configs,
+		logger: log.NewHelper(log.With(
+			log.GetLogger(),
+			"module", "client-factory",
+		)),
+		conns:   make(map[string]*sharedConn),
+		clients: make(map[string]interface{}),
+	}
+}
+
+// Resource 获取资源服务内部客户端
+func (f *Factory) Resource() (*resource.ResourceClient, error) {
+	return getClient(f, ServiceResource, resource.DefaultInternalConfig, resource.NewResourceClientWithConn)
+}
+
+// Subscribe 获取订阅服务客户端
+func (f *Factory) Subscribe() (*subscribe.Client, error) {
+	return getClient(f, ServiceSubscribe, subscribe.DefaultConfig, subscribe.NewClientWithConn)
+}
+
+// Product 获取产品服务客户端
+func (f *Factory) Product() (*product.Client, error) {
+	return getClient(f, ServiceProduct, product.DefaultConfig, product.NewClientWithConn)
+}
+
+// Merchant 获取商户服务客户端
+func (f *Factory) Merchant() (*merchant.Client, error) {
+	return getClient(f, ServiceMerchant, merchant.DefaultConfig, merchant.NewClientWithConn)
+}
+
+// Platform 获取平台服务客户端
+func (f *Factory) Platform() (*platform.Client, error) {
+	return getClient(f, ServicePlatform, platform.DefaultConfig, platform.NewClientWithConn)
+}
+
+// System 获取系统服务客户端
+func (f *Factory) System() (*system.Client, error) {
+	return getClient(f, ServiceSystem, system.DefaultConfig, system.NewClientWithConn)
+}
+
+// Conn 获取服务的 gRPC 连接，未建立时使用 configs 中的配置建立
+//
+// 用于访问工厂未提供类型化客户端的服务，连接由工厂管理，配置变更时自动切换
+//
+// 参数:
+//   - name: 服务名，需在 configs 中配置
+//
+// 使用示例:
+//
+//	conn, err := factory.Conn("search")
+//	client := searchv1.NewSearchServiceClient(conn)
+func (f *Factory) Conn(name string) (grpc.ClientConnInterface, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	config := f.configs[name]
+	if config == nil {
+		return nil, fmt.Errorf("未配置服务: %s", name)
+	}
+	return f.connLocked(name, config)
+}
+
+// Close 关闭所有连接，之后不能再获取客户端
+func (f *Factory) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.closed = true
+	var errs []error
+	for name, conn := range f.conns {
+		if err := conn.cc.Load().Close(); err != nil {
+			errs = append(errs, fmt.Errorf("关闭 %s 连接失败: %w", name, err))
+		}
+	}
+	f.conns = make(map[string]*sharedConn)
+	f.clients = make(map[string]interface{})
+	return stderrors.Join(errs...)
+}
+
+// connections 获取已建立连接的快照
+func (f *Factory) connections() map[string]*grpc.ClientConn {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	conns := make(map[string]*grpc.ClientConn, len(f.conns))
+	for name, conn := range f.conns {
+		conns[name] = conn.cc.Load()
+	}
+	return conns
+}
+
+// UpdateConfigs 更新服务配置
+//
+// 已建立连接的服务配置发生变化时（如 Endpoint、Timeout），使用新配置重新建立连接，
+// 已创建的客户端自动切换到新连接，旧连接在原 Timeout 后关闭，以便进行中的请求完成。
+// 之后通过工厂获取的客户端使用新配置创建
+//
+// 参数:
+//   - configs: 全部服务配置，可直接作为 common.WatchServiceConfig 的回调
+//
+// 说明:
+//   - 新配置无效或重新建立连接失败时记录日志并保留原连接
+//   - 配置中删除的服务保留原连接
+func (f *Factory) UpdateConfigs(configs map[string]*common.ServiceConfig) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.closed {
+		return
+	}
+	f.configs = configs
+
+	for name, conn := range f.conns {
+		config := configs[name]
+		if config == nil {
+			continue
+		}
+		if err := config.Validate(); err != nil {
+			f.logger.Errorf("服务配置无效，保留原连接: service=%s, error=%v", name, err)
+			continue
+		}
+		if reflect.DeepEqual(config, conn.config) {
+			continue
+		}
+
+		cc, err := middleware.CreateGRPCConn(config, f.discovery, f.logger)
+		if err != nil {
+			f.logger.Errorf("重新建立连接失败，保留原连接: service=%s, error=%v", name, err)
+			continue
+		}
+		old := conn.cc.Swap(cc)
+		drain := conn.config.Timeout
+		conn.config = config
+		delete(f.clients, name)
+		time.AfterFunc(drain, func() {
+			old.Close()
+		})
+
+		f.logger.Infof("服务配置已更新，重新建立连接: service=%s, endpoint=%s, timeout=%v", name, config.Endpoint, config.Timeout)
+	}
+}
+
+// getClient 获取缓存的客户端，不存在时基于服务连接创建
+func getClient[C any](f *Factory, name string, defaultConfig func() *common.ServiceConfig,
+	newClient func(*common.ServiceConfig, grpc.ClientConnInterface) C) (C, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if client, ok := f.clients[name]; ok {
+		return client.(C), nil
+	}
+
+	var zero C
+	config := f.configs[name]
+	if config == nil {
+		config = defaultConfig()
+	}
+	conn, err := f.connLocked(name, config)
+	if err != nil {
+		return zero, err
+	}
+
+	client := newClient(config, conn)
+	f.clients[name] = client
+	return client, nil
+}
+
+// connLocked 获取或建立服务连接，调用方需持有锁
+func (f *Factory) connLocked(name string, config *common.ServiceConfig) (*sharedConn, error) {
+	if f.closed {
+		return nil, fmt.Errorf("客户端工厂已关闭")
+	}
+	if conn, ok := f.conns[name]; ok {
+		return conn, nil
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("服务 %s 配置无效: %w", name, err)
+	}
+	cc, err := middleware.CreateGRPCConn(config, f.discovery, f.logger)
+	if err != nil {
+		return nil, fmt.Errorf("创建 %s gRPC 连接失败: %w", name, err)
+	}
+	conn := &sharedConn{config: config}
+	conn.cc.Store(cc)
+	f.conns[name] = conn
+	return conn, nil
+}
+
+// sharedConn 工厂管理的服务连接，重新建立连接时替换底层连接，持有它的客户端无需重建
+type sharedConn struct {
+	// config 建立当前连接使用的配置，只在持有工厂锁时读写
+	config *common.ServiceConfig
+	cc     atomic.Pointer[grpc.ClientConn]
+}
+
+// Invoke 实现 grpc.ClientConnInterface
+func (c *sharedConn) Invoke(ctx context.Context, method string, args, reply interface{}, opts ...grpc.CallOption) error {
+	return c.cc.Load().Invoke(ctx, method, args, reply, opts...)
+}
+
+// NewStream 实现 grpc.ClientConnInterface
+func (c *sharedConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return c.cc.Load().NewStream(ctx, desc, method, opts...)
+}
+
+// GetState 获取当前底层连接的状态
+func (c *sharedConn) GetState() connectivity.State {
+	return c.cc.Load().GetState()
+}
+
+// WaitForStateChange 等待当前底层连接的状态变化
+//
+// 等待期间替换了底层连接时，旧连接关闭后返回 true，调用方再次 GetState 即可获取新连接的状态
+func (c *sharedConn) WaitForStateChange(ctx context.Context, sourceState connectivity.State) bool {
+	return c.cc.Load().WaitForStateChange(ctx, sourceState)
+}
@@ -0,0 +1,134 @@
+package clients
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestFactory(t *testing.T) {
+	factory := NewClientFactory(nil, map[string]*common.ServiceConfig{
+		ServiceResource:  common.NewServiceConfig("resource-server").WithEndpoint("127.0.0.1:19001"),
+		ServiceSubscribe: common.NewServiceConfig("subscription-server").WithEndpoint("127.0.0.1:19002"),
+		"search":         common.NewServiceConfig("search-server").WithEndpoint("127.0.0.1:19003"),
+	})
+
+	first, err := factory.Resource()
+	if err != nil {
+		t.Fatalf("Resource failed: %v", err)
+	}
+	second, _ := factory.Resource()
+	if first != second {
+		t.Error("Expected cached resource client")
+	}
+	if _, err := factory.Subscribe(); err != nil {
+		t.Fatalf("Subscribe failed: %v", err)
+	}
+
+	conn, err := factory.Conn("search")
+	if err != nil {
+		t.Fatalf("Conn failed: %v", err)
+	}
+	if again, _ := factory.Conn("search"); again != conn {
+		t.Error("Expected cached connection")
+	}
+	if _, err := factory.Conn("unknown"); err == nil {
+		t.Error("Expected error for unknown service")
+	}
+	if len(factory.conns) != 3 {
+		t.Errorf("Expected 3 connections, got %d", len(factory.conns))
+	}
+
+	// 共享连接的客户端支持观察连接状态
+	states := make(chan connectivity.State, 1)
+	first.OnStateChange(func(state connectivity.State) {
+		select {
+		case states <- state:
+		default:
+		}
+	})
+	select {
+	case <-states:
+	case <-time.After(time.Second):
+		t.Error("Expected state callback for factory client")
+	}
+
+	// 共享连接的客户端 Close 不关闭连接
+	first.Close()
+	if state := factory.conns[ServiceResource].cc.Load().GetState().String(); state == "SHUTDOWN" {
+		t.Error("Expected shared connection to stay open")
+	}
+
+	cc := conn.(*sharedConn).cc.Load()
+	if err := factory.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if state := cc.GetState().String(); state != "SHUTDOWN" {
+		t.Errorf("Expected connection closed, got %s", state)
+	}
+	if _, err := factory.Product(); err == nil {
+		t.Error("Expected error after Close")
+	}
+}
+
+func TestFactory_UpdateConfigs(t *testing.T) {
+	// 两个健康检查服务，记录各自收到的请求数
+	var counts [2]atomic.Int32
+	var addrs [2]string
+	for i := range addrs {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatalf("listen: %v", err)
+		}
+		count := &counts[i]
+		srv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			count.Add(1)
+			return handler(ctx, req)
+		}))
+		healthpb.RegisterHealthServer(srv, health.NewServer())
+		go srv.Serve(lis)
+		t.Cleanup(srv.Stop)
+		addrs[i] = lis.Addr().String()
+	}
+
+	factory := NewClientFactory(nil, map[string]*common.ServiceConfig{
+		"health": common.NewServiceConfig("health").WithEndpoint(addrs[0]).WithTimeout(time.Second).WithoutMetrics(),
+	})
+	defer factory.Close()
+
+	conn, err := factory.Conn("health")
+	if err != nil {
+		t.Fatalf("Conn failed: %v", err)
+	}
+	client := healthpb.NewHealthClient(conn)
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+	old := conn.(*sharedConn).cc.Load()
+
+	// 配置未变化时不重新建立连接
+	factory.UpdateConfigs(map[string]*common.ServiceConfig{
+		"health": common.NewServiceConfig("health").WithEndpoint(addrs[0]).WithTimeout(time.Second).WithoutMetrics(),
+	})
+	if conn.(*sharedConn).cc.Load() != old {
+		t.Fatal("Expected connection reused when config is unchanged")
+	}
+
+	factory.UpdateConfigs(map[string]*common.ServiceConfig{
+		"health": common.NewServiceConfig("health").WithEndpoint(addrs[1]).WithTimeout(time.Second).WithoutMetrics(),
+	})
+	if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("Check after update failed: %v", err)
+	}
+	if counts[0].Load() != 1 || counts[1].Load() != 1 {
+		t.Errorf("Expected existing client switched to new endpoint, got %d/%d", counts[0].Load(), counts[1].Load())
+	}
+}
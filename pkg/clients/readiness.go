@@ -0,0 +1,314 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	// DefaultReadinessInterval 默认健康检查间隔
+	DefaultReadinessInterval = 10 * time.Second
+
+	// DefaultReadinessTimeout 默认单次健康检查超时时间
+	DefaultReadinessTimeout = 2 * time.Second
+)
+
+// DependencyStatus 下游服务的健康状态
+type DependencyStatus struct {
+	// Name 服务名，如 "resource"
+	Name string `json:"name"`
+
+	// Healthy 最近一次检查是否健康（SERVING）
+	Healthy bool `json:"healthy"`
+
+	// Critical 是否为关键依赖，关键依赖不健康时服务未就绪
+	Critical bool `json:"critical"`
+
+	// Error 检查失败的原因
+	Error string `json:"error,omitempty"`
+
+	// CheckedAt 最近一次检查时间
+	CheckedAt time.Time `json:"checked_at"`
+}
+
+// ReadinessOption 就绪检查选项
+type ReadinessOption func(*readinessOptions)
+
+type readinessOptions struct {
+	interval time.Duration
+	timeout  time.Duration
+	critical map[string]bool
+}
+
+// WithCheckInterval 设置健康检查间隔，默认 10 秒
+func WithCheckInterval(interval time.Duration) ReadinessOption {
+	return func(o *readinessOptions) {
+		o.interval = interval
+	}
+}
+
+// WithCheckTimeout 设置单次健康检查超时时间，默认 2 秒
+func WithCheckTimeout(timeout time.Duration) ReadinessOption {
+	return func(o *readinessOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithCritical 设置关键依赖，只有关键依赖不健康时服务才未就绪
+//
+// 默认所有已建立连接的依赖都是关键依赖。指定的关键依赖尚未建立连接时视为不健康，
+// 需在启动时通过工厂获取对应的客户端
+func WithCritical(names ...string) ReadinessOption {
+	return func(o *readinessOptions) {
+		if o.critical == nil {
+			o.critical = make(map[string]bool, len(names))
+		}
+		for _, name := range names {
+			o.critical[name] = true
+		}
+	}
+}
+
+// ReadinessChecker 下游依赖就绪检查
+//
+// 定期通过 gRPC 健康检查协议（grpc.health.v1.Health/Check）探测工厂中已建立的连接，
+// 汇总为服务整体的就绪状态，供 k8s readinessProbe 使用。
+// 首次检查完成前服务未就绪；探测不经过连接上的中间件（重试、熔断、指标等），
+// 不会触发重试或计入熔断统计
+//
+// 使用示例:
+//
+//	factory := clients.NewClientFactory(discovery, configs)
+//	checker := clients.NewReadinessChecker(factory, clients.WithCritical(clients.ServiceResource))
+//	defer checker.Close()
+//
+//	httpSrv.Handle("/readyz", checker.Handler())
+type ReadinessChecker struct {
+	factory *Factory
+	opts    *readinessOptions
+
+	mu       sync.RWMutex
+	statuses map[string]DependencyStatus
+	checked  bool
+
+	stop chan struct{}
+	once sync.Once
+}
+
+// NewReadinessChecker 创建就绪检查并启动后台检查
+//
+// 参数:
+//   - factory: 客户端工厂，只检查已建立的连接
+//   - opts: 可选配置，如 WithCheckInterval、WithCritical
+//
+// 说明:
+//   - 不再使用时需调用 Close 停止后台检查
+func NewReadinessChecker(factory *Factory, opts ...ReadinessOption) *ReadinessChecker {
+	o := &readinessOptions{
+		interval: DefaultReadinessInterval,
+		timeout:  DefaultReadinessTimeout,
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if o.interval <= 0 {
+		o.interval = DefaultReadinessInterval
+	}
+	if o.timeout <= 0 {
+		o.timeout = DefaultReadinessTimeout
+	}
+
+	c := &ReadinessChecker{
+		factory:  factory,
+		opts:     o,
+		statuses: make(map[string]DependencyStatus, len(o.critical)),
+		stop:     make(chan struct{}),
+	}
+	for name := range o.critical {
+		c.statuses[name] = DependencyStatus{Name: name, Critical: true, Error: "尚未检查"}
+	}
+	go c.loop()
+	return c
+}
+
+// Check 立即检查所有已建立的连接并更新状态
+func (c *ReadinessChecker) Check(ctx context.Context) {
+	conns := c.factory.connections()
+
+	var (
+		wg sync.WaitGroup
+		mu sync.Mutex
+	)
+	statuses := make(map[string]DependencyStatus, len(conns))
+	for name, conn := range conns {
+		wg.Add(1)
+		go func(name string, conn *grpc.ClientConn) {
+			defer wg.Done()
+			status := DependencyStatus{
+				Name:      name,
+				Healthy:   true,
+				Critical:  c.isCritical(name),
+				CheckedAt: time.Now(),
+			}
+			if err := c.check(ctx, conn); err != nil {
+				status.Healthy = false
+				status.Error = err.Error()
+				c.factory.logger.WithContext(ctx).Warnf("下游服务健康检查失败: service=%s, error=%v", name, err)
+			}
+			mu.Lock()
+			statuses[name] = status
+			mu.Unlock()
+		}(name, conn)
+	}
+	wg.Wait()
+
+	for name := range c.opts.critical {
+		if _, ok := statuses[name]; !ok {
+			statuses[name] = DependencyStatus{Name: name, Critical: true, Error: "连接未建立", CheckedAt: time.Now()}
+		}
+	}
+
+	c.mu.Lock()
+	c.statuses = statuses
+	c.checked = true
+	c.mu.Unlock()
+}
+
+// Ready 服务是否就绪，首次检查完成且所有关键依赖健康时返回 true
+func (c *ReadinessChecker) Ready() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if !c.checked {
+		return false
+	}
+	for _, status := range c.statuses {
+		if status.Critical && !status.Healthy {
+			return false
+		}
+	}
+	return true
+}
+
+// Statuses 获取各依赖最近一次的检查结果，按服务名排序
+func (c *ReadinessChecker) Statuses() []DependencyStatus {
+	c.mu.RLock()
+	statuses := make([]DependencyStatus, 0, len(c.statuses))
+	for _, status := range c.statuses {
+		statuses = append(statuses, status)
+	}
+	c.mu.RUnlock()
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+// Handler 就绪检查 HTTP 接口
+//
+// 就绪时返回 200，否则返回 503，响应体包含整体状态和各依赖的检查结果:
+//
+//	{"ready": false, "dependencies": [{"name": "resource", "healthy": false, "critical": true, "error": "...", "checked_at": "..."}]}
+//
+// 通过 ?service=resource 查询单个依赖，依赖健康时返回 200，不健康返回 503，不存在返回 404
+func (c *ReadinessChecker) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if name := r.URL.Query().Get("service"); name != "" {
+			c.mu.RLock()
+			status, ok := c.statuses[name]
+			c.mu.RUnlock()
+			switch {
+			case !ok:
+				writeJSON(w, http.StatusNotFound, map[string]string{"error": fmt.Sprintf("未找到依赖: %s", name)})
+			case status.Healthy:
+				writeJSON(w, http.StatusOK, status)
+			default:
+				writeJSON(w, http.StatusServiceUnavailable, status)
+			}
+			return
+		}
+
+		ready := c.Ready()
+		code := http.StatusOK
+		if !ready {
+			code = http.StatusServiceUnavailable
+		}
+		writeJSON(w, code, map[string]interface{}{
+			"ready":        ready,
+			"dependencies": c.Statuses(),
+		})
+	})
+}
+
+// Close 停止后台检查
+func (c *ReadinessChecker) Close() {
+	c.once.Do(func() {
+		close(c.stop)
+	})
+}
+
+// loop 启动时检查一次，之后定期检查
+func (c *ReadinessChecker) loop() {
+	c.Check(context.Background())
+
+	ticker := time.NewTicker(c.opts.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.stop:
+			return
+		case <-ticker.C:
+			c.Check(context.Background())
+		}
+	}
+}
+
+// check 检查单个连接
+//
+// 连接上的中间件以一元拦截器的形式生效，这里以流的方式发送一元的 Check 请求，
+// 绕过中间件，探测结果只反映下游服务本身的状态
+func (c *ReadinessChecker) check(ctx context.Context, conn *grpc.ClientConn) error {
+	ctx, cancel := context.WithTimeout(ctx, c.opts.timeout)
+	defer cancel()
+
+	stream, err := conn.NewStream(ctx, &grpc.StreamDesc{StreamName: "Check"}, healthpb.Health_Check_FullMethodName)
+	if err != nil {
+		return err
+	}
+	if err := stream.SendMsg(&healthpb.HealthCheckRequest{}); err != nil {
+		return err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return err
+	}
+	resp := &healthpb.HealthCheckResponse{}
+	if err := stream.RecvMsg(resp); err != nil {
+		return err
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("服务不可用: status=%s", resp.Status)
+	}
+	return nil
+}
+
+// isCritical 是否为关键依赖，未设置 WithCritical 时所有依赖都是关键依赖
+func (c *ReadinessChecker) isCritical(name string) bool {
+	return c.opts.critical == nil || c.opts.critical[name]
+}
+
+// writeJSON 写入 JSON 响应
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,118 @@
+package clients
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// startHealthServer 启动本地 gRPC 健康检查服务
+func startHealthServer(t *testing.T) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+func TestReadinessChecker(t *testing.T) {
+	// 已关闭端口，健康检查失败
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	down := lis.Addr().String()
+	lis.Close()
+
+	factory := NewClientFactory(nil, map[string]*common.ServiceConfig{
+		ServiceResource: common.NewServiceConfig("resource-server").WithEndpoint(startHealthServer(t)).WithoutMetrics(),
+		ServiceSystem: common.NewServiceConfig("system-server").WithEndpoint(down).WithoutMetrics().
+			WithCircuitBreaker(1, time.Hour),
+	})
+	defer factory.Close()
+	factory.Resource()
+	factory.System()
+
+	checker := NewReadinessChecker(factory,
+		WithCheckInterval(time.Hour),
+		WithCheckTimeout(500*time.Millisecond),
+		WithCritical(ServiceResource),
+	)
+	defer checker.Close()
+	checker.Check(context.Background())
+
+	statuses := checker.Statuses()
+	if len(statuses) != 2 || !statuses[0].Healthy || statuses[1].Healthy || statuses[1].Critical {
+		t.Fatalf("Unexpected statuses: %+v", statuses)
+	}
+	if !checker.Ready() {
+		t.Error("Expected ready when only non-critical dependency is down")
+	}
+
+	tests := []struct {
+		url  string
+		code int
+	}{
+		{"/readyz", http.StatusOK},
+		{"/readyz?service=resource", http.StatusOK},
+		{"/readyz?service=system", http.StatusServiceUnavailable},
+		{"/readyz?service=unknown", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		rec := httptest.NewRecorder()
+		checker.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.url, nil))
+		if rec.Code != tt.code {
+			t.Errorf("%s: expected %d, got %d: %s", tt.url, tt.code, rec.Code, rec.Body.String())
+		}
+	}
+
+	// 所有依赖默认为关键依赖
+	strict := NewReadinessChecker(factory, WithCheckInterval(time.Hour), WithCheckTimeout(500*time.Millisecond))
+	defer strict.Close()
+	strict.Check(context.Background())
+	if strict.Ready() {
+		t.Error("Expected not ready when a critical dependency is down")
+	}
+
+	// 探测不经过熔断中间件，多次失败后仍为真实的连接错误
+	for _, status := range strict.Statuses() {
+		if status.Name == ServiceSystem && strings.Contains(status.Error, "CIRCUIT_BREAKER_OPEN") {
+			t.Errorf("Expected probe to bypass circuit breaker, got %s", status.Error)
+		}
+	}
+
+	// 关键依赖未建立连接时未就绪
+	missing := NewReadinessChecker(factory, WithCheckInterval(time.Hour), WithCritical(ServiceProduct))
+	defer missing.Close()
+	missing.Check(context.Background())
+	if missing.Ready() {
+		t.Error("Expected not ready when a critical dependency has no connection")
+	}
+}
+
+func TestReadinessChecker_NotReadyBeforeCheck(t *testing.T) {
+	factory := NewClientFactory(nil, nil)
+	defer factory.Close()
+
+	checker := &ReadinessChecker{factory: factory, opts: &readinessOptions{}, statuses: map[string]DependencyStatus{}}
+	if checker.Ready() {
+		t.Error("Expected not ready before the first check")
+	}
+	checker.Check(context.Background())
+	if !checker.Ready() {
+		t.Error("Expected ready after checking with no dependencies")
+	}
+}
@@ -0,0 +1,64 @@
+package common
+
+import "sync"
+
+var (
+	overrideMu        sync.RWMutex
+	endpointOverrides = make(map[string]string)
+)
+
+// SetEndpointOverride 覆盖服务的默认端点
+//
+// 设置后 NewServiceConfig（各服务包的 DefaultConfig 均基于它创建）和 WithServiceName
+// 使用覆盖的端点替代 "discovery:///服务名"，用于集成测试和本地开发时将客户端指向本地服务，
+// 无需逐个修改各服务包的配置
+//
+// 参数:
+//   - serviceName: 服务名称，如 resource.DefaultServiceName（"resource-server"）
+//   - endpoint: 覆盖的端点，如 "localhost:9000"，为空时删除覆盖
+//
+// 使用示例:
+//
+//	func TestMain(m *testing.M) {
+//	    common.SetEndpointOverride(resource.DefaultServiceName, "localhost:9000")
+//	    common.SetEndpointOverride(subscribe.DefaultServiceName, "localhost:9001")
+//	    os.Exit(m.Run())
+//	}
+//
+// 注意:
+//   - 只影响设置之后创建的配置
+func SetEndpointOverride(serviceName, endpoint string) {
+	overrideMu.Lock()
+	defer overrideMu.Unlock()
+
+	if endpoint == "" {
+		delete(endpointOverrides, serviceName)
+		return
+	}
+	endpointOverrides[serviceName] = endpoint
+}
+
+// EndpointOverride 获取服务的端点覆盖，未设置时返回 false
+func EndpointOverride(serviceName string) (string, bool) {
+	overrideMu.RLock()
+	defer overrideMu.RUnlock()
+
+	endpoint, ok := endpointOverrides[serviceName]
+	return endpoint, ok
+}
+
+// ResetEndpointOverrides 清除所有端点覆盖
+func ResetEndpointOverrides() {
+	overrideMu.Lock()
+	defer overrideMu.Unlock()
+
+	endpointOverrides = make(map[string]string)
+}
+
+// defaultEndpoint 服务的默认端点，存在覆盖时使用覆盖的端点
+func defaultEndpoint(serviceName string) string {
+	if endpoint, ok := EndpointOverride(serviceName); ok {
+		return endpoint
+	}
+	return "discovery:///" + serviceName
+}
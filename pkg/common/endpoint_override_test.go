@@ -0,0 +1,23 @@
+package common
+
+import "testing"
+
+func TestEndpointOverride(t *testing.T) {
+	t.Cleanup(ResetEndpointOverrides)
+
+	SetEndpointOverride("resource-server", "localhost:9000")
+	if c := NewServiceConfig("resource-server"); c.Endpoint != "localhost:9000" || c.ServiceName != "resource-server" {
+		t.Errorf("Expected overridden endpoint, got %+v", c)
+	}
+	if c := NewServiceConfig("product-server"); c.Endpoint != "discovery:///product-server" {
+		t.Errorf("Expected discovery endpoint, got %s", c.Endpoint)
+	}
+	if c := NewServiceConfig("product-server").WithServiceName("resource-server"); c.Endpoint != "localhost:9000" {
+		t.Errorf("Expected overridden endpoint via WithServiceName, got %s", c.Endpoint)
+	}
+
+	SetEndpointOverride("resource-server", "")
+	if c := NewServiceConfig("resource-server"); c.Endpoint != "discovery:///resource-server" {
+		t.Errorf("Expected override removed, got %s", c.Endpoint)
+	}
+}
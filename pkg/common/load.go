@@ -0,0 +1,284 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+	"github.com/go-kratos/kratos/v2/encoding"
+	"google.golang.org/grpc/codes"
+)
+
+// LoadServiceConfig 从环境变量加载服务配置
+//
+// 读取以 prefix 开头的环境变量（prefix 会转为大写）:
+//   - {PREFIX}_ENDPOINT: 服务端点，如 "10.0.1.20:9000"，优先于 SERVICE_NAME
+//   - {PREFIX}_SERVICE_NAME: 服务名称，未设置 ENDPOINT 时通过服务发现连接
+//   - {PREFIX}_TIMEOUT: 请求超时时间，如 "5s"
+//   - {PREFIX}_MAX_RETRIES: 最大重试次数
+//   - {PREFIX}_TLS_CA_FILE、{PREFIX}_TLS_CERT_FILE、{PREFIX}_TLS_KEY_FILE、
+//     {PREFIX}_TLS_SERVER_NAME、{PREFIX}_TLS_INSECURE_SKIP_VERIFY: TLS 配置，设置任一项即启用 TLS
+//
+// 参数:
+//   - prefix: 环境变量前缀，如 "RESOURCE"
+//
+// 返回:
+//   - *ServiceConfig: 配置实例
+//   - error: ENDPOINT 和 SERVICE_NAME 都未设置，或变量格式错误
+//
+// 使用示例:
+//
+//	// RESOURCE_ENDPOINT=resource.internal:9000 RESOURCE_TIMEOUT=5s
+//	config, err := common.LoadServiceConfig("RESOURCE")
+//	if err != nil {
+//	    return err
+//	}
+//	client, err := resource.NewResourceClient(config)
+func LoadServiceConfig(prefix string) (*ServiceConfig, error) {
+	prefix = strings.ToUpper(strings.TrimSuffix(prefix, "_")) + "_"
+	env := func(key string) string {
+		return strings.TrimSpace(os.Getenv(prefix + key))
+	}
+
+	c := &ServiceConfig{Timeout: DefaultTimeout}
+	switch endpoint, name := env("ENDPOINT"), env("SERVICE_NAME"); {
+	case endpoint != "":
+		c.Endpoint, c.ServiceName = endpoint, name
+	case name != "":
+		c.WithServiceName(name)
+	default:
+		return nil, fmt.Errorf("未配置 %sENDPOINT 或 %sSERVICE_NAME", prefix, prefix)
+	}
+
+	if v := env("TIMEOUT"); v != "" {
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("%sTIMEOUT 格式错误: %w", prefix, err)
+		}
+		c.Timeout = timeout
+	}
+	if v := env("MAX_RETRIES"); v != "" {
+		retries, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("%sMAX_RETRIES 格式错误: %w", prefix, err)
+		}
+		c.WithRetry(retries, 0)
+	}
+
+	tlsConfig := &TLSConfig{
+		CAFile:     env("TLS_CA_FILE"),
+		CertFile:   env("TLS_CERT_FILE"),
+		KeyFile:    env("TLS_KEY_FILE"),
+		ServerName: env("TLS_SERVER_NAME"),
+	}
+	if v := env("TLS_INSECURE_SKIP_VERIFY"); v != "" {
+		skip, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("%sTLS_INSECURE_SKIP_VERIFY 格式错误: %w", prefix, err)
+		}
+		tlsConfig.InsecureSkipVerify = skip
+	}
+	if *tlsConfig != (TLSConfig{}) {
+		c.TLS = tlsConfig
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// LoadServiceConfigsFromFile 从配置文件加载多个服务配置
+//
+// 支持 YAML 和 JSON 格式（按扩展名识别），path 为目录时合并目录下的所有文件，顶层为服务名到配置的映射，
+// 未设置 service_name 时使用服务名，未设置 endpoint 时通过服务发现连接:
+//
+//	resource:
+//	  endpoint: resource.internal:9000
+//	  timeout: 5s
+//	  max_recv_msg_size: 33554432
+//	  load_balancer: p2c
+//	  retry:
+//	    max_retries: 2
+//	    backoff: 100ms
+//	    retryable_codes: [UNAVAILABLE, DEADLINE_EXCEEDED]
+//	  tls:
+//	    ca_file: /etc/certs/ca.pem
+//	    server_name: resource.internal
+//	merchant:
+//	  service_name: iam-merchant-server
+//	  keepalive:
+//	    time: 30s
+//	    timeout: 5s
+//
+// 参数:
+//   - path: 配置文件路径
+//
+// 返回:
+//   - map[string]*ServiceConfig: 服务名到配置的映射
+//   - error: 文件读取、解析或配置验证失败
+func LoadServiceConfigsFromFile(path string) (map[string]*ServiceConfig, error) {
+	kvs, err := file.NewSource(path).Load()
+	if err != nil {
+		return nil, fmt.Errorf("加载服务配置文件失败: %w", err)
+	}
+	return decodeServiceConfigs(kvs)
+}
+
+// decodeServiceConfigs 解析配置源中的服务配置，多个配置项的服务名相同时后者覆盖前者
+func decodeServiceConfigs(kvs []*config.KeyValue) (map[string]*ServiceConfig, error) {
+	merged := make(map[string]interface{})
+	for _, kv := range kvs {
+		format := kv.Format
+		if format == "yml" {
+			format = "yaml"
+		}
+		codec := encoding.GetCodec(format)
+		if codec == nil {
+			return nil, fmt.Errorf("不支持的配置格式: key=%s, format=%s", kv.Key, kv.Format)
+		}
+		values := make(map[string]interface{})
+		if err := codec.Unmarshal(kv.Value, &values); err != nil {
+			return nil, fmt.Errorf("解析服务配置失败: key=%s: %w", kv.Key, err)
+		}
+		for name, value := range values {
+			merged[name] = value
+		}
+	}
+
+	data, err := json.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("解析服务配置失败: %w", err)
+	}
+	var files map[string]*serviceConfigFile
+	if err := json.Unmarshal(data, &files); err != nil {
+		return nil, fmt.Errorf("解析服务配置失败: %w", err)
+	}
+
+	configs := make(map[string]*ServiceConfig, len(files))
+	for name, f := range files {
+		if f == nil {
+			f = &serviceConfigFile{}
+		}
+		sc := f.serviceConfig(name)
+		if err := sc.Validate(); err != nil {
+			return nil, fmt.Errorf("服务 %s: %w", name, err)
+		}
+		configs[name] = sc
+	}
+	return configs, nil
+}
+
+// serviceConfigFile 配置文件中的服务配置
+type serviceConfigFile struct {
+	Endpoint       string   `json:"endpoint"`
+	ServiceName    string   `json:"service_name"`
+	Timeout        duration `json:"timeout"`
+	DisableMetrics bool     `json:"disable_metrics"`
+	EnableTracing  bool     `json:"enable_tracing"`
+	MaxRecvMsgSize int      `json:"max_recv_msg_size"`
+	MaxSendMsgSize int      `json:"max_send_msg_size"`
+	LoadBalancer   string   `json:"load_balancer"`
+	SubsetSize     int      `json:"subset_size"`
+
+	Retry *struct {
+		MaxRetries     int          `json:"max_retries"`
+		Backoff        duration     `json:"backoff"`
+		MaxBackoff     duration     `json:"max_backoff"`
+		RetryableCodes []codes.Code `json:"retryable_codes"`
+	} `json:"retry"`
+
+	CircuitBreaker *struct {
+		FailureThreshold int      `json:"failure_threshold"`
+		OpenDuration     duration `json:"open_duration"`
+	} `json:"circuit_breaker"`
+
+	Keepalive *struct {
+		Time                duration `json:"time"`
+		Timeout             duration `json:"timeout"`
+		PermitWithoutStream bool     `json:"permit_without_stream"`
+	} `json:"keepalive"`
+
+	TLS *struct {
+		CAFile             string `json:"ca_file"`
+		CertFile           string `json:"cert_file"`
+		KeyFile            string `json:"key_file"`
+		ServerName         string `json:"server_name"`
+		InsecureSkipVerify bool   `json:"insecure_skip_verify"`
+	} `json:"tls"`
+}
+
+// serviceConfig 转换为 ServiceConfig，name 为配置文件中的服务名
+func (f *serviceConfigFile) serviceConfig(name string) *ServiceConfig {
+	serviceName := f.ServiceName
+	if serviceName == "" {
+		serviceName = name
+	}
+	c := NewServiceConfig(serviceName)
+	if f.Endpoint != "" {
+		c.Endpoint = f.Endpoint
+	}
+	if f.Timeout > 0 {
+		c.Timeout = time.Duration(f.Timeout)
+	}
+	c.DisableMetrics = f.DisableMetrics
+	c.EnableTracing = f.EnableTracing
+	c.MaxRecvMsgSize = f.MaxRecvMsgSize
+	c.MaxSendMsgSize = f.MaxSendMsgSize
+	c.LoadBalancer = f.LoadBalancer
+	c.SubsetSize = f.SubsetSize
+
+	if r := f.Retry; r != nil {
+		c.Retry = &RetryConfig{
+			MaxRetries:     r.MaxRetries,
+			Backoff:        time.Duration(r.Backoff),
+			MaxBackoff:     time.Duration(r.MaxBackoff),
+			RetryableCodes: r.RetryableCodes,
+		}
+	}
+	if b := f.CircuitBreaker; b != nil {
+		c.CircuitBreaker = &CircuitBreakerConfig{
+			FailureThreshold: b.FailureThreshold,
+			OpenDuration:     time.Duration(b.OpenDuration),
+		}
+	}
+	if k := f.Keepalive; k != nil {
+		c.Keepalive = &KeepaliveConfig{
+			Time:                time.Duration(k.Time),
+			Timeout:             time.Duration(k.Timeout),
+			PermitWithoutStream: k.PermitWithoutStream,
+		}
+	}
+	if t := f.TLS; t != nil {
+		c.TLS = &TLSConfig{
+			CAFile:             t.CAFile,
+			CertFile:           t.CertFile,
+			KeyFile:            t.KeyFile,
+			ServerName:         t.ServerName,
+			InsecureSkipVerify: t.InsecureSkipVerify,
+		}
+	}
+	return c
+}
+
+// duration 配置文件中的时间间隔，格式如 "5s"、"100ms"
+type duration time.Duration
+
+// UnmarshalJSON 解析时间间隔字符串
+func (d *duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("时间间隔需为字符串，如 \"5s\": %s", data)
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = duration(v)
+	return nil
+}
@@ -0,0 +1,97 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestLoadServiceConfig(t *testing.T) {
+	t.Setenv("RESOURCE_ENDPOINT", "resource.internal:9000")
+	t.Setenv("RESOURCE_TIMEOUT", "5s")
+	t.Setenv("RESOURCE_MAX_RETRIES", "2")
+	t.Setenv("RESOURCE_TLS_SERVER_NAME", "resource.internal")
+
+	config, err := LoadServiceConfig("resource")
+	if err != nil {
+		t.Fatalf("LoadServiceConfig failed: %v", err)
+	}
+	if config.Endpoint != "resource.internal:9000" || config.Timeout != 5*time.Second {
+		t.Errorf("Unexpected config: %+v", config)
+	}
+	if config.Retry == nil || config.Retry.MaxRetries != 2 || config.Retry.Backoff != DefaultRetryBackoff {
+		t.Errorf("Unexpected retry: %+v", config.Retry)
+	}
+	if config.TLS == nil || config.TLS.ServerName != "resource.internal" {
+		t.Errorf("Unexpected TLS: %+v", config.TLS)
+	}
+
+	t.Setenv("MERCHANT_SERVICE_NAME", "iam-merchant-server")
+	config, err = LoadServiceConfig("MERCHANT")
+	if err != nil {
+		t.Fatalf("LoadServiceConfig failed: %v", err)
+	}
+	if config.Endpoint != "discovery:///iam-merchant-server" || config.Timeout != DefaultTimeout || config.TLS != nil {
+		t.Errorf("Unexpected config: %+v", config)
+	}
+
+	if _, err := LoadServiceConfig("MISSING"); err == nil {
+		t.Error("Expected error when endpoint is not configured")
+	}
+	t.Setenv("RESOURCE_TIMEOUT", "5")
+	if _, err := LoadServiceConfig("RESOURCE"); err == nil {
+		t.Error("Expected error for invalid timeout")
+	}
+}
+
+func TestLoadServiceConfigsFromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "services.yaml")
+	content := `
+resource:
+  endpoint: resource.internal:9000
+  timeout: 5s
+  max_recv_msg_size: 33554432
+  retry:
+    max_retries: 2
+    backoff: 100ms
+    retryable_codes: [UNAVAILABLE, DEADLINE_EXCEEDED]
+  tls:
+    ca_file: /etc/certs/ca.pem
+merchant:
+  service_name: iam-merchant-server
+  keepalive:
+    time: 30s
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	configs, err := LoadServiceConfigsFromFile(path)
+	if err != nil {
+		t.Fatalf("LoadServiceConfigsFromFile failed: %v", err)
+	}
+
+	resource := configs["resource"]
+	if resource == nil || resource.Endpoint != "resource.internal:9000" || resource.ServiceName != "resource" ||
+		resource.Timeout != 5*time.Second || resource.MaxRecvMsgSize != 32<<20 {
+		t.Fatalf("Unexpected resource config: %+v", resource)
+	}
+	if r := resource.Retry; r.MaxRetries != 2 || r.Backoff != 100*time.Millisecond ||
+		len(r.RetryableCodes) != 2 || r.RetryableCodes[1] != codes.DeadlineExceeded {
+		t.Errorf("Unexpected retry: %+v", r)
+	}
+	if resource.TLS == nil || resource.TLS.CAFile != "/etc/certs/ca.pem" {
+		t.Errorf("Unexpected TLS: %+v", resource.TLS)
+	}
+
+	merchant := configs["merchant"]
+	if merchant == nil || merchant.Endpoint != "discovery:///iam-merchant-server" || merchant.Timeout != DefaultTimeout {
+		t.Fatalf("Unexpected merchant config: %+v", merchant)
+	}
+	if merchant.Keepalive == nil || merchant.Keepalive.Time != 30*time.Second {
+		t.Errorf("Unexpected keepalive: %+v", merchant.Keepalive)
+	}
+}
@@ -2,14 +2,113 @@ package common
 
 import (
 	"fmt"
+	"strings"
 	"time"
+
+	"google.golang.org/grpc/codes"
 )
 
 const (
 	// DefaultTimeout 默认超时时间
 	DefaultTimeout = 10 * time.Second
+
+	// DefaultRetryBackoff 默认重试退避时间
+	DefaultRetryBackoff = 100 * time.Millisecond
+
+	// DefaultRetryMaxBackoff 默认最大重试退避时间
+	DefaultRetryMaxBackoff = 2 * time.Second
+
+	// DefaultBreakerFailureThreshold 默认熔断连续失败阈值
+	DefaultBreakerFailureThreshold = 5
+
+	// DefaultBreakerOpenDuration 默认熔断打开时长
+	DefaultBreakerOpenDuration = 30 * time.Second
+
+	// DefaultHedgingDelay 默认对冲请求间隔
+	DefaultHedgingDelay = 50 * time.Millisecond
+
+	// DefaultSubsetSize 默认服务发现子集大小，每个客户端最多连接的实例数
+	DefaultSubsetSize = 25
 )
 
+// 负载均衡策略，通过 ServiceConfig.LoadBalancer 设置
+const (
+	// LoadBalancerWRR 加权轮询（kratos selector，默认）
+	LoadBalancerWRR = "wrr"
+
+	// LoadBalancerP2C 两次随机选择（kratos selector），按延迟和在途请求数选择负载较低的实例
+	LoadBalancerP2C = "p2c"
+
+	// LoadBalancerRandom 随机选择（kratos selector）
+	LoadBalancerRandom = "random"
+
+	// LoadBalancerRoundRobin 轮询（gRPC 内置）
+	LoadBalancerRoundRobin = "round_robin"
+
+	// LoadBalancerPickFirst 只使用第一个可用实例（gRPC 内置），所有请求发往同一实例
+	LoadBalancerPickFirst = "pick_first"
+)
+
+// RetryConfig 重试配置
+type RetryConfig struct {
+	// MaxRetries 最大重试次数（不含首次调用），0 表示不重试
+	MaxRetries int
+
+	// Backoff 首次重试前的退避时间，之后按指数增长
+	Backoff time.Duration
+
+	// MaxBackoff 单次退避时间上限
+	MaxBackoff time.Duration
+
+	// RetryableCodes 可重试的 gRPC 状态码，为空时使用默认值（Unavailable、Aborted）
+	RetryableCodes []codes.Code
+}
+
+// KeepaliveConfig 连接保活配置
+//
+// 连接空闲 Time 后发送 ping，Timeout 内未收到响应则关闭连接，
+// 用于及时发现被 NAT、负载均衡静默断开的连接
+type KeepaliveConfig struct {
+	// Time 空闲多久后发送 ping，gRPC 要求不小于 10 秒
+	Time time.Duration
+
+	// Timeout 等待 ping 响应的超时时间，为 0 时使用 gRPC 默认值（20 秒）
+	Timeout time.Duration
+
+	// PermitWithoutStream 没有进行中的请求时也发送 ping
+	PermitWithoutStream bool
+}
+
+// CircuitBreakerConfig 熔断器配置
+type CircuitBreakerConfig struct {
+	// FailureThreshold 连续失败多少次后打开熔断
+	FailureThreshold int
+
+	// OpenDuration 熔断打开后多久进入半开状态（放行一次探测请求）
+	OpenDuration time.Duration
+}
+
+// HedgingConfig 对冲请求配置
+//
+// 首次请求在 Delay 内未返回时，向其他实例并发发送相同请求，采用最先成功的响应。
+// 仅适用于幂等的读接口
+type HedgingConfig struct {
+	// MaxAttempts 最多同时发出的请求数（含首次请求），小于 2 时不对冲
+	MaxAttempts int
+
+	// Delay 发出下一个对冲请求前的等待时间
+	Delay time.Duration
+}
+
+// MethodPolicy 单个方法的调用策略
+type MethodPolicy struct {
+	// Retry 重试策略，nil 表示沿用 ServiceConfig.Retry，MaxRetries 为 0 表示不重试
+	Retry *RetryConfig
+
+	// Hedging 对冲请求配置（可选，nil 表示不对冲）
+	Hedging *HedgingConfig
+}
+
 // ServiceConfig 通用服务客户端配置
 type ServiceConfig struct {
 	// Endpoint 服务端点
@@ -22,6 +121,40 @@ type ServiceConfig struct {
 
 	// Timeout 请求超时时间
 	Timeout time.Duration
+
+	// Retry 重试策略（可选，nil 表示不重试）
+	Retry *RetryConfig
+
+	// CircuitBreaker 熔断器配置（可选，nil 表示不启用）
+	CircuitBreaker *CircuitBreakerConfig
+
+	// DisableMetrics 关闭客户端调用指标（rpc_client_*，见 pkg/middleware/metrics，默认开启）
+	DisableMetrics bool
+
+	// EnableTracing 开启客户端链路追踪（默认关闭，需先配置 OpenTelemetry TracerProvider）
+	EnableTracing bool
+
+	// TLS 传输层加密配置（可选，nil 表示明文连接）
+	TLS *TLSConfig
+
+	// Keepalive 连接保活配置（可选，nil 表示不主动探测）
+	Keepalive *KeepaliveConfig
+
+	// MaxRecvMsgSize 单个响应消息的最大字节数，0 表示使用 gRPC 默认值（4MB）
+	MaxRecvMsgSize int
+
+	// MaxSendMsgSize 单个请求消息的最大字节数，0 表示使用 gRPC 默认值（不限制）
+	MaxSendMsgSize int
+
+	// LoadBalancer 负载均衡策略（LoadBalancerWRR 等），为空时使用 kratos 全局 selector（默认 wrr）
+	LoadBalancer string
+
+	// SubsetSize 服务发现时每个客户端最多连接的实例数，0 表示使用默认值 25，负数表示连接全部实例
+	SubsetSize int
+
+	// Methods 按方法配置的调用策略（可选），键为完整方法名如 "/merchant.v1.Tenant/GetTenant"，
+	// 或以 "/*" 结尾匹配整个服务如 "/merchant.v1.Tenant/*"
+	Methods map[string]*MethodPolicy
 }
 
 // NewServiceConfig 创建新的服务配置
@@ -30,10 +163,10 @@ type ServiceConfig struct {
 //   - serviceName: 服务名称（用于服务发现）
 //
 // 返回:
-//   - *ServiceConfig: 配置实例
+//   - *ServiceConfig: 配置实例，端点默认为 "discovery:///服务名"，可通过 SetEndpointOverride 覆盖
 func NewServiceConfig(serviceName string) *ServiceConfig {
 	return &ServiceConfig{
-		Endpoint:    fmt.Sprintf("discovery:///%s", serviceName),
+		Endpoint:    defaultEndpoint(serviceName),
 		ServiceName: serviceName,
 		Timeout:     DefaultTimeout,
 	}
@@ -47,6 +180,64 @@ func (c *ServiceConfig) Validate() error {
 	if c.Timeout <= 0 {
 		c.Timeout = DefaultTimeout
 	}
+	if err := c.Retry.validate(); err != nil {
+		return err
+	}
+	if c.CircuitBreaker != nil {
+		if c.CircuitBreaker.FailureThreshold <= 0 {
+			c.CircuitBreaker.FailureThreshold = DefaultBreakerFailureThreshold
+		}
+		if c.CircuitBreaker.OpenDuration <= 0 {
+			c.CircuitBreaker.OpenDuration = DefaultBreakerOpenDuration
+		}
+	}
+	if c.TLS != nil {
+		if err := c.TLS.Validate(); err != nil {
+			return err
+		}
+	}
+	if c.Keepalive != nil && (c.Keepalive.Time < 0 || c.Keepalive.Timeout < 0) {
+		return fmt.Errorf("保活时间不能为负数")
+	}
+	if c.MaxRecvMsgSize < 0 || c.MaxSendMsgSize < 0 {
+		return fmt.Errorf("消息大小限制不能为负数")
+	}
+	switch c.LoadBalancer {
+	case "", LoadBalancerWRR, LoadBalancerP2C, LoadBalancerRandom, LoadBalancerRoundRobin, LoadBalancerPickFirst:
+	default:
+		return fmt.Errorf("不支持的负载均衡策略: %s", c.LoadBalancer)
+	}
+	for method, policy := range c.Methods {
+		if policy == nil {
+			continue
+		}
+		if err := policy.Retry.validate(); err != nil {
+			return fmt.Errorf("方法 %s: %w", method, err)
+		}
+		if policy.Hedging != nil && policy.Hedging.Delay <= 0 {
+			policy.Hedging.Delay = DefaultHedgingDelay
+		}
+	}
+	return nil
+}
+
+// validate 验证重试配置并填充默认值，nil 时不做处理
+func (r *RetryConfig) validate() error {
+	if r == nil {
+		return nil
+	}
+	if r.MaxRetries < 0 {
+		return fmt.Errorf("重试次数不能为负数")
+	}
+	if r.Backoff <= 0 {
+		r.Backoff = DefaultRetryBackoff
+	}
+	if r.MaxBackoff < r.Backoff {
+		r.MaxBackoff = DefaultRetryMaxBackoff
+		if r.MaxBackoff < r.Backoff {
+			r.MaxBackoff = r.Backoff
+		}
+	}
 	return nil
 }
 
@@ -66,7 +257,7 @@ func (c *ServiceConfig) WithEndpoint(endpoint string) *ServiceConfig {
 // WithServiceName 设置服务名称
 func (c *ServiceConfig) WithServiceName(name string) *ServiceConfig {
 	c.ServiceName = name
-	c.Endpoint = fmt.Sprintf("discovery:///%s", name)
+	c.Endpoint = defaultEndpoint(name)
 	return c
 }
 
@@ -76,11 +267,245 @@ func (c *ServiceConfig) WithTimeout(timeout time.Duration) *ServiceConfig {
 	return c
 }
 
+// WithRetry 设置重试策略
+//
+// 参数:
+//   - maxRetries: 最大重试次数（不含首次调用）
+//   - backoff: 首次重试前的退避时间，之后按指数增长
+//
+// 说明:
+//   - 默认仅对 Unavailable、Aborted 重试，可通过 WithRetryPolicy 的 RetryableCodes 修改
+func (c *ServiceConfig) WithRetry(maxRetries int, backoff time.Duration) *ServiceConfig {
+	c.Retry = &RetryConfig{
+		MaxRetries: maxRetries,
+		Backoff:    backoff,
+	}
+	return c
+}
+
+// WithRetryPolicy 设置完整的重试策略，可指定退避上限和可重试的状态码
+//
+// 使用示例:
+//
+//	config := common.NewServiceConfig("resource-service").WithRetryPolicy(&common.RetryConfig{
+//	    MaxRetries:     3,
+//	    Backoff:        100 * time.Millisecond,
+//	    MaxBackoff:     time.Second,
+//	    RetryableCodes: []codes.Code{codes.Unavailable, codes.DeadlineExceeded},
+//	})
+func (c *ServiceConfig) WithRetryPolicy(policy *RetryConfig) *ServiceConfig {
+	c.Retry = policy
+	return c
+}
+
+// WithCircuitBreaker 设置熔断器
+//
+// 参数:
+//   - failureThreshold: 连续失败多少次后打开熔断
+//   - openDuration: 熔断打开时长，到期后放行一次探测请求
+func (c *ServiceConfig) WithCircuitBreaker(failureThreshold int, openDuration time.Duration) *ServiceConfig {
+	c.CircuitBreaker = &CircuitBreakerConfig{
+		FailureThreshold: failureThreshold,
+		OpenDuration:     openDuration,
+	}
+	return c
+}
+
+// WithMethodRetry 为指定方法设置重试策略，覆盖 Retry
+//
+// 参数:
+//   - method: 完整方法名，或以 "/*" 结尾匹配整个服务
+//   - maxRetries: 最大重试次数（不含首次调用），0 表示该方法不重试
+//   - backoff: 首次重试前的退避时间，之后按指数增长
+//
+// 说明:
+//   - 非幂等的写接口（如创建订单）应设置 maxRetries 为 0，避免重复执行
+func (c *ServiceConfig) WithMethodRetry(method string, maxRetries int, backoff time.Duration) *ServiceConfig {
+	c.methodPolicy(method).Retry = &RetryConfig{
+		MaxRetries: maxRetries,
+		Backoff:    backoff,
+	}
+	return c
+}
+
+// WithMethodHedging 为指定方法开启对冲请求
+//
+// 参数:
+//   - method: 完整方法名，或以 "/*" 结尾匹配整个服务
+//   - maxAttempts: 最多同时发出的请求数（含首次请求）
+//   - delay: 发出下一个对冲请求前的等待时间
+//
+// 使用示例:
+//
+//	config := common.NewServiceConfig("merchant-service").
+//	    WithRetry(2, 100*time.Millisecond).
+//	    WithMethodRetry("/merchant.v1.Tenant/CreateTenant", 0, 0).
+//	    WithMethodHedging("/merchant.v1.Tenant/GetTenant", 2, 30*time.Millisecond)
+func (c *ServiceConfig) WithMethodHedging(method string, maxAttempts int, delay time.Duration) *ServiceConfig {
+	c.methodPolicy(method).Hedging = &HedgingConfig{
+		MaxAttempts: maxAttempts,
+		Delay:       delay,
+	}
+	return c
+}
+
+// methodPolicy 获取或创建方法的调用策略
+func (c *ServiceConfig) methodPolicy(method string) *MethodPolicy {
+	if c.Methods == nil {
+		c.Methods = make(map[string]*MethodPolicy)
+	}
+	policy := c.Methods[method]
+	if policy == nil {
+		policy = &MethodPolicy{}
+		c.Methods[method] = policy
+	}
+	return policy
+}
+
+// MethodPolicy 获取方法的调用策略，优先精确匹配，其次匹配 "/服务名/*"，未配置时返回 nil
+func (c *ServiceConfig) MethodPolicy(method string) *MethodPolicy {
+	if policy, ok := c.Methods[method]; ok {
+		return policy
+	}
+	if i := strings.LastIndex(method, "/"); i > 0 {
+		return c.Methods[method[:i]+"/*"]
+	}
+	return nil
+}
+
+// MethodRetry 获取方法的重试策略，未单独配置时返回 Retry
+func (c *ServiceConfig) MethodRetry(method string) *RetryConfig {
+	if policy := c.MethodPolicy(method); policy != nil && policy.Retry != nil {
+		return policy.Retry
+	}
+	return c.Retry
+}
+
+// WithoutMetrics 关闭客户端调用指标
+func (c *ServiceConfig) WithoutMetrics() *ServiceConfig {
+	c.DisableMetrics = true
+	return c
+}
+
+// WithTLS 使用 TLS 连接服务
+//
+// 参数:
+//   - tlsConfig: TLS 配置，配置客户端证书时为 mTLS
+//
+// 使用示例:
+//
+//	config := common.NewServiceConfig("resource-service").WithTLS(&common.TLSConfig{
+//	    CAFile:     "/etc/certs/ca.pem",
+//	    CertFile:   "/etc/certs/client.pem",
+//	    KeyFile:    "/etc/certs/client-key.pem",
+//	    ServerName: "resource.internal",
+//	})
+func (c *ServiceConfig) WithTLS(tlsConfig *TLSConfig) *ServiceConfig {
+	c.TLS = tlsConfig
+	return c
+}
+
+// WithKeepalive 设置连接保活
+//
+// 参数:
+//   - interval: 空闲多久后发送 ping，gRPC 要求不小于 10 秒
+//   - timeout: 等待 ping 响应的超时时间
+func (c *ServiceConfig) WithKeepalive(interval, timeout time.Duration) *ServiceConfig {
+	c.Keepalive = &KeepaliveConfig{
+		Time:                interval,
+		Timeout:             timeout,
+		PermitWithoutStream: true,
+	}
+	return c
+}
+
+// WithMaxMsgSize 设置单个消息的最大字节数
+//
+// 参数:
+//   - recv: 响应消息上限，如批量查询文件元数据时需调大
+//   - send: 请求消息上限
+//
+// 使用示例:
+//
+//	config := common.NewServiceConfig("resource-service").WithMaxMsgSize(32<<20, 16<<20)
+func (c *ServiceConfig) WithMaxMsgSize(recv, send int) *ServiceConfig {
+	c.MaxRecvMsgSize = recv
+	c.MaxSendMsgSize = send
+	return c
+}
+
+// WithLoadBalancer 设置负载均衡策略
+//
+// 参数:
+//   - policy: 负载均衡策略，如 LoadBalancerP2C、LoadBalancerRoundRobin
+//   - subsetSize: 服务发现时每个客户端最多连接的实例数，0 表示使用默认值，负数表示连接全部实例
+//
+// 使用示例:
+//
+//	config := common.NewServiceConfig("resource-server").WithLoadBalancer(common.LoadBalancerP2C, 10)
+func (c *ServiceConfig) WithLoadBalancer(policy string, subsetSize int) *ServiceConfig {
+	c.LoadBalancer = policy
+	c.SubsetSize = subsetSize
+	return c
+}
+
+// WithTracing 开启客户端链路追踪
+func (c *ServiceConfig) WithTracing() *ServiceConfig {
+	c.EnableTracing = true
+	return c
+}
+
 // Copy 创建配置的副本
 func (c *ServiceConfig) Copy() *ServiceConfig {
-	return &ServiceConfig{
-		Endpoint:    c.Endpoint,
-		ServiceName: c.ServiceName,
-		Timeout:     c.Timeout,
+	cp := &ServiceConfig{
+		Endpoint:       c.Endpoint,
+		ServiceName:    c.ServiceName,
+		Timeout:        c.Timeout,
+		DisableMetrics: c.DisableMetrics,
+		EnableTracing:  c.EnableTracing,
+		MaxRecvMsgSize: c.MaxRecvMsgSize,
+		MaxSendMsgSize: c.MaxSendMsgSize,
+		LoadBalancer:   c.LoadBalancer,
+		SubsetSize:     c.SubsetSize,
+	}
+	if c.Retry != nil {
+		cp.Retry = c.Retry.copy()
+	}
+	if c.CircuitBreaker != nil {
+		breaker := *c.CircuitBreaker
+		cp.CircuitBreaker = &breaker
+	}
+	if c.TLS != nil {
+		tlsConfig := *c.TLS
+		cp.TLS = &tlsConfig
+	}
+	if c.Keepalive != nil {
+		keepalive := *c.Keepalive
+		cp.Keepalive = &keepalive
 	}
-}
\ No newline at end of file
+	if c.Methods != nil {
+		cp.Methods = make(map[string]*MethodPolicy, len(c.Methods))
+		for method, policy := range c.Methods {
+			if policy == nil {
+				continue
+			}
+			p := &MethodPolicy{}
+			if policy.Retry != nil {
+				p.Retry = policy.Retry.copy()
+			}
+			if policy.Hedging != nil {
+				hedging := *policy.Hedging
+				p.Hedging = &hedging
+			}
+			cp.Methods[method] = p
+		}
+	}
+	return cp
+}
+
+// copy 复制重试配置
+func (r *RetryConfig) copy() *RetryConfig {
+	cp := *r
+	cp.RetryableCodes = append([]codes.Code(nil), r.RetryableCodes...)
+	return &cp
+}
@@ -0,0 +1,75 @@
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+)
+
+// TLSConfig 客户端 TLS 配置
+//
+// 只配置 CAFile 时为单向 TLS（校验服务端证书），同时配置 CertFile/KeyFile 时为双向 TLS（mTLS）
+type TLSConfig struct {
+	// CAFile 用于校验服务端证书的 CA 证书文件（PEM），为空时使用系统根证书
+	CAFile string
+
+	// CertFile 客户端证书文件（PEM），mTLS 时必填
+	CertFile string
+
+	// KeyFile 客户端私钥文件（PEM），mTLS 时必填
+	KeyFile string
+
+	// ServerName 校验服务端证书时使用的主机名，为空时使用连接地址的主机名
+	// 通过服务发现连接时，实例地址通常为 IP，需设置为证书中的域名
+	ServerName string
+
+	// InsecureSkipVerify 跳过服务端证书校验，仅用于测试环境
+	InsecureSkipVerify bool
+}
+
+// Validate 验证 TLS 配置
+func (c *TLSConfig) Validate() error {
+	if (c.CertFile == "") != (c.KeyFile == "") {
+		return fmt.Errorf("客户端证书和私钥必须同时配置")
+	}
+	return nil
+}
+
+// Build 加载证书并创建 tls.Config
+//
+// 返回:
+//   - *tls.Config: TLS 配置
+//   - error: 证书文件读取或解析失败
+func (c *TLSConfig) Build() (*tls.Config, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+
+	conf := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         c.ServerName,
+		InsecureSkipVerify: c.InsecureSkipVerify,
+	}
+
+	if c.CAFile != "" {
+		pem, err := os.ReadFile(c.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("读取 CA 证书失败: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("解析 CA 证书失败: %s", c.CAFile)
+		}
+		conf.RootCAs = pool
+	}
+
+	if c.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("加载客户端证书失败: %w", err)
+		}
+		conf.Certificates = []tls.Certificate{cert}
+	}
+	return conf, nil
+}
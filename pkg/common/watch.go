@@ -0,0 +1,127 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 监听失败时的退避时间，连续失败时按指数增长，测试中可修改
+var (
+	watchRetryBackoff = time.Second
+	watchMaxBackoff   = 30 * time.Second
+)
+
+// ServiceConfigChangeFunc 服务配置变更回调，参数为变更后的全部服务配置
+type ServiceConfigChangeFunc func(configs map[string]*ServiceConfig)
+
+// WatchServiceConfig 监听服务配置变更
+//
+// 配置格式与 LoadServiceConfigsFromFile 一致。启动时先同步加载一次并回调，
+// 之后在配置源变化且解析结果与上次不同时回调。方法会阻塞直到 ctx 取消
+//
+// 参数:
+//   - ctx: 上下文，取消后停止监听
+//   - source: 配置源，如 file.NewSource(path) 或 consul.New(client, consul.WithPath("configs/services"))
+//   - onChange: 变更回调（在监听协程中同步调用），可传入 clients.Factory.UpdateConfigs
+//
+// 返回:
+//   - error: 首次加载失败时返回错误；ctx 取消时返回 nil
+//
+// 使用示例:
+//
+//	factory := clients.NewClientFactory(discovery, nil)
+//	go func() {
+//	    err := common.WatchServiceConfig(ctx, file.NewSource("configs/services.yaml"), factory.UpdateConfigs)
+//	    if err != nil {
+//	        log.Errorf("监听服务配置失败: %v", err)
+//	    }
+//	}()
+//
+// 说明:
+//   - 变更后的配置无效（解析或验证失败）时记录日志并保留上次的配置
+//   - 配置源监听出错时按指数退避（1 秒起，最长 30 秒）后继续监听
+func WatchServiceConfig(ctx context.Context, source config.Source, onChange ServiceConfigChangeFunc) error {
+	if source == nil {
+		return fmt.Errorf("配置源不能为空")
+	}
+	if onChange == nil {
+		return fmt.Errorf("变更回调不能为空")
+	}
+
+	kvs, err := source.Load()
+	if err != nil {
+		return fmt.Errorf("加载服务配置失败: %w", err)
+	}
+	current, err := decodeServiceConfigs(kvs)
+	if err != nil {
+		return err
+	}
+	onChange(current)
+
+	watcher, err := source.Watch()
+	if err != nil {
+		return fmt.Errorf("监听服务配置失败: %w", err)
+	}
+	stopped := make(chan struct{})
+	defer close(stopped)
+	go func() {
+		select {
+		case <-ctx.Done():
+			watcher.Stop()
+		case <-stopped:
+		}
+	}()
+
+	// 目录配置源每次只返回变化的文件，按 key 合并后再解析
+	latest := make(map[string]*config.KeyValue, len(kvs))
+	for _, kv := range kvs {
+		latest[kv.Key] = kv
+	}
+	backoff := watchRetryBackoff
+	for {
+		changed, err := watcher.Next()
+		if ctx.Err() != nil {
+			return nil
+		}
+		if err != nil {
+			log.Errorf("监听服务配置失败，%s 后重试: %v", backoff, err)
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(backoff):
+			}
+			backoff = min(backoff*2, watchMaxBackoff)
+			continue
+		}
+		backoff = watchRetryBackoff
+
+		for _, kv := range changed {
+			// 文件被截断后尚未写入内容，等待下一次变更
+			if len(kv.Value) == 0 {
+				continue
+			}
+			latest[kv.Key] = kv
+		}
+		all := make([]*config.KeyValue, 0, len(latest))
+		for _, kv := range latest {
+			all = append(all, kv)
+		}
+		sort.Slice(all, func(i, j int) bool { return all[i].Key < all[j].Key })
+		configs, err := decodeServiceConfigs(all)
+		if err != nil {
+			log.Errorf("服务配置无效，保留上次的配置: %v", err)
+			continue
+		}
+		if reflect.DeepEqual(configs, current) {
+			continue
+		}
+		current = configs
+		onChange(configs)
+	}
+}
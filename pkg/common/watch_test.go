@@ -0,0 +1,99 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/config/file"
+)
+
+// failingSource 加载成功，监听始终返回错误
+type failingSource struct {
+	nexts int
+}
+
+func (s *failingSource) Load() ([]*config.KeyValue, error) {
+	return []*config.KeyValue{{Key: "services.yaml", Format: "yaml", Value: []byte("resource:\n  endpoint: localhost:9000\n")}}, nil
+}
+
+func (s *failingSource) Watch() (config.Watcher, error) { return s, nil }
+
+func (s *failingSource) Next() ([]*config.KeyValue, error) {
+	s.nexts++
+	return nil, errors.New("watch failed")
+}
+
+func (s *failingSource) Stop() error { return nil }
+
+func TestWatchServiceConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "services.yaml")
+	if err := os.WriteFile(path, []byte("resource:\n  endpoint: localhost:9000\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := make(chan map[string]*ServiceConfig, 4)
+	done := make(chan error, 1)
+	go func() {
+		done <- WatchServiceConfig(ctx, file.NewSource(path), func(configs map[string]*ServiceConfig) {
+			changes <- configs
+		})
+	}()
+
+	next := func() map[string]*ServiceConfig {
+		select {
+		case configs := <-changes:
+			return configs
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for config change")
+			return nil
+		}
+	}
+
+	if configs := next(); configs["resource"].Endpoint != "localhost:9000" {
+		t.Fatalf("Unexpected initial config: %+v", configs["resource"])
+	}
+
+	// 无效配置不回调，之后的有效配置正常回调
+	if err := os.WriteFile(path, []byte("resource:\n  timeout: 5\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte("resource:\n  endpoint: localhost:9001\n  timeout: 3s\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	configs := next()
+	if configs["resource"].Endpoint != "localhost:9001" || configs["resource"].Timeout != 3*time.Second {
+		t.Errorf("Unexpected updated config: %+v", configs["resource"])
+	}
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Errorf("Expected nil after cancel, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("WatchServiceConfig did not return after cancel")
+	}
+}
+
+func TestWatchServiceConfig_Backoff(t *testing.T) {
+	watchRetryBackoff, watchMaxBackoff = 10*time.Millisecond, 40*time.Millisecond
+	defer func() { watchRetryBackoff, watchMaxBackoff = time.Second, 30*time.Second }()
+
+	source := &failingSource{}
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	if err := WatchServiceConfig(ctx, source, func(map[string]*ServiceConfig) {}); err != nil {
+		t.Fatalf("WatchServiceConfig failed: %v", err)
+	}
+	// 10+20+40+40+40... 毫秒，200 毫秒内最多重试约 7 次
+	if source.nexts > 10 {
+		t.Errorf("Expected backoff between watch errors, got %d calls", source.nexts)
+	}
+}
@@ -11,12 +11,29 @@ type BusinessError struct {
 	Message  string `json:"message"`   // 错误消息
 	Type     string `json:"type"`      // 错误类型
 	HttpCode int32  `json:"http_code"` // 对应的HTTP状态码
+
+	MessageKey string            `json:"message_key,omitempty"` // 错误信息的消息键，为空时使用 Type
+	Metadata   map[string]string `json:"metadata,omitempty"`    // 错误详情，如 {"user_id": "123"}
+
+	cause error
+	args  []interface{} // 错误信息模板参数，见 WithArgs
 }
 
 func (e *BusinessError) Error() string {
 	return e.Message
 }
 
+// Unwrap 返回原始错误
+func (e *BusinessError) Unwrap() error {
+	return e.cause
+}
+
+// Is 错误类型（Type）相同即视为同一错误，支持 errors.Is(err, ErrUserNotFound)
+func (e *BusinessError) Is(target error) bool {
+	t, ok := target.(*BusinessError)
+	return ok && e.Type != "" && e.Type == t.Type
+}
+
 // 预定义的业务错误
 var (
 	// 用户相关错误 (10001-10099)
@@ -32,6 +49,8 @@ var (
 	ErrTenantDisabled      = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_TENANT_DISABLED), Message: "租户已被禁用", Type: "TENANT_DISABLED", HttpCode: 403}
 	ErrTenantPending       = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_TENANT_PENDING), Message: "租户待审核", Type: "TENANT_PENDING", HttpCode: 403}
 	ErrTenantRejected      = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_TENANT_REJECTED), Message: "租户申请被拒绝", Type: "TENANT_REJECTED", HttpCode: 403}
+	ErrTenantExpired       = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_TENANT_EXPIRED), Message: "租户服务已过期", Type: "TENANT_EXPIRED", HttpCode: 403}
+	ErrTenantSuspended     = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_TENANT_SUSPENDED), Message: "租户已被暂停", Type: "TENANT_SUSPENDED", HttpCode: 403}
 
 	// 权限相关错误 (10200-10299)
 	ErrPermissionDenied   = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_PERMISSION_DENIED), Message: "权限不足", Type: "PERMISSION_DENIED", HttpCode: 403}
@@ -61,17 +80,20 @@ var (
 	ErrInvalidPhone     = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_INVALID_PHONE), Message: "手机号格式错误", Type: "INVALID_PHONE", HttpCode: 400}
 
 	// 数据相关错误 (10500-10599)
-	ErrDataNotFound   = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_DATA_NOT_FOUND), Message: "数据不存在", Type: "DATA_NOT_FOUND", HttpCode: 404}
-	ErrDataConflict   = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_DATA_CONFLICT), Message: "数据冲突", Type: "DATA_CONFLICT", HttpCode: 409}
-	ErrDataInvalid    = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_DATA_INVALID), Message: "数据无效", Type: "DATA_INVALID", HttpCode: 400}
-	ErrDataDuplicate  = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_DATA_DUPLICATE), Message: "数据重复", Type: "DATA_DUPLICATE", HttpCode: 409}
-	ErrDataConstraint = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_DATA_CONSTRAINT), Message: "数据约束错误", Type: "DATA_CONSTRAINT", HttpCode: 400}
+	ErrDataNotFound           = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_DATA_NOT_FOUND), Message: "数据不存在", Type: "DATA_NOT_FOUND", HttpCode: 404}
+	ErrDataConflict           = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_DATA_CONFLICT), Message: "数据冲突", Type: "DATA_CONFLICT", HttpCode: 409}
+	ErrDataInvalid            = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_DATA_INVALID), Message: "数据无效", Type: "DATA_INVALID", HttpCode: 400}
+	ErrDataDuplicate          = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_DATA_DUPLICATE), Message: "数据重复", Type: "DATA_DUPLICATE", HttpCode: 409}
+	ErrDataConstraint         = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_DATA_CONSTRAINT), Message: "数据约束错误", Type: "DATA_CONSTRAINT", HttpCode: 400}
+	ErrIdempotencyInProgress  = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_IDEMPOTENCY_IN_PROGRESS), Message: "相同幂等键的请求正在处理中", Type: "IDEMPOTENCY_IN_PROGRESS", HttpCode: 409}
+	ErrIdempotencyKeyMismatch = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_IDEMPOTENCY_KEY_MISMATCH), Message: "幂等键已用于不同的请求", Type: "IDEMPOTENCY_KEY_MISMATCH", HttpCode: 422}
 
 	// 系统相关错误 (19900-19999)
 	ErrSystemError        = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_SYSTEM_ERROR), Message: "系统错误", Type: "SYSTEM_ERROR", HttpCode: 500}
 	ErrServiceUnavailable = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_SERVICE_UNAVAILABLE), Message: "服务不可用", Type: "SERVICE_UNAVAILABLE", HttpCode: 503}
 	ErrDatabaseError      = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_DATABASE_ERROR), Message: "数据库错误", Type: "DATABASE_ERROR", HttpCode: 500}
 	ErrNetworkError       = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_NETWORK_ERROR), Message: "网络错误", Type: "NETWORK_ERROR", HttpCode: 500}
+	ErrTooManyRequests    = &BusinessError{Code: convertToInt32(commonV1.ErrorCode_TOO_MANY_REQUESTS), Message: "请求过于频繁", Type: "TOO_MANY_REQUESTS", HttpCode: 429}
 )
 
 // 错误分类函数
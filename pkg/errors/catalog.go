@@ -0,0 +1,181 @@
+package errors
+
+import (
+	"fmt"
+	"sync"
+)
+
+// catalog 已注册的业务错误定义和错误信息
+var catalog = struct {
+	sync.RWMutex
+	defs     map[string]*BusinessError    // Type -> 错误定义
+	messages map[string]string            // 消息键 -> 默认语言的错误信息
+	locales  map[string]map[string]string // 语言 -> 消息键 -> 错误信息模板
+}{
+	defs:     make(map[string]*BusinessError),
+	messages: make(map[string]string),
+	locales:  make(map[string]map[string]string),
+}
+
+func init() {
+	Register(
+		ErrUserNotFound, ErrUserAlreadyExists, ErrInvalidPassword, ErrUserDisabled, ErrUserDeleted,
+		ErrTenantNotFound, ErrTenantAlreadyExists, ErrTenantDisabled, ErrTenantPending, ErrTenantRejected,
+		ErrTenantExpired, ErrTenantSuspended,
+		ErrPermissionDenied, ErrRoleNotFound, ErrRoleDisabled, ErrPermissionNotFound,
+		ErrInvalidCredentials, ErrTokenExpired, ErrTokenInvalid, ErrTokenRevoked, ErrAccountLocked,
+		ErrAuthHeaderMissing, ErrAuthHeaderInvalid, ErrAuthServiceError, ErrUserTypeUndefined,
+		ErrAccessForbidden, ErrTenantMissing, ErrTenantInvalid, ErrRegisterFailed,
+		ErrInvalidParameter, ErrMissingParameter, ErrInvalidFormat, ErrInvalidEmail, ErrInvalidPhone,
+		ErrDataNotFound, ErrDataConflict, ErrDataInvalid, ErrDataDuplicate, ErrDataConstraint,
+		ErrIdempotencyInProgress, ErrIdempotencyKeyMismatch,
+		ErrSystemError, ErrServiceUnavailable, ErrDatabaseError, ErrNetworkError, ErrTooManyRequests,
+	)
+}
+
+// Define 定义并注册业务错误
+//
+// 参数:
+//   - code: 业务错误码，各服务使用各自的号段
+//   - reason: 错误类型，全局唯一，如 "ORDER_NOT_FOUND"
+//   - httpCode: 对应的 HTTP 状态码
+//   - message: 默认错误信息
+//
+// 返回:
+//   - *BusinessError: 错误定义，可直接返回或通过 New 创建带详情的错误
+//
+// 使用示例:
+//
+//	var ErrOrderNotFound = errors.Define(20001, "ORDER_NOT_FOUND", 404, "订单不存在")
+func Define(code int32, reason string, httpCode int32, message string) *BusinessError {
+	def := NewBusinessError(code, message, reason, httpCode)
+	Register(def)
+	return def
+}
+
+// Register 注册业务错误定义，注册后可通过 New 按 Type 创建错误，
+// 并在 FromError 中将下游服务返回的错误还原为业务错误
+//
+// 说明:
+//   - Type 为空，或 Type 已注册为不同的错误码时 panic，应在包初始化时调用
+//   - 定义的 Message 同时注册为其消息键（MessageKey，为空时为 Type）的错误信息
+func Register(defs ...*BusinessError) {
+	catalog.Lock()
+	defer catalog.Unlock()
+
+	for _, def := range defs {
+		if def == nil || def.Type == "" {
+			panic("errors: 业务错误类型不能为空")
+		}
+		if existing, ok := catalog.defs[def.Type]; ok && (existing.Code != def.Code || existing.HttpCode != def.HttpCode) {
+			panic(fmt.Sprintf("errors: 业务错误类型重复注册: %s", def.Type))
+		}
+		catalog.defs[def.Type] = def
+		catalog.messages[def.messageKey()] = def.Message
+	}
+}
+
+// RegisterMessages 注册默认语言（DefaultLocale）的错误信息，键为消息键
+//
+// 用于同一错误类型在不同场景下使用不同的错误信息，其他语言的错误信息通过 RegisterLocale 注册
+//
+// 使用示例:
+//
+//	errors.RegisterMessages(map[string]string{
+//	    "USER_NOT_FOUND.email": "该邮箱未注册",
+//	})
+//	return errors.New("USER_NOT_FOUND", "USER_NOT_FOUND.email", map[string]string{"email": email})
+func RegisterMessages(messages map[string]string) {
+	catalog.Lock()
+	defer catalog.Unlock()
+
+	for key, message := range messages {
+		catalog.messages[key] = message
+	}
+}
+
+// Lookup 按错误类型查找已注册的错误定义
+func Lookup(reason string) (*BusinessError, bool) {
+	catalog.RLock()
+	defer catalog.RUnlock()
+
+	def, ok := catalog.defs[reason]
+	return def, ok
+}
+
+// New 按已注册的错误类型创建业务错误
+//
+// 参数:
+//   - reason: 错误类型，如 "USER_NOT_FOUND"
+//   - msgKey: 错误信息的消息键，为空时使用错误定义的默认信息
+//   - metadata: 错误详情，会随错误返回给调用方，可为 nil
+//
+// 返回:
+//   - *BusinessError: 新的错误实例，修改它不影响错误定义
+//
+// 使用示例:
+//
+//	return nil, errors.New("USER_NOT_FOUND", "", map[string]string{"user_id": id})
+//
+// 说明:
+//   - reason 未注册时返回 500 错误，Type 为 reason，Code 为 SYSTEM_ERROR 的错误码
+//   - msgKey 未注册错误信息时使用错误定义的默认信息
+func New(reason, msgKey string, metadata map[string]string) *BusinessError {
+	def, ok := Lookup(reason)
+	if !ok {
+		def = &BusinessError{Code: ErrSystemError.Code, Message: reason, Type: reason, HttpCode: ErrSystemError.HttpCode}
+	}
+
+	e := def.clone()
+	if msgKey != "" {
+		e.MessageKey = msgKey
+		catalog.RLock()
+		if message, ok := catalog.messages[msgKey]; ok {
+			e.Message = message
+		}
+		catalog.RUnlock()
+	}
+	e.Metadata = copyMetadata(metadata)
+	return e
+}
+
+// WithMetadata 返回设置了错误详情的副本
+func (e *BusinessError) WithMetadata(metadata map[string]string) *BusinessError {
+	cp := e.clone()
+	cp.Metadata = copyMetadata(metadata)
+	return cp
+}
+
+// WithCause 返回设置了原始错误的副本，原始错误不会返回给调用方，可通过 errors.Unwrap 获取
+func (e *BusinessError) WithCause(cause error) *BusinessError {
+	cp := e.clone()
+	cp.cause = cause
+	return cp
+}
+
+// messageKey 获取消息键，未设置时使用 Type
+func (e *BusinessError) messageKey() string {
+	if e.MessageKey != "" {
+		return e.MessageKey
+	}
+	return e.Type
+}
+
+// clone 复制错误，Metadata 深拷贝
+func (e *BusinessError) clone() *BusinessError {
+	cp := *e
+	cp.Metadata = copyMetadata(e.Metadata)
+	return &cp
+}
+
+// copyMetadata 复制 metadata，为空时返回 nil
+func copyMetadata(md map[string]string) map[string]string {
+	if len(md) == 0 {
+		return nil
+	}
+	cp := make(map[string]string, len(md))
+	for k, v := range md {
+		cp[k] = v
+	}
+	return cp
+}
@@ -0,0 +1,155 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var errOrderNotFound = Define(20001, "TEST_ORDER_NOT_FOUND", 404, "订单不存在")
+
+func TestNew(t *testing.T) {
+	RegisterMessages(map[string]string{"TEST_ORDER_NOT_FOUND.archived": "订单已归档"})
+
+	e := New("TEST_ORDER_NOT_FOUND", "", map[string]string{"order_id": "1"})
+	if e.Code != 20001 || e.HttpCode != 404 || e.Message != "订单不存在" || e.Metadata["order_id"] != "1" {
+		t.Fatalf("Unexpected error: %+v", e)
+	}
+	if errOrderNotFound.Metadata != nil {
+		t.Error("New should not modify the definition")
+	}
+
+	if e := New("TEST_ORDER_NOT_FOUND", "TEST_ORDER_NOT_FOUND.archived", nil); e.Message != "订单已归档" || e.MessageKey != "TEST_ORDER_NOT_FOUND.archived" {
+		t.Errorf("Expected message from message key, got %+v", e)
+	}
+	if e := New("TEST_UNREGISTERED", "", nil); e.HttpCode != 500 || e.Code != ErrSystemError.Code || e.Type != "TEST_UNREGISTERED" {
+		t.Errorf("Unexpected error for unregistered reason: %+v", e)
+	}
+
+	if def, ok := Lookup("USER_NOT_FOUND"); !ok || def != ErrUserNotFound {
+		t.Error("Expected predefined errors to be registered")
+	}
+}
+
+func TestRegister_Conflict(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for conflicting registration")
+		}
+	}()
+	Register(NewBusinessError(20002, "订单不存在", "TEST_ORDER_NOT_FOUND", 404))
+}
+
+func TestConvert(t *testing.T) {
+	e := ErrIdempotencyKeyMismatch.WithMetadata(map[string]string{"key": "k1"})
+
+	ke := e.ToKratos()
+	if ke.Code != 422 || ke.Reason != "IDEMPOTENCY_KEY_MISMATCH" || ke.Metadata["key"] != "k1" {
+		t.Fatalf("Unexpected kratos error: %v", ke)
+	}
+
+	// 422 没有对应的 gRPC 状态码，经 gRPC 传递后按注册的定义还原
+	st := status.Convert(e)
+	if len(st.Details()) == 0 {
+		t.Fatalf("Expected GRPCStatus with ErrorInfo, got %v", st)
+	}
+	got := FromError(st.Err())
+	if got.Code != ErrIdempotencyKeyMismatch.Code || got.HttpCode != 422 || got.Metadata["key"] != "k1" {
+		t.Errorf("Unexpected error after round trip: %+v", got)
+	}
+
+	tests := []struct {
+		name   string
+		err    error
+		code   int32
+		reason string
+	}{
+		{"business", fmt.Errorf("get: %w", ErrUserNotFound), 404, "USER_NOT_FOUND"},
+		{"kratos registered", kratosErrors.BadRequest("TEST_ORDER_NOT_FOUND", "订单不存在"), 404, "TEST_ORDER_NOT_FOUND"},
+		{"kratos unregistered", kratosErrors.Forbidden("QUOTA_EXCEEDED", "配额不足"), 403, "QUOTA_EXCEEDED"},
+		{"grpc code", status.Error(codes.NotFound, "no such plan"), 404, "DATA_NOT_FOUND"},
+		{"classified", stderrors.New("duplicate key"), 409, "DATA_DUPLICATE"},
+	}
+	for _, tt := range tests {
+		be := FromError(tt.err)
+		if be.HttpCode != tt.code || be.Type != tt.reason {
+			t.Errorf("%s: expected %d %s, got %+v", tt.name, tt.code, tt.reason, be)
+		}
+	}
+
+	if _, ok := AsBusiness(stderrors.New("plain")); ok {
+		t.Error("Expected plain error not to be a business error")
+	}
+	if FromError(nil) != nil {
+		t.Error("Expected nil for nil error")
+	}
+}
+
+func TestIs(t *testing.T) {
+	remote := status.Convert(New("USER_NOT_FOUND", "", map[string]string{"user_id": "1"})).Err()
+	tests := []struct {
+		name   string
+		err    error
+		target error
+		want   bool
+	}{
+		{"same", ErrUserNotFound, ErrUserNotFound, true},
+		{"copy", ErrUserNotFound.WithMetadata(map[string]string{"user_id": "1"}), ErrUserNotFound, true},
+		{"wrapped", fmt.Errorf("get: %w", ErrUserNotFound.WithCause(stderrors.New("no rows"))), ErrUserNotFound, true},
+		{"grpc status", remote, ErrUserNotFound, true},
+		{"different", ErrUserNotFound, ErrUserDeleted, false},
+		{"plain", stderrors.New("user not found"), ErrUserNotFound, false},
+	}
+	for _, tt := range tests {
+		if got := Is(tt.err, tt.target); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	Define(20003, "TEST_QUOTA_EXCEEDED", 403, "配额不足，上限为 %d")
+	RegisterMessages(map[string]string{"TEST_QUOTA_EXCEEDED.daily": "今日配额不足"})
+	RegisterLocale("en", map[string]string{"TEST_QUOTA_EXCEEDED": "Quota exceeded, limit is %d"})
+	RegisterLocale("en_GB", map[string]string{"TEST_QUOTA_EXCEEDED": "Quota exceeded, the limit is %d"})
+
+	e := New("TEST_QUOTA_EXCEEDED", "", nil).WithArgs(10)
+	if e.Message != "配额不足，上限为 10" {
+		t.Errorf("Expected message formatted in default locale, got %q", e.Message)
+	}
+
+	tests := []struct {
+		locale  string
+		err     *BusinessError
+		args    []interface{}
+		message string
+	}{
+		{"en-US", e, nil, "Quota exceeded, limit is 10"},
+		{"en-gb", e, nil, "Quota exceeded, the limit is 10"},
+		{"en", e, []interface{}{20}, "Quota exceeded, limit is 20"},
+		{"zh-CN", e, nil, "配额不足，上限为 10"},
+		{"zh-TW", e, []interface{}{30}, "配额不足，上限为 30"},
+		{"fr", e, nil, "配额不足，上限为 10"},
+		// 消息键未注册该语言时使用错误类型的模板
+		{"en", New("TEST_QUOTA_EXCEEDED", "TEST_QUOTA_EXCEEDED.daily", nil).WithArgs(5), nil, "Quota exceeded, limit is 5"},
+		// 默认语言保留原错误信息
+		{"", WrapError(ErrUserNotFound, "查询失败"), nil, "查询失败: 用户不存在"},
+		{"en", ErrUserNotFound, nil, "User not found"},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Localize(tt.locale, tt.args...).Message; got != tt.message {
+			t.Errorf("%s: expected %q, got %q", tt.locale, tt.message, got)
+		}
+	}
+	if e.Message != "配额不足，上限为 10" {
+		t.Error("Localize should not modify the original error")
+	}
+
+	if _, ok := LocalizedMessage("en", "TEST_UNREGISTERED"); ok {
+		t.Error("Expected unregistered message key not to be found")
+	}
+}
@@ -0,0 +1,122 @@
+package errors
+
+import (
+	stderrors "errors"
+
+	kratosErrors "github.com/go-kratos/kratos/v2/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ToKratos 转换为 kratos 错误，Type 作为 Reason，HttpCode 作为 Code，携带 Metadata
+func (e *BusinessError) ToKratos() *kratosErrors.Error {
+	ke := kratosErrors.New(int(e.HttpCode), e.Type, e.Message).WithMetadata(copyMetadata(e.Metadata))
+	if e.cause != nil {
+		ke = ke.WithCause(e.cause)
+	}
+	return ke
+}
+
+// GRPCStatus 转换为 gRPC 状态，Type 和 Metadata 通过 ErrorInfo 详情传递
+//
+// 业务错误可直接作为 gRPC 或 kratos handler 的返回值，调用方通过 FromError 还原
+func (e *BusinessError) GRPCStatus() *status.Status {
+	return e.ToKratos().GRPCStatus()
+}
+
+// AsBusiness 从错误中获取业务错误
+//
+// 支持错误链中的 *BusinessError，以及携带 Reason 的 kratos 错误和 gRPC 状态（如下游服务返回的错误）。
+// Reason 已注册时使用错误定义的错误码和 HTTP 状态码，错误信息和 Metadata 使用原错误的
+//
+// 使用示例:
+//
+//	_, err := userClient.GetUser(ctx, req)
+//	if be, ok := errors.AsBusiness(err); ok && be.Type == "USER_NOT_FOUND" {
+//	    userID := be.Metadata["user_id"]
+//	}
+func AsBusiness(err error) (*BusinessError, bool) {
+	if err == nil {
+		return nil, false
+	}
+	var be *BusinessError
+	if stderrors.As(err, &be) {
+		return be, true
+	}
+
+	ke := kratosErrors.FromError(err)
+	if ke == nil || ke.Reason == "" {
+		return nil, false
+	}
+	e := &BusinessError{Code: ErrSystemError.Code, Type: ke.Reason, HttpCode: ke.Code}
+	if def, ok := Lookup(ke.Reason); ok {
+		e = def.clone()
+	}
+	e.Message = ke.Message
+	e.Metadata = copyMetadata(ke.Metadata)
+	e.cause = err
+	return e, true
+}
+
+// FromError 将任意错误转换为业务错误
+//
+// 转换规则:
+//   - 业务错误、携带 Reason 的 kratos 错误和 gRPC 状态: 同 AsBusiness
+//   - 其他 gRPC 状态: 按 FromGRPCCode 映射，如 NotFound -> DATA_NOT_FOUND
+//   - 其他错误: 按 ClassifyError 的规则分类
+func FromError(err error) *BusinessError {
+	if err == nil {
+		return nil
+	}
+	if be, ok := AsBusiness(err); ok {
+		return be
+	}
+	if st, ok := status.FromError(err); ok {
+		if be, ok := FromGRPCCode(st.Code()); ok {
+			return be.WithCause(err)
+		}
+	}
+	return ClassifyError(err).WithCause(err)
+}
+
+// Is 判断错误是否为指定的业务错误，错误类型（Type）相同即视为相同
+//
+// 与标准库 errors.Is 不同，下游服务返回的 kratos 错误和 gRPC 状态也会按 Reason 比较
+//
+// 使用示例:
+//
+//	if errors.Is(err, errors.ErrUserNotFound) {
+//	    // ...
+//	}
+func Is(err, target error) bool {
+	if stderrors.Is(err, target) {
+		return true
+	}
+	t, ok := target.(*BusinessError)
+	if !ok {
+		return false
+	}
+	be, ok := AsBusiness(err)
+	return ok && be.Is(t)
+}
+
+// grpcCodeErrors gRPC 状态码对应的业务错误
+var grpcCodeErrors = map[codes.Code]*BusinessError{
+	codes.InvalidArgument:    ErrInvalidParameter,
+	codes.OutOfRange:         ErrInvalidParameter,
+	codes.NotFound:           ErrDataNotFound,
+	codes.AlreadyExists:      ErrDataDuplicate,
+	codes.FailedPrecondition: ErrDataConflict,
+	codes.Aborted:            ErrDataConflict,
+	codes.PermissionDenied:   ErrPermissionDenied,
+	codes.Unauthenticated:    ErrTokenInvalid,
+	codes.ResourceExhausted:  ErrTooManyRequests,
+	codes.Unavailable:        ErrServiceUnavailable,
+	codes.DeadlineExceeded:   ErrServiceUnavailable,
+}
+
+// FromGRPCCode 获取 gRPC 状态码对应的预定义业务错误，没有对应错误时返回 false
+func FromGRPCCode(code codes.Code) (*BusinessError, bool) {
+	e, ok := grpcCodeErrors[code]
+	return e, ok
+}
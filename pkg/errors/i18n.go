@@ -0,0 +1,198 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultLocale 默认语言，错误定义的 Message 和 RegisterMessages 注册的错误信息均为该语言
+const DefaultLocale = "zh-CN"
+
+func init() {
+	RegisterLocale("en", map[string]string{
+		"USER_NOT_FOUND":           "User not found",
+		"USER_ALREADY_EXISTS":      "User already exists",
+		"INVALID_PASSWORD":         "Invalid password format",
+		"USER_DISABLED":            "User is disabled",
+		"USER_DELETED":             "User has been deleted",
+		"TENANT_NOT_FOUND":         "Tenant not found",
+		"TENANT_ALREADY_EXISTS":    "Tenant already exists",
+		"TENANT_DISABLED":          "Tenant is disabled",
+		"TENANT_PENDING":           "Tenant is pending review",
+		"TENANT_REJECTED":          "Tenant application was rejected",
+		"TENANT_EXPIRED":           "Tenant subscription has expired",
+		"TENANT_SUSPENDED":         "Tenant is suspended",
+		"PERMISSION_DENIED":        "Permission denied",
+		"ROLE_NOT_FOUND":           "Role not found",
+		"ROLE_DISABLED":            "Role is disabled",
+		"PERMISSION_NOT_FOUND":     "Permission not found",
+		"INVALID_CREDENTIALS":      "Invalid username or password",
+		"TOKEN_EXPIRED":            "Token has expired",
+		"TOKEN_INVALID":            "Invalid token",
+		"TOKEN_REVOKED":            "Token has been revoked",
+		"ACCOUNT_LOCKED":           "Account is locked",
+		"AUTH_HEADER_MISSING":      "Missing Authorization header",
+		"AUTH_HEADER_INVALID":      "Invalid Authorization header",
+		"AUTH_SERVICE_ERROR":       "Authentication service error",
+		"USER_TYPE_UNDEFINED":      "User type is undefined",
+		"ACCESS_FORBIDDEN":         "Access forbidden",
+		"TENANT_MISSING":           "Missing tenant ID",
+		"TENANT_INVALID":           "Invalid tenant ID",
+		"REGISTER_FAILED":          "Registration failed",
+		"INVALID_PARAMETER":        "Invalid parameter",
+		"MISSING_PARAMETER":        "Missing required parameter",
+		"INVALID_FORMAT":           "Invalid data format",
+		"INVALID_EMAIL":            "Invalid email address",
+		"INVALID_PHONE":            "Invalid phone number",
+		"DATA_NOT_FOUND":           "Data not found",
+		"DATA_CONFLICT":            "Data conflict",
+		"DATA_INVALID":             "Invalid data",
+		"DATA_DUPLICATE":           "Duplicate data",
+		"DATA_CONSTRAINT":          "Data constraint violation",
+		"IDEMPOTENCY_IN_PROGRESS":  "A request with the same idempotency key is in progress",
+		"IDEMPOTENCY_KEY_MISMATCH": "Idempotency key was used for a different request",
+		"SYSTEM_ERROR":             "System error",
+		"SERVICE_UNAVAILABLE":      "Service unavailable",
+		"DATABASE_ERROR":           "Database error",
+		"NETWORK_ERROR":            "Network error",
+		"TOO_MANY_REQUESTS":        "Too many requests",
+	})
+}
+
+// RegisterLocale 注册指定语言的错误信息模板，键为消息键（默认为错误类型 Type）
+//
+// 模板使用 fmt 格式，参数通过 WithArgs 或 Localize 传入。
+// 查找时先匹配完整的语言标签，再匹配主语言，如 "en-US" 依次查找 "en-US"、"en"
+//
+// 参数:
+//   - locale: 语言标签，如 "en"、"en-US"，不区分大小写
+//   - messages: 消息键到错误信息模板的映射，同一语言多次注册时合并
+//
+// 使用示例:
+//
+//	errors.RegisterLocale("en", map[string]string{
+//	    "ORDER_NOT_FOUND":       "Order not found",
+//	    "ORDER_AMOUNT_EXCEEDED": "Order amount cannot exceed %d",
+//	})
+func RegisterLocale(locale string, messages map[string]string) {
+	locale = normalizeLocale(locale)
+	if isDefaultLocale(locale) {
+		RegisterMessages(messages)
+		return
+	}
+
+	catalog.Lock()
+	defer catalog.Unlock()
+
+	templates, ok := catalog.locales[locale]
+	if !ok {
+		templates = make(map[string]string, len(messages))
+		catalog.locales[locale] = templates
+	}
+	for key, message := range messages {
+		templates[key] = message
+	}
+}
+
+// LocalizedMessage 获取指定语言的错误信息
+//
+// 参数:
+//   - locale: 语言标签，如 "en-US"，为空时使用 DefaultLocale
+//   - key: 消息键
+//   - args: 模板参数，为空时直接返回模板
+//
+// 返回:
+//   - string: 错误信息
+//   - bool: 该语言是否注册了对应的错误信息
+func LocalizedMessage(locale, key string, args ...interface{}) (string, bool) {
+	template, ok := lookupTemplate(locale, key)
+	if !ok {
+		return "", false
+	}
+	if len(args) == 0 {
+		return template, true
+	}
+	return fmt.Sprintf(template, args...), true
+}
+
+// WithArgs 返回设置了错误信息模板参数的副本，Message 使用默认语言的模板格式化，
+// 之后 Localize 未传入参数时使用这里的参数
+//
+// 使用示例:
+//
+//	// RegisterMessages(map[string]string{"ORDER_AMOUNT_EXCEEDED": "订单金额不能超过 %d"})
+//	return errors.New("ORDER_AMOUNT_EXCEEDED", "", nil).WithArgs(limit)
+func (e *BusinessError) WithArgs(args ...interface{}) *BusinessError {
+	cp := e.clone()
+	cp.args = args
+	if message, ok := LocalizedMessage(DefaultLocale, e.messageKey(), args...); ok {
+		cp.Message = message
+	}
+	return cp
+}
+
+// Localize 返回错误信息为指定语言的副本
+//
+// 依次查找消息键（MessageKey）和错误类型（Type）在该语言下的错误信息模板，都未注册时保留原错误信息。
+// 默认语言且未传入参数时保留原错误信息（如 WrapError 添加的前缀）
+//
+// 参数:
+//   - locale: 语言标签，如 "en-US"，可通过 errconv.Language(ctx) 获取
+//   - args: 模板参数，为空时使用 WithArgs 设置的参数
+//
+// 使用示例:
+//
+//	be := errors.New("ORDER_AMOUNT_EXCEEDED", "", nil).WithArgs(limit)
+//	message := be.Localize("en-US").Message // "Order amount cannot exceed 1000"
+func (e *BusinessError) Localize(locale string, args ...interface{}) *BusinessError {
+	cp := e.clone()
+	if len(args) == 0 {
+		if isDefaultLocale(locale) {
+			return cp
+		}
+		args = e.args
+	}
+	for _, key := range []string{e.messageKey(), e.Type} {
+		if message, ok := LocalizedMessage(locale, key, args...); ok {
+			cp.Message = message
+			break
+		}
+	}
+	return cp
+}
+
+// lookupTemplate 按语言查找错误信息模板，先匹配完整的语言标签，再匹配主语言
+func lookupTemplate(locale, key string) (string, bool) {
+	locale = normalizeLocale(locale)
+	candidates := []string{locale}
+	if lang, _, ok := strings.Cut(locale, "-"); ok {
+		candidates = append(candidates, lang)
+	}
+
+	catalog.RLock()
+	defer catalog.RUnlock()
+
+	for _, candidate := range candidates {
+		if isDefaultLocale(candidate) {
+			message, ok := catalog.messages[key]
+			return message, ok
+		}
+		if message, ok := catalog.locales[candidate][key]; ok {
+			return message, true
+		}
+	}
+	return "", false
+}
+
+// isDefaultLocale 是否为默认语言，空字符串和默认语言的主语言（如 "zh"）也视为默认语言
+func isDefaultLocale(locale string) bool {
+	locale = normalizeLocale(locale)
+	defaultLocale := normalizeLocale(DefaultLocale)
+	defaultLang, _, _ := strings.Cut(defaultLocale, "-")
+	return locale == "" || locale == defaultLocale || locale == defaultLang
+}
+
+// normalizeLocale 统一语言标签格式，如 "en_US" -> "en-us"
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
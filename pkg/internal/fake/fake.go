@@ -0,0 +1,53 @@
+// Package fake 提供各 xxxtest 包假客户端共用的基础实现
+package fake
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// Errors 按方法名注入的错误，用于假客户端模拟下游故障
+//
+// 零值可用，并发安全
+type Errors struct {
+	mu     sync.Mutex
+	errors map[string]error
+}
+
+// Set 设置指定方法返回的错误，err 为 nil 时清除
+func (e *Errors) Set(method string, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if err == nil {
+		delete(e.errors, method)
+		return
+	}
+	if e.errors == nil {
+		e.errors = make(map[string]error)
+	}
+	e.errors[method] = err
+}
+
+// Get 获取指定方法的错误，未设置时返回 nil
+func (e *Errors) Get(method string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.errors[method]
+}
+
+// Clone 深拷贝消息
+func Clone[T proto.Message](m T) T {
+	return proto.Clone(m).(T)
+}
+
+// CloneAll 深拷贝消息列表
+func CloneAll[T proto.Message](items []T) []T {
+	result := make([]T, len(items))
+	for i, item := range items {
+		result[i] = Clone(item)
+	}
+	return result
+}
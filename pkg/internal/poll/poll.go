@@ -0,0 +1,71 @@
+// Package poll 提供按版本号轮询远端资源变更的通用实现
+package poll
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// FetchFunc 按版本拉取资源
+//
+// version 为上次拉取到的版本号（首次为空），服务端版本一致时返回 notModified=true，此时 value 无效
+type FetchFunc[T any] func(ctx context.Context, version string) (value T, latest string, notModified bool, err error)
+
+// Watch 轮询资源并在版本变化时回调
+//
+// 启动时先同步拉取一次并回调，之后每隔 interval 携带上次的版本号拉取，
+// 未修改或版本号未变化时跳过。方法会阻塞直到 ctx 取消
+//
+// 返回:
+//   - error: 首次拉取失败时返回错误；ctx 取消时返回 nil
+//
+// 说明:
+//   - 首次之后的拉取失败不会中断轮询，下个周期继续拉取，错误由 fetch 自行记录
+func Watch[T any](ctx context.Context, interval time.Duration, fetch FetchFunc[T], onChange func(T)) error {
+	value, version, _, err := fetch(ctx, "")
+	if err != nil {
+		return err
+	}
+	onChange(value)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		value, latest, notModified, err := fetch(ctx, version)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			continue
+		}
+		if notModified || latest == version {
+			continue
+		}
+
+		version = latest
+		onChange(value)
+	}
+}
+
+// Fingerprint 根据消息内容计算版本号，用于服务端未返回版本号的场景
+func Fingerprint[M proto.Message](msgs ...M) string {
+	h := sha256.New()
+	marshal := proto.MarshalOptions{Deterministic: true}
+	for _, msg := range msgs {
+		data, _ := marshal.Marshal(msg)
+		h.Write(data)
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
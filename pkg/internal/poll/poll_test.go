@@ -0,0 +1,88 @@
+package poll
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestWatch(t *testing.T) {
+	var mu sync.Mutex
+	responses := []struct {
+		value       string
+		version     string
+		notModified bool
+		err         error
+	}{
+		{value: "a", version: "v1"},
+		{notModified: true},
+		{err: errors.New("unavailable")},
+		{value: "a", version: "v1"},
+		{value: "b", version: "v2"},
+	}
+	var versions []string
+	fetch := func(ctx context.Context, version string) (string, string, bool, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		versions = append(versions, version)
+		if len(responses) == 0 {
+			return "", version, true, nil
+		}
+		r := responses[0]
+		responses = responses[1:]
+		return r.value, r.version, r.notModified, r.err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes := make(chan string, 4)
+	done := make(chan error, 1)
+	go func() {
+		done <- Watch(ctx, time.Millisecond, fetch, func(v string) { changes <- v })
+	}()
+
+	// 未修改、失败和版本未变化时不回调
+	for _, want := range []string{"a", "b"} {
+		select {
+		case got := <-changes:
+			if got != want {
+				t.Errorf("Expected change %q, got %q", want, got)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("Timed out waiting for change %q", want)
+		}
+	}
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Expected nil after cancel, got %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if versions[0] != "" || versions[1] != "v1" || versions[4] != "v1" {
+		t.Errorf("Unexpected versions sent: %v", versions)
+	}
+}
+
+func TestWatch_InitialError(t *testing.T) {
+	fetchErr := errors.New("not found")
+	err := Watch(context.Background(), time.Millisecond, func(ctx context.Context, version string) (string, string, bool, error) {
+		return "", "", false, fetchErr
+	}, func(string) { t.Error("Unexpected callback") })
+	if !errors.Is(err, fetchErr) {
+		t.Errorf("Expected initial fetch error, got %v", err)
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	a, b := wrapperspb.String("a"), wrapperspb.String("b")
+	if Fingerprint(a, b) == Fingerprint(b, a) {
+		t.Error("Expected order to change the fingerprint")
+	}
+	if Fingerprint(a) != Fingerprint(wrapperspb.String("a")) {
+		t.Error("Expected equal content to produce the same fingerprint")
+	}
+}
@@ -0,0 +1,138 @@
+// Package ttlcache 提供带过期时间和容量上限的进程内缓存
+package ttlcache
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxEntries 默认最大条目数
+	DefaultMaxEntries = 4096
+
+	// sweepInterval 定期清理过期条目的间隔
+	sweepInterval = time.Minute
+)
+
+// Cache 带过期时间的并发安全缓存
+//
+// 写入时每隔 sweepInterval 清理一次过期条目，条目数达到上限时先清理过期条目，
+// 仍然超出上限时随机淘汰，缓存大小始终不超过 MaxEntries
+type Cache[K comparable, V any] struct {
+	mu         sync.Mutex
+	entries    map[K]entry[V]
+	maxEntries int
+	nextSweep  time.Time
+	now        func() time.Time
+}
+
+type entry[V any] struct {
+	value     V
+	expiresAt time.Time
+}
+
+// New 创建缓存
+//
+// 参数:
+//   - maxEntries: 最大条目数，<=0 时使用 DefaultMaxEntries
+func New[K comparable, V any](maxEntries int) *Cache[K, V] {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMaxEntries
+	}
+	return &Cache[K, V]{
+		entries:    make(map[K]entry[V]),
+		maxEntries: maxEntries,
+		now:        time.Now,
+	}
+}
+
+// Get 获取未过期的值
+func (c *Cache[K, V]) Get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok || !c.now().Before(e.expiresAt) {
+		var zero V
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Set 写入值，ttl 后过期
+func (c *Cache[K, V]) Set(key K, value V, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.set(key, value, ttl)
+}
+
+// LoadOrStore 存在未过期的值时返回该值和 true，否则写入 value 并返回 value 和 false
+func (c *Cache[K, V]) LoadOrStore(key K, value V, ttl time.Duration) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok && c.now().Before(e.expiresAt) {
+		return e.value, true
+	}
+	c.set(key, value, ttl)
+	return value, false
+}
+
+// Compute 原子地更新值
+//
+// fn 接收未过期的旧值（不存在或已过期时 ok 为 false），返回新值和新值的过期时长
+func (c *Cache[K, V]) Compute(key K, fn func(old V, ok bool) (V, time.Duration)) V {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	ok = ok && c.now().Before(e.expiresAt)
+	value, ttl := fn(e.value, ok)
+	c.set(key, value, ttl)
+	return value
+}
+
+// Delete 删除值
+func (c *Cache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// Len 当前条目数，包含已过期但尚未清理的条目
+func (c *Cache[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.entries)
+}
+
+// set 写入值并按需清理，调用方需持有锁
+func (c *Cache[K, V]) set(key K, value V, ttl time.Duration) {
+	now := c.now()
+	if _, exists := c.entries[key]; !exists {
+		if now.After(c.nextSweep) || len(c.entries) >= c.maxEntries {
+			c.evict(now)
+		}
+	}
+	c.entries[key] = entry[V]{value: value, expiresAt: now.Add(ttl)}
+}
+
+// evict 清理过期条目，仍然达到上限时随机淘汰，调用方需持有锁
+func (c *Cache[K, V]) evict(now time.Time) {
+	c.nextSweep = now.Add(sweepInterval)
+	for key, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, key)
+		}
+	}
+
+	for key := range c.entries {
+		if len(c.entries) < c.maxEntries {
+			break
+		}
+		delete(c.entries, key)
+	}
+}
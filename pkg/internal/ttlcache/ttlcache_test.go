@@ -0,0 +1,69 @@
+package ttlcache
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestCache_Expire(t *testing.T) {
+	now := time.Now()
+	c := New[string, int](0)
+	c.now = func() time.Time { return now }
+
+	c.Set("a", 1, time.Minute)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Fatalf("Expected hit, got %v %v", v, ok)
+	}
+	if v, loaded := c.LoadOrStore("a", 2, time.Minute); !loaded || v != 1 {
+		t.Errorf("Expected existing value, got %v %v", v, loaded)
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Error("Expected entry expired")
+	}
+	if v, loaded := c.LoadOrStore("a", 2, time.Minute); loaded || v != 2 {
+		t.Errorf("Expected expired entry replaced, got %v %v", v, loaded)
+	}
+
+	v := c.Compute("a", func(old int, ok bool) (int, time.Duration) {
+		if !ok {
+			t.Error("Expected old value in Compute")
+		}
+		return old + 1, time.Minute
+	})
+	if v != 3 {
+		t.Errorf("Expected 3, got %d", v)
+	}
+
+	c.Delete("a")
+	if _, ok := c.Get("a"); ok {
+		t.Error("Expected entry deleted")
+	}
+}
+
+func TestCache_Bound(t *testing.T) {
+	now := time.Now()
+	c := New[string, int](10)
+	c.now = func() time.Time { return now }
+
+	for i := 0; i < 25; i++ {
+		c.Set(fmt.Sprintf("k%d", i), i, time.Hour)
+	}
+	if c.Len() > 10 {
+		t.Errorf("Expected at most 10 entries, got %d", c.Len())
+	}
+
+	// 定期清理过期条目，未达到上限也会清理
+	c = New[string, int](100)
+	c.now = func() time.Time { return now }
+	for i := 0; i < 5; i++ {
+		c.Set(fmt.Sprintf("k%d", i), i, time.Second)
+	}
+	now = now.Add(2 * sweepInterval)
+	c.Set("new", 0, time.Hour)
+	if c.Len() != 1 {
+		t.Errorf("Expected expired entries swept, got %d", c.Len())
+	}
+}
@@ -28,9 +28,9 @@ type ResolverOptions struct {
 	ExpiresIn int64
 }
 
-// resourceResolver 基于 resource.ResourceClient 的解析器实现
+// resourceResolver 基于 resource.ResourceAPI 的解析器实现
 type resourceResolver struct {
-	client *resource.ResourceClient
+	client resource.ResourceAPI
 	opts   *ResolverOptions
 }
 
@@ -47,7 +47,7 @@ type resourceResolver struct {
 // 说明:
 //   - URL查询不需要租户隔离，支持平台级资源与租户资源混合使用
 //   - 租户隔离在下载时由其他接口处理
-func NewResolver(client *resource.ResourceClient) Resolver {
+func NewResolver(client resource.ResourceAPI) Resolver {
 	return &resourceResolver{
 		client: client,
 		opts: &ResolverOptions{
@@ -69,7 +69,7 @@ func NewResolver(client *resource.ResourceClient) Resolver {
 //	    IncludeVariants: true,
 //	    ExpiresIn:       7200,
 //	})
-func NewResolverWithOptions(client *resource.ResourceClient, opts *ResolverOptions) Resolver {
+func NewResolverWithOptions(client resource.ResourceAPI, opts *ResolverOptions) Resolver {
 	if opts == nil {
 		opts = &ResolverOptions{
 			IncludeVariants: true,
@@ -88,7 +88,7 @@ func (r *resourceResolver) Resolve(ctx context.Context, ids []string) (map[strin
 		return make(map[string]*ResourceInfo), nil
 	}
 
-	results, err := r.client.GetFileUrls(ctx, ids, &resource.GetFileUrlsOptions{
+	results, err := r.client.GetFileUrlsAll(ctx, ids, &resource.GetFileUrlsOptions{
 		IncludeVariants: r.opts.IncludeVariants,
 		ExpiresIn:       r.opts.ExpiresIn,
 	})
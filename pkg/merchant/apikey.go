@@ -0,0 +1,166 @@
+package merchant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/merchant/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateAPIKeyOptions 创建 OpenAPI 凭证选项
+type CreateAPIKeyOptions struct {
+	TenantCode  string    // 租户code（必填）
+	ProductCode string    // 产品code（必填）
+	Name        string    // 名称（必填）
+	Scopes      []string  // 授权范围，为空表示产品下全部范围
+	ExpiresAt   time.Time // 过期时间，零值表示永不过期
+}
+
+// ListAPIKeysOptions OpenAPI 凭证列表查询选项
+type ListAPIKeysOptions struct {
+	TenantCode  string                   // 租户code（必填）
+	ProductCode *string                  // 产品code
+	Status      *v1.InternalAPIKeyStatus // 状态
+	Page        int32                    // 页码，默认1
+	Limit       int32                    // 每页数量，默认且最大20
+}
+
+// CreateAPIKey 创建 OpenAPI 凭证
+//
+// 凭证按租户和产品隔离，密钥明文仅在创建时返回一次，调用方需要立即展示给用户
+//
+// 参数:
+//   - opts: 创建选项
+//
+// 返回:
+//   - *v1.InternalAPIKey: 凭证信息
+//   - string: 密钥明文
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	key, secret, err := client.IAM().CreateAPIKey(ctx, &merchant.CreateAPIKeyOptions{
+//	    TenantCode:  tenantCode,
+//	    ProductCode: "storefront",
+//	    Name:        "ERP 对接",
+//	})
+func (c *IAMClient) CreateAPIKey(ctx context.Context, opts *CreateAPIKeyOptions) (*v1.InternalAPIKey, string, error) {
+	if opts == nil || opts.TenantCode == "" || opts.ProductCode == "" {
+		return nil, "", fmt.Errorf("租户code和产品code不能为空")
+	}
+	if opts.Name == "" {
+		return nil, "", fmt.Errorf("凭证名称不能为空")
+	}
+
+	req := &v1.InternalCreateAPIKeyRequest{
+		TenantCode:  opts.TenantCode,
+		ProductCode: opts.ProductCode,
+		Name:        opts.Name,
+		Scopes:      opts.Scopes,
+	}
+	if !opts.ExpiresAt.IsZero() {
+		req.ExpireTime = timestamppb.New(opts.ExpiresAt)
+	}
+
+	resp, err := c.client.InternalCreateAPIKey(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("创建API凭证失败, tenantCode=%s, productCode=%s, err=%v", opts.TenantCode, opts.ProductCode, err)
+		return nil, "", err
+	}
+
+	return resp.GetKey(), resp.GetSecret(), nil
+}
+
+// ListAPIKeys 获取 OpenAPI 凭证列表
+//
+// 参数:
+//   - opts: 查询选项
+//
+// 返回:
+//   - []*v1.InternalAPIKey: 凭证列表（不含密钥）
+//   - int64: 总数
+//   - error: 错误信息
+func (c *IAMClient) ListAPIKeys(ctx context.Context, opts *ListAPIKeysOptions) ([]*v1.InternalAPIKey, int64, error) {
+	if opts == nil || opts.TenantCode == "" {
+		return nil, 0, fmt.Errorf("租户code不能为空")
+	}
+
+	page, limit := opts.Page, opts.Limit
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 || limit > 20 {
+		limit = 20
+	}
+
+	resp, err := c.client.InternalListAPIKeys(ctx, &v1.InternalListAPIKeysRequest{
+		TenantCode:  opts.TenantCode,
+		ProductCode: opts.ProductCode,
+		Status:      opts.Status,
+		Page:        page,
+		Limit:       limit,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取API凭证列表失败, tenantCode=%s, err=%v", opts.TenantCode, err)
+		return nil, 0, err
+	}
+
+	return resp.GetItems(), resp.GetTotal(), nil
+}
+
+// RevokeAPIKey 吊销 OpenAPI 凭证
+//
+// 吊销后凭证立即失效且不可恢复
+//
+// 参数:
+//   - tenantCode: 租户code
+//   - keyID: 凭证ID
+//   - reason: 吊销原因，为空时不传递
+//
+// 返回:
+//   - error: 错误信息
+func (c *IAMClient) RevokeAPIKey(ctx context.Context, tenantCode, keyID, reason string) error {
+	if tenantCode == "" || keyID == "" {
+		return fmt.Errorf("租户code和凭证ID不能为空")
+	}
+
+	req := &v1.InternalRevokeAPIKeyRequest{TenantCode: tenantCode, KeyId: keyID}
+	if reason != "" {
+		req.Reason = &reason
+	}
+
+	if _, err := c.client.InternalRevokeAPIKey(ctx, req); err != nil {
+		c.logger.WithContext(ctx).Errorf("吊销API凭证失败, tenantCode=%s, keyID=%s, err=%v", tenantCode, keyID, err)
+		return err
+	}
+
+	return nil
+}
+
+// RotateAPIKeySecret 轮换 OpenAPI 凭证密钥
+//
+// 轮换后 AccessKey 保持不变，旧密钥立即失效，新密钥明文仅返回一次
+//
+// 参数:
+//   - tenantCode: 租户code
+//   - keyID: 凭证ID
+//
+// 返回:
+//   - *v1.InternalAPIKey: 凭证信息
+//   - string: 新密钥明文
+//   - error: 错误信息
+func (c *IAMClient) RotateAPIKeySecret(ctx context.Context, tenantCode, keyID string) (*v1.InternalAPIKey, string, error) {
+	if tenantCode == "" || keyID == "" {
+		return nil, "", fmt.Errorf("租户code和凭证ID不能为空")
+	}
+
+	resp, err := c.client.InternalRotateAPIKeySecret(ctx, &v1.InternalRotateAPIKeySecretRequest{TenantCode: tenantCode, KeyId: keyID})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("轮换API凭证密钥失败, tenantCode=%s, keyID=%s, err=%v", tenantCode, keyID, err)
+		return nil, "", err
+	}
+
+	return resp.GetKey(), resp.GetSecret(), nil
+}
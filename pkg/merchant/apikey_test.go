@@ -0,0 +1,93 @@
+package merchant
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/merchant/v1"
+	"google.golang.org/grpc"
+)
+
+// mockAPIKeyClient 模拟 OpenAPI 凭证接口
+type mockAPIKeyClient struct {
+	mockIAMClient
+
+	createReq *v1.InternalCreateAPIKeyRequest
+	listReq   *v1.InternalListAPIKeysRequest
+	revokeReq *v1.InternalRevokeAPIKeyRequest
+}
+
+func (m *mockAPIKeyClient) InternalCreateAPIKey(_ context.Context, in *v1.InternalCreateAPIKeyRequest, _ ...grpc.CallOption) (*v1.InternalCreateAPIKeyResponse, error) {
+	m.createReq = in
+	return &v1.InternalCreateAPIKeyResponse{
+		Key:    &v1.InternalAPIKey{KeyId: "k1", TenantCode: in.GetTenantCode(), ProductCode: in.GetProductCode(), AccessKey: "AK1"},
+		Secret: "s1",
+	}, nil
+}
+
+func (m *mockAPIKeyClient) InternalListAPIKeys(_ context.Context, in *v1.InternalListAPIKeysRequest, _ ...grpc.CallOption) (*v1.InternalListAPIKeysResponse, error) {
+	m.listReq = in
+	return &v1.InternalListAPIKeysResponse{Items: []*v1.InternalAPIKey{{KeyId: "k1"}}, Total: 1}, nil
+}
+
+func (m *mockAPIKeyClient) InternalRevokeAPIKey(_ context.Context, in *v1.InternalRevokeAPIKeyRequest, _ ...grpc.CallOption) (*v1.InternalRevokeAPIKeyResponse, error) {
+	m.revokeReq = in
+	return &v1.InternalRevokeAPIKeyResponse{Key: &v1.InternalAPIKey{KeyId: in.GetKeyId(), Status: v1.InternalAPIKeyStatus_API_KEY_STATUS_REVOKED}}, nil
+}
+
+func (m *mockAPIKeyClient) InternalRotateAPIKeySecret(_ context.Context, in *v1.InternalRotateAPIKeySecretRequest, _ ...grpc.CallOption) (*v1.InternalRotateAPIKeySecretResponse, error) {
+	return &v1.InternalRotateAPIKeySecretResponse{Key: &v1.InternalAPIKey{KeyId: in.GetKeyId(), AccessKey: "AK1"}, Secret: "s2"}, nil
+}
+
+func TestAPIKeys(t *testing.T) {
+	mock := &mockAPIKeyClient{}
+	c := newTestIAMClient(mock)
+	ctx := context.Background()
+	expiresAt := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	key, secret, err := c.CreateAPIKey(ctx, &CreateAPIKeyOptions{
+		TenantCode:  "T001",
+		ProductCode: "storefront",
+		Name:        "ERP",
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		t.Fatalf("CreateAPIKey failed: %v", err)
+	}
+	if key.GetAccessKey() != "AK1" || secret != "s1" {
+		t.Errorf("Unexpected key: %v, secret=%q", key, secret)
+	}
+	if !mock.createReq.GetExpireTime().AsTime().Equal(expiresAt) {
+		t.Errorf("Unexpected expire time: %v", mock.createReq.GetExpireTime())
+	}
+	if _, _, err := c.CreateAPIKey(ctx, &CreateAPIKeyOptions{TenantCode: "T001", Name: "ERP"}); err == nil {
+		t.Error("Expected error without product code")
+	}
+
+	keys, total, err := c.ListAPIKeys(ctx, &ListAPIKeysOptions{TenantCode: "T001", Limit: 100})
+	if err != nil {
+		t.Fatalf("ListAPIKeys failed: %v", err)
+	}
+	if len(keys) != 1 || total != 1 {
+		t.Errorf("Unexpected keys: %v, total=%d", keys, total)
+	}
+	if mock.listReq.GetPage() != 1 || mock.listReq.GetLimit() != 20 {
+		t.Errorf("Unexpected paging: page=%d limit=%d", mock.listReq.GetPage(), mock.listReq.GetLimit())
+	}
+
+	if err := c.RevokeAPIKey(ctx, "T001", "k1", ""); err != nil {
+		t.Fatalf("RevokeAPIKey failed: %v", err)
+	}
+	if mock.revokeReq.Reason != nil {
+		t.Error("Expected reason to be omitted when empty")
+	}
+
+	key, secret, err = c.RotateAPIKeySecret(ctx, "T001", "k1")
+	if err != nil {
+		t.Fatalf("RotateAPIKeySecret failed: %v", err)
+	}
+	if key.GetAccessKey() != "AK1" || secret != "s2" {
+		t.Errorf("Unexpected rotated key: %v, secret=%q", key, secret)
+	}
+}
@@ -1,4 +1,4 @@
-package platform
+package merchant
 
 import (
 	"context"
@@ -11,17 +11,18 @@ import (
 	"google.golang.org/grpc"
 )
 
-// Client 平台服务客户端
+// Client 商户服务客户端
 //
-// 聚合了所有平台相关的服务客户端，提供统一的访问入口
+// 聚合了所有商户相关的服务客户端，提供统一的访问入口
 //
 // 当前支持的服务：
-// - IAM: 身份认证和权限管理服务
+// - IAM: 商户权限和用户管理服务
+// - Tenant: 租户管理服务
 //
 // 使用示例:
 //
-//	client, err := platform.NewClientWithDiscovery(
-//	    platform.DefaultConfig(),
+//	client, err := merchant.NewClientWithDiscovery(
+//	    merchant.DefaultConfig(),
 //	    consulDiscovery,
 //	)
 //	if err != nil {
@@ -29,20 +30,23 @@ import (
 //	}
 //	defer client.Close()
 //
-//	// 使用 IAM 服务
-//	tree, err := client.IAM().GetTenantPermissionsTree(ctx, &platform.GetTenantPermissionsTreeOptions{
-//	    Status: "GA",
-//	})
+//	// 使用租户服务
+//	tenants, err := client.Tenant().ListTenant(ctx, 1, 20, nil)
+//
+// 说明:
+//   - 本包此前声明为 package platform，与 pkg/platform 同名；
+//     旧代码以 platform.Xxx 引用时需改为 merchant.Xxx 或使用导入别名
 type Client struct {
 	config *Config
 	conn   *grpc.ClientConn
 	logger *log.Helper
 
 	// 子服务客户端
-	iamClient *IAMClient
+	iamClient    *IAMClient
+	tenantClient *TenantClient
 }
 
-// NewClient 创建平台服务客户端（直连方式）
+// NewClient 创建商户服务客户端（直连方式）
 //
 // 参数:
 //   - config: 客户端配置，可以使用 DefaultConfig() 获取默认配置
@@ -61,7 +65,7 @@ func NewClient(config *Config) (*Client, error) {
 
 	logger := log.NewHelper(log.With(
 		log.GetLogger(),
-		"module", "platform-client",
+		"module", "merchant-client",
 	))
 
 	conn, err := middleware.CreateGRPCConn(config, nil, logger)
@@ -69,15 +73,12 @@ func NewClient(config *Config) (*Client, error) {
 		return nil, fmt.Errorf("创建 gRPC 连接失败: %w", err)
 	}
 
-	return &Client{
-		config:    config,
-		conn:      conn,
-		logger:    logger,
-		iamClient: newIAMClient(conn, logger),
-	}, nil
+	c := newClient(config, conn, logger)
+	c.conn = conn
+	return c, nil
 }
 
-// NewClientWithDiscovery 创建带服务发现的平台服务客户端
+// NewClientWithDiscovery 创建带服务发现的商户服务客户端
 //
 // 参数:
 //   - config: 客户端配置
@@ -101,7 +102,7 @@ func NewClientWithDiscovery(config *Config, discovery registry.Discovery) (*Clie
 
 	logger := log.NewHelper(log.With(
 		log.GetLogger(),
-		"module", "platform-client",
+		"module", "merchant-client",
 	))
 
 	conn, err := middleware.CreateGRPCConn(config, discovery, logger)
@@ -109,14 +110,43 @@ func NewClientWithDiscovery(config *Config, discovery registry.Discovery) (*Clie
 		return nil, fmt.Errorf("创建 gRPC 连接失败: %w", err)
 	}
 
-	logger.Infof("平台服务客户端连接成功 (服务发现): endpoint=%s, timeout=%v", config.Endpoint, config.Timeout)
+	logger.Infof("商户服务客户端连接成功 (服务发现): endpoint=%s, timeout=%v", config.Endpoint, config.Timeout)
+
+	c := newClient(config, conn, logger)
+	c.conn = conn
+	return c, nil
+}
+
+// NewClientWithConn 基于已建立的连接创建商户服务客户端
+//
+// 用于多个客户端共享连接（如 clients.Factory），连接由调用方管理，Close 不会关闭该连接
+//
+// 参数:
+//   - config: 客户端配置，nil 时使用默认配置
+//   - conn: gRPC 连接，通常由 middleware.CreateGRPCConn 创建
+func NewClientWithConn(config *Config, conn grpc.ClientConnInterface) *Client {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	logger := log.NewHelper(log.With(
+		log.GetLogger(),
+		"module", "merchant-client",
+	))
+
+	return newClient(config, conn, logger)
+}
+
+// newClient 基于已建立的连接创建客户端，不持有连接
+func newClient(config *Config, conn grpc.ClientConnInterface, logger *log.Helper) *Client {
+	client := v1.NewMerchantIamServiceClient(conn)
+	tenantClient := &TenantClient{client: client, logger: logger}
 
 	return &Client{
-		config:    config,
-		conn:      conn,
-		logger:    logger,
-		iamClient: newIAMClient(conn, logger),
-	}, nil
+		config:       config,
+		logger:       logger,
+		iamClient:    &IAMClient{client: client, logger: logger, tenant: tenantClient},
+		tenantClient: tenantClient,
+	}
 }
 
 // Close 关闭客户端连接
@@ -133,33 +163,37 @@ func (c *Client) Close() error {
 
 // IAM 返回 IAM 服务客户端
 //
-// 用于访问身份认证和权限管理相关功能
+// 用于租户权限下发和平台用户管理
 //
 // 使用示例:
 //
-//	tree, err := client.IAM().GetTenantPermissionsTree(ctx, &GetTenantPermissionsTreeOptions{
-//	    Status: "GA",
-//	})
+//	resp, err := client.IAM().SetTenantPermissions(ctx, tenantCode, codes)
 func (c *Client) IAM() *IAMClient {
 	return c.iamClient
 }
 
+// Tenant 返回租户服务客户端
+//
+// 用于租户查询和统计
+//
+// 使用示例:
+//
+//	tenant, err := client.Tenant().InternalGetTenant(ctx, tenantCode)
+func (c *Client) Tenant() *TenantClient {
+	return c.tenantClient
+}
+
 // ========== IAM 客户端 ==========
 
 // IAMClient IAM 服务客户端
 //
-// 提供身份认证和权限管理相关功能
+// 提供租户权限下发和平台用户管理相关功能
 type IAMClient struct {
 	client v1.MerchantIamServiceClient
 	logger *log.Helper
-}
 
-// newIAMClient 创建 IAM 客户端
-func newIAMClient(conn *grpc.ClientConn, logger *log.Helper) *IAMClient {
-	return &IAMClient{
-		client: v1.NewMerchantIamServiceClient(conn),
-		logger: logger,
-	}
+	// 租户相关方法已迁移到 TenantClient，保留委托一个版本
+	tenant *TenantClient
 }
 
 // SetTenantPermissions 将权限代码列表下发到租户
@@ -185,41 +219,6 @@ func (c *IAMClient) SetTenantPermissions(ctx context.Context, tenantCode string,
 	return resp, nil
 }
 
-type ListTenantOptions struct {
-	Name        *string          // 名称
-	Status      *v1.TenantStatus // 状态
-	Country     *string          // 国家
-	Type        *v1.TenantType   // 类型
-	AccessLevel *v1.AccessLevel  // 访问等级
-}
-
-// 获取租户列表
-func (c *IAMClient) ListTenant(ctx context.Context, page, limit int32, opt *ListTenantOptions) (*v1.InternalListTenantResponse, error) {
-	if page <= 0 {
-		page = 1
-	}
-	if limit <= 0 || limit > 20 {
-		limit = 20
-	}
-	req := &v1.InternalListTenantRequest{
-		Page:  page,
-		Limit: limit,
-	}
-	if opt != nil {
-		req.Name = opt.Name
-		req.Status = opt.Status
-		req.Country = opt.Country
-		req.Type = opt.Type
-	}
-	resp, err := c.client.InternalListTenant(ctx, req)
-	if err != nil {
-		c.logger.WithContext(ctx).Errorf("获取租户列表失败, opt=%v, err=%v", opt, err)
-		return nil, err
-	}
-
-	return resp, nil
-}
-
 type ListPlatformUserOptions struct {
 	P              *string                // 关键词
 	Status         *v1.InternalUserStatus // 状态
@@ -251,24 +250,25 @@ func (c *IAMClient) ListPlatformUser(ctx context.Context, page, limit int32, opt
 	return resp, nil
 }
 
-func (c *IAMClient) InternalGetTenant(ctx context.Context, tenantCode string) (*v1.InternalGetTenantResponse, error) {
-	resp, err := c.client.InternalGetTenant(ctx, &v1.InternalGetTenantRequest{TenantCode: tenantCode})
-	if err != nil {
-		c.logger.WithContext(ctx).Errorf("获取用户信息失败, tenantCode=%v, err=%v", tenantCode, err)
-		return nil, err
-	}
+// ListTenant 获取租户列表
+//
+// Deprecated: 使用 Client.Tenant().ListTenant
+func (c *IAMClient) ListTenant(ctx context.Context, page, limit int32, opt *ListTenantOptions) (*v1.InternalListTenantResponse, error) {
+	return c.tenant.ListTenant(ctx, page, limit, opt)
+}
 
-	return resp, nil
+// InternalGetTenant 获取租户信息
+//
+// Deprecated: 使用 Client.Tenant().InternalGetTenant
+func (c *IAMClient) InternalGetTenant(ctx context.Context, tenantCode string) (*v1.InternalGetTenantResponse, error) {
+	return c.tenant.InternalGetTenant(ctx, tenantCode)
 }
 
+// GetTenantStats 获取商户统计信息
+//
+// Deprecated: 使用 Client.Tenant().GetTenantStats
 func (c *IAMClient) GetTenantStats(ctx context.Context) (*v1.InternalGetTenantStatsResponse, error) {
-	resp, err := c.client.InternalGetTenantStats(ctx, &v1.InternalGetTenantStatsRequest{})
-	if err != nil {
-		c.logger.WithContext(ctx).Errorf("获取商户统计信息失败, err=%v", err)
-		return nil, err
-	}
-
-	return resp, nil
+	return c.tenant.GetTenantStats(ctx)
 }
 
 func (c *IAMClient) GetUserStats(ctx context.Context) (*v1.InternalGetUserStatsResponse, error) {
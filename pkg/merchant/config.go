@@ -1,22 +1,22 @@
-package platform
+package merchant
 
 import (
 	"github.com/heyinLab/common/pkg/common"
 )
 
 const (
-	// DefaultServiceName 默认的平台服务名称（用于服务发现）
+	// DefaultServiceName 默认的商户服务名称（用于服务发现）
 	DefaultServiceName = "iam-merchant-server"
 )
 
-// Config 平台服务客户端配置
+// Config 商户服务客户端配置
 type Config = common.ServiceConfig
 
-// DefaultConfig 返回默认的平台服务客户端配置
+// DefaultConfig 返回默认的商户服务客户端配置
 //
 // 默认配置:
 //   - Endpoint: "discovery:///iam-merchant-server"
-//   - ServiceName: "iamServer"
+//   - ServiceName: "iam-merchant-server"
 //   - Timeout: 10s
 func DefaultConfig() *Config {
 	return common.NewServiceConfig(DefaultServiceName)
@@ -0,0 +1,139 @@
+package merchant
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/merchant/v1"
+)
+
+// MaxTenantPageSize 租户列表单页最大数量（服务端限制）
+const MaxTenantPageSize = 20
+
+// ListTenantIterOptions 租户遍历选项
+type ListTenantIterOptions struct {
+	// 过滤条件，与 ListTenant 相同
+	ListTenantOptions
+
+	// PageSize 每页数量，默认且最大为 MaxTenantPageSize
+	PageSize int32
+
+	// PageInterval 两次翻页之间的间隔，用于批量任务限流，默认不等待
+	PageInterval time.Duration
+}
+
+// ListTenantIter 遍历全部租户
+//
+// 自动翻页，ctx 结束时立即停止。遇到错误时产出 (nil, err) 并结束遍历，
+// 临时性错误的重试由连接的重试策略（RetryPolicy）负责。
+// 返回不足一页时结束，服务端返回了总数（Total > 0）时取满总数也结束
+//
+// 参数:
+//   - opt: 遍历选项，可以为 nil
+//
+// 返回:
+//   - iter.Seq2[*v1.InternalTenant, error]: 租户迭代器
+//
+// 使用示例:
+//
+//	for tenant, err := range client.Tenant().ListTenantIter(ctx, nil) {
+//	    if err != nil {
+//	        return err
+//	    }
+//	    fmt.Println(tenant.GetCode())
+//	}
+func (c *TenantClient) ListTenantIter(ctx context.Context, opt *ListTenantIterOptions) iter.Seq2[*v1.InternalTenant, error] {
+	o := normalizeTenantIterOptions(opt)
+
+	return func(yield func(*v1.InternalTenant, error) bool) {
+		var fetched int64
+		for page := int32(1); ; page++ {
+			if page > 1 && o.PageInterval > 0 {
+				if err := sleepContext(ctx, o.PageInterval); err != nil {
+					yield(nil, err)
+					return
+				}
+			}
+
+			if err := ctx.Err(); err != nil {
+				yield(nil, err)
+				return
+			}
+
+			resp, err := c.ListTenant(ctx, page, o.PageSize, &o.ListTenantOptions)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+
+			for _, tenant := range resp.GetItems() {
+				if !yield(tenant, nil) {
+					return
+				}
+			}
+
+			// 指定 Codes 时 ListTenant 一次返回全部结果
+			if len(o.Codes) > 0 {
+				return
+			}
+			fetched += int64(len(resp.GetItems()))
+			if len(resp.GetItems()) < int(o.PageSize) || (resp.GetTotal() > 0 && fetched >= resp.GetTotal()) {
+				return
+			}
+		}
+	}
+}
+
+// ForEachTenant 对每个租户执行 fn
+//
+// 基于 ListTenantIter 实现，fn 返回错误时停止遍历并返回该错误
+//
+// 参数:
+//   - opt: 遍历选项，可以为 nil
+//   - fn: 处理函数
+//
+// 返回:
+//   - error: 翻页失败、ctx 结束或 fn 返回的错误
+//
+// 使用示例:
+//
+//	err := client.Tenant().ForEachTenant(ctx, nil, func(tenant *v1.InternalTenant) error {
+//	    return syncTenant(ctx, tenant)
+//	})
+func (c *TenantClient) ForEachTenant(ctx context.Context, opt *ListTenantIterOptions, fn func(tenant *v1.InternalTenant) error) error {
+	for tenant, err := range c.ListTenantIter(ctx, opt) {
+		if err != nil {
+			return err
+		}
+		if err := fn(tenant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeTenantIterOptions 填充遍历选项默认值
+func normalizeTenantIterOptions(opt *ListTenantIterOptions) ListTenantIterOptions {
+	var o ListTenantIterOptions
+	if opt != nil {
+		o = *opt
+	}
+	if o.PageSize <= 0 || o.PageSize > MaxTenantPageSize {
+		o.PageSize = MaxTenantPageSize
+	}
+	return o
+}
+
+// sleepContext 等待指定时间，ctx 结束时立即返回
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,142 @@
+package merchant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/merchant/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockListTenantClient 模拟分页租户列表，可在指定页注入错误
+type mockListTenantClient struct {
+	mockIAMClient
+
+	items    []*v1.InternalTenant
+	failures map[int32][]error
+	pages    []int32
+	lastReq  *v1.InternalListTenantRequest
+	noTotal  bool
+}
+
+func (m *mockListTenantClient) InternalListTenant(_ context.Context, in *v1.InternalListTenantRequest, _ ...grpc.CallOption) (*v1.InternalListTenantResponse, error) {
+	m.pages = append(m.pages, in.GetPage())
+	m.lastReq = in
+	if errs := m.failures[in.GetPage()]; len(errs) > 0 {
+		m.failures[in.GetPage()] = errs[1:]
+		return nil, errs[0]
+	}
+
+	items := m.items
+	if len(in.GetCodes()) > 0 {
+		items = nil
+		for _, item := range m.items {
+			if slices.Contains(in.GetCodes(), item.GetCode()) {
+				items = append(items, item)
+			}
+		}
+	}
+	start := min(int((in.GetPage()-1)*in.GetLimit()), len(items))
+	end := min(start+int(in.GetLimit()), len(items))
+	resp := &v1.InternalListTenantResponse{Items: items[start:end], Total: int64(len(items))}
+	if m.noTotal {
+		resp.Total = 0
+	}
+	return resp, nil
+}
+
+func newMockTenants(n int) []*v1.InternalTenant {
+	items := make([]*v1.InternalTenant, n)
+	for i := range items {
+		items[i] = &v1.InternalTenant{Code: fmt.Sprintf("T%03d", i+1)}
+	}
+	return items
+}
+
+func TestListTenantIter(t *testing.T) {
+	mock := &mockListTenantClient{items: newMockTenants(45)}
+	c := newTestTenantClient(mock)
+
+	var codes []string
+	for tenant, err := range c.ListTenantIter(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("ListTenantIter failed: %v", err)
+		}
+		codes = append(codes, tenant.GetCode())
+	}
+
+	if len(codes) != 45 || codes[0] != "T001" || codes[44] != "T045" {
+		t.Errorf("Unexpected tenants: %d %v", len(codes), codes)
+	}
+	if len(mock.pages) != 3 {
+		t.Errorf("Expected 3 pages, got %v", mock.pages)
+	}
+}
+
+func TestListTenantIterError(t *testing.T) {
+	// 错误直接返回，重试由连接的 RetryPolicy 负责
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+	mock := &mockListTenantClient{items: newMockTenants(25), failures: map[int32][]error{2: {unavailable}}}
+	c := newTestTenantClient(mock)
+
+	count := 0
+	err := c.ForEachTenant(context.Background(), nil, func(*v1.InternalTenant) error {
+		count++
+		return nil
+	})
+	if status.Code(err) != codes.Unavailable || count != MaxTenantPageSize {
+		t.Errorf("Expected Unavailable after first page, got count=%d err=%v", count, err)
+	}
+	if len(mock.pages) != 2 {
+		t.Errorf("Expected no retry, got %v", mock.pages)
+	}
+}
+
+func TestListTenantIterWithoutTotal(t *testing.T) {
+	// 服务端未返回总数时按短页结束
+	mock := &mockListTenantClient{items: newMockTenants(45), noTotal: true}
+	c := newTestTenantClient(mock)
+
+	count := 0
+	if err := c.ForEachTenant(context.Background(), nil, func(*v1.InternalTenant) error {
+		count++
+		return nil
+	}); err != nil {
+		t.Fatalf("ForEachTenant failed: %v", err)
+	}
+	if count != 45 || len(mock.pages) != 3 {
+		t.Errorf("Expected 45 tenants in 3 pages, got %d %v", count, mock.pages)
+	}
+}
+
+func TestForEachTenantStop(t *testing.T) {
+	mock := &mockListTenantClient{items: newMockTenants(45)}
+	c := newTestTenantClient(mock)
+
+	stop := errors.New("stop")
+	count := 0
+	err := c.ForEachTenant(context.Background(), nil, func(*v1.InternalTenant) error {
+		count++
+		if count == 5 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) || count != 5 {
+		t.Errorf("Expected stop after 5 tenants, got count=%d err=%v", count, err)
+	}
+	if len(mock.pages) != 1 {
+		t.Errorf("Expected 1 page, got %v", mock.pages)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := c.ForEachTenant(ctx, nil, func(*v1.InternalTenant) error { return nil }); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,174 @@
+package merchant
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/heyinLab/common/api/gen/go/merchant/v1"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+)
+
+// InviteUserOptions 邀请用户选项
+type InviteUserOptions struct {
+	TenantCode  string // 租户code（必填）
+	Email       string // 被邀请人邮箱（必填）
+	RoleCode    string // 加入后的角色code（必填）
+	InviterCode string // 邀请人用户code，为空时取 ctx 中的当前用户
+	Message     string // 邀请附言
+}
+
+// ListMembersOptions 租户成员列表查询选项
+type ListMembersOptions struct {
+	Keyword  *string                  // 昵称/邮箱关键词
+	RoleCode *string                  // 角色过滤
+	Status   *v1.InternalMemberStatus // 状态过滤
+}
+
+// InviteUser 邀请用户加入租户
+//
+// 被邀请人接受邀请前成员状态为 MEMBER_STATUS_INVITED
+//
+// 参数:
+//   - opts: 邀请选项
+//
+// 返回:
+//   - *v1.InternalTenantMember: 成员信息
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	member, err := client.IAM().InviteUser(ctx, &merchant.InviteUserOptions{
+//	    TenantCode: tenantCode,
+//	    Email:      "alice@example.com",
+//	    RoleCode:   "admin",
+//	})
+func (c *IAMClient) InviteUser(ctx context.Context, opts *InviteUserOptions) (*v1.InternalTenantMember, error) {
+	if opts == nil || opts.TenantCode == "" {
+		return nil, fmt.Errorf("租户code不能为空")
+	}
+	if opts.Email == "" || opts.RoleCode == "" {
+		return nil, fmt.Errorf("邮箱和角色code不能为空")
+	}
+
+	req := &v1.InternalInviteUserRequest{
+		TenantCode: opts.TenantCode,
+		Email:      opts.Email,
+		RoleCode:   opts.RoleCode,
+	}
+	inviterCode := opts.InviterCode
+	if inviterCode == "" {
+		if claims, ok := auth.FromContext(ctx); ok {
+			inviterCode = claims.UserCode
+		}
+	}
+	if inviterCode != "" {
+		req.InviterCode = &inviterCode
+	}
+	if opts.Message != "" {
+		req.Message = &opts.Message
+	}
+
+	resp, err := c.client.InternalInviteUser(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("邀请用户失败, tenantCode=%s, email=%s, err=%v", opts.TenantCode, opts.Email, err)
+		return nil, err
+	}
+
+	return resp.GetMember(), nil
+}
+
+// ListMembers 获取租户成员列表
+//
+// 参数:
+//   - tenantCode: 租户code
+//   - page: 页码，默认1
+//   - limit: 每页数量，默认且最大20
+//   - opt: 过滤选项，可以为 nil
+//
+// 返回:
+//   - []*v1.InternalTenantMember: 成员列表（包含未接受邀请的成员）
+//   - int64: 总数
+//   - error: 错误信息
+func (c *IAMClient) ListMembers(ctx context.Context, tenantCode string, page, limit int32, opt *ListMembersOptions) ([]*v1.InternalTenantMember, int64, error) {
+	if tenantCode == "" {
+		return nil, 0, fmt.Errorf("租户code不能为空")
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 || limit > 20 {
+		limit = 20
+	}
+
+	req := &v1.InternalListMembersRequest{
+		TenantCode: tenantCode,
+		Page:       page,
+		Limit:      limit,
+	}
+	if opt != nil {
+		req.Keyword = opt.Keyword
+		req.RoleCode = opt.RoleCode
+		req.Status = opt.Status
+	}
+
+	resp, err := c.client.InternalListMembers(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取租户成员列表失败, tenantCode=%s, err=%v", tenantCode, err)
+		return nil, 0, err
+	}
+
+	return resp.GetItems(), resp.GetTotal(), nil
+}
+
+// RemoveMember 将成员移出租户
+//
+// 参数:
+//   - tenantCode: 租户code
+//   - userCode: 用户code
+//
+// 返回:
+//   - error: 错误信息
+func (c *IAMClient) RemoveMember(ctx context.Context, tenantCode, userCode string) error {
+	if tenantCode == "" || userCode == "" {
+		return fmt.Errorf("租户code和用户code不能为空")
+	}
+
+	_, err := c.client.InternalRemoveMember(ctx, &v1.InternalRemoveMemberRequest{TenantCode: tenantCode, UserCode: userCode})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("移除租户成员失败, tenantCode=%s, userCode=%s, err=%v", tenantCode, userCode, err)
+		return err
+	}
+
+	return nil
+}
+
+// ChangeMemberRole 变更租户成员角色
+//
+// 参数:
+//   - tenantCode: 租户code
+//   - userCode: 用户code
+//   - roleCode: 新角色code
+//
+// 返回:
+//   - *v1.InternalTenantMember: 变更后的成员信息
+//   - error: 错误信息
+func (c *IAMClient) ChangeMemberRole(ctx context.Context, tenantCode, userCode, roleCode string) (*v1.InternalTenantMember, error) {
+	if tenantCode == "" || userCode == "" {
+		return nil, fmt.Errorf("租户code和用户code不能为空")
+	}
+	if roleCode == "" {
+		return nil, fmt.Errorf("角色code不能为空")
+	}
+
+	resp, err := c.client.InternalChangeMemberRole(ctx, &v1.InternalChangeMemberRoleRequest{
+		TenantCode: tenantCode,
+		UserCode:   userCode,
+		RoleCode:   roleCode,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("变更成员角色失败, tenantCode=%s, userCode=%s, roleCode=%s, err=%v", tenantCode, userCode, roleCode, err)
+		return nil, err
+	}
+
+	return resp.GetMember(), nil
+}
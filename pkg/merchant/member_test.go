@@ -0,0 +1,106 @@
+package merchant
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/merchant/v1"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// mockMemberClient 模拟租户成员接口
+type mockMemberClient struct {
+	mockIAMClient
+
+	inviteReq *v1.InternalInviteUserRequest
+	listReq   *v1.InternalListMembersRequest
+	removed   []string
+	roles     map[string]string
+}
+
+func (m *mockMemberClient) InternalInviteUser(_ context.Context, in *v1.InternalInviteUserRequest, _ ...grpc.CallOption) (*v1.InternalInviteUserResponse, error) {
+	m.inviteReq = in
+	return &v1.InternalInviteUserResponse{Member: &v1.InternalTenantMember{
+		TenantCode: in.GetTenantCode(),
+		Email:      in.GetEmail(),
+		RoleCode:   in.GetRoleCode(),
+		Status:     v1.InternalMemberStatus_MEMBER_STATUS_INVITED,
+	}}, nil
+}
+
+func (m *mockMemberClient) InternalListMembers(_ context.Context, in *v1.InternalListMembersRequest, _ ...grpc.CallOption) (*v1.InternalListMembersResponse, error) {
+	m.listReq = in
+	return &v1.InternalListMembersResponse{Items: []*v1.InternalTenantMember{{UserCode: "U1"}}, Total: 1}, nil
+}
+
+func (m *mockMemberClient) InternalRemoveMember(_ context.Context, in *v1.InternalRemoveMemberRequest, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.removed = append(m.removed, in.GetUserCode())
+	return &emptypb.Empty{}, nil
+}
+
+func (m *mockMemberClient) InternalChangeMemberRole(_ context.Context, in *v1.InternalChangeMemberRoleRequest, _ ...grpc.CallOption) (*v1.InternalChangeMemberRoleResponse, error) {
+	m.roles[in.GetUserCode()] = in.GetRoleCode()
+	return &v1.InternalChangeMemberRoleResponse{Member: &v1.InternalTenantMember{UserCode: in.GetUserCode(), RoleCode: in.GetRoleCode()}}, nil
+}
+
+func TestInviteUser(t *testing.T) {
+	mock := &mockMemberClient{}
+	c := newTestIAMClient(mock)
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U_ADMIN", TenantCode: "T001"})
+
+	member, err := c.InviteUser(ctx, &InviteUserOptions{TenantCode: "T001", Email: "alice@example.com", RoleCode: "admin"})
+	if err != nil {
+		t.Fatalf("InviteUser failed: %v", err)
+	}
+	if member.GetStatus() != v1.InternalMemberStatus_MEMBER_STATUS_INVITED {
+		t.Errorf("Unexpected member: %v", member)
+	}
+	if mock.inviteReq.GetInviterCode() != "U_ADMIN" {
+		t.Errorf("Expected inviter from context, got %q", mock.inviteReq.GetInviterCode())
+	}
+	if mock.inviteReq.Message != nil {
+		t.Error("Expected message to be omitted when empty")
+	}
+
+	if _, err := c.InviteUser(ctx, &InviteUserOptions{TenantCode: "T001", Email: "alice@example.com"}); err == nil {
+		t.Error("Expected error without role code")
+	}
+}
+
+func TestMembers(t *testing.T) {
+	mock := &mockMemberClient{roles: map[string]string{}}
+	c := newTestIAMClient(mock)
+	ctx := context.Background()
+	role := "admin"
+
+	members, total, err := c.ListMembers(ctx, "T001", 0, 50, &ListMembersOptions{RoleCode: &role})
+	if err != nil {
+		t.Fatalf("ListMembers failed: %v", err)
+	}
+	if len(members) != 1 || total != 1 {
+		t.Errorf("Unexpected members: %v, total=%d", members, total)
+	}
+	if mock.listReq.GetPage() != 1 || mock.listReq.GetLimit() != 20 || mock.listReq.GetRoleCode() != "admin" {
+		t.Errorf("Unexpected request: %v", mock.listReq)
+	}
+
+	member, err := c.ChangeMemberRole(ctx, "T001", "U1", "viewer")
+	if err != nil {
+		t.Fatalf("ChangeMemberRole failed: %v", err)
+	}
+	if member.GetRoleCode() != "viewer" || mock.roles["U1"] != "viewer" {
+		t.Errorf("Unexpected member: %v", member)
+	}
+
+	if err := c.RemoveMember(ctx, "T001", "U1"); err != nil {
+		t.Fatalf("RemoveMember failed: %v", err)
+	}
+	if len(mock.removed) != 1 || mock.removed[0] != "U1" {
+		t.Errorf("Unexpected removed: %v", mock.removed)
+	}
+	if err := c.RemoveMember(ctx, "T001", ""); err == nil {
+		t.Error("Expected error for empty user code")
+	}
+}
@@ -0,0 +1,135 @@
+package merchant
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/heyinLab/common/api/gen/go/merchant/v1"
+	"github.com/heyinLab/common/pkg/platform"
+)
+
+// SyncTenantPermissionsOptions 租户权限同步选项
+type SyncTenantPermissionsOptions struct {
+	// DryRun 仅计算差异，不实际下发
+	DryRun bool
+}
+
+// PermissionSyncReport 租户权限同步报告
+type PermissionSyncReport struct {
+	// TenantCode 租户code
+	TenantCode string
+	// Added 新增的权限codes，已排序
+	Added []string
+	// Removed 移除的权限codes，已排序
+	Removed []string
+	// Unchanged 保持不变的权限数量
+	Unchanged int
+	// DryRun 是否为预演
+	DryRun bool
+	// Applied 是否实际下发（无差异或预演时为 false）
+	Applied bool
+	// TotalCount 下发后最终生效的权限数量，未下发时为期望权限数量
+	TotalCount int32
+}
+
+// Changed 是否存在权限变更
+func (r *PermissionSyncReport) Changed() bool {
+	return len(r.Added) > 0 || len(r.Removed) > 0
+}
+
+// GetTenantPermissions 获取租户当前权限codes
+//
+// 参数:
+//   - tenantCode: 租户code
+//
+// 返回:
+//   - []string: 当前生效的权限codes
+//   - error: 错误信息
+func (c *IAMClient) GetTenantPermissions(ctx context.Context, tenantCode string) ([]string, error) {
+	if tenantCode == "" {
+		return nil, fmt.Errorf("租户code不能为空")
+	}
+
+	resp, err := c.client.InternalGetTenantPermissions(ctx, &v1.InternalGetTenantPermissionsRequest{TenantCode: tenantCode})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取租户权限失败, tenantCode=%s, err=%v", tenantCode, err)
+		return nil, err
+	}
+
+	return resp.GetCodes(), nil
+}
+
+// SyncTenantPermissions 以声明式方式同步租户权限
+//
+// 先获取租户当前权限，与期望权限比较后仅在存在差异时下发，并返回变更报告用于审计。
+// 与 SetTenantPermissions 直接覆盖不同，无差异时不会调用下发接口
+//
+// 参数:
+//   - tenantCode: 租户code
+//   - desiredCodes: 期望拥有的权限codes，空字符串和重复项会被忽略
+//   - opts: 同步选项，可以为 nil
+//
+// 返回:
+//   - *PermissionSyncReport: 变更报告
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	report, err := client.IAM().SyncTenantPermissions(ctx, tenantCode, codes, &merchant.SyncTenantPermissionsOptions{DryRun: true})
+//	if err != nil {
+//	    return err
+//	}
+//	log.Infof("新增=%v, 移除=%v", report.Added, report.Removed)
+func (c *IAMClient) SyncTenantPermissions(ctx context.Context, tenantCode string, desiredCodes []string, opts *SyncTenantPermissionsOptions) (*PermissionSyncReport, error) {
+	if tenantCode == "" {
+		return nil, fmt.Errorf("租户code不能为空")
+	}
+
+	desired := make([]string, 0, len(desiredCodes))
+	seen := make(map[string]struct{}, len(desiredCodes))
+	for _, code := range desiredCodes {
+		if code == "" {
+			continue
+		}
+		if _, ok := seen[code]; ok {
+			continue
+		}
+		seen[code] = struct{}{}
+		desired = append(desired, code)
+	}
+	if len(desired) == 0 {
+		return nil, fmt.Errorf("权限代码列表不能为空")
+	}
+
+	current, err := c.GetTenantPermissions(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	added, removed := platform.DiffPermissionCodes(current, desired)
+	report := &PermissionSyncReport{
+		TenantCode: tenantCode,
+		Added:      added,
+		Removed:    removed,
+		Unchanged:  len(desired) - len(added),
+		DryRun:     opts != nil && opts.DryRun,
+		TotalCount: int32(len(desired)),
+	}
+	if !report.Changed() || report.DryRun {
+		return report, nil
+	}
+
+	resp, err := c.SetTenantPermissions(ctx, tenantCode, desired)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.GetSuccess() {
+		return nil, fmt.Errorf("下发租户权限失败: %s", tenantCode)
+	}
+
+	report.Applied = true
+	report.TotalCount = resp.GetTotalCount()
+	c.logger.WithContext(ctx).Infof("同步租户权限完成, tenantCode=%s, added=%v, removed=%v", tenantCode, added, removed)
+
+	return report, nil
+}
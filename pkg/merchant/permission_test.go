@@ -0,0 +1,75 @@
+package merchant
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/heyinLab/common/api/gen/go/merchant/v1"
+	"google.golang.org/grpc"
+)
+
+// mockPermissionClient 模拟租户权限的获取和下发
+type mockPermissionClient struct {
+	mockIAMClient
+
+	codes    map[string][]string
+	setCalls int
+}
+
+func (m *mockPermissionClient) InternalGetTenantPermissions(_ context.Context, in *v1.InternalGetTenantPermissionsRequest, _ ...grpc.CallOption) (*v1.InternalGetTenantPermissionsResponse, error) {
+	return &v1.InternalGetTenantPermissionsResponse{Codes: m.codes[in.GetTenantCode()]}, nil
+}
+
+func (m *mockPermissionClient) SetTenantPermissions(_ context.Context, in *v1.SetTenantPermissionsRequest, _ ...grpc.CallOption) (*v1.SetTenantPermissionsResponse, error) {
+	m.setCalls++
+	m.codes[in.GetTenantCode()] = in.GetCodes()
+	return &v1.SetTenantPermissionsResponse{Success: true, TotalCount: int32(len(in.GetCodes()))}, nil
+}
+
+func newTestIAMClient(client v1.MerchantIamServiceClient) *IAMClient {
+	logger := log.NewHelper(log.DefaultLogger)
+	return &IAMClient{client: client, logger: logger, tenant: &TenantClient{client: client, logger: logger}}
+}
+
+func TestSyncTenantPermissions(t *testing.T) {
+	mock := &mockPermissionClient{codes: map[string][]string{
+		"T001": {"order:read", "order:write", "user:read"},
+	}}
+	c := newTestIAMClient(mock)
+	ctx := context.Background()
+	desired := []string{"order:read", "report:read", "user:read", "report:read", ""}
+
+	report, err := c.SyncTenantPermissions(ctx, "T001", desired, &SyncTenantPermissionsOptions{DryRun: true})
+	if err != nil {
+		t.Fatalf("SyncTenantPermissions failed: %v", err)
+	}
+	if !slices.Equal(report.Added, []string{"report:read"}) || !slices.Equal(report.Removed, []string{"order:write"}) {
+		t.Errorf("Unexpected diff: added=%v removed=%v", report.Added, report.Removed)
+	}
+	if report.Unchanged != 2 || report.Applied || mock.setCalls != 0 {
+		t.Errorf("Dry run should not apply: %+v, setCalls=%d", report, mock.setCalls)
+	}
+
+	report, err = c.SyncTenantPermissions(ctx, "T001", desired, nil)
+	if err != nil {
+		t.Fatalf("SyncTenantPermissions failed: %v", err)
+	}
+	if !report.Applied || report.TotalCount != 3 || mock.setCalls != 1 {
+		t.Errorf("Expected permissions applied: %+v, setCalls=%d", report, mock.setCalls)
+	}
+
+	// 再次同步无差异，不应下发
+	report, err = c.SyncTenantPermissions(ctx, "T001", desired, nil)
+	if err != nil {
+		t.Fatalf("SyncTenantPermissions failed: %v", err)
+	}
+	if report.Changed() || report.Applied || mock.setCalls != 1 {
+		t.Errorf("Expected no change: %+v, setCalls=%d", report, mock.setCalls)
+	}
+
+	if _, err := c.SyncTenantPermissions(ctx, "T001", []string{""}, nil); err == nil {
+		t.Error("Expected error for empty desired codes")
+	}
+}
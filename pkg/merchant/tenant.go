@@ -0,0 +1,231 @@
+package merchant
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/heyinLab/common/api/gen/go/merchant/v1"
+)
+
+// TenantClient 租户服务客户端
+//
+// 提供租户查询和统计相关功能
+type TenantClient struct {
+	client v1.MerchantIamServiceClient
+	logger *log.Helper
+}
+
+// ErrTenantNotFound 租户不存在，可通过 errors.Is 判断，gRPC 状态码为 NotFound
+var ErrTenantNotFound = errors.NotFound("TENANT_NOT_FOUND", "租户不存在")
+
+// MaxTenantCodes 按code批量查询时单次最多指定的租户数量
+const MaxTenantCodes = 100
+
+// 租户列表排序字段
+const (
+	TenantSortByCreateTime = "create_time" // 按创建时间
+	TenantSortByName       = "name"        // 按名称
+	TenantSortByMembersNum = "members_num" // 按成员数
+)
+
+// 租户列表排序方向
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+// ListTenantOptions 租户列表查询选项
+type ListTenantOptions struct {
+	Name        *string          // 名称
+	Status      *v1.TenantStatus // 状态
+	Country     *string          // 国家
+	Type        *v1.TenantType   // 类型
+	AccessLevel *v1.AccessLevel  // 访问等级
+	Codes       []string         // 租户code列表，最多 MaxTenantCodes 个，超过 MaxTenantPageSize 个时分批查询
+	SortBy      string           // 排序字段（TenantSortByCreateTime, TenantSortByName, TenantSortByMembersNum）
+	SortOrder   string           // 排序方向（SortOrderAsc, SortOrderDesc）
+}
+
+// ListTenant 获取租户列表
+//
+// 指定 Codes 时忽略 page 和 limit，按每批最多 MaxTenantPageSize 个code分批查询，
+// 合并返回指定的全部租户，排序仅在批内生效
+func (c *TenantClient) ListTenant(ctx context.Context, page, limit int32, opt *ListTenantOptions) (*v1.InternalListTenantResponse, error) {
+	if opt != nil && len(opt.Codes) > MaxTenantCodes {
+		return nil, fmt.Errorf("租户code数量不能超过%d", MaxTenantCodes)
+	}
+	if opt != nil && len(opt.Codes) > 0 {
+		return c.listTenantByCodes(ctx, opt)
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if limit <= 0 || limit > MaxTenantPageSize {
+		limit = MaxTenantPageSize
+	}
+	resp, err := c.client.InternalListTenant(ctx, newListTenantRequest(page, limit, opt))
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取租户列表失败, opt=%v, err=%v", opt, err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// listTenantByCodes 按code分批查询租户，合并各批结果
+func (c *TenantClient) listTenantByCodes(ctx context.Context, opt *ListTenantOptions) (*v1.InternalListTenantResponse, error) {
+	merged := &v1.InternalListTenantResponse{}
+	for codes := range slices.Chunk(opt.Codes, MaxTenantPageSize) {
+		batch := *opt
+		batch.Codes = codes
+		resp, err := c.client.InternalListTenant(ctx, newListTenantRequest(1, int32(len(codes)), &batch))
+		if err != nil {
+			c.logger.WithContext(ctx).Errorf("获取租户列表失败, codes=%v, err=%v", codes, err)
+			return nil, err
+		}
+		merged.Items = append(merged.Items, resp.GetItems()...)
+		merged.Total += resp.GetTotal()
+	}
+
+	return merged, nil
+}
+
+// newListTenantRequest 构建租户列表请求
+func newListTenantRequest(page, limit int32, opt *ListTenantOptions) *v1.InternalListTenantRequest {
+	req := &v1.InternalListTenantRequest{
+		Page:  page,
+		Limit: limit,
+	}
+	if opt != nil {
+		req.Name = opt.Name
+		req.Status = opt.Status
+		req.Country = opt.Country
+		req.Type = opt.Type
+		req.AccessLevel = opt.AccessLevel
+		req.Codes = opt.Codes
+		if opt.SortBy != "" {
+			req.SortBy = &opt.SortBy
+		}
+		if opt.SortOrder != "" {
+			req.SortOrder = &opt.SortOrder
+		}
+	}
+	return req
+}
+
+// InternalGetTenant 获取租户信息
+func (c *TenantClient) InternalGetTenant(ctx context.Context, tenantCode string) (*v1.InternalGetTenantResponse, error) {
+	resp, err := c.client.InternalGetTenant(ctx, &v1.InternalGetTenantRequest{TenantCode: tenantCode})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取用户信息失败, tenantCode=%v, err=%v", tenantCode, err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetTenantStats 获取商户统计信息
+func (c *TenantClient) GetTenantStats(ctx context.Context) (*v1.InternalGetTenantStatsResponse, error) {
+	resp, err := c.client.InternalGetTenantStats(ctx, &v1.InternalGetTenantStatsRequest{})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取商户统计信息失败, err=%v", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetTenant 根据租户code获取租户信息
+//
+// 参数:
+//   - tenantCode: 租户code
+//
+// 返回:
+//   - *v1.InternalTenant: 租户信息
+//   - error: 租户不存在时返回 ErrTenantNotFound，调用失败时返回原错误
+//
+// 使用示例:
+//
+//	tenant, err := client.Tenant().GetTenant(ctx, "T001")
+//	if errors.Is(err, merchant.ErrTenantNotFound) {
+//	    // 租户不存在
+//	}
+func (c *TenantClient) GetTenant(ctx context.Context, tenantCode string) (*v1.InternalTenant, error) {
+	if tenantCode == "" {
+		return nil, fmt.Errorf("租户code不能为空")
+	}
+
+	resp, err := c.client.InternalGetTenant(ctx, &v1.InternalGetTenantRequest{TenantCode: tenantCode})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取租户信息失败, tenantCode=%s, err=%v", tenantCode, err)
+		return nil, err
+	}
+	if resp.GetTenant() == nil {
+		return nil, ErrTenantNotFound.WithMetadata(map[string]string{"tenant_code": tenantCode})
+	}
+
+	return resp.GetTenant(), nil
+}
+
+// GetTenantSettings 获取租户设置
+//
+// 参数:
+//   - tenantCode: 租户code
+//
+// 返回:
+//   - *v1.InternalTenantSettings: 租户设置（语言、时区、货币等）
+//   - error: 错误信息
+func (c *TenantClient) GetTenantSettings(ctx context.Context, tenantCode string) (*v1.InternalTenantSettings, error) {
+	if tenantCode == "" {
+		return nil, fmt.Errorf("租户code不能为空")
+	}
+
+	resp, err := c.client.InternalGetTenantSettings(ctx, &v1.InternalGetTenantSettingsRequest{TenantCode: tenantCode})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取租户设置失败, tenantCode=%s, err=%v", tenantCode, err)
+		return nil, err
+	}
+
+	return resp.GetSettings(), nil
+}
+
+// UpdateTenantStatus 更新租户状态
+//
+// 用于平台运营后台暂停、恢复或终止租户
+//
+// 参数:
+//   - tenantCode: 租户code
+//   - status: 目标状态
+//   - reason: 变更原因，为空时不传递
+//
+// 返回:
+//   - *v1.InternalTenant: 变更后的租户信息
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	tenant, err := client.Tenant().UpdateTenantStatus(ctx, "T001", v1.TenantStatus_TENANT_STATUS_SUSPENDED, "欠费")
+func (c *TenantClient) UpdateTenantStatus(ctx context.Context, tenantCode string, status v1.TenantStatus, reason string) (*v1.InternalTenant, error) {
+	if tenantCode == "" {
+		return nil, fmt.Errorf("租户code不能为空")
+	}
+
+	req := &v1.InternalUpdateTenantStatusRequest{
+		TenantCode: tenantCode,
+		Status:     status,
+	}
+	if reason != "" {
+		req.Reason = &reason
+	}
+
+	resp, err := c.client.InternalUpdateTenantStatus(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("更新租户状态失败, tenantCode=%s, status=%s, err=%v", tenantCode, status, err)
+		return nil, err
+	}
+
+	return resp.GetTenant(), nil
+}
@@ -0,0 +1,157 @@
+package merchant
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/heyinLab/common/api/gen/go/merchant/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// mockIAMClient 模拟商户IAM服务，仅实现测试用到的方法
+type mockIAMClient struct {
+	v1.MerchantIamServiceClient
+
+	tenants      map[string]*v1.InternalTenant
+	settings     map[string]*v1.InternalTenantSettings
+	statusReq    *v1.InternalUpdateTenantStatusRequest
+	getTenantReq int
+}
+
+func (m *mockIAMClient) InternalGetTenant(_ context.Context, in *v1.InternalGetTenantRequest, _ ...grpc.CallOption) (*v1.InternalGetTenantResponse, error) {
+	m.getTenantReq++
+	return &v1.InternalGetTenantResponse{Tenant: m.tenants[in.GetTenantCode()]}, nil
+}
+
+func (m *mockIAMClient) InternalGetTenantSettings(_ context.Context, in *v1.InternalGetTenantSettingsRequest, _ ...grpc.CallOption) (*v1.InternalGetTenantSettingsResponse, error) {
+	return &v1.InternalGetTenantSettingsResponse{Settings: m.settings[in.GetTenantCode()]}, nil
+}
+
+func (m *mockIAMClient) InternalUpdateTenantStatus(_ context.Context, in *v1.InternalUpdateTenantStatusRequest, _ ...grpc.CallOption) (*v1.InternalUpdateTenantStatusResponse, error) {
+	m.statusReq = in
+	tenant := m.tenants[in.GetTenantCode()]
+	tenant.Status = in.GetStatus()
+	return &v1.InternalUpdateTenantStatusResponse{Tenant: tenant}, nil
+}
+
+func newTestTenantClient(client v1.MerchantIamServiceClient) *TenantClient {
+	return &TenantClient{client: client, logger: log.NewHelper(log.DefaultLogger)}
+}
+
+func TestGetTenant(t *testing.T) {
+	mock := &mockIAMClient{tenants: map[string]*v1.InternalTenant{
+		"T001": {Code: "T001", Name: "测试租户"},
+	}}
+	c := newTestTenantClient(mock)
+	ctx := context.Background()
+
+	tenant, err := c.GetTenant(ctx, "T001")
+	if err != nil {
+		t.Fatalf("GetTenant failed: %v", err)
+	}
+	if tenant.GetName() != "测试租户" {
+		t.Errorf("Unexpected tenant: %v", tenant)
+	}
+
+	if _, err := c.GetTenant(ctx, "T404"); !stderrors.Is(err, ErrTenantNotFound) || status.Code(err) != codes.NotFound {
+		t.Errorf("Expected ErrTenantNotFound for missing tenant, got %v", err)
+	}
+	if _, err := c.GetTenant(ctx, ""); err == nil {
+		t.Error("Expected error for empty tenant code")
+	}
+	if mock.getTenantReq != 2 {
+		t.Errorf("Expected 2 RPC calls, got %d", mock.getTenantReq)
+	}
+}
+
+func TestGetTenantSettings(t *testing.T) {
+	mock := &mockIAMClient{settings: map[string]*v1.InternalTenantSettings{
+		"T001": {TenantCode: "T001", Timezone: "Asia/Shanghai"},
+	}}
+	c := newTestTenantClient(mock)
+
+	settings, err := c.GetTenantSettings(context.Background(), "T001")
+	if err != nil {
+		t.Fatalf("GetTenantSettings failed: %v", err)
+	}
+	if settings.GetTimezone() != "Asia/Shanghai" {
+		t.Errorf("Unexpected settings: %v", settings)
+	}
+}
+
+func TestUpdateTenantStatus(t *testing.T) {
+	mock := &mockIAMClient{tenants: map[string]*v1.InternalTenant{
+		"T001": {Code: "T001", Status: v1.TenantStatus_TENANT_STATUS_ACTIVE},
+	}}
+	c := newTestTenantClient(mock)
+	ctx := context.Background()
+
+	tenant, err := c.UpdateTenantStatus(ctx, "T001", v1.TenantStatus_TENANT_STATUS_SUSPENDED, "欠费")
+	if err != nil {
+		t.Fatalf("UpdateTenantStatus failed: %v", err)
+	}
+	if tenant.GetStatus() != v1.TenantStatus_TENANT_STATUS_SUSPENDED {
+		t.Errorf("Unexpected status: %v", tenant.GetStatus())
+	}
+	if mock.statusReq.GetReason() != "欠费" {
+		t.Errorf("Unexpected reason: %q", mock.statusReq.GetReason())
+	}
+
+	if _, err := c.UpdateTenantStatus(ctx, "T001", v1.TenantStatus_TENANT_STATUS_ACTIVE, ""); err != nil {
+		t.Fatalf("UpdateTenantStatus failed: %v", err)
+	}
+	if mock.statusReq.Reason != nil {
+		t.Error("Expected reason to be omitted when empty")
+	}
+}
+
+func TestListTenantOptions(t *testing.T) {
+	mock := &mockListTenantClient{items: newMockTenants(30)}
+	c := newTestTenantClient(mock)
+	ctx := context.Background()
+	level := v1.AccessLevel_ACCESS_LEVEL_BETA
+
+	_, err := c.ListTenant(ctx, 1, 0, &ListTenantOptions{
+		AccessLevel: &level,
+		SortBy:      TenantSortByName,
+		SortOrder:   SortOrderDesc,
+	})
+	if err != nil {
+		t.Fatalf("ListTenant failed: %v", err)
+	}
+	req := mock.lastReq
+	if req.AccessLevel == nil || req.GetAccessLevel() != level {
+		t.Errorf("Expected access level passthrough, got %v", req.AccessLevel)
+	}
+	if req.GetSortBy() != TenantSortByName || req.GetSortOrder() != SortOrderDesc {
+		t.Errorf("Unexpected sort: %q %q", req.GetSortBy(), req.GetSortOrder())
+	}
+	if req.GetLimit() != MaxTenantPageSize {
+		t.Errorf("Expected default limit %d, got %d", MaxTenantPageSize, req.GetLimit())
+	}
+
+	codes := make([]string, 25)
+	for i := range codes {
+		codes[i] = fmt.Sprintf("T%03d", i+1)
+	}
+	mock.pages = nil
+	resp, err := c.ListTenant(ctx, 1, 0, &ListTenantOptions{Codes: codes})
+	if err != nil {
+		t.Fatalf("ListTenant failed: %v", err)
+	}
+	if len(mock.pages) != 2 || len(mock.lastReq.GetCodes()) != 5 || mock.lastReq.GetLimit() != 5 {
+		t.Errorf("Expected codes split into batches of %d, got pages=%v last=%v", MaxTenantPageSize, mock.pages, mock.lastReq.GetCodes())
+	}
+	if len(resp.GetItems()) != 25 || resp.GetTotal() != 25 {
+		t.Errorf("Expected all 25 tenants merged, got %d total=%d", len(resp.GetItems()), resp.GetTotal())
+	}
+
+	if _, err := c.ListTenant(ctx, 1, 0, &ListTenantOptions{Codes: make([]string, MaxTenantCodes+1)}); err == nil {
+		t.Error("Expected error for too many codes")
+	}
+}
@@ -2,11 +2,14 @@ package auth
 
 import (
 	"context"
+	stderrors "errors"
 	"strconv"
 
 	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/golang-jwt/jwt/v5"
 	businessErrors "github.com/heyinLab/common/pkg/errors"
 	"github.com/heyinLab/common/pkg/middleware/common"
 )
@@ -28,10 +31,15 @@ func GetAPIKeyID(ctx context.Context) uint64 {
 }
 
 // GetProductCode 获取产品编码（仅 OpenAPI 认证有值）
+//
+// 服务间调用时从 ExtractClaims 还原的 Claims 中获取
 func GetProductCode(ctx context.Context) string {
 	if v, ok := ctx.Value(common.KeyProductCode).(string); ok {
 		return v
 	}
+	if claims, ok := FromContext(ctx); ok {
+		return claims.ProductCode
+	}
 	return ""
 }
 
@@ -59,28 +67,59 @@ func GetOperator(ctx context.Context) Operator {
 }
 
 // Server 统一认证中间件，支持 JWT Token 和 OpenAPI 两种认证方式
-func Server() middleware.Middleware {
+//
+// 从网关注入的 Header 中读取用户code、租户code和区域，生成 Claims 注入 context，
+// 业务代码通过 FromContext 获取
+//
+// 参数:
+//   - needTenant: 是否要求租户code，平台级接口（无租户上下文）传 false
+//   - opts: 可选配置，如 WithSkipPaths、WithSelector、WithLegacyHeaders、WithJWT
+//
+// 使用示例:
+//
+//	srv := http.NewServer(
+//	    http.Middleware(auth.Server(true, auth.WithSkipPaths("/healthz", "/metrics"))),
+//	)
+func Server(needTenant bool, opts ...Option) middleware.Middleware {
+	o := newOptions(opts)
+
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
 			// 从 context 中获取 transport 信息 (HTTP/gRPC)
 			tr, ok := transport.FromServerContext(ctx)
 			if !ok {
-				return nil, errors.New(
-					int(businessErrors.ErrSystemError.HttpCode),
-					businessErrors.ErrSystemError.Type,
-					businessErrors.ErrSystemError.Message,
-				)
+				return nil, businessErrors.ErrSystemError.ToKratos()
+			}
+
+			// 公开接口跳过认证
+			if o.skip(ctx, tr.Operation()) {
+				return handler(ctx, req)
 			}
 
 			header := tr.RequestHeader()
 
+			// JWT 校验模式：Claims 全部取自令牌
+			if o.jwt != nil {
+				claims, err := authenticateJWT(ctx, o.jwt, header.Get("Authorization"), needTenant)
+				if err != nil {
+					return nil, err
+				}
+				return handler(NewContext(ctx, claims), req)
+			}
+
 			// 1. 先检查认证类型
-			authType := header.Get("X-Auth-Type")
-			isOpenAPI := authType == "openapi"
+			isOpenAPI := header.Get(common.AUTHTYPE) == string(common.AuthTypeOpenAPI)
 
-			// 2. 读取公共 headers (现在使用 code 字符串)
+			// 2. 读取公共 headers，兼容模式下回退到旧版数字 ID
 			userCode := header.Get(common.USERCODE)
+			tenantCode := header.Get(common.TENANTCODE)
 			regionName := header.Get(common.REGIONNAME)
+			if o.legacyHeaders {
+				userCode, tenantCode, err = o.canonicalize(ctx, header, userCode, tenantCode)
+				if err != nil {
+					return nil, err
+				}
+			}
 
 			if !isOpenAPI {
 				// JWT Token 认证：X-User-Code 必须存在且有效
@@ -93,15 +132,9 @@ func Server() middleware.Middleware {
 				}
 			}
 
-			// 3. 处理租户 Code
-			var tenantCode string
-			tenantCode = header.Get(common.TENANTCODE)
-			if tenantCode == "" {
-				return nil, errors.New(
-					int(businessErrors.ErrTenantMissing.HttpCode),
-					businessErrors.ErrTenantMissing.Type,
-					businessErrors.ErrTenantMissing.Message,
-				)
+			// 3. 检查租户 Code
+			if needTenant && tenantCode == "" {
+				return nil, businessErrors.ErrTenantMissing.ToKratos()
 			}
 
 			// 4. 创建 Claims 并注入 context
@@ -109,6 +142,11 @@ func Server() middleware.Middleware {
 				UserCode:   userCode,
 				TenantCode: tenantCode,
 				RegionName: regionName,
+				Roles:      common.SplitValues(header.Get(common.ROLES)),
+				Scopes:     common.SplitValues(header.Get(common.SCOPES)),
+			}
+			if isOpenAPI {
+				claims.ProductCode = header.Get(common.PRODUCTCODE)
 			}
 			newCtx := NewContext(ctx, claims)
 
@@ -117,14 +155,14 @@ func Server() middleware.Middleware {
 				newCtx = context.WithValue(newCtx, common.KeyAuthType, common.AuthTypeOpenAPI)
 
 				// 读取 API Key ID
-				if apiKeyIDStr := header.Get("X-API-Key-ID"); apiKeyIDStr != "" {
+				if apiKeyIDStr := header.Get(common.APIKEYID); apiKeyIDStr != "" {
 					if id, err := strconv.ParseUint(apiKeyIDStr, 10, 64); err == nil {
 						newCtx = context.WithValue(newCtx, common.KeyAPIKeyID, id)
 					}
 				}
 
 				// 读取 Product Code
-				if productCode := header.Get("X-Product-Code"); productCode != "" {
+				if productCode := header.Get(common.PRODUCTCODE); productCode != "" {
 					newCtx = context.WithValue(newCtx, common.KeyProductCode, productCode)
 				}
 			}
@@ -133,3 +171,69 @@ func Server() middleware.Middleware {
 		}
 	}
 }
+
+// canonicalize code Header 缺失时读取旧版数字 ID Header，配置了 IdentityResolver 时解析为 code
+func (o *options) canonicalize(ctx context.Context, header transport.Header, userCode, tenantCode string) (string, string, error) {
+	if userCode == "" {
+		if userID := header.Get(common.LEGACYUSERID); userID != "" {
+			code, err := o.resolveLegacy(ctx, userID, o.identity.userCode)
+			if err != nil {
+				return "", "", err
+			}
+			userCode = code
+		}
+	}
+	if tenantCode == "" {
+		if tenantID := header.Get(common.LEGACYTENANTID); tenantID != "" {
+			code, err := o.resolveLegacy(ctx, tenantID, o.identity.tenantCode)
+			if err != nil {
+				return "", "", err
+			}
+			tenantCode = code
+		}
+	}
+	return userCode, tenantCode, nil
+}
+
+// resolveLegacy 解析旧版数字 ID，未配置 IdentityResolver 时原样返回
+func (o *options) resolveLegacy(ctx context.Context, id string, resolve func(context.Context, string) (string, error)) (string, error) {
+	if o.identity == nil {
+		return id, nil
+	}
+	code, err := resolve(ctx, id)
+	if err != nil {
+		log.Context(ctx).Errorf("解析旧版 ID Header 失败: id=%s, error=%v", id, err)
+		return "", businessErrors.ErrAuthServiceError.ToKratos()
+	}
+	if code == "" {
+		return "", businessErrors.ErrAuthHeaderInvalid.ToKratos()
+	}
+	return code, nil
+}
+
+// authenticateJWT 校验 Authorization 头中的 Bearer Token
+func authenticateJWT(ctx context.Context, v *jwtValidator, authorization string, needTenant bool) (*Claims, error) {
+	if authorization == "" {
+		return nil, businessErrors.ErrAuthHeaderMissing.ToKratos()
+	}
+	token, ok := bearerToken(authorization)
+	if !ok {
+		return nil, businessErrors.ErrAuthHeaderInvalid.ToKratos()
+	}
+
+	claims, err := v.validate(ctx, token)
+	switch {
+	case stderrors.Is(err, errJWKSUnavailable):
+		log.Context(ctx).Errorf("JWT 校验失败: %v", err)
+		return nil, businessErrors.ErrAuthServiceError.ToKratos()
+	case stderrors.Is(err, jwt.ErrTokenExpired):
+		return nil, businessErrors.ErrTokenExpired.ToKratos()
+	case err != nil:
+		return nil, businessErrors.ErrTokenInvalid.ToKratos().WithCause(err)
+	}
+
+	if needTenant && claims.TenantCode == "" {
+		return nil, businessErrors.ErrTenantMissing.ToKratos()
+	}
+	return claims, nil
+}
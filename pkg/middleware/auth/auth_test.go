@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// headerCarrier 基于 http.Header 的 transport.Header 实现
+type headerCarrier nethttp.Header
+
+func (h headerCarrier) Get(key string) string      { return nethttp.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { nethttp.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { nethttp.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return nethttp.Header(h).Values(key) }
+
+// mockTransport 模拟服务端 transport，仅提供请求 Header
+type mockTransport struct {
+	header headerCarrier
+}
+
+func (t *mockTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *mockTransport) Endpoint() string                { return "" }
+func (t *mockTransport) Operation() string               { return "/test.v1.Test/Get" }
+func (t *mockTransport) RequestHeader() transport.Header { return t.header }
+func (t *mockTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+// serve 以指定 Header 调用中间件，返回注入的 Claims
+func serve(t *testing.T, headers map[string]string, opts ...Option) (*Claims, error) {
+	t.Helper()
+
+	h := headerCarrier{}
+	for k, v := range headers {
+		h.Set(k, v)
+	}
+	ctx := transport.NewServerContext(context.Background(), &mockTransport{header: h})
+
+	var claims *Claims
+	_, err := Server(true, opts...)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		claims, _ = FromContext(ctx)
+		return nil, nil
+	})(ctx, nil)
+	return claims, err
+}
+
+func TestServer(t *testing.T) {
+	claims, err := serve(t, map[string]string{
+		"X-User-Code":   "U001",
+		"X-Tenant-Code": "T001",
+		"X-Region-Name": "cn",
+		"X-User-Roles":  "admin, finance",
+		"X-User-Scopes": "order:read",
+	})
+	if err != nil {
+		t.Fatalf("Server failed: %v", err)
+	}
+	if claims.UserCode != "U001" || claims.TenantCode != "T001" || claims.RegionName != "cn" {
+		t.Errorf("Unexpected claims: %+v", claims)
+	}
+	if !claims.HasRole("finance") || !claims.HasScope("order:read") || claims.HasRole("owner") {
+		t.Errorf("Unexpected roles or scopes: %+v", claims)
+	}
+
+	// 默认不读取旧版 Header
+	if _, err := serve(t, map[string]string{"X-User-ID": "1", "X-Tenant-ID": "2"}); errors.Code(err) != 401 {
+		t.Errorf("Expected 401 for legacy headers, got %v", err)
+	}
+
+	// OpenAPI 请求不要求用户code
+	claims, err = serve(t, map[string]string{"X-Auth-Type": "openapi", "X-Tenant-Code": "T001", "X-Product-Code": "mall"})
+	if err != nil || claims.ProductCode != "mall" {
+		t.Errorf("Unexpected openapi claims: %+v, %v", claims, err)
+	}
+}
+
+func TestServer_LegacyHeaders(t *testing.T) {
+	claims, err := serve(t, map[string]string{"X-User-ID": "1", "X-Tenant-ID": "2"}, WithLegacyHeaders())
+	if err != nil {
+		t.Fatalf("Server failed: %v", err)
+	}
+	if claims.UserCode != "1" || claims.TenantCode != "2" {
+		t.Errorf("Unexpected claims: %+v", claims)
+	}
+
+	// 新旧 Header 同时存在时以 code Header 为准
+	claims, err = serve(t, map[string]string{
+		"X-User-Code": "U001", "X-User-ID": "1",
+		"X-Tenant-Code": "T001", "X-Tenant-ID": "2",
+	}, WithLegacyHeaders())
+	if err != nil || claims.UserCode != "U001" || claims.TenantCode != "T001" {
+		t.Errorf("Unexpected claims: %+v, %v", claims, err)
+	}
+}
+
+func TestServer_NeedTenant(t *testing.T) {
+	ctx := transport.NewServerContext(context.Background(), &mockTransport{header: headerCarrier{"X-User-Code": {"U001"}}})
+
+	var claims *Claims
+	_, err := Server(false)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		claims, _ = FromContext(ctx)
+		return nil, nil
+	})(ctx, nil)
+	if err != nil || claims.UserCode != "U001" || claims.TenantCode != "" {
+		t.Errorf("Unexpected claims without tenant: %+v, %v", claims, err)
+	}
+}
+
+func TestServer_Skip(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []Option
+		skip bool
+	}{
+		{name: "exact operation", opts: []Option{WithSkipPaths("/test.v1.Test/Get")}, skip: true},
+		{name: "prefix", opts: []Option{WithSkipPaths("/test.v1.Test/*")}, skip: true},
+		{name: "no match", opts: []Option{WithSkipPaths("/healthz", "/test.v1.Test/List")}, skip: false},
+		{name: "selector", opts: []Option{WithSelector(func(ctx context.Context, operation string) bool {
+			return operation != "/test.v1.Test/Get"
+		})}, skip: true},
+		{name: "selector required", opts: []Option{WithSelector(func(ctx context.Context, operation string) bool {
+			return true
+		})}, skip: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 请求没有任何认证 Header，跳过认证时不报错
+			_, err := serve(t, nil, tt.opts...)
+			if skipped := err == nil; skipped != tt.skip {
+				t.Errorf("Expected skip=%v, got err=%v", tt.skip, err)
+			}
+		})
+	}
+}
+
+// mockResolver 模拟 ID 到 code 的解析
+type mockResolver struct {
+	calls int
+	err   error
+}
+
+func (r *mockResolver) ResolveUserCode(_ context.Context, userID string) (string, error) {
+	r.calls++
+	if userID == "1" {
+		return "U001", r.err
+	}
+	return "", r.err
+}
+
+func (r *mockResolver) ResolveTenantCode(_ context.Context, tenantID string) (string, error) {
+	r.calls++
+	if tenantID == "2" {
+		return "T001", r.err
+	}
+	return "", r.err
+}
+
+func TestServer_IdentityResolver(t *testing.T) {
+	resolver := &mockResolver{}
+	opt := WithIdentityResolver(resolver)
+
+	// 旧版 ID Header 解析为 code
+	claims, err := serve(t, map[string]string{"X-User-ID": "1", "X-Tenant-ID": "2"}, opt)
+	if err != nil || claims.UserCode != "U001" || claims.TenantCode != "T001" {
+		t.Fatalf("Unexpected claims: %+v, %v", claims, err)
+	}
+
+	// 新版 code Header 不解析
+	claims, err = serve(t, map[string]string{"X-User-Code": "U002", "X-Tenant-Code": "T002", "X-User-ID": "1"}, opt)
+	if err != nil || claims.UserCode != "U002" || claims.TenantCode != "T002" {
+		t.Errorf("Unexpected claims: %+v, %v", claims, err)
+	}
+	if resolver.calls != 2 {
+		t.Errorf("Expected 2 resolver calls, got %d", resolver.calls)
+	}
+
+	// 同一个中间件实例内缓存解析结果
+	h := headerCarrier{}
+	h.Set("X-User-ID", "1")
+	h.Set("X-Tenant-ID", "2")
+	srv := Server(true, opt)(func(context.Context, interface{}) (interface{}, error) { return nil, nil })
+	for i := 0; i < 2; i++ {
+		srv(transport.NewServerContext(context.Background(), &mockTransport{header: h}), nil)
+	}
+	if resolver.calls != 4 {
+		t.Errorf("Expected cached resolution, got %d calls", resolver.calls)
+	}
+
+	// ID 不存在
+	if _, err := serve(t, map[string]string{"X-User-ID": "9", "X-Tenant-ID": "2"}, opt); errors.Reason(err) != "AUTH_HEADER_INVALID" {
+		t.Errorf("Expected AUTH_HEADER_INVALID, got %v", err)
+	}
+
+	// 解析失败
+	if _, err := serve(t, map[string]string{"X-User-ID": "1"}, WithIdentityResolver(&mockResolver{err: context.DeadlineExceeded})); errors.Reason(err) != "AUTH_SERVICE_ERROR" {
+		t.Errorf("Expected AUTH_SERVICE_ERROR, got %v", err)
+	}
+}
@@ -1,11 +1,44 @@
 package auth
 
-import "context"
+import (
+	"context"
+	"slices"
+)
 
+// Claims 认证信息，由 Server（HTTP/gRPC 入口）或 ExtractClaims（服务间调用）注入 context
 type Claims struct {
-	UserCode   string
+	// UserCode 用户code，OpenAPI 请求可能为空
+	UserCode string
+	// TenantCode 租户code
 	TenantCode string
+	// RegionName 区域名称
 	RegionName string
+	// Roles 角色code列表，用于粗粒度鉴权
+	Roles []string
+	// Scopes 授权范围列表，如 order:read
+	Scopes []string
+	// ProductCode 产品编码，OpenAPI 请求为凭证所属产品
+	ProductCode string
+
+	// ImpersonatorCode 代操作时的实际操作者（平台管理员）用户code，此时 UserCode 为被代操作的用户
+	ImpersonatorCode string
+	// DelegationToken 代操作授权令牌，由 IAM 签发，下游服务可据此校验代操作的合法性
+	DelegationToken string
+}
+
+// IsImpersonated 是否为代操作请求
+func (c *Claims) IsImpersonated() bool {
+	return c != nil && c.ImpersonatorCode != ""
+}
+
+// HasRole 是否拥有指定角色
+func (c *Claims) HasRole(role string) bool {
+	return c != nil && slices.Contains(c.Roles, role)
+}
+
+// HasScope 是否拥有指定授权范围
+func (c *Claims) HasScope(scope string) bool {
+	return c != nil && slices.Contains(c.Scopes, scope)
 }
 
 // 定义用于在 context 中传递 Claims 的 key
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/heyinLab/common/pkg/internal/ttlcache"
+)
+
+// DefaultIdentityCacheTTL 旧版数字 ID 与 code 映射的默认缓存时间
+const DefaultIdentityCacheTTL = 10 * time.Minute
+
+// IdentityResolver 将旧版网关的数字 ID 解析为 code
+//
+// ID 不存在时返回空字符串和 nil 错误，请求按无效认证头拒绝；
+// 返回错误时视为认证服务异常
+type IdentityResolver interface {
+	// ResolveUserCode 根据用户ID获取用户code
+	ResolveUserCode(ctx context.Context, userID string) (string, error)
+	// ResolveTenantCode 根据租户ID获取租户code
+	ResolveTenantCode(ctx context.Context, tenantID string) (string, error)
+}
+
+// WithIdentityResolver 兼容旧版网关的数字 ID Header，并解析为 code
+//
+// 开启后 X-User-Code/X-Tenant-Code 缺失时读取 X-User-ID/X-Tenant-ID，
+// 通过 resolver 解析为 code 后写入 Claims，业务代码只需处理 code。
+// 解析结果在进程内缓存 DefaultIdentityCacheTTL
+//
+// 与 WithLegacyHeaders 的区别: 后者将数字 ID 原样作为 code，仅适用于下游已兼容数字 ID 的服务
+//
+// 使用示例:
+//
+//	auth.Server(true, auth.WithIdentityResolver(merchantResolver))
+func WithIdentityResolver(resolver IdentityResolver) Option {
+	return func(o *options) {
+		o.legacyHeaders = true
+		o.identity = newIdentityCache(resolver, DefaultIdentityCacheTTL)
+	}
+}
+
+// identityCache 缓存 ID 到 code 的解析结果
+type identityCache struct {
+	resolver IdentityResolver
+	ttl      time.Duration
+	entries  *ttlcache.Cache[string, string]
+}
+
+func newIdentityCache(resolver IdentityResolver, ttl time.Duration) *identityCache {
+	return &identityCache{resolver: resolver, ttl: ttl, entries: ttlcache.New[string, string](0)}
+}
+
+// userCode 解析用户ID
+func (c *identityCache) userCode(ctx context.Context, userID string) (string, error) {
+	return c.resolve(ctx, "user:"+userID, func() (string, error) {
+		return c.resolver.ResolveUserCode(ctx, userID)
+	})
+}
+
+// tenantCode 解析租户ID
+func (c *identityCache) tenantCode(ctx context.Context, tenantID string) (string, error) {
+	return c.resolve(ctx, "tenant:"+tenantID, func() (string, error) {
+		return c.resolver.ResolveTenantCode(ctx, tenantID)
+	})
+}
+
+// resolve 查询缓存，未命中时调用 fn 并缓存成功的结果
+func (c *identityCache) resolve(ctx context.Context, key string, fn func() (string, error)) (string, error) {
+	if code, ok := c.entries.Get(key); ok {
+		return code, nil
+	}
+
+	code, err := fn()
+	if err != nil || code == "" {
+		return code, err
+	}
+
+	c.entries.Set(key, code, c.ttl)
+	return code, nil
+}
@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// minJWKSRefreshInterval 两次拉取 JWKS 的最小间隔，防止伪造 kid 或 IAM 故障时打满 IAM
+	minJWKSRefreshInterval = 30 * time.Second
+
+	// jwksFetchTimeout 拉取 JWKS 的超时时间
+	jwksFetchTimeout = 10 * time.Second
+)
+
+// errUnknownKeyID JWKS 中不存在令牌指定的 kid
+var errUnknownKeyID = errors.New("未知的签名密钥")
+
+// jwk JSON Web Key，仅包含校验签名需要的字段
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// jwks 带缓存的 JWKS 公钥集合
+type jwks struct {
+	url      string
+	client   *http.Client
+	interval time.Duration
+
+	mu          sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	fetchedAt   time.Time // 最近一次拉取成功的时间
+	lastAttempt time.Time // 最近一次拉取的时间
+	lastErr     error     // 最近一次拉取的错误，成功时为 nil
+	fetchMu     sync.Mutex
+}
+
+func newJWKS(url string, client *http.Client, interval time.Duration) *jwks {
+	return &jwks{url: url, client: client, interval: interval}
+}
+
+// key 按 kid 获取公钥
+//
+// 缓存过期时刷新；kid 不存在时在最小刷新间隔之外强制刷新一次，以支持密钥轮换。
+// 拉取失败后的最小刷新间隔内不再拉取，直接返回上次的错误（有旧公钥时继续使用旧公钥）
+func (s *jwks) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	s.mu.RLock()
+	key, ok := s.keys[kid]
+	fresh := !s.fetchedAt.IsZero() && time.Since(s.fetchedAt) < s.interval
+	canForce := time.Since(s.lastAttempt) >= minJWKSRefreshInterval
+	lastErr := s.lastErr
+	s.mu.RUnlock()
+
+	if ok && fresh {
+		return key, nil
+	}
+
+	var err error
+	switch {
+	case lastErr != nil && !canForce:
+		err = lastErr
+	case !fresh || canForce:
+		err = s.refresh(ctx)
+	}
+	if err != nil {
+		// 刷新失败时继续使用旧公钥
+		if ok {
+			return key, nil
+		}
+		return nil, err
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if key, ok := s.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("%w: kid=%s", errUnknownKeyID, kid)
+}
+
+// refresh 重新拉取 JWKS，并发调用时只拉取一次，等待的请求共享拉取结果
+//
+// 拉取使用与调用方请求分离的上下文，第一个请求被取消不影响其他等待的请求
+func (s *jwks) refresh(ctx context.Context) error {
+	started := time.Now()
+	s.fetchMu.Lock()
+	defer s.fetchMu.Unlock()
+
+	// 等待锁期间其他请求已完成拉取
+	s.mu.RLock()
+	attempted := !s.lastAttempt.Before(started)
+	lastErr := s.lastErr
+	s.mu.RUnlock()
+	if attempted {
+		return lastErr
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), jwksFetchTimeout)
+	defer cancel()
+	keys, err := s.fetch(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastAttempt = time.Now()
+	s.lastErr = err
+	if err != nil {
+		return err
+	}
+	s.keys = keys
+	s.fetchedAt = s.lastAttempt
+	return nil
+}
+
+// fetch 请求 JWKS 地址并解析公钥，跳过不支持的密钥类型和非签名用途的密钥
+func (s *jwks) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建 JWKS 请求失败: %w", err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("获取 JWKS 失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("获取 JWKS 失败: status=%d", resp.StatusCode)
+	}
+
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("解析 JWKS 失败: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = key
+	}
+	return keys, nil
+}
+
+// publicKey 将 JWK 转换为公钥，支持 RSA、EC (P-256/P-384/P-521) 和 OKP (Ed25519)
+func (k *jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() {
+			return nil, fmt.Errorf("RSA 公钥指数无效")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("不支持的椭圆曲线: %s", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("不支持的曲线: %s", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("Ed25519 公钥无效")
+		}
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("不支持的密钥类型: %s", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(data) == 0 {
+		return nil, fmt.Errorf("密钥参数无效")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
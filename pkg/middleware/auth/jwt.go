@@ -0,0 +1,209 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// DefaultJWTClockSkew 默认允许的时钟偏差
+	DefaultJWTClockSkew = time.Minute
+
+	// DefaultJWKSRefreshInterval 默认 JWKS 缓存刷新间隔
+	DefaultJWKSRefreshInterval = 10 * time.Minute
+)
+
+// jwtValidMethods 允许的签名算法，只接受非对称算法，防止 none 和算法混淆攻击
+var jwtValidMethods = []string{
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+// JWTConfig JWT 校验配置
+type JWTConfig struct {
+	// JWKSURL 公钥集合地址（必填），如 https://iam.example.com/.well-known/jwks.json
+	JWKSURL string
+	// Issuer 签发者，为空时不校验
+	Issuer string
+	// Audience 受众，为空时不校验
+	Audience string
+	// ClockSkew 允许的时钟偏差，默认 DefaultJWTClockSkew
+	ClockSkew time.Duration
+	// RefreshInterval JWKS 缓存刷新间隔，默认 DefaultJWKSRefreshInterval
+	RefreshInterval time.Duration
+	// HTTPClient 获取 JWKS 使用的 HTTP 客户端，默认超时 10s
+	HTTPClient *http.Client
+
+	// UserCodeClaim 用户code所在的声明，默认 sub
+	UserCodeClaim string
+	// TenantCodeClaim 租户code所在的声明，默认 tenant_code
+	TenantCodeClaim string
+	// RegionNameClaim 区域名称所在的声明，默认 region_name
+	RegionNameClaim string
+	// RolesClaim 角色列表所在的声明，默认 roles
+	RolesClaim string
+	// ScopesClaim 授权范围所在的声明，默认 scope（空格分隔，RFC 8693），也支持数组
+	ScopesClaim string
+	// ProductCodeClaim 产品编码所在的声明，默认 product_code
+	ProductCodeClaim string
+}
+
+// WithJWT 由中间件自行校验 Bearer Token，不再信任网关注入的 Header
+//
+// 用于不经过网关直接暴露的服务（Webhook、内部工具等）。开启后从 Authorization 头读取令牌，
+// 使用 JWKS 公钥校验签名，并校验过期时间、签发者和受众，Claims 全部取自令牌；
+// X-User-Code 等网关 Header 和 OpenAPI 认证均被忽略
+//
+// 使用示例:
+//
+//	auth.Server(true, auth.WithJWT(&auth.JWTConfig{
+//	    JWKSURL:  "https://iam.example.com/.well-known/jwks.json",
+//	    Issuer:   "https://iam.example.com",
+//	    Audience: "order-server",
+//	}))
+//
+// 注意: JWKSURL 为空时 panic
+func WithJWT(cfg *JWTConfig) Option {
+	v := newJWTValidator(cfg)
+	return func(o *options) {
+		o.jwt = v
+	}
+}
+
+// jwtValidator JWT 校验器
+type jwtValidator struct {
+	cfg    JWTConfig
+	keys   *jwks
+	parser *jwt.Parser
+}
+
+func newJWTValidator(cfg *JWTConfig) *jwtValidator {
+	if cfg == nil || cfg.JWKSURL == "" {
+		panic("auth: JWKSURL 不能为空")
+	}
+
+	c := *cfg
+	if c.ClockSkew <= 0 {
+		c.ClockSkew = DefaultJWTClockSkew
+	}
+	if c.RefreshInterval <= 0 {
+		c.RefreshInterval = DefaultJWKSRefreshInterval
+	}
+	if c.HTTPClient == nil {
+		c.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	if c.UserCodeClaim == "" {
+		c.UserCodeClaim = "sub"
+	}
+	if c.TenantCodeClaim == "" {
+		c.TenantCodeClaim = "tenant_code"
+	}
+	if c.RegionNameClaim == "" {
+		c.RegionNameClaim = "region_name"
+	}
+	if c.RolesClaim == "" {
+		c.RolesClaim = "roles"
+	}
+	if c.ScopesClaim == "" {
+		c.ScopesClaim = "scope"
+	}
+	if c.ProductCodeClaim == "" {
+		c.ProductCodeClaim = "product_code"
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(jwtValidMethods),
+		jwt.WithLeeway(c.ClockSkew),
+		jwt.WithExpirationRequired(),
+	}
+	if c.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(c.Issuer))
+	}
+	if c.Audience != "" {
+		opts = append(opts, jwt.WithAudience(c.Audience))
+	}
+
+	return &jwtValidator{
+		cfg:    c,
+		keys:   newJWKS(c.JWKSURL, c.HTTPClient, c.RefreshInterval),
+		parser: jwt.NewParser(opts...),
+	}
+}
+
+// errJWKSUnavailable 无法获取签名公钥
+var errJWKSUnavailable = errors.New("无法获取签名公钥")
+
+// validate 校验令牌并转换为 Claims
+func (v *jwtValidator) validate(ctx context.Context, token string) (*Claims, error) {
+	var keyErr error
+	mapClaims := jwt.MapClaims{}
+	_, err := v.parser.ParseWithClaims(token, mapClaims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		key, err := v.keys.key(ctx, kid)
+		if err != nil {
+			keyErr = err
+			return nil, err
+		}
+		return key, nil
+	})
+	if keyErr != nil && !errors.Is(keyErr, errUnknownKeyID) {
+		return nil, fmt.Errorf("%w: %v", errJWKSUnavailable, keyErr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &Claims{
+		UserCode:    stringClaim(mapClaims, v.cfg.UserCodeClaim),
+		TenantCode:  stringClaim(mapClaims, v.cfg.TenantCodeClaim),
+		RegionName:  stringClaim(mapClaims, v.cfg.RegionNameClaim),
+		Roles:       listClaim(mapClaims, v.cfg.RolesClaim),
+		Scopes:      listClaim(mapClaims, v.cfg.ScopesClaim),
+		ProductCode: stringClaim(mapClaims, v.cfg.ProductCodeClaim),
+	}
+	if claims.UserCode == "" {
+		return nil, fmt.Errorf("令牌缺少用户信息")
+	}
+	return claims, nil
+}
+
+// bearerToken 从 Authorization 头中提取 Bearer Token
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
+
+func stringClaim(claims jwt.MapClaims, name string) string {
+	s, _ := claims[name].(string)
+	return s
+}
+
+// listClaim 读取字符串数组声明，字符串按空格或逗号分隔
+func listClaim(claims jwt.MapClaims, name string) []string {
+	switch v := claims[name].(type) {
+	case string:
+		return strings.FieldsFunc(v, func(r rune) bool { return r == ' ' || r == ',' })
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
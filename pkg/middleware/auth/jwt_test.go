@@ -0,0 +1,232 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	nethttp "net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// jwksServer 模拟 IAM 的 JWKS 接口
+type jwksServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	keys     map[string]*rsa.PrivateKey
+	requests int
+}
+
+func newJWKSServer(t *testing.T) *jwksServer {
+	t.Helper()
+
+	s := &jwksServer{keys: make(map[string]*rsa.PrivateKey)}
+	s.addKey(t, "k1")
+	s.Server = httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.requests++
+		set := struct {
+			Keys []jwk `json:"keys"`
+		}{}
+		for kid, key := range s.keys {
+			set.Keys = append(set.Keys, jwk{
+				Kty: "RSA",
+				Kid: kid,
+				Use: "sig",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			})
+		}
+		_ = json.NewEncoder(w).Encode(set)
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *jwksServer) addKey(t *testing.T, kid string) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	s.mu.Lock()
+	s.keys[kid] = key
+	s.mu.Unlock()
+}
+
+func (s *jwksServer) sign(t *testing.T, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+
+	s.mu.Lock()
+	key := s.keys[kid]
+	s.mu.Unlock()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("sign token: %v", err)
+	}
+	return signed
+}
+
+func validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"sub":         "U001",
+		"tenant_code": "T001",
+		"region_name": "cn",
+		"roles":       []string{"admin"},
+		"scope":       "order:read order:write",
+		"iss":         "https://iam.example.com",
+		"aud":         "order-server",
+		"exp":         time.Now().Add(time.Hour).Unix(),
+	}
+}
+
+func TestServer_JWT(t *testing.T) {
+	srv := newJWKSServer(t)
+	opt := WithJWT(&JWTConfig{
+		JWKSURL:  srv.URL,
+		Issuer:   "https://iam.example.com",
+		Audience: "order-server",
+	})
+
+	claims, err := serve(t, map[string]string{"Authorization": "Bearer " + srv.sign(t, "k1", validClaims())}, opt)
+	if err != nil {
+		t.Fatalf("Server failed: %v", err)
+	}
+	if claims.UserCode != "U001" || claims.TenantCode != "T001" || claims.RegionName != "cn" {
+		t.Errorf("Unexpected claims: %+v", claims)
+	}
+	if !claims.HasRole("admin") || len(claims.Scopes) != 2 || !claims.HasScope("order:write") {
+		t.Errorf("Unexpected roles or scopes: %+v", claims)
+	}
+
+	// 网关 Header 被忽略
+	if _, err := serve(t, map[string]string{"X-User-Code": "U001", "X-Tenant-Code": "T001"}, opt); errors.Reason(err) != "AUTH_HEADER_MISSING" {
+		t.Errorf("Expected AUTH_HEADER_MISSING, got %v", err)
+	}
+
+	expired := validClaims()
+	expired["exp"] = time.Now().Add(-2 * DefaultJWTClockSkew).Unix()
+	if _, err := serve(t, map[string]string{"Authorization": "Bearer " + srv.sign(t, "k1", expired)}, opt); errors.Reason(err) != "TOKEN_EXPIRED" {
+		t.Errorf("Expected TOKEN_EXPIRED, got %v", err)
+	}
+
+	// 时钟偏差范围内视为有效
+	skewed := validClaims()
+	skewed["exp"] = time.Now().Add(-DefaultJWTClockSkew / 2).Unix()
+	if _, err := serve(t, map[string]string{"Authorization": "Bearer " + srv.sign(t, "k1", skewed)}, opt); err != nil {
+		t.Errorf("Expected token within clock skew to pass, got %v", err)
+	}
+
+	wrongAudience := validClaims()
+	wrongAudience["aud"] = "other-server"
+	if _, err := serve(t, map[string]string{"Authorization": "Bearer " + srv.sign(t, "k1", wrongAudience)}, opt); errors.Reason(err) != "TOKEN_INVALID" {
+		t.Errorf("Expected TOKEN_INVALID for audience mismatch, got %v", err)
+	}
+
+	// 拒绝对称算法签名的令牌
+	hmac, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, validClaims()).SignedString([]byte("secret"))
+	if _, err := serve(t, map[string]string{"Authorization": "Bearer " + hmac}, opt); errors.Reason(err) != "TOKEN_INVALID" {
+		t.Errorf("Expected TOKEN_INVALID for HS256, got %v", err)
+	}
+
+	noTenant := validClaims()
+	delete(noTenant, "tenant_code")
+	if _, err := serve(t, map[string]string{"Authorization": "Bearer " + srv.sign(t, "k1", noTenant)}, opt); errors.Reason(err) != "TENANT_MISSING" {
+		t.Errorf("Expected TENANT_MISSING, got %v", err)
+	}
+
+	if _, err := serve(t, map[string]string{"Authorization": "Basic abc"}, opt); errors.Reason(err) != "AUTH_HEADER_INVALID" {
+		t.Errorf("Expected AUTH_HEADER_INVALID, got %v", err)
+	}
+}
+
+func TestJWKS_KeyRotation(t *testing.T) {
+	srv := newJWKSServer(t)
+	v := newJWTValidator(&JWTConfig{JWKSURL: srv.URL})
+	ctx := context.Background()
+
+	if _, err := v.validate(ctx, srv.sign(t, "k1", validClaims())); err != nil {
+		t.Fatalf("validate failed: %v", err)
+	}
+
+	// 新密钥在最小刷新间隔内不会触发刷新
+	srv.addKey(t, "k2")
+	if _, err := v.validate(ctx, srv.sign(t, "k2", validClaims())); err == nil {
+		t.Error("Expected unknown kid within refresh interval to fail")
+	}
+
+	// 超过最小刷新间隔后，未知 kid 触发刷新
+	v.keys.mu.Lock()
+	v.keys.fetchedAt = time.Now().Add(-minJWKSRefreshInterval)
+	v.keys.lastAttempt = v.keys.fetchedAt
+	v.keys.mu.Unlock()
+	if _, err := v.validate(ctx, srv.sign(t, "k2", validClaims())); err != nil {
+		t.Errorf("Expected rotated key to be accepted, got %v", err)
+	}
+	if srv.requests != 2 {
+		t.Errorf("Expected 2 JWKS requests, got %d", srv.requests)
+	}
+
+	// JWKS 不可用时返回认证服务错误
+	unavailable := WithJWT(&JWTConfig{JWKSURL: "http://127.0.0.1:1/jwks"})
+	if _, err := serve(t, map[string]string{"Authorization": "Bearer " + srv.sign(t, "k1", validClaims())}, unavailable); errors.Reason(err) != "AUTH_SERVICE_ERROR" {
+		t.Errorf("Expected AUTH_SERVICE_ERROR, got %v", err)
+	}
+}
+
+func TestJWKS_FailedRefresh(t *testing.T) {
+	var requests atomic.Int32
+	var healthy atomic.Bool
+	srv := newJWKSServer(t)
+	proxy := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		requests.Add(1)
+		if !healthy.Load() {
+			w.WriteHeader(nethttp.StatusServiceUnavailable)
+			return
+		}
+		srv.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer proxy.Close()
+
+	keys := newJWKS(proxy.URL, nethttp.DefaultClient, time.Hour)
+
+	// 拉取失败后在最小刷新间隔内不再拉取
+	for i := 0; i < 3; i++ {
+		if _, err := keys.key(context.Background(), "k1"); err == nil {
+			t.Fatal("Expected error while JWKS is unavailable")
+		}
+	}
+	if requests.Load() != 1 {
+		t.Errorf("Expected failed fetch to be cached, got %d requests", requests.Load())
+	}
+
+	// 超过最小刷新间隔后重试，调用方的 ctx 已取消也不影响拉取
+	healthy.Store(true)
+	keys.mu.Lock()
+	keys.lastAttempt = time.Now().Add(-minJWKSRefreshInterval)
+	keys.mu.Unlock()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := keys.key(ctx, "k1"); err != nil {
+		t.Errorf("Expected refresh with canceled caller context to succeed, got %v", err)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests.Load())
+	}
+}
@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// Option 认证中间件选项
+type Option func(*options)
+
+// MatchFunc 路由选择函数，返回 true 表示该请求需要认证
+//
+// operation 为 transport 的 Operation，gRPC 请求为 /package.Service/Method，
+// HTTP 请求为 protoc-gen-go-http 生成的同名 Operation
+type MatchFunc func(ctx context.Context, operation string) bool
+
+type options struct {
+	legacyHeaders bool
+	identity      *identityCache
+	jwt           *jwtValidator
+	skipPaths     map[string]struct{}
+	skipPrefixes  []string
+	selector      MatchFunc
+}
+
+// WithSkipPaths 跳过认证的路径
+//
+// 与 Operation 或 HTTP 请求路径完全匹配时跳过认证；以 * 结尾时按前缀匹配，
+// 如 "/public/*"、"/api.health.v1.Health/*"。跳过认证的请求 context 中没有 Claims
+//
+// 使用示例:
+//
+//	auth.Server(true, auth.WithSkipPaths("/healthz", "/metrics", "/api.order.v1.Order/GetPublicConfig"))
+func WithSkipPaths(paths ...string) Option {
+	return func(o *options) {
+		if o.skipPaths == nil {
+			o.skipPaths = make(map[string]struct{}, len(paths))
+		}
+		for _, path := range paths {
+			if prefix, ok := strings.CutSuffix(path, "*"); ok {
+				o.skipPrefixes = append(o.skipPrefixes, prefix)
+				continue
+			}
+			o.skipPaths[path] = struct{}{}
+		}
+	}
+}
+
+// WithSelector 按路由选择需要认证的请求，fn 返回 false 时跳过认证
+//
+// 与 WithSkipPaths 同时使用时，任一条件命中即跳过认证
+//
+// 使用示例:
+//
+//	auth.Server(true, auth.WithSelector(func(ctx context.Context, operation string) bool {
+//	    return !strings.HasPrefix(operation, "/api.webhook.v1.")
+//	}))
+func WithSelector(fn MatchFunc) Option {
+	return func(o *options) {
+		o.selector = fn
+	}
+}
+
+// WithLegacyHeaders 兼容旧版网关注入的数字 ID Header
+//
+// 开启后，X-User-Code/X-Tenant-Code 缺失时读取 X-User-ID/X-Tenant-ID，
+// 其值按字符串原样写入 Claims 的 UserCode/TenantCode，便于网关切换期间新旧请求共存。
+// 需要将 ID 解析为 code 时使用 WithIdentityResolver
+//
+// Deprecated: 网关全部切换为 code Header 后移除，新服务不要开启
+func WithLegacyHeaders() Option {
+	return func(o *options) {
+		o.legacyHeaders = true
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// skip 请求是否跳过认证
+func (o *options) skip(ctx context.Context, operation string) bool {
+	if o.selector != nil && !o.selector(ctx, operation) {
+		return true
+	}
+	if len(o.skipPaths) == 0 && len(o.skipPrefixes) == 0 {
+		return false
+	}
+
+	paths := []string{operation}
+	if req, ok := http.RequestFromServerContext(ctx); ok {
+		paths = append(paths, req.URL.Path)
+	}
+	for _, path := range paths {
+		if _, ok := o.skipPaths[path]; ok {
+			return true
+		}
+		for _, prefix := range o.skipPrefixes {
+			if strings.HasPrefix(path, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
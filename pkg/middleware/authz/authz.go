@@ -0,0 +1,175 @@
+// Package authz 提供基于 IAM 权限code的鉴权中间件
+package authz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	"github.com/heyinLab/common/pkg/internal/ttlcache"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+)
+
+// DefaultCacheTTL 权限检查结果的默认缓存时间
+const DefaultCacheTTL = time.Minute
+
+// PermissionChecker 权限检查接口，platform.IAMClient 实现了该接口
+type PermissionChecker interface {
+	CheckPermissions(ctx context.Context, userCode string, codes []string) (map[string]bool, error)
+}
+
+// Rules 接口与所需权限的映射（Operation -> 权限code列表），需要拥有全部权限才能访问
+//
+// Operation 为 transport 的 Operation，如 /api.mall.v1.Goods/DeleteGoods
+type Rules map[string][]string
+
+// Option 鉴权选项
+type Option func(*Authorizer)
+
+// WithCacheTTL 设置权限检查结果的缓存时间，<=0 时不缓存
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(a *Authorizer) {
+		a.ttl = ttl
+	}
+}
+
+// Authorizer 鉴权器
+//
+// 按 Claims 中的用户检查权限，结果按 租户+用户+权限code 在进程内缓存（包括无权限的结果），
+// 权限变更最多延迟一个缓存周期生效
+type Authorizer struct {
+	checker PermissionChecker
+	ttl     time.Duration
+	cache   *ttlcache.Cache[string, bool]
+}
+
+// New 创建鉴权器
+//
+// 参数:
+//   - checker: 权限检查实现，通常为 platform 客户端的 IAM()
+//   - opts: 可选配置
+//
+// 使用示例:
+//
+//	authorizer := authz.New(platformClient.IAM())
+//	srv := http.NewServer(http.Middleware(
+//	    auth.Server(true),
+//	    authorizer.Server(authz.Rules{
+//	        "/api.mall.v1.Goods/DeleteGoods": {"mall:goods:delete"},
+//	    }),
+//	))
+func New(checker PermissionChecker, opts ...Option) *Authorizer {
+	a := &Authorizer{
+		checker: checker,
+		ttl:     DefaultCacheTTL,
+		cache:   ttlcache.New[string, bool](0),
+	}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// Require 要求拥有全部指定权限的中间件
+//
+// 需放在 auth.Server 之后，可配合 kratos selector 用于单个接口
+//
+// 使用示例:
+//
+//	selector.Server(authorizer.Require("mall:goods:delete")).
+//	    Path("/api.mall.v1.Goods/DeleteGoods").
+//	    Build()
+func (a *Authorizer) Require(codes ...string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if err := a.Check(ctx, codes...); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Server 按接口映射表鉴权的中间件，未配置的接口不做检查
+func (a *Authorizer) Server(rules Rules) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				if codes := rules[tr.Operation()]; len(codes) > 0 {
+					if err := a.Check(ctx, codes...); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Check 检查当前用户是否拥有全部指定权限，可在业务代码中直接调用
+//
+// 返回:
+//   - error: 未认证返回 401，无权限返回 403，IAM 调用失败返回 500（kratos 错误）
+func (a *Authorizer) Check(ctx context.Context, codes ...string) error {
+	if len(codes) == 0 {
+		return nil
+	}
+
+	claims, ok := auth.FromContext(ctx)
+	if !ok || claims.UserCode == "" {
+		return businessErrors.ErrAuthHeaderMissing.ToKratos()
+	}
+
+	allowed, err := a.allowed(ctx, claims, codes)
+	if err != nil {
+		log.Context(ctx).Errorf("检查用户权限失败: user_code=%s, codes=%v, error=%v", claims.UserCode, codes, err)
+		return businessErrors.ErrAuthServiceError.ToKratos()
+	}
+	if !allowed {
+		return businessErrors.ErrAccessForbidden.ToKratos().WithMetadata(map[string]string{
+			"permissions": strings.Join(codes, ","),
+		})
+	}
+	return nil
+}
+
+// allowed 是否拥有全部权限，只对未缓存的权限调用 IAM
+func (a *Authorizer) allowed(ctx context.Context, claims *auth.Claims, codes []string) (bool, error) {
+	prefix := claims.TenantCode + "|" + claims.UserCode + "|"
+
+	var missing []string
+	for _, code := range codes {
+		allowed, ok := a.cache.Get(prefix + code)
+		if !ok {
+			missing = append(missing, code)
+			continue
+		}
+		if !allowed {
+			return false, nil
+		}
+	}
+
+	if len(missing) == 0 {
+		return true, nil
+	}
+
+	results, err := a.checker.CheckPermissions(ctx, claims.UserCode, missing)
+	if err != nil {
+		return false, err
+	}
+
+	allowed := true
+	for _, code := range missing {
+		if !results[code] {
+			allowed = false
+		}
+		if a.ttl > 0 {
+			a.cache.Set(prefix+code, results[code], a.ttl)
+		}
+	}
+	return allowed, nil
+}
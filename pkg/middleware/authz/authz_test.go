@@ -0,0 +1,106 @@
+package authz
+
+import (
+	"context"
+	stderrors "errors"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/platform"
+)
+
+// 确保 platform.IAMClient 可以直接作为权限检查实现
+var _ PermissionChecker = (*platform.IAMClient)(nil)
+
+// mockChecker 模拟 IAM 权限检查
+type mockChecker struct {
+	granted map[string]bool
+	err     error
+	calls   [][]string
+}
+
+func (m *mockChecker) CheckPermissions(_ context.Context, _ string, codes []string) (map[string]bool, error) {
+	m.calls = append(m.calls, codes)
+	if m.err != nil {
+		return nil, m.err
+	}
+	results := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		results[code] = m.granted[code]
+	}
+	return results, nil
+}
+
+// mockTransport 模拟服务端 transport，仅提供 Operation
+type mockTransport struct {
+	operation string
+}
+
+func (t *mockTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *mockTransport) Endpoint() string                { return "" }
+func (t *mockTransport) Operation() string               { return t.operation }
+func (t *mockTransport) RequestHeader() transport.Header { return nil }
+func (t *mockTransport) ReplyHeader() transport.Header   { return nil }
+
+func newTestContext(operation string) context.Context {
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001"})
+	return transport.NewServerContext(ctx, &mockTransport{operation: operation})
+}
+
+func okHandler(context.Context, interface{}) (interface{}, error) {
+	return "ok", nil
+}
+
+func TestRequire(t *testing.T) {
+	checker := &mockChecker{granted: map[string]bool{"goods:create": true}}
+	a := New(checker)
+	ctx := newTestContext("")
+
+	if _, err := a.Require("goods:create")(okHandler)(ctx, nil); err != nil {
+		t.Errorf("Expected allowed, got %v", err)
+	}
+	if _, err := a.Require("goods:create", "goods:delete")(okHandler)(ctx, nil); errors.Code(err) != nethttp.StatusForbidden {
+		t.Errorf("Expected 403, got %v", err)
+	}
+
+	// 已缓存的结果不再调用 IAM，无权限的结果同样缓存
+	if _, err := a.Require("goods:delete")(okHandler)(ctx, nil); errors.Code(err) != nethttp.StatusForbidden {
+		t.Errorf("Expected 403, got %v", err)
+	}
+	if len(checker.calls) != 2 || len(checker.calls[1]) != 1 || checker.calls[1][0] != "goods:delete" {
+		t.Errorf("Expected only uncached codes to be checked, got %v", checker.calls)
+	}
+
+	if _, err := a.Require("goods:create")(okHandler)(context.Background(), nil); errors.Code(err) != nethttp.StatusUnauthorized {
+		t.Errorf("Expected 401 without claims, got %v", err)
+	}
+}
+
+func TestRequire_CheckerError(t *testing.T) {
+	a := New(&mockChecker{err: stderrors.New("unavailable")}, WithCacheTTL(0))
+
+	if _, err := a.Require("goods:create")(okHandler)(newTestContext(""), nil); errors.Code(err) != nethttp.StatusInternalServerError {
+		t.Errorf("Expected 500, got %v", err)
+	}
+}
+
+func TestServer(t *testing.T) {
+	checker := &mockChecker{granted: map[string]bool{"goods:read": true}}
+	mw := New(checker).Server(Rules{
+		"/api.mall.v1.Goods/GetGoods":    {"goods:read"},
+		"/api.mall.v1.Goods/DeleteGoods": {"goods:delete"},
+	})
+
+	if _, err := mw(okHandler)(newTestContext("/api.mall.v1.Goods/GetGoods"), nil); err != nil {
+		t.Errorf("Expected allowed, got %v", err)
+	}
+	if _, err := mw(okHandler)(newTestContext("/api.mall.v1.Goods/DeleteGoods"), nil); errors.Reason(err) != "ACCESS_FORBIDDEN" {
+		t.Errorf("Expected ACCESS_FORBIDDEN, got %v", err)
+	}
+	if _, err := mw(okHandler)(newTestContext("/api.mall.v1.Goods/ListGoods"), nil); err != nil {
+		t.Errorf("Expected unmapped operation to pass, got %v", err)
+	}
+}
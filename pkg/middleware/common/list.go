@@ -0,0 +1,23 @@
+package common
+
+import "strings"
+
+// JoinValues 将多个值以逗号连接为 Header 值
+func JoinValues(values []string) string {
+	return strings.Join(values, ",")
+}
+
+// SplitValues 解析逗号分隔的 Header 值，去除空白和空值
+//
+// 支持传入多个 Header 值（如 gRPC metadata 的同名多值），结果按出现顺序合并
+func SplitValues(headers ...string) []string {
+	var values []string
+	for _, header := range headers {
+		for _, v := range strings.Split(header, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
@@ -5,6 +5,39 @@ const (
 	USERCODE   string = "X-User-Code"
 	TENANTCODE string = "X-Tenant-Code"
 	REGIONNAME string = "X-Region-Name"
+
+	// 代操作相关 Header
+	IMPERSONATORCODE string = "X-Impersonator-Code"
+	DELEGATIONTOKEN  string = "X-Delegation-Token"
+
+	// 授权相关 Header，多个值以逗号分隔
+	ROLES  string = "X-User-Roles"
+	SCOPES string = "X-User-Scopes"
+
+	// OpenAPI 认证相关 Header
+	AUTHTYPE    string = "X-Auth-Type"
+	APIKEYID    string = "X-API-Key-ID"
+	PRODUCTCODE string = "X-Product-Code"
+
+	// 请求ID Header，用于跨服务关联日志
+	REQUESTID string = "X-Request-ID"
+
+	// 链路ID Header，服务间调用时转发上游的 OpenTelemetry TraceID
+	TRACEID string = "X-Trace-ID"
+
+	// 幂等键 Header，相同键的重复请求直接返回首次的响应
+	IDEMPOTENCYKEY string = "X-Idempotency-Key"
+
+	// 内部服务令牌 Header，内部接口据此确认调用方为受信任的服务
+	INTERNALTOKEN string = "X-Internal-Token"
+)
+
+// 旧版网关使用的数字 ID Header
+//
+// Deprecated: 使用 USERCODE/TENANTCODE，仅在 auth.WithLegacyHeaders 兼容模式下读取
+const (
+	LEGACYUSERID   string = "X-User-ID"
+	LEGACYTENANTID string = "X-Tenant-ID"
 )
 
 // OpenAPI 认证相关的 context key
@@ -0,0 +1,87 @@
+// Package ctxutil 提供从请求 context 读取认证信息的类型化方法
+//
+// 统一封装 auth.Claims 和 OpenAPI context key 的读取，业务代码无需关心
+// 信息来自网关入口（auth.Server）还是服务间调用（ExtractClaims）
+package ctxutil
+
+import (
+	"context"
+
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/requestid"
+)
+
+// TenantCode 获取当前请求的租户code
+//
+// 返回:
+//   - string: 租户code
+//   - bool: 是否存在（无认证信息或平台级请求时为 false）
+func TenantCode(ctx context.Context) (string, bool) {
+	if claims, ok := auth.FromContext(ctx); ok && claims != nil && claims.TenantCode != "" {
+		return claims.TenantCode, true
+	}
+	return "", false
+}
+
+// MustTenantCode 获取当前请求的租户code，不存在时 panic
+//
+// 仅用于已由 auth.Server(true) 保证存在租户的接口
+func MustTenantCode(ctx context.Context) string {
+	tenantCode, ok := TenantCode(ctx)
+	if !ok {
+		panic("ctxutil: context 中缺少租户code")
+	}
+	return tenantCode
+}
+
+// UserCode 获取当前请求的用户code，OpenAPI 请求可能不存在
+func UserCode(ctx context.Context) (string, bool) {
+	if claims, ok := auth.FromContext(ctx); ok && claims != nil && claims.UserCode != "" {
+		return claims.UserCode, true
+	}
+	return "", false
+}
+
+// Region 获取当前请求的区域名称
+func Region(ctx context.Context) (string, bool) {
+	if claims, ok := auth.FromContext(ctx); ok && claims != nil && claims.RegionName != "" {
+		return claims.RegionName, true
+	}
+	return "", false
+}
+
+// ProductCode 获取当前请求的产品编码（OpenAPI 请求和服务间调用有值）
+func ProductCode(ctx context.Context) (string, bool) {
+	productCode := auth.GetProductCode(ctx)
+	return productCode, productCode != ""
+}
+
+// APIKeyID 获取 OpenAPI 请求的 API Key ID
+func APIKeyID(ctx context.Context) (uint64, bool) {
+	id := auth.GetAPIKeyID(ctx)
+	return id, id != 0
+}
+
+// IsOpenAPI 是否为 OpenAPI 请求
+func IsOpenAPI(ctx context.Context) bool {
+	return auth.IsOpenAPIRequest(ctx)
+}
+
+// ImpersonatorCode 获取代操作的实际操作者用户code，非代操作请求返回 false
+func ImpersonatorCode(ctx context.Context) (string, bool) {
+	if claims, ok := auth.FromContext(ctx); ok && claims.IsImpersonated() {
+		return claims.ImpersonatorCode, true
+	}
+	return "", false
+}
+
+// RequestID 获取当前请求的请求ID
+func RequestID(ctx context.Context) (string, bool) {
+	id := requestid.FromContext(ctx)
+	return id, id != ""
+}
+
+// Operator 获取操作者信息，用于审计日志
+func Operator(ctx context.Context) auth.Operator {
+	return auth.GetOperator(ctx)
+}
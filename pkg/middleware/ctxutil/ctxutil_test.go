@@ -0,0 +1,68 @@
+package ctxutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/common"
+)
+
+func TestClaims(t *testing.T) {
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001", RegionName: "cn"})
+
+	if v, ok := TenantCode(ctx); !ok || v != "T001" {
+		t.Errorf("Unexpected tenant code: %q, %v", v, ok)
+	}
+	if v, ok := UserCode(ctx); !ok || v != "U001" {
+		t.Errorf("Unexpected user code: %q, %v", v, ok)
+	}
+	if v, ok := Region(ctx); !ok || v != "cn" {
+		t.Errorf("Unexpected region: %q, %v", v, ok)
+	}
+	if MustTenantCode(ctx) != "T001" {
+		t.Error("Unexpected MustTenantCode")
+	}
+	if op := Operator(ctx); op.Type != "user" {
+		t.Errorf("Unexpected operator: %+v", op)
+	}
+	if _, ok := ImpersonatorCode(ctx); ok {
+		t.Error("Expected no impersonator")
+	}
+
+	empty := context.Background()
+	if _, ok := TenantCode(empty); ok {
+		t.Error("Expected no tenant code")
+	}
+	if _, ok := UserCode(empty); ok {
+		t.Error("Expected no user code")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected MustTenantCode to panic")
+		}
+	}()
+	MustTenantCode(empty)
+}
+
+func TestOpenAPI(t *testing.T) {
+	ctx := auth.NewContext(context.Background(), &auth.Claims{TenantCode: "T001", ProductCode: "mall"})
+	ctx = context.WithValue(ctx, common.KeyAuthType, common.AuthTypeOpenAPI)
+	ctx = context.WithValue(ctx, common.KeyAPIKeyID, uint64(42))
+
+	if !IsOpenAPI(ctx) {
+		t.Error("Expected OpenAPI request")
+	}
+	if id, ok := APIKeyID(ctx); !ok || id != 42 {
+		t.Errorf("Unexpected api key id: %d, %v", id, ok)
+	}
+	if v, ok := ProductCode(ctx); !ok || v != "mall" {
+		t.Errorf("Unexpected product code: %q, %v", v, ok)
+	}
+	if _, ok := UserCode(ctx); ok {
+		t.Error("Expected no user code for OpenAPI request")
+	}
+	if op := Operator(ctx); op.Type != "api_key" || op.ID != 42 {
+		t.Errorf("Unexpected operator: %+v", op)
+	}
+}
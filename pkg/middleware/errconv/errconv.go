@@ -0,0 +1,217 @@
+// Package errconv 将服务内部错误统一转换为 pkg/errors 定义的业务错误
+package errconv
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	"github.com/heyinLab/common/pkg/middleware/requestid"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// MessageLookup 按错误类型（Reason）查找本地化的错误信息
+//
+// 语言可通过 Language(ctx) 获取，未找到时返回 false，使用原错误信息
+type MessageLookup func(ctx context.Context, reason string) (string, bool)
+
+// Option 错误转换中间件选项
+type Option func(*options)
+
+type options struct {
+	lookup   MessageLookup
+	localize bool
+}
+
+// WithMessageLookup 设置本地化错误信息查找
+func WithMessageLookup(lookup MessageLookup) Option {
+	return func(o *options) {
+		o.lookup = lookup
+	}
+}
+
+// WithLocalization 按请求的首选语言（Language(ctx)）返回错误信息
+//
+// 使用 pkg/errors 中通过 RegisterLocale 注册的错误信息模板，原错误为业务错误时使用其消息键和模板参数，
+// 未注册对应语言时保留原错误信息。与 WithMessageLookup 同时使用时后者优先
+func WithLocalization() Option {
+	return func(o *options) {
+		o.localize = true
+	}
+}
+
+// Server 错误转换中间件
+//
+// 将 handler 返回的错误统一转换为业务错误格式（稳定的 Reason 和 HTTP 状态码），
+// 已带 Reason 的 kratos 错误保持不变，其余按 Translate 的规则转换。
+// 5xx 错误记录原始错误日志，返回给调用方的信息不包含内部细节
+//
+// 参数:
+//   - opts: 可选配置，如 WithLocalization、WithMessageLookup
+//
+// 使用示例:
+//
+//	srv := http.NewServer(http.Middleware(
+//	    recovery.Server(),
+//	    errconv.Server(errconv.WithLocalization()),
+//	))
+//
+//	srv := grpc.NewServer(grpc.Middleware(
+//	    recovery.Server(),
+//	    errconv.Server(errconv.WithMessageLookup(func(ctx context.Context, reason string) (string, bool) {
+//	        return i18n.Lookup(errconv.Language(ctx), reason)
+//	    })),
+//	))
+func Server(opts ...Option) middleware.Middleware {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := handler(ctx, req)
+			if err == nil {
+				return reply, nil
+			}
+
+			e := Translate(err)
+			if e.Code >= 500 {
+				log.Context(ctx).Errorf("请求处理失败: reason=%s, error=%v", e.Reason, err)
+				if id := requestid.FromContext(ctx); id != "" {
+					e = e.WithMetadata(withValue(e.Metadata, "request_id", id))
+				}
+			}
+			if o.localize {
+				if lang := Language(ctx); lang != "" {
+					e = localize(err, e, lang)
+				}
+			}
+			if o.lookup != nil {
+				if message, ok := o.lookup(ctx, e.Reason); ok {
+					e = errors.Clone(e)
+					e.Message = message
+				}
+			}
+			return reply, e
+		}
+	}
+}
+
+// Translate 将错误转换为业务错误
+//
+// 转换规则:
+//   - *BusinessError 和已带 Reason 的 kratos 错误: 保持原样
+//   - 参数校验错误（protoc-gen-validate 生成的错误）: 400 INVALID_PARAMETER，metadata 中携带 field
+//   - 记录不存在（sql.ErrNoRows、gorm.ErrRecordNotFound、ent NotFoundError）: 404 DATA_NOT_FOUND
+//   - ent ConstraintError: 409 DATA_DUPLICATE 或 400 DATA_CONSTRAINT
+//   - gRPC 状态码: 按 businessErrors.FromGRPCCode 映射，如 NotFound -> DATA_NOT_FOUND、Unavailable -> SERVICE_UNAVAILABLE
+//   - 其他错误: 500 SYSTEM_ERROR
+func Translate(err error) *errors.Error {
+	var be *businessErrors.BusinessError
+	if stderrors.As(err, &be) {
+		return be.ToKratos()
+	}
+	// 包括下游 kratos 服务返回的、在 gRPC 状态详情中携带 Reason 的错误
+	if ke := errors.FromError(err); ke != nil && ke.Reason != "" {
+		return ke
+	}
+
+	if e, ok := validationError(err); ok {
+		return e
+	}
+
+	switch {
+	case stderrors.Is(err, sql.ErrNoRows), stderrors.Is(err, gorm.ErrRecordNotFound), isEntError(err, "not found"):
+		return businessErrors.ErrDataNotFound.ToKratos()
+	case isEntError(err, "constraint failed"):
+		if msg := strings.ToLower(err.Error()); strings.Contains(msg, "duplicate") || strings.Contains(msg, "unique") {
+			return businessErrors.ErrDataDuplicate.ToKratos()
+		}
+		return businessErrors.ErrDataConstraint.ToKratos()
+	case stderrors.Is(err, context.DeadlineExceeded):
+		return businessErrors.ErrServiceUnavailable.ToKratos()
+	}
+
+	if st, ok := status.FromError(err); ok {
+		if e, ok := businessErrors.FromGRPCCode(st.Code()); ok {
+			return e.ToKratos()
+		}
+	}
+	return businessErrors.ErrSystemError.ToKratos()
+}
+
+// fieldError protoc-gen-validate 生成的字段校验错误
+type fieldError interface {
+	Field() string
+	Reason() string
+}
+
+// multiError protoc-gen-validate ValidateAll 返回的多个校验错误
+type multiError interface {
+	AllErrors() []error
+}
+
+// validationError 转换参数校验错误，信息可直接展示给调用方
+func validationError(err error) (*errors.Error, bool) {
+	var multi multiError
+	if stderrors.As(err, &multi) && len(multi.AllErrors()) > 0 {
+		err = multi.AllErrors()[0]
+	}
+	var fe fieldError
+	if !stderrors.As(err, &fe) {
+		return nil, false
+	}
+	e := businessErrors.ErrInvalidParameter
+	return errors.New(int(e.HttpCode), e.Type, e.Message+": "+fe.Field()+" "+fe.Reason()).
+		WithMetadata(map[string]string{"field": fe.Field()}), true
+}
+
+// isEntError 是否为 ent 生成代码返回的错误（可能被包装），如 "ent: user not found"、"ent: constraint failed: ..."
+func isEntError(err error, keyword string) bool {
+	_, msg, ok := strings.Cut(err.Error(), "ent: ")
+	return ok && strings.Contains(" "+msg, " "+keyword)
+}
+
+// Language 获取请求的首选语言（Accept-Language 的第一个值），如 "zh-CN"，不存在时返回空字符串
+func Language(ctx context.Context) string {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return ""
+	}
+	lang, _, _ := strings.Cut(tr.RequestHeader().Get("Accept-Language"), ",")
+	lang, _, _ = strings.Cut(lang, ";")
+	return strings.TrimSpace(lang)
+}
+
+// localize 将错误信息转换为指定语言，原错误为同一类型的业务错误时使用其消息键和模板参数
+func localize(err error, e *errors.Error, lang string) *errors.Error {
+	be, ok := businessErrors.AsBusiness(err)
+	if !ok || be.Type != e.Reason {
+		be = &businessErrors.BusinessError{Type: e.Reason, Message: e.Message}
+	}
+	message := be.Localize(lang).Message
+	if message == e.Message {
+		return e
+	}
+	e = errors.Clone(e)
+	e.Message = message
+	return e
+}
+
+// withValue 复制 metadata 并设置 key
+func withValue(md map[string]string, key, value string) map[string]string {
+	cp := make(map[string]string, len(md)+1)
+	for k, v := range md {
+		cp[k] = v
+	}
+	cp[key] = value
+	return cp
+}
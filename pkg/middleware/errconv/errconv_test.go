@@ -0,0 +1,152 @@
+package errconv
+
+import (
+	"context"
+	"database/sql"
+	stderrors "errors"
+	"fmt"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	"github.com/heyinLab/common/pkg/middleware/requestid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// fieldValidationError 模拟 protoc-gen-validate 生成的校验错误
+type fieldValidationError struct {
+	field, reason string
+}
+
+func (e fieldValidationError) Field() string  { return e.field }
+func (e fieldValidationError) Reason() string { return e.reason }
+func (e fieldValidationError) Error() string  { return "invalid " + e.field + ": " + e.reason }
+
+type multiValidationError []error
+
+func (m multiValidationError) Error() string      { return "multiple errors" }
+func (m multiValidationError) AllErrors() []error { return m }
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   int32
+		reason string
+	}{
+		{"business", businessErrors.ErrTenantNotFound, 404, "TENANT_NOT_FOUND"},
+		{"kratos", errors.Forbidden("QUOTA_EXCEEDED", "配额不足"), 403, "QUOTA_EXCEEDED"},
+		{"wrapped kratos", fmt.Errorf("create: %w", errors.Conflict("ORDER_PAID", "订单已支付")), 409, "ORDER_PAID"},
+		{"validation", fieldValidationError{"email", "value must be a valid email"}, 400, "INVALID_PARAMETER"},
+		{"multi validation", multiValidationError{fieldValidationError{"name", "required"}}, 400, "INVALID_PARAMETER"},
+		{"sql not found", fmt.Errorf("get user: %w", sql.ErrNoRows), 404, "DATA_NOT_FOUND"},
+		{"gorm not found", gorm.ErrRecordNotFound, 404, "DATA_NOT_FOUND"},
+		{"ent not found", fmt.Errorf("get user: %w", stderrors.New("ent: user not found")), 404, "DATA_NOT_FOUND"},
+		{"ent duplicate", stderrors.New("ent: constraint failed: Error 1062: Duplicate entry"), 409, "DATA_DUPLICATE"},
+		{"ent constraint", stderrors.New("ent: constraint failed: foreign key"), 400, "DATA_CONSTRAINT"},
+		{"grpc not found", status.Error(codes.NotFound, "no such plan"), 404, "DATA_NOT_FOUND"},
+		{"grpc unavailable", status.Error(codes.Unavailable, "connection refused"), 503, "SERVICE_UNAVAILABLE"},
+		{"deadline", context.DeadlineExceeded, 503, "SERVICE_UNAVAILABLE"},
+		{"unknown", stderrors.New("invalid memory address"), 500, "SYSTEM_ERROR"},
+	}
+	for _, tt := range tests {
+		e := Translate(tt.err)
+		if e.Code != tt.code || e.Reason != tt.reason {
+			t.Errorf("%s: expected %d %s, got %v", tt.name, tt.code, tt.reason, e)
+		}
+	}
+
+	if e := Translate(fieldValidationError{"email", "required"}); e.Metadata["field"] != "email" {
+		t.Errorf("Expected field metadata, got %v", e.Metadata)
+	}
+}
+
+// headerCarrier 基于 http.Header 的 transport.Header 实现
+type headerCarrier nethttp.Header
+
+func (h headerCarrier) Get(key string) string      { return nethttp.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { nethttp.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { nethttp.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return nethttp.Header(h).Values(key) }
+
+// mockTransport 模拟服务端 transport
+type mockTransport struct {
+	header headerCarrier
+}
+
+func (t *mockTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *mockTransport) Endpoint() string                { return "" }
+func (t *mockTransport) Operation() string               { return "/order.v1.Order/Get" }
+func (t *mockTransport) RequestHeader() transport.Header { return t.header }
+func (t *mockTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+func TestServer(t *testing.T) {
+	messages := map[string]map[string]string{
+		"en-US": {"DATA_NOT_FOUND": "Not found"},
+	}
+	lookup := func(ctx context.Context, reason string) (string, bool) {
+		msg, ok := messages[Language(ctx)][reason]
+		return msg, ok
+	}
+
+	h := Server(WithMessageLookup(lookup))(func(context.Context, interface{}) (interface{}, error) {
+		return nil, sql.ErrNoRows
+	})
+	ctx := transport.NewServerContext(context.Background(), &mockTransport{
+		header: headerCarrier{"Accept-Language": {"en-US,en;q=0.9"}},
+	})
+	_, err := h(ctx, nil)
+	if e := errors.FromError(err); e.Reason != "DATA_NOT_FOUND" || e.Message != "Not found" {
+		t.Errorf("Expected localized DATA_NOT_FOUND, got %v", err)
+	}
+
+	// 未找到本地化信息时保留原信息，5xx 携带请求ID
+	h = Server(WithMessageLookup(lookup))(func(context.Context, interface{}) (interface{}, error) {
+		return nil, stderrors.New("boom")
+	})
+	_, err = h(requestid.NewContext(ctx, "req-1"), nil)
+	if e := errors.FromError(err); e.Reason != "SYSTEM_ERROR" || e.Message != "系统错误" || e.Metadata["request_id"] != "req-1" {
+		t.Errorf("Expected SYSTEM_ERROR with request id, got %v", err)
+	}
+
+	// 成功时不处理
+	h = Server()(func(context.Context, interface{}) (interface{}, error) { return "ok", nil })
+	if reply, err := h(ctx, nil); reply != "ok" || err != nil {
+		t.Errorf("Expected pass through, got %v, %v", reply, err)
+	}
+}
+
+func TestServer_Localization(t *testing.T) {
+	businessErrors.Define(20101, "TEST_AMOUNT_EXCEEDED", 400, "订单金额不能超过 %d")
+	businessErrors.RegisterLocale("en", map[string]string{"TEST_AMOUNT_EXCEEDED": "Order amount cannot exceed %d"})
+
+	tests := []struct {
+		name    string
+		lang    string
+		err     error
+		message string
+	}{
+		{"business with args", "en-US,en;q=0.9", businessErrors.New("TEST_AMOUNT_EXCEEDED", "", nil).WithArgs(100), "Order amount cannot exceed 100"},
+		{"translated", "en-US", sql.ErrNoRows, "Data not found"},
+		{"default locale", "zh-CN", businessErrors.New("TEST_AMOUNT_EXCEEDED", "", nil).WithArgs(100), "订单金额不能超过 100"},
+		{"unregistered locale", "fr-FR", businessErrors.ErrUserNotFound, "用户不存在"},
+		{"no header", "", businessErrors.ErrUserNotFound, "用户不存在"},
+	}
+	for _, tt := range tests {
+		h := Server(WithLocalization())(func(context.Context, interface{}) (interface{}, error) {
+			return nil, tt.err
+		})
+		ctx := transport.NewServerContext(context.Background(), &mockTransport{
+			header: headerCarrier{"Accept-Language": {tt.lang}},
+		})
+		_, err := h(ctx, nil)
+		if e := errors.FromError(err); e.Message != tt.message {
+			t.Errorf("%s: expected %q, got %v", tt.name, tt.message, err)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/go-kratos/kratos/v2/selector"
+	"github.com/go-kratos/kratos/v2/selector/p2c"
+	"github.com/go-kratos/kratos/v2/selector/random"
+	"github.com/go-kratos/kratos/v2/selector/wrr"
+	"github.com/go-kratos/kratos/v2/transport"
+	kratosGrpc "github.com/go-kratos/kratos/v2/transport/grpc"
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+)
+
+// selectorBalancers 负载均衡策略对应的 gRPC balancer 名称
+//
+// kratos 只注册了使用全局 selector 的 "selector" balancer，
+// 这里为每种 selector 单独注册，以便按服务选择
+var selectorBalancers = map[string]string{
+	common.LoadBalancerWRR:    "common_selector_wrr",
+	common.LoadBalancerP2C:    "common_selector_p2c",
+	common.LoadBalancerRandom: "common_selector_random",
+}
+
+func init() {
+	builders := map[string]selector.Builder{
+		common.LoadBalancerWRR:    wrr.NewBuilder(),
+		common.LoadBalancerP2C:    p2c.NewBuilder(),
+		common.LoadBalancerRandom: random.NewBuilder(),
+	}
+	for policy, name := range selectorBalancers {
+		balancer.Register(base.NewBalancerBuilder(name, &selectorPickerBuilder{builder: builders[policy]}, base.Config{HealthCheck: true}))
+	}
+}
+
+// loadBalancerOption 按负载均衡策略生成默认服务配置，policy 为空时返回 nil（使用 kratos 默认）
+func loadBalancerOption(policy string) grpc.DialOption {
+	if policy == "" {
+		return nil
+	}
+	name := policy
+	if n, ok := selectorBalancers[policy]; ok {
+		name = n
+	}
+	// 与 kratos 一致开启客户端健康检查
+	return grpc.WithDefaultServiceConfig(fmt.Sprintf(`{"loadBalancingConfig":[{"%s":{}}],"healthCheckConfig":{"serviceName":""}}`, name))
+}
+
+// selectorPickerBuilder 基于 kratos selector 的 PickerBuilder，与 kratos 内置的 "selector" balancer 逻辑相同
+type selectorPickerBuilder struct {
+	builder selector.Builder
+}
+
+// Build 创建 Picker
+func (b *selectorPickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	nodes := make([]selector.Node, 0, len(info.ReadySCs))
+	for conn, sc := range info.ReadySCs {
+		ins, _ := sc.Address.Attributes.Value("rawServiceInstance").(*registry.ServiceInstance)
+		nodes = append(nodes, &selectorNode{
+			Node:    selector.NewNode("grpc", sc.Address.Addr, ins),
+			subConn: conn,
+		})
+	}
+	p := &selectorPicker{selector: b.builder.Build()}
+	p.selector.Apply(nodes)
+	return p
+}
+
+// selectorPicker 使用 kratos selector 选择实例
+type selectorPicker struct {
+	selector selector.Selector
+}
+
+// Pick 选择实例，支持通过 kratosGrpc.WithNodeFilter 设置的节点过滤
+func (p *selectorPicker) Pick(info balancer.PickInfo) (balancer.PickResult, error) {
+	var filters []selector.NodeFilter
+	if tr, ok := transport.FromClientContext(info.Ctx); ok {
+		if gtr, ok := tr.(*kratosGrpc.Transport); ok {
+			filters = gtr.NodeFilters()
+		}
+	}
+
+	n, done, err := p.selector.Select(info.Ctx, selector.WithNodeFilter(filters...))
+	if err != nil {
+		return balancer.PickResult{}, err
+	}
+	return balancer.PickResult{
+		SubConn: n.(*selectorNode).subConn,
+		Done: func(di balancer.DoneInfo) {
+			done(info.Ctx, selector.DoneInfo{
+				Err:           di.Err,
+				BytesSent:     di.BytesSent,
+				BytesReceived: di.BytesReceived,
+				ReplyMD:       kratosGrpc.Trailer(di.Trailer),
+			})
+		},
+	}, nil
+}
+
+// selectorNode 关联 gRPC SubConn 的 selector 节点
+type selectorNode struct {
+	selector.Node
+	subConn balancer.SubConn
+}
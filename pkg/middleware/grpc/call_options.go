@@ -0,0 +1,158 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/grpc/metadata"
+)
+
+// 单次调用覆盖配置的 context key
+type callTimeoutKey struct{}
+type callRetryKey struct{}
+
+// WithCallTimeout 为单次调用设置超时时间，覆盖 ServiceConfig.Timeout
+func WithCallTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, callTimeoutKey{}, timeout)
+}
+
+// WithCallRetry 为单次调用设置重试策略，覆盖 ServiceConfig.Retry
+func WithCallRetry(ctx context.Context, retry *common.RetryConfig) context.Context {
+	return context.WithValue(ctx, callRetryKey{}, retry)
+}
+
+// callTimeoutFromContext 获取单次调用的超时时间
+func callTimeoutFromContext(ctx context.Context) (time.Duration, bool) {
+	timeout, ok := ctx.Value(callTimeoutKey{}).(time.Duration)
+	return timeout, ok
+}
+
+// callRetryFromContext 获取单次调用的重试策略
+func callRetryFromContext(ctx context.Context) (*common.RetryConfig, bool) {
+	retry, ok := ctx.Value(callRetryKey{}).(*common.RetryConfig)
+	return retry, ok
+}
+
+// Timeout 客户端超时中间件
+//
+// 优先使用 WithCallTimeout 设置的单次超时，否则使用默认超时
+func Timeout(defaultTimeout time.Duration) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			timeout := defaultTimeout
+			if t, ok := callTimeoutFromContext(ctx); ok {
+				timeout = t
+			}
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// CallOption 单次调用选项，供各服务客户端包（如 resource、product）的 CallOption 复用
+//
+// 超时和重试通过 CreateGRPCConn 连接中的 Timeout、RetryPolicy 中间件生效，
+// 基于其他方式建立的连接只有 CallHeader 生效
+type CallOption func(*callOptions)
+
+// callOptions 单次调用参数
+type callOptions struct {
+	timeout    time.Duration
+	hasTimeout bool
+	retry      *common.RetryConfig
+	headers    []string
+	values     []callValue
+}
+
+// callValue CallValue 附加的上下文值
+type callValue struct {
+	key, value any
+}
+
+// CallTimeout 设置单次调用的超时时间，覆盖 ServiceConfig.Timeout
+//
+// 超时时间是整个调用的总时间，包含全部重试和退避，<=0 表示不设置超时
+func CallTimeout(timeout time.Duration) CallOption {
+	return func(o *callOptions) {
+		o.timeout = timeout
+		o.hasTimeout = true
+	}
+}
+
+// CallRetry 设置单次调用的重试策略，覆盖 ServiceConfig.Retry
+//
+// 参数:
+//   - maxRetries: 最大重试次数（不含首次调用），0 表示不重试
+//   - backoff: 首次重试前的退避时间，之后按指数增长，<=0 时使用 common.DefaultRetryBackoff
+func CallRetry(maxRetries int, backoff time.Duration) CallOption {
+	return func(o *callOptions) {
+		if backoff <= 0 {
+			backoff = common.DefaultRetryBackoff
+		}
+		o.retry = &common.RetryConfig{
+			MaxRetries: maxRetries,
+			Backoff:    backoff,
+			MaxBackoff: max(common.DefaultRetryMaxBackoff, backoff),
+		}
+	}
+}
+
+// CallHeader 为单次调用附加 gRPC metadata
+func CallHeader(key, value string) CallOption {
+	return func(o *callOptions) {
+		o.headers = append(o.headers, key, value)
+	}
+}
+
+// CallValue 为单次调用附加上下文值，供服务客户端包扩展自己的调用选项
+//
+// 使用示例:
+//
+//	type idempotencyKeyCtx struct{}
+//
+//	func WithIdempotencyKey(key string) CallOption {
+//	    return middleware.CallValue(idempotencyKeyCtx{}, key)
+//	}
+func CallValue(key, value any) CallOption {
+	return func(o *callOptions) {
+		o.values = append(o.values, callValue{key: key, value: value})
+	}
+}
+
+// ApplyCallOptions 将单次调用选项写入上下文
+//
+// 使用示例:
+//
+//	func (c *Client) GetPlan(ctx context.Context, planCode string, callOpts ...CallOption) (*v1.Plan, error) {
+//	    ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+//	    return c.client.GetPlan(ctx, &v1.GetPlanRequest{Code: planCode})
+//	}
+func ApplyCallOptions(ctx context.Context, opts ...CallOption) context.Context {
+	if len(opts) == 0 {
+		return ctx
+	}
+	o := &callOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.hasTimeout {
+		ctx = WithCallTimeout(ctx, o.timeout)
+	}
+	if o.retry != nil {
+		ctx = WithCallRetry(ctx, o.retry)
+	}
+	if len(o.headers) > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, o.headers...)
+	}
+	for _, v := range o.values {
+		ctx = context.WithValue(ctx, v.key, v.value)
+	}
+	return ctx
+}
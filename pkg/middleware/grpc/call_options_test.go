@@ -0,0 +1,92 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTimeout(t *testing.T) {
+	var remaining time.Duration
+	handler := Timeout(time.Second)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		deadline, _ := ctx.Deadline()
+		remaining = time.Until(deadline)
+		return nil, nil
+	})
+
+	handler(context.Background(), nil)
+	if remaining > time.Second {
+		t.Errorf("Expected default timeout, got %v", remaining)
+	}
+
+	handler(WithCallTimeout(context.Background(), time.Minute), nil)
+	if remaining <= time.Second {
+		t.Errorf("Expected call timeout override, got %v", remaining)
+	}
+}
+
+func TestRetry_CallOverride(t *testing.T) {
+	calls := 0
+	handler := Retry(nil)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	})
+
+	handler(context.Background(), nil)
+	if calls != 1 {
+		t.Errorf("Expected no retry without config, got %d calls", calls)
+	}
+
+	calls = 0
+	ctx := WithCallRetry(context.Background(), &common.RetryConfig{MaxRetries: 1, Backoff: time.Millisecond, MaxBackoff: time.Millisecond})
+	handler(ctx, nil)
+	if calls != 2 {
+		t.Errorf("Expected 2 calls with call retry, got %d", calls)
+	}
+}
+
+type testCallValueKey struct{}
+
+func TestApplyCallOptions(t *testing.T) {
+	ctx := ApplyCallOptions(context.Background(),
+		CallTimeout(time.Second),
+		CallRetry(2, 0),
+		CallHeader("x-request-source", "job"),
+		CallValue(testCallValueKey{}, "v"),
+	)
+	if timeout, ok := callTimeoutFromContext(ctx); !ok || timeout != time.Second {
+		t.Errorf("Expected call timeout, got %v", timeout)
+	}
+	if retry, ok := callRetryFromContext(ctx); !ok || retry.MaxRetries != 2 || retry.Backoff != common.DefaultRetryBackoff {
+		t.Errorf("Expected call retry, got %+v", retry)
+	}
+	if md, _ := metadata.FromOutgoingContext(ctx); md.Get("x-request-source")[0] != "job" {
+		t.Errorf("Expected header, got %v", md)
+	}
+	if v, _ := ctx.Value(testCallValueKey{}).(string); v != "v" {
+		t.Errorf("Expected call value, got %q", v)
+	}
+	// 超时由 Timeout 中间件控制，ApplyCallOptions 不设置截止时间
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("Expected no deadline on the call context")
+	}
+
+	// 单次超时覆盖全部重试: 首次尝试后仍在截止时间内，可以继续重试
+	calls := 0
+	handler := Timeout(0)(RetryPolicy(&common.ServiceConfig{})(func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		if calls < 3 {
+			return nil, status.Error(codes.Unavailable, "unavailable")
+		}
+		return "ok", ctx.Err()
+	}))
+	ctx = ApplyCallOptions(context.Background(), CallTimeout(time.Second), CallRetry(2, time.Millisecond))
+	if _, err := handler(ctx, nil); err != nil || calls != 3 {
+		t.Errorf("Expected success after retries within the call timeout, got %v after %d calls", err, calls)
+	}
+}
@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrCircuitOpen 熔断打开时返回的错误
+var ErrCircuitOpen = errors.New(503, "CIRCUIT_BREAKER_OPEN", "下游服务熔断中，请求被拒绝")
+
+// breakerState 熔断器状态
+type breakerState int
+
+const (
+	breakerClosed   breakerState = iota // 关闭：正常放行
+	breakerOpen                         // 打开：拒绝请求
+	breakerHalfOpen                     // 半开：放行一次探测请求
+)
+
+// circuitBreaker 基于连续失败次数的熔断器
+type circuitBreaker struct {
+	mu       sync.Mutex
+	config   *common.CircuitBreakerConfig
+	state    breakerState
+	failures int
+	openedAt time.Time
+	now      func() time.Time
+}
+
+func newCircuitBreaker(config *common.CircuitBreakerConfig) *circuitBreaker {
+	return &circuitBreaker{
+		config: config,
+		now:    time.Now,
+	}
+}
+
+// allow 判断是否放行请求
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.config.OpenDuration {
+			return false
+		}
+		b.state = breakerHalfOpen
+		return true
+	case breakerHalfOpen:
+		// 已有探测请求在进行中
+		return false
+	default:
+		return true
+	}
+}
+
+// record 记录请求结果
+func (b *circuitBreaker) record(success bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if success {
+		b.state = breakerClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.config.FailureThreshold {
+		b.state = breakerOpen
+		b.openedAt = b.now()
+	}
+}
+
+// release 请求被调用方取消，结果不计入成功或失败；半开状态下恢复为打开，允许下一个请求重新探测
+func (b *circuitBreaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen {
+		b.state = breakerOpen
+	}
+}
+
+// CircuitBreaker 客户端熔断中间件
+//
+// 连续 FailureThreshold 次下游故障（Unavailable、DeadlineExceeded、Internal 等）后打开熔断，
+// 在 OpenDuration 内直接返回 ErrCircuitOpen；到期后放行一次探测请求，成功则恢复
+//
+// 说明:
+//   - 业务错误（如 NotFound、InvalidArgument）视为下游正常，不计入失败
+//   - 调用方取消（Canceled）既不计入成功也不计入失败，被取消的探测请求不会恢复熔断
+func CircuitBreaker(config *common.CircuitBreakerConfig) middleware.Middleware {
+	breaker := newCircuitBreaker(config)
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			if !breaker.allow() {
+				return nil, ErrCircuitOpen
+			}
+			reply, err = handler(ctx, req)
+			if isCanceled(err) {
+				breaker.release()
+				return reply, err
+			}
+			breaker.record(!isServerFailure(err))
+			return reply, err
+		}
+	}
+}
+
+// isServerFailure 判断错误是否属于下游故障，限流和配额拒绝（ResourceExhausted）不计入
+func isServerFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// isCanceled 判断错误是否为调用方取消
+func isCanceled(err error) bool {
+	return stderrors.Is(err, context.Canceled) || status.Code(err) == codes.Canceled
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	breaker := newCircuitBreaker(&common.CircuitBreakerConfig{FailureThreshold: 2, OpenDuration: time.Second})
+	breaker.now = func() time.Time { return now }
+
+	// 业务错误和限流拒绝不计入失败
+	breaker.record(!isServerFailure(status.Error(codes.NotFound, "not found")))
+	breaker.record(!isServerFailure(status.Error(codes.NotFound, "not found")))
+	breaker.record(!isServerFailure(status.Error(codes.ResourceExhausted, "rate limited")))
+	if !breaker.allow() {
+		t.Fatal("Expected breaker closed after business errors")
+	}
+
+	breaker.record(false)
+	breaker.record(false)
+	if breaker.allow() {
+		t.Fatal("Expected breaker open after threshold failures")
+	}
+
+	// 到期后放行一次探测请求
+	now = now.Add(time.Second)
+	if !breaker.allow() {
+		t.Fatal("Expected probe request allowed")
+	}
+	if breaker.allow() {
+		t.Fatal("Expected only one probe request in half-open state")
+	}
+
+	// 探测失败重新打开
+	breaker.record(false)
+	if breaker.allow() {
+		t.Fatal("Expected breaker reopened after probe failure")
+	}
+
+	// 探测请求被取消不恢复熔断，下一个请求重新探测
+	now = now.Add(time.Second)
+	breaker.allow()
+	breaker.release()
+	if !breaker.allow() {
+		t.Fatal("Expected new probe allowed after canceled probe")
+	}
+	breaker.record(true)
+	if !breaker.allow() {
+		t.Fatal("Expected breaker closed after probe success")
+	}
+}
+
+func TestCircuitBreakerMiddleware(t *testing.T) {
+	handler := CircuitBreaker(&common.CircuitBreakerConfig{FailureThreshold: 1, OpenDuration: time.Minute})(
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.Unavailable, "unavailable")
+		},
+	)
+
+	if _, err := handler(context.Background(), nil); status.Code(err) != codes.Unavailable {
+		t.Fatalf("Expected Unavailable, got %v", err)
+	}
+	if _, err := handler(context.Background(), nil); err != ErrCircuitOpen {
+		t.Fatalf("Expected ErrCircuitOpen, got %v", err)
+	}
+}
+
+func TestCircuitBreakerMiddleware_Canceled(t *testing.T) {
+	var err error
+	mw := CircuitBreaker(&common.CircuitBreakerConfig{FailureThreshold: 1, OpenDuration: time.Minute})
+	handler := mw(func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, err
+	})
+
+	// 调用方取消不计入失败
+	for _, canceled := range []error{context.Canceled, status.Error(codes.Canceled, "canceled")} {
+		err = canceled
+		handler(context.Background(), nil)
+	}
+	err = nil
+	if _, got := handler(context.Background(), nil); got != nil {
+		t.Errorf("Expected canceled calls not to open the breaker, got %v", got)
+	}
+}
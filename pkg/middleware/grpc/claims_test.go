@@ -0,0 +1,100 @@
+package middleware
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	authWare "github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/common"
+	"github.com/heyinLab/common/pkg/middleware/requestid"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestForwardExtractClaims(t *testing.T) {
+	claims := &authWare.Claims{
+		UserCode:         "U001",
+		TenantCode:       "T001",
+		RegionName:       "cn",
+		Roles:            []string{"admin", "finance"},
+		Scopes:           []string{"order:read"},
+		ProductCode:      "mall",
+		ImpersonatorCode: "ADMIN",
+		DelegationToken:  "dt-1",
+	}
+
+	// 客户端转发 claims 到 outgoing metadata
+	var md metadata.MD
+	forward := ForwardClaims()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil, nil
+	})
+	forward(authWare.NewContext(context.Background(), claims), nil)
+
+	// 服务端从 incoming metadata 还原 claims
+	var got *authWare.Claims
+	extract := ExtractClaims()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		got, _ = authWare.FromContext(ctx)
+		return nil, nil
+	})
+	extract(metadata.NewIncomingContext(context.Background(), md), nil)
+
+	if got == nil || !reflect.DeepEqual(got, claims) {
+		t.Errorf("Expected claims round trip, got %+v", got)
+	}
+
+	if len(md.Get("X-Request-ID")) != 0 {
+		t.Errorf("Unexpected request id metadata: %v", md)
+	}
+
+	// 请求ID与 claims 无关，单独转发
+	forward(requestid.NewContext(context.Background(), "req-1"), nil)
+	if ids := md.Get("X-Request-ID"); len(ids) != 1 || ids[0] != "req-1" {
+		t.Errorf("Expected request id forwarded, got %v", md)
+	}
+
+	// 非代操作请求不转发代操作 header
+	forward(authWare.NewContext(context.Background(), &authWare.Claims{UserCode: "U001", TenantCode: "T001"}), nil)
+	if len(md.Get("X-Impersonator-Code")) != 0 || len(md.Get("X-Delegation-Token")) != 0 {
+		t.Errorf("Unexpected impersonation metadata: %v", md)
+	}
+}
+
+func TestForwardExtractClaims_OpenAPI(t *testing.T) {
+	// 网关入口 auth.Server 注入的 OpenAPI 认证信息，没有用户code
+	ctx := authWare.NewContext(context.Background(), &authWare.Claims{TenantCode: "T001", ProductCode: "mall"})
+	ctx = context.WithValue(ctx, common.KeyAuthType, common.AuthTypeOpenAPI)
+	ctx = context.WithValue(ctx, common.KeyAPIKeyID, uint64(42))
+	ctx = context.WithValue(ctx, common.KeyProductCode, "mall")
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	ctx = trace.ContextWithSpanContext(ctx, trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID}))
+
+	var md metadata.MD
+	ForwardClaims()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		md, _ = metadata.FromOutgoingContext(ctx)
+		return nil, nil
+	})(ctx, nil)
+
+	var downstream context.Context
+	ExtractClaims()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		downstream = ctx
+		return nil, nil
+	})(metadata.NewIncomingContext(context.Background(), md), nil)
+
+	claims, ok := authWare.FromContext(downstream)
+	if !ok || claims.TenantCode != "T001" || claims.ProductCode != "mall" {
+		t.Errorf("Expected OpenAPI claims restored, got %+v", claims)
+	}
+	if !authWare.IsOpenAPIRequest(downstream) || authWare.GetAPIKeyID(downstream) != 42 || authWare.GetProductCode(downstream) != "mall" {
+		t.Errorf("Expected OpenAPI context restored, got type=%s, key=%d", authWare.GetAuthType(downstream), authWare.GetAPIKeyID(downstream))
+	}
+	if op := authWare.GetOperator(downstream); op.Type != "api_key" || op.ID != 42 {
+		t.Errorf("Unexpected operator: %+v", op)
+	}
+
+	// 没有请求ID时使用链路ID
+	if id := requestid.FromContext(downstream); id != traceID.String() {
+		t.Errorf("Expected trace id as request id, got %q", id)
+	}
+}
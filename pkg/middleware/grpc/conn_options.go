@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"crypto/tls"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/keepalive"
+)
+
+// ConnOption CreateGRPCConn 的连接选项
+type ConnOption func(*connOptions)
+
+type connOptions struct {
+	middleware        []middleware.Middleware
+	unaryInterceptors []grpc.UnaryClientInterceptor
+	tlsConf           *tls.Config
+	block             bool
+	keepalive         *keepalive.ClientParameters
+	dialOptions       []grpc.DialOption
+}
+
+// WithMiddleware 追加客户端中间件
+//
+// 追加的中间件位于内置中间件（恢复、指标、超时、认证转发）之后、熔断和重试之前，
+// 每次逻辑调用只执行一次，可读取和修改已转发的认证信息
+func WithMiddleware(ms ...middleware.Middleware) ConnOption {
+	return func(o *connOptions) {
+		o.middleware = append(o.middleware, ms...)
+	}
+}
+
+// WithUnaryInterceptor 追加 gRPC 一元拦截器
+//
+// 拦截器在所有中间件之后执行，重试时每次尝试都会经过
+func WithUnaryInterceptor(in ...grpc.UnaryClientInterceptor) ConnOption {
+	return func(o *connOptions) {
+		o.unaryInterceptors = append(o.unaryInterceptors, in...)
+	}
+}
+
+// WithTLS 使用 TLS 建立连接，优先于 ServiceConfig.TLS，两者都未设置时为明文连接
+func WithTLS(conf *tls.Config) ConnOption {
+	return func(o *connOptions) {
+		o.tlsConf = conf
+	}
+}
+
+// WithBlock 创建连接时等待连接就绪
+//
+// 最长等待 ServiceConfig.Timeout（未设置时一直等待），超时返回错误。
+// 默认不等待，首次调用时才建立连接
+func WithBlock() ConnOption {
+	return func(o *connOptions) {
+		o.block = true
+	}
+}
+
+// WithKeepalive 设置连接保活参数，优先于 ServiceConfig.Keepalive
+//
+// 使用示例:
+//
+//	conn, err := middleware.CreateGRPCConn(config, discovery, logger,
+//	    middleware.WithKeepalive(keepalive.ClientParameters{
+//	        Time:                30 * time.Second,
+//	        Timeout:             5 * time.Second,
+//	        PermitWithoutStream: true,
+//	    }),
+//	)
+func WithKeepalive(params keepalive.ClientParameters) ConnOption {
+	return func(o *connOptions) {
+		o.keepalive = &params
+	}
+}
+
+// WithDialOptions 追加原生 gRPC 拨号选项，用于以上选项未覆盖的配置
+func WithDialOptions(opts ...grpc.DialOption) ConnOption {
+	return func(o *connOptions) {
+		o.dialOptions = append(o.dialOptions, opts...)
+	}
+}
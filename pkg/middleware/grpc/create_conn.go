@@ -2,39 +2,180 @@ package middleware
 
 import (
 	"context"
+	"fmt"
+	"time"
+
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	"github.com/go-kratos/kratos/v2/registry"
 	kratosGrpc "github.com/go-kratos/kratos/v2/transport/grpc"
+	resolver "github.com/go-kratos/kratos/v2/transport/grpc/resolver/discovery"
 	"github.com/heyinLab/common/pkg/common"
+	"github.com/heyinLab/common/pkg/middleware/metrics"
+	"github.com/heyinLab/common/pkg/middleware/tracing"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/keepalive"
 )
 
-// createGRPCConn 创建 gRPC 连接
-func CreateGRPCConn(config *common.ServiceConfig, discovery registry.Discovery, logger *log.Helper) (*grpc.ClientConn, error) {
-	opts := []kratosGrpc.ClientOption{
+// CreateGRPCConn 创建 gRPC 连接
+//
+// 所有服务客户端共用的连接构建方法，默认中间件链为:
+// 恢复 -> 链路追踪（EnableTracing）-> 指标 -> 超时 -> 认证转发 -> [WithMiddleware] -> 熔断 -> 重试（RetryPolicy），
+// 之后依次为 WithUnaryInterceptor 追加的拦截器和对冲请求（Hedging）
+//
+// 参数:
+//   - config: 服务配置
+//   - discovery: 服务发现，为 nil 时直连 config.Endpoint
+//   - logger: 日志
+//   - opts: 连接选项，如 WithMiddleware、WithUnaryInterceptor、WithTLS、WithBlock、WithKeepalive
+//
+// 使用示例:
+//
+//	// 链路追踪由 EnableTracing 开启，不要再通过 WithMiddleware 追加 tracing.Client()
+//	conn, err := middleware.CreateGRPCConn(config.WithTracing(), discovery, logger,
+//	    middleware.WithTLS(&tls.Config{ServerName: "merchant.internal"}),
+//	    middleware.WithBlock(),
+//	)
+func CreateGRPCConn(config *common.ServiceConfig, discovery registry.Discovery, logger *log.Helper, opts ...ConnOption) (*grpc.ClientConn, error) {
+	o := &connOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	// WithTLS 选项优先于 ServiceConfig.TLS
+	if o.tlsConf == nil && config.TLS != nil {
+		tlsConf, err := config.TLS.Build()
+		if err != nil {
+			return nil, err
+		}
+		o.tlsConf = tlsConf
+	}
+
+	// 超时由 Timeout 中间件控制，以便单次调用通过 WithCallTimeout 覆盖
+	// 指标在最外层统计一次完整调用（含重试和熔断拒绝）
+	// 链路追踪在 ForwardClaims 之前，链路上下文与认证信息一起转发
+	ms := []middleware.Middleware{recovery.Recovery()}
+	if config.EnableTracing {
+		ms = append(ms, tracing.Client())
+	}
+	if !config.DisableMetrics {
+		ms = append(ms, metrics.Client(metrics.WithService(config.ServiceName)))
+	}
+	ms = append(ms, Timeout(config.Timeout), ForwardClaims())
+	ms = append(ms, o.middleware...)
+
+	// 熔断在重试之外，一次调用（含重试）只计一次结果
+	if config.CircuitBreaker != nil {
+		ms = append(ms, CircuitBreaker(config.CircuitBreaker))
+	}
+	ms = append(ms, RetryPolicy(config))
+
+	clientOpts := []kratosGrpc.ClientOption{
 		kratosGrpc.WithEndpoint(config.Endpoint),
-		kratosGrpc.WithTimeout(config.Timeout),
-		kratosGrpc.WithMiddleware(
-			recovery.Recovery(),
-			ForwardClaims(),
-		),
+		kratosGrpc.WithTimeout(0),
+		kratosGrpc.WithMiddleware(ms...),
+	}
+	// 对冲请求最靠近连接，每个请求使用独立的响应对象
+	interceptors := append(o.unaryInterceptors, Hedging(config))
+	clientOpts = append(clientOpts, kratosGrpc.WithUnaryInterceptor(interceptors...))
+	if o.tlsConf != nil {
+		clientOpts = append(clientOpts, kratosGrpc.WithTLSConfig(o.tlsConf))
+	}
+
+	dialOpts := o.dialOptions
+	// WithKeepalive 选项优先于 ServiceConfig.Keepalive
+	if o.keepalive == nil && config.Keepalive != nil {
+		o.keepalive = &keepalive.ClientParameters{
+			Time:                config.Keepalive.Time,
+			Timeout:             config.Keepalive.Timeout,
+			PermitWithoutStream: config.Keepalive.PermitWithoutStream,
+		}
+	}
+	if o.keepalive != nil {
+		dialOpts = append(dialOpts, grpc.WithKeepaliveParams(*o.keepalive))
+	}
+	var callOpts []grpc.CallOption
+	if config.MaxRecvMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallRecvMsgSize(config.MaxRecvMsgSize))
+	}
+	if config.MaxSendMsgSize > 0 {
+		callOpts = append(callOpts, grpc.MaxCallSendMsgSize(config.MaxSendMsgSize))
+	}
+	if len(callOpts) > 0 {
+		dialOpts = append(dialOpts, grpc.WithDefaultCallOptions(callOpts...))
+	}
+	// 在 kratos 默认的 "selector" balancer 之后设置，覆盖默认策略
+	if opt := loadBalancerOption(config.LoadBalancer); opt != nil {
+		dialOpts = append(dialOpts, opt)
 	}
 
 	// 如果有服务发现，添加服务发现选项
+	// 由于连接级超时已关闭，这里单独构建 resolver 以保留服务发现的 watch 超时
 	if discovery != nil {
-		opts = append(opts, kratosGrpc.WithDiscovery(discovery))
+		dialOpts = append(dialOpts, grpc.WithResolvers(
+			resolver.NewBuilder(
+				discovery,
+				resolver.WithInsecure(o.tlsConf == nil),
+				resolver.WithTimeout(config.Timeout),
+				resolver.WithSubset(subsetSize(config.SubsetSize)),
+			),
+		))
+	}
+	if len(dialOpts) > 0 {
+		clientOpts = append(clientOpts, kratosGrpc.WithOptions(dialOpts...))
 	}
 
-	conn, err := kratosGrpc.DialInsecure(
-		context.Background(),
-		opts...,
-	)
+	dial := kratosGrpc.DialInsecure
+	if o.tlsConf != nil {
+		dial = kratosGrpc.Dial
+	}
+	conn, err := dial(context.Background(), clientOpts...)
 	if err != nil {
 		return nil, err
 	}
 
+	if o.block {
+		if err := waitForReady(conn, config.Timeout); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
 	logger.Infof("平台服务客户端连接成功: endpoint=%s, timeout=%v", config.Endpoint, config.Timeout)
 
 	return conn, nil
 }
+
+// subsetSize 转换为 kratos resolver 的子集大小，0 表示不使用子集
+func subsetSize(size int) int {
+	switch {
+	case size == 0:
+		return common.DefaultSubsetSize
+	case size < 0:
+		return 0
+	default:
+		return size
+	}
+}
+
+// waitForReady 等待连接就绪，timeout<=0 时一直等待
+func waitForReady(conn *grpc.ClientConn, timeout time.Duration) error {
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	conn.Connect()
+	for {
+		state := conn.GetState()
+		if state == connectivity.Ready {
+			return nil
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("等待 gRPC 连接就绪超时: target=%s, state=%s", conn.Target(), state)
+		}
+	}
+}
@@ -0,0 +1,266 @@
+package middleware
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/registry"
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/status"
+)
+
+// startHealthServer 启动本地 gRPC 健康检查服务
+func startHealthServer(t *testing.T, opts ...grpc.ServerOption) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	srv := grpc.NewServer(opts...)
+	healthpb.RegisterHealthServer(srv, health.NewServer())
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+	return lis.Addr().String()
+}
+
+func TestCreateGRPCConn_Options(t *testing.T) {
+	config := common.NewServiceConfig("health").WithEndpoint(startHealthServer(t)).WithoutMetrics()
+
+	var trace []string
+	mw := func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			trace = append(trace, "middleware")
+			return handler(ctx, req)
+		}
+	}
+	interceptor := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		trace = append(trace, "interceptor:"+method)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+
+	conn, err := CreateGRPCConn(config, nil, log.NewHelper(log.DefaultLogger),
+		WithMiddleware(mw),
+		WithUnaryInterceptor(interceptor),
+		WithKeepalive(keepalive.ClientParameters{Time: time.Minute}),
+		WithBlock(),
+	)
+	if err != nil {
+		t.Fatalf("CreateGRPCConn failed: %v", err)
+	}
+	defer conn.Close()
+
+	if _, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+	if len(trace) != 2 || trace[0] != "middleware" || trace[1] != "interceptor:/grpc.health.v1.Health/Check" {
+		t.Errorf("Unexpected call chain: %v", trace)
+	}
+}
+
+func TestCreateGRPCConn_ServiceConfig(t *testing.T) {
+	config := common.NewServiceConfig("health").WithEndpoint(startHealthServer(t)).WithoutMetrics().
+		WithKeepalive(time.Minute, 5*time.Second).
+		WithMaxMsgSize(1, 0)
+	conn, err := CreateGRPCConn(config, nil, log.NewHelper(log.DefaultLogger))
+	if err != nil {
+		t.Fatalf("CreateGRPCConn failed: %v", err)
+	}
+	defer conn.Close()
+
+	// 响应超过 MaxRecvMsgSize 时调用失败
+	_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("Expected ResourceExhausted, got %v", err)
+	}
+}
+
+func TestCreateGRPCConn_BlockTimeout(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	addr := lis.Addr().String()
+	lis.Close()
+
+	config := common.NewServiceConfig("health").WithEndpoint(addr).WithTimeout(200 * time.Millisecond).WithoutMetrics()
+	if _, err := CreateGRPCConn(config, nil, log.NewHelper(log.DefaultLogger), WithBlock()); err == nil {
+		t.Error("Expected error when endpoint is unreachable")
+	}
+
+	// 默认不等待连接就绪
+	conn, err := CreateGRPCConn(config, nil, log.NewHelper(log.DefaultLogger))
+	if err != nil {
+		t.Fatalf("Expected non-blocking dial to succeed, got %v", err)
+	}
+	conn.Close()
+}
+
+// writeSelfSignedCert 生成 server.internal 的自签名证书，返回证书和私钥文件路径
+func writeSelfSignedCert(t *testing.T) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		DNSNames:              []string{"server.internal"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+
+	dir := t.TempDir()
+	certFile, keyFile = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600)
+	os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600)
+	return certFile, keyFile
+}
+
+func TestCreateGRPCConn_TLS(t *testing.T) {
+	certFile, keyFile := writeSelfSignedCert(t)
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		t.Fatalf("load key pair: %v", err)
+	}
+	addr := startHealthServer(t, grpc.Creds(credentials.NewServerTLSFromCert(&cert)))
+
+	config := common.NewServiceConfig("health").WithEndpoint(addr).WithoutMetrics().
+		WithTLS(&common.TLSConfig{CAFile: certFile, ServerName: "server.internal"})
+	conn, err := CreateGRPCConn(config, nil, log.NewHelper(log.DefaultLogger))
+	if err != nil {
+		t.Fatalf("CreateGRPCConn failed: %v", err)
+	}
+	defer conn.Close()
+	if _, err := healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+		t.Fatalf("Check over TLS failed: %v", err)
+	}
+
+	// CA 证书不存在时创建连接失败
+	config = common.NewServiceConfig("health").WithEndpoint(addr).WithoutMetrics().
+		WithTLS(&common.TLSConfig{CAFile: filepath.Join(t.TempDir(), "missing.pem")})
+	if _, err := CreateGRPCConn(config, nil, log.NewHelper(log.DefaultLogger)); err == nil {
+		t.Error("Expected error when CA file is missing")
+	}
+}
+
+// staticDiscovery 返回固定实例列表的服务发现
+type staticDiscovery struct {
+	instances []*registry.ServiceInstance
+}
+
+func (d *staticDiscovery) GetService(context.Context, string) ([]*registry.ServiceInstance, error) {
+	return d.instances, nil
+}
+
+func (d *staticDiscovery) Watch(ctx context.Context, _ string) (registry.Watcher, error) {
+	return &staticWatcher{ctx: ctx, instances: d.instances}, nil
+}
+
+type staticWatcher struct {
+	ctx       context.Context
+	instances []*registry.ServiceInstance
+	sent      bool
+}
+
+func (w *staticWatcher) Next() ([]*registry.ServiceInstance, error) {
+	if !w.sent {
+		w.sent = true
+		return w.instances, nil
+	}
+	<-w.ctx.Done()
+	return nil, w.ctx.Err()
+}
+
+func (w *staticWatcher) Stop() error { return nil }
+
+func TestCreateGRPCConn_LoadBalancer(t *testing.T) {
+	var counts [2]atomic.Int32
+	discovery := &staticDiscovery{}
+	for i := range counts {
+		count := &counts[i]
+		addr := startHealthServer(t, grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			count.Add(1)
+			return handler(ctx, req)
+		}))
+		discovery.instances = append(discovery.instances, &registry.ServiceInstance{
+			ID:        addr,
+			Name:      "health",
+			Endpoints: []string{"grpc://" + addr},
+		})
+	}
+
+	for _, policy := range []string{common.LoadBalancerRoundRobin, common.LoadBalancerP2C} {
+		counts[0].Store(0)
+		counts[1].Store(0)
+
+		config := common.NewServiceConfig("health").WithoutMetrics().WithLoadBalancer(policy, 0)
+		conn, err := CreateGRPCConn(config, discovery, log.NewHelper(log.DefaultLogger), WithBlock())
+		if err != nil {
+			t.Fatalf("%s: CreateGRPCConn failed: %v", policy, err)
+		}
+		client := healthpb.NewHealthClient(conn)
+		// 等待两个实例都就绪
+		deadline := time.Now().Add(2 * time.Second)
+		for (counts[0].Load() == 0 || counts[1].Load() == 0) && time.Now().Before(deadline) {
+			if _, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{}); err != nil {
+				t.Fatalf("%s: Check failed: %v", policy, err)
+			}
+		}
+		conn.Close()
+
+		if counts[0].Load() == 0 || counts[1].Load() == 0 {
+			t.Errorf("%s: expected calls spread across instances, got %d/%d", policy, counts[0].Load(), counts[1].Load())
+		}
+	}
+
+	// pick_first 所有请求发往同一实例
+	counts[0].Store(0)
+	counts[1].Store(0)
+	config := common.NewServiceConfig("health").WithoutMetrics().WithLoadBalancer(common.LoadBalancerPickFirst, 0)
+	conn, err := CreateGRPCConn(config, discovery, log.NewHelper(log.DefaultLogger), WithBlock())
+	if err != nil {
+		t.Fatalf("CreateGRPCConn failed: %v", err)
+	}
+	defer conn.Close()
+	for i := 0; i < 10; i++ {
+		healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	}
+	if counts[0].Load() != 0 && counts[1].Load() != 0 {
+		t.Errorf("pick_first: expected a single instance, got %d/%d", counts[0].Load(), counts[1].Load())
+	}
+
+	config = common.NewServiceConfig("health").WithLoadBalancer("least_conn", 0)
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for unsupported load balancer")
+	}
+}
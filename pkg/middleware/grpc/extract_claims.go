@@ -2,13 +2,21 @@ package middleware
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-kratos/kratos/v2/middleware"
 	authWare "github.com/heyinLab/common/pkg/middleware/auth"
 	"github.com/heyinLab/common/pkg/middleware/common"
+	"github.com/heyinLab/common/pkg/middleware/requestid"
 	"google.golang.org/grpc/metadata"
 )
 
+// ExtractClaims 服务端认证信息还原中间件
+//
+// 从 gRPC metadata 还原上游 ForwardClaims 转发的认证信息:
+//   - Claims 通过 auth.FromContext 获取
+//   - OpenAPI 请求同时还原认证类型、API Key ID 和产品编码，与网关入口的 auth.Server 一致
+//   - 请求ID 通过 requestid.FromContext 获取，上游未传时使用链路ID；已由 requestid.Server 设置时不覆盖
 func ExtractClaims() middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
@@ -34,14 +42,63 @@ func ExtractClaims() middleware.Middleware {
 					claims.RegionName = vals[0]
 				}
 
-				// 5. 如果成功提取到了数据，将其注入到 Context 中
+				// 5. 提取角色、授权范围和产品编码
+				claims.Roles = common.SplitValues(md.Get(common.ROLES)...)
+				claims.Scopes = common.SplitValues(md.Get(common.SCOPES)...)
+				if vals := md.Get(common.PRODUCTCODE); len(vals) > 0 {
+					claims.ProductCode = vals[0]
+				}
+
+				// 6. 提取代操作信息
+				if vals := md.Get(common.IMPERSONATORCODE); len(vals) > 0 {
+					claims.ImpersonatorCode = vals[0]
+				}
+				if vals := md.Get(common.DELEGATIONTOKEN); len(vals) > 0 {
+					claims.DelegationToken = vals[0]
+				}
+
+				// 7. 提取 OpenAPI 认证信息，OpenAPI 请求可能没有用户code
+				isOpenAPI := first(md, common.AUTHTYPE) == string(common.AuthTypeOpenAPI)
+				if isOpenAPI {
+					hasData = true
+				}
+
+				// 8. 如果成功提取到了数据，将其注入到 Context 中
 				// 这样后续的业务逻辑（Service层）就可以通过 authWare.FromContext(ctx) 拿到了
 				if hasData {
 					ctx = authWare.NewContext(ctx, claims)
 				}
+				if isOpenAPI {
+					ctx = context.WithValue(ctx, common.KeyAuthType, common.AuthTypeOpenAPI)
+					if id, err := strconv.ParseUint(first(md, common.APIKEYID), 10, 64); err == nil {
+						ctx = context.WithValue(ctx, common.KeyAPIKeyID, id)
+					}
+					if claims.ProductCode != "" {
+						ctx = context.WithValue(ctx, common.KeyProductCode, claims.ProductCode)
+					}
+				}
+
+				// 9. 还原请求ID，上游未传时使用链路ID
+				if requestid.FromContext(ctx) == "" {
+					id := first(md, common.REQUESTID)
+					if !requestid.Valid(id) {
+						id = first(md, common.TRACEID)
+					}
+					if requestid.Valid(id) {
+						ctx = requestid.NewContext(ctx, id)
+					}
+				}
 			}
 
 			return handler(ctx, req)
 		}
 	}
 }
+
+// first 获取 metadata 中的第一个值，不存在时返回空字符串
+func first(md metadata.MD, key string) string {
+	if vals := md.Get(key); len(vals) > 0 {
+		return vals[0]
+	}
+	return ""
+}
@@ -2,19 +2,30 @@ package middleware
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-kratos/kratos/v2/middleware"
 	authWare "github.com/heyinLab/common/pkg/middleware/auth"
 	"github.com/heyinLab/common/pkg/middleware/common"
+	"github.com/heyinLab/common/pkg/middleware/requestid"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc/metadata"
 )
 
+// ForwardClaims 客户端认证信息转发中间件
+//
+// 将当前请求的认证信息写入 gRPC metadata，下游服务通过 ExtractClaims 还原:
+//   - Claims: 用户、租户、区域、角色、授权范围、产品编码和代操作信息
+//   - OpenAPI 认证: 认证类型和 API Key ID，下游的 auth.IsOpenAPIRequest、auth.GetOperator 等保持一致
+//   - 请求ID 和链路ID，便于跨服务关联日志
 func ForwardClaims() middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
 			// 1. 从当前上下文中获取认证信息 (通常是 HTTP 侧解析 token 后放进去的)
+			// OpenAPI 请求可能没有用户code，仍需转发租户和凭证信息
 			claims, ok := authWare.FromContext(ctx)
-			if ok && claims != nil && claims.UserCode != "" {
+			isOpenAPI := authWare.IsOpenAPIRequest(ctx)
+			if ok && claims != nil && (claims.UserCode != "" || isOpenAPI) {
 				// 2. 将关键字段放入 gRPC Metadata
 				// 使用 AppendToOutgoingContext 可以保留已有的 metadata (如 trace_id)
 				ctx = metadata.AppendToOutgoingContext(ctx,
@@ -22,6 +33,37 @@ func ForwardClaims() middleware.Middleware {
 					common.TENANTCODE, claims.TenantCode,
 					common.REGIONNAME, claims.RegionName,
 				)
+				if len(claims.Roles) > 0 {
+					ctx = metadata.AppendToOutgoingContext(ctx, common.ROLES, common.JoinValues(claims.Roles))
+				}
+				if len(claims.Scopes) > 0 {
+					ctx = metadata.AppendToOutgoingContext(ctx, common.SCOPES, common.JoinValues(claims.Scopes))
+				}
+				if productCode := authWare.GetProductCode(ctx); productCode != "" {
+					ctx = metadata.AppendToOutgoingContext(ctx, common.PRODUCTCODE, productCode)
+				}
+				if isOpenAPI {
+					ctx = metadata.AppendToOutgoingContext(ctx, common.AUTHTYPE, string(common.AuthTypeOpenAPI))
+					if id := authWare.GetAPIKeyID(ctx); id != 0 {
+						ctx = metadata.AppendToOutgoingContext(ctx, common.APIKEYID, strconv.FormatUint(id, 10))
+					}
+				}
+
+				// 3. 代操作时同时转发实际操作者和授权令牌
+				if claims.IsImpersonated() {
+					ctx = metadata.AppendToOutgoingContext(ctx,
+						common.IMPERSONATORCODE, claims.ImpersonatorCode,
+						common.DELEGATIONTOKEN, claims.DelegationToken,
+					)
+				}
+			}
+
+			// 4. 转发请求ID和链路ID，便于跨服务关联日志
+			if id := requestid.FromContext(ctx); id != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, common.REQUESTID, id)
+			}
+			if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+				ctx = metadata.AppendToOutgoingContext(ctx, common.TRACEID, sc.TraceID().String())
 			}
 			return handler(ctx, req)
 		}
@@ -0,0 +1,164 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/selector"
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/proto"
+)
+
+// Hedging 对冲请求拦截器
+//
+// 对 ServiceConfig.Methods 中配置了 Hedging 的方法，首次请求在 Delay 内未返回
+// 或返回临时性错误时，并发发送相同请求（由负载均衡选择实例），采用最先成功的响应并取消其余请求。
+// 返回非临时性错误时立即结束，所有请求都失败时返回最后一个错误
+//
+// 说明:
+//   - 以拦截器实现，每个请求使用独立的响应对象，中间件（重试、熔断、指标）对一次调用只执行一次
+//   - 仅适用于幂等的读接口，会放大下游流量，Delay 建议设置为接口的 P95 延迟
+//
+// 使用示例:
+//
+//	config := common.NewServiceConfig("merchant-service").
+//	    WithMethodHedging("/merchant.v1.Tenant/GetTenant", 2, 30*time.Millisecond)
+//	conn, err := middleware.CreateGRPCConn(config, discovery, logger) // 已默认启用
+func Hedging(serviceConfig *common.ServiceConfig) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		policy := serviceConfig.MethodPolicy(method)
+		msg, ok := reply.(proto.Message)
+		if !ok || policy == nil || policy.Hedging == nil || policy.Hedging.MaxAttempts < 2 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return hedge(ctx, policy.Hedging, msg, opts, func(ctx context.Context, reply proto.Message, opts []grpc.CallOption) error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+	}
+}
+
+// hedgeResult 单个对冲请求的结果
+type hedgeResult struct {
+	attempt *hedgeAttempt
+	err     error
+}
+
+// hedge 发出对冲请求，成功时将最先返回的响应写入 reply
+func hedge(ctx context.Context, config *common.HedgingConfig, reply proto.Message, opts []grpc.CallOption,
+	call func(ctx context.Context, reply proto.Message, opts []grpc.CallOption) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	delay := config.Delay
+	if delay <= 0 {
+		delay = common.DefaultHedgingDelay
+	}
+
+	results := make(chan hedgeResult, config.MaxAttempts)
+	var (
+		started, pending int
+		timer            *time.Timer
+		next             <-chan time.Time
+	)
+	launch := func() {
+		attempt := newHedgeAttempt(reply, opts)
+		// 负载均衡会将选中的节点写入 Peer，每个请求使用独立的 Peer 避免并发写
+		attemptCtx := selector.NewPeerContext(ctx, &selector.Peer{})
+		go func() {
+			results <- hedgeResult{attempt: attempt, err: call(attemptCtx, attempt.reply, attempt.opts)}
+		}()
+		started++
+		pending++
+
+		if timer != nil {
+			timer.Stop()
+		}
+		next = nil
+		if started < config.MaxAttempts {
+			timer = time.NewTimer(delay)
+			next = timer.C
+		}
+	}
+	defer func() {
+		if timer != nil {
+			timer.Stop()
+		}
+	}()
+
+	launch()
+	for {
+		select {
+		case <-next:
+			launch()
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				res.attempt.commit(reply)
+				return nil
+			}
+			if !isRetryable(res.err, nil) {
+				return res.err
+			}
+			if started < config.MaxAttempts {
+				launch()
+			} else if pending == 0 {
+				return res.err
+			}
+		}
+	}
+}
+
+// hedgeAttempt 单个对冲请求的响应和调用选项
+//
+// 响应头、Trailer 和对端信息写入各自的副本，成功后再复制到调用方传入的地址
+type hedgeAttempt struct {
+	reply   proto.Message
+	opts    []grpc.CallOption
+	header  metadata.MD
+	trailer metadata.MD
+	peer    peer.Peer
+
+	headerAddrs  []*metadata.MD
+	trailerAddrs []*metadata.MD
+	peerAddrs    []*peer.Peer
+}
+
+func newHedgeAttempt(reply proto.Message, opts []grpc.CallOption) *hedgeAttempt {
+	a := &hedgeAttempt{
+		reply: reply.ProtoReflect().New().Interface(),
+		opts:  make([]grpc.CallOption, 0, len(opts)),
+	}
+	for _, opt := range opts {
+		switch o := opt.(type) {
+		case grpc.HeaderCallOption:
+			a.headerAddrs = append(a.headerAddrs, o.HeaderAddr)
+			opt = grpc.Header(&a.header)
+		case grpc.TrailerCallOption:
+			a.trailerAddrs = append(a.trailerAddrs, o.TrailerAddr)
+			opt = grpc.Trailer(&a.trailer)
+		case grpc.PeerCallOption:
+			a.peerAddrs = append(a.peerAddrs, o.PeerAddr)
+			opt = grpc.Peer(&a.peer)
+		}
+		a.opts = append(a.opts, opt)
+	}
+	return a
+}
+
+// commit 将该请求的结果写回调用方
+func (a *hedgeAttempt) commit(reply proto.Message) {
+	proto.Reset(reply)
+	proto.Merge(reply, a.reply)
+	for _, addr := range a.headerAddrs {
+		*addr = a.header
+	}
+	for _, addr := range a.trailerAddrs {
+		*addr = a.trailer
+	}
+	for _, addr := range a.peerAddrs {
+		*addr = a.peer
+	}
+}
@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestHedging(t *testing.T) {
+	config := common.NewServiceConfig("merchant").
+		WithMethodHedging("/merchant.v1.Tenant/GetTenant", 3, 10*time.Millisecond)
+	interceptor := Hedging(config)
+
+	// 首个请求卡住，对冲请求返回
+	var calls int32
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		n := atomic.AddInt32(&calls, 1)
+		if n == 1 {
+			<-ctx.Done()
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		for _, opt := range opts {
+			if o, ok := opt.(grpc.HeaderCallOption); ok {
+				*o.HeaderAddr = metadata.Pairs("attempt", "2")
+			}
+		}
+		reply.(*wrapperspb.StringValue).Value = "hedged"
+		return nil
+	}
+
+	reply := &wrapperspb.StringValue{}
+	var header metadata.MD
+	err := interceptor(context.Background(), "/merchant.v1.Tenant/GetTenant", nil, reply, nil, invoker, grpc.Header(&header))
+	if err != nil || reply.GetValue() != "hedged" {
+		t.Fatalf("Expected hedged reply, got %v, %v", reply, err)
+	}
+	if atomic.LoadInt32(&calls) != 2 || len(header.Get("attempt")) != 1 {
+		t.Errorf("Expected 2 attempts and winner header, got calls=%d, header=%v", calls, header)
+	}
+
+	// 未配置对冲的方法只调用一次
+	atomic.StoreInt32(&calls, 1)
+	reply = &wrapperspb.StringValue{}
+	interceptor(context.Background(), "/merchant.v1.Tenant/CreateTenant", nil, reply, nil, invoker)
+	if atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("Expected single attempt, got %d", calls)
+	}
+}
+
+func TestHedging_Errors(t *testing.T) {
+	config := common.NewServiceConfig("merchant").
+		WithMethodHedging("/merchant.v1.Tenant/*", 3, time.Hour)
+	interceptor := Hedging(config)
+
+	// 临时性错误立即发出下一个请求，全部失败时返回错误
+	var calls int32
+	unavailable := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		atomic.AddInt32(&calls, 1)
+		return status.Error(codes.Unavailable, "unavailable")
+	}
+	err := interceptor(context.Background(), "/merchant.v1.Tenant/GetTenant", nil, &wrapperspb.StringValue{}, nil, unavailable)
+	if status.Code(err) != codes.Unavailable || atomic.LoadInt32(&calls) != 3 {
+		t.Errorf("Expected 3 attempts and Unavailable, got calls=%d, err=%v", calls, err)
+	}
+
+	// 非临时性错误不再对冲
+	atomic.StoreInt32(&calls, 0)
+	notFound := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		atomic.AddInt32(&calls, 1)
+		return status.Error(codes.NotFound, "not found")
+	}
+	err = interceptor(context.Background(), "/merchant.v1.Tenant/GetTenant", nil, &wrapperspb.StringValue{}, nil, notFound)
+	if status.Code(err) != codes.NotFound || atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("Expected single attempt and NotFound, got calls=%d, err=%v", calls, err)
+	}
+}
@@ -0,0 +1,101 @@
+package middleware
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Retry 客户端重试中间件
+//
+// 仅对 RetryableCodes 中的错误重试，未配置时为临时性错误（Unavailable、Aborted），
+// 限流和配额拒绝（ResourceExhausted）不重试，避免加重下游负载，
+// 退避时间按指数增长并受 MaxBackoff 限制，ctx 结束时立即返回
+//
+// 说明:
+//   - 优先使用 WithCallRetry 设置的单次重试策略，config 为 nil 时默认不重试
+func Retry(defaultConfig *common.RetryConfig) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			config := defaultConfig
+			if c, ok := callRetryFromContext(ctx); ok {
+				config = c
+			}
+			return retry(ctx, req, handler, config)
+		}
+	}
+}
+
+// RetryPolicy 按方法配置的客户端重试中间件
+//
+// 重试策略的优先级: WithCallRetry 设置的单次策略 > ServiceConfig.Methods 中的方法策略 > ServiceConfig.Retry。
+// 非幂等的写接口应通过 WithMethodRetry(method, 0, 0) 关闭重试
+//
+// 使用示例:
+//
+//	config := common.NewServiceConfig("merchant-service").
+//	    WithRetry(2, 100*time.Millisecond).
+//	    WithMethodRetry("/merchant.v1.Tenant/CreateTenant", 0, 0)
+//	conn, err := grpc.DialInsecure(ctx, grpc.WithMiddleware(middleware.RetryPolicy(config)))
+func RetryPolicy(serviceConfig *common.ServiceConfig) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			config, ok := callRetryFromContext(ctx)
+			if !ok {
+				config = serviceConfig.Retry
+				if tr, ok := transport.FromClientContext(ctx); ok {
+					config = serviceConfig.MethodRetry(tr.Operation())
+				}
+			}
+			return retry(ctx, req, handler, config)
+		}
+	}
+}
+
+// retry 按重试策略调用 handler，config 为 nil 时不重试
+func retry(ctx context.Context, req interface{}, handler middleware.Handler, config *common.RetryConfig) (reply interface{}, err error) {
+	if config == nil || config.MaxRetries <= 0 {
+		return handler(ctx, req)
+	}
+
+	backoff := config.Backoff
+	for attempt := 0; ; attempt++ {
+		reply, err = handler(ctx, req)
+		if err == nil || attempt >= config.MaxRetries || !isRetryable(err, config.RetryableCodes) {
+			return reply, err
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return reply, err
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if backoff > config.MaxBackoff {
+			backoff = config.MaxBackoff
+		}
+	}
+}
+
+// isRetryable 判断错误是否可以重试，retryableCodes 为空时使用默认的临时性错误
+func isRetryable(err error, retryableCodes []codes.Code) bool {
+	code := status.Code(err)
+	if len(retryableCodes) > 0 {
+		return slices.Contains(retryableCodes, code)
+	}
+	switch code {
+	case codes.Unavailable, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
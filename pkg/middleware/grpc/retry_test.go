@@ -0,0 +1,148 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetry(t *testing.T) {
+	config := &common.RetryConfig{MaxRetries: 2, Backoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	calls := 0
+	handler := Retry(config)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		if calls < 3 {
+			return nil, status.Error(codes.Unavailable, "unavailable")
+		}
+		return "ok", nil
+	})
+
+	reply, err := handler(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("Expected success, got %v", err)
+	}
+	if reply != "ok" || calls != 3 {
+		t.Errorf("Expected 3 calls and reply ok, got %d calls, reply %v", calls, reply)
+	}
+}
+
+func TestRetry_NonRetryable(t *testing.T) {
+	config := &common.RetryConfig{MaxRetries: 3, Backoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	calls := 0
+	handler := Retry(config)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return nil, status.Error(codes.NotFound, "not found")
+	})
+
+	if _, err := handler(context.Background(), nil); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected 1 call, got %d", calls)
+	}
+}
+
+func TestRetry_ResourceExhaustedNotRetried(t *testing.T) {
+	config := &common.RetryConfig{MaxRetries: 3, Backoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	calls := 0
+	handler := Retry(config)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return nil, status.Error(codes.ResourceExhausted, "rate limited")
+	})
+
+	if _, err := handler(context.Background(), nil); status.Code(err) != codes.ResourceExhausted || calls != 1 {
+		t.Errorf("Expected rate limited call not to be retried, got %v after %d calls", err, calls)
+	}
+}
+
+func TestRetry_Exhausted(t *testing.T) {
+	config := &common.RetryConfig{MaxRetries: 2, Backoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	calls := 0
+	handler := Retry(config)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	})
+
+	if _, err := handler(context.Background(), nil); err == nil {
+		t.Error("Expected error, got nil")
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 calls, got %d", calls)
+	}
+}
+
+func TestRetry_RetryableCodes(t *testing.T) {
+	config := &common.RetryConfig{
+		MaxRetries:     2,
+		Backoff:        time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		RetryableCodes: []codes.Code{codes.DeadlineExceeded},
+	}
+
+	tests := []struct {
+		code  codes.Code
+		calls int
+	}{
+		{codes.DeadlineExceeded, 3},
+		{codes.Unavailable, 1},
+	}
+	for _, tt := range tests {
+		calls := 0
+		handler := Retry(config)(func(ctx context.Context, req interface{}) (interface{}, error) {
+			calls++
+			return nil, status.Error(tt.code, "failed")
+		})
+		handler(context.Background(), nil)
+		if calls != tt.calls {
+			t.Errorf("%s: expected %d calls, got %d", tt.code, tt.calls, calls)
+		}
+	}
+}
+
+// mockTransport 模拟客户端 transport，仅提供 Operation
+type mockTransport struct {
+	transport.Transporter
+	operation string
+}
+
+func (t *mockTransport) Operation() string { return t.operation }
+
+func TestRetryPolicy_Method(t *testing.T) {
+	config := common.NewServiceConfig("merchant").
+		WithRetry(2, time.Millisecond).
+		WithMethodRetry("/merchant.v1.Tenant/CreateTenant", 0, 0)
+	if err := config.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	handler := RetryPolicy(config)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	})
+
+	tests := []struct {
+		operation string
+		calls     int
+	}{
+		{"/merchant.v1.Tenant/GetTenant", 3},
+		{"/merchant.v1.Tenant/CreateTenant", 1},
+	}
+	for _, tt := range tests {
+		calls = 0
+		ctx := transport.NewClientContext(context.Background(), &mockTransport{operation: tt.operation})
+		handler(ctx, nil)
+		if calls != tt.calls {
+			t.Errorf("%s: expected %d calls, got %d", tt.operation, tt.calls, calls)
+		}
+	}
+}
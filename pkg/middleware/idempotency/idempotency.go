@@ -0,0 +1,207 @@
+// Package idempotency 提供基于 X-Idempotency-Key 的幂等中间件
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/common"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// DefaultTTL 默认幂等记录保留时间
+	DefaultTTL = 24 * time.Hour
+
+	// DefaultLockTTL 处理中记录的保留时间
+	//
+	// 进程在处理过程中崩溃或释放失败时，幂等键在该时间后自动解锁，
+	// 处理完成后记录的保留时间延长为 ttl。处理耗时超过该时间时，相同幂等键的请求可能被再次处理
+	DefaultLockTTL = time.Minute
+
+	// ReplayedHeader 响应来自幂等缓存时设置的响应头
+	ReplayedHeader = "X-Idempotency-Replayed"
+
+	// maxKeyLength 幂等键最大长度
+	maxKeyLength = 255
+)
+
+// Record 幂等记录
+type Record struct {
+	// Fingerprint 请求指纹，相同幂等键的请求体不同时拒绝
+	Fingerprint string `json:"fingerprint"`
+	// Done 是否已处理完成，false 表示处理中
+	Done bool `json:"done"`
+	// Reply 序列化的 anypb.Any 响应，仅处理完成时有值
+	Reply []byte `json:"reply,omitempty"`
+}
+
+// Store 幂等记录存储
+//
+// Acquire 写入的处理中记录使用较短的 DefaultLockTTL，Complete 保存结果时延长为完整的 ttl。
+// 内置 MemoryStore（单实例进程内）和 RedisStore（多实例共享）两种实现
+type Store interface {
+	// Acquire 幂等键不存在时写入处理中的记录并返回 nil；已存在时返回已有记录
+	Acquire(ctx context.Context, key string, record *Record, ttl time.Duration) (*Record, error)
+	// Complete 保存处理完成的记录
+	Complete(ctx context.Context, key string, record *Record, ttl time.Duration) error
+	// Release 删除记录，处理失败后允许客户端使用相同幂等键重试
+	Release(ctx context.Context, key string) error
+}
+
+// Server 幂等中间件
+//
+// 请求携带 X-Idempotency-Key 时生效，幂等键按 接口+租户+用户 隔离：
+//   - 首次请求正常处理，成功的响应保存 ttl 时长
+//   - 重复请求直接返回保存的响应，并设置 X-Idempotency-Replayed: true 响应头
+//   - 首次请求仍在处理时返回 409 IDEMPOTENCY_IN_PROGRESS
+//   - 相同幂等键但请求体不同时返回 422 IDEMPOTENCY_KEY_MISMATCH
+//
+// 处理失败（返回错误）时不保存结果，客户端可使用相同幂等键重试；
+// 只保存 proto 响应，kratos 生成的接口均满足。存储不可用时返回 503，避免重复扣款等副作用。
+// 处理成功但响应无法保存（非 proto 响应或存储写入失败）时不释放幂等键，
+// 处理中记录保留至 DefaultLockTTL（不超过 ttl）后过期，期间重复请求返回 409 而不会再次执行
+//
+// 需放在 auth.Server 之后
+//
+// 参数:
+//   - store: 幂等记录存储，如 NewMemoryStore、NewRedisStore
+//   - ttl: 处理完成后记录的保留时间，<=0 时使用 DefaultTTL
+//
+// 使用示例:
+//
+//	selector.Server(idempotency.Server(idempotency.NewRedisStore(evaler), 24*time.Hour)).
+//	    Prefix("/api.payment.v1.Payment/").
+//	    Build()
+func Server(store Store, ttl time.Duration) middleware.Middleware {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	lockTTL := min(DefaultLockTTL, ttl)
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			idemKey := tr.RequestHeader().Get(common.IDEMPOTENCYKEY)
+			if idemKey == "" {
+				return handler(ctx, req)
+			}
+			if len(idemKey) > maxKeyLength {
+				return nil, businessErrors.ErrInvalidParameter.ToKratos()
+			}
+
+			key := scopedKey(ctx, tr.Operation(), idemKey)
+			fp := fingerprint(req)
+
+			existing, err := store.Acquire(ctx, key, &Record{Fingerprint: fp}, lockTTL)
+			if err != nil {
+				log.Context(ctx).Errorf("获取幂等记录失败: key=%s, error=%v", key, err)
+				return nil, businessErrors.ErrServiceUnavailable.ToKratos()
+			}
+			if existing != nil {
+				return replay(ctx, tr, existing, fp)
+			}
+
+			reply, err := handler(ctx, req)
+			// 客户端断开时 ctx 已取消，保存和释放记录不受影响
+			storeCtx := context.WithoutCancel(ctx)
+			if err != nil {
+				release(storeCtx, store, key)
+				return reply, err
+			}
+
+			// 处理已成功，无论能否保存响应都不释放幂等键，避免重试时重复执行
+			data, ok := encodeReply(reply)
+			if !ok {
+				log.Context(ctx).Warnf("幂等响应不是 proto 消息，无法保存: key=%s", key)
+				return reply, nil
+			}
+			if err := store.Complete(storeCtx, key, &Record{Fingerprint: fp, Done: true, Reply: data}, ttl); err != nil {
+				log.Context(ctx).Errorf("保存幂等记录失败: key=%s, error=%v", key, err)
+			}
+			return reply, nil
+		}
+	}
+}
+
+// replay 根据已有记录返回响应
+func replay(ctx context.Context, tr transport.Transporter, record *Record, fp string) (interface{}, error) {
+	if record.Fingerprint != fp {
+		return nil, businessErrors.ErrIdempotencyKeyMismatch.ToKratos()
+	}
+	if !record.Done {
+		return nil, businessErrors.ErrIdempotencyInProgress.ToKratos()
+	}
+
+	var a anypb.Any
+	if err := proto.Unmarshal(record.Reply, &a); err != nil {
+		log.Context(ctx).Errorf("解析幂等响应失败: error=%v", err)
+		return nil, businessErrors.ErrSystemError.ToKratos()
+	}
+	reply, err := a.UnmarshalNew()
+	if err != nil {
+		log.Context(ctx).Errorf("解析幂等响应失败: type=%s, error=%v", a.TypeUrl, err)
+		return nil, businessErrors.ErrSystemError.ToKratos()
+	}
+	if tr.ReplyHeader() != nil {
+		tr.ReplyHeader().Set(ReplayedHeader, "true")
+	}
+	return reply, nil
+}
+
+// release 释放幂等键，失败时记录日志，处理中记录会在 DefaultLockTTL 后自动删除
+func release(ctx context.Context, store Store, key string) {
+	if err := store.Release(ctx, key); err != nil {
+		log.Context(ctx).Errorf("释放幂等记录失败: key=%s, error=%v", key, err)
+	}
+}
+
+// scopedKey 按 接口+租户+用户 隔离幂等键，避免不同调用方的键冲突
+func scopedKey(ctx context.Context, operation, idemKey string) string {
+	var tenant, user string
+	if claims, ok := auth.FromContext(ctx); ok {
+		tenant, user = claims.TenantCode, claims.UserCode
+	}
+	return operation + "|" + tenant + "|" + user + "|" + idemKey
+}
+
+// fingerprint 计算请求体指纹
+func fingerprint(req interface{}) string {
+	var data []byte
+	if m, ok := req.(proto.Message); ok {
+		data, _ = proto.MarshalOptions{Deterministic: true}.Marshal(m)
+	} else {
+		data, _ = json.Marshal(req)
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// encodeReply 将 proto 响应序列化为 anypb.Any，非 proto 响应返回 false
+func encodeReply(reply interface{}) ([]byte, bool) {
+	m, ok := reply.(proto.Message)
+	if !ok {
+		return nil, false
+	}
+	a, err := anypb.New(m)
+	if err != nil {
+		return nil, false
+	}
+	data, err := proto.Marshal(a)
+	if err != nil {
+		return nil, false
+	}
+	return data, true
+}
@@ -0,0 +1,257 @@
+package idempotency
+
+import (
+	"context"
+	stderrors "errors"
+	nethttp "net/http"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// headerCarrier 基于 http.Header 的 transport.Header 实现
+type headerCarrier nethttp.Header
+
+func (h headerCarrier) Get(key string) string      { return nethttp.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { nethttp.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { nethttp.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return nethttp.Header(h).Values(key) }
+
+// mockTransport 模拟服务端 transport
+type mockTransport struct {
+	request headerCarrier
+	reply   headerCarrier
+}
+
+func (t *mockTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *mockTransport) Endpoint() string                { return "" }
+func (t *mockTransport) Operation() string               { return "/payment.v1.Payment/Pay" }
+func (t *mockTransport) RequestHeader() transport.Header { return t.request }
+func (t *mockTransport) ReplyHeader() transport.Header   { return t.reply }
+
+func newTestContext(userCode, idemKey string) (context.Context, *mockTransport) {
+	tr := &mockTransport{request: headerCarrier{}, reply: headerCarrier{}}
+	if idemKey != "" {
+		tr.request.Set("X-Idempotency-Key", idemKey)
+	}
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: userCode, TenantCode: "T001"})
+	return transport.NewServerContext(ctx, tr), tr
+}
+
+// countingHandler 记录调用次数，返回递增的订单号
+func countingHandler(calls *int) func(context.Context, interface{}) (interface{}, error) {
+	return func(context.Context, interface{}) (interface{}, error) {
+		*calls++
+		return wrapperspb.Int64(int64(*calls)), nil
+	}
+}
+
+func TestServer(t *testing.T) {
+	var calls int
+	h := Server(NewMemoryStore(), time.Minute)(countingHandler(&calls))
+
+	ctx, _ := newTestContext("U001", "key-1")
+	first, err := h(ctx, wrapperspb.String("100"))
+	if err != nil {
+		t.Fatalf("First request failed: %v", err)
+	}
+
+	// 重复请求返回首次响应，不再调用 handler
+	ctx, tr := newTestContext("U001", "key-1")
+	second, err := h(ctx, wrapperspb.String("100"))
+	if err != nil || calls != 1 || !proto.Equal(first.(proto.Message), second.(proto.Message)) {
+		t.Errorf("Expected replayed reply, got %v, %v (calls=%d)", second, err, calls)
+	}
+	if tr.reply.Get(ReplayedHeader) != "true" {
+		t.Error("Expected replayed header")
+	}
+
+	// 相同幂等键但请求体不同
+	ctx, _ = newTestContext("U001", "key-1")
+	_, err = h(ctx, wrapperspb.String("200"))
+	if e := errors.FromError(err); e.Code != 422 || e.Reason != "IDEMPOTENCY_KEY_MISMATCH" {
+		t.Errorf("Expected 422 mismatch, got %v", err)
+	}
+
+	// 不同用户的幂等键互不影响
+	ctx, _ = newTestContext("U002", "key-1")
+	if _, err := h(ctx, wrapperspb.String("100")); err != nil || calls != 2 {
+		t.Errorf("Expected other user to be processed, got %v (calls=%d)", err, calls)
+	}
+
+	// 未携带幂等键时每次都处理
+	ctx, _ = newTestContext("U001", "")
+	h(ctx, wrapperspb.String("100"))
+	h(ctx, wrapperspb.String("100"))
+	if calls != 4 {
+		t.Errorf("Expected requests without key to be processed, calls=%d", calls)
+	}
+}
+
+func TestServer_InProgressAndFailure(t *testing.T) {
+	store := NewMemoryStore()
+	ctx, _ := newTestContext("U001", "key-1")
+
+	// 首次请求处理中时重复请求返回 409
+	var inner error
+	h := Server(store, time.Minute)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		retry, _ := newTestContext("U001", "key-1")
+		_, inner = Server(store, time.Minute)(okHandler)(retry, req)
+		return nil, stderrors.New("payment failed")
+	})
+	if _, err := h(ctx, wrapperspb.String("100")); err == nil {
+		t.Fatal("Expected handler error")
+	}
+	if e := errors.FromError(inner); e.Code != 409 || e.Reason != "IDEMPOTENCY_IN_PROGRESS" {
+		t.Errorf("Expected 409 in progress, got %v", inner)
+	}
+
+	// 失败后释放幂等键，允许重试
+	if _, err := Server(store, time.Minute)(okHandler)(ctx, wrapperspb.String("100")); err != nil {
+		t.Errorf("Expected retry after failure to succeed, got %v", err)
+	}
+}
+
+func TestServer_StoreError(t *testing.T) {
+	store := NewRedisStore(RedisEvalFunc(func(context.Context, string, []string, ...interface{}) (interface{}, error) {
+		return nil, stderrors.New("redis down")
+	}))
+	ctx, _ := newTestContext("U001", "key-1")
+	_, err := Server(store, time.Minute)(okHandler)(ctx, wrapperspb.String("100"))
+	if e := errors.FromError(err); e.Code != 503 {
+		t.Errorf("Expected 503 when store is unavailable, got %v", err)
+	}
+}
+
+func TestRedisStore(t *testing.T) {
+	// 按脚本模拟 Redis 的 SET NX/GET/SET/DEL
+	data := map[string]string{}
+	var ttls []interface{}
+	evaler := RedisEvalFunc(func(_ context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+		switch script {
+		case acquireScript:
+			ttls = append(ttls, args[1])
+			if v, ok := data[keys[0]]; ok {
+				return v, nil
+			}
+			data[keys[0]] = args[0].(string)
+			return "", nil
+		case completeScript:
+			data[keys[0]] = args[0].(string)
+			return "OK", nil
+		case releaseScript:
+			delete(data, keys[0])
+			return int64(1), nil
+		}
+		return nil, stderrors.New("unknown script")
+	})
+
+	var calls int
+	h := Server(NewRedisStore(evaler).WithKeyPrefix("pay:"), time.Minute)(countingHandler(&calls))
+	for i := 0; i < 2; i++ {
+		ctx, _ := newTestContext("U001", "key-1")
+		reply, err := h(ctx, wrapperspb.String("100"))
+		if err != nil || reply.(*wrapperspb.Int64Value).GetValue() != 1 {
+			t.Fatalf("Unexpected reply %d: %v, %v", i, reply, err)
+		}
+	}
+	if calls != 1 || len(data) != 1 {
+		t.Errorf("Expected single call and record, calls=%d, data=%v", calls, data)
+	}
+	if _, ok := data["pay:/payment.v1.Payment/Pay|T001|U001|key-1"]; !ok {
+		t.Errorf("Unexpected keys: %v", data)
+	}
+	if ttls[0] != int64(60000) {
+		t.Errorf("Unexpected ttl arg: %v", ttls[0])
+	}
+}
+
+func okHandler(context.Context, interface{}) (interface{}, error) {
+	return wrapperspb.Bool(true), nil
+}
+
+// recordingStore 记录处理中记录的保留时间和释放时的 ctx 状态
+type recordingStore struct {
+	*MemoryStore
+	acquireTTL time.Duration
+	releaseErr error
+}
+
+func (s *recordingStore) Acquire(ctx context.Context, key string, record *Record, ttl time.Duration) (*Record, error) {
+	s.acquireTTL = ttl
+	return s.MemoryStore.Acquire(ctx, key, record, ttl)
+}
+
+func (s *recordingStore) Release(ctx context.Context, key string) error {
+	s.releaseErr = ctx.Err()
+	return s.MemoryStore.Release(ctx, key)
+}
+
+func TestServer_LockTTLAndCanceledRelease(t *testing.T) {
+	store := &recordingStore{MemoryStore: NewMemoryStore()}
+	ctx, _ := newTestContext("U001", "key-1")
+	ctx, cancel := context.WithCancel(ctx)
+
+	// 客户端断开后 handler 返回错误
+	h := Server(store, time.Hour)(func(context.Context, interface{}) (interface{}, error) {
+		cancel()
+		return nil, context.Canceled
+	})
+	h(ctx, wrapperspb.String("100"))
+
+	if store.acquireTTL != DefaultLockTTL {
+		t.Errorf("Expected in-progress record with lock TTL %v, got %v", DefaultLockTTL, store.acquireTTL)
+	}
+	if store.releaseErr != nil {
+		t.Errorf("Expected release with uncanceled ctx, got %v", store.releaseErr)
+	}
+	retry, _ := newTestContext("U001", "key-1")
+	if _, err := Server(store, time.Hour)(okHandler)(retry, wrapperspb.String("100")); err != nil {
+		t.Errorf("Expected retry after canceled request to succeed, got %v", err)
+	}
+}
+
+// failingCompleteStore 保存结果总是失败
+type failingCompleteStore struct {
+	*MemoryStore
+}
+
+func (s *failingCompleteStore) Complete(context.Context, string, *Record, time.Duration) error {
+	return stderrors.New("redis down")
+}
+
+func TestServer_KeepLockAfterSuccess(t *testing.T) {
+	var calls int
+	stores := map[string]Store{
+		"complete failed": &failingCompleteStore{MemoryStore: NewMemoryStore()},
+		"non-proto reply": NewMemoryStore(),
+	}
+	for name, store := range stores {
+		calls = 0
+		handler := countingHandler(&calls)
+		if name == "non-proto reply" {
+			handler = func(context.Context, interface{}) (interface{}, error) {
+				calls++
+				return "ok", nil
+			}
+		}
+		h := Server(store, time.Hour)(handler)
+
+		ctx, _ := newTestContext("U001", "key-1")
+		if _, err := h(ctx, wrapperspb.String("100")); err != nil {
+			t.Fatalf("%s: first request failed: %v", name, err)
+		}
+		// 处理成功后不释放幂等键，重试返回 409 而不是再次执行
+		retry, _ := newTestContext("U001", "key-1")
+		_, err := h(retry, wrapperspb.String("100"))
+		if e := errors.FromError(err); e.Code != 409 || calls != 1 {
+			t.Errorf("%s: expected 409 without re-running handler, got %v, calls=%d", name, err, calls)
+		}
+	}
+}
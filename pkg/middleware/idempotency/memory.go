@@ -0,0 +1,40 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/heyinLab/common/pkg/internal/ttlcache"
+)
+
+// MemoryStore 进程内幂等记录存储，仅适用于单实例部署和测试
+//
+// 最多保存 ttlcache.DefaultMaxEntries 条记录，超出时淘汰部分记录
+type MemoryStore struct {
+	records *ttlcache.Cache[string, Record]
+}
+
+// NewMemoryStore 创建进程内幂等记录存储
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: ttlcache.New[string, Record](0)}
+}
+
+// Acquire 实现 Store 接口
+func (s *MemoryStore) Acquire(_ context.Context, key string, record *Record, ttl time.Duration) (*Record, error) {
+	if existing, loaded := s.records.LoadOrStore(key, *record, ttl); loaded {
+		return &existing, nil
+	}
+	return nil, nil
+}
+
+// Complete 实现 Store 接口
+func (s *MemoryStore) Complete(_ context.Context, key string, record *Record, ttl time.Duration) error {
+	s.records.Set(key, *record, ttl)
+	return nil
+}
+
+// Release 实现 Store 接口
+func (s *MemoryStore) Release(_ context.Context, key string) error {
+	s.records.Delete(key)
+	return nil
+}
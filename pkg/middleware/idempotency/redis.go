@@ -0,0 +1,110 @@
+package idempotency
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// DefaultRedisKeyPrefix RedisStore 默认的 key 前缀
+const DefaultRedisKeyPrefix = "idempotency:"
+
+// RedisEvaler 执行 Lua 脚本的 Redis 客户端
+//
+// 与 ratelimit.RedisEvaler 签名相同，可共用同一个适配器。go-redis 可通过 RedisEvalFunc 适配:
+//
+//	idempotency.RedisEvalFunc(func(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+//	    return rdb.Eval(ctx, script, keys, args...).Result()
+//	})
+type RedisEvaler interface {
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error)
+}
+
+// RedisEvalFunc 函数形式的 RedisEvaler
+type RedisEvalFunc func(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error)
+
+// Eval 实现 RedisEvaler 接口
+func (f RedisEvalFunc) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	return f(ctx, script, keys, args...)
+}
+
+const (
+	// acquireScript key 不存在时写入并返回空字符串，否则返回已有记录
+	acquireScript = `
+if redis.call('SET', KEYS[1], ARGV[1], 'NX', 'PX', ARGV[2]) then
+  return ''
+end
+return redis.call('GET', KEYS[1]) or ''
+`
+	completeScript = `return redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])`
+	releaseScript  = `return redis.call('DEL', KEYS[1])`
+)
+
+// RedisStore 基于 Redis 的幂等记录存储，多个实例共享
+type RedisStore struct {
+	client RedisEvaler
+	prefix string
+}
+
+// NewRedisStore 创建基于 Redis 的幂等记录存储
+func NewRedisStore(client RedisEvaler) *RedisStore {
+	return &RedisStore{client: client, prefix: DefaultRedisKeyPrefix}
+}
+
+// WithKeyPrefix 设置 key 前缀，用于多个服务共用 Redis 时隔离
+func (s *RedisStore) WithKeyPrefix(prefix string) *RedisStore {
+	s.prefix = prefix
+	return s
+}
+
+// Acquire 实现 Store 接口
+func (s *RedisStore) Acquire(ctx context.Context, key string, record *Record, ttl time.Duration) (*Record, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("序列化幂等记录失败: %w", err)
+	}
+	res, err := s.client.Eval(ctx, acquireScript, []string{s.prefix + key}, string(data), ttl.Milliseconds())
+	if err != nil {
+		return nil, fmt.Errorf("写入幂等记录失败: %w", err)
+	}
+
+	var existing string
+	switch v := res.(type) {
+	case string:
+		existing = v
+	case []byte:
+		existing = string(v)
+	default:
+		return nil, fmt.Errorf("幂等脚本返回值无效: %v", res)
+	}
+	if existing == "" {
+		return nil, nil
+	}
+
+	var r Record
+	if err := json.Unmarshal([]byte(existing), &r); err != nil {
+		return nil, fmt.Errorf("解析幂等记录失败: %w", err)
+	}
+	return &r, nil
+}
+
+// Complete 实现 Store 接口
+func (s *RedisStore) Complete(ctx context.Context, key string, record *Record, ttl time.Duration) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("序列化幂等记录失败: %w", err)
+	}
+	if _, err := s.client.Eval(ctx, completeScript, []string{s.prefix + key}, string(data), ttl.Milliseconds()); err != nil {
+		return fmt.Errorf("保存幂等记录失败: %w", err)
+	}
+	return nil
+}
+
+// Release 实现 Store 接口
+func (s *RedisStore) Release(ctx context.Context, key string) error {
+	if _, err := s.client.Eval(ctx, releaseScript, []string{s.prefix + key}); err != nil {
+		return fmt.Errorf("删除幂等记录失败: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+// Package logging 提供带敏感字段脱敏的结构化访问日志中间件
+package logging
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/requestid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultMaxPayloadSize 默认记录的请求/响应体最大字节数
+	DefaultMaxPayloadSize = 2048
+
+	// redactedValue 脱敏后的字段值
+	redactedValue = "***"
+)
+
+// DefaultRedactFields 默认脱敏的字段
+var DefaultRedactFields = []string{
+	"password", "passwd", "secret", "token", "authorization", "credential", "apikey",
+}
+
+// Options 访问日志配置
+type Options struct {
+	// Logger 日志输出，默认 log.GetLogger()
+	Logger log.Logger
+	// LogRequest 是否记录请求体
+	LogRequest bool
+	// LogReply 是否记录响应体
+	LogReply bool
+	// RedactFields 需要脱敏的字段，默认 DefaultRedactFields
+	//
+	// 匹配时忽略大小写、下划线和中划线，并按后缀匹配，
+	// 如 "password" 可匹配 password、newPassword、confirm_password
+	RedactFields []string
+	// MaxPayloadSize 请求/响应体最大记录字节数，超出部分截断，默认 DefaultMaxPayloadSize
+	MaxPayloadSize int
+}
+
+// Server 访问日志中间件
+//
+// 每个请求输出一条结构化日志，包含 operation、租户、用户、请求ID、耗时、错误码和原因；
+// 成功请求为 Info 级别，4xx 为 Warn 级别，5xx 为 Error 级别。
+// 开启 LogRequest/LogReply 时记录 JSON 格式的请求/响应体，敏感字段替换为 ***
+//
+// 需放在 auth.Server 和 requestid.Server 之后
+//
+// 参数:
+//   - opts: 日志配置，为 nil 时使用默认配置（不记录请求/响应体）
+//
+// 使用示例:
+//
+//	srv := http.NewServer(http.Middleware(
+//	    requestid.Server(),
+//	    auth.Server(true),
+//	    logging.Server(&logging.Options{
+//	        Logger:       logger,
+//	        LogRequest:   true,
+//	        RedactFields: append(logging.DefaultRedactFields, "id_card", "bank_card"),
+//	    }),
+//	))
+func Server(opts *Options) middleware.Middleware {
+	o := Options{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.Logger == nil {
+		o.Logger = log.GetLogger()
+	}
+	if o.RedactFields == nil {
+		o.RedactFields = DefaultRedactFields
+	}
+	if o.MaxPayloadSize <= 0 {
+		o.MaxPayloadSize = DefaultMaxPayloadSize
+	}
+	r := newRedactor(o.RedactFields)
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			start := time.Now()
+			reply, err := handler(ctx, req)
+
+			kvs := []interface{}{"kind", "server"}
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				kvs = append(kvs, "component", tr.Kind().String(), "operation", tr.Operation())
+			}
+			if claims, ok := auth.FromContext(ctx); ok {
+				kvs = append(kvs, "tenant_code", claims.TenantCode, "user_code", claims.UserCode)
+			}
+			if id := requestid.FromContext(ctx); id != "" {
+				kvs = append(kvs, "request_id", id)
+			}
+
+			level := log.LevelInfo
+			code := int32(200)
+			reason := ""
+			if se := errors.FromError(err); se != nil {
+				code, reason = se.Code, se.Reason
+				level = log.LevelWarn
+				if code >= 500 {
+					level = log.LevelError
+				}
+			}
+			kvs = append(kvs, "code", code, "reason", reason, "latency", time.Since(start).Seconds())
+
+			if o.LogRequest {
+				kvs = append(kvs, "args", r.payload(req, o.MaxPayloadSize))
+			}
+			if o.LogReply && err == nil {
+				kvs = append(kvs, "reply", r.payload(reply, o.MaxPayloadSize))
+			}
+			if err != nil {
+				kvs = append(kvs, "error", err.Error())
+			}
+
+			_ = log.WithContext(ctx, o.Logger).Log(level, kvs...)
+			return reply, err
+		}
+	}
+}
+
+// redactor 敏感字段脱敏
+type redactor struct {
+	fields []string
+}
+
+func newRedactor(fields []string) *redactor {
+	normalized := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if f = normalize(f); f != "" {
+			normalized = append(normalized, f)
+		}
+	}
+	return &redactor{fields: normalized}
+}
+
+// payload 将请求/响应序列化为脱敏后的 JSON，超出 limit 时截断
+func (r *redactor) payload(v interface{}, limit int) string {
+	if v == nil {
+		return ""
+	}
+
+	var data []byte
+	var err error
+	if m, ok := v.(proto.Message); ok {
+		data, err = protojson.Marshal(m)
+	} else {
+		data, err = json.Marshal(v)
+	}
+	if err != nil {
+		return ""
+	}
+
+	// 解析为通用结构后逐层脱敏
+	var tree interface{}
+	if err := json.Unmarshal(data, &tree); err == nil {
+		if data, err = json.Marshal(r.redact(tree)); err != nil {
+			return ""
+		}
+	}
+
+	if len(data) > limit {
+		return strings.ToValidUTF8(string(data[:limit]), "") + "...(truncated)"
+	}
+	return string(data)
+}
+
+// redact 递归替换敏感字段的值
+func (r *redactor) redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if r.sensitive(key) {
+				v[key] = redactedValue
+			} else {
+				v[key] = r.redact(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = r.redact(value)
+		}
+	}
+	return v
+}
+
+// sensitive 字段名是否需要脱敏
+func (r *redactor) sensitive(key string) bool {
+	key = normalize(key)
+	for _, f := range r.fields {
+		if strings.HasSuffix(key, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// normalize 转为小写并去掉下划线和中划线，使 access_token、accessToken、Access-Token 等价
+func normalize(s string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(s))
+}
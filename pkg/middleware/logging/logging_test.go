@@ -0,0 +1,132 @@
+package logging
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/requestid"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// captureLogger 记录最后一条日志
+type captureLogger struct {
+	level  log.Level
+	fields map[string]interface{}
+}
+
+func (l *captureLogger) Log(level log.Level, keyvals ...interface{}) error {
+	l.level = level
+	l.fields = make(map[string]interface{})
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		l.fields[fmt.Sprint(keyvals[i])] = keyvals[i+1]
+	}
+	return nil
+}
+
+// mockTransport 模拟服务端 transport，仅提供 Operation
+type mockTransport struct{}
+
+func (t *mockTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *mockTransport) Endpoint() string                { return "" }
+func (t *mockTransport) Operation() string               { return "/user.v1.User/ChangePassword" }
+func (t *mockTransport) RequestHeader() transport.Header { return nil }
+func (t *mockTransport) ReplyHeader() transport.Header   { return nil }
+
+func newTestContext() context.Context {
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001"})
+	ctx = requestid.NewContext(ctx, "req-1")
+	return transport.NewServerContext(ctx, &mockTransport{})
+}
+
+func TestServer(t *testing.T) {
+	logger := &captureLogger{}
+	req, _ := structpb.NewStruct(map[string]interface{}{
+		"old_password": "p1",
+		"newPassword":  "p2",
+		"nickname":     "tom",
+		"devices":      []interface{}{map[string]interface{}{"accessToken": "t1", "name": "ios"}},
+	})
+	h := Server(&Options{Logger: logger, LogRequest: true, LogReply: true})(func(context.Context, interface{}) (interface{}, error) {
+		return map[string]string{"refresh_token": "r1", "status": "ok"}, nil
+	})
+
+	if _, err := h(newTestContext(), req); err != nil {
+		t.Fatalf("Handler failed: %v", err)
+	}
+	if logger.level != log.LevelInfo {
+		t.Errorf("Expected info level, got %v", logger.level)
+	}
+	for key, want := range map[string]interface{}{
+		"operation":   "/user.v1.User/ChangePassword",
+		"component":   "grpc",
+		"tenant_code": "T001",
+		"user_code":   "U001",
+		"request_id":  "req-1",
+		"code":        int32(200),
+	} {
+		if logger.fields[key] != want {
+			t.Errorf("Expected %s=%v, got %v", key, want, logger.fields[key])
+		}
+	}
+	if _, ok := logger.fields["latency"].(float64); !ok {
+		t.Errorf("Expected latency, got %v", logger.fields["latency"])
+	}
+
+	args := logger.fields["args"].(string)
+	for _, secret := range []string{"p1", "p2", "t1"} {
+		if strings.Contains(args, `"`+secret+`"`) {
+			t.Errorf("Expected %q to be redacted: %s", secret, args)
+		}
+	}
+	if !strings.Contains(args, `"tom"`) || !strings.Contains(args, `"ios"`) {
+		t.Errorf("Expected non-sensitive fields kept: %s", args)
+	}
+	if reply := logger.fields["reply"].(string); strings.Contains(reply, "r1") || !strings.Contains(reply, "ok") {
+		t.Errorf("Unexpected reply: %s", reply)
+	}
+}
+
+func TestServer_Error(t *testing.T) {
+	logger := &captureLogger{}
+	h := Server(&Options{Logger: logger})(func(context.Context, interface{}) (interface{}, error) {
+		return nil, errors.Forbidden("ACCESS_FORBIDDEN", "无权访问")
+	})
+	h(newTestContext(), nil)
+	if logger.level != log.LevelWarn || logger.fields["code"] != int32(403) || logger.fields["reason"] != "ACCESS_FORBIDDEN" {
+		t.Errorf("Unexpected log for 4xx: %v %v", logger.level, logger.fields)
+	}
+	if _, ok := logger.fields["args"]; ok {
+		t.Error("Request payload should not be logged by default")
+	}
+
+	h = Server(&Options{Logger: logger})(func(context.Context, interface{}) (interface{}, error) {
+		return nil, stderrors.New("db down")
+	})
+	h(context.Background(), nil)
+	if logger.level != log.LevelError || logger.fields["code"] != int32(500) || logger.fields["error"] != "db down" {
+		t.Errorf("Unexpected log for 5xx: %v %v", logger.level, logger.fields)
+	}
+}
+
+func TestRedactor_Payload(t *testing.T) {
+	r := newRedactor([]string{"id_card"})
+	got := r.payload(map[string]string{"IdCard": "110101", "password": "p"}, 100)
+	if strings.Contains(got, "110101") || !strings.Contains(got, `"p"`) {
+		t.Errorf("Unexpected custom redaction: %s", got)
+	}
+
+	got = r.payload(map[string]string{"name": strings.Repeat("长", 10)}, 10)
+	if !strings.HasSuffix(got, "...(truncated)") || !strings.HasPrefix(got, `{"name":"`) {
+		t.Errorf("Unexpected truncation: %s", got)
+	}
+	if r.payload(nil, 10) != "" {
+		t.Error("Expected empty payload for nil")
+	}
+}
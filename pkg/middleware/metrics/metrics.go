@@ -0,0 +1,194 @@
+// Package metrics 提供带产品（可选租户）标签的 Prometheus 指标中间件
+package metrics
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics RPC 调用指标
+//
+// 实现了 prometheus.Collector，服务端指标:
+//   - rpc_server_requests_total{kind, operation, code, reason, tenant, product}: 请求次数
+//   - rpc_server_request_duration_seconds{kind, operation, tenant, product}: 请求耗时
+//
+// 客户端指标:
+//   - rpc_client_requests_total{service, operation, code, reason, tenant, product}: 调用次数
+//   - rpc_client_request_duration_seconds{service, operation, tenant, product}: 调用耗时
+//
+// code 和 reason 取自 kratos 错误，成功时为 200 和空字符串。
+// tenant 标签默认为空字符串，通过 WithTenant 开启
+type Metrics struct {
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+// NewServerMetrics 创建服务端指标
+func NewServerMetrics() *Metrics {
+	return newMetrics("server", "kind")
+}
+
+// NewClientMetrics 创建客户端指标
+func NewClientMetrics() *Metrics {
+	return newMetrics("client", "service")
+}
+
+func newMetrics(side, target string) *Metrics {
+	return &Metrics{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "rpc_" + side + "_requests_total",
+			Help: "Total number of RPC " + side + " requests by operation, code, tenant and product.",
+		}, []string{target, "operation", "code", "reason", "tenant", "product"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "rpc_" + side + "_request_duration_seconds",
+			Help:    "Latency of RPC " + side + " requests in seconds.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{target, "operation", "tenant", "product"}),
+	}
+}
+
+// Describe 实现 prometheus.Collector
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.duration.Describe(ch)
+}
+
+// Collect 实现 prometheus.Collector
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.duration.Collect(ch)
+}
+
+var (
+	defaultServerMetrics = NewServerMetrics()
+	defaultClientMetrics = NewClientMetrics()
+)
+
+// Collectors 返回 Server 和 Client 默认使用的指标收集器
+//
+// 使用示例:
+//
+//	prometheus.MustRegister(metrics.Collectors()...)
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{defaultServerMetrics, defaultClientMetrics}
+}
+
+// Option 指标中间件选项
+type Option func(*options)
+
+type options struct {
+	metrics *Metrics
+	service string
+	tenant  bool
+}
+
+// WithMetrics 使用指定的指标收集器，默认使用 Collectors 返回的共享收集器
+func WithMetrics(m *Metrics) Option {
+	return func(o *options) {
+		o.metrics = m
+	}
+}
+
+// WithService 设置下游服务名称，作为客户端指标的 service 标签
+func WithService(name string) Option {
+	return func(o *options) {
+		o.service = name
+	}
+}
+
+// WithTenant 记录租户标签
+//
+// 时间序列数量随租户数量成倍增长，仅在租户数量有限时开启
+func WithTenant() Option {
+	return func(o *options) {
+		o.tenant = true
+	}
+}
+
+// Server 服务端指标中间件
+//
+// 需放在 auth.Server 之后，才能取到租户和产品编码
+//
+// 使用示例:
+//
+//	srv := grpc.NewServer(grpc.Middleware(
+//	    auth.Server(true),
+//	    metrics.Server(),
+//	))
+func Server(opts ...Option) middleware.Middleware {
+	o := newOptions(opts, defaultServerMetrics)
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			kind, operation := "unknown", "unknown"
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				kind, operation = tr.Kind().String(), tr.Operation()
+			}
+
+			start := time.Now()
+			reply, err := handler(ctx, req)
+			o.observe(ctx, kind, operation, err, time.Since(start))
+			return reply, err
+		}
+	}
+}
+
+// Client 客户端指标中间件
+//
+// CreateGRPCConn 创建的连接默认已安装（service 标签为 ServiceConfig.ServiceName），
+// 可通过 ServiceConfig.DisableMetrics 关闭。需要租户标签或独立收集器时，关闭默认指标后通过 WithMiddleware 添加
+//
+// 使用示例:
+//
+//	conn, err := middleware.CreateGRPCConn(config.WithoutMetrics(), discovery, logger,
+//	    middleware.WithMiddleware(metrics.Client(metrics.WithService("order-server"), metrics.WithTenant())),
+//	)
+func Client(opts ...Option) middleware.Middleware {
+	o := newOptions(opts, defaultClientMetrics)
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			operation := "unknown"
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				operation = tr.Operation()
+			}
+
+			start := time.Now()
+			reply, err := handler(ctx, req)
+			o.observe(ctx, o.service, operation, err, time.Since(start))
+			return reply, err
+		}
+	}
+}
+
+func newOptions(opts []Option, m *Metrics) *options {
+	o := &options{metrics: m}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// observe 记录一次调用
+func (o *options) observe(ctx context.Context, target, operation string, err error, elapsed time.Duration) {
+	var tenant string
+	if claims, ok := auth.FromContext(ctx); ok && o.tenant {
+		tenant = claims.TenantCode
+	}
+	product := auth.GetProductCode(ctx)
+
+	code, reason := 200, ""
+	if se := errors.FromError(err); se != nil {
+		code, reason = int(se.Code), se.Reason
+	}
+
+	o.metrics.requests.WithLabelValues(target, operation, strconv.Itoa(code), reason, tenant, product).Inc()
+	o.metrics.duration.WithLabelValues(target, operation, tenant, product).Observe(elapsed.Seconds())
+}
@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+// mockTransport 模拟 transport，仅提供 Operation
+type mockTransport struct {
+	kind transport.Kind
+}
+
+func (t *mockTransport) Kind() transport.Kind            { return t.kind }
+func (t *mockTransport) Endpoint() string                { return "" }
+func (t *mockTransport) Operation() string               { return "/order.v1.Order/Get" }
+func (t *mockTransport) RequestHeader() transport.Header { return nil }
+func (t *mockTransport) ReplyHeader() transport.Header   { return nil }
+
+func newClaimsContext() context.Context {
+	return auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001", ProductCode: "mall"})
+}
+
+func TestServer(t *testing.T) {
+	m := NewServerMetrics()
+	ctx := transport.NewServerContext(newClaimsContext(), &mockTransport{kind: transport.KindGRPC})
+
+	ok := Server(WithMetrics(m), WithTenant())(func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	fail := Server(WithMetrics(m), WithTenant())(func(context.Context, interface{}) (interface{}, error) {
+		return nil, errors.NotFound("ORDER_NOT_FOUND", "订单不存在")
+	})
+	_, _ = ok(ctx, nil)
+	_, _ = ok(ctx, nil)
+	_, _ = fail(ctx, nil)
+
+	if got := testutil.ToFloat64(m.requests.WithLabelValues("grpc", "/order.v1.Order/Get", "200", "", "T001", "mall")); got != 2 {
+		t.Errorf("Expected 2 successful requests, got %v", got)
+	}
+	if got := testutil.ToFloat64(m.requests.WithLabelValues("grpc", "/order.v1.Order/Get", "404", "ORDER_NOT_FOUND", "T001", "mall")); got != 1 {
+		t.Errorf("Expected 1 failed request, got %v", got)
+	}
+	if got := testutil.CollectAndCount(m, "rpc_server_request_duration_seconds"); got != 1 {
+		t.Errorf("Expected 1 duration series, got %d", got)
+	}
+}
+
+func TestClient(t *testing.T) {
+	m := NewClientMetrics()
+	ctx := transport.NewClientContext(newClaimsContext(), &mockTransport{kind: transport.KindGRPC})
+
+	h := Client(WithMetrics(m), WithService("order-server"))(func(context.Context, interface{}) (interface{}, error) {
+		return nil, errors.ServiceUnavailable("UNAVAILABLE", "down")
+	})
+	_, _ = h(ctx, nil)
+
+	if got := testutil.ToFloat64(m.requests.WithLabelValues("order-server", "/order.v1.Order/Get", "503", "UNAVAILABLE", "", "mall")); got != 1 {
+		t.Errorf("Expected 1 request without tenant label by default, got %v", got)
+	}
+	if got := testutil.CollectAndCount(m, "rpc_client_requests_total"); got != 1 {
+		t.Errorf("Expected 1 request series, got %d", got)
+	}
+}
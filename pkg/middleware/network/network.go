@@ -0,0 +1,175 @@
+// Package network 提供按来源网络限制访问的中间件，用于保护仅供内部调用的接口
+package network
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net"
+	"net/netip"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	kratosHttp "github.com/go-kratos/kratos/v2/transport/http"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	"github.com/heyinLab/common/pkg/middleware/common"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// DefaultInternalCIDRs 默认的内网地址段（私有地址、回环地址和 IPv6 ULA）
+var DefaultInternalCIDRs = []string{
+	"10.0.0.0/8",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"127.0.0.0/8",
+	"::1/128",
+	"fc00::/7",
+}
+
+// Guard 内部接口访问控制
+type Guard struct {
+	prefixes []netip.Prefix
+	token    string
+}
+
+// NewGuard 创建内部接口访问控制
+//
+// 参数:
+//   - cidrs: 受信任的地址段，为空时使用 DefaultInternalCIDRs；格式错误时 panic
+func NewGuard(cidrs ...string) *Guard {
+	if len(cidrs) == 0 {
+		cidrs = DefaultInternalCIDRs
+	}
+	g := &Guard{prefixes: make([]netip.Prefix, 0, len(cidrs))}
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			panic(fmt.Sprintf("network: 地址段格式错误: %s", cidr))
+		}
+		g.prefixes = append(g.prefixes, prefix.Masked())
+	}
+	return g
+}
+
+// WithToken 要求调用方同时携带内部服务令牌（X-Internal-Token）
+//
+// 调用方通过 Client(token) 中间件自动携带
+func (g *Guard) WithToken(token string) *Guard {
+	g.token = token
+	return g
+}
+
+// Server 内部接口访问控制中间件
+//
+// 拒绝来源地址不在受信任地址段内、或未携带正确内部服务令牌（已通过 WithToken 配置时）的请求，
+// 返回 403 ACCESS_FORBIDDEN
+//
+// 说明:
+//   - 来源地址取自 TCP 连接的对端地址，不信任 X-Forwarded-For 等请求头；
+//     经网关转发的请求来源为网关，内部接口不应通过网关对外暴露
+//   - 无法获取来源地址时拒绝请求
+func (g *Guard) Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			addr, ok := remoteAddr(ctx)
+			if !ok || !g.trusted(addr) {
+				log.Context(ctx).Warnf("拒绝非内网访问: remote_addr=%s, operation=%s", addr, operation(ctx))
+				return nil, businessErrors.ErrAccessForbidden.ToKratos()
+			}
+			if g.token != "" && !g.validToken(ctx) {
+				log.Context(ctx).Warnf("内部服务令牌无效: remote_addr=%s, operation=%s", addr, operation(ctx))
+				return nil, businessErrors.ErrAccessForbidden.ToKratos()
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// InternalOnly 仅允许内网访问的中间件，作为 Internal* 服务的纵深防御
+//
+// 参数:
+//   - cidrs: 受信任的地址段，为空时使用 DefaultInternalCIDRs
+//
+// 需要同时校验内部服务令牌时使用 NewGuard(cidrs...).WithToken(token).Server()
+//
+// 使用示例:
+//
+//	srv := grpc.NewServer(grpc.Middleware(
+//	    selector.Server(network.InternalOnly()).Regex(`^/[^/]+\.Internal[^/]*/`).Build(),
+//	))
+func InternalOnly(cidrs ...string) middleware.Middleware {
+	return NewGuard(cidrs...).Server()
+}
+
+// Client 客户端内部服务令牌中间件，为请求携带 X-Internal-Token
+//
+// 使用示例:
+//
+//	conn, err := middleware.CreateGRPCConn(config, discovery, logger,
+//	    middleware.WithMiddleware(network.Client(token)),
+//	)
+func Client(token string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromClientContext(ctx); ok {
+				tr.RequestHeader().Set(common.INTERNALTOKEN, token)
+			} else {
+				ctx = metadata.AppendToOutgoingContext(ctx, common.INTERNALTOKEN, token)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// trusted 来源地址是否在受信任地址段内
+func (g *Guard) trusted(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range g.prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// validToken 请求是否携带正确的内部服务令牌
+func (g *Guard) validToken(ctx context.Context) bool {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return false
+	}
+	token := tr.RequestHeader().Get(common.INTERNALTOKEN)
+	return subtle.ConstantTimeCompare([]byte(token), []byte(g.token)) == 1
+}
+
+// remoteAddr 获取请求的对端地址，gRPC 取自连接信息，HTTP 取自 RemoteAddr
+func remoteAddr(ctx context.Context) (netip.Addr, bool) {
+	var address string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		address = p.Addr.String()
+	} else if r, ok := kratosHttp.RequestFromServerContext(ctx); ok {
+		address = r.RemoteAddr
+	} else {
+		return netip.Addr{}, false
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addr, true
+}
+
+// operation 当前请求的操作名，用于日志
+func operation(ctx context.Context) string {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		return tr.Operation()
+	}
+	return ""
+}
@@ -0,0 +1,118 @@
+package network
+
+import (
+	"context"
+	"net"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc/peer"
+)
+
+// headerCarrier 基于 http.Header 的 transport.Header 实现
+type headerCarrier nethttp.Header
+
+func (h headerCarrier) Get(key string) string      { return nethttp.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { nethttp.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { nethttp.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return nethttp.Header(h).Values(key) }
+
+// mockTransport 模拟 transport
+type mockTransport struct {
+	kind   transport.Kind
+	header headerCarrier
+}
+
+func (t *mockTransport) Kind() transport.Kind            { return t.kind }
+func (t *mockTransport) Endpoint() string                { return "" }
+func (t *mockTransport) Operation() string               { return "/merchant.v1.InternalTenant/GetTenant" }
+func (t *mockTransport) RequestHeader() transport.Header { return t.header }
+func (t *mockTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+func okHandler(context.Context, interface{}) (interface{}, error) {
+	return "ok", nil
+}
+
+// newGRPCContext 模拟来自 ip 的 gRPC 请求
+func newGRPCContext(ip string, header headerCarrier) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000},
+	})
+	return transport.NewServerContext(ctx, &mockTransport{kind: transport.KindGRPC, header: header})
+}
+
+func TestInternalOnly(t *testing.T) {
+	h := InternalOnly()(okHandler)
+
+	tests := []struct {
+		ip      string
+		allowed bool
+	}{
+		{"10.1.2.3", true},
+		{"172.20.0.5", true},
+		{"192.168.1.10", true},
+		{"127.0.0.1", true},
+		{"::1", true},
+		{"::ffff:10.0.0.1", true},
+		{"8.8.8.8", false},
+		{"172.32.0.1", false},
+		{"2001:db8::1", false},
+	}
+	for _, tt := range tests {
+		_, err := h(newGRPCContext(tt.ip, headerCarrier{}), nil)
+		if tt.allowed && err != nil {
+			t.Errorf("%s: expected allowed, got %v", tt.ip, err)
+		}
+		if !tt.allowed && errors.FromError(err).Reason != "ACCESS_FORBIDDEN" {
+			t.Errorf("%s: expected ACCESS_FORBIDDEN, got %v", tt.ip, err)
+		}
+	}
+
+	// 无法获取来源地址时拒绝
+	if _, err := h(context.Background(), nil); err == nil {
+		t.Error("Expected request without peer to be rejected")
+	}
+
+	// 自定义地址段
+	if _, err := InternalOnly("203.0.113.0/24")(okHandler)(newGRPCContext("203.0.113.7", headerCarrier{}), nil); err != nil {
+		t.Errorf("Expected custom cidr allowed, got %v", err)
+	}
+}
+
+func TestGuard_Token(t *testing.T) {
+	h := NewGuard().WithToken("s3cret").Server()(okHandler)
+
+	if _, err := h(newGRPCContext("10.0.0.1", headerCarrier{}), nil); err == nil {
+		t.Error("Expected request without token to be rejected")
+	}
+	if _, err := h(newGRPCContext("10.0.0.1", headerCarrier{"X-Internal-Token": {"wrong"}}), nil); err == nil {
+		t.Error("Expected request with wrong token to be rejected")
+	}
+	if _, err := h(newGRPCContext("10.0.0.1", headerCarrier{"X-Internal-Token": {"s3cret"}}), nil); err != nil {
+		t.Errorf("Expected request with token allowed, got %v", err)
+	}
+	// 令牌正确但来源不受信任
+	if _, err := h(newGRPCContext("8.8.8.8", headerCarrier{"X-Internal-Token": {"s3cret"}}), nil); err == nil {
+		t.Error("Expected external request to be rejected")
+	}
+
+	// 客户端中间件携带令牌
+	header := headerCarrier{}
+	ctx := transport.NewClientContext(context.Background(), &mockTransport{kind: transport.KindGRPC, header: header})
+	Client("s3cret")(okHandler)(ctx, nil)
+	if header.Get("X-Internal-Token") != "s3cret" {
+		t.Errorf("Expected client token header, got %v", header)
+	}
+}
+
+func TestNewGuard_InvalidCIDR(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for invalid cidr")
+		}
+	}()
+	NewGuard("10.0.0.0/33")
+}
@@ -0,0 +1,51 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/heyinLab/common/pkg/internal/ttlcache"
+	"golang.org/x/time/rate"
+)
+
+// MemoryStore 进程内令牌桶存储
+//
+// 配额按实例计算，多实例部署时整体配额为 实例数 × Limit，需要全局配额时使用 RedisStore。
+// 最多保留 ttlcache.DefaultMaxEntries 个令牌桶，超出时被淘汰的 key 重新获得满额令牌
+type MemoryStore struct {
+	limit Limit
+	// idle 令牌桶从空到回满的时间，空闲超过该时间的桶与新建的桶等价，可以清理
+	idle    time.Duration
+	buckets *ttlcache.Cache[string, *rate.Limiter]
+}
+
+// NewMemoryStore 创建进程内令牌桶存储
+//
+// 注意: Rate 或 Burst <= 0 时 panic
+func NewMemoryStore(limit Limit) *MemoryStore {
+	limit.validate()
+	return &MemoryStore{
+		limit:   limit,
+		idle:    time.Duration(float64(limit.Burst) / limit.Rate * float64(time.Second)),
+		buckets: ttlcache.New[string, *rate.Limiter](0),
+	}
+}
+
+// Allow 实现 Store 接口
+func (s *MemoryStore) Allow(_ context.Context, key string) (bool, time.Duration, error) {
+	now := time.Now()
+
+	limiter := s.buckets.Compute(key, func(limiter *rate.Limiter, ok bool) (*rate.Limiter, time.Duration) {
+		if !ok {
+			limiter = rate.NewLimiter(rate.Limit(s.limit.Rate), s.limit.Burst)
+		}
+		return limiter, s.idle
+	})
+
+	r := limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return false, delay, nil
+	}
+	return true, 0, nil
+}
@@ -0,0 +1,137 @@
+// Package ratelimit 提供按租户、用户或 API Key 限流的中间件
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+)
+
+// Limit 令牌桶限流规则
+type Limit struct {
+	// Rate 每秒补充的令牌数
+	Rate float64
+	// Burst 桶容量，即允许的最大突发请求数
+	Burst int
+}
+
+// PerSecond 每秒 n 次，允许突发 n 次
+func PerSecond(n int) Limit {
+	return Limit{Rate: float64(n), Burst: n}
+}
+
+// PerMinute 每分钟 n 次，允许突发 n 次
+func PerMinute(n int) Limit {
+	return Limit{Rate: float64(n) / 60, Burst: n}
+}
+
+// validate 校验限流规则，无效时 panic
+func (l Limit) validate() {
+	if l.Rate <= 0 || math.IsInf(l.Rate, 0) || math.IsNaN(l.Rate) || l.Burst <= 0 {
+		panic("ratelimit: Rate 和 Burst 必须大于 0")
+	}
+}
+
+// Store 限流存储
+//
+// 内置 MemoryStore（单实例进程内）和 RedisStore（多实例共享）两种实现
+type Store interface {
+	// Allow 消耗 key 对应的一个令牌
+	//
+	// 返回:
+	//   - allowed: 是否放行
+	//   - retryAfter: 拒绝时距离下一个可用令牌的等待时间
+	//   - err: 存储不可用等错误
+	Allow(ctx context.Context, key string) (allowed bool, retryAfter time.Duration, err error)
+}
+
+// KeyFunc 从请求上下文中提取限流 key，返回空字符串时不限流
+type KeyFunc func(ctx context.Context) string
+
+// ByTenant 按租户限流，同一租户下所有用户和 API Key 共享配额
+func ByTenant(ctx context.Context) string {
+	claims, ok := auth.FromContext(ctx)
+	if !ok || claims.TenantCode == "" {
+		return ""
+	}
+	return "tenant:" + claims.TenantCode
+}
+
+// ByUser 按用户限流，不同租户下的同一用户分别计数
+func ByUser(ctx context.Context) string {
+	claims, ok := auth.FromContext(ctx)
+	if !ok || claims.UserCode == "" {
+		return ""
+	}
+	return "user:" + claims.TenantCode + ":" + claims.UserCode
+}
+
+// ByAPIKey 按 API Key 限流，仅对 OpenAPI 请求生效
+func ByAPIKey(ctx context.Context) string {
+	if !auth.IsOpenAPIRequest(ctx) {
+		return ""
+	}
+	id := auth.GetAPIKeyID(ctx)
+	if id == 0 {
+		return ""
+	}
+	return "apikey:" + strconv.FormatUint(id, 10)
+}
+
+// Server 限流中间件
+//
+// 需放在 auth.Server 之后，内置的 KeyFunc 依赖 Claims。超出配额时返回 429 业务错误
+// TOO_MANY_REQUESTS，metadata 和 Retry-After 响应头中带有建议的重试秒数；
+// 存储不可用时记录日志并放行，避免限流组件故障导致服务整体不可用
+//
+// 参数:
+//   - store: 限流存储，如 NewMemoryStore、NewRedisStore
+//   - keyFunc: 限流 key 提取函数，如 ByTenant、ByUser、ByAPIKey
+//
+// 使用示例:
+//
+//	srv := http.NewServer(http.Middleware(
+//	    auth.Server(true),
+//	    ratelimit.Server(ratelimit.NewMemoryStore(ratelimit.PerSecond(100)), ratelimit.ByTenant),
+//	    ratelimit.Server(ratelimit.NewRedisStore(evaler, ratelimit.PerMinute(600)), ratelimit.ByAPIKey),
+//	))
+func Server(store Store, keyFunc KeyFunc) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			key := keyFunc(ctx)
+			if key == "" {
+				return handler(ctx, req)
+			}
+
+			allowed, retryAfter, err := store.Allow(ctx, key)
+			if err != nil {
+				log.Context(ctx).Errorf("限流检查失败，放行请求: key=%s, error=%v", key, err)
+				return handler(ctx, req)
+			}
+			if !allowed {
+				return nil, tooManyRequests(ctx, retryAfter)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// tooManyRequests 构造 429 错误，并设置 Retry-After 响应头
+func tooManyRequests(ctx context.Context, retryAfter time.Duration) *errors.Error {
+	seconds := strconv.Itoa(max(1, int(math.Ceil(retryAfter.Seconds()))))
+	if tr, ok := transport.FromServerContext(ctx); ok && tr.ReplyHeader() != nil {
+		tr.ReplyHeader().Set("Retry-After", seconds)
+	}
+	e := businessErrors.ErrTooManyRequests
+	return errors.New(int(e.HttpCode), e.Type, e.Message).WithMetadata(map[string]string{
+		"retry_after": seconds,
+	})
+}
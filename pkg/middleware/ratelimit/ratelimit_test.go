@@ -0,0 +1,177 @@
+package ratelimit
+
+import (
+	"context"
+	stderrors "errors"
+	nethttp "net/http"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/common"
+)
+
+// headerCarrier 基于 http.Header 的 transport.Header 实现
+type headerCarrier nethttp.Header
+
+func (h headerCarrier) Get(key string) string      { return nethttp.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { nethttp.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { nethttp.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return nethttp.Header(h).Values(key) }
+
+// mockTransport 模拟服务端 transport，记录响应头
+type mockTransport struct {
+	reply headerCarrier
+}
+
+func (t *mockTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *mockTransport) Endpoint() string                { return "" }
+func (t *mockTransport) Operation() string               { return "/test.v1.Test/Get" }
+func (t *mockTransport) RequestHeader() transport.Header { return headerCarrier{} }
+func (t *mockTransport) ReplyHeader() transport.Header   { return t.reply }
+
+// mockStore 返回固定结果的限流存储
+type mockStore struct {
+	allowed bool
+	err     error
+	keys    []string
+}
+
+func (m *mockStore) Allow(_ context.Context, key string) (bool, time.Duration, error) {
+	m.keys = append(m.keys, key)
+	return m.allowed, 1500 * time.Millisecond, m.err
+}
+
+func okHandler(context.Context, interface{}) (interface{}, error) {
+	return "ok", nil
+}
+
+func TestServer(t *testing.T) {
+	tr := &mockTransport{reply: headerCarrier{}}
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001"})
+	ctx = transport.NewServerContext(ctx, tr)
+
+	store := NewMemoryStore(Limit{Rate: 1, Burst: 2})
+	h := Server(store, ByTenant)(okHandler)
+	for i := 0; i < 2; i++ {
+		if _, err := h(ctx, nil); err != nil {
+			t.Fatalf("Request %d should be allowed: %v", i, err)
+		}
+	}
+
+	_, err := h(ctx, nil)
+	e := errors.FromError(err)
+	if e.Code != 429 || e.Reason != "TOO_MANY_REQUESTS" || e.Metadata["retry_after"] != "1" {
+		t.Errorf("Expected 429 with retry_after, got %v", err)
+	}
+	if got := tr.reply.Get("Retry-After"); got != "1" {
+		t.Errorf("Expected Retry-After header 1, got %q", got)
+	}
+
+	// 其他租户不受影响
+	other := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T002"})
+	if _, err := h(other, nil); err != nil {
+		t.Errorf("Other tenant should be allowed: %v", err)
+	}
+
+	// 无法提取 key 时不限流
+	for i := 0; i < 5; i++ {
+		if _, err := h(context.Background(), nil); err != nil {
+			t.Fatalf("Request without claims should not be limited: %v", err)
+		}
+	}
+}
+
+func TestServer_StoreError(t *testing.T) {
+	store := &mockStore{err: stderrors.New("redis down")}
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001"})
+
+	if _, err := Server(store, ByUser)(okHandler)(ctx, nil); err != nil {
+		t.Errorf("Expected fail-open on store error, got %v", err)
+	}
+	if len(store.keys) != 1 || store.keys[0] != "user:T001:U001" {
+		t.Errorf("Unexpected keys: %v", store.keys)
+	}
+
+	store = &mockStore{allowed: false}
+	_, err := Server(store, ByUser)(okHandler)(ctx, nil)
+	if e := errors.FromError(err); e.Code != 429 || e.Metadata["retry_after"] != "2" {
+		t.Errorf("Expected 429 with retry_after 2, got %v", err)
+	}
+}
+
+func TestKeyFuncs(t *testing.T) {
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001"})
+	if got := ByTenant(ctx); got != "tenant:T001" {
+		t.Errorf("ByTenant = %q", got)
+	}
+	if got := ByUser(ctx); got != "user:T001:U001" {
+		t.Errorf("ByUser = %q", got)
+	}
+	if got := ByAPIKey(ctx); got != "" {
+		t.Errorf("ByAPIKey should be empty for token requests, got %q", got)
+	}
+
+	ctx = context.WithValue(ctx, common.KeyAuthType, common.AuthTypeOpenAPI)
+	ctx = context.WithValue(ctx, common.KeyAPIKeyID, uint64(42))
+	if got := ByAPIKey(ctx); got != "apikey:42" {
+		t.Errorf("ByAPIKey = %q", got)
+	}
+
+	if ByTenant(context.Background()) != "" || ByUser(context.Background()) != "" {
+		t.Error("Expected empty keys without claims")
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	store := NewMemoryStore(PerSecond(1))
+	ctx := context.Background()
+
+	if allowed, _, _ := store.Allow(ctx, "a"); !allowed {
+		t.Fatal("First request should be allowed")
+	}
+	allowed, retryAfter, err := store.Allow(ctx, "a")
+	if err != nil || allowed || retryAfter <= 0 || retryAfter > time.Second {
+		t.Errorf("Unexpected second request: allowed=%v, retryAfter=%v, err=%v", allowed, retryAfter, err)
+	}
+	if allowed, _, _ := store.Allow(ctx, "b"); !allowed {
+		t.Error("Different key should have its own bucket")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for invalid limit")
+		}
+	}()
+	NewMemoryStore(Limit{})
+}
+
+func TestRedisStore(t *testing.T) {
+	var gotKeys []string
+	var gotArgs []interface{}
+	result := interface{}([]interface{}{int64(0), int64(250)})
+	evaler := RedisEvalFunc(func(_ context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+		gotKeys, gotArgs = keys, args
+		return result, nil
+	})
+
+	store := NewRedisStore(evaler, PerMinute(30)).WithKeyPrefix("order:")
+	allowed, retryAfter, err := store.Allow(context.Background(), "tenant:T001")
+	if err != nil || allowed || retryAfter != 250*time.Millisecond {
+		t.Errorf("Unexpected result: allowed=%v, retryAfter=%v, err=%v", allowed, retryAfter, err)
+	}
+	if len(gotKeys) != 1 || gotKeys[0] != "order:tenant:T001" {
+		t.Errorf("Unexpected keys: %v", gotKeys)
+	}
+	if len(gotArgs) != 2 || gotArgs[0] != "0.5" || gotArgs[1] != 30 {
+		t.Errorf("Unexpected args: %v", gotArgs)
+	}
+
+	result = "unexpected"
+	if _, _, err := store.Allow(context.Background(), "tenant:T001"); err == nil {
+		t.Error("Expected error for invalid script result")
+	}
+}
@@ -0,0 +1,105 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// DefaultRedisKeyPrefix RedisStore 默认的 key 前缀
+const DefaultRedisKeyPrefix = "ratelimit:"
+
+// RedisEvaler 执行 Lua 脚本的 Redis 客户端
+//
+// 本库不直接依赖 Redis 客户端，go-redis 可通过 RedisEvalFunc 适配:
+//
+//	ratelimit.RedisEvalFunc(func(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+//	    return rdb.Eval(ctx, script, keys, args...).Result()
+//	})
+type RedisEvaler interface {
+	Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error)
+}
+
+// RedisEvalFunc 函数形式的 RedisEvaler
+type RedisEvalFunc func(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error)
+
+// Eval 实现 RedisEvaler 接口
+func (f RedisEvalFunc) Eval(ctx context.Context, script string, keys []string, args ...interface{}) (interface{}, error) {
+	return f(ctx, script, keys, args...)
+}
+
+// tokenBucketScript 令牌桶 Lua 脚本，使用 Redis 服务器时间，避免各实例时钟不一致
+//
+// KEYS[1]: 桶 key；ARGV[1]: 每秒补充令牌数；ARGV[2]: 桶容量
+// 返回 {是否放行(1/0), 需等待的毫秒数}
+const tokenBucketScript = `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local t = redis.call('TIME')
+local now = tonumber(t[1]) * 1000 + math.floor(tonumber(t[2]) / 1000)
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(state[1])
+local ts = tonumber(state[2])
+if tokens == nil or ts == nil then
+  tokens = burst
+  ts = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'ts', tostring(now))
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return {allowed, wait}
+`
+
+// RedisStore 基于 Redis 的令牌桶存储，多个实例共享同一配额
+type RedisStore struct {
+	client RedisEvaler
+	limit  Limit
+	prefix string
+}
+
+// NewRedisStore 创建基于 Redis 的令牌桶存储
+//
+// 参数:
+//   - client: Redis 客户端，见 RedisEvaler
+//   - limit: 限流规则
+//
+// 注意: Rate 或 Burst <= 0 时 panic
+func NewRedisStore(client RedisEvaler, limit Limit) *RedisStore {
+	limit.validate()
+	return &RedisStore{client: client, limit: limit, prefix: DefaultRedisKeyPrefix}
+}
+
+// WithKeyPrefix 设置 key 前缀，用于多个服务共用 Redis 时隔离配额
+func (s *RedisStore) WithKeyPrefix(prefix string) *RedisStore {
+	s.prefix = prefix
+	return s
+}
+
+// Allow 实现 Store 接口
+func (s *RedisStore) Allow(ctx context.Context, key string) (bool, time.Duration, error) {
+	res, err := s.client.Eval(ctx, tokenBucketScript, []string{s.prefix + key},
+		strconv.FormatFloat(s.limit.Rate, 'f', -1, 64), s.limit.Burst)
+	if err != nil {
+		return false, 0, fmt.Errorf("执行限流脚本失败: %w", err)
+	}
+
+	values, ok := res.([]interface{})
+	if !ok || len(values) != 2 {
+		return false, 0, fmt.Errorf("限流脚本返回值无效: %v", res)
+	}
+	allowed, ok1 := values[0].(int64)
+	wait, ok2 := values[1].(int64)
+	if !ok1 || !ok2 {
+		return false, 0, fmt.Errorf("限流脚本返回值无效: %v", res)
+	}
+	return allowed == 1, time.Duration(wait) * time.Millisecond, nil
+}
@@ -0,0 +1,151 @@
+// Package recovery 提供 panic 恢复中间件，支持接入错误上报（如 Sentry）
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/requestid"
+)
+
+// Report panic 上报信息
+type Report struct {
+	// Panic recover() 得到的值
+	Panic interface{}
+	// Stack panic 时的调用栈
+	Stack []byte
+	// Kind 传输协议（http/grpc）
+	Kind string
+	// Operation 请求的操作名，如 /api.order.v1.Order/CreateOrder
+	Operation string
+	// TenantCode 租户code
+	TenantCode string
+	// UserCode 用户code
+	UserCode string
+	// RequestID 请求ID
+	RequestID string
+	// Time 发生时间
+	Time time.Time
+}
+
+// Error panic 的错误描述
+func (r *Report) Error() string {
+	return fmt.Sprintf("panic: %v", r.Panic)
+}
+
+// Reporter 错误上报接口，Report 需自行处理超时，不应阻塞过久
+type Reporter interface {
+	Report(ctx context.Context, report *Report)
+}
+
+// ReporterFunc 函数形式的 Reporter
+type ReporterFunc func(ctx context.Context, report *Report)
+
+// Report 实现 Reporter
+func (f ReporterFunc) Report(ctx context.Context, report *Report) {
+	f(ctx, report)
+}
+
+// Option 恢复中间件选项
+type Option func(*options)
+
+type options struct {
+	reporters []Reporter
+}
+
+// WithReporter 添加错误上报，可多次调用
+func WithReporter(reporter Reporter) Option {
+	return func(o *options) {
+		o.reporters = append(o.reporters, reporter)
+	}
+}
+
+// Server panic 恢复中间件
+//
+// 捕获 handler 的 panic，记录包含调用栈、租户、用户和请求ID的错误日志并调用上报，
+// 返回统一的业务错误 500 SYSTEM_ERROR（metadata 中携带 request_id，便于用户反馈时定位），
+// 替代 kratos recovery 的通用 500
+//
+// 请求ID和用户信息从 context 读取，需放在 requestid.Server、auth.Server 之后；
+// 如需覆盖这些中间件自身的 panic，可在最外层再放置一个 recovery.Server()
+//
+// 使用示例:
+//
+//	reporter := recovery.ReporterFunc(func(ctx context.Context, r *recovery.Report) {
+//	    sentry.CaptureException(r)
+//	})
+//	srv := http.NewServer(http.Middleware(
+//	    recovery.Server(),
+//	    requestid.Server(),
+//	    auth.Server(true),
+//	    recovery.Server(recovery.WithReporter(reporter)),
+//	))
+func Server(opts ...Option) middleware.Middleware {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			defer func() {
+				if rerr := recover(); rerr != nil {
+					report := newReport(ctx, rerr)
+					log.Context(ctx).Errorf("请求处理发生 panic: operation=%s, tenant_code=%s, user_code=%s, request_id=%s, panic=%v\n%s",
+						report.Operation, report.TenantCode, report.UserCode, report.RequestID, rerr, report.Stack)
+					for _, reporter := range o.reporters {
+						safeReport(ctx, reporter, report)
+					}
+					reply, err = nil, panicError(report)
+				}
+			}()
+			return handler(ctx, req)
+		}
+	}
+}
+
+// newReport 从 context 收集请求信息
+func newReport(ctx context.Context, rerr interface{}) *Report {
+	report := &Report{
+		Panic:     rerr,
+		Stack:     debug.Stack(),
+		RequestID: requestid.FromContext(ctx),
+		Time:      time.Now(),
+	}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		report.Kind = tr.Kind().String()
+		report.Operation = tr.Operation()
+	}
+	if claims, ok := auth.FromContext(ctx); ok && claims != nil {
+		report.TenantCode = claims.TenantCode
+		report.UserCode = claims.UserCode
+	}
+	return report
+}
+
+// safeReport 调用上报，上报本身 panic 时只记录日志
+func safeReport(ctx context.Context, reporter Reporter, report *Report) {
+	defer func() {
+		if rerr := recover(); rerr != nil {
+			log.Context(ctx).Errorf("panic 上报失败: %v", rerr)
+		}
+	}()
+	reporter.Report(ctx, report)
+}
+
+// panicError panic 对应的业务错误，不向调用方暴露 panic 内容
+func panicError(report *Report) *errors.Error {
+	err := businessErrors.ErrSystemError.ToKratos()
+	if report.RequestID != "" {
+		err = err.WithMetadata(map[string]string{"request_id": report.RequestID})
+	}
+	return err
+}
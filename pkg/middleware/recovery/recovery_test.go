@@ -0,0 +1,45 @@
+package recovery
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/requestid"
+)
+
+func TestServer(t *testing.T) {
+	var got *Report
+	reporter := ReporterFunc(func(_ context.Context, r *Report) { got = r })
+	broken := ReporterFunc(func(context.Context, *Report) { panic("reporter down") })
+
+	h := Server(WithReporter(broken), WithReporter(reporter))(func(context.Context, interface{}) (interface{}, error) {
+		panic("nil map")
+	})
+
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001"})
+	ctx = requestid.NewContext(ctx, "req-1")
+	reply, err := h(ctx, nil)
+
+	e := errors.FromError(err)
+	if reply != nil || e.Code != 500 || e.Reason != "SYSTEM_ERROR" || e.Metadata["request_id"] != "req-1" {
+		t.Errorf("Expected SYSTEM_ERROR with request id, got %v", err)
+	}
+	if strings.Contains(e.Message, "nil map") {
+		t.Errorf("Panic value leaked to caller: %s", e.Message)
+	}
+	if got == nil || got.Panic != "nil map" || got.TenantCode != "T001" || got.UserCode != "U001" || got.RequestID != "req-1" || len(got.Stack) == 0 {
+		t.Errorf("Unexpected report: %+v", got)
+	}
+}
+
+func TestServer_NoPanic(t *testing.T) {
+	h := Server()(func(context.Context, interface{}) (interface{}, error) {
+		return "ok", nil
+	})
+	if reply, err := h(context.Background(), nil); reply != "ok" || err != nil {
+		t.Errorf("Expected pass through, got %v, %v", reply, err)
+	}
+}
@@ -0,0 +1,100 @@
+// Package requestid 提供请求ID的生成、透传和日志注入
+package requestid
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/google/uuid"
+	"github.com/heyinLab/common/pkg/middleware/common"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxLength 透传请求ID的最大长度，超长或包含非法字符时重新生成，防止日志注入
+const maxLength = 128
+
+type requestIDKey struct{}
+
+// NewContext 将请求ID注入 context
+func NewContext(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// FromContext 从 context 获取请求ID，不存在时返回空字符串
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// Server 请求ID中间件
+//
+// 优先使用请求头 X-Request-ID（网关或上游服务传入），缺失或不合法时生成新的ID：
+// 存在 OpenTelemetry 链路时使用 TraceID，便于日志与链路互相检索，否则使用 UUID。
+// 请求ID注入 context 并写回响应头；服务间调用由 ForwardClaims 自动转发到下游
+//
+// 需放在 tracing.Server 之后、日志中间件之前
+//
+// 使用示例:
+//
+//	logger = log.With(logger, "request_id", requestid.Valuer())
+//	srv := grpc.NewServer(grpc.Middleware(
+//	    tracing.Server(),
+//	    requestid.Server(),
+//	    logging.Server(&logging.Options{Logger: logger}),
+//	))
+func Server() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			var id string
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				id = tr.RequestHeader().Get(common.REQUESTID)
+				if !Valid(id) {
+					id = generate(ctx)
+				}
+				if tr.ReplyHeader() != nil {
+					tr.ReplyHeader().Set(common.REQUESTID, id)
+				}
+			} else {
+				id = generate(ctx)
+			}
+			return handler(NewContext(ctx, id), req)
+		}
+	}
+}
+
+// Valuer 日志字段，输出当前请求的请求ID
+//
+// 使用示例:
+//
+//	logger = log.With(logger, "request_id", requestid.Valuer())
+func Valuer() log.Valuer {
+	return func(ctx context.Context) interface{} {
+		return FromContext(ctx)
+	}
+}
+
+// generate 生成请求ID，存在链路时复用 TraceID
+func generate(ctx context.Context) string {
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		return sc.TraceID().String()
+	}
+	return uuid.NewString()
+}
+
+// Valid 判断请求ID是否合法：只允许字母、数字和 -_.:，长度不超过 128
+func Valid(id string) bool {
+	if id == "" || len(id) > maxLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
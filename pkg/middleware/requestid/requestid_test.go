@@ -0,0 +1,90 @@
+package requestid
+
+import (
+	"context"
+	nethttp "net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// headerCarrier 基于 http.Header 的 transport.Header 实现
+type headerCarrier nethttp.Header
+
+func (h headerCarrier) Get(key string) string      { return nethttp.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)      { nethttp.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)      { nethttp.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string             { return nil }
+func (h headerCarrier) Values(key string) []string { return nethttp.Header(h).Values(key) }
+
+// mockTransport 模拟服务端 transport
+type mockTransport struct {
+	request headerCarrier
+	reply   headerCarrier
+}
+
+func (t *mockTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *mockTransport) Endpoint() string                { return "" }
+func (t *mockTransport) Operation() string               { return "/test.v1.Test/Get" }
+func (t *mockTransport) RequestHeader() transport.Header { return t.request }
+func (t *mockTransport) ReplyHeader() transport.Header   { return t.reply }
+
+// serve 以指定请求头调用中间件，返回 handler 中获取的请求ID和响应头
+func serve(ctx context.Context, requestID string) (string, headerCarrier) {
+	tr := &mockTransport{request: headerCarrier{}, reply: headerCarrier{}}
+	if requestID != "" {
+		tr.request.Set("X-Request-ID", requestID)
+	}
+	var got string
+	h := Server()(func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = FromContext(ctx)
+		return nil, nil
+	})
+	h(transport.NewServerContext(ctx, tr), nil)
+	return got, tr.reply
+}
+
+func TestServer(t *testing.T) {
+	// 透传上游请求ID
+	id, reply := serve(context.Background(), "gw-123")
+	if id != "gw-123" || reply.Get("X-Request-ID") != "gw-123" {
+		t.Errorf("Expected propagated id, got %q, reply %q", id, reply.Get("X-Request-ID"))
+	}
+
+	// 缺失时生成 UUID
+	id, reply = serve(context.Background(), "")
+	if _, err := uuid.Parse(id); err != nil || reply.Get("X-Request-ID") != id {
+		t.Errorf("Expected generated uuid, got %q", id)
+	}
+
+	// 不合法时重新生成
+	if id, _ = serve(context.Background(), "bad\nid"); id == "bad\nid" || id == "" {
+		t.Errorf("Expected invalid id to be replaced, got %q", id)
+	}
+	if id, _ = serve(context.Background(), strings.Repeat("a", maxLength+1)); len(id) > maxLength {
+		t.Errorf("Expected oversized id to be replaced, got %q", id)
+	}
+
+	// 存在链路时复用 TraceID
+	traceID := trace.TraceID{0x01, 0x02, 0x03}
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: traceID,
+		SpanID:  trace.SpanID{0x01},
+	}))
+	if id, _ = serve(ctx, ""); id != traceID.String() {
+		t.Errorf("Expected trace id %s, got %q", traceID, id)
+	}
+}
+
+func TestValuer(t *testing.T) {
+	ctx := NewContext(context.Background(), "req-1")
+	if got := Valuer()(ctx); got != "req-1" {
+		t.Errorf("Valuer = %v", got)
+	}
+	if got := Valuer()(context.Background()); got != "" {
+		t.Errorf("Expected empty value without request id, got %v", got)
+	}
+}
@@ -0,0 +1,171 @@
+// Package tenantstatus 提供按租户状态拦截请求的中间件
+package tenantstatus
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware"
+	v1 "github.com/heyinLab/common/api/gen/go/merchant/v1"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	"github.com/heyinLab/common/pkg/internal/ttlcache"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultCacheTTL 租户状态的默认缓存时间
+	DefaultCacheTTL = time.Minute
+
+	// DefaultErrorCacheTTL 查询失败的缓存时间，商户服务不可用时避免每个请求都查询
+	DefaultErrorCacheTTL = 5 * time.Second
+
+	// staleRetention 缓存过期后继续保留的时间，商户服务不可用时在此期间使用过期的缓存状态
+	staleRetention = 10 * time.Minute
+)
+
+// TenantSource 租户信息来源，merchant.TenantClient 实现了该接口
+type TenantSource interface {
+	GetTenant(ctx context.Context, tenantCode string) (*v1.InternalTenant, error)
+}
+
+// Option 租户状态检查选项
+type Option func(*options)
+
+type options struct {
+	ttl          time.Duration
+	allowPastDue bool
+}
+
+// WithCacheTTL 设置租户状态的缓存时间，<=0 时不缓存
+func WithCacheTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.ttl = ttl
+	}
+}
+
+// AllowPastDue 放行欠费（PAST_DUE）的租户，用于欠费宽限期内仍允许访问的服务
+func AllowPastDue() Option {
+	return func(o *options) {
+		o.allowPastDue = true
+	}
+}
+
+// Server 租户状态检查中间件
+//
+// 按 Claims 中的租户查询租户状态（进程内缓存），拒绝不可用租户的请求:
+//   - PENDING: 403 TENANT_PENDING
+//   - PAST_DUE: 403 TENANT_EXPIRED（可通过 AllowPastDue 放行）
+//   - SUSPENDED: 403 TENANT_SUSPENDED
+//   - TERMINATED: 403 TENANT_DISABLED
+//   - 租户不存在（source 返回 gRPC NotFound，如 merchant.ErrTenantNotFound）: 404 TENANT_NOT_FOUND
+//
+// 无租户上下文的请求（平台级接口）直接放行。商户服务不可用时使用过期的缓存状态，
+// 没有缓存时记录日志并放行，避免商户服务故障导致所有服务不可用。
+// 查询失败的结果缓存 DefaultErrorCacheTTL（不超过缓存时间），期间不再查询商户服务
+//
+// 需放在 auth.Server 之后
+//
+// 参数:
+//   - source: 租户信息来源，通常为 merchant 客户端的 Tenant()
+//   - opts: 可选配置
+//
+// 使用示例:
+//
+//	srv := http.NewServer(http.Middleware(
+//	    auth.Server(true),
+//	    tenantstatus.Server(merchantClient.Tenant()),
+//	))
+func Server(source TenantSource, opts ...Option) middleware.Middleware {
+	o := &options{ttl: DefaultCacheTTL}
+	for _, opt := range opts {
+		opt(o)
+	}
+	c := &cache{source: source, ttl: o.ttl, errTTL: min(DefaultErrorCacheTTL, o.ttl), entries: ttlcache.New[string, cacheEntry](0)}
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims, ok := auth.FromContext(ctx)
+			if !ok || claims.TenantCode == "" {
+				return handler(ctx, req)
+			}
+
+			st, found, err := c.status(ctx, claims.TenantCode)
+			if err != nil {
+				log.Context(ctx).Errorf("获取租户状态失败，放行请求: tenant_code=%s, error=%v", claims.TenantCode, err)
+				return handler(ctx, req)
+			}
+			if !found {
+				return nil, businessErrors.ErrTenantNotFound.ToKratos()
+			}
+			if e := statusError(st, o.allowPastDue); e != nil {
+				return nil, e.ToKratos()
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// statusError 租户状态对应的业务错误，可访问时返回 nil
+func statusError(st v1.TenantStatus, allowPastDue bool) *businessErrors.BusinessError {
+	switch st {
+	case v1.TenantStatus_TENANT_STATUS_ACTIVE:
+		return nil
+	case v1.TenantStatus_TENANT_STATUS_PAST_DUE:
+		if allowPastDue {
+			return nil
+		}
+		return businessErrors.ErrTenantExpired
+	case v1.TenantStatus_TENANT_STATUS_PENDING:
+		return businessErrors.ErrTenantPending
+	case v1.TenantStatus_TENANT_STATUS_SUSPENDED:
+		return businessErrors.ErrTenantSuspended
+	default:
+		return businessErrors.ErrTenantDisabled
+	}
+}
+
+// cache 租户状态缓存
+type cache struct {
+	source TenantSource
+	ttl    time.Duration
+	errTTL time.Duration
+
+	entries *ttlcache.Cache[string, cacheEntry]
+}
+
+type cacheEntry struct {
+	status    v1.TenantStatus
+	found     bool
+	err       error
+	expiresAt time.Time
+}
+
+// status 获取租户状态，查询失败时返回过期的缓存，没有缓存时返回错误
+func (c *cache) status(ctx context.Context, tenantCode string) (v1.TenantStatus, bool, error) {
+	now := time.Now()
+	entry, ok := c.entries.Get(tenantCode)
+	if ok && now.Before(entry.expiresAt) {
+		return entry.status, entry.found, entry.err
+	}
+
+	tenant, err := c.source.GetTenant(ctx, tenantCode)
+	switch {
+	case err == nil:
+		entry = cacheEntry{status: tenant.GetStatus(), found: true, expiresAt: now.Add(c.ttl)}
+	case status.Code(err) == codes.NotFound:
+		entry = cacheEntry{found: false, expiresAt: now.Add(c.ttl)}
+	case ok && entry.err == nil:
+		// 继续使用过期的缓存状态
+		entry.expiresAt = now.Add(c.errTTL)
+	default:
+		entry = cacheEntry{err: err, expiresAt: now.Add(c.errTTL)}
+	}
+
+	if c.ttl > 0 {
+		c.entries.Set(tenantCode, entry, entry.expiresAt.Sub(now)+staleRetention)
+	}
+	return entry.status, entry.found, entry.err
+}
@@ -0,0 +1,144 @@
+package tenantstatus
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	v1 "github.com/heyinLab/common/api/gen/go/merchant/v1"
+	"github.com/heyinLab/common/pkg/merchant"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 确保 merchant.TenantClient 可以直接作为租户信息来源
+var _ TenantSource = (*merchant.TenantClient)(nil)
+
+// mockSource 模拟商户服务
+type mockSource struct {
+	tenants  map[string]v1.TenantStatus
+	err      error
+	notFound error
+	calls    int
+}
+
+func (m *mockSource) GetTenant(_ context.Context, tenantCode string) (*v1.InternalTenant, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	st, ok := m.tenants[tenantCode]
+	if !ok && m.notFound != nil {
+		return nil, m.notFound
+	}
+	if !ok {
+		return nil, status.Error(codes.NotFound, "tenant not found")
+	}
+	return &v1.InternalTenant{Code: tenantCode, Status: st}, nil
+}
+
+func okHandler(context.Context, interface{}) (interface{}, error) {
+	return "ok", nil
+}
+
+func call(h func(context.Context, interface{}) (interface{}, error), tenantCode string) error {
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: tenantCode})
+	_, err := h(ctx, nil)
+	return err
+}
+
+func TestServer(t *testing.T) {
+	source := &mockSource{tenants: map[string]v1.TenantStatus{
+		"ACTIVE":     v1.TenantStatus_TENANT_STATUS_ACTIVE,
+		"PENDING":    v1.TenantStatus_TENANT_STATUS_PENDING,
+		"PAST_DUE":   v1.TenantStatus_TENANT_STATUS_PAST_DUE,
+		"SUSPENDED":  v1.TenantStatus_TENANT_STATUS_SUSPENDED,
+		"TERMINATED": v1.TenantStatus_TENANT_STATUS_TERMINATED,
+	}}
+	h := Server(source)(okHandler)
+
+	tests := []struct {
+		tenant string
+		code   int32
+		reason string
+	}{
+		{"ACTIVE", 200, ""},
+		{"PENDING", 403, "TENANT_PENDING"},
+		{"PAST_DUE", 403, "TENANT_EXPIRED"},
+		{"SUSPENDED", 403, "TENANT_SUSPENDED"},
+		{"TERMINATED", 403, "TENANT_DISABLED"},
+		{"MISSING", 404, "TENANT_NOT_FOUND"},
+	}
+	for _, tt := range tests {
+		err := call(h, tt.tenant)
+		if tt.code == 200 {
+			if err != nil {
+				t.Errorf("%s: expected allowed, got %v", tt.tenant, err)
+			}
+			continue
+		}
+		if e := errors.FromError(err); e.Code != tt.code || e.Reason != tt.reason {
+			t.Errorf("%s: expected %d %s, got %v", tt.tenant, tt.code, tt.reason, err)
+		}
+	}
+
+	// 状态已缓存，不再查询商户服务
+	calls := source.calls
+	call(h, "SUSPENDED")
+	if source.calls != calls {
+		t.Errorf("Expected cached status, calls %d -> %d", calls, source.calls)
+	}
+
+	// 无租户上下文时放行
+	if _, err := h(context.Background(), nil); err != nil {
+		t.Errorf("Expected request without tenant to pass, got %v", err)
+	}
+
+	if err := call(Server(source, AllowPastDue())(okHandler), "PAST_DUE"); err != nil {
+		t.Errorf("Expected past due tenant to pass with AllowPastDue, got %v", err)
+	}
+}
+
+func TestServer_SourceError(t *testing.T) {
+	source := &mockSource{tenants: map[string]v1.TenantStatus{"T001": v1.TenantStatus_TENANT_STATUS_SUSPENDED}}
+	h := Server(source, WithCacheTTL(time.Nanosecond))(okHandler)
+
+	if err := call(h, "T001"); err == nil {
+		t.Fatal("Expected suspended tenant to be rejected")
+	}
+
+	// 查询失败时使用过期的缓存状态
+	source.err = stderrors.New("merchant down")
+	time.Sleep(time.Millisecond)
+	if e := errors.FromError(call(h, "T001")); e.Reason != "TENANT_SUSPENDED" {
+		t.Errorf("Expected stale status on source error, got %v", e)
+	}
+
+	// 没有缓存时放行
+	if err := call(h, "T002"); err != nil {
+		t.Errorf("Expected fail-open without cache, got %v", err)
+	}
+}
+
+func TestServer_MerchantNotFoundAndErrorCache(t *testing.T) {
+	source := &mockSource{notFound: merchant.ErrTenantNotFound.WithMetadata(map[string]string{"tenant_code": "MISSING"})}
+	h := Server(source)(okHandler)
+
+	if e := errors.FromError(call(h, "MISSING")); e.Code != 404 || e.Reason != "TENANT_NOT_FOUND" {
+		t.Errorf("Expected 404 for merchant.ErrTenantNotFound, got %v", e)
+	}
+
+	// 查询失败的结果短时间缓存，期间放行且不再查询
+	source.err = stderrors.New("merchant down")
+	for i := 0; i < 3; i++ {
+		if err := call(h, "T003"); err != nil {
+			t.Errorf("Expected fail-open on source error, got %v", err)
+		}
+	}
+	if source.calls != 2 {
+		t.Errorf("Expected source error to be cached, got %d calls", source.calls)
+	}
+}
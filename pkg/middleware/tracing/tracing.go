@@ -0,0 +1,144 @@
+// Package tracing 提供 OpenTelemetry 链路追踪中间件，在 kratos tracing 的基础上记录租户、用户和请求ID
+package tracing
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	kratosTracing "github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/common"
+	"github.com/heyinLab/common/pkg/middleware/requestid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// span 属性名
+const (
+	AttrTenantCode  = attribute.Key("tenant.code")
+	AttrUserCode    = attribute.Key("user.code")
+	AttrProductCode = attribute.Key("product.code")
+	AttrRequestID   = attribute.Key("request.id")
+)
+
+// Option 链路追踪选项
+type Option func(*options)
+
+type options struct {
+	provider   trace.TracerProvider
+	propagator propagation.TextMapPropagator
+}
+
+// WithTracerProvider 设置 TracerProvider，默认使用 otel.GetTracerProvider()
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(o *options) {
+		o.provider = provider
+	}
+}
+
+// WithPropagator 设置链路上下文的传播方式，默认为 W3C Trace Context 和 Baggage
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(o *options) {
+		o.propagator = propagator
+	}
+}
+
+func (o *options) kratosOptions() []kratosTracing.Option {
+	propagator := o.propagator
+	if propagator == nil {
+		propagator = propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	}
+	opts := []kratosTracing.Option{kratosTracing.WithPropagator(propagator)}
+	if o.provider != nil {
+		opts = append(opts, kratosTracing.WithTracerProvider(o.provider))
+	}
+	return opts
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// Server 服务端链路追踪中间件
+//
+// 从请求头（traceparent）还原上游链路并创建服务端 span，
+// span 属性中记录租户、用户、产品编码和请求ID（取自网关或上游服务传入的 Header）
+//
+// 需放在中间件链的最前面，requestid.Server 之前，以便请求ID复用 TraceID
+//
+// 使用示例:
+//
+//	srv := grpc.NewServer(grpc.Middleware(
+//	    tracing.Server(),
+//	    requestid.Server(),
+//	    middleware.ExtractClaims(),
+//	))
+func Server(opts ...Option) middleware.Middleware {
+	o := newOptions(opts)
+	server := kratosTracing.Server(o.kratosOptions()...)
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return server(func(ctx context.Context, req interface{}) (interface{}, error) {
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				header := tr.RequestHeader()
+				setAttributes(trace.SpanFromContext(ctx),
+					header.Get(common.TENANTCODE),
+					header.Get(common.USERCODE),
+					header.Get(common.PRODUCTCODE),
+					header.Get(common.REQUESTID),
+				)
+			}
+			return handler(ctx, req)
+		})
+	}
+}
+
+// Client 客户端链路追踪中间件
+//
+// 创建客户端 span 并将链路上下文（traceparent）注入请求头，
+// span 属性中记录当前请求的租户、用户、产品编码和请求ID
+//
+// 由 CreateGRPCConn 创建的连接可通过 ServiceConfig.WithTracing() 开启，
+// 位于 ForwardClaims 之前，链路上下文与认证信息一起转发
+func Client(opts ...Option) middleware.Middleware {
+	o := newOptions(opts)
+	client := kratosTracing.Client(o.kratosOptions()...)
+
+	return func(handler middleware.Handler) middleware.Handler {
+		return client(func(ctx context.Context, req interface{}) (interface{}, error) {
+			var tenantCode, userCode string
+			if claims, ok := auth.FromContext(ctx); ok && claims != nil {
+				tenantCode, userCode = claims.TenantCode, claims.UserCode
+			}
+			setAttributes(trace.SpanFromContext(ctx), tenantCode, userCode, auth.GetProductCode(ctx), requestid.FromContext(ctx))
+			return handler(ctx, req)
+		})
+	}
+}
+
+// setAttributes 记录非空的请求属性
+func setAttributes(span trace.Span, tenantCode, userCode, productCode, requestID string) {
+	if !span.IsRecording() {
+		return
+	}
+	attrs := make([]attribute.KeyValue, 0, 4)
+	if tenantCode != "" {
+		attrs = append(attrs, AttrTenantCode.String(tenantCode))
+	}
+	if userCode != "" {
+		attrs = append(attrs, AttrUserCode.String(userCode))
+	}
+	if productCode != "" {
+		attrs = append(attrs, AttrProductCode.String(productCode))
+	}
+	if requestID != "" {
+		attrs = append(attrs, AttrRequestID.String(requestID))
+	}
+	span.SetAttributes(attrs...)
+}
@@ -0,0 +1,126 @@
+package tracing
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/middleware/requestid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// headerCarrier 基于 http.Header 的 transport.Header 实现
+type headerCarrier nethttp.Header
+
+func (h headerCarrier) Get(key string) string { return nethttp.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string) { nethttp.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string) { nethttp.Header(h).Add(key, value) }
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for k := range h {
+		keys = append(keys, k)
+	}
+	return keys
+}
+func (h headerCarrier) Values(key string) []string { return nethttp.Header(h).Values(key) }
+
+// mockTransport 模拟 transport
+type mockTransport struct {
+	header headerCarrier
+}
+
+func (t *mockTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *mockTransport) Endpoint() string                { return "" }
+func (t *mockTransport) Operation() string               { return "/order.v1.Order/Get" }
+func (t *mockTransport) RequestHeader() transport.Header { return t.header }
+func (t *mockTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+// recordingProvider 记录 span 的父链路和属性
+type recordingProvider struct {
+	noop.TracerProvider
+	parent trace.SpanContext
+	attrs  map[attribute.Key]string
+}
+
+func (p *recordingProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return &recordingTracer{provider: p}
+}
+
+type recordingTracer struct {
+	noop.Tracer
+	provider *recordingProvider
+}
+
+func (t *recordingTracer) Start(ctx context.Context, _ string, _ ...trace.SpanStartOption) (context.Context, trace.Span) {
+	t.provider.parent = trace.SpanContextFromContext(ctx)
+	traceID := t.provider.parent.TraceID()
+	if !traceID.IsValid() {
+		traceID = trace.TraceID{1}
+	}
+	span := &recordingSpan{provider: t.provider, sc: trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})}
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+type recordingSpan struct {
+	noop.Span
+	provider *recordingProvider
+	sc       trace.SpanContext
+}
+
+func (s *recordingSpan) SpanContext() trace.SpanContext { return s.sc }
+func (s *recordingSpan) IsRecording() bool              { return true }
+func (s *recordingSpan) SetAttributes(kv ...attribute.KeyValue) {
+	for _, attr := range kv {
+		s.provider.attrs[attr.Key] = attr.Value.Emit()
+	}
+}
+
+func TestServer(t *testing.T) {
+	provider := &recordingProvider{attrs: map[attribute.Key]string{}}
+	header := headerCarrier{}
+	header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	header.Set("X-Tenant-Code", "T001")
+	header.Set("X-User-Code", "U001")
+	header.Set("X-Request-ID", "req-1")
+	ctx := transport.NewServerContext(context.Background(), &mockTransport{header: header})
+
+	var traceID string
+	Server(WithTracerProvider(provider))(func(ctx context.Context, req interface{}) (interface{}, error) {
+		traceID = trace.SpanContextFromContext(ctx).TraceID().String()
+		return nil, nil
+	})(ctx, nil)
+
+	if !provider.parent.IsRemote() || traceID != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("Expected upstream trace context, got parent=%v, trace=%s", provider.parent, traceID)
+	}
+	if provider.attrs[AttrTenantCode] != "T001" || provider.attrs[AttrUserCode] != "U001" || provider.attrs[AttrRequestID] != "req-1" {
+		t.Errorf("Unexpected attributes: %v", provider.attrs)
+	}
+}
+
+func TestClient(t *testing.T) {
+	provider := &recordingProvider{attrs: map[attribute.Key]string{}}
+	header := headerCarrier{}
+	ctx := transport.NewClientContext(context.Background(), &mockTransport{header: header})
+	ctx = auth.NewContext(ctx, &auth.Claims{UserCode: "U001", TenantCode: "T001", ProductCode: "mall"})
+	ctx = requestid.NewContext(ctx, "req-1")
+
+	Client(WithTracerProvider(provider))(func(context.Context, interface{}) (interface{}, error) {
+		return nil, nil
+	})(ctx, nil)
+
+	if header.Get("traceparent") == "" {
+		t.Errorf("Expected traceparent injected, got %v", header)
+	}
+	if provider.attrs[AttrTenantCode] != "T001" || provider.attrs[AttrProductCode] != "mall" || provider.attrs[AttrRequestID] != "req-1" {
+		t.Errorf("Unexpected attributes: %v", provider.attrs)
+	}
+}
@@ -0,0 +1,250 @@
+// Package webhook 提供 Webhook 请求的签名和验签
+//
+// 签名方案:
+//   - X-Webhook-Timestamp: 发送时间（Unix 秒）
+//   - X-Webhook-Signature: "v1=" + hex(HMAC-SHA256(secret, timestamp + "." + body))，
+//     密钥轮换期间可携带多个签名，以逗号分隔，任一匹配即通过
+//   - X-Webhook-Key-ID: 可选，接收方据此查找对应的密钥（如商户应用ID）
+//
+// 接收方校验签名并拒绝超过容忍时间的请求，防止篡改和重放
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	kratosHttp "github.com/go-kratos/kratos/v2/transport/http"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+)
+
+const (
+	// SignatureHeader 签名 Header
+	SignatureHeader = "X-Webhook-Signature"
+	// TimestampHeader 时间戳 Header
+	TimestampHeader = "X-Webhook-Timestamp"
+	// KeyIDHeader 密钥标识 Header
+	KeyIDHeader = "X-Webhook-Key-ID"
+
+	// DefaultTolerance 默认允许的时间偏差
+	DefaultTolerance = 5 * time.Minute
+	// DefaultMaxBodySize 默认请求体大小上限
+	DefaultMaxBodySize = 1 << 20
+
+	// signatureVersion 签名方案版本
+	signatureVersion = "v1"
+)
+
+// Webhook 错误 (10320-10329)，注册在 pkg/errors 错误目录中
+var (
+	// ErrSignatureMissing 缺少签名或时间戳
+	ErrSignatureMissing = businessErrors.Define(10320, "WEBHOOK_SIGNATURE_MISSING", 401, "缺少 Webhook 签名")
+	// ErrSignatureInvalid 签名不匹配
+	ErrSignatureInvalid = businessErrors.Define(10321, "WEBHOOK_SIGNATURE_INVALID", 401, "Webhook 签名无效")
+	// ErrTimestampExpired 时间戳超出容忍范围，可能为重放请求
+	ErrTimestampExpired = businessErrors.Define(10322, "WEBHOOK_TIMESTAMP_EXPIRED", 401, "Webhook 请求已过期")
+	// ErrBodyTooLarge 请求体超过大小上限
+	ErrBodyTooLarge = businessErrors.Define(10323, "WEBHOOK_BODY_TOO_LARGE", 413, "Webhook 请求体过大")
+	// ErrBodyInvalid 读取请求体失败
+	ErrBodyInvalid = businessErrors.Define(10324, "WEBHOOK_BODY_INVALID", 400, "读取请求体失败")
+)
+
+func init() {
+	businessErrors.RegisterLocale("en", map[string]string{
+		"WEBHOOK_SIGNATURE_MISSING": "Missing webhook signature",
+		"WEBHOOK_SIGNATURE_INVALID": "Invalid webhook signature",
+		"WEBHOOK_TIMESTAMP_EXPIRED": "Webhook request has expired",
+		"WEBHOOK_BODY_TOO_LARGE":    "Webhook request body is too large",
+		"WEBHOOK_BODY_INVALID":      "Failed to read webhook request body",
+	})
+}
+
+// Sign 计算 Webhook 签名
+//
+// 参数:
+//   - secret: 签名密钥
+//   - payload: 请求体原文
+//   - ts: 发送时间，需同时通过 X-Webhook-Timestamp 发送
+//
+// 返回:
+//   - string: X-Webhook-Signature 的值，格式为 "v1=<hex>"
+func Sign(secret string, payload []byte, ts time.Time) string {
+	return signatureVersion + "=" + hex.EncodeToString(mac(secret, ts.Unix(), payload))
+}
+
+// SignRequest 为发出的 Webhook 请求设置签名相关 Header
+//
+// 参数:
+//   - req: 待发送的请求
+//   - keyID: 密钥标识，为空时不设置 X-Webhook-Key-ID
+//   - secret: 签名密钥
+//   - payload: 请求体原文，需与 req 的请求体一致
+//
+// 使用示例:
+//
+//	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+//	webhook.SignRequest(req, appID, secret, body)
+//	resp, err := http.DefaultClient.Do(req)
+func SignRequest(req *http.Request, keyID, secret string, payload []byte) {
+	ts := time.Now()
+	req.Header.Set(TimestampHeader, strconv.FormatInt(ts.Unix(), 10))
+	req.Header.Set(SignatureHeader, Sign(secret, payload, ts))
+	if keyID != "" {
+		req.Header.Set(KeyIDHeader, keyID)
+	}
+}
+
+// Check 校验 Webhook 签名
+//
+// 参数:
+//   - secret: 签名密钥
+//   - payload: 请求体原文
+//   - timestamp: X-Webhook-Timestamp 的值
+//   - signature: X-Webhook-Signature 的值
+//   - tolerance: 允许的时间偏差，<=0 时不校验时间
+//
+// 返回:
+//   - error: ErrSignatureMissing、ErrSignatureInvalid 或 ErrTimestampExpired
+func Check(secret string, payload []byte, timestamp, signature string, tolerance time.Duration) error {
+	return check(secret, payload, timestamp, signature, tolerance, time.Now())
+}
+
+func check(secret string, payload []byte, timestamp, signature string, tolerance time.Duration, now time.Time) error {
+	if timestamp == "" || signature == "" {
+		return ErrSignatureMissing
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrSignatureInvalid
+	}
+	if tolerance > 0 {
+		if diff := now.Sub(time.Unix(ts, 0)); diff > tolerance || diff < -tolerance {
+			return ErrTimestampExpired
+		}
+	}
+
+	expected := mac(secret, ts, payload)
+	for _, part := range strings.Split(signature, ",") {
+		version, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok || version != signatureVersion {
+			continue
+		}
+		if sig, err := hex.DecodeString(value); err == nil && hmac.Equal(sig, expected) {
+			return nil
+		}
+	}
+	return ErrSignatureInvalid
+}
+
+// mac 计算 HMAC-SHA256(secret, timestamp + "." + payload)
+func mac(secret string, ts int64, payload []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(strconv.FormatInt(ts, 10)))
+	h.Write([]byte("."))
+	h.Write(payload)
+	return h.Sum(nil)
+}
+
+// SecretLookup 按密钥标识（X-Webhook-Key-ID，可能为空）查找签名密钥
+type SecretLookup func(ctx context.Context, keyID string) (string, error)
+
+// Option 验签中间件选项
+type Option func(*options)
+
+type options struct {
+	tolerance   time.Duration
+	maxBodySize int64
+}
+
+// WithTolerance 设置允许的时间偏差，<=0 时不校验时间（不推荐）
+func WithTolerance(tolerance time.Duration) Option {
+	return func(o *options) {
+		o.tolerance = tolerance
+	}
+}
+
+// WithMaxBodySize 设置请求体大小上限
+func WithMaxBodySize(size int64) Option {
+	return func(o *options) {
+		o.maxBodySize = size
+	}
+}
+
+type keyIDKey struct{}
+
+// KeyIDFromContext 获取已通过验签的密钥标识
+func KeyIDFromContext(ctx context.Context) string {
+	keyID, _ := ctx.Value(keyIDKey{}).(string)
+	return keyID
+}
+
+// Verify Webhook 验签中间件
+//
+// 读取请求体并校验签名和时间戳，通过后还原请求体交给后续处理，
+// 密钥标识可通过 KeyIDFromContext 获取。失败时按 kratos 错误格式返回 401
+//
+// 参数:
+//   - lookup: 密钥查找函数，返回错误或空密钥时拒绝请求
+//   - opts: 可选配置，如 WithTolerance、WithMaxBodySize
+//
+// 使用示例:
+//
+//	srv := http.NewServer(http.Filter(webhook.Verify(func(ctx context.Context, keyID string) (string, error) {
+//	    return appRepo.GetWebhookSecret(ctx, keyID)
+//	})))
+func Verify(lookup SecretLookup, opts ...Option) func(http.Handler) http.Handler {
+	o := &options{tolerance: DefaultTolerance, maxBodySize: DefaultMaxBodySize}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			keyID := r.Header.Get(KeyIDHeader)
+
+			body, err := io.ReadAll(io.LimitReader(r.Body, o.maxBodySize+1))
+			if err != nil {
+				writeError(w, r, ErrBodyInvalid)
+				return
+			}
+			if int64(len(body)) > o.maxBodySize {
+				writeError(w, r, ErrBodyTooLarge)
+				return
+			}
+
+			secret, err := lookup(ctx, keyID)
+			if err != nil || secret == "" {
+				if err != nil {
+					log.Context(ctx).Errorf("查找 Webhook 密钥失败: key_id=%s, error=%v", keyID, err)
+				}
+				writeError(w, r, ErrSignatureInvalid)
+				return
+			}
+
+			if err := Check(secret, body, r.Header.Get(TimestampHeader), r.Header.Get(SignatureHeader), o.tolerance); err != nil {
+				log.Context(ctx).Warnf("Webhook 验签失败: key_id=%s, error=%v", keyID, err)
+				writeError(w, r, businessErrors.FromError(err))
+				return
+			}
+
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r.WithContext(context.WithValue(ctx, keyIDKey{}, keyID)))
+		})
+	}
+}
+
+// writeError 按 Accept-Language 的首选语言输出错误响应
+func writeError(w http.ResponseWriter, r *http.Request, be *businessErrors.BusinessError) {
+	lang, _, _ := strings.Cut(r.Header.Get("Accept-Language"), ",")
+	lang, _, _ = strings.Cut(lang, ";")
+	kratosHttp.DefaultErrorEncoder(w, r, be.Localize(strings.TrimSpace(lang)).ToKratos())
+}
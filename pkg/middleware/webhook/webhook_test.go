@@ -0,0 +1,104 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+)
+
+func TestSignCheck(t *testing.T) {
+	payload := []byte(`{"event":"order.paid"}`)
+	now := time.Unix(1700000000, 0)
+	ts := strconv.FormatInt(now.Unix(), 10)
+	sig := Sign("s3cret", payload, now)
+
+	if err := check("s3cret", payload, ts, sig, DefaultTolerance, now); err != nil {
+		t.Errorf("Expected valid signature, got %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		payload   string
+		timestamp string
+		signature string
+		now       time.Time
+		want      *businessErrors.BusinessError
+	}{
+		{"missing", string(payload), ts, "", now, ErrSignatureMissing},
+		{"tampered", `{"event":"order.refunded"}`, ts, sig, now, ErrSignatureInvalid},
+		{"wrong timestamp", string(payload), "1700000001", sig, now, ErrSignatureInvalid},
+		{"unknown version", string(payload), ts, "v0=" + strings.TrimPrefix(sig, "v1="), now, ErrSignatureInvalid},
+		{"replayed", string(payload), ts, sig, now.Add(10 * time.Minute), ErrTimestampExpired},
+	}
+	for _, tt := range tests {
+		err := check("s3cret", []byte(tt.payload), tt.timestamp, tt.signature, DefaultTolerance, tt.now)
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, err)
+		}
+	}
+
+	// 密钥轮换期间携带多个签名
+	rotated := Sign("old", payload, now) + "," + sig
+	if err := check("s3cret", payload, ts, rotated, DefaultTolerance, now); err != nil {
+		t.Errorf("Expected rotated signature to pass, got %v", err)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	lookup := func(_ context.Context, keyID string) (string, error) {
+		if keyID == "app-1" {
+			return "s3cret", nil
+		}
+		return "", nil
+	}
+	var gotBody, gotKeyID string
+	h := Verify(lookup, WithMaxBodySize(64))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, _ := io.ReadAll(r.Body)
+		gotBody, gotKeyID = string(data), KeyIDFromContext(r.Context())
+	}))
+
+	body := `{"event":"order.paid"}`
+	req := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	SignRequest(req, "app-1", "s3cret", []byte(body))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusOK || gotBody != body || gotKeyID != "app-1" {
+		t.Errorf("Expected verified request, got code=%d, body=%q, key=%q", w.Code, gotBody, gotKeyID)
+	}
+
+	// 未知密钥标识
+	req = httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	SignRequest(req, "app-2", "s3cret", []byte(body))
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 for unknown key, got %d", w.Code)
+	}
+
+	// 错误信息按 Accept-Language 本地化
+	req = httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(body))
+	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if !strings.Contains(w.Body.String(), "Invalid webhook signature") {
+		t.Errorf("Expected localized error, got %s", w.Body.String())
+	}
+
+	// 请求体过大
+	large := strings.Repeat("x", 65)
+	req = httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(large))
+	SignRequest(req, "app-1", "s3cret", []byte(large))
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+	if w.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected 413 for large body, got %d", w.Code)
+	}
+}
@@ -0,0 +1,357 @@
+package platform
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	v1 "github.com/heyinLab/common/api/gen/go/platform/v1"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// DefaultAuditBatchSize 单批提交的默认审计日志数量
+	DefaultAuditBatchSize = 100
+
+	// DefaultAuditBufferSize 默认待提交缓冲区大小
+	DefaultAuditBufferSize = 1000
+
+	// DefaultAuditFlushInterval 默认定时提交间隔
+	DefaultAuditFlushInterval = time.Second
+
+	// DefaultAuditFlushTimeout 默认单批提交超时时间
+	DefaultAuditFlushTimeout = 5 * time.Second
+)
+
+// AuditMetadataImpersonator 代操作时记录实际操作者用户code的 Metadata 键
+const AuditMetadataImpersonator = "impersonator"
+
+var (
+	// ErrAuditBufferFull 缓冲区已满，审计日志被丢弃
+	ErrAuditBufferFull = errors.New("审计日志缓冲区已满")
+
+	// ErrAuditClientClosed 审计客户端已关闭
+	ErrAuditClientClosed = errors.New("审计客户端已关闭")
+)
+
+// AuditEvent 审计事件
+type AuditEvent struct {
+	// Actor 操作者，为零值时从 ctx 中获取（auth.GetOperator），显式指定时不记录 ctx 中的用户Code
+	Actor auth.Operator
+	// Action 操作，如 tenant.suspend（必填）
+	Action string
+	// Resource 资源，如 tenant/T001（必填）
+	Resource string
+	// Before 变更前内容，按 JSON 序列化，可以为 nil
+	Before any
+	// After 变更后内容，按 JSON 序列化，可以为 nil
+	After any
+	// TenantCode 租户编码，为空时从 ctx 中获取
+	TenantCode string
+	// Metadata 扩展信息
+	Metadata map[string]string
+	// OccurredAt 发生时间，为零值时使用当前时间
+	OccurredAt time.Time
+}
+
+// AuditOptions 审计客户端选项
+type AuditOptions struct {
+	// Service 来源服务名称
+	Service string
+	// BatchSize 单批提交数量，默认 DefaultAuditBatchSize
+	BatchSize int
+	// BufferSize 待提交缓冲区大小，默认 DefaultAuditBufferSize
+	BufferSize int
+	// FlushInterval 定时提交间隔，默认 DefaultAuditFlushInterval
+	FlushInterval time.Duration
+	// FlushTimeout 单批提交超时时间，默认 DefaultAuditFlushTimeout
+	FlushTimeout time.Duration
+}
+
+// AuditClient 审计日志客户端
+//
+// Record 只做序列化并写入缓冲区，不阻塞业务调用；后台协程在达到批量大小或定时到期时批量提交。
+// 缓冲区满时丢弃新事件并返回 ErrAuditBufferFull，提交失败时记录日志后丢弃该批次
+type AuditClient struct {
+	client v1.PlatformIamServiceClient
+	logger *log.Helper
+	opts   AuditOptions
+
+	events  chan *v1.AuditLogEntry
+	flushes chan chan struct{}
+	done    chan struct{}
+	stopped chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewAuditClient 创建审计日志客户端
+//
+// 审计客户端复用平台服务连接，并启动后台提交协程，使用完毕后需要调用 Close
+//
+// 参数:
+//   - client: 平台服务客户端
+//   - opts: 审计选项，可以为 nil
+//
+// 返回:
+//   - *AuditClient: 审计客户端
+//
+// 使用示例:
+//
+//	audit := platform.NewAuditClient(client, &platform.AuditOptions{Service: "order-server"})
+//	defer audit.Close(context.Background())
+//
+//	_ = audit.Record(ctx, platform.AuditEvent{
+//	    Action:   "tenant.suspend",
+//	    Resource: "tenant/" + tenantCode,
+//	    Before:   oldTenant,
+//	    After:    newTenant,
+//	})
+func NewAuditClient(client *Client, opts *AuditOptions) *AuditClient {
+	return newAuditClient(client.iamClient.client, client.logger, opts)
+}
+
+// newAuditClient 基于 gRPC 客户端创建审计客户端
+func newAuditClient(client v1.PlatformIamServiceClient, logger *log.Helper, opts *AuditOptions) *AuditClient {
+	var o AuditOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = DefaultAuditBatchSize
+	}
+	if o.BufferSize <= 0 {
+		o.BufferSize = DefaultAuditBufferSize
+	}
+	if o.FlushInterval <= 0 {
+		o.FlushInterval = DefaultAuditFlushInterval
+	}
+	if o.FlushTimeout <= 0 {
+		o.FlushTimeout = DefaultAuditFlushTimeout
+	}
+
+	c := &AuditClient{
+		client:  client,
+		logger:  logger,
+		opts:    o,
+		events:  make(chan *v1.AuditLogEntry, o.BufferSize),
+		flushes: make(chan chan struct{}),
+		done:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go c.run()
+
+	return c
+}
+
+// Record 记录审计事件
+//
+// 非阻塞，事件进入缓冲区后由后台协程批量提交
+//
+// 返回:
+//   - error: 参数错误、序列化失败、ErrAuditBufferFull 或 ErrAuditClientClosed
+func (c *AuditClient) Record(ctx context.Context, event AuditEvent) error {
+	entry, err := c.newEntry(ctx, event)
+	if err != nil {
+		return err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.closed {
+		return ErrAuditClientClosed
+	}
+
+	select {
+	case c.events <- entry:
+		return nil
+	default:
+		c.logger.WithContext(ctx).Warnf("审计日志缓冲区已满，丢弃事件, action=%s, resource=%s", event.Action, event.Resource)
+		return ErrAuditBufferFull
+	}
+}
+
+// Flush 立即提交缓冲区中的审计日志，并等待提交完成
+func (c *AuditClient) Flush(ctx context.Context) error {
+	done := make(chan struct{})
+	select {
+	case c.flushes <- done:
+	case <-c.stopped:
+		return ErrAuditClientClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close 停止接收新事件，提交剩余的审计日志后退出后台协程
+//
+// ctx 结束时不再等待剩余日志提交完成
+func (c *AuditClient) Close(ctx context.Context) error {
+	c.mu.Lock()
+	if !c.closed {
+		c.closed = true
+		close(c.done)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-c.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run 后台提交协程
+func (c *AuditClient) run() {
+	defer close(c.stopped)
+
+	ticker := time.NewTicker(c.opts.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*v1.AuditLogEntry, 0, c.opts.BatchSize)
+	flush := func() {
+		if len(batch) > 0 {
+			c.send(batch)
+			batch = make([]*v1.AuditLogEntry, 0, c.opts.BatchSize)
+		}
+	}
+	drain := func() {
+		for {
+			select {
+			case entry := <-c.events:
+				batch = append(batch, entry)
+				if len(batch) >= c.opts.BatchSize {
+					flush()
+				}
+			default:
+				flush()
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case entry := <-c.events:
+			batch = append(batch, entry)
+			if len(batch) >= c.opts.BatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case done := <-c.flushes:
+			drain()
+			close(done)
+		case <-c.done:
+			drain()
+			return
+		}
+	}
+}
+
+// send 提交一批审计日志，失败时记录日志后丢弃
+func (c *AuditClient) send(batch []*v1.AuditLogEntry) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.opts.FlushTimeout)
+	defer cancel()
+
+	if _, err := c.client.RecordAuditLogs(ctx, &v1.RecordAuditLogsRequest{Entries: batch}); err != nil {
+		c.logger.WithContext(ctx).Errorf("提交审计日志失败, count=%d, err=%v", len(batch), err)
+	}
+}
+
+// newEntry 将审计事件转换为日志条目
+func (c *AuditClient) newEntry(ctx context.Context, event AuditEvent) (*v1.AuditLogEntry, error) {
+	if event.Action == "" || event.Resource == "" {
+		return nil, fmt.Errorf("审计操作和资源不能为空")
+	}
+
+	before, err := marshalAuditValue(event.Before)
+	if err != nil {
+		return nil, fmt.Errorf("序列化变更前内容失败: %w", err)
+	}
+	after, err := marshalAuditValue(event.After)
+	if err != nil {
+		return nil, fmt.Errorf("序列化变更后内容失败: %w", err)
+	}
+
+	actor := event.Actor
+	explicitActor := actor != (auth.Operator{})
+	if !explicitActor {
+		actor = auth.GetOperator(ctx)
+	}
+	entry := &v1.AuditLogEntry{
+		EventId:    uuid.NewString(),
+		ActorType:  actor.Type,
+		ActorId:    actor.ID,
+		TenantCode: event.TenantCode,
+		Action:     event.Action,
+		Resource:   event.Resource,
+		Before:     before,
+		After:      after,
+		Service:    c.opts.Service,
+		Metadata:   event.Metadata,
+	}
+	if claims, ok := auth.FromContext(ctx); ok {
+		// 显式指定 Actor 时（如系统任务代用户执行），上下文中的用户不是操作者
+		if !explicitActor {
+			entry.ActorUserCode = claims.UserCode
+		}
+		if entry.TenantCode == "" {
+			entry.TenantCode = claims.TenantCode
+		}
+		// 代操作时记录实际操作者
+		if claims.IsImpersonated() {
+			metadata := make(map[string]string, len(event.Metadata)+1)
+			maps.Copy(metadata, event.Metadata)
+			metadata[AuditMetadataImpersonator] = claims.ImpersonatorCode
+			entry.Metadata = metadata
+		}
+	}
+
+	occurredAt := event.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+	entry.OccurredAt = timestamppb.New(occurredAt)
+
+	return entry, nil
+}
+
+// marshalAuditValue 将变更内容序列化为 JSON，nil 返回空字符串
+//
+// proto 消息使用 protojson 序列化，其余类型使用 encoding/json
+func marshalAuditValue(v any) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	if msg, ok := v.(proto.Message); ok {
+		data, err := protojson.Marshal(msg)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,160 @@
+package platform
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/heyinLab/common/api/gen/go/platform/v1"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"google.golang.org/grpc"
+)
+
+// mockAuditClient 记录每次提交的审计日志批次
+type mockAuditClient struct {
+	v1.PlatformIamServiceClient
+
+	mu      sync.Mutex
+	batches [][]*v1.AuditLogEntry
+}
+
+func (m *mockAuditClient) RecordAuditLogs(_ context.Context, in *v1.RecordAuditLogsRequest, _ ...grpc.CallOption) (*v1.RecordAuditLogsResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.batches = append(m.batches, in.GetEntries())
+	return &v1.RecordAuditLogsResponse{Accepted: int32(len(in.GetEntries()))}, nil
+}
+
+func (m *mockAuditClient) entries() []*v1.AuditLogEntry {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var all []*v1.AuditLogEntry
+	for _, batch := range m.batches {
+		all = append(all, batch...)
+	}
+	return all
+}
+
+func (m *mockAuditClient) batchCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.batches)
+}
+
+func TestAuditClientRecord(t *testing.T) {
+	mock := &mockAuditClient{}
+	c := newAuditClient(mock, log.NewHelper(log.DefaultLogger), &AuditOptions{Service: "order-server", FlushInterval: time.Hour})
+	defer c.Close(context.Background())
+
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001"})
+	err := c.Record(ctx, AuditEvent{
+		Action:   "tenant.suspend",
+		Resource: "tenant/T001",
+		Before:   map[string]string{"status": "active"},
+		After:    &v1.UserInfo{UserCode: "U002"},
+	})
+	if err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+	if err := c.Record(ctx, AuditEvent{Action: "tenant.suspend"}); err == nil {
+		t.Error("Expected error without resource")
+	}
+	// 显式 Actor 不使用上下文中的用户
+	if err := c.Record(ctx, AuditEvent{Action: "tenant.expire", Resource: "tenant/T001", Actor: auth.Operator{Type: "system", ID: 1}}); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+	entries := mock.entries()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if system := entries[1]; system.GetActorType() != "system" || system.GetActorUserCode() != "" || system.GetTenantCode() != "T001" {
+		t.Errorf("Unexpected explicit actor: %v", system)
+	}
+	entry := entries[0]
+	if entry.GetActorType() != "user" || entry.GetActorUserCode() != "U001" || entry.GetTenantCode() != "T001" {
+		t.Errorf("Unexpected actor: %v", entry)
+	}
+	if entry.GetBefore() != `{"status":"active"}` || entry.GetAfter() != `{"userCode":"U002"}` {
+		t.Errorf("Unexpected before/after: %q %q", entry.GetBefore(), entry.GetAfter())
+	}
+	if entry.GetService() != "order-server" || entry.GetEventId() == "" || entry.GetOccurredAt() == nil {
+		t.Errorf("Unexpected entry: %v", entry)
+	}
+}
+
+func TestAuditClientImpersonation(t *testing.T) {
+	mock := &mockAuditClient{}
+	c := newAuditClient(mock, log.NewHelper(log.DefaultLogger), &AuditOptions{FlushInterval: time.Hour})
+	defer c.Close(context.Background())
+
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001", ImpersonatorCode: "ADMIN"})
+	metadata := map[string]string{"ip": "127.0.0.1"}
+	if err := c.Record(ctx, AuditEvent{Action: "a", Resource: "r", Metadata: metadata}); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+	if err := c.Flush(context.Background()); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+
+	entry := mock.entries()[0]
+	if entry.GetMetadata()[AuditMetadataImpersonator] != "ADMIN" || entry.GetMetadata()["ip"] != "127.0.0.1" {
+		t.Errorf("Unexpected metadata: %v", entry.GetMetadata())
+	}
+	if _, ok := metadata[AuditMetadataImpersonator]; ok {
+		t.Error("Caller metadata should not be modified")
+	}
+}
+
+func TestAuditClientBatching(t *testing.T) {
+	mock := &mockAuditClient{}
+	c := newAuditClient(mock, log.NewHelper(log.DefaultLogger), &AuditOptions{BatchSize: 3, FlushInterval: time.Hour})
+	ctx := context.Background()
+
+	for range 7 {
+		if err := c.Record(ctx, AuditEvent{Action: "a", Resource: "r", Actor: auth.Operator{Type: "api_key", ID: 9}}); err != nil {
+			t.Fatalf("Record failed: %v", err)
+		}
+	}
+	waitFor(t, func() bool { return mock.batchCount() >= 2 })
+
+	// Close 提交剩余事件
+	if err := c.Close(ctx); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if got := len(mock.entries()); got != 7 {
+		t.Errorf("Expected 7 entries after close, got %d", got)
+	}
+	if mock.entries()[0].GetActorId() != 9 {
+		t.Errorf("Expected explicit actor, got %v", mock.entries()[0])
+	}
+
+	if err := c.Record(ctx, AuditEvent{Action: "a", Resource: "r"}); !errors.Is(err, ErrAuditClientClosed) {
+		t.Errorf("Expected ErrAuditClientClosed, got %v", err)
+	}
+	if err := c.Flush(ctx); !errors.Is(err, ErrAuditClientClosed) {
+		t.Errorf("Expected ErrAuditClientClosed from Flush, got %v", err)
+	}
+}
+
+func TestAuditClientBufferFull(t *testing.T) {
+	// 不启动后台协程，直接验证缓冲区满时的行为
+	c := &AuditClient{
+		logger: log.NewHelper(log.DefaultLogger),
+		events: make(chan *v1.AuditLogEntry, 1),
+	}
+	ctx := context.Background()
+
+	if err := c.Record(ctx, AuditEvent{Action: "a", Resource: "r"}); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+	if err := c.Record(ctx, AuditEvent{Action: "a", Resource: "r"}); !errors.Is(err, ErrAuditBufferFull) {
+		t.Errorf("Expected ErrAuditBufferFull, got %v", err)
+	}
+}
@@ -0,0 +1,156 @@
+package platform
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/platform/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultPermissionTreeCacheTTL 权限树缓存默认有效期
+	DefaultPermissionTreeCacheTTL = 5 * time.Minute
+
+	// DefaultPermissionTreeRefreshTimeout 后台刷新权限树的默认超时时间
+	DefaultPermissionTreeRefreshTimeout = 10 * time.Second
+)
+
+// permissionTreeEntry 权限树缓存条目
+type permissionTreeEntry struct {
+	tree       []*v1.TenantPermissionTreeNode
+	total      uint32
+	fetchedAt  time.Time
+	refreshing bool
+}
+
+// CachedIAMClient 带权限树缓存的 IAM 客户端
+//
+// GetTenantPermissionsTree 按状态过滤条件缓存，过期后先返回旧数据并在后台刷新
+// （stale-while-revalidate），菜单渲染不会因刷新而阻塞；后台刷新失败时继续使用旧数据。
+// 其余方法直接调用内部客户端
+//
+// 使用示例:
+//
+//	iam := platform.NewCachedIAMClient(client.IAM(), 10*time.Minute)
+//	tree, total, err := iam.GetTenantPermissionsTree(ctx, &platform.GetTenantPermissionsTreeOptions{
+//	    Status: "GA",
+//	})
+//
+//	// 权限配置变更后
+//	iam.Invalidate()
+type CachedIAMClient struct {
+	*IAMClient
+
+	ttl   time.Duration
+	mu    sync.Mutex
+	trees map[string]*permissionTreeEntry
+	gen   uint64
+	now   func() time.Time
+}
+
+// NewCachedIAMClient 创建带缓存的 IAM 客户端
+//
+// 参数:
+//   - inner: IAM 客户端，通常为 Client.IAM()
+//   - ttl: 缓存有效期，<=0 时使用 DefaultPermissionTreeCacheTTL
+func NewCachedIAMClient(inner *IAMClient, ttl time.Duration) *CachedIAMClient {
+	if ttl <= 0 {
+		ttl = DefaultPermissionTreeCacheTTL
+	}
+	return &CachedIAMClient{
+		IAMClient: inner,
+		ttl:       ttl,
+		trees:     make(map[string]*permissionTreeEntry),
+		now:       time.Now,
+	}
+}
+
+// GetTenantPermissionsTree 获取租户权限树，优先读取缓存
+//
+// 首次查询同步调用 IAM 服务；缓存过期后返回旧数据并触发一次后台刷新
+func (c *CachedIAMClient) GetTenantPermissionsTree(ctx context.Context, opts *GetTenantPermissionsTreeOptions) ([]*v1.TenantPermissionTreeNode, uint32, error) {
+	var status string
+	if opts != nil {
+		status = opts.Status
+	}
+
+	c.mu.Lock()
+	if entry, ok := c.trees[status]; ok {
+		if c.now().Sub(entry.fetchedAt) >= c.ttl && !entry.refreshing {
+			entry.refreshing = true
+			go c.refresh(context.WithoutCancel(ctx), status, opts, c.gen)
+		}
+		tree, total := cloneTree(entry.tree), entry.total
+		c.mu.Unlock()
+		return tree, total, nil
+	}
+	gen := c.gen
+	c.mu.Unlock()
+
+	tree, total, err := c.IAMClient.GetTenantPermissionsTree(ctx, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+	c.store(status, gen, tree, total)
+	return cloneTree(tree), total, nil
+}
+
+// Invalidate 清空权限树缓存，下次查询时同步拉取
+func (c *CachedIAMClient) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	clear(c.trees)
+	c.gen++
+}
+
+// refresh 后台刷新指定状态的权限树
+func (c *CachedIAMClient) refresh(ctx context.Context, status string, opts *GetTenantPermissionsTreeOptions, gen uint64) {
+	timeout := DefaultPermissionTreeRefreshTimeout
+	if opts != nil && opts.Timeout > 0 {
+		timeout = opts.Timeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	tree, total, err := c.IAMClient.GetTenantPermissionsTree(ctx, opts)
+	if err != nil {
+		c.mu.Lock()
+		if entry, ok := c.trees[status]; ok && c.gen == gen {
+			entry.refreshing = false
+		}
+		c.mu.Unlock()
+		c.logger.WithContext(ctx).Warnf("后台刷新租户权限树失败，继续使用缓存: status=%s, error=%v", status, err)
+		return
+	}
+	c.store(status, gen, tree, total)
+}
+
+// store 写入缓存，期间发生过 Invalidate 时丢弃结果
+func (c *CachedIAMClient) store(status string, gen uint64, tree []*v1.TenantPermissionTreeNode, total uint32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.gen != gen {
+		return
+	}
+	c.trees[status] = &permissionTreeEntry{
+		tree:      cloneTree(tree),
+		total:     total,
+		fetchedAt: c.now(),
+	}
+}
+
+// cloneTree 深拷贝权限树，避免调用方修改缓存
+func cloneTree(tree []*v1.TenantPermissionTreeNode) []*v1.TenantPermissionTreeNode {
+	if tree == nil {
+		return nil
+	}
+	result := make([]*v1.TenantPermissionTreeNode, len(tree))
+	for i, node := range tree {
+		result[i] = proto.Clone(node).(*v1.TenantPermissionTreeNode)
+	}
+	return result
+}
@@ -0,0 +1,124 @@
+package platform
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/platform/v1"
+	"google.golang.org/grpc"
+)
+
+// mockTreeClient 返回带版本号的权限树
+type mockTreeClient struct {
+	v1.PlatformIamServiceClient
+	mu      sync.Mutex
+	version uint32
+	err     error
+	calls   chan string
+}
+
+func (m *mockTreeClient) GetTenantPermissionsTree(ctx context.Context, in *v1.GetTenantPermissionsTreeRequest, opts ...grpc.CallOption) (*v1.GetTenantPermissionsTreeResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls <- in.GetStatus()
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &v1.GetTenantPermissionsTreeResponse{
+		Tree:  []*v1.TenantPermissionTreeNode{{Id: m.version, Name: "root"}},
+		Total: m.version,
+	}, nil
+}
+
+func (m *mockTreeClient) set(version uint32, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.version = version
+	m.err = err
+}
+
+func TestCachedIAMClient(t *testing.T) {
+	mock := &mockTreeClient{version: 1, calls: make(chan string, 10)}
+	cached := NewCachedIAMClient(newTestIAMClient(mock), time.Minute)
+	var mu sync.Mutex
+	now := time.Now()
+	cached.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	advance := func(d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		now = now.Add(d)
+	}
+	ctx := context.Background()
+	ga := &GetTenantPermissionsTreeOptions{Status: "GA"}
+
+	tree, total, err := cached.GetTenantPermissionsTree(ctx, ga)
+	if err != nil || total != 1 {
+		t.Fatalf("GetTenantPermissionsTree = %v, %d, %v", tree, total, err)
+	}
+	<-mock.calls
+	tree[0].Name = "mutated"
+
+	// 缓存命中，按状态区分
+	tree, _, _ = cached.GetTenantPermissionsTree(ctx, ga)
+	if tree[0].Name != "root" {
+		t.Errorf("cached tree was mutated: %s", tree[0].Name)
+	}
+	cached.GetTenantPermissionsTree(ctx, nil)
+	if status := <-mock.calls; status != "" {
+		t.Errorf("expected separate entry for empty status, got %q", status)
+	}
+
+	// 过期后返回旧数据并后台刷新
+	mock.set(2, nil)
+	advance(2 * time.Minute)
+	if _, total, _ := cached.GetTenantPermissionsTree(ctx, ga); total != 1 {
+		t.Errorf("expected stale total 1, got %d", total)
+	}
+	select {
+	case <-mock.calls:
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for background refresh")
+	}
+	waitFor(t, func() bool {
+		_, total, _ := cached.GetTenantPermissionsTree(ctx, ga)
+		return total == 2
+	})
+
+	// 刷新失败继续使用旧数据
+	mock.set(3, errors.New("unavailable"))
+	advance(2 * time.Minute)
+	cached.GetTenantPermissionsTree(ctx, ga)
+	<-mock.calls
+	if _, total, err := cached.GetTenantPermissionsTree(ctx, ga); err != nil || total != 2 {
+		t.Errorf("expected stale total 2 after failed refresh, got %d, %v", total, err)
+	}
+
+	// 失效后同步拉取
+	mock.set(4, nil)
+	cached.Invalidate()
+	for len(mock.calls) > 0 {
+		<-mock.calls
+	}
+	if _, total, _ := cached.GetTenantPermissionsTree(ctx, ga); total != 4 {
+		t.Errorf("expected total 4 after invalidate, got %d", total)
+	}
+}
+
+// waitFor 等待条件成立
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timeout waiting for condition")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
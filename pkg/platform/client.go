@@ -120,6 +120,28 @@ func NewClientWithDiscovery(config *Config, discovery registry.Discovery) (*Clie
 	}, nil
 }
 
+// NewClientWithConn 基于已建立的连接创建平台服务客户端
+//
+// 用于多个客户端共享连接（如 clients.Factory），连接由调用方管理，Close 不会关闭该连接
+//
+// 参数:
+//   - config: 客户端配置，nil 时使用默认配置
+//   - conn: gRPC 连接，通常由 middleware.CreateGRPCConn 创建
+func NewClientWithConn(config *Config, conn grpc.ClientConnInterface) *Client {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	logger := log.NewHelper(log.With(
+		log.GetLogger(),
+		"module", "platform-client",
+	))
+	return &Client{
+		config:    config,
+		logger:    logger,
+		iamClient: newIAMClient(conn, logger),
+	}
+}
+
 // Close 关闭客户端连接
 //
 // 释放 gRPC 连接资源，应该在程序退出前调用
@@ -156,7 +178,7 @@ type IAMClient struct {
 }
 
 // newIAMClient 创建 IAM 客户端
-func newIAMClient(conn *grpc.ClientConn, logger *log.Helper) *IAMClient {
+func newIAMClient(conn grpc.ClientConnInterface, logger *log.Helper) *IAMClient {
 	return &IAMClient{
 		client: v1.NewPlatformIamServiceClient(conn),
 		logger: logger,
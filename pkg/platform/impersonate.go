@@ -0,0 +1,69 @@
+package platform
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+
+	v1 "github.com/heyinLab/common/api/gen/go/platform/v1"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+)
+
+// Impersonate 以指定用户身份代操作
+//
+// 向 IAM 申请代操作授权，返回的 ctx 中 Claims 的 UserCode/TenantCode 为被代操作的用户，
+// ImpersonatorCode 为实际操作者，DelegationToken 为授权令牌。
+// 经由 ForwardClaims 中间件调用下游服务时，两种身份会一并转发，便于下游鉴权和审计
+//
+// 参数:
+//   - ctx: 上下文，必须携带实际操作者（平台管理员）的 Claims
+//   - tenantCode: 目标租户编码
+//   - userCode: 被代操作的用户编码
+//
+// 返回:
+//   - context.Context: 携带代操作身份的上下文
+//   - error: 缺少操作者身份、嵌套代操作或 IAM 拒绝授权时的错误
+//
+// 使用示例:
+//
+//	impCtx, err := client.IAM().Impersonate(ctx, "T001", "U001")
+//	if err != nil {
+//	    return err
+//	}
+//	// 以 U001 的身份调用下游服务
+//	orders, err := orderClient.ListOrders(impCtx, req)
+func (c *IAMClient) Impersonate(ctx context.Context, tenantCode, userCode string) (context.Context, error) {
+	if tenantCode == "" || userCode == "" {
+		return nil, fmt.Errorf("租户编码和用户编码不能为空")
+	}
+
+	operator, ok := auth.FromContext(ctx)
+	if !ok || operator.UserCode == "" {
+		return nil, fmt.Errorf("缺少操作者身份，无法代操作")
+	}
+	if operator.IsImpersonated() {
+		return nil, fmt.Errorf("不支持嵌套代操作")
+	}
+
+	resp, err := c.client.CreateImpersonation(ctx, &v1.CreateImpersonationRequest{
+		TenantCode: tenantCode,
+		UserCode:   userCode,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("创建代操作授权失败, operator=%s, tenantCode=%s, userCode=%s, err=%v", operator.UserCode, tenantCode, userCode, err)
+		return nil, err
+	}
+	if resp.GetDelegationToken() == "" {
+		return nil, fmt.Errorf("代操作授权令牌为空")
+	}
+
+	c.logger.WithContext(ctx).Infof("代操作授权成功, operator=%s, tenantCode=%s, userCode=%s", operator.UserCode, tenantCode, userCode)
+
+	return auth.NewContext(ctx, &auth.Claims{
+		UserCode:         userCode,
+		TenantCode:       tenantCode,
+		RegionName:       cmp.Or(resp.GetRegionName(), operator.RegionName),
+		ImpersonatorCode: operator.UserCode,
+		DelegationToken:  resp.GetDelegationToken(),
+	}), nil
+}
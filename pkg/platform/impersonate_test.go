@@ -0,0 +1,55 @@
+package platform
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/platform/v1"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"google.golang.org/grpc"
+)
+
+// mockImpersonationClient 模拟代操作授权
+type mockImpersonationClient struct {
+	v1.PlatformIamServiceClient
+
+	req      *v1.CreateImpersonationRequest
+	operator string
+}
+
+func (m *mockImpersonationClient) CreateImpersonation(ctx context.Context, in *v1.CreateImpersonationRequest, _ ...grpc.CallOption) (*v1.CreateImpersonationResponse, error) {
+	m.req = in
+	if claims, ok := auth.FromContext(ctx); ok {
+		m.operator = claims.UserCode
+	}
+	return &v1.CreateImpersonationResponse{DelegationToken: "dt-1"}, nil
+}
+
+func TestImpersonate(t *testing.T) {
+	mock := &mockImpersonationClient{}
+	c := newTestIAMClient(mock)
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "ADMIN", TenantCode: "PLATFORM", RegionName: "cn"})
+
+	impCtx, err := c.Impersonate(ctx, "T001", "U001")
+	if err != nil {
+		t.Fatalf("Impersonate failed: %v", err)
+	}
+	if mock.operator != "ADMIN" || mock.req.GetUserCode() != "U001" || mock.req.GetTenantCode() != "T001" {
+		t.Errorf("Unexpected request: operator=%s req=%v", mock.operator, mock.req)
+	}
+
+	claims, _ := auth.FromContext(impCtx)
+	if claims.UserCode != "U001" || claims.TenantCode != "T001" || claims.RegionName != "cn" {
+		t.Errorf("Unexpected impersonated claims: %+v", claims)
+	}
+	if !claims.IsImpersonated() || claims.ImpersonatorCode != "ADMIN" || claims.DelegationToken != "dt-1" {
+		t.Errorf("Expected impersonation info: %+v", claims)
+	}
+
+	if _, err := c.Impersonate(impCtx, "T002", "U002"); err == nil {
+		t.Error("Expected error for nested impersonation")
+	}
+	if _, err := c.Impersonate(context.Background(), "T001", "U001"); err == nil {
+		t.Error("Expected error without operator claims")
+	}
+}
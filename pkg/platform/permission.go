@@ -0,0 +1,163 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	v1 "github.com/heyinLab/common/api/gen/go/platform/v1"
+)
+
+// CheckPermission 检查用户是否拥有指定权限
+//
+// 参数:
+//   - ctx: 上下文
+//   - userCode: 用户编码
+//   - permissionCode: 权限code
+//
+// 返回:
+//   - bool: 是否拥有权限
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	ok, err := client.IAM().CheckPermission(ctx, claims.UserCode, "mall:goods:delete")
+//	if err != nil {
+//	    return err
+//	}
+//	if !ok {
+//	    return errors.Forbidden("FORBIDDEN", "无权删除商品")
+//	}
+func (c *IAMClient) CheckPermission(ctx context.Context, userCode string, permissionCode string) (bool, error) {
+	results, err := c.CheckPermissions(ctx, userCode, []string{permissionCode})
+	if err != nil {
+		return false, err
+	}
+	return results[permissionCode], nil
+}
+
+// CheckPermissions 批量检查用户是否拥有权限
+//
+// 参数:
+//   - ctx: 上下文
+//   - userCode: 用户编码
+//   - codes: 权限code列表，重复的code只检查一次
+//
+// 返回:
+//   - map[string]bool: 权限code -> 是否拥有，包含所有传入的code
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	results, err := client.IAM().CheckPermissions(ctx, userCode, []string{
+//	    "mall:goods:create",
+//	    "mall:goods:delete",
+//	})
+//	canDelete := results["mall:goods:delete"]
+//
+// 说明:
+//   - 服务端未返回的code视为没有权限
+func (c *IAMClient) CheckPermissions(ctx context.Context, userCode string, codes []string) (map[string]bool, error) {
+	if userCode == "" {
+		return nil, fmt.Errorf("用户编码不能为空")
+	}
+
+	results := make(map[string]bool, len(codes))
+	req := &v1.CheckPermissionsRequest{UserCode: userCode}
+	for _, code := range codes {
+		if code == "" {
+			return nil, fmt.Errorf("权限code不能为空")
+		}
+		if _, ok := results[code]; ok {
+			continue
+		}
+		results[code] = false
+		req.PermissionCodes = append(req.PermissionCodes, code)
+	}
+	if len(req.PermissionCodes) == 0 {
+		return results, nil
+	}
+
+	resp, err := c.client.CheckPermissions(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("检查用户权限失败: user_code=%s, codes=%v, error=%v", userCode, req.PermissionCodes, err)
+		return nil, err
+	}
+
+	for code := range results {
+		results[code] = resp.Results[code]
+	}
+	return results, nil
+}
+
+// FlattenPermissionTree 将权限树展开为权限code列表
+//
+// 按深度优先（父节点在前）的顺序返回，忽略没有code的节点，重复的code只保留一次
+//
+// 使用示例:
+//
+//	tree, _, err := client.IAM().GetTenantPermissionsTree(ctx, nil)
+//	codes := platform.FlattenPermissionTree(tree)
+func FlattenPermissionTree(tree []*v1.TenantPermissionTreeNode) []string {
+	var codes []string
+	seen := make(map[string]struct{})
+
+	var walk func(nodes []*v1.TenantPermissionTreeNode)
+	walk = func(nodes []*v1.TenantPermissionTreeNode) {
+		for _, node := range nodes {
+			if code := node.GetCode(); code != "" {
+				if _, ok := seen[code]; !ok {
+					seen[code] = struct{}{}
+					codes = append(codes, code)
+				}
+			}
+			walk(node.GetChildren())
+		}
+	}
+	walk(tree)
+
+	return codes
+}
+
+// DiffPermissionCodes 比较当前和目标权限code集合
+//
+// 参数:
+//   - current: 当前拥有的权限codes
+//   - desired: 期望拥有的权限codes
+//
+// 返回:
+//   - add: 需要新增的codes（在 desired 中但不在 current 中），已排序
+//   - remove: 需要移除的codes（在 current 中但不在 desired 中），已排序
+//
+// 使用示例:
+//
+//	desired := platform.FlattenPermissionTree(planTree)
+//	add, remove := platform.DiffPermissionCodes(currentCodes, desired)
+//	if len(add) == 0 && len(remove) == 0 {
+//	    return nil // 无需更新
+//	}
+func DiffPermissionCodes(current []string, desired []string) (add []string, remove []string) {
+	currentSet := make(map[string]struct{}, len(current))
+	for _, code := range current {
+		currentSet[code] = struct{}{}
+	}
+	desiredSet := make(map[string]struct{}, len(desired))
+	for _, code := range desired {
+		desiredSet[code] = struct{}{}
+	}
+
+	for code := range desiredSet {
+		if _, ok := currentSet[code]; !ok {
+			add = append(add, code)
+		}
+	}
+	for code := range currentSet {
+		if _, ok := desiredSet[code]; !ok {
+			remove = append(remove, code)
+		}
+	}
+	slices.Sort(add)
+	slices.Sort(remove)
+
+	return add, remove
+}
@@ -0,0 +1,106 @@
+package platform
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/heyinLab/common/api/gen/go/platform/v1"
+	"google.golang.org/grpc"
+)
+
+// mockIAMClient 按预设的权限集合返回检查结果
+type mockIAMClient struct {
+	v1.PlatformIamServiceClient
+	granted map[string]bool
+	calls   int
+	req     *v1.CheckPermissionsRequest
+}
+
+func (m *mockIAMClient) CheckPermissions(ctx context.Context, in *v1.CheckPermissionsRequest, opts ...grpc.CallOption) (*v1.CheckPermissionsResponse, error) {
+	m.calls++
+	m.req = in
+	results := make(map[string]bool)
+	for _, code := range in.PermissionCodes {
+		if m.granted[code] {
+			results[code] = true
+		}
+	}
+	return &v1.CheckPermissionsResponse{Results: results}, nil
+}
+
+func newTestIAMClient(client v1.PlatformIamServiceClient) *IAMClient {
+	return &IAMClient{
+		client: client,
+		logger: log.NewHelper(log.DefaultLogger),
+	}
+}
+
+func TestCheckPermissions(t *testing.T) {
+	mock := &mockIAMClient{granted: map[string]bool{"goods:create": true}}
+	client := newTestIAMClient(mock)
+	ctx := context.Background()
+
+	results, err := client.CheckPermissions(ctx, "U001", []string{"goods:create", "goods:delete", "goods:create"})
+	if err != nil {
+		t.Fatalf("CheckPermissions failed: %v", err)
+	}
+	if len(mock.req.PermissionCodes) != 2 {
+		t.Errorf("expected deduplicated codes, got %v", mock.req.PermissionCodes)
+	}
+	if !results["goods:create"] || results["goods:delete"] || len(results) != 2 {
+		t.Errorf("unexpected results: %v", results)
+	}
+
+	ok, err := client.CheckPermission(ctx, "U001", "goods:delete")
+	if err != nil || ok {
+		t.Errorf("CheckPermission = %v, %v", ok, err)
+	}
+
+	calls := mock.calls
+	if results, err := client.CheckPermissions(ctx, "U001", nil); err != nil || len(results) != 0 || mock.calls != calls {
+		t.Errorf("expected no call for empty codes: %v, %v", results, err)
+	}
+	if _, err := client.CheckPermissions(ctx, "", []string{"goods:create"}); err == nil {
+		t.Error("expected error for empty user code")
+	}
+}
+
+func TestFlattenPermissionTree(t *testing.T) {
+	code := func(s string) *string { return &s }
+	tree := []*v1.TenantPermissionTreeNode{
+		{
+			Code: code("mall"),
+			Children: []*v1.TenantPermissionTreeNode{
+				{Code: code("mall:goods")},
+				{Name: "分组", Children: []*v1.TenantPermissionTreeNode{{Code: code("mall:order")}}},
+			},
+		},
+		{Code: code("mall:goods")},
+		{Code: code("crm")},
+	}
+
+	codes := FlattenPermissionTree(tree)
+	if !slices.Equal(codes, []string{"mall", "mall:goods", "mall:order", "crm"}) {
+		t.Errorf("unexpected codes: %v", codes)
+	}
+	if codes := FlattenPermissionTree(nil); len(codes) != 0 {
+		t.Errorf("expected empty codes, got %v", codes)
+	}
+}
+
+func TestDiffPermissionCodes(t *testing.T) {
+	add, remove := DiffPermissionCodes(
+		[]string{"a", "b", "c", "c"},
+		[]string{"c", "d", "b", "e"},
+	)
+	if !slices.Equal(add, []string{"d", "e"}) || !slices.Equal(remove, []string{"a"}) {
+		t.Errorf("unexpected diff: add=%v, remove=%v", add, remove)
+	}
+
+	add, remove = DiffPermissionCodes([]string{"a"}, []string{"a"})
+	if len(add) != 0 || len(remove) != 0 {
+		t.Errorf("expected no diff, got add=%v, remove=%v", add, remove)
+	}
+}
@@ -0,0 +1,61 @@
+package platform
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/platform/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mockTokenClient 按令牌返回预设的校验结果
+type mockTokenClient struct {
+	v1.PlatformIamServiceClient
+	tokens map[string]*v1.IntrospectTokenResponse
+}
+
+func (m *mockTokenClient) IntrospectToken(ctx context.Context, in *v1.IntrospectTokenRequest, opts ...grpc.CallOption) (*v1.IntrospectTokenResponse, error) {
+	if resp, ok := m.tokens[in.Token]; ok {
+		return resp, nil
+	}
+	return &v1.IntrospectTokenResponse{Active: false}, nil
+}
+
+func TestIntrospectToken(t *testing.T) {
+	now := time.Now()
+	client := newTestIAMClient(&mockTokenClient{tokens: map[string]*v1.IntrospectTokenResponse{
+		"valid": {
+			Active:     true,
+			UserCode:   "U001",
+			TenantCode: "T001",
+			Scopes:     []string{"orders:read"},
+			ExpiresAt:  timestamppb.New(now.Add(time.Hour)),
+		},
+		"expired": {Active: true, ExpiresAt: timestamppb.New(now.Add(-time.Minute))},
+	}})
+	ctx := context.Background()
+
+	info, err := client.introspectToken(ctx, "valid", now)
+	if err != nil {
+		t.Fatalf("introspectToken failed: %v", err)
+	}
+	if !info.HasScope("orders:read") || info.HasScope("orders:write") {
+		t.Errorf("unexpected scopes: %v", info.Scopes)
+	}
+	if claims := info.Claims(); claims.UserCode != "U001" || claims.TenantCode != "T001" {
+		t.Errorf("unexpected claims: %+v", claims)
+	}
+
+	if _, err := client.introspectToken(ctx, "revoked", now); !errors.Is(err, ErrTokenInactive) {
+		t.Errorf("expected ErrTokenInactive for inactive token, got %v", err)
+	}
+	if _, err := client.introspectToken(ctx, "expired", now); !errors.Is(err, ErrTokenInactive) {
+		t.Errorf("expected ErrTokenInactive for expired token, got %v", err)
+	}
+	if _, err := client.IntrospectToken(ctx, ""); err == nil {
+		t.Error("expected error for empty token")
+	}
+}
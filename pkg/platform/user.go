@@ -0,0 +1,216 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/platform/v1"
+)
+
+// GetUser 获取用户信息
+//
+// 参数:
+//   - ctx: 上下文
+//   - userCode: 用户编码
+//
+// 返回:
+//   - *v1.UserInfo: 用户信息
+//   - error: 错误信息
+func (c *IAMClient) GetUser(ctx context.Context, userCode string) (*v1.UserInfo, error) {
+	if userCode == "" {
+		return nil, fmt.Errorf("用户编码不能为空")
+	}
+
+	resp, err := c.client.GetUser(ctx, &v1.GetUserRequest{UserCode: userCode})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取用户失败: user_code=%s, error=%v", userCode, err)
+		return nil, err
+	}
+
+	return resp.User, nil
+}
+
+// ListUsersOptions 获取用户列表的选项
+type ListUsersOptions struct {
+	// Page 页码
+	Page *int32
+	// PageSize 每页数量
+	PageSize *int32
+	// TenantCode 租户编码筛选
+	TenantCode *string
+	// Status 状态筛选
+	Status *string
+	// Keyword 关键词（用户名/昵称/邮箱/手机号）
+	Keyword *string
+	// RoleCode 角色编码筛选
+	RoleCode *string
+	// Timeout 自定义超时时间（可选）
+	Timeout time.Duration
+}
+
+// ListUsers 获取用户列表
+//
+// 参数:
+//   - ctx: 上下文
+//   - opts: 查询选项（可选）
+//
+// 返回:
+//   - []*v1.UserInfo: 用户列表
+//   - uint32: 总数量
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	users, total, err := client.IAM().ListUsers(ctx, &platform.ListUsersOptions{
+//	    TenantCode: &tenantCode,
+//	    RoleCode:   &roleCode,
+//	})
+func (c *IAMClient) ListUsers(ctx context.Context, opts *ListUsersOptions) ([]*v1.UserInfo, uint32, error) {
+	req := &v1.ListUsersRequest{}
+	if opts != nil {
+		if opts.Timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+			defer cancel()
+		}
+		req.Page = opts.Page
+		req.PageSize = opts.PageSize
+		req.TenantCode = opts.TenantCode
+		req.Status = opts.Status
+		req.Keyword = opts.Keyword
+		req.RoleCode = opts.RoleCode
+	}
+
+	resp, err := c.client.ListUsers(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取用户列表失败: tenant_code=%s, error=%v", req.GetTenantCode(), err)
+		return nil, 0, err
+	}
+
+	return resp.Users, resp.Total, nil
+}
+
+// GetUserRoles 获取用户的角色列表
+func (c *IAMClient) GetUserRoles(ctx context.Context, userCode string) ([]*v1.RoleInfo, error) {
+	if userCode == "" {
+		return nil, fmt.Errorf("用户编码不能为空")
+	}
+
+	resp, err := c.client.GetUserRoles(ctx, &v1.GetUserRolesRequest{UserCode: userCode})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取用户角色失败: user_code=%s, error=%v", userCode, err)
+		return nil, err
+	}
+
+	return resp.Roles, nil
+}
+
+// CreateRoleOptions 创建角色的参数
+type CreateRoleOptions struct {
+	// RoleCode 角色编码（可选，为空时由服务端生成）
+	RoleCode string
+	// Name 角色名称（必填）
+	Name string
+	// Description 描述（可选）
+	Description string
+	// TenantCode 所属租户编码（可选，为空表示平台角色）
+	TenantCode string
+	// PermissionCodes 初始权限codes（可选）
+	PermissionCodes []string
+}
+
+// CreateRole 创建角色
+//
+// 使用示例:
+//
+//	role, err := client.IAM().CreateRole(ctx, &platform.CreateRoleOptions{
+//	    Name:            "店长",
+//	    TenantCode:      tenantCode,
+//	    PermissionCodes: []string{"mall:goods", "mall:order"},
+//	})
+func (c *IAMClient) CreateRole(ctx context.Context, opts *CreateRoleOptions) (*v1.RoleInfo, error) {
+	if opts == nil || opts.Name == "" {
+		return nil, fmt.Errorf("角色名称不能为空")
+	}
+
+	req := &v1.CreateRoleRequest{
+		Name:            opts.Name,
+		PermissionCodes: opts.PermissionCodes,
+	}
+	if opts.RoleCode != "" {
+		req.RoleCode = &opts.RoleCode
+	}
+	if opts.Description != "" {
+		req.Description = &opts.Description
+	}
+	if opts.TenantCode != "" {
+		req.TenantCode = &opts.TenantCode
+	}
+
+	resp, err := c.client.CreateRole(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("创建角色失败: name=%s, tenant_code=%s, error=%v", opts.Name, opts.TenantCode, err)
+		return nil, err
+	}
+
+	return resp.Role, nil
+}
+
+// AssignRolePermissions 为角色分配权限
+//
+// 参数:
+//   - ctx: 上下文
+//   - roleCode: 角色编码
+//   - permissionCodes: 权限codes
+//   - replace: true 时替换角色现有权限，false 时追加
+//
+// 返回:
+//   - *v1.RoleInfo: 分配后的角色信息
+//   - error: 错误信息
+func (c *IAMClient) AssignRolePermissions(ctx context.Context, roleCode string, permissionCodes []string, replace bool) (*v1.RoleInfo, error) {
+	if roleCode == "" {
+		return nil, fmt.Errorf("角色编码不能为空")
+	}
+
+	resp, err := c.client.AssignRolePermissions(ctx, &v1.AssignRolePermissionsRequest{
+		RoleCode:        roleCode,
+		PermissionCodes: permissionCodes,
+		Replace:         replace,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("分配角色权限失败: role_code=%s, replace=%v, error=%v", roleCode, replace, err)
+		return nil, err
+	}
+
+	return resp.Role, nil
+}
+
+// AssignUserRoles 为用户分配角色
+//
+// 参数:
+//   - ctx: 上下文
+//   - userCode: 用户编码
+//   - roleCodes: 角色编码列表
+//   - replace: true 时替换用户现有角色，false 时追加
+//
+// 返回:
+//   - []*v1.RoleInfo: 分配后用户的全部角色
+//   - error: 错误信息
+func (c *IAMClient) AssignUserRoles(ctx context.Context, userCode string, roleCodes []string, replace bool) ([]*v1.RoleInfo, error) {
+	if userCode == "" {
+		return nil, fmt.Errorf("用户编码不能为空")
+	}
+
+	resp, err := c.client.AssignUserRoles(ctx, &v1.AssignUserRolesRequest{
+		UserCode:  userCode,
+		RoleCodes: roleCodes,
+		Replace:   replace,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("分配用户角色失败: user_code=%s, replace=%v, error=%v", userCode, replace, err)
+		return nil, err
+	}
+
+	return resp.Roles, nil
+}
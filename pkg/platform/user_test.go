@@ -0,0 +1,59 @@
+package platform
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/platform/v1"
+	"google.golang.org/grpc"
+)
+
+// mockUserClient 记录用户和角色请求
+type mockUserClient struct {
+	v1.PlatformIamServiceClient
+	createReq *v1.CreateRoleRequest
+	assignReq *v1.AssignUserRolesRequest
+}
+
+func (m *mockUserClient) CreateRole(ctx context.Context, in *v1.CreateRoleRequest, opts ...grpc.CallOption) (*v1.CreateRoleResponse, error) {
+	m.createReq = in
+	return &v1.CreateRoleResponse{Role: &v1.RoleInfo{RoleCode: "R001", Name: in.Name, PermissionCodes: in.PermissionCodes}}, nil
+}
+
+func (m *mockUserClient) AssignUserRoles(ctx context.Context, in *v1.AssignUserRolesRequest, opts ...grpc.CallOption) (*v1.AssignUserRolesResponse, error) {
+	m.assignReq = in
+	roles := make([]*v1.RoleInfo, len(in.RoleCodes))
+	for i, code := range in.RoleCodes {
+		roles[i] = &v1.RoleInfo{RoleCode: code}
+	}
+	return &v1.AssignUserRolesResponse{Roles: roles}, nil
+}
+
+func TestCreateRoleAndAssign(t *testing.T) {
+	mock := &mockUserClient{}
+	client := newTestIAMClient(mock)
+	ctx := context.Background()
+
+	role, err := client.CreateRole(ctx, &CreateRoleOptions{
+		Name:            "店长",
+		TenantCode:      "T001",
+		PermissionCodes: []string{"mall:goods"},
+	})
+	if err != nil || role.RoleCode != "R001" {
+		t.Fatalf("CreateRole = %v, %v", role, err)
+	}
+	if mock.createReq.GetTenantCode() != "T001" || mock.createReq.RoleCode != nil || mock.createReq.Description != nil {
+		t.Errorf("unexpected request: %+v", mock.createReq)
+	}
+	if _, err := client.CreateRole(ctx, &CreateRoleOptions{}); err == nil {
+		t.Error("expected error for empty role name")
+	}
+
+	roles, err := client.AssignUserRoles(ctx, "U001", []string{"R001", "R002"}, true)
+	if err != nil || len(roles) != 2 || !mock.assignReq.Replace {
+		t.Errorf("AssignUserRoles = %v, %v, req=%+v", roles, err, mock.assignReq)
+	}
+	if _, err := client.AssignUserRoles(ctx, "", nil, false); err == nil {
+		t.Error("expected error for empty user code")
+	}
+}
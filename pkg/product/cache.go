@@ -0,0 +1,169 @@
+package product
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultProductCacheTTL 产品/套餐缓存默认有效期
+const DefaultProductCacheTTL = 5 * time.Minute
+
+// productCacheEntry 缓存条目
+type productCacheEntry struct {
+	value    proto.Message
+	expireAt time.Time
+}
+
+// CachedProductClient 带缓存的产品客户端
+//
+// GetProduct/GetPlan 按编码和选项缓存 ttl 时间，其余方法直接调用内部客户端。
+// 产品或套餐变更时调用 Invalidate 失效缓存（如在产品变更事件的消费者中调用）
+//
+// 使用示例:
+//
+//	products := product.NewCachedProductClient(client.ProductClient(), 10*time.Minute)
+//	plan, err := products.GetPlan(ctx, planCode, nil)
+//
+//	// 收到套餐变更事件时
+//	products.Invalidate(event.PlanCode)
+type CachedProductClient struct {
+	ProductAPI
+
+	ttl      time.Duration
+	mu       sync.RWMutex
+	products map[string]*productCacheEntry
+	plans    map[string]*productCacheEntry
+	now      func() time.Time
+}
+
+// 确保 CachedProductClient 实现了 ProductAPI 接口
+var _ ProductAPI = (*CachedProductClient)(nil)
+
+// NewCachedProductClient 创建带缓存的产品客户端
+//
+// 参数:
+//   - inner: 产品客户端，通常为 Client.ProductClient()，测试中可传入 producttest.FakeClient
+//   - ttl: 缓存有效期，<=0 时使用 DefaultProductCacheTTL
+func NewCachedProductClient(inner ProductAPI, ttl time.Duration) *CachedProductClient {
+	if ttl <= 0 {
+		ttl = DefaultProductCacheTTL
+	}
+	return &CachedProductClient{
+		ProductAPI: inner,
+		ttl:        ttl,
+		products:   make(map[string]*productCacheEntry),
+		plans:      make(map[string]*productCacheEntry),
+		now:        time.Now,
+	}
+}
+
+// GetProduct 获取产品信息，优先读取缓存
+func (c *CachedProductClient) GetProduct(ctx context.Context, productCode string, opt *GetProductOption, callOpts ...CallOption) (*v1.InternalProductInfo, error) {
+	var includePlans *bool
+	if opt != nil {
+		includePlans = opt.IncludePlans
+	}
+	key := productCacheKey(productCode, includePlans)
+
+	if cached, ok := c.get(c.products, key); ok {
+		return cached.(*v1.InternalProductInfo), nil
+	}
+
+	product, err := c.ProductAPI.GetProduct(ctx, productCode, opt, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if product != nil {
+		c.set(c.products, key, product)
+	}
+	return product, nil
+}
+
+// GetPlan 获取套餐信息，优先读取缓存
+func (c *CachedProductClient) GetPlan(ctx context.Context, planCode string, opt *GetPlanOption, callOpts ...CallOption) (*v1.InternalProductPlanInfo, error) {
+	var includeParameters *bool
+	if opt != nil {
+		includeParameters = opt.IncludeParameters
+	}
+	key := productCacheKey(planCode, includeParameters)
+
+	if cached, ok := c.get(c.plans, key); ok {
+		return cached.(*v1.InternalProductPlanInfo), nil
+	}
+
+	plan, err := c.ProductAPI.GetPlan(ctx, planCode, opt, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+	if plan != nil {
+		c.set(c.plans, key, plan)
+	}
+	return plan, nil
+}
+
+// Invalidate 失效指定产品编码或套餐编码的缓存
+//
+// 套餐已缓存时同时失效其所属产品的缓存（产品信息如 PriceMonthly 由套餐汇总得出）。
+// 套餐未缓存时无法得知所属产品，套餐变更事件的消费者应同时以产品编码调用 Invalidate
+func (c *CachedProductClient) Invalidate(code string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	codes := []string{code}
+	for _, flag := range []string{"", "true", "false"} {
+		if entry, ok := c.plans[code+"|"+flag]; ok {
+			if productCode := entry.value.(*v1.InternalProductPlanInfo).GetProductCode(); productCode != "" {
+				codes = append(codes, productCode)
+			}
+		}
+	}
+
+	for _, code := range codes {
+		for _, flag := range []string{"", "true", "false"} {
+			delete(c.products, code+"|"+flag)
+			delete(c.plans, code+"|"+flag)
+		}
+	}
+}
+
+// InvalidateAll 清空全部缓存
+func (c *CachedProductClient) InvalidateAll() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.products)
+	clear(c.plans)
+}
+
+// get 读取未过期的缓存，返回副本避免调用方修改缓存内容
+func (c *CachedProductClient) get(entries map[string]*productCacheEntry, key string) (proto.Message, bool) {
+	c.mu.RLock()
+	entry, ok := entries[key]
+	c.mu.RUnlock()
+	if !ok || !c.now().Before(entry.expireAt) {
+		return nil, false
+	}
+	return proto.Clone(entry.value), true
+}
+
+// set 写入缓存
+func (c *CachedProductClient) set(entries map[string]*productCacheEntry, key string, value proto.Message) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entries[key] = &productCacheEntry{
+		value:    proto.Clone(value),
+		expireAt: c.now().Add(c.ttl),
+	}
+}
+
+// productCacheKey 生成缓存key，包含选项以区分是否返回套餐/规则
+func productCacheKey(code string, include *bool) string {
+	if include == nil {
+		return code + "|"
+	}
+	return code + "|" + strconv.FormatBool(*include)
+}
@@ -0,0 +1,100 @@
+package product
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+	"google.golang.org/grpc"
+)
+
+// mockProductClient 统计调用次数
+type mockProductClient struct {
+	v1.ProductInternalServiceClient
+	planCalls    int
+	productCalls int
+}
+
+func (m *mockProductClient) InternalGetPlan(ctx context.Context, in *v1.InternalGetPlanRequest, opts ...grpc.CallOption) (*v1.InternalGetPlanResponse, error) {
+	m.planCalls++
+	return &v1.InternalGetPlanResponse{Plan: &v1.InternalProductPlanInfo{PlanCode: in.PlanCode, ProductCode: "mall", PriceMonthly: 100}}, nil
+}
+
+func (m *mockProductClient) InternalGetProduct(ctx context.Context, in *v1.InternalGetProductRequest, opts ...grpc.CallOption) (*v1.InternalGetProductResponse, error) {
+	m.productCalls++
+	return &v1.InternalGetProductResponse{Product: &v1.InternalProductInfo{ProductCode: in.ProductCode}}, nil
+}
+
+func newTestProductClient(client v1.ProductInternalServiceClient) *ProductClient {
+	return &ProductClient{
+		client: client,
+		logger: log.NewHelper(log.DefaultLogger),
+		config: DefaultConfig(),
+	}
+}
+
+func TestCachedProductClient(t *testing.T) {
+	mock := &mockProductClient{}
+	cached := NewCachedProductClient(newTestProductClient(mock), time.Minute)
+	now := time.Now()
+	cached.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		plan, err := cached.GetPlan(ctx, "pro", nil)
+		if err != nil {
+			t.Fatalf("GetPlan failed: %v", err)
+		}
+		// 修改返回值不影响缓存
+		plan.PriceMonthly = 0
+	}
+	if mock.planCalls != 1 {
+		t.Errorf("expected 1 plan call, got %d", mock.planCalls)
+	}
+	plan, _ := cached.GetPlan(ctx, "pro", nil)
+	if plan.PriceMonthly != 100 {
+		t.Errorf("cached plan was mutated: %d", plan.PriceMonthly)
+	}
+
+	// 不同选项分别缓存
+	include := true
+	if _, err := cached.GetPlan(ctx, "pro", &GetPlanOption{IncludeParameters: &include}); err != nil {
+		t.Fatalf("GetPlan failed: %v", err)
+	}
+	if mock.planCalls != 2 {
+		t.Errorf("expected 2 plan calls, got %d", mock.planCalls)
+	}
+
+	cached.Invalidate("pro")
+	if _, err := cached.GetPlan(ctx, "pro", nil); err != nil {
+		t.Fatalf("GetPlan failed: %v", err)
+	}
+	if mock.planCalls != 3 {
+		t.Errorf("expected refetch after Invalidate, got %d calls", mock.planCalls)
+	}
+
+	if _, err := cached.GetProduct(ctx, "mall", nil); err != nil {
+		t.Fatalf("GetProduct failed: %v", err)
+	}
+	now = now.Add(2 * time.Minute)
+	if _, err := cached.GetProduct(ctx, "mall", nil); err != nil {
+		t.Fatalf("GetProduct failed: %v", err)
+	}
+	if mock.productCalls != 2 {
+		t.Errorf("expected refetch after expiry, got %d calls", mock.productCalls)
+	}
+
+	// 失效套餐时同时失效所属产品
+	if _, err := cached.GetProduct(ctx, "mall", &GetProductOption{IncludePlans: &include}); err != nil {
+		t.Fatalf("GetProduct failed: %v", err)
+	}
+	cached.Invalidate("pro")
+	if _, err := cached.GetProduct(ctx, "mall", &GetProductOption{IncludePlans: &include}); err != nil {
+		t.Fatalf("GetProduct failed: %v", err)
+	}
+	if mock.productCalls != 4 {
+		t.Errorf("expected product refetch after plan Invalidate, got %d calls", mock.productCalls)
+	}
+}
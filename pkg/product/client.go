@@ -18,6 +18,11 @@ type Client struct {
 	productClient *ProductClient
 }
 
+// NewClient 创建产品服务客户端（直连方式）
+//
+// 连接通过 middleware.CreateGRPCConn 创建，与其他服务客户端一致：
+// 自动转发认证信息（ForwardClaims），并按 Config 启用超时、重试和熔断，
+// 单次调用可通过 WithTimeout/WithRetry/WithHeader 覆盖
 func NewClient(config *Config) (*Client, error) {
 	if config == nil {
 		config = DefaultConfig()
@@ -42,6 +47,7 @@ func NewClient(config *Config) (*Client, error) {
 	}, nil
 }
 
+// NewClientWithDiscovery 创建带服务发现的产品服务客户端
 func NewClientWithDiscovery(config *Config, discovery registry.Discovery) (*Client, error) {
 	if config == nil {
 		config = DefaultConfig()
@@ -75,6 +81,24 @@ func NewClientWithDiscovery(config *Config, discovery registry.Discovery) (*Clie
 	}, nil
 }
 
+// NewClientWithConn 基于已建立的连接创建产品服务客户端
+//
+// 用于多个客户端共享连接（如 clients.Factory），连接由调用方管理，Close 不会关闭该连接
+func NewClientWithConn(config *Config, conn grpc.ClientConnInterface) *Client {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	logger := log.NewHelper(log.With(
+		log.GetLogger(),
+		"module", "product-client",
+	))
+	return &Client{
+		config:        config,
+		logger:        logger,
+		productClient: newProductClient(conn, logger, config),
+	}
+}
+
 func (c *Client) Close() error {
 	if c.conn != nil {
 		return c.conn.Close()
@@ -92,7 +116,7 @@ type ProductClient struct {
 	config *Config
 }
 
-func newProductClient(conn *grpc.ClientConn, logger *log.Helper, config *Config) *ProductClient {
+func newProductClient(conn grpc.ClientConnInterface, logger *log.Helper, config *Config) *ProductClient {
 	return &ProductClient{
 		client: v1.NewProductInternalServiceClient(conn),
 		logger: logger,
@@ -105,7 +129,7 @@ type GetPlanOption struct {
 }
 
 // GetPlan 获取套餐信息
-func (c *ProductClient) GetPlan(ctx context.Context, planCode string, opt *GetPlanOption) (*v1.InternalProductPlanInfo, error) {
+func (c *ProductClient) GetPlan(ctx context.Context, planCode string, opt *GetPlanOption, callOpts ...CallOption) (*v1.InternalProductPlanInfo, error) {
 	req := &v1.InternalGetPlanRequest{
 		PlanCode:          planCode,
 		IncludeParameters: nil,
@@ -116,8 +140,7 @@ func (c *ProductClient) GetPlan(ctx context.Context, planCode string, opt *GetPl
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
 
 	resp, err := c.client.InternalGetPlan(ctx, req)
 	if err != nil {
@@ -133,7 +156,7 @@ type MerchantGetPlanOption struct {
 }
 
 // MerchantGetPlan 商户获取套餐详情
-func (c *ProductClient) MerchantGetPlan(ctx context.Context, planCode string, opt *MerchantGetPlanOption) (*v1.InternalProductPlanInfo, error) {
+func (c *ProductClient) MerchantGetPlan(ctx context.Context, planCode string, opt *MerchantGetPlanOption, callOpts ...CallOption) (*v1.InternalProductPlanInfo, error) {
 	req := &v1.InternalMerchantGetPlanRequest{
 		PlanCode:          planCode,
 		IncludeParameters: nil,
@@ -144,8 +167,7 @@ func (c *ProductClient) MerchantGetPlan(ctx context.Context, planCode string, op
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
 
 	resp, err := c.client.InternalMerchantGetPlan(ctx, req)
 	if err != nil {
@@ -161,7 +183,7 @@ type GetProductOption struct {
 }
 
 // GetProduct 获取产品信息
-func (c *ProductClient) GetProduct(ctx context.Context, productCode string, opt *GetProductOption) (*v1.InternalProductInfo, error) {
+func (c *ProductClient) GetProduct(ctx context.Context, productCode string, opt *GetProductOption, callOpts ...CallOption) (*v1.InternalProductInfo, error) {
 	req := &v1.InternalGetProductRequest{
 		ProductCode:  productCode,
 		IncludePlans: nil,
@@ -172,8 +194,7 @@ func (c *ProductClient) GetProduct(ctx context.Context, productCode string, opt
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
 
 	resp, err := c.client.InternalGetProduct(ctx, req)
 	if err != nil {
@@ -189,7 +210,7 @@ type GetMerchantGetProduct struct {
 }
 
 // MerchantGetProduct 商户获取产品
-func (c *ProductClient) MerchantGetProduct(ctx context.Context, productCode string, opt *GetMerchantGetProduct) (*v1.InternalProductInfo, error) {
+func (c *ProductClient) MerchantGetProduct(ctx context.Context, productCode string, opt *GetMerchantGetProduct, callOpts ...CallOption) (*v1.InternalProductInfo, error) {
 	req := &v1.InternalMerchantGetProductRequest{
 		ProductCode:  productCode,
 		IncludePlans: nil,
@@ -200,8 +221,7 @@ func (c *ProductClient) MerchantGetProduct(ctx context.Context, productCode stri
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
 
 	resp, err := c.client.InternalMerchantGetProduct(ctx, req)
 	if err != nil {
@@ -212,6 +232,38 @@ func (c *ProductClient) MerchantGetProduct(ctx context.Context, productCode stri
 	return resp.Product, nil
 }
 
+type MerchantListProductsOption struct {
+	Locale       *string // 语言，如 zh-CN、en-US
+	IncludePlans *bool   // 是否包含套餐列表
+	CategoryID   *uint32 // 分类ID筛选
+	Page         *int32  // 页码
+	PageSize     *int32  // 每页数量
+}
+
+// MerchantListProducts 商户获取产品目录
+//
+// 与 MerchantGetProduct 一致，仅返回已上架且对商户可见的产品，名称和描述按 Locale 本地化
+func (c *ProductClient) MerchantListProducts(ctx context.Context, opt *MerchantListProductsOption, callOpts ...CallOption) (*v1.InternalMerchantListProductsResponse, error) {
+	req := &v1.InternalMerchantListProductsRequest{}
+	if opt != nil {
+		req.Locale = opt.Locale
+		req.IncludePlans = opt.IncludePlans
+		req.CategoryId = opt.CategoryID
+		req.Page = opt.Page
+		req.PageSize = opt.PageSize
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	resp, err := c.client.InternalMerchantListProducts(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("商户获取产品目录失败:locale=%s,error=%v", req.GetLocale(), err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 type ListPricingRulesOption struct {
 	Page      *int32                 // 页码
 	PageSize  *int32                 // 每页数量
@@ -222,7 +274,9 @@ type ListPricingRulesOption struct {
 }
 
 // 获取定价规则列表
-func (c *ProductClient) ListPricingRules(ctx context.Context, opt *ListPricingRulesOption) (*v1.InternalListPricingRulesResponse, error) {
+//
+// 定价规则是套餐的配额和功能规则（开关、数字、用量型），不包含折扣、优惠券或地区价格
+func (c *ProductClient) ListPricingRules(ctx context.Context, opt *ListPricingRulesOption, callOpts ...CallOption) (*v1.InternalListPricingRulesResponse, error) {
 	req := &v1.InternalListPricingRulesRequest{
 		Page:      nil,
 		PageSize:  nil,
@@ -253,8 +307,7 @@ func (c *ProductClient) ListPricingRules(ctx context.Context, opt *ListPricingRu
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
 
 	resp, err := c.client.InternalListPricingRules(ctx, req)
 	if err != nil {
@@ -264,3 +317,65 @@ func (c *ProductClient) ListPricingRules(ctx context.Context, opt *ListPricingRu
 
 	return resp, nil
 }
+
+type ListProductsOption struct {
+	Page       *int32                    // 页码
+	PageSize   *int32                    // 每页数量
+	Status     *v1.InternalProductStatus // 状态筛选
+	CategoryID *uint32                   // 分类ID筛选
+	Search     *string                   // 关键词搜索
+}
+
+// ListProducts 获取产品列表
+func (c *ProductClient) ListProducts(ctx context.Context, opt *ListProductsOption, callOpts ...CallOption) (*v1.InternalListProductsResponse, error) {
+	req := &v1.InternalListProductsRequest{}
+	if opt != nil {
+		req.Page = opt.Page
+		req.PageSize = opt.PageSize
+		req.Status = opt.Status
+		req.CategoryId = opt.CategoryID
+		req.Search = opt.Search
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	resp, err := c.client.InternalListProducts(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取产品列表失败:error=%v", err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+type ListPlansOption struct {
+	Page              *int32                 // 页码
+	PageSize          *int32                 // 每页数量
+	Status            *v1.InternalPlanStatus // 状态筛选
+	IncludeParameters *bool                  // 是否包含规则
+	IfNoneMatch       *string                // 已知的套餐版本，未变化时返回 NotModified
+}
+
+// ListPlans 获取产品的套餐列表
+func (c *ProductClient) ListPlans(ctx context.Context, productCode string, opt *ListPlansOption, callOpts ...CallOption) (*v1.InternalListPlansResponse, error) {
+	req := &v1.InternalListPlansRequest{
+		ProductCode: productCode,
+	}
+	if opt != nil {
+		req.Page = opt.Page
+		req.PageSize = opt.PageSize
+		req.Status = opt.Status
+		req.IncludeParameters = opt.IncludeParameters
+		req.IfNoneMatch = opt.IfNoneMatch
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	resp, err := c.client.InternalListPlans(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取套餐列表失败:product_code=%s,error=%v", productCode, err)
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,128 @@
+package product
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// PlanMatrix 套餐对比矩阵，行为规则维度，列为套餐
+type PlanMatrix struct {
+	ProductCode string                        // 产品编码
+	Plans       []*v1.InternalProductPlanInfo // 列：按排序值排列的套餐
+	Rows        []*PlanMatrixRow              // 行：规则维度
+}
+
+// PlanMatrixRow 对比矩阵的一行（一个规则维度）
+type PlanMatrixRow struct {
+	RuleKey   string               // 规则键名
+	RuleType  string               // 规则类型
+	ValueType v1.InternalValueType // 值类型
+	Unit      string               // 单位
+	I18n      *structpb.Struct     // 规则多语言内容
+	Cells     []PlanMatrixCell     // 各套餐的取值，与 PlanMatrix.Plans 一一对应
+}
+
+// PlanMatrixCell 某个套餐在某个维度上的取值
+type PlanMatrixCell struct {
+	Present     bool   // 套餐是否配置了该维度，未配置时通常展示为不支持
+	Value       string // 规则值
+	IsUnlimited bool   // 是否无限制
+}
+
+// ComparePlans 获取产品套餐对比矩阵
+//
+// 拉取产品全部上架套餐（含规则配置）并归一化为 维度 × 套餐 的矩阵，
+// 价格页和升级弹窗可直接按行渲染
+//
+// 参数:
+//   - ctx: 上下文
+//   - productCode: 产品编码
+//
+// 返回:
+//   - *PlanMatrix: 对比矩阵
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	matrix, err := client.ComparePlans(ctx, "mall")
+//	for _, row := range matrix.Rows {
+//	    for i, cell := range row.Cells {
+//	        render(row.RuleKey, matrix.Plans[i].PlanCode, cell)
+//	    }
+//	}
+func (c *ProductClient) ComparePlans(ctx context.Context, productCode string, callOpts ...CallOption) (*PlanMatrix, error) {
+	if productCode == "" {
+		return nil, fmt.Errorf("产品编码不能为空")
+	}
+
+	status := v1.InternalPlanStatus_INTERNAL_PLAN_STATUS_ACTIVE
+	plans, _, _, err := listAllPlans(ctx, func(ctx context.Context, productCode string, opt *ListPlansOption) (*v1.InternalListPlansResponse, error) {
+		return c.ListPlans(ctx, productCode, opt, callOpts...)
+	}, productCode, &status, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildPlanMatrix(productCode, plans), nil
+}
+
+// BuildPlanMatrix 根据套餐列表构建对比矩阵
+//
+// 套餐按排序值（相同时按编码）排列；维度按在套餐中首次出现的顺序排列
+func BuildPlanMatrix(productCode string, plans []*v1.InternalProductPlanInfo) *PlanMatrix {
+	plans = slices.Clone(plans)
+	slices.SortStableFunc(plans, func(a, b *v1.InternalProductPlanInfo) int {
+		return cmp.Or(
+			cmp.Compare(a.GetSortOrder(), b.GetSortOrder()),
+			cmp.Compare(a.GetPlanCode(), b.GetPlanCode()),
+		)
+	})
+
+	matrix := &PlanMatrix{ProductCode: productCode, Plans: plans}
+	rows := make(map[string]*PlanMatrixRow)
+	for i, plan := range plans {
+		for _, param := range plan.GetParameters() {
+			row, ok := rows[param.GetRuleKey()]
+			if !ok {
+				row = &PlanMatrixRow{
+					RuleKey:   param.GetRuleKey(),
+					RuleType:  param.GetRuleType(),
+					ValueType: param.GetValueType(),
+					Unit:      param.GetUnit(),
+					I18n:      param.GetRuleI18N(),
+					Cells:     make([]PlanMatrixCell, len(plans)),
+				}
+				rows[param.GetRuleKey()] = row
+				matrix.Rows = append(matrix.Rows, row)
+			}
+			row.Cells[i] = PlanMatrixCell{
+				Present:     true,
+				Value:       param.GetRuleValue(),
+				IsUnlimited: param.GetIsUnlimited(),
+			}
+		}
+	}
+
+	return matrix
+}
+
+// Cell 获取指定维度和套餐的取值
+func (m *PlanMatrix) Cell(ruleKey string, planCode string) (PlanMatrixCell, bool) {
+	col := slices.IndexFunc(m.Plans, func(plan *v1.InternalProductPlanInfo) bool {
+		return plan.GetPlanCode() == planCode
+	})
+	if col < 0 {
+		return PlanMatrixCell{}, false
+	}
+	for _, row := range m.Rows {
+		if row.RuleKey == ruleKey {
+			return row.Cells[col], true
+		}
+	}
+	return PlanMatrixCell{}, false
+}
@@ -0,0 +1,70 @@
+package product
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+	"google.golang.org/grpc"
+)
+
+// mockCompareClient 返回带规则配置的套餐列表
+type mockCompareClient struct {
+	v1.ProductInternalServiceClient
+	req *v1.InternalListPlansRequest
+}
+
+func (m *mockCompareClient) InternalListPlans(ctx context.Context, in *v1.InternalListPlansRequest, opts ...grpc.CallOption) (*v1.InternalListPlansResponse, error) {
+	m.req = in
+	return &v1.InternalListPlansResponse{
+		Total: 2,
+		Plans: []*v1.InternalProductPlanInfo{
+			{
+				PlanCode: "pro", SortOrder: 2,
+				Parameters: []*v1.InternalPlanParameter{
+					{RuleKey: "goods_count", IsUnlimited: true},
+					{RuleKey: "custom_domain", RuleValue: "true"},
+				},
+			},
+			{
+				PlanCode: "basic", SortOrder: 1,
+				Parameters: []*v1.InternalPlanParameter{
+					{RuleKey: "goods_count", RuleValue: "100"},
+				},
+			},
+		},
+	}, nil
+}
+
+func TestComparePlans(t *testing.T) {
+	mock := &mockCompareClient{}
+	client := newTestProductClient(mock)
+
+	matrix, err := client.ComparePlans(context.Background(), "mall")
+	if err != nil {
+		t.Fatalf("ComparePlans failed: %v", err)
+	}
+	if mock.req.GetStatus() != v1.InternalPlanStatus_INTERNAL_PLAN_STATUS_ACTIVE || !mock.req.GetIncludeParameters() {
+		t.Errorf("unexpected request: %+v", mock.req)
+	}
+
+	if len(matrix.Plans) != 2 || matrix.Plans[0].PlanCode != "basic" {
+		t.Fatalf("unexpected columns: %v", matrix.Plans)
+	}
+	if len(matrix.Rows) != 2 || matrix.Rows[0].RuleKey != "goods_count" {
+		t.Fatalf("unexpected rows: %v", matrix.Rows)
+	}
+
+	if cell, ok := matrix.Cell("goods_count", "basic"); !ok || cell.Value != "100" {
+		t.Errorf("unexpected basic goods_count: %+v", cell)
+	}
+	if cell, _ := matrix.Cell("goods_count", "pro"); !cell.IsUnlimited {
+		t.Errorf("expected pro goods_count unlimited: %+v", cell)
+	}
+	if cell, ok := matrix.Cell("custom_domain", "basic"); !ok || cell.Present {
+		t.Errorf("expected basic custom_domain absent: %+v", cell)
+	}
+	if _, ok := matrix.Cell("custom_domain", "missing"); ok {
+		t.Error("expected missing plan")
+	}
+}
@@ -18,6 +18,12 @@ type Config = common.ServiceConfig
 //   - Endpoint: "discovery:///product-server"
 //   - ServiceName: "product-server"
 //   - Timeout: 10s
+//
+// 重试和熔断默认关闭，可按需开启:
+//
+//	config := product.DefaultConfig().
+//	    WithRetry(3, 100*time.Millisecond).
+//	    WithCircuitBreaker(5, 30*time.Second)
 func DefaultConfig() *Config {
 	return common.NewServiceConfig(DefaultServiceName)
 }
@@ -0,0 +1,49 @@
+package product
+
+import (
+	"context"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+)
+
+// ProductAPI 产品服务客户端接口
+//
+// ProductClient 和 CachedProductClient 实现了该接口，业务代码依赖该接口即可在单元测试中
+// 使用 producttest.NewFakeClient 替换真实客户端
+//
+// 使用示例:
+//
+//	type OrderService struct {
+//	    products product.ProductAPI
+//	}
+//
+//	// 生产环境
+//	svc := &OrderService{products: client.ProductClient()}
+//
+//	// 单元测试
+//	svc := &OrderService{products: producttest.NewFakeClient(fixtures)}
+type ProductAPI interface {
+	// ========== 产品相关接口 ==========
+
+	GetProduct(ctx context.Context, productCode string, opt *GetProductOption, callOpts ...CallOption) (*v1.InternalProductInfo, error)
+	MerchantGetProduct(ctx context.Context, productCode string, opt *GetMerchantGetProduct, callOpts ...CallOption) (*v1.InternalProductInfo, error)
+	ListProducts(ctx context.Context, opt *ListProductsOption, callOpts ...CallOption) (*v1.InternalListProductsResponse, error)
+	MerchantListProducts(ctx context.Context, opt *MerchantListProductsOption, callOpts ...CallOption) (*v1.InternalMerchantListProductsResponse, error)
+
+	// ========== 套餐相关接口 ==========
+
+	GetPlan(ctx context.Context, planCode string, opt *GetPlanOption, callOpts ...CallOption) (*v1.InternalProductPlanInfo, error)
+	MerchantGetPlan(ctx context.Context, planCode string, opt *MerchantGetPlanOption, callOpts ...CallOption) (*v1.InternalProductPlanInfo, error)
+	ListPlans(ctx context.Context, productCode string, opt *ListPlansOption, callOpts ...CallOption) (*v1.InternalListPlansResponse, error)
+	WatchPlans(ctx context.Context, productCode string, onChange PlanChangeFunc, opt *WatchPlansOption) error
+	CalculatePrice(ctx context.Context, planCode string, input CalcInput, callOpts ...CallOption) (*PriceResult, error)
+	ComparePlans(ctx context.Context, productCode string, callOpts ...CallOption) (*PlanMatrix, error)
+
+	// ========== 定价规则接口 ==========
+
+	ListPricingRules(ctx context.Context, opt *ListPricingRulesOption, callOpts ...CallOption) (*v1.InternalListPricingRulesResponse, error)
+	GetPricingRule(ctx context.Context, ruleKey string, callOpts ...CallOption) (*v1.InternalPricingRuleInfo, error)
+}
+
+// 确保 ProductClient 实现了 ProductAPI 接口
+var _ ProductAPI = (*ProductClient)(nil)
@@ -0,0 +1,38 @@
+package product
+
+import (
+	"time"
+
+	middleware "github.com/heyinLab/common/pkg/middleware/grpc"
+)
+
+// CallOption 单次调用选项
+//
+// 用于覆盖客户端配置中的超时、重试等参数，仅对当前调用生效
+//
+// 使用示例:
+//
+//	// 下单路径上使用更短的超时并对临时性错误重试
+//	plan, err := client.GetPlan(ctx, planCode, nil,
+//	    product.WithTimeout(500*time.Millisecond),
+//	    product.WithRetry(2, 50*time.Millisecond))
+type CallOption = middleware.CallOption
+
+// WithTimeout 设置单次调用的超时时间，包含全部重试和退避
+func WithTimeout(timeout time.Duration) CallOption {
+	return middleware.CallTimeout(timeout)
+}
+
+// WithRetry 设置单次调用的重试策略，覆盖 Config.Retry
+//
+// 参数:
+//   - maxRetries: 最大重试次数（不含首次调用），0 表示不重试
+//   - backoff: 首次重试前的退避时间，之后按指数增长
+func WithRetry(maxRetries int, backoff time.Duration) CallOption {
+	return middleware.CallRetry(maxRetries, backoff)
+}
+
+// WithHeader 为单次调用附加 gRPC metadata
+func WithHeader(key, value string) CallOption {
+	return middleware.CallHeader(key, value)
+}
@@ -0,0 +1,184 @@
+package product
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+)
+
+// ParamTag 套餐参数解码使用的结构体标签名
+const ParamTag = "param"
+
+// ErrParamNotFound 套餐中没有该参数
+var ErrParamNotFound = errors.New("套餐参数不存在")
+
+// ParamValue GetParam 支持的参数类型
+type ParamValue interface {
+	~string | ~bool |
+		~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// GetParam 读取套餐参数并转换为指定类型
+//
+// 无限制（IsUnlimited）的参数对有符号整数和浮点数返回 -1
+//
+// 使用示例:
+//
+//	limit, err := product.GetParam[int](plan, "goods_count")
+//	if errors.Is(err, product.ErrParamNotFound) {
+//	    limit = 0
+//	}
+func GetParam[T ParamValue](plan *v1.InternalProductPlanInfo, key string) (T, error) {
+	var value T
+	param := findParameter(plan, key)
+	if param == nil {
+		return value, fmt.Errorf("%w: %s", ErrParamNotFound, key)
+	}
+	err := setParamValue(reflect.ValueOf(&value).Elem(), param)
+	return value, err
+}
+
+// GetParamOr 读取套餐参数，参数不存在或转换失败时返回默认值
+func GetParamOr[T ParamValue](plan *v1.InternalProductPlanInfo, key string, def T) T {
+	value, err := GetParam[T](plan, key)
+	if err != nil {
+		return def
+	}
+	return value
+}
+
+// DecodeParameters 将套餐参数解码到结构体
+//
+// 字段通过 `param:"规则键名,default=默认值"` 标签映射参数，未设置标签的字段忽略；
+// 参数不存在时使用 default，未设置 default 时保持字段原值。
+// 无限制（IsUnlimited）的参数对有符号整数和浮点数字段写入 -1
+//
+// 参数:
+//   - plan: 套餐信息（需包含规则配置，即 IncludeParameters=true）
+//   - dst: 结构体指针
+//
+// 使用示例:
+//
+//	type MallLimits struct {
+//	    GoodsCount   int     `param:"goods_count,default=100"`
+//	    CustomDomain bool    `param:"custom_domain"`
+//	    Commission   float64 `param:"commission_rate,default=0.05"`
+//	}
+//
+//	var limits MallLimits
+//	if err := product.DecodeParameters(plan, &limits); err != nil {
+//	    return err
+//	}
+func DecodeParameters(plan *v1.InternalProductPlanInfo, dst any) error {
+	rv := reflect.ValueOf(dst)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("dst 必须是结构体指针")
+	}
+
+	rv = rv.Elem()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag, ok := field.Tag.Lookup(ParamTag)
+		if !ok || tag == "-" || !field.IsExported() {
+			continue
+		}
+
+		key, def, hasDefault := parseParamTag(tag)
+		if key == "" {
+			key = field.Name
+		}
+
+		if param := findParameter(plan, key); param != nil {
+			if err := setParamValue(rv.Field(i), param); err != nil {
+				return fmt.Errorf("解码套餐参数失败: field=%s, %w", field.Name, err)
+			}
+			continue
+		}
+		if hasDefault {
+			if err := setStringValue(rv.Field(i), def); err != nil {
+				return fmt.Errorf("解码套餐参数默认值失败: field=%s, %w", field.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// parseParamTag 解析标签，格式为 "key,default=value"
+func parseParamTag(tag string) (key string, def string, hasDefault bool) {
+	key, opts, _ := strings.Cut(tag, ",")
+	if value, ok := strings.CutPrefix(opts, "default="); ok {
+		return key, value, true
+	}
+	return key, "", false
+}
+
+// findParameter 按规则键名查找参数
+func findParameter(plan *v1.InternalProductPlanInfo, key string) *v1.InternalPlanParameter {
+	for _, param := range plan.GetParameters() {
+		if param.GetRuleKey() == key {
+			return param
+		}
+	}
+	return nil
+}
+
+// setParamValue 将参数值写入字段
+func setParamValue(field reflect.Value, param *v1.InternalPlanParameter) error {
+	if param.GetIsUnlimited() {
+		switch field.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			field.SetInt(-1)
+			return nil
+		case reflect.Float32, reflect.Float64:
+			field.SetFloat(-1)
+			return nil
+		}
+	}
+	if err := setStringValue(field, param.GetRuleValue()); err != nil {
+		return fmt.Errorf("key=%s, %w", param.GetRuleKey(), err)
+	}
+	return nil
+}
+
+// setStringValue 将字符串转换为字段类型并写入
+func setStringValue(field reflect.Value, raw string) error {
+	raw = strings.TrimSpace(raw)
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		v, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("无法转换为布尔值: %q", raw)
+		}
+		field.SetBool(v)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v, err := strconv.ParseInt(raw, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("无法转换为整数: %q", raw)
+		}
+		field.SetInt(v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v, err := strconv.ParseUint(raw, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("无法转换为无符号整数: %q", raw)
+		}
+		field.SetUint(v)
+	case reflect.Float32, reflect.Float64:
+		v, err := strconv.ParseFloat(raw, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("无法转换为小数: %q", raw)
+		}
+		field.SetFloat(v)
+	default:
+		return fmt.Errorf("不支持的字段类型: %s", field.Type())
+	}
+	return nil
+}
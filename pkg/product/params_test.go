@@ -0,0 +1,65 @@
+package product
+
+import (
+	"errors"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+)
+
+func testPlan() *v1.InternalProductPlanInfo {
+	return &v1.InternalProductPlanInfo{
+		PlanCode: "pro",
+		Parameters: []*v1.InternalPlanParameter{
+			{RuleKey: "goods_count", RuleValue: "500", ValueType: v1.InternalValueType_INTERNAL_VALUE_TYPE_NUMBER},
+			{RuleKey: "custom_domain", RuleValue: "true", ValueType: v1.InternalValueType_INTERNAL_VALUE_TYPE_BOOLEAN},
+			{RuleKey: "commission_rate", RuleValue: "0.03", ValueType: v1.InternalValueType_INTERNAL_VALUE_TYPE_DECIMAL},
+			{RuleKey: "staff_count", IsUnlimited: true},
+			{RuleKey: "theme", RuleValue: " dark "},
+		},
+	}
+}
+
+func TestDecodeParameters(t *testing.T) {
+	var limits struct {
+		GoodsCount   int     `param:"goods_count,default=100"`
+		CustomDomain bool    `param:"custom_domain"`
+		Commission   float64 `param:"commission_rate"`
+		StaffCount   int32   `param:"staff_count"`
+		Theme        string  `param:"theme"`
+		Storage      uint64  `param:"storage_gb,default=5"`
+		Ignored      string
+	}
+	if err := DecodeParameters(testPlan(), &limits); err != nil {
+		t.Fatalf("DecodeParameters failed: %v", err)
+	}
+	if limits.GoodsCount != 500 || !limits.CustomDomain || limits.Commission != 0.03 {
+		t.Errorf("unexpected values: %+v", limits)
+	}
+	if limits.StaffCount != -1 || limits.Theme != "dark" || limits.Storage != 5 {
+		t.Errorf("unexpected values: %+v", limits)
+	}
+
+	var bad struct {
+		Domain int `param:"custom_domain"`
+	}
+	if err := DecodeParameters(testPlan(), &bad); err == nil {
+		t.Error("expected conversion error")
+	}
+	if err := DecodeParameters(testPlan(), limits); err == nil {
+		t.Error("expected error for non-pointer dst")
+	}
+}
+
+func TestGetParam(t *testing.T) {
+	plan := testPlan()
+	if v, err := GetParam[int64](plan, "goods_count"); err != nil || v != 500 {
+		t.Errorf("GetParam = %d, %v", v, err)
+	}
+	if _, err := GetParam[int](plan, "missing"); !errors.Is(err, ErrParamNotFound) {
+		t.Errorf("expected ErrParamNotFound, got %v", err)
+	}
+	if v := GetParamOr(plan, "missing", true); !v {
+		t.Error("expected default value")
+	}
+}
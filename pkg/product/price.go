@@ -0,0 +1,120 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+)
+
+// ErrPriceInputUnsupported 产品服务不支持的价格计算参数（优惠券、地区定价）
+//
+// 产品服务的定价规则（ListPricingRules）是套餐的配额和功能规则，不包含折扣、优惠券或地区价格，
+// 优惠券和地区定价需由营销服务在 CalculatePrice 的结果之上计算
+var ErrPriceInputUnsupported = errors.New("产品服务不支持该价格计算参数")
+
+// BillingCycle 计费周期
+type BillingCycle string
+
+const (
+	BillingCycleMonthly BillingCycle = "monthly" // 按月，使用套餐的月付价格
+	BillingCycleYearly  BillingCycle = "yearly"  // 按年，使用套餐的年付价格
+)
+
+// CalcInput 价格计算参数
+type CalcInput struct {
+	BillingCycle BillingCycle // 计费周期
+	Quantity     int32        // 购买数量，<= 0 时按 1 计算
+	CouponCode   string       // 优惠券码，产品服务不支持，非空时返回 ErrPriceInputUnsupported
+	Country      string       // 国家代码，产品服务不支持地区定价，非空时返回 ErrPriceInputUnsupported
+}
+
+// PriceResult 价格计算结果，金额均为最小货币单位
+type PriceResult struct {
+	PlanCode     string       // 套餐编码
+	BillingCycle BillingCycle // 计费周期
+	Currency     string       // 货币单位
+	UnitPrice    int64        // 单价
+	Quantity     int32        // 购买数量
+	Total        int64        // 总价（单价 × 数量）
+}
+
+// CalculatePrice 计算套餐价格
+//
+// 获取套餐，按计费周期和数量计算总价，订单和账单服务应统一使用该方法，避免各自实现计算逻辑
+//
+// 参数:
+//   - ctx: 上下文
+//   - planCode: 套餐编码
+//   - input: 计算参数
+//
+// 返回:
+//   - *PriceResult: 价格明细
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	result, err := client.CalculatePrice(ctx, "mall_pro", product.CalcInput{
+//	    BillingCycle: product.BillingCycleYearly,
+//	    Quantity:     1,
+//	})
+//
+// 说明:
+//   - 产品服务的定价规则是配额和功能规则（见 EvaluateRule），不是折扣规则，
+//     因此不计算优惠券和地区价格，CouponCode 或 Country 非空时返回 ErrPriceInputUnsupported
+//   - 参数在请求产品服务之前校验
+func (c *ProductClient) CalculatePrice(ctx context.Context, planCode string, input CalcInput, callOpts ...CallOption) (*PriceResult, error) {
+	if planCode == "" {
+		return nil, fmt.Errorf("套餐编码不能为空")
+	}
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+
+	plan, err := c.GetPlan(ctx, planCode, nil, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return PlanPrice(plan, input)
+}
+
+// PlanPrice 根据套餐价格计算总价
+//
+// CalculatePrice 的本地计算部分，已持有套餐时可直接调用，也供测试替身复用
+func PlanPrice(plan *v1.InternalProductPlanInfo, input CalcInput) (*PriceResult, error) {
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+
+	result := &PriceResult{
+		PlanCode:     plan.GetPlanCode(),
+		BillingCycle: input.BillingCycle,
+		Currency:     plan.GetCurrency(),
+		UnitPrice:    plan.GetPriceMonthly(),
+		Quantity:     max(input.Quantity, 1),
+	}
+	if input.BillingCycle == BillingCycleYearly {
+		result.UnitPrice = plan.GetPriceYearly()
+	}
+	result.Total = result.UnitPrice * int64(result.Quantity)
+
+	return result, nil
+}
+
+// validate 校验计算参数
+func (in CalcInput) validate() error {
+	switch in.BillingCycle {
+	case BillingCycleMonthly, BillingCycleYearly:
+	default:
+		return fmt.Errorf("不支持的计费周期: %q", string(in.BillingCycle))
+	}
+	if in.CouponCode != "" {
+		return fmt.Errorf("%w: 优惠券 %s", ErrPriceInputUnsupported, in.CouponCode)
+	}
+	if in.Country != "" {
+		return fmt.Errorf("%w: 地区定价 %s", ErrPriceInputUnsupported, in.Country)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package product
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+)
+
+func TestCalculatePrice(t *testing.T) {
+	mock := &mockProductClient{}
+	client := newTestProductClient(mock)
+
+	result, err := client.CalculatePrice(context.Background(), "pro", CalcInput{
+		BillingCycle: BillingCycleMonthly,
+		Quantity:     3,
+	})
+	if err != nil {
+		t.Fatalf("CalculatePrice failed: %v", err)
+	}
+	if result.UnitPrice != 100 || result.Total != 300 || result.BillingCycle != BillingCycleMonthly {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	// 参数无效时不发起请求
+	calls := mock.planCalls
+	for _, input := range []CalcInput{
+		{},
+		{BillingCycle: BillingCycleMonthly, CouponCode: "NEWYEAR"},
+		{BillingCycle: BillingCycleMonthly, Country: "US"},
+	} {
+		if _, err := client.CalculatePrice(context.Background(), "pro", input); err == nil {
+			t.Errorf("expected error for %+v", input)
+		}
+	}
+	if mock.planCalls != calls {
+		t.Error("expected no GetPlan call for invalid input")
+	}
+	if _, err := client.CalculatePrice(context.Background(), "pro", CalcInput{BillingCycle: BillingCycleYearly, CouponCode: "X"}); !errors.Is(err, ErrPriceInputUnsupported) {
+		t.Errorf("expected ErrPriceInputUnsupported, got %v", err)
+	}
+}
+
+func TestPlanPrice(t *testing.T) {
+	plan := &v1.InternalProductPlanInfo{PlanCode: "pro", PriceMonthly: 100, PriceYearly: 1000, Currency: "CNY"}
+	result, err := PlanPrice(plan, CalcInput{BillingCycle: BillingCycleYearly})
+	if err != nil {
+		t.Fatalf("PlanPrice failed: %v", err)
+	}
+	if result.Currency != "CNY" || result.UnitPrice != 1000 || result.Quantity != 1 || result.Total != 1000 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+}
@@ -0,0 +1,467 @@
+// Package producttest 提供产品服务客户端的内存实现，供业务方单元测试使用
+package producttest
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+	"github.com/heyinLab/common/pkg/internal/fake"
+	"github.com/heyinLab/common/pkg/product"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultPageSize 未指定每页数量时的默认值
+const defaultPageSize = 20
+
+// Fixtures 假客户端的初始数据
+type Fixtures struct {
+	// 产品列表，以 ProductCode 为key
+	Products []*v1.InternalProductInfo
+	// 套餐列表，以 PlanCode 为key，ProductCode 关联产品
+	Plans []*v1.InternalProductPlanInfo
+	// 定价规则列表，以 RuleKey 为key
+	PricingRules []*v1.InternalPricingRuleInfo
+}
+
+// FakeClient product.ProductAPI 的内存实现
+//
+// 所有数据保存在内存中，返回结果均为副本；商户接口只返回已上架（ACTIVE）的产品和套餐
+//
+// 使用示例:
+//
+//	fake := producttest.NewFakeClient(&producttest.Fixtures{
+//	    Plans: []*productv1.InternalProductPlanInfo{
+//	        {ProductCode: "mall", PlanCode: "mall_pro", PriceMonthly: 9900, Currency: "CNY"},
+//	    },
+//	})
+//	svc := NewOrderService(fake)
+//
+//	// 模拟下游故障
+//	fake.SetError("GetPlan", status.Error(codes.Unavailable, "unavailable"))
+type FakeClient struct {
+	mu       sync.Mutex
+	products map[string]*v1.InternalProductInfo
+	plans    map[string]*v1.InternalProductPlanInfo
+	rules    map[string]*v1.InternalPricingRuleInfo
+	errors   fake.Errors
+	version  int
+}
+
+// 确保 FakeClient 实现了 ProductAPI 接口
+var _ product.ProductAPI = (*FakeClient)(nil)
+
+// NewFakeClient 创建假客户端
+//
+// 参数:
+//   - fixtures: 初始数据（可选），会被复制，后续修改不影响假客户端
+//
+// 返回:
+//   - *FakeClient: 假客户端实例
+func NewFakeClient(fixtures *Fixtures) *FakeClient {
+	f := &FakeClient{
+		products: make(map[string]*v1.InternalProductInfo),
+		plans:    make(map[string]*v1.InternalProductPlanInfo),
+		rules:    make(map[string]*v1.InternalPricingRuleInfo),
+		version:  1,
+	}
+	if fixtures == nil {
+		return f
+	}
+
+	for _, p := range fixtures.Products {
+		f.products[p.ProductCode] = fake.Clone(p)
+	}
+	for _, plan := range fixtures.Plans {
+		f.plans[plan.PlanCode] = fake.Clone(plan)
+	}
+	for _, rule := range fixtures.PricingRules {
+		f.rules[rule.RuleKey] = fake.Clone(rule)
+	}
+
+	return f
+}
+
+// SetError 设置指定方法返回的错误，err 为 nil 时清除
+//
+// method 为 ProductAPI 的方法名，如 "GetPlan"、"ListPlans"
+func (f *FakeClient) SetError(method string, err error) {
+	f.errors.Set(method, err)
+}
+
+// PutProduct 添加或替换产品
+func (f *FakeClient) PutProduct(p *v1.InternalProductInfo) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.products[p.ProductCode] = fake.Clone(p)
+}
+
+// PutPlan 添加或替换套餐，套餐版本随之变化，WatchPlans 会收到变更
+func (f *FakeClient) PutPlan(plan *v1.InternalProductPlanInfo) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.plans[plan.PlanCode] = fake.Clone(plan)
+	f.version++
+}
+
+// ========== 产品相关接口 ==========
+
+// GetProduct 获取产品信息
+func (f *FakeClient) GetProduct(ctx context.Context, productCode string, opt *product.GetProductOption, callOpts ...product.CallOption) (*v1.InternalProductInfo, error) {
+	return f.getProduct("GetProduct", productCode, false)
+}
+
+// MerchantGetProduct 商户获取产品，未上架的产品返回 NotFound
+func (f *FakeClient) MerchantGetProduct(ctx context.Context, productCode string, opt *product.GetMerchantGetProduct, callOpts ...product.CallOption) (*v1.InternalProductInfo, error) {
+	return f.getProduct("MerchantGetProduct", productCode, true)
+}
+
+// ListProducts 获取产品列表，支持状态、分类和关键词（编码/名称）筛选
+func (f *FakeClient) ListProducts(ctx context.Context, opt *product.ListProductsOption, callOpts ...product.CallOption) (*v1.InternalListProductsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("ListProducts"); err != nil {
+		return nil, err
+	}
+
+	if opt == nil {
+		opt = &product.ListProductsOption{}
+	}
+	var products []*v1.InternalProductInfo
+	for _, p := range f.sortedProducts() {
+		if opt.Status != nil && p.Status != *opt.Status {
+			continue
+		}
+		if opt.CategoryID != nil && p.GetCategoryId() != *opt.CategoryID {
+			continue
+		}
+		if opt.Search != nil && !matches(*opt.Search, p.ProductCode, p.ProductName) {
+			continue
+		}
+		products = append(products, fake.Clone(p))
+	}
+
+	items, page, pageSize := paginate(products, opt.Page, opt.PageSize)
+	return &v1.InternalListProductsResponse{
+		Products: items,
+		Total:    int32(len(products)),
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// MerchantListProducts 商户获取产品目录，仅返回已上架的产品和套餐，不做本地化处理
+func (f *FakeClient) MerchantListProducts(ctx context.Context, opt *product.MerchantListProductsOption, callOpts ...product.CallOption) (*v1.InternalMerchantListProductsResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("MerchantListProducts"); err != nil {
+		return nil, err
+	}
+
+	if opt == nil {
+		opt = &product.MerchantListProductsOption{}
+	}
+	var items []*v1.InternalMerchantCatalogProduct
+	for _, p := range f.sortedProducts() {
+		if p.Status != v1.InternalProductStatus_INTERNAL_PRODUCT_STATUS_ACTIVE {
+			continue
+		}
+		if opt.CategoryID != nil && p.GetCategoryId() != *opt.CategoryID {
+			continue
+		}
+
+		item := &v1.InternalMerchantCatalogProduct{Product: fake.Clone(p)}
+		if opt.IncludePlans != nil && *opt.IncludePlans {
+			for _, plan := range f.productPlans(p.ProductCode) {
+				if plan.Status == v1.InternalPlanStatus_INTERNAL_PLAN_STATUS_ACTIVE {
+					item.Plans = append(item.Plans, fake.Clone(plan))
+				}
+			}
+		}
+		items = append(items, item)
+	}
+
+	page, pageNum, pageSize := paginate(items, opt.Page, opt.PageSize)
+	return &v1.InternalMerchantListProductsResponse{
+		Products: page,
+		Total:    int32(len(items)),
+		Page:     pageNum,
+		PageSize: pageSize,
+	}, nil
+}
+
+// ========== 套餐相关接口 ==========
+
+// GetPlan 获取套餐信息，未设置 IncludeParameters=true 时不返回规则配置
+func (f *FakeClient) GetPlan(ctx context.Context, planCode string, opt *product.GetPlanOption, callOpts ...product.CallOption) (*v1.InternalProductPlanInfo, error) {
+	var includeParameters bool
+	if opt != nil && opt.IncludeParameters != nil {
+		includeParameters = *opt.IncludeParameters
+	}
+	return f.getPlan("GetPlan", planCode, includeParameters, false)
+}
+
+// MerchantGetPlan 商户获取套餐，未上架的套餐返回 NotFound
+func (f *FakeClient) MerchantGetPlan(ctx context.Context, planCode string, opt *product.MerchantGetPlanOption, callOpts ...product.CallOption) (*v1.InternalProductPlanInfo, error) {
+	var includeParameters bool
+	if opt != nil && opt.IncludeParameters != nil {
+		includeParameters = *opt.IncludeParameters
+	}
+	return f.getPlan("MerchantGetPlan", planCode, includeParameters, true)
+}
+
+// ListPlans 获取产品的套餐列表，IfNoneMatch 与当前版本一致时返回 NotModified
+func (f *FakeClient) ListPlans(ctx context.Context, productCode string, opt *product.ListPlansOption, callOpts ...product.CallOption) (*v1.InternalListPlansResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("ListPlans"); err != nil {
+		return nil, err
+	}
+
+	if opt == nil {
+		opt = &product.ListPlansOption{}
+	}
+	version := strconv.Itoa(f.version)
+	if opt.IfNoneMatch != nil && *opt.IfNoneMatch == version {
+		return &v1.InternalListPlansResponse{Version: version, NotModified: true}, nil
+	}
+
+	includeParameters := opt.IncludeParameters != nil && *opt.IncludeParameters
+	var plans []*v1.InternalProductPlanInfo
+	for _, plan := range f.productPlans(productCode) {
+		if opt.Status != nil && plan.Status != *opt.Status {
+			continue
+		}
+		plans = append(plans, clonePlan(plan, includeParameters))
+	}
+
+	items, page, pageSize := paginate(plans, opt.Page, opt.PageSize)
+	return &v1.InternalListPlansResponse{
+		Plans:    items,
+		Total:    int32(len(plans)),
+		Page:     page,
+		PageSize: pageSize,
+		Version:  version,
+	}, nil
+}
+
+// WatchPlans 监听套餐变更，套餐可通过 PutPlan 修改
+func (f *FakeClient) WatchPlans(ctx context.Context, productCode string, onChange product.PlanChangeFunc, opt *product.WatchPlansOption) error {
+	return product.WatchPlansWithLister(ctx, func(ctx context.Context, productCode string, opt *product.ListPlansOption) (*v1.InternalListPlansResponse, error) {
+		return f.ListPlans(ctx, productCode, opt)
+	}, productCode, onChange, opt)
+}
+
+// CalculatePrice 按套餐价格计算总价，参数校验与 ProductClient 一致
+func (f *FakeClient) CalculatePrice(ctx context.Context, planCode string, input product.CalcInput, callOpts ...product.CallOption) (*product.PriceResult, error) {
+	if planCode == "" {
+		return nil, fmt.Errorf("套餐编码不能为空")
+	}
+
+	plan, err := f.getPlan("CalculatePrice", planCode, false, false)
+	if err != nil {
+		return nil, err
+	}
+	return product.PlanPrice(plan, input)
+}
+
+// ComparePlans 按产品的上架套餐构建对比矩阵
+func (f *FakeClient) ComparePlans(ctx context.Context, productCode string, callOpts ...product.CallOption) (*product.PlanMatrix, error) {
+	if productCode == "" {
+		return nil, fmt.Errorf("产品编码不能为空")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("ComparePlans"); err != nil {
+		return nil, err
+	}
+
+	var plans []*v1.InternalProductPlanInfo
+	for _, plan := range f.productPlans(productCode) {
+		if plan.Status == v1.InternalPlanStatus_INTERNAL_PLAN_STATUS_ACTIVE {
+			plans = append(plans, fake.Clone(plan))
+		}
+	}
+	return product.BuildPlanMatrix(productCode, plans), nil
+}
+
+// ========== 定价规则接口 ==========
+
+// ListPricingRules 获取定价规则列表，支持类型、状态、可见性和关键词筛选
+func (f *FakeClient) ListPricingRules(ctx context.Context, opt *product.ListPricingRulesOption, callOpts ...product.CallOption) (*v1.InternalListPricingRulesResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("ListPricingRules"); err != nil {
+		return nil, err
+	}
+
+	if opt == nil {
+		opt = &product.ListPricingRulesOption{}
+	}
+	keys := make([]string, 0, len(f.rules))
+	for key := range f.rules {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var rules []*v1.InternalPricingRuleInfo
+	for _, key := range keys {
+		rule := f.rules[key]
+		if opt.RuleType != nil && rule.RuleType != *opt.RuleType {
+			continue
+		}
+		if opt.Status != nil && rule.Status != *opt.Status {
+			continue
+		}
+		if opt.IsVisible != nil && rule.IsVisible != *opt.IsVisible {
+			continue
+		}
+		if opt.Search != nil && !matches(*opt.Search, rule.RuleKey) {
+			continue
+		}
+		rules = append(rules, fake.Clone(rule))
+	}
+
+	items, page, pageSize := paginate(rules, opt.Page, opt.PageSize)
+	return &v1.InternalListPricingRulesResponse{
+		Rules:    items,
+		Total:    int32(len(rules)),
+		Page:     page,
+		PageSize: pageSize,
+		Success:  true,
+	}, nil
+}
+
+// GetPricingRule 获取定价规则
+func (f *FakeClient) GetPricingRule(ctx context.Context, ruleKey string, callOpts ...product.CallOption) (*v1.InternalPricingRuleInfo, error) {
+	if ruleKey == "" {
+		return nil, fmt.Errorf("规则键名不能为空")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("GetPricingRule"); err != nil {
+		return nil, err
+	}
+
+	rule, ok := f.rules[ruleKey]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "定价规则不存在: %s", ruleKey)
+	}
+	return fake.Clone(rule), nil
+}
+
+// ========== 内部方法 ==========
+
+func (f *FakeClient) getProduct(method string, productCode string, merchant bool) (*v1.InternalProductInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get(method); err != nil {
+		return nil, err
+	}
+
+	p, ok := f.products[productCode]
+	if !ok || (merchant && p.Status != v1.InternalProductStatus_INTERNAL_PRODUCT_STATUS_ACTIVE) {
+		return nil, status.Errorf(codes.NotFound, "产品不存在: %s", productCode)
+	}
+	return fake.Clone(p), nil
+}
+
+func (f *FakeClient) getPlan(method string, planCode string, includeParameters bool, merchant bool) (*v1.InternalProductPlanInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get(method); err != nil {
+		return nil, err
+	}
+
+	plan, ok := f.plans[planCode]
+	if !ok || (merchant && plan.Status != v1.InternalPlanStatus_INTERNAL_PLAN_STATUS_ACTIVE) {
+		return nil, status.Errorf(codes.NotFound, "套餐不存在: %s", planCode)
+	}
+	return clonePlan(plan, includeParameters), nil
+}
+
+// sortedProducts 按排序值和编码排序的产品列表
+func (f *FakeClient) sortedProducts() []*v1.InternalProductInfo {
+	products := make([]*v1.InternalProductInfo, 0, len(f.products))
+	for _, p := range f.products {
+		products = append(products, p)
+	}
+	slices.SortFunc(products, func(a, b *v1.InternalProductInfo) int {
+		if a.SortOrder != b.SortOrder {
+			return int(a.SortOrder - b.SortOrder)
+		}
+		return strings.Compare(a.ProductCode, b.ProductCode)
+	})
+	return products
+}
+
+// productPlans 按排序值和编码排序的产品套餐
+func (f *FakeClient) productPlans(productCode string) []*v1.InternalProductPlanInfo {
+	var plans []*v1.InternalProductPlanInfo
+	for _, plan := range f.plans {
+		if plan.ProductCode == productCode {
+			plans = append(plans, plan)
+		}
+	}
+	slices.SortFunc(plans, func(a, b *v1.InternalProductPlanInfo) int {
+		if a.SortOrder != b.SortOrder {
+			return int(a.SortOrder - b.SortOrder)
+		}
+		return strings.Compare(a.PlanCode, b.PlanCode)
+	})
+	return plans
+}
+
+// paginate 分页，页码从1开始
+func paginate[T any](items []T, page *int32, pageSize *int32) ([]T, int32, int32) {
+	p, size := int32(1), int32(defaultPageSize)
+	if page != nil && *page > 0 {
+		p = *page
+	}
+	if pageSize != nil && *pageSize > 0 {
+		size = *pageSize
+	}
+
+	start := int((p - 1) * size)
+	if start >= len(items) {
+		return nil, p, size
+	}
+	end := min(start+int(size), len(items))
+	return items[start:end], p, size
+}
+
+// matches 关键词是否包含在任一字段中（不区分大小写）
+func matches(search string, fields ...string) bool {
+	search = strings.ToLower(search)
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), search) {
+			return true
+		}
+	}
+	return false
+}
+
+func clonePlan(plan *v1.InternalProductPlanInfo, includeParameters bool) *v1.InternalProductPlanInfo {
+	result := fake.Clone(plan)
+	if !includeParameters {
+		result.Parameters = nil
+	}
+	return result
+}
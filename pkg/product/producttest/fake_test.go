@@ -0,0 +1,142 @@
+package producttest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+	"github.com/heyinLab/common/pkg/product"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newFixtureClient() *FakeClient {
+	active := v1.InternalPlanStatus_INTERNAL_PLAN_STATUS_ACTIVE
+	return NewFakeClient(&Fixtures{
+		Products: []*v1.InternalProductInfo{
+			{ProductCode: "mall", ProductName: "商城", Status: v1.InternalProductStatus_INTERNAL_PRODUCT_STATUS_ACTIVE},
+			{ProductCode: "crm", ProductName: "CRM", Status: v1.InternalProductStatus_INTERNAL_PRODUCT_STATUS_DRAFT},
+		},
+		Plans: []*v1.InternalProductPlanInfo{
+			{ProductCode: "mall", PlanCode: "mall_basic", PriceMonthly: 100, Currency: "CNY", Status: active, SortOrder: 1},
+			{
+				ProductCode: "mall", PlanCode: "mall_pro", PriceMonthly: 300, Currency: "CNY", Status: active, SortOrder: 2,
+				Parameters: []*v1.InternalPlanParameter{{RuleKey: "goods_count", RuleValue: "500"}},
+			},
+			{ProductCode: "mall", PlanCode: "mall_old", Status: v1.InternalPlanStatus_INTERNAL_PLAN_STATUS_DISCONTINUED},
+		},
+		PricingRules: []*v1.InternalPricingRuleInfo{
+			{RuleKey: "goods_count", RuleType: v1.InternalRuleType_INTERNAL_NUMERIC},
+		},
+	})
+}
+
+func TestFakeClient_Products(t *testing.T) {
+	fake := newFixtureClient()
+	ctx := context.Background()
+
+	if _, err := fake.GetProduct(ctx, "crm", nil); err != nil {
+		t.Errorf("GetProduct failed: %v", err)
+	}
+	if _, err := fake.MerchantGetProduct(ctx, "crm", nil); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for draft product, got %v", err)
+	}
+
+	search := "cr"
+	list, err := fake.ListProducts(ctx, &product.ListProductsOption{Search: &search})
+	if err != nil || list.Total != 1 || list.Products[0].ProductCode != "crm" {
+		t.Errorf("Unexpected ListProducts: %v, %v", list, err)
+	}
+
+	includePlans := true
+	catalog, err := fake.MerchantListProducts(ctx, &product.MerchantListProductsOption{IncludePlans: &includePlans})
+	if err != nil || catalog.Total != 1 || len(catalog.Products[0].Plans) != 2 {
+		t.Errorf("Unexpected MerchantListProducts: %v, %v", catalog, err)
+	}
+}
+
+func TestFakeClient_Plans(t *testing.T) {
+	fake := newFixtureClient()
+	ctx := context.Background()
+
+	plan, err := fake.GetPlan(ctx, "mall_pro", nil)
+	if err != nil || len(plan.Parameters) != 0 {
+		t.Errorf("Expected plan without parameters: %v, %v", plan, err)
+	}
+	includeParameters := true
+	plan, _ = fake.GetPlan(ctx, "mall_pro", &product.GetPlanOption{IncludeParameters: &includeParameters})
+	if limit, err := product.GetParam[int](plan, "goods_count"); err != nil || limit != 500 {
+		t.Errorf("Unexpected parameter: %d, %v", limit, err)
+	}
+	if _, err := fake.MerchantGetPlan(ctx, "mall_old", nil); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for discontinued plan, got %v", err)
+	}
+
+	pageSize := int32(2)
+	plans, err := fake.ListPlans(ctx, "mall", &product.ListPlansOption{PageSize: &pageSize})
+	if err != nil || plans.Total != 3 || len(plans.Plans) != 2 || plans.Plans[0].PlanCode != "mall_old" {
+		t.Errorf("Unexpected ListPlans: %v, %v", plans, err)
+	}
+	notModified, _ := fake.ListPlans(ctx, "mall", &product.ListPlansOption{IfNoneMatch: &plans.Version})
+	if !notModified.NotModified {
+		t.Error("Expected NotModified for same version")
+	}
+
+	result, err := fake.CalculatePrice(ctx, "mall_pro", product.CalcInput{
+		BillingCycle: product.BillingCycleMonthly,
+		Quantity:     2,
+	})
+	if err != nil || result.Total != 600 {
+		t.Errorf("Unexpected CalculatePrice: %+v, %v", result, err)
+	}
+
+	fake.SetError("GetPlan", status.Error(codes.Unavailable, "unavailable"))
+	if _, err := fake.GetPlan(ctx, "mall_pro", nil); status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected Unavailable, got %v", err)
+	}
+}
+
+func TestFakeClient_WatchPlans(t *testing.T) {
+	fake := newFixtureClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan []*v1.InternalProductPlanInfo, 10)
+	go fake.WatchPlans(ctx, "mall", func(plans []*v1.InternalProductPlanInfo) {
+		changes <- plans
+	}, &product.WatchPlansOption{Interval: 5 * time.Millisecond})
+
+	if plans := <-changes; len(plans) != 3 {
+		t.Fatalf("Unexpected initial plans: %d", len(plans))
+	}
+
+	fake.PutPlan(&v1.InternalProductPlanInfo{ProductCode: "mall", PlanCode: "mall_max"})
+	select {
+	case plans := <-changes:
+		if len(plans) != 4 {
+			t.Errorf("Unexpected changed plans: %d", len(plans))
+		}
+	case <-time.After(time.Second):
+		t.Fatal("timeout waiting for change")
+	}
+}
+
+func TestFakeClient_CachedProductClient(t *testing.T) {
+	fake := newFixtureClient()
+	cached := product.NewCachedProductClient(fake, time.Minute)
+	ctx := context.Background()
+
+	if _, err := cached.GetPlan(ctx, "mall_basic", nil); err != nil {
+		t.Fatalf("GetPlan failed: %v", err)
+	}
+	fake.SetError("GetPlan", status.Error(codes.Unavailable, "unavailable"))
+	if _, err := cached.GetPlan(ctx, "mall_basic", nil); err != nil {
+		t.Errorf("Expected cached plan, got %v", err)
+	}
+
+	rule, err := cached.GetPricingRule(ctx, "goods_count")
+	if err != nil || rule.RuleKey != "goods_count" {
+		t.Errorf("Unexpected GetPricingRule: %v, %v", rule, err)
+	}
+}
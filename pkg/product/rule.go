@@ -0,0 +1,130 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+	middleware "github.com/heyinLab/common/pkg/middleware/grpc"
+)
+
+// RuleInput 规则评估参数
+type RuleInput struct {
+	Parameter *v1.InternalPlanParameter // 套餐中该规则的配置，为空表示套餐未配置该规则
+	Used      int64                     // 当前已使用量（开关型规则忽略）
+	Amount    int64                     // 本次申请使用量（开关型规则忽略）
+}
+
+// RuleResult 规则评估结果
+type RuleResult struct {
+	Allowed     bool   // 是否允许
+	IsUnlimited bool   // 是否无限制
+	Limit       int64  // 限额（开关型规则为 0/1）
+	Remaining   int64  // 本次使用后的剩余量，无限制时为 -1
+	Reason      string // 不允许的原因
+}
+
+// GetPricingRule 获取定价规则详情
+func (c *ProductClient) GetPricingRule(ctx context.Context, ruleKey string, callOpts ...CallOption) (*v1.InternalPricingRuleInfo, error) {
+	if ruleKey == "" {
+		return nil, fmt.Errorf("规则键名不能为空")
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	resp, err := c.client.InternalGetPricingRule(ctx, &v1.InternalGetPricingRuleRequest{
+		RuleKey: ruleKey,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取定价规则失败:rule_key=%s,error=%v", ruleKey, err)
+		return nil, err
+	}
+
+	return resp.Rule, nil
+}
+
+// EvaluateRule 按定价规则评估套餐配置是否允许本次使用
+//
+// 与平台侧的判定逻辑保持一致，便于业务方在单元测试中复用：
+//   - 规则已禁用：不做限制
+//   - 套餐未配置该规则：不允许
+//   - 开关型：规则值为 true 时允许
+//   - 数字型/用量型：已使用量 + 本次使用量不超过规则值时允许
+//   - 无限制：仅当规则允许不限（AllowUnlimited）时生效，否则返回错误
+//
+// 参数:
+//   - rule: 定价规则
+//   - input: 评估参数
+//
+// 返回:
+//   - *RuleResult: 评估结果
+//   - error: 规则或规则值无效
+//
+// 使用示例:
+//
+//	result, err := product.EvaluateRule(rule, product.RuleInput{
+//	    Parameter: param,
+//	    Used:      currentCount,
+//	    Amount:    1,
+//	})
+//	if err == nil && !result.Allowed {
+//	    return errors.New(result.Reason)
+//	}
+func EvaluateRule(rule *v1.InternalPricingRuleInfo, input RuleInput) (*RuleResult, error) {
+	if rule == nil {
+		return nil, fmt.Errorf("定价规则不能为空")
+	}
+	if input.Parameter != nil && input.Parameter.GetRuleKey() != "" && input.Parameter.GetRuleKey() != rule.GetRuleKey() {
+		return nil, fmt.Errorf("套餐配置与规则不匹配: rule_key=%s, parameter=%s", rule.GetRuleKey(), input.Parameter.GetRuleKey())
+	}
+
+	if rule.GetStatus() == v1.InternalRuleStatus_INTERNAL_RULE_INACTIVE {
+		return &RuleResult{Allowed: true, IsUnlimited: true, Remaining: -1}, nil
+	}
+	if input.Parameter == nil {
+		return &RuleResult{Reason: fmt.Sprintf("套餐未配置规则: %s", rule.GetRuleKey())}, nil
+	}
+
+	if input.Parameter.GetIsUnlimited() {
+		if !rule.GetAllowUnlimited() {
+			return nil, fmt.Errorf("规则不允许设置为不限: rule_key=%s", rule.GetRuleKey())
+		}
+		return &RuleResult{Allowed: true, IsUnlimited: true, Remaining: -1}, nil
+	}
+
+	value := strings.TrimSpace(input.Parameter.GetRuleValue())
+	switch rule.GetRuleType() {
+	case v1.InternalRuleType_INTERNAL_SWITCH:
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("开关型规则值无效: rule_key=%s, value=%q", rule.GetRuleKey(), value)
+		}
+		result := &RuleResult{Allowed: enabled}
+		if enabled {
+			result.Limit = 1
+		} else {
+			result.Reason = fmt.Sprintf("套餐未开通: %s", rule.GetRuleKey())
+		}
+		return result, nil
+
+	case v1.InternalRuleType_INTERNAL_NUMERIC, v1.InternalRuleType_INTERNAL_USAGE:
+		limit, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("数字型规则值无效: rule_key=%s, value=%q", rule.GetRuleKey(), value)
+		}
+		result := &RuleResult{
+			Limit:     limit,
+			Remaining: limit - input.Used - input.Amount,
+		}
+		result.Allowed = result.Remaining >= 0
+		if !result.Allowed {
+			result.Reason = fmt.Sprintf("超出套餐限额: %s, limit=%d, used=%d, amount=%d", rule.GetRuleKey(), limit, input.Used, input.Amount)
+		}
+		return result, nil
+
+	default:
+		return nil, fmt.Errorf("不支持的规则类型: rule_key=%s, type=%s", rule.GetRuleKey(), rule.GetRuleType())
+	}
+}
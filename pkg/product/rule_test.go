@@ -0,0 +1,76 @@
+package product
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+	"google.golang.org/grpc"
+)
+
+// mockRuleClient 返回固定的定价规则
+type mockRuleClient struct {
+	v1.ProductInternalServiceClient
+}
+
+func (m *mockRuleClient) InternalGetPricingRule(ctx context.Context, in *v1.InternalGetPricingRuleRequest, opts ...grpc.CallOption) (*v1.InternalGetPricingRuleResponse, error) {
+	return &v1.InternalGetPricingRuleResponse{Rule: &v1.InternalPricingRuleInfo{RuleKey: in.RuleKey}}, nil
+}
+
+func TestGetPricingRule(t *testing.T) {
+	client := newTestProductClient(&mockRuleClient{})
+	rule, err := client.GetPricingRule(context.Background(), "goods_count")
+	if err != nil || rule.GetRuleKey() != "goods_count" {
+		t.Fatalf("GetPricingRule = %v, %v", rule, err)
+	}
+	if _, err := client.GetPricingRule(context.Background(), ""); err == nil {
+		t.Error("expected error for empty rule key")
+	}
+}
+
+func TestEvaluateRule(t *testing.T) {
+	numeric := &v1.InternalPricingRuleInfo{
+		RuleKey:  "goods_count",
+		RuleType: v1.InternalRuleType_INTERNAL_NUMERIC,
+		Status:   v1.InternalRuleStatus_INTERNAL_RULE_ACTIVE,
+	}
+	param := &v1.InternalPlanParameter{RuleKey: "goods_count", RuleValue: "10"}
+
+	result, err := EvaluateRule(numeric, RuleInput{Parameter: param, Used: 8, Amount: 2})
+	if err != nil || !result.Allowed || result.Remaining != 0 {
+		t.Errorf("expected allowed at limit, got %+v, %v", result, err)
+	}
+	result, _ = EvaluateRule(numeric, RuleInput{Parameter: param, Used: 9, Amount: 2})
+	if result.Allowed || result.Reason == "" {
+		t.Errorf("expected exceeded, got %+v", result)
+	}
+	result, _ = EvaluateRule(numeric, RuleInput{Used: 0, Amount: 1})
+	if result.Allowed {
+		t.Error("expected not allowed without plan parameter")
+	}
+
+	unlimited := &v1.InternalPlanParameter{RuleKey: "goods_count", IsUnlimited: true}
+	if _, err := EvaluateRule(numeric, RuleInput{Parameter: unlimited}); err == nil {
+		t.Error("expected error when rule does not allow unlimited")
+	}
+	numeric.AllowUnlimited = true
+	result, _ = EvaluateRule(numeric, RuleInput{Parameter: unlimited, Used: 1000})
+	if !result.Allowed || !result.IsUnlimited {
+		t.Errorf("expected unlimited, got %+v", result)
+	}
+
+	switchRule := &v1.InternalPricingRuleInfo{RuleKey: "custom_domain", RuleType: v1.InternalRuleType_INTERNAL_SWITCH}
+	result, _ = EvaluateRule(switchRule, RuleInput{Parameter: &v1.InternalPlanParameter{RuleValue: "false"}})
+	if result.Allowed {
+		t.Error("expected switch off")
+	}
+	if _, err := EvaluateRule(switchRule, RuleInput{Parameter: param}); err == nil {
+		t.Error("expected error for mismatched rule key")
+	}
+
+	switchRule.Status = v1.InternalRuleStatus_INTERNAL_RULE_INACTIVE
+	result, _ = EvaluateRule(switchRule, RuleInput{})
+	if !result.Allowed {
+		t.Error("expected inactive rule to allow")
+	}
+}
@@ -0,0 +1,141 @@
+package product
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+	"github.com/heyinLab/common/pkg/internal/poll"
+)
+
+const (
+	// DefaultPlanWatchInterval 套餐变更轮询的默认间隔
+	DefaultPlanWatchInterval = 30 * time.Second
+
+	// watchPlansPageSize 轮询时每页拉取的套餐数量
+	watchPlansPageSize = 100
+)
+
+// PlanChangeFunc 套餐变更回调，参数为变更后的完整套餐列表
+type PlanChangeFunc func(plans []*v1.InternalProductPlanInfo)
+
+// PlanLister 分页获取套餐列表的函数，与 ProductClient.ListPlans 一致（不含调用选项）
+type PlanLister func(ctx context.Context, productCode string, opt *ListPlansOption) (*v1.InternalListPlansResponse, error)
+
+// WatchPlansOption 套餐监听选项
+type WatchPlansOption struct {
+	Interval time.Duration          // 轮询间隔，<=0 时使用 DefaultPlanWatchInterval
+	Status   *v1.InternalPlanStatus // 状态筛选
+}
+
+// WatchPlans 监听产品套餐变更
+//
+// 按间隔轮询套餐列表，携带上次的版本号（if_none_match），服务端版本未变化时不返回列表；
+// 服务端未返回版本号时在本地根据套餐内容计算。启动时先同步拉取一次并回调，之后仅在版本变化时回调。
+// 方法会阻塞直到 ctx 取消
+//
+// 参数:
+//   - ctx: 上下文，取消后停止监听
+//   - productCode: 产品编码
+//   - onChange: 变更回调（在监听协程中同步调用）
+//   - opt: 监听选项（可选）
+//
+// 返回:
+//   - error: 首次拉取失败时返回错误；ctx 取消时返回 nil
+//
+// 使用示例:
+//
+//	go func() {
+//	    err := client.WatchPlans(ctx, "mall", func(plans []*v1.InternalProductPlanInfo) {
+//	        for _, plan := range plans {
+//	            cached.Invalidate(plan.PlanCode)
+//	        }
+//	    }, nil)
+//	    if err != nil {
+//	        log.Errorf("监听套餐变更失败: %v", err)
+//	    }
+//	}()
+//
+// 说明:
+//   - 某次轮询失败时不回调，onChange 最近一次收到的套餐列表仍然有效，失败原因见 ListPlans 的错误日志
+func (c *ProductClient) WatchPlans(ctx context.Context, productCode string, onChange PlanChangeFunc, opt *WatchPlansOption) error {
+	return WatchPlansWithLister(ctx, func(ctx context.Context, productCode string, opt *ListPlansOption) (*v1.InternalListPlansResponse, error) {
+		return c.ListPlans(ctx, productCode, opt)
+	}, productCode, onChange, opt)
+}
+
+// WatchPlansWithLister 使用自定义的套餐列表函数监听套餐变更
+//
+// 行为与 ProductClient.WatchPlans 一致，便于接入缓存或测试替身
+func WatchPlansWithLister(ctx context.Context, list PlanLister, productCode string, onChange PlanChangeFunc, opt *WatchPlansOption) error {
+	if list == nil {
+		return fmt.Errorf("套餐列表函数不能为空")
+	}
+	if productCode == "" {
+		return fmt.Errorf("产品编码不能为空")
+	}
+	if onChange == nil {
+		return fmt.Errorf("变更回调不能为空")
+	}
+
+	interval := DefaultPlanWatchInterval
+	var status *v1.InternalPlanStatus
+	if opt != nil {
+		if opt.Interval > 0 {
+			interval = opt.Interval
+		}
+		status = opt.Status
+	}
+
+	return poll.Watch(ctx, interval, func(ctx context.Context, version string) ([]*v1.InternalProductPlanInfo, string, bool, error) {
+		return listAllPlans(ctx, list, productCode, status, version)
+	}, onChange)
+}
+
+// listAllPlans 拉取产品的全部套餐
+//
+// 返回:
+//   - []*v1.InternalProductPlanInfo: 套餐列表（未变化时为空）
+//   - string: 套餐版本
+//   - bool: 版本与 version 一致，未拉取列表
+//   - error: 错误信息
+func listAllPlans(ctx context.Context, list PlanLister, productCode string, status *v1.InternalPlanStatus, version string) ([]*v1.InternalProductPlanInfo, string, bool, error) {
+	includeParameters := true
+	pageSize := int32(watchPlansPageSize)
+
+	var plans []*v1.InternalProductPlanInfo
+	var serverVersion string
+	for page := int32(1); ; page++ {
+		opt := &ListPlansOption{
+			Page:              &page,
+			PageSize:          &pageSize,
+			Status:            status,
+			IncludeParameters: &includeParameters,
+		}
+		if page == 1 && version != "" {
+			opt.IfNoneMatch = &version
+		}
+
+		resp, err := list(ctx, productCode, opt)
+		if err != nil {
+			return nil, "", false, err
+		}
+		if resp.GetNotModified() {
+			return nil, version, true, nil
+		}
+		if page == 1 {
+			serverVersion = resp.GetVersion()
+		}
+
+		plans = append(plans, resp.GetPlans()...)
+		if len(resp.GetPlans()) == 0 || int32(len(plans)) >= resp.GetTotal() {
+			break
+		}
+	}
+
+	if serverVersion == "" {
+		serverVersion = poll.Fingerprint(plans...)
+	}
+	return plans, serverVersion, false, nil
+}
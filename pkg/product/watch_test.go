@@ -0,0 +1,88 @@
+package product
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/product/v1"
+	"google.golang.org/grpc"
+)
+
+// mockWatchClient 返回可修改的套餐列表
+type mockWatchClient struct {
+	v1.ProductInternalServiceClient
+	mu      sync.Mutex
+	price   int64
+	version string
+	calls   int
+}
+
+func (m *mockWatchClient) InternalListPlans(ctx context.Context, in *v1.InternalListPlansRequest, opts ...grpc.CallOption) (*v1.InternalListPlansResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+	if m.version != "" && in.GetIfNoneMatch() == m.version {
+		return &v1.InternalListPlansResponse{NotModified: true, Version: m.version}, nil
+	}
+	return &v1.InternalListPlansResponse{
+		Plans:   []*v1.InternalProductPlanInfo{{PlanCode: "pro", PriceMonthly: m.price}},
+		Total:   1,
+		Version: m.version,
+	}, nil
+}
+
+func (m *mockWatchClient) set(price int64, version string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.price = price
+	m.version = version
+}
+
+func TestWatchPlans(t *testing.T) {
+	for _, version := range []string{"", "v1"} {
+		mock := &mockWatchClient{price: 100, version: version}
+		client := newTestProductClient(mock)
+		ctx, cancel := context.WithCancel(context.Background())
+
+		changes := make(chan []*v1.InternalProductPlanInfo, 10)
+		done := make(chan error, 1)
+		go func() {
+			done <- client.WatchPlans(ctx, "mall", func(plans []*v1.InternalProductPlanInfo) {
+				changes <- plans
+			}, &WatchPlansOption{Interval: 5 * time.Millisecond})
+		}()
+
+		if plans := <-changes; plans[0].PriceMonthly != 100 {
+			t.Errorf("unexpected initial plans: %v", plans)
+		}
+
+		// 未变化时不回调
+		time.Sleep(30 * time.Millisecond)
+		select {
+		case plans := <-changes:
+			t.Errorf("unexpected change without update: %v", plans)
+		default:
+		}
+
+		newVersion := ""
+		if version != "" {
+			newVersion = "v2"
+		}
+		mock.set(200, newVersion)
+		select {
+		case plans := <-changes:
+			if plans[0].PriceMonthly != 200 {
+				t.Errorf("unexpected changed plans: %v", plans)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for change")
+		}
+
+		cancel()
+		if err := <-done; err != nil {
+			t.Errorf("WatchPlans returned %v", err)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	middleware "github.com/heyinLab/common/pkg/middleware/grpc"
+)
+
+// MaxArchiveFiles 单个打包任务的最大文件数
+const MaxArchiveFiles = 1000
+
+// ArchiveStatus 打包任务状态
+const (
+	ArchiveStatusPending    = "pending"    // 等待处理
+	ArchiveStatusProcessing = "processing" // 打包中
+	ArchiveStatusCompleted  = "completed"  // 已完成
+	ArchiveStatusFailed     = "failed"     // 失败
+)
+
+// CreateArchive 创建文件打包任务
+//
+// 将多个文件异步打包为zip，通过 GetArchiveStatus 或 WaitForArchive 获取下载URL
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantCode: 租户ID
+//   - fileIDs: 文件ID列表（最多1000个）
+//   - name: 压缩包文件名（可选，如 "order_1001_attachments.zip"）
+//
+// 返回:
+//   - *v1.InternalArchiveJob: 打包任务，JobId 用于查询状态
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	job, err := client.CreateArchive(ctx, tenantCode, fileIDs, "attachments.zip")
+//	if err != nil {
+//	    return err
+//	}
+//	job, err = client.WaitForArchive(ctx, tenantCode, job.JobId, 2*time.Second)
+//	if err == nil && job.Status == resource.ArchiveStatusCompleted {
+//	    return job.DownloadUrl
+//	}
+func (c *ResourceClient) CreateArchive(ctx context.Context, tenantCode string, fileIDs []string, name string, callOpts ...CallOption) (*v1.InternalArchiveJob, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	fileIDs = uniqueIDs(fileIDs)
+	if len(fileIDs) == 0 {
+		return nil, fmt.Errorf("文件ID列表不能为空")
+	}
+	if len(fileIDs) > MaxArchiveFiles {
+		return nil, fmt.Errorf("打包文件数量不能超过%d个，当前: %d", MaxArchiveFiles, len(fileIDs))
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	resp, err := c.client.InternalCreateArchive(ctx, &v1.InternalCreateArchiveRequest{
+		TenantCode: tenantCode,
+		FileIds:    fileIDs,
+		Name:       name,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("创建打包任务失败: tenant_code=%s, count=%d, error=%v", tenantCode, len(fileIDs), err)
+		return nil, err
+	}
+
+	return resp.Job, nil
+}
+
+// GetArchiveStatus 查询打包任务状态
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantCode: 租户ID
+//   - jobID: 任务ID
+//
+// 返回:
+//   - *v1.InternalArchiveJob: 打包任务，完成时 DownloadUrl 有效
+//   - error: 错误信息
+func (c *ResourceClient) GetArchiveStatus(ctx context.Context, tenantCode string, jobID string, callOpts ...CallOption) (*v1.InternalArchiveJob, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if jobID == "" {
+		return nil, fmt.Errorf("任务ID不能为空")
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	resp, err := c.client.InternalGetArchiveStatus(ctx, &v1.InternalGetArchiveStatusRequest{
+		TenantCode: tenantCode,
+		JobId:      jobID,
+		ExpiresIn:  DefaultURLExpiresIn,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("查询打包任务状态失败: tenant_code=%s, job_id=%s, error=%v", tenantCode, jobID, err)
+		return nil, err
+	}
+
+	return resp.Job, nil
+}
+
+// WaitForArchive 轮询等待打包任务结束
+//
+// 参数:
+//   - ctx: 上下文（用于控制总等待时间）
+//   - tenantCode: 租户ID
+//   - jobID: 任务ID
+//   - interval: 轮询间隔，<=0 时默认1秒
+//
+// 返回:
+//   - *v1.InternalArchiveJob: 任务结束（completed 或 failed）时的状态
+//   - error: 查询失败或 ctx 结束时的错误
+func (c *ResourceClient) WaitForArchive(ctx context.Context, tenantCode string, jobID string, interval time.Duration, callOpts ...CallOption) (*v1.InternalArchiveJob, error) {
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		job, err := c.GetArchiveStatus(ctx, tenantCode, jobID, callOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if job.Status == ArchiveStatusCompleted || job.Status == ArchiveStatusFailed {
+			return job, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return job, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
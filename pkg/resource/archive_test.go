@@ -0,0 +1,62 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"google.golang.org/grpc"
+)
+
+// mockArchiveClient 打包任务在第 doneAfter 次查询时完成
+type mockArchiveClient struct {
+	v1.ResourceInternalServiceClient
+	created   *v1.InternalCreateArchiveRequest
+	polls     int
+	doneAfter int
+}
+
+func (m *mockArchiveClient) InternalCreateArchive(ctx context.Context, in *v1.InternalCreateArchiveRequest, opts ...grpc.CallOption) (*v1.InternalCreateArchiveResponse, error) {
+	m.created = in
+	return &v1.InternalCreateArchiveResponse{Job: &v1.InternalArchiveJob{JobId: "job_1", Status: ArchiveStatusPending}}, nil
+}
+
+func (m *mockArchiveClient) InternalGetArchiveStatus(ctx context.Context, in *v1.InternalGetArchiveStatusRequest, opts ...grpc.CallOption) (*v1.InternalGetArchiveStatusResponse, error) {
+	m.polls++
+	job := &v1.InternalArchiveJob{JobId: in.JobId, Status: ArchiveStatusProcessing}
+	if m.polls >= m.doneAfter {
+		job.Status = ArchiveStatusCompleted
+		job.DownloadUrl = "https://cdn.example.com/" + in.JobId + ".zip"
+	}
+	return &v1.InternalGetArchiveStatusResponse{Job: job}, nil
+}
+
+func TestCreateArchiveAndWait(t *testing.T) {
+	mock := &mockArchiveClient{doneAfter: 3}
+	client := newTestClient(mock)
+	ctx := context.Background()
+
+	if _, err := client.CreateArchive(ctx, "T001", nil, ""); err == nil {
+		t.Error("Expected error for empty file list")
+	}
+	if _, err := client.CreateArchive(ctx, "T001", makeIDs(MaxArchiveFiles+1), ""); err == nil {
+		t.Error("Expected error for too many files")
+	}
+
+	job, err := client.CreateArchive(ctx, "T001", []string{"file_1", "file_2", "file_1"}, "all.zip")
+	if err != nil {
+		t.Fatalf("CreateArchive failed: %v", err)
+	}
+	if len(mock.created.FileIds) != 2 || mock.created.Name != "all.zip" {
+		t.Errorf("Unexpected request: %v", mock.created)
+	}
+
+	job, err = client.WaitForArchive(ctx, "T001", job.JobId, time.Millisecond)
+	if err != nil {
+		t.Fatalf("WaitForArchive failed: %v", err)
+	}
+	if job.Status != ArchiveStatusCompleted || job.DownloadUrl == "" || mock.polls != 3 {
+		t.Errorf("Unexpected job %v after %d polls", job, mock.polls)
+	}
+}
@@ -0,0 +1,203 @@
+package resource
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultURLCacheMaxEntries URL缓存默认最大条目数
+	DefaultURLCacheMaxEntries = 10000
+
+	// DefaultURLCacheSafetyMargin URL缓存默认安全余量（在签名URL过期前提前失效）
+	DefaultURLCacheSafetyMargin = time.Minute
+
+	// DefaultURLCachePublicTTL 公开URL（永久有效）的默认缓存时间
+	DefaultURLCachePublicTTL = 10 * time.Minute
+)
+
+// URLCacheConfig URL缓存配置
+type URLCacheConfig struct {
+	// MaxEntries 最大缓存条目数，默认10000
+	MaxEntries int
+	// SafetyMargin 安全余量，缓存会在签名URL过期前提前失效，默认1分钟
+	SafetyMargin time.Duration
+	// PublicTTL 公开URL的缓存时间，默认10分钟
+	PublicTTL time.Duration
+}
+
+// urlCacheEntry URL缓存条目
+type urlCacheEntry struct {
+	info     *v1.InternalFileUrlInfo
+	expireAt time.Time // 缓存失效时间，签名URL为URL过期时间减去安全余量
+	urlExpAt time.Time // 签名URL的实际过期时间，公开URL为零值
+}
+
+// urlCache 文件URL的内存缓存
+//
+// 以 文件ID + 变体范围 + 请求的有效期 为key，过期时间根据 URL 的 ExpiresIn 计算
+type urlCache struct {
+	mu      sync.RWMutex
+	entries map[string]*urlCacheEntry
+	config  URLCacheConfig
+	now     func() time.Time
+}
+
+// newURLCache 创建URL缓存
+func newURLCache(config *URLCacheConfig) *urlCache {
+	cfg := URLCacheConfig{}
+	if config != nil {
+		cfg = *config
+	}
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = DefaultURLCacheMaxEntries
+	}
+	if cfg.SafetyMargin <= 0 {
+		cfg.SafetyMargin = DefaultURLCacheSafetyMargin
+	}
+	if cfg.PublicTTL <= 0 {
+		cfg.PublicTTL = DefaultURLCachePublicTTL
+	}
+
+	return &urlCache{
+		entries: make(map[string]*urlCacheEntry),
+		config:  cfg,
+		now:     time.Now,
+	}
+}
+
+// urlCacheVariants 根据选项生成变体范围标识
+//
+// 不含变体为空字符串，包含全部变体为 "*"，指定变体时为排序后的变体ID列表
+func urlCacheVariants(opts *GetFileUrlsOptions) string {
+	if opts == nil {
+		return ""
+	}
+	if len(opts.VariantIDs) > 0 {
+		ids := slices.Clone(opts.VariantIDs)
+		slices.Sort(ids)
+		return strings.Join(slices.Compact(ids), ",")
+	}
+	if opts.IncludeVariants {
+		return "*"
+	}
+	return ""
+}
+
+// urlCacheScope 根据选项生成缓存范围标识，包含变体范围和请求的URL有效期
+//
+// 请求的有效期不同时签名URL不同，不能互相复用
+func urlCacheScope(opts *GetFileUrlsOptions) string {
+	scope := urlCacheVariants(opts)
+	if opts != nil && opts.ExpiresIn > 0 {
+		scope += "|" + strconv.FormatInt(opts.ExpiresIn, 10)
+	}
+	return scope
+}
+
+// urlCacheKey 生成缓存key
+func urlCacheKey(fileID string, scope string) string {
+	return fileID + "|" + scope
+}
+
+// get 获取缓存，返回命中的结果和未命中的ID列表
+//
+// 命中的结果为副本，签名URL的 ExpiresIn 改为距实际过期的剩余秒数
+func (c *urlCache) get(fileIDs []string, scope string) (map[string]*v1.InternalFileUrlInfo, []string) {
+	hits := make(map[string]*v1.InternalFileUrlInfo, len(fileIDs))
+	var misses []string
+
+	now := c.now()
+	c.mu.RLock()
+	for _, id := range fileIDs {
+		entry, ok := c.entries[urlCacheKey(id, scope)]
+		if ok && now.Before(entry.expireAt) {
+			info := proto.Clone(entry.info).(*v1.InternalFileUrlInfo)
+			if !entry.urlExpAt.IsZero() {
+				info.ExpiresIn = int64(entry.urlExpAt.Sub(now) / time.Second)
+			}
+			hits[id] = info
+			continue
+		}
+		misses = append(misses, id)
+	}
+	c.mu.RUnlock()
+
+	return hits, misses
+}
+
+// set 写入缓存，仅缓存成功获取的URL
+func (c *urlCache) set(results map[string]*v1.InternalFileUrlInfo, scope string) {
+	now := c.now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, info := range results {
+		if info == nil || !info.Success {
+			continue
+		}
+
+		ttl := c.config.PublicTTL
+		var urlExpAt time.Time
+		if !info.IsPublic {
+			urlExpAt = now.Add(time.Duration(info.ExpiresIn) * time.Second)
+			ttl = urlExpAt.Sub(now) - c.config.SafetyMargin
+		}
+		if ttl <= 0 {
+			continue
+		}
+
+		if len(c.entries) >= c.config.MaxEntries {
+			c.evict(now)
+		}
+
+		c.entries[urlCacheKey(id, scope)] = &urlCacheEntry{
+			info:     proto.Clone(info).(*v1.InternalFileUrlInfo),
+			expireAt: now.Add(ttl),
+			urlExpAt: urlExpAt,
+		}
+	}
+}
+
+// evict 清理过期条目，仍然超出上限时随机淘汰
+func (c *urlCache) evict(now time.Time) {
+	for key, entry := range c.entries {
+		if !now.Before(entry.expireAt) {
+			delete(c.entries, key)
+		}
+	}
+
+	for key := range c.entries {
+		if len(c.entries) < c.config.MaxEntries {
+			break
+		}
+		delete(c.entries, key)
+	}
+}
+
+// invalidate 删除指定文件的缓存
+func (c *urlCache) invalidate(fileIDs []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prefixes := make([]string, len(fileIDs))
+	for i, id := range fileIDs {
+		prefixes[i] = urlCacheKey(id, "")
+	}
+
+	for key := range c.entries {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(key, prefix) {
+				delete(c.entries, key)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package resource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+)
+
+func TestURLCache_Expire(t *testing.T) {
+	now := time.Now()
+	cache := newURLCache(&URLCacheConfig{SafetyMargin: time.Minute})
+	cache.now = func() time.Time { return now }
+
+	cache.set(map[string]*v1.InternalFileUrlInfo{
+		"signed": {Url: "https://cdn.example.com/signed", Success: true, ExpiresIn: 600},
+		"public": {Url: "https://cdn.example.com/public", Success: true, IsPublic: true},
+		"failed": {Success: false, Error: "file not found"},
+		"short":  {Url: "https://cdn.example.com/short", Success: true, ExpiresIn: 30},
+	}, "")
+
+	hits, misses := cache.get([]string{"signed", "public", "failed", "short"}, "")
+	if len(hits) != 2 {
+		t.Errorf("Expected 2 hits, got %d", len(hits))
+	}
+	if len(misses) != 2 {
+		t.Errorf("Expected 2 misses, got %v", misses)
+	}
+
+	// 变体标记不同，不应命中
+	if hits, _ := cache.get([]string{"signed"}, "*"); len(hits) != 0 {
+		t.Errorf("Expected no hits for variant key, got %d", len(hits))
+	}
+
+	// 命中时 ExpiresIn 为剩余有效期，且不修改缓存的结果
+	now = now.Add(5 * time.Minute)
+	hits, _ = cache.get([]string{"signed"}, "")
+	if got := hits["signed"].GetExpiresIn(); got != 300 {
+		t.Errorf("Expected remaining ExpiresIn 300, got %d", got)
+	}
+	hits["signed"].Url = "modified"
+	if hits, _ := cache.get([]string{"signed"}, ""); hits["signed"].GetUrl() != "https://cdn.example.com/signed" {
+		t.Errorf("Expected cached info not modified by caller, got %q", hits["signed"].GetUrl())
+	}
+
+	// 签名URL应在过期前 SafetyMargin 失效
+	now = now.Add(4 * time.Minute)
+	if _, misses := cache.get([]string{"signed"}, ""); len(misses) != 1 {
+		t.Errorf("Expected signed url expired, got misses %v", misses)
+	}
+	if hits, _ := cache.get([]string{"public"}, ""); len(hits) != 1 {
+		t.Errorf("Expected public url still cached")
+	}
+}
+
+func TestURLCache_MaxEntries(t *testing.T) {
+	cache := newURLCache(&URLCacheConfig{MaxEntries: 10})
+
+	results := make(map[string]*v1.InternalFileUrlInfo)
+	for _, id := range makeIDs(25) {
+		results[id] = &v1.InternalFileUrlInfo{Url: id, Success: true, IsPublic: true}
+	}
+	cache.set(results, "")
+
+	if len(cache.entries) > 10 {
+		t.Errorf("Expected at most 10 entries, got %d", len(cache.entries))
+	}
+}
+
+func TestGetFileUrls_WithCache(t *testing.T) {
+	mock := &mockInternalClient{}
+	client := newTestClient(mock).EnableURLCache(nil)
+	ctx := context.Background()
+
+	if _, err := client.GetFileUrls(ctx, []string{"file_1", "file_2"}, nil); err != nil {
+		t.Fatalf("GetFileUrls failed: %v", err)
+	}
+
+	// mock 返回的 ExpiresIn 为0且非公开，不会被缓存，改用公开URL验证
+	client.urlCache.set(map[string]*v1.InternalFileUrlInfo{
+		"file_1": {Url: "cached", Success: true, IsPublic: true},
+	}, "")
+
+	results, err := client.GetFileUrls(ctx, []string{"file_1", "file_3"}, nil)
+	if err != nil {
+		t.Fatalf("GetFileUrls failed: %v", err)
+	}
+	if results["file_1"].Url != "cached" {
+		t.Errorf("Expected cached url, got %s", results["file_1"].Url)
+	}
+	if len(results) != 2 {
+		t.Errorf("Expected 2 results, got %d", len(results))
+	}
+
+	last := mock.urlCalls[len(mock.urlCalls)-1]
+	if len(last) != 1 || last[0] != "file_3" {
+		t.Errorf("Expected only file_3 requested, got %v", last)
+	}
+
+	client.InvalidateURLCache("file_1")
+	if hits, _ := client.urlCache.get([]string{"file_1"}, ""); len(hits) != 0 {
+		t.Errorf("Expected cache invalidated")
+	}
+}
+
+func TestURLCacheVariants(t *testing.T) {
+	cases := []struct {
+		opts *GetFileUrlsOptions
+		want string
+	}{
+		{nil, ""},
+		{&GetFileUrlsOptions{}, ""},
+		{&GetFileUrlsOptions{IncludeVariants: true}, "*"},
+		{&GetFileUrlsOptions{VariantIDs: []string{"b", "a", "b"}}, "a,b"},
+	}
+	for _, c := range cases {
+		if got := urlCacheVariants(c.opts); got != c.want {
+			t.Errorf("urlCacheVariants(%v) = %q, want %q", c.opts, got, c.want)
+		}
+	}
+	if got := urlCacheScope(&GetFileUrlsOptions{IncludeVariants: true, ExpiresIn: 600}); got != "*|600" {
+		t.Errorf("Expected requested expiry in cache scope, got %q", got)
+	}
+
+	cache := newURLCache(nil)
+	cache.set(map[string]*v1.InternalFileUrlInfo{
+		"file_1": {Url: "a", Success: true, IsPublic: true},
+	}, "thumb")
+	cache.invalidate([]string{"file_1"})
+	if len(cache.entries) != 0 {
+		t.Errorf("Expected all variant entries invalidated, got %d", len(cache.entries))
+	}
+}
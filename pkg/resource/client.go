@@ -3,6 +3,8 @@ package resource
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	middleware "github.com/heyinLab/common/pkg/middleware/grpc"
 
@@ -10,6 +12,7 @@ import (
 	"github.com/go-kratos/kratos/v2/registry"
 	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 // ResourceClient 资源服务内部客户端
@@ -29,11 +32,34 @@ import (
 //
 //	// 获取文件信息
 //	file, err := client.GetFile(ctx, tenantCode, fileID)
+//
+//	// 单次调用覆盖超时时间
+//	file, err := client.GetFile(ctx, tenantCode, fileID, resource.WithTimeout(60*time.Second))
+//
+//	// 注册调用指标（所有通过 CreateGRPCConn 创建的客户端共享 rpc_client_* 指标）
+//	prometheus.MustRegister(metrics.Collectors()...)
 type ResourceClient struct {
 	config *InternalConfig
 	conn   *grpc.ClientConn
 	client v1.ResourceInternalServiceClient
+	health healthpb.HealthClient
 	logger *log.Helper
+
+	// URL缓存（可选，通过 EnableURLCache 开启）
+	urlCache *urlCache
+
+	// 租户ID为空时从认证信息中读取（通过 WithTenantFromContext 开启）
+	tenantFromContext bool
+
+	// sharedConn 连接由外部管理（NewResourceClientWithConn），Close 时不关闭
+	sharedConn bool
+
+	// state 用于 OnStateChange 观察连接状态，连接不支持时为 nil
+	state stateConn
+
+	// closeCtx 在 Close 时取消，用于停止 OnStateChange 的观察协程
+	closeCtx    context.Context
+	closeCancel context.CancelFunc
 }
 
 // NewResourceClient 创建资源服务内部客户端（直连方式）
@@ -69,11 +95,16 @@ func NewResourceClient(config *InternalConfig) (*ResourceClient, error) {
 		return nil, fmt.Errorf("创建 gRPC 连接失败: %w", err)
 	}
 
+	closeCtx, closeCancel := context.WithCancel(context.Background())
 	return &ResourceClient{
-		config: config,
-		conn:   conn,
-		client: v1.NewResourceInternalServiceClient(conn),
-		logger: logger,
+		config:      config,
+		conn:        conn,
+		client:      v1.NewResourceInternalServiceClient(conn),
+		health:      healthpb.NewHealthClient(conn),
+		logger:      logger,
+		state:       conn,
+		closeCtx:    closeCtx,
+		closeCancel: closeCancel,
 	}, nil
 }
 
@@ -119,22 +150,89 @@ func NewResourceClientWithDiscovery(config *InternalConfig, discovery registry.D
 
 	logger.Infof("资源内部服务客户端连接成功 (服务发现): endpoint=%s, timeout=%v", config.Endpoint, config.Timeout)
 
+	closeCtx, closeCancel := context.WithCancel(context.Background())
 	return &ResourceClient{
-		config: config,
-		conn:   conn,
-		client: v1.NewResourceInternalServiceClient(conn),
-		logger: logger,
+		config:      config,
+		conn:        conn,
+		client:      v1.NewResourceInternalServiceClient(conn),
+		health:      healthpb.NewHealthClient(conn),
+		logger:      logger,
+		state:       conn,
+		closeCtx:    closeCtx,
+		closeCancel: closeCancel,
 	}, nil
 }
 
-// Close 关闭客户端连接
+// NewResourceClientWithConn 基于已建立的连接创建资源服务内部客户端
+//
+// 用于多个客户端共享连接（如 clients.Factory），连接由调用方管理，Close 不会关闭该连接
+//
+// 参数:
+//   - config: 客户端配置，nil 时使用默认配置
+//   - conn: gRPC 连接，实现了 GetState 和 WaitForStateChange 时（如 *grpc.ClientConn、
+//     clients.Factory 管理的连接）支持 OnStateChange
+func NewResourceClientWithConn(config *InternalConfig, conn grpc.ClientConnInterface) *ResourceClient {
+	if config == nil {
+		config = DefaultInternalConfig()
+	}
+
+	closeCtx, closeCancel := context.WithCancel(context.Background())
+	c := &ResourceClient{
+		config:     config,
+		client:     v1.NewResourceInternalServiceClient(conn),
+		health:     healthpb.NewHealthClient(conn),
+		sharedConn: true,
+		logger: log.NewHelper(log.With(
+			log.GetLogger(),
+			"module", "resource-internal-client",
+		)),
+		closeCtx:    closeCtx,
+		closeCancel: closeCancel,
+	}
+	if sc, ok := conn.(stateConn); ok {
+		c.state = sc
+	}
+	return c
+}
+
+// Close 关闭客户端连接，并停止 OnStateChange 注册的观察协程
 func (c *ResourceClient) Close() error {
-	if c.conn != nil {
+	if c.closeCancel != nil {
+		c.closeCancel()
+	}
+	if c.conn != nil && !c.sharedConn {
 		return c.conn.Close()
 	}
 	return nil
 }
 
+// EnableURLCache 开启文件URL的内存缓存
+//
+// 开启后 GetFileUrls（及基于它的方法）会优先读取缓存，缓存会在签名URL过期前
+// 按 SafetyMargin 提前失效，公开URL按 PublicTTL 缓存
+//
+// 参数:
+//   - config: 缓存配置，传 nil 使用默认配置
+//
+// 使用示例:
+//
+//	client, err := resource.NewResourceClientWithDiscovery(config, discovery)
+//	client.EnableURLCache(nil)
+//
+// 注意:
+//   - 应在客户端创建后、开始使用前调用
+func (c *ResourceClient) EnableURLCache(config *URLCacheConfig) *ResourceClient {
+	c.urlCache = newURLCache(config)
+	return c
+}
+
+// InvalidateURLCache 删除指定文件的URL缓存
+func (c *ResourceClient) InvalidateURLCache(fileIDs ...string) {
+	if c.urlCache != nil {
+		c.urlCache.invalidate(fileIDs)
+	}
+}
+
 // ========== 文件相关接口 ==========
 
 // GetFile 获取单个文件信息
@@ -147,9 +245,13 @@ func (c *ResourceClient) Close() error {
 // 返回:
 //   - *v1.InternalFileInfo: 文件信息
 //   - error: 错误信息
-func (c *ResourceClient) GetFile(ctx context.Context, tenantCode string, fileID string) (*v1.InternalFileInfo, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+func (c *ResourceClient) GetFile(ctx context.Context, tenantCode string, fileID string, callOpts ...CallOption) (*v1.InternalFileInfo, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
 
 	resp, err := c.client.InternalGetFile(ctx, &v1.InternalGetFileRequest{
 		TenantCode: tenantCode,
@@ -174,7 +276,12 @@ func (c *ResourceClient) GetFile(ctx context.Context, tenantCode string, fileID
 //   - map[string]*v1.InternalFileInfo: 文件ID到文件信息的映射
 //   - []string: 获取失败的文件ID列表
 //   - error: 错误信息
-func (c *ResourceClient) GetFiles(ctx context.Context, tenantCode string, fileIDs []string) (map[string]*v1.InternalFileInfo, []string, error) {
+func (c *ResourceClient) GetFiles(ctx context.Context, tenantCode string, fileIDs []string, callOpts ...CallOption) (map[string]*v1.InternalFileInfo, []string, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	if len(fileIDs) == 0 {
 		return make(map[string]*v1.InternalFileInfo), nil, nil
 	}
@@ -183,8 +290,7 @@ func (c *ResourceClient) GetFiles(ctx context.Context, tenantCode string, fileID
 		return nil, nil, fmt.Errorf("文件ID数量不能超过100个，当前: %d", len(fileIDs))
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
 
 	resp, err := c.client.InternalGetFiles(ctx, &v1.InternalGetFilesRequest{
 		TenantCode: tenantCode,
@@ -202,8 +308,12 @@ func (c *ResourceClient) GetFiles(ctx context.Context, tenantCode string, fileID
 type GetFileUrlsOptions struct {
 	// 是否包含变体URL（如缩略图）
 	IncludeVariants bool
+	// 只返回指定变体的URL（可选），设置后无需 IncludeVariants
+	VariantIDs []string
 	// URL有效期（秒），默认3600
 	ExpiresIn int64
+	// 分批并发数（仅 GetFileUrlsAll 使用），默认4
+	Concurrency int
 }
 
 // GetFileUrls 批量获取文件URL
@@ -220,34 +330,161 @@ type GetFileUrlsOptions struct {
 // 说明:
 //   - URL查询不需要租户隔离，支持平台级资源与租户资源混合使用
 //   - 租户隔离在下载时由其他接口处理
-func (c *ResourceClient) GetFileUrls(ctx context.Context, fileIDs []string, opts *GetFileUrlsOptions) (map[string]*v1.InternalFileUrlInfo, error) {
+func (c *ResourceClient) GetFileUrls(ctx context.Context, fileIDs []string, opts *GetFileUrlsOptions, callOpts ...CallOption) (map[string]*v1.InternalFileUrlInfo, error) {
 	if len(fileIDs) == 0 {
 		return make(map[string]*v1.InternalFileUrlInfo), nil
 	}
 
-	if len(fileIDs) > 100 {
+	if len(fileIDs) > MaxFileUrlsBatchSize {
 		return nil, fmt.Errorf("文件ID数量不能超过100个，当前: %d", len(fileIDs))
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
-
 	req := &v1.InternalGetFileUrlsRequest{
 		FileIds: fileIDs,
 	}
 
 	if opts != nil {
 		req.IncludeVariants = opts.IncludeVariants
+		req.VariantIds = opts.VariantIDs
 		req.ExpiresIn = opts.ExpiresIn
 	}
 
+	// 优先从缓存读取，只请求未命中的ID
+	var cached map[string]*v1.InternalFileUrlInfo
+	scope := urlCacheScope(opts)
+	if c.urlCache != nil {
+		cached, req.FileIds = c.urlCache.get(fileIDs, scope)
+		if len(req.FileIds) == 0 {
+			return cached, nil
+		}
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
 	resp, err := c.client.InternalGetFileUrls(ctx, req)
 	if err != nil {
-		c.logger.WithContext(ctx).Errorf("批量获取文件URL失败: count=%d, error=%v", len(fileIDs), err)
+		c.logger.WithContext(ctx).Errorf("批量获取文件URL失败: count=%d, error=%v", len(req.FileIds), err)
 		return nil, err
 	}
 
-	return resp.Results, nil
+	if c.urlCache == nil {
+		return resp.Results, nil
+	}
+
+	c.urlCache.set(resp.Results, scope)
+	for id, info := range resp.Results {
+		cached[id] = info
+	}
+
+	return cached, nil
+}
+
+// GetFileUrlsAll 批量获取文件URL（不限数量）
+//
+// 自动将文件ID去重并按每批100个拆分，以有限并发调用 GetFileUrls 后合并结果
+//
+// 参数:
+//   - ctx: 上下文
+//   - fileIDs: 文件ID列表（数量不限）
+//   - opts: 可选参数，Concurrency 控制并发批次数
+//
+// 返回:
+//   - map[string]*v1.InternalFileUrlInfo: 文件ID到URL信息的映射
+//   - error: 任一批次失败时返回第一个错误
+func (c *ResourceClient) GetFileUrlsAll(ctx context.Context, fileIDs []string, opts *GetFileUrlsOptions, callOpts ...CallOption) (map[string]*v1.InternalFileUrlInfo, error) {
+	batches := chunkIDs(uniqueIDs(fileIDs), MaxFileUrlsBatchSize)
+	if len(batches) <= 1 {
+		if len(batches) == 0 {
+			return make(map[string]*v1.InternalFileUrlInfo), nil
+		}
+		return c.GetFileUrls(ctx, batches[0], opts, callOpts...)
+	}
+
+	concurrency := DefaultBatchConcurrency
+	if opts != nil && opts.Concurrency > 0 {
+		concurrency = opts.Concurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		sem      = make(chan struct{}, concurrency)
+		merged   = make(map[string]*v1.InternalFileUrlInfo, len(fileIDs))
+	)
+
+	for _, batch := range batches {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(ids []string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results, err := c.GetFileUrls(ctx, ids, opts, callOpts...)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				return
+			}
+			for id, info := range results {
+				merged[id] = info
+			}
+		}(batch)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
+
+// uniqueIDs 去除空值和重复的ID，保持原有顺序
+func uniqueIDs(ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if id == "" {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
+
+// chunkIDs 将ID列表按指定大小拆分
+func chunkIDs(ids []string, size int) [][]string {
+	var batches [][]string
+	for size < len(ids) {
+		ids, batches = ids[size:], append(batches, ids[:size:size])
+	}
+	if len(ids) > 0 {
+		batches = append(batches, ids)
+	}
+	return batches
 }
 
 // GetFileUrl 获取单个文件URL（便捷方法）
@@ -259,8 +496,8 @@ func (c *ResourceClient) GetFileUrls(ctx context.Context, fileIDs []string, opts
 // 返回:
 //   - string: 文件URL
 //   - error: 错误信息
-func (c *ResourceClient) GetFileUrl(ctx context.Context, fileID string) (string, error) {
-	results, err := c.GetFileUrls(ctx, []string{fileID}, nil)
+func (c *ResourceClient) GetFileUrl(ctx context.Context, fileID string, callOpts ...CallOption) (string, error) {
+	results, err := c.GetFileUrls(ctx, []string{fileID}, nil, callOpts...)
 	if err != nil {
 		return "", err
 	}
@@ -277,6 +514,49 @@ func (c *ResourceClient) GetFileUrl(ctx context.Context, fileID string) (string,
 	return info.Url, nil
 }
 
+// GetFileVariantUrl 获取单个文件指定变体的URL（便捷方法）
+//
+// 参数:
+//   - ctx: 上下文
+//   - fileID: 文件ID
+//   - variantID: 变体ID，如 thumbnail_200x200
+//
+// 返回:
+//   - string: 变体URL
+//   - error: 文件或变体不存在时返回错误
+//
+// 使用示例:
+//
+//	url, err := client.GetFileVariantUrl(ctx, fileID, "thumbnail_200x200")
+func (c *ResourceClient) GetFileVariantUrl(ctx context.Context, fileID string, variantID string, callOpts ...CallOption) (string, error) {
+	if variantID == "" {
+		return "", fmt.Errorf("变体ID不能为空")
+	}
+
+	results, err := c.GetFileUrls(ctx, []string{fileID}, &GetFileUrlsOptions{
+		VariantIDs: []string{variantID},
+	}, callOpts...)
+	if err != nil {
+		return "", err
+	}
+
+	info, ok := results[fileID]
+	if !ok || !info.Success {
+		errMsg := "文件不存在"
+		if ok && info.Error != "" {
+			errMsg = info.Error
+		}
+		return "", fmt.Errorf("获取文件URL失败: %s", errMsg)
+	}
+
+	url, ok := info.VariantUrls[variantID]
+	if !ok || url == "" {
+		return "", fmt.Errorf("获取变体URL失败: 变体不存在, variant_id=%s", variantID)
+	}
+
+	return url, nil
+}
+
 // DownloadFileRequest 下载文件请求
 type DownloadFileRequest struct {
 	// 文件ID（必填）
@@ -298,7 +578,12 @@ type DownloadFileRequest struct {
 // 返回:
 //   - map[string]*v1.InternalFileDownloadInfo: 文件ID到下载信息的映射
 //   - error: 错误信息
-func (c *ResourceClient) GetDownloadUrls(ctx context.Context, tenantCode string, files []DownloadFileRequest, expiresIn int64) (map[string]*v1.InternalFileDownloadInfo, error) {
+func (c *ResourceClient) GetDownloadUrls(ctx context.Context, tenantCode string, files []DownloadFileRequest, expiresIn int64, callOpts ...CallOption) (map[string]*v1.InternalFileDownloadInfo, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
 	if len(files) == 0 {
 		return make(map[string]*v1.InternalFileDownloadInfo), nil
 	}
@@ -307,8 +592,7 @@ func (c *ResourceClient) GetDownloadUrls(ctx context.Context, tenantCode string,
 		return nil, fmt.Errorf("文件数量不能超过50个，当前: %d", len(files))
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
 
 	// 转换请求
 	protoFiles := make([]*v1.InternalFileDownloadRequest, len(files))
@@ -343,8 +627,8 @@ func (c *ResourceClient) GetDownloadUrls(ctx context.Context, tenantCode string,
 // 返回:
 //   - string: 下载URL
 //   - error: 错误信息
-func (c *ResourceClient) GetDownloadUrl(ctx context.Context, tenantCode string, fileID string) (string, error) {
-	results, err := c.GetDownloadUrls(ctx, tenantCode, []DownloadFileRequest{{FileID: fileID}}, 3600)
+func (c *ResourceClient) GetDownloadUrl(ctx context.Context, tenantCode string, fileID string, callOpts ...CallOption) (string, error) {
+	results, err := c.GetDownloadUrls(ctx, tenantCode, []DownloadFileRequest{{FileID: fileID}}, DefaultURLExpiresIn, callOpts...)
 	if err != nil {
 		return "", err
 	}
@@ -373,9 +657,13 @@ func (c *ResourceClient) GetDownloadUrl(ctx context.Context, tenantCode string,
 //   - bool: 文件是否存在
 //   - *v1.InternalFileInfo: 已存在的文件信息（如果存在）
 //   - error: 错误信息
-func (c *ResourceClient) CheckFileExists(ctx context.Context, tenantCode string, checksumSHA256 string, size int64) (bool, *v1.InternalFileInfo, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+func (c *ResourceClient) CheckFileExists(ctx context.Context, tenantCode string, checksumSHA256 string, size int64, callOpts ...CallOption) (bool, *v1.InternalFileInfo, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return false, nil, err
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
 
 	resp, err := c.client.InternalCheckFileExists(ctx, &v1.InternalCheckFileExistsRequest{
 		TenantCode:     tenantCode,
@@ -390,6 +678,416 @@ func (c *ResourceClient) CheckFileExists(ctx context.Context, tenantCode string,
 	return resp.Exists, resp.File, nil
 }
 
+// ChecksumSize 校验和检查项
+type ChecksumSize struct {
+	// SHA256校验和（必填）
+	ChecksumSHA256 string
+	// 文件大小（字节，可选但推荐）
+	Size int64
+}
+
+// CheckFilesExist 批量检查文件是否存在（批量导入去重）
+//
+// 自动按校验和去重并按每批100个拆分请求
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantCode: 租户ID
+//   - items: 检查项列表（数量不限）
+//
+// 返回:
+//   - map[string]*v1.InternalFileInfo: 已存在的文件（校验和 -> 文件信息），不存在的校验和不在结果中
+//   - error: 任一批次失败时返回错误
+//
+// 使用示例:
+//
+//	existing, err := client.CheckFilesExist(ctx, tenantCode, []resource.ChecksumSize{
+//	    {ChecksumSHA256: sum1, Size: 1024},
+//	    {ChecksumSHA256: sum2, Size: 2048},
+//	})
+//	if file, ok := existing[sum1]; ok {
+//	    // 复用已有文件
+//	}
+func (c *ResourceClient) CheckFilesExist(ctx context.Context, tenantCode string, items []ChecksumSize, callOpts ...CallOption) (map[string]*v1.InternalFileInfo, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*v1.InternalFileInfo)
+
+	seen := make(map[string]struct{}, len(items))
+	protoItems := make([]*v1.InternalChecksumItem, 0, len(items))
+	for _, item := range items {
+		if item.ChecksumSHA256 == "" {
+			continue
+		}
+		if _, ok := seen[item.ChecksumSHA256]; ok {
+			continue
+		}
+		seen[item.ChecksumSHA256] = struct{}{}
+		protoItems = append(protoItems, &v1.InternalChecksumItem{
+			ChecksumSha256: item.ChecksumSHA256,
+			Size:           item.Size,
+		})
+	}
+
+	for start := 0; start < len(protoItems); start += MaxCheckFilesExistBatchSize {
+		end := min(start+MaxCheckFilesExistBatchSize, len(protoItems))
+
+		files, err := c.checkFilesExist(ctx, tenantCode, protoItems[start:end], callOpts)
+		if err != nil {
+			return nil, err
+		}
+		for checksum, file := range files {
+			result[checksum] = file
+		}
+	}
+
+	return result, nil
+}
+
+// checkFilesExist 单批次检查文件是否存在
+func (c *ResourceClient) checkFilesExist(ctx context.Context, tenantCode string, items []*v1.InternalChecksumItem, callOpts []CallOption) (map[string]*v1.InternalFileInfo, error) {
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	resp, err := c.client.InternalCheckFilesExist(ctx, &v1.InternalCheckFilesExistRequest{
+		TenantCode: tenantCode,
+		Items:      items,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("批量检查文件是否存在失败: tenant_code=%s, count=%d, error=%v", tenantCode, len(items), err)
+		return nil, err
+	}
+
+	return resp.Files, nil
+}
+
+// CopyOptions 复制文件的选项
+type CopyOptions struct {
+	// 新文件名（可选，默认沿用源文件名）
+	Filename string
+	// 是否同时复制变体（如缩略图）
+	IncludeVariants bool
+}
+
+// CopyFile 复制文件到目标租户
+//
+// 参数:
+//   - ctx: 上下文
+//   - srcTenantCode: 源租户ID
+//   - fileID: 源文件ID
+//   - dstTenantCode: 目标租户ID
+//   - opts: 可选参数
+//
+// 返回:
+//   - string: 新文件ID
+//   - error: 错误信息
+//
+// 使用场景:
+//   - 克隆店铺模板时将平台模板图片复制到商户租户的存储桶
+//
+// 注意:
+//   - 复制会占用目标租户的存储配额
+func (c *ResourceClient) CopyFile(ctx context.Context, srcTenantCode string, fileID string, dstTenantCode string, opts *CopyOptions, callOpts ...CallOption) (string, error) {
+	srcTenantCode, err := c.resolveTenant(ctx, srcTenantCode)
+	if err != nil {
+		return "", err
+	}
+
+	if fileID == "" {
+		return "", fmt.Errorf("文件ID不能为空")
+	}
+	if dstTenantCode == "" {
+		return "", fmt.Errorf("目标租户ID不能为空")
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	req := &v1.InternalCopyFileRequest{
+		SrcTenantCode: srcTenantCode,
+		FileId:        fileID,
+		DstTenantCode: dstTenantCode,
+	}
+
+	if opts != nil {
+		req.Filename = opts.Filename
+		req.IncludeVariants = opts.IncludeVariants
+	}
+
+	resp, err := c.client.InternalCopyFile(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("复制文件失败: src_tenant=%s, file_id=%s, dst_tenant=%s, error=%v", srcTenantCode, fileID, dstTenantCode, err)
+		return "", err
+	}
+
+	return resp.FileId, nil
+}
+
+// UpdateFileMetadataOptions 更新文件元数据的选项
+//
+// 为 nil 的字段保持不变
+type UpdateFileMetadataOptions struct {
+	// 显示文件名
+	Filename *string
+	// 标签列表（非 nil 时整体替换，空切片表示清空）
+	Tags []string
+	// 是否公开访问
+	IsPublic *bool
+}
+
+// UpdateFileMetadata 更新文件元数据（重命名、标签、可见性）
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantCode: 租户ID
+//   - fileID: 文件ID
+//   - opts: 需要更新的字段
+//
+// 返回:
+//   - *v1.InternalFileInfo: 更新后的文件信息
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	isPublic := true
+//	file, err := client.UpdateFileMetadata(ctx, tenantCode, fileID, &resource.UpdateFileMetadataOptions{
+//	    IsPublic: &isPublic,
+//	})
+func (c *ResourceClient) UpdateFileMetadata(ctx context.Context, tenantCode string, fileID string, opts *UpdateFileMetadataOptions, callOpts ...CallOption) (*v1.InternalFileInfo, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if fileID == "" {
+		return nil, fmt.Errorf("文件ID不能为空")
+	}
+	if opts == nil {
+		return nil, fmt.Errorf("更新选项不能为空")
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	req := &v1.InternalUpdateFileMetadataRequest{
+		TenantCode: tenantCode,
+		FileId:     fileID,
+		Filename:   opts.Filename,
+		IsPublic:   opts.IsPublic,
+	}
+	if opts.Tags != nil {
+		req.Tags = opts.Tags
+		req.UpdateTags = true
+	}
+
+	resp, err := c.client.InternalUpdateFileMetadata(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("更新文件元数据失败: tenant_code=%s, file_id=%s, error=%v", tenantCode, fileID, err)
+		return nil, err
+	}
+
+	// 可见性变化会影响URL，清理缓存
+	c.InvalidateURLCache(fileID)
+
+	return resp.File, nil
+}
+
+// ========== 变体相关接口 ==========
+
+// VariantType 变体类型
+type VariantType string
+
+const (
+	VariantTypeThumbnail VariantType = "thumbnail" // 缩略图
+	VariantTypeResize    VariantType = "resize"    // 缩放
+	VariantTypeWatermark VariantType = "watermark" // 水印
+)
+
+// VariantStatus 变体生成状态
+const (
+	VariantStatusPending    = "pending"    // 等待处理
+	VariantStatusProcessing = "processing" // 处理中
+	VariantStatusCompleted  = "completed"  // 已完成
+	VariantStatusFailed     = "failed"     // 失败
+)
+
+// VariantSpec 变体规格
+type VariantSpec struct {
+	// 变体ID（必填），如 thumbnail_200x200
+	VariantID string
+	// 变体类型（必填）
+	Type VariantType
+	// 目标宽度（像素）
+	Width int32
+	// 目标高度（像素）
+	Height int32
+	// 输出格式：jpeg, png, webp，默认与原图一致
+	Format string
+	// 输出质量（1-100），默认85
+	Quality int32
+	// 水印文字（Type=watermark时可选）
+	WatermarkText string
+	// 水印图片文件ID（Type=watermark时可选）
+	WatermarkFileID string
+}
+
+// CreateVariants 按需生成文件变体
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantCode: 租户ID
+//   - fileID: 文件ID
+//   - specs: 变体规格列表（最多20个）
+//
+// 返回:
+//   - []*v1.InternalVariantStatus: 各变体的当前状态
+//   - error: 错误信息
+//
+// 说明:
+//   - 变体为异步生成，可通过 GetVariantStatus 或 WaitForVariants 等待完成
+func (c *ResourceClient) CreateVariants(ctx context.Context, tenantCode string, fileID string, specs []VariantSpec, callOpts ...CallOption) ([]*v1.InternalVariantStatus, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("变体规格不能为空")
+	}
+
+	if len(specs) > 20 {
+		return nil, fmt.Errorf("变体规格数量不能超过20个，当前: %d", len(specs))
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	protoSpecs := make([]*v1.InternalVariantSpec, len(specs))
+	for i, s := range specs {
+		protoSpecs[i] = &v1.InternalVariantSpec{
+			VariantId:       s.VariantID,
+			Type:            string(s.Type),
+			Width:           s.Width,
+			Height:          s.Height,
+			Format:          s.Format,
+			Quality:         s.Quality,
+			WatermarkText:   s.WatermarkText,
+			WatermarkFileId: s.WatermarkFileID,
+		}
+	}
+
+	resp, err := c.client.InternalCreateVariants(ctx, &v1.InternalCreateVariantsRequest{
+		TenantCode: tenantCode,
+		FileId:     fileID,
+		Specs:      protoSpecs,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("生成文件变体失败: tenant_code=%s, file_id=%s, count=%d, error=%v", tenantCode, fileID, len(specs), err)
+		return nil, err
+	}
+
+	return resp.Variants, nil
+}
+
+// GetVariantStatus 查询文件变体生成状态
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantCode: 租户ID
+//   - fileID: 文件ID
+//   - variantIDs: 变体ID列表（为空时返回全部变体）
+//
+// 返回:
+//   - []*v1.InternalVariantStatus: 各变体的当前状态
+//   - error: 错误信息
+func (c *ResourceClient) GetVariantStatus(ctx context.Context, tenantCode string, fileID string, variantIDs []string, callOpts ...CallOption) ([]*v1.InternalVariantStatus, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	resp, err := c.client.InternalGetVariantStatus(ctx, &v1.InternalGetVariantStatusRequest{
+		TenantCode: tenantCode,
+		FileId:     fileID,
+		VariantIds: variantIDs,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("查询文件变体状态失败: tenant_code=%s, file_id=%s, error=%v", tenantCode, fileID, err)
+		return nil, err
+	}
+
+	return resp.Variants, nil
+}
+
+// WaitForVariants 轮询等待变体生成结束
+//
+// 参数:
+//   - ctx: 上下文（用于控制总等待时间）
+//   - tenantCode: 租户ID
+//   - fileID: 文件ID
+//   - variantIDs: 变体ID列表（为空时等待全部变体）
+//   - interval: 轮询间隔，<=0 时默认1秒
+//
+// 返回:
+//   - []*v1.InternalVariantStatus: 全部变体结束（completed 或 failed）时的状态
+//   - error: 查询失败或 ctx 结束时的错误
+//
+// 说明:
+//   - 查询结果为空或缺少 variantIDs 中的变体时视为尚未结束，继续等待直到 ctx 结束
+//
+// 使用示例:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+//	defer cancel()
+//	variants, err := client.WaitForVariants(ctx, tenantCode, fileID, []string{"thumbnail_200x200"}, time.Second)
+func (c *ResourceClient) WaitForVariants(ctx context.Context, tenantCode string, fileID string, variantIDs []string, interval time.Duration, callOpts ...CallOption) ([]*v1.InternalVariantStatus, error) {
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		variants, err := c.GetVariantStatus(ctx, tenantCode, fileID, variantIDs, callOpts...)
+		if err != nil {
+			return nil, err
+		}
+
+		if variantsFinished(variants, variantIDs) {
+			return variants, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return variants, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// variantsFinished 判断变体是否全部结束，没有变体或缺少 variantIDs 中的变体时视为未结束
+func variantsFinished(variants []*v1.InternalVariantStatus, variantIDs []string) bool {
+	if len(variants) == 0 {
+		return false
+	}
+
+	finished := make(map[string]bool, len(variants))
+	for _, v := range variants {
+		if v.Status != VariantStatusCompleted && v.Status != VariantStatusFailed {
+			return false
+		}
+		finished[v.VariantId] = true
+	}
+	for _, id := range variantIDs {
+		if !finished[id] {
+			return false
+		}
+	}
+	return true
+}
+
 // ========== 配额相关接口 ==========
 
 // GetQuota 获取租户配额信息
@@ -401,9 +1099,13 @@ func (c *ResourceClient) CheckFileExists(ctx context.Context, tenantCode string,
 // 返回:
 //   - *v1.InternalQuotaInfo: 配额信息
 //   - error: 错误信息
-func (c *ResourceClient) GetQuota(ctx context.Context, tenantCode string) (*v1.InternalQuotaInfo, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+func (c *ResourceClient) GetQuota(ctx context.Context, tenantCode string, callOpts ...CallOption) (*v1.InternalQuotaInfo, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
 
 	resp, err := c.client.InternalGetQuota(ctx, &v1.InternalGetQuotaRequest{
 		TenantCode: tenantCode,
@@ -446,9 +1148,13 @@ type CheckQuotaResult struct {
 // 返回:
 //   - *CheckQuotaResult: 检查结果
 //   - error: 错误信息
-func (c *ResourceClient) CheckQuota(ctx context.Context, tenantCode string, checkType CheckQuotaType, size int64) (*CheckQuotaResult, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+func (c *ResourceClient) CheckQuota(ctx context.Context, tenantCode string, checkType CheckQuotaType, size int64, callOpts ...CallOption) (*CheckQuotaResult, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
 
 	resp, err := c.client.InternalCheckQuota(ctx, &v1.InternalCheckQuotaRequest{
 		TenantCode: tenantCode,
@@ -485,6 +1191,18 @@ type InitTenantResult struct {
 	Message string
 	// 错误信息（失败时）
 	Error string
+	// 租户此前已完成初始化（幂等返回）
+	AlreadyInitialized bool
+}
+
+// InitTenantOptions 初始化租户的选项
+type InitTenantOptions struct {
+	// 存储区域（可选，默认"sea"），可选值: cn|sea|us|eu
+	Region string
+	// 幂等键（可选），相同幂等键的重复请求返回首次初始化的结果
+	IdempotencyKey string
+	// 租户已初始化时是否视为成功（可选）
+	AllowExisting bool
 }
 
 // InitTenant 初始化租户资源
@@ -507,27 +1225,129 @@ type InitTenantResult struct {
 //
 // 注意:
 //   - 一个租户只能初始化一次
-//   - 重复调用会返回错误
-func (c *ResourceClient) InitTenant(ctx context.Context, tenantCode string, region string) (*InitTenantResult, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+//   - 重复调用会返回错误，需要重试时使用 InitTenantWithOptions
+func (c *ResourceClient) InitTenant(ctx context.Context, tenantCode string, region string, callOpts ...CallOption) (*InitTenantResult, error) {
+	return c.InitTenantWithOptions(ctx, tenantCode, &InitTenantOptions{Region: region}, callOpts...)
+}
+
+// InitTenantWithOptions 初始化租户资源（支持幂等重试）
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantCode: 租户ID
+//   - opts: 可选参数
+//
+// 返回:
+//   - *InitTenantResult: 初始化结果，幂等返回时 AlreadyInitialized=true
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	// 开通流程可安全重试
+//	result, err := client.InitTenantWithOptions(ctx, tenantCode, &resource.InitTenantOptions{
+//	    Region:         "cn",
+//	    IdempotencyKey: provisioningID,
+//	    AllowExisting:  true,
+//	})
+func (c *ResourceClient) InitTenantWithOptions(ctx context.Context, tenantCode string, opts *InitTenantOptions, callOpts ...CallOption) (*InitTenantResult, error) {
+	if opts == nil {
+		opts = &InitTenantOptions{}
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
 
 	resp, err := c.client.InternalInitTenant(ctx, &v1.InternalInitTenantRequest{
-		TenantCode: tenantCode,
-		Region:     region,
+		TenantCode:     tenantCode,
+		Region:         opts.Region,
+		IdempotencyKey: opts.IdempotencyKey,
+		AllowExisting:  opts.AllowExisting,
 	})
 	if err != nil {
-		c.logger.WithContext(ctx).Errorf("初始化租户失败: tenant_id=%d, region=%s, error=%v", tenantCode, region, err)
+		c.logger.WithContext(ctx).Errorf("初始化租户失败: tenant_id=%d, region=%s, error=%v", tenantCode, opts.Region, err)
 		return nil, err
 	}
 
 	return &InitTenantResult{
-		Success:        resp.Success,
+		Success:            resp.Success,
+		BucketID:           resp.BucketId,
+		BucketName:         resp.BucketName,
+		StorageQuota:       resp.StorageQuota,
+		FileCountQuota:     resp.FileCountQuota,
+		Message:            resp.Message,
+		Error:              resp.Error,
+		AlreadyInitialized: resp.AlreadyInitialized,
+	}, nil
+}
+
+// TenantInitStatus 租户初始化状态
+const (
+	TenantInitStatusNotInitialized = "not_initialized" // 未初始化
+	TenantInitStatusInitializing   = "initializing"    // 初始化中
+	TenantInitStatusInitialized    = "initialized"     // 已初始化
+	TenantInitStatusFailed         = "failed"          // 初始化失败
+)
+
+// TenantInitStatus 租户初始化状态信息
+type TenantInitStatus struct {
+	// 初始化状态（TenantInitStatus* 常量）
+	Status string
+	// 存储区域
+	Region string
+	// 存储桶ID
+	BucketID string
+	// 存储桶名称
+	BucketName string
+	// 存储配额（字节）
+	StorageQuota int64
+	// 文件数配额
+	FileCountQuota int64
+	// 初始化完成时间（未完成时为零值）
+	InitializedAt time.Time
+	// 失败原因
+	Error string
+}
+
+// Initialized 是否已完成初始化
+func (s *TenantInitStatus) Initialized() bool {
+	return s.Status == TenantInitStatusInitialized
+}
+
+// GetTenantInitStatus 查询租户初始化状态
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantCode: 租户ID
+//
+// 返回:
+//   - *TenantInitStatus: 初始化状态
+//   - error: 错误信息
+//
+// 使用场景:
+//   - 开通流程重试前判断是否需要再次初始化
+//   - 对账任务找出初始化失败的租户
+func (c *ResourceClient) GetTenantInitStatus(ctx context.Context, tenantCode string, callOpts ...CallOption) (*TenantInitStatus, error) {
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	resp, err := c.client.InternalGetTenantInitStatus(ctx, &v1.InternalGetTenantInitStatusRequest{
+		TenantCode: tenantCode,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("查询租户初始化状态失败: tenant_code=%s, error=%v", tenantCode, err)
+		return nil, err
+	}
+
+	status := &TenantInitStatus{
+		Status:         resp.Status,
+		Region:         resp.Region,
 		BucketID:       resp.BucketId,
 		BucketName:     resp.BucketName,
 		StorageQuota:   resp.StorageQuota,
 		FileCountQuota: resp.FileCountQuota,
-		Message:        resp.Message,
 		Error:          resp.Error,
-	}, nil
+	}
+	if resp.InitializedAt != nil {
+		status.InitializedAt = resp.InitializedAt.AsTime()
+	}
+
+	return status, nil
 }
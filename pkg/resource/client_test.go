@@ -0,0 +1,248 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// mockInternalClient 测试用的资源服务 gRPC 客户端
+type mockInternalClient struct {
+	v1.ResourceInternalServiceClient
+
+	mu       sync.Mutex
+	urlCalls [][]string
+	failOn   string
+}
+
+func (m *mockInternalClient) InternalGetFileUrls(ctx context.Context, in *v1.InternalGetFileUrlsRequest, opts ...grpc.CallOption) (*v1.InternalGetFileUrlsResponse, error) {
+	m.mu.Lock()
+	m.urlCalls = append(m.urlCalls, in.FileIds)
+	m.mu.Unlock()
+
+	results := make(map[string]*v1.InternalFileUrlInfo, len(in.FileIds))
+	for _, id := range in.FileIds {
+		if id == m.failOn {
+			return nil, fmt.Errorf("mock error")
+		}
+		results[id] = &v1.InternalFileUrlInfo{Url: "https://cdn.example.com/" + id, Success: true}
+	}
+	return &v1.InternalGetFileUrlsResponse{Results: results}, nil
+}
+
+func newTestClient(mock v1.ResourceInternalServiceClient) *ResourceClient {
+	return &ResourceClient{
+		config: DefaultInternalConfig(),
+		client: mock,
+		logger: log.NewHelper(log.DefaultLogger),
+	}
+}
+
+func makeIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("file_%d", i)
+	}
+	return ids
+}
+
+func TestGetFileUrlsAll(t *testing.T) {
+	mock := &mockInternalClient{}
+	client := newTestClient(mock)
+
+	ids := makeIDs(250)
+	// 重复ID应被去重
+	ids = append(ids, "file_0", "file_1")
+
+	results, err := client.GetFileUrlsAll(context.Background(), ids, &GetFileUrlsOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatalf("GetFileUrlsAll failed: %v", err)
+	}
+
+	if len(results) != 250 {
+		t.Errorf("Expected 250 results, got %d", len(results))
+	}
+	if len(mock.urlCalls) != 3 {
+		t.Errorf("Expected 3 batches, got %d", len(mock.urlCalls))
+	}
+	for _, call := range mock.urlCalls {
+		if len(call) > MaxFileUrlsBatchSize {
+			t.Errorf("Batch size %d exceeds limit", len(call))
+		}
+	}
+	if results["file_249"].Url != "https://cdn.example.com/file_249" {
+		t.Errorf("Unexpected url: %s", results["file_249"].Url)
+	}
+}
+
+func TestGetFileUrlsAll_Empty(t *testing.T) {
+	mock := &mockInternalClient{}
+	client := newTestClient(mock)
+
+	results, err := client.GetFileUrlsAll(context.Background(), nil, nil)
+	if err != nil {
+		t.Fatalf("GetFileUrlsAll failed: %v", err)
+	}
+	if len(results) != 0 || len(mock.urlCalls) != 0 {
+		t.Errorf("Expected no results and no calls, got %d results, %d calls", len(results), len(mock.urlCalls))
+	}
+}
+
+func TestGetFileUrlsAll_Error(t *testing.T) {
+	mock := &mockInternalClient{failOn: "file_150"}
+	client := newTestClient(mock)
+
+	_, err := client.GetFileUrlsAll(context.Background(), makeIDs(300), nil)
+	if err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+}
+
+// mockInitTenantClient 记录初始化租户请求
+type mockInitTenantClient struct {
+	v1.ResourceInternalServiceClient
+	req *v1.InternalInitTenantRequest
+}
+
+func (m *mockInitTenantClient) InternalInitTenant(ctx context.Context, in *v1.InternalInitTenantRequest, opts ...grpc.CallOption) (*v1.InternalInitTenantResponse, error) {
+	m.req = in
+	return &v1.InternalInitTenantResponse{Success: true, AlreadyInitialized: in.AllowExisting}, nil
+}
+
+func TestInitTenantWithOptions(t *testing.T) {
+	mock := &mockInitTenantClient{}
+	client := newTestClient(mock)
+
+	result, err := client.InitTenantWithOptions(context.Background(), "T001", &InitTenantOptions{
+		Region:         "cn",
+		IdempotencyKey: "provision-1",
+		AllowExisting:  true,
+	})
+	if err != nil {
+		t.Fatalf("InitTenantWithOptions failed: %v", err)
+	}
+	if !result.AlreadyInitialized {
+		t.Error("Expected AlreadyInitialized")
+	}
+	if mock.req.Region != "cn" || mock.req.IdempotencyKey != "provision-1" || !mock.req.AllowExisting {
+		t.Errorf("Unexpected request: %v", mock.req)
+	}
+}
+
+// mockCheckFilesClient 记录批量检查请求，偶数序号的校验和视为已存在
+type mockCheckFilesClient struct {
+	v1.ResourceInternalServiceClient
+	batches [][]*v1.InternalChecksumItem
+}
+
+func (m *mockCheckFilesClient) InternalCheckFilesExist(ctx context.Context, in *v1.InternalCheckFilesExistRequest, opts ...grpc.CallOption) (*v1.InternalCheckFilesExistResponse, error) {
+	m.batches = append(m.batches, in.Items)
+
+	files := make(map[string]*v1.InternalFileInfo)
+	for _, item := range in.Items {
+		var n int
+		fmt.Sscanf(item.ChecksumSha256, "file_%d", &n)
+		if n%2 == 0 {
+			files[item.ChecksumSha256] = &v1.InternalFileInfo{Id: "id_" + item.ChecksumSha256}
+		}
+	}
+	return &v1.InternalCheckFilesExistResponse{Files: files}, nil
+}
+
+func TestCheckFilesExist(t *testing.T) {
+	mock := &mockCheckFilesClient{}
+	client := newTestClient(mock)
+
+	var items []ChecksumSize
+	for _, id := range makeIDs(230) {
+		items = append(items, ChecksumSize{ChecksumSHA256: id})
+	}
+	items = append(items, ChecksumSize{ChecksumSHA256: "file_0"}, ChecksumSize{})
+
+	result, err := client.CheckFilesExist(context.Background(), "T001", items)
+	if err != nil {
+		t.Fatalf("CheckFilesExist failed: %v", err)
+	}
+	if len(mock.batches) != 3 {
+		t.Errorf("Expected 3 batches, got %d", len(mock.batches))
+	}
+	if len(result) != 115 {
+		t.Errorf("Expected 115 existing files, got %d", len(result))
+	}
+	if result["file_228"].Id != "id_file_228" {
+		t.Errorf("Unexpected file: %v", result["file_228"])
+	}
+}
+
+func TestVariantsFinished(t *testing.T) {
+	done := []*v1.InternalVariantStatus{
+		{VariantId: "thumb", Status: VariantStatusCompleted},
+		{VariantId: "large", Status: VariantStatusFailed},
+	}
+	tests := []struct {
+		name       string
+		variants   []*v1.InternalVariantStatus
+		variantIDs []string
+		want       bool
+	}{
+		{"all finished", done, []string{"thumb", "large"}, true},
+		{"all variants", done, nil, true},
+		{"empty", nil, nil, false},
+		{"missing requested", done, []string{"thumb", "webp"}, false},
+		{"processing", []*v1.InternalVariantStatus{{VariantId: "thumb", Status: VariantStatusProcessing}}, nil, false},
+	}
+	for _, tt := range tests {
+		if got := variantsFinished(tt.variants, tt.variantIDs); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+}
+
+// idleConn 状态始终为 Idle 的连接，WaitForStateChange 阻塞到 ctx 结束
+type idleConn struct {
+	grpc.ClientConnInterface
+	waiting chan struct{}
+	done    chan struct{}
+}
+
+func (c *idleConn) GetState() connectivity.State {
+	return connectivity.Idle
+}
+
+func (c *idleConn) WaitForStateChange(ctx context.Context, sourceState connectivity.State) bool {
+	close(c.waiting)
+	<-ctx.Done()
+	close(c.done)
+	return false
+}
+
+func TestOnStateChange_StopOnClose(t *testing.T) {
+	conn := &idleConn{waiting: make(chan struct{}), done: make(chan struct{})}
+	client := NewResourceClientWithConn(nil, conn)
+
+	states := make(chan connectivity.State, 1)
+	client.OnStateChange(func(state connectivity.State) {
+		states <- state
+	})
+	if state := <-states; state != connectivity.Idle {
+		t.Errorf("Expected initial Idle state, got %v", state)
+	}
+	<-conn.waiting
+
+	// 共享连接不会 Shutdown，Close 后观察协程应退出
+	if err := client.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	select {
+	case <-conn.done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected watcher to stop after Close")
+	}
+}
@@ -10,6 +10,15 @@ const (
 
 	// DefaultURLExpiresIn 默认URL过期时间（秒）
 	DefaultURLExpiresIn = 3600
+
+	// MaxFileUrlsBatchSize 单次获取文件URL的最大ID数量
+	MaxFileUrlsBatchSize = 100
+
+	// MaxCheckFilesExistBatchSize 单次批量检查文件存在的最大数量
+	MaxCheckFilesExistBatchSize = 100
+
+	// DefaultBatchConcurrency 分批请求的默认并发数
+	DefaultBatchConcurrency = 4
 )
 
 // InternalConfig 资源内部服务客户端配置
@@ -0,0 +1,195 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultDownloadMaxRetries 下载中断时的默认最大重试次数
+	DefaultDownloadMaxRetries = 3
+
+	// DefaultDownloadRetryBackoff 下载重试的默认退避时间
+	DefaultDownloadRetryBackoff = 500 * time.Millisecond
+)
+
+// DownloadOptions 流式下载选项
+type DownloadOptions struct {
+	// 要下载的变体ID（可选）
+	VariantID string
+	// 下载URL有效期（秒），默认3600
+	ExpiresIn int64
+	// 下载中断时的最大重试次数，默认3，<0 表示不重试
+	MaxRetries int
+	// 重试退避时间，默认500ms
+	RetryBackoff time.Duration
+	// 自定义 HTTP 客户端（可选），默认 http.DefaultClient
+	HTTPClient *http.Client
+}
+
+// writeError 写入目标 Writer 失败的错误（不可重试）
+type writeError struct {
+	err error
+}
+
+func (e *writeError) Error() string { return e.err.Error() }
+func (e *writeError) Unwrap() error { return e.err }
+
+// countingWriter 记录已写入字节数，并区分写入错误
+type countingWriter struct {
+	w       io.Writer
+	written int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.written += int64(n)
+	if err != nil {
+		return n, &writeError{err: err}
+	}
+	return n, nil
+}
+
+// DownloadFile 下载文件并流式写入 w
+//
+// 先获取预签名下载URL，再通过 HTTP 流式读取文件内容；传输中断时使用 Range 请求断点续传
+//
+// 参数:
+//   - ctx: 上下文（控制整个下载过程）
+//   - tenantCode: 租户ID
+//   - fileID: 文件ID
+//   - w: 写入目标
+//   - opts: 可选参数
+//   - callOpts: 获取下载URL时的调用选项
+//
+// 返回:
+//   - int64: 写入的字节数
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	f, _ := os.Create("/tmp/export.xlsx")
+//	defer f.Close()
+//	n, err := client.DownloadFile(ctx, tenantCode, fileID, f, nil)
+func (c *ResourceClient) DownloadFile(ctx context.Context, tenantCode string, fileID string, w io.Writer, opts *DownloadOptions, callOpts ...CallOption) (int64, error) {
+	if opts == nil {
+		opts = &DownloadOptions{}
+	}
+	maxRetries := opts.MaxRetries
+	if maxRetries == 0 {
+		maxRetries = DefaultDownloadMaxRetries
+	}
+	backoff := opts.RetryBackoff
+	if backoff <= 0 {
+		backoff = DefaultDownloadRetryBackoff
+	}
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	expiresIn := opts.ExpiresIn
+	if expiresIn <= 0 {
+		expiresIn = DefaultURLExpiresIn
+	}
+
+	results, err := c.GetDownloadUrls(ctx, tenantCode, []DownloadFileRequest{{
+		FileID:    fileID,
+		VariantID: opts.VariantID,
+	}}, expiresIn, callOpts...)
+	if err != nil {
+		return 0, err
+	}
+
+	info, ok := results[fileID]
+	if !ok || !info.Success {
+		errMsg := "文件不存在"
+		if ok && info.Error != "" {
+			errMsg = info.Error
+		}
+		return 0, fmt.Errorf("获取下载URL失败: %s", errMsg)
+	}
+
+	cw := &countingWriter{w: w}
+	for attempt := 0; ; attempt++ {
+		err = fetchRange(ctx, httpClient, info.DownloadUrl, cw)
+		if err == nil {
+			return cw.written, nil
+		}
+
+		var we *writeError
+		if errors.As(err, &we) || !isRetryableDownload(err) || attempt >= maxRetries || ctx.Err() != nil {
+			c.logger.WithContext(ctx).Errorf("下载文件失败: tenant_code=%s, file_id=%s, written=%d, error=%v", tenantCode, fileID, cw.written, err)
+			return cw.written, err
+		}
+
+		c.logger.WithContext(ctx).Warnf("下载文件中断，准备重试: file_id=%s, written=%d, attempt=%d, error=%v", fileID, cw.written, attempt+1, err)
+
+		select {
+		case <-ctx.Done():
+			return cw.written, ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
+}
+
+// httpStatusError 非预期的 HTTP 状态码
+type httpStatusError struct {
+	code int
+}
+
+func (e *httpStatusError) Error() string {
+	return fmt.Sprintf("下载请求返回非预期状态码: %d", e.code)
+}
+
+// isRetryableDownload 判断下载错误是否可以重试
+func isRetryableDownload(err error) bool {
+	var se *httpStatusError
+	if errors.As(err, &se) {
+		return se.code >= 500 || se.code == http.StatusTooManyRequests || se.code == http.StatusRequestTimeout
+	}
+	return true
+}
+
+// fetchRange 从已写入位置开始读取文件内容
+func fetchRange(ctx context.Context, httpClient *http.Client, url string, cw *countingWriter) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	if cw.written > 0 {
+		req.Header.Set("Range", "bytes="+strconv.FormatInt(cw.written, 10)+"-")
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// 服务端不支持 Range 时返回完整内容，跳过已写入部分
+		if cw.written > 0 {
+			if _, err := io.CopyN(io.Discard, resp.Body, cw.written); err != nil {
+				return err
+			}
+		}
+	case http.StatusPartialContent:
+	case http.StatusRequestedRangeNotSatisfiable:
+		// 已全部写入
+		if cw.written > 0 {
+			return nil
+		}
+		return &httpStatusError{code: resp.StatusCode}
+	default:
+		return &httpStatusError{code: resp.StatusCode}
+	}
+
+	_, err = io.Copy(cw, resp.Body)
+	return err
+}
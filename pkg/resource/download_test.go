@@ -0,0 +1,75 @@
+package resource
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"google.golang.org/grpc"
+)
+
+// mockDownloadClient 返回固定下载URL的 gRPC 客户端
+type mockDownloadClient struct {
+	v1.ResourceInternalServiceClient
+	url string
+}
+
+func (m *mockDownloadClient) InternalGetDownloadUrls(ctx context.Context, in *v1.InternalGetDownloadUrlsRequest, opts ...grpc.CallOption) (*v1.InternalGetDownloadUrlsResponse, error) {
+	results := make(map[string]*v1.InternalFileDownloadInfo)
+	for _, f := range in.Files {
+		results[f.FileId] = &v1.InternalFileDownloadInfo{DownloadUrl: m.url, Success: true}
+	}
+	return &v1.InternalGetDownloadUrlsResponse{Results: results}, nil
+}
+
+func TestDownloadFile_Resume(t *testing.T) {
+	content := strings.Repeat("0123456789", 1000)
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// 第一次请求只返回一半内容后中断连接
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.Header().Set("Content-Length", strconv.Itoa(len(content)))
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(content[:len(content)/2]))
+			panic(http.ErrAbortHandler)
+		}
+		http.ServeContent(w, r, "file.txt", time.Now(), strings.NewReader(content))
+	}))
+	defer server.Close()
+
+	client := newTestClient(&mockDownloadClient{url: server.URL})
+
+	var buf bytes.Buffer
+	n, err := client.DownloadFile(context.Background(), "1001", "file_1", &buf, &DownloadOptions{RetryBackoff: time.Millisecond})
+	if err != nil {
+		t.Fatalf("DownloadFile failed: %v", err)
+	}
+	if n != int64(len(content)) || buf.String() != content {
+		t.Errorf("Downloaded content mismatch: got %d bytes", n)
+	}
+	if atomic.LoadInt32(&requests) != 2 {
+		t.Errorf("Expected 2 requests, got %d", requests)
+	}
+}
+
+func TestDownloadFile_NotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.NotFound(w, r)
+	}))
+	defer server.Close()
+
+	client := newTestClient(&mockDownloadClient{url: server.URL})
+
+	var buf bytes.Buffer
+	if _, err := client.DownloadFile(context.Background(), "1001", "file_1", &buf, nil); err == nil {
+		t.Fatal("Expected error, got nil")
+	}
+}
@@ -0,0 +1,89 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	middleware "github.com/heyinLab/common/pkg/middleware/grpc"
+	"google.golang.org/grpc/connectivity"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// stateConn 可观察状态的连接，*grpc.ClientConn 和 clients.Factory 管理的连接均实现该接口
+type stateConn interface {
+	GetState() connectivity.State
+	WaitForStateChange(ctx context.Context, sourceState connectivity.State) bool
+}
+
+// HealthCheck 检查资源服务是否可用
+//
+// 通过 gRPC 健康检查协议（grpc.health.v1.Health/Check）探测服务端整体状态
+//
+// 参数:
+//   - ctx: 上下文
+//   - callOpts: 调用选项（如 WithTimeout）
+//
+// 返回:
+//   - error: 服务不可用或状态不是 SERVING 时返回错误
+//
+// 使用示例:
+//
+//	// 就绪探针
+//	if err := client.HealthCheck(ctx, resource.WithTimeout(time.Second)); err != nil {
+//	    return err
+//	}
+func (c *ResourceClient) HealthCheck(ctx context.Context, callOpts ...CallOption) error {
+	if c.health == nil {
+		return fmt.Errorf("客户端未建立连接")
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	resp, err := c.health.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("资源服务健康检查失败: error=%v", err)
+		return err
+	}
+
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("资源服务不可用: status=%s", resp.Status)
+	}
+
+	return nil
+}
+
+// OnStateChange 注册连接状态变化回调
+//
+// 注册后立即以当前状态回调一次，之后每次连接状态变化时回调，
+// 连接关闭（Shutdown）或调用 Close 后停止。连接不支持观察状态时（见 NewResourceClientWithConn）不会回调
+//
+// 参数:
+//   - cb: 状态变化回调，在独立的 goroutine 中执行，不应阻塞
+//
+// 使用示例:
+//
+//	var ready atomic.Bool
+//	client.OnStateChange(func(state connectivity.State) {
+//	    ready.Store(state == connectivity.Ready || state == connectivity.Idle)
+//	})
+func (c *ResourceClient) OnStateChange(cb func(connectivity.State)) *ResourceClient {
+	if c.state == nil || cb == nil || c.closeCtx == nil {
+		return c
+	}
+
+	go func() {
+		for {
+			state := c.state.GetState()
+			cb(state)
+			if state == connectivity.Shutdown {
+				return
+			}
+			// 共享连接不随 Close 关闭，由 closeCtx 结束等待
+			if !c.state.WaitForStateChange(c.closeCtx, state) {
+				return
+			}
+		}
+	}()
+
+	return c
+}
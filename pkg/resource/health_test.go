@@ -0,0 +1,38 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// mockHealthClient 测试用的健康检查客户端
+type mockHealthClient struct {
+	healthpb.HealthClient
+	status healthpb.HealthCheckResponse_ServingStatus
+}
+
+func (m *mockHealthClient) Check(ctx context.Context, in *healthpb.HealthCheckRequest, opts ...grpc.CallOption) (*healthpb.HealthCheckResponse, error) {
+	return &healthpb.HealthCheckResponse{Status: m.status}, nil
+}
+
+func TestHealthCheck(t *testing.T) {
+	client := newTestClient(&mockInternalClient{})
+	ctx := context.Background()
+
+	if err := client.HealthCheck(ctx); err == nil {
+		t.Error("Expected error without connection")
+	}
+
+	client.health = &mockHealthClient{status: healthpb.HealthCheckResponse_SERVING}
+	if err := client.HealthCheck(ctx); err != nil {
+		t.Errorf("Expected healthy, got %v", err)
+	}
+
+	client.health = &mockHealthClient{status: healthpb.HealthCheckResponse_NOT_SERVING}
+	if err := client.HealthCheck(ctx); err == nil {
+		t.Error("Expected error for NOT_SERVING")
+	}
+}
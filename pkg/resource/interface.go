@@ -0,0 +1,82 @@
+package resource
+
+import (
+	"context"
+	"io"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+)
+
+// ResourceAPI 资源服务客户端接口
+//
+// ResourceClient 实现了该接口，业务代码依赖该接口即可在单元测试中
+// 使用 resourcetest.NewFakeClient 替换真实客户端
+//
+// 使用示例:
+//
+//	type ProductService struct {
+//	    resource resource.ResourceAPI
+//	}
+//
+//	// 生产环境
+//	svc := &ProductService{resource: resourceClient}
+//
+//	// 单元测试
+//	svc := &ProductService{resource: resourcetest.NewFakeClient(fixtures)}
+type ResourceAPI interface {
+	// Close 关闭客户端连接
+	Close() error
+
+	// HealthCheck 检查资源服务是否可用
+	HealthCheck(ctx context.Context, callOpts ...CallOption) error
+
+	// ========== 文件相关接口 ==========
+
+	GetFile(ctx context.Context, tenantCode string, fileID string, callOpts ...CallOption) (*v1.InternalFileInfo, error)
+	GetFiles(ctx context.Context, tenantCode string, fileIDs []string, callOpts ...CallOption) (map[string]*v1.InternalFileInfo, []string, error)
+	GetFileUrls(ctx context.Context, fileIDs []string, opts *GetFileUrlsOptions, callOpts ...CallOption) (map[string]*v1.InternalFileUrlInfo, error)
+	GetFileUrlsAll(ctx context.Context, fileIDs []string, opts *GetFileUrlsOptions, callOpts ...CallOption) (map[string]*v1.InternalFileUrlInfo, error)
+	GetFileUrl(ctx context.Context, fileID string, callOpts ...CallOption) (string, error)
+	GetFileVariantUrl(ctx context.Context, fileID string, variantID string, callOpts ...CallOption) (string, error)
+	GetDownloadUrls(ctx context.Context, tenantCode string, files []DownloadFileRequest, expiresIn int64, callOpts ...CallOption) (map[string]*v1.InternalFileDownloadInfo, error)
+	GetDownloadUrl(ctx context.Context, tenantCode string, fileID string, callOpts ...CallOption) (string, error)
+	DownloadFile(ctx context.Context, tenantCode string, fileID string, w io.Writer, opts *DownloadOptions, callOpts ...CallOption) (int64, error)
+	CheckFileExists(ctx context.Context, tenantCode string, checksumSHA256 string, size int64, callOpts ...CallOption) (bool, *v1.InternalFileInfo, error)
+	CheckFilesExist(ctx context.Context, tenantCode string, items []ChecksumSize, callOpts ...CallOption) (map[string]*v1.InternalFileInfo, error)
+	CopyFile(ctx context.Context, srcTenantCode string, fileID string, dstTenantCode string, opts *CopyOptions, callOpts ...CallOption) (string, error)
+	UpdateFileMetadata(ctx context.Context, tenantCode string, fileID string, opts *UpdateFileMetadataOptions, callOpts ...CallOption) (*v1.InternalFileInfo, error)
+
+	// ========== 变体相关接口 ==========
+
+	CreateVariants(ctx context.Context, tenantCode string, fileID string, specs []VariantSpec, callOpts ...CallOption) ([]*v1.InternalVariantStatus, error)
+	GetVariantStatus(ctx context.Context, tenantCode string, fileID string, variantIDs []string, callOpts ...CallOption) ([]*v1.InternalVariantStatus, error)
+	WaitForVariants(ctx context.Context, tenantCode string, fileID string, variantIDs []string, interval time.Duration, callOpts ...CallOption) ([]*v1.InternalVariantStatus, error)
+
+	// ========== 打包下载接口 ==========
+
+	CreateArchive(ctx context.Context, tenantCode string, fileIDs []string, name string, callOpts ...CallOption) (*v1.InternalArchiveJob, error)
+	GetArchiveStatus(ctx context.Context, tenantCode string, jobID string, callOpts ...CallOption) (*v1.InternalArchiveJob, error)
+	WaitForArchive(ctx context.Context, tenantCode string, jobID string, interval time.Duration, callOpts ...CallOption) (*v1.InternalArchiveJob, error)
+
+	// ========== 文件引用接口 ==========
+
+	AttachReference(ctx context.Context, tenantCode string, fileID string, refType string, refID string, callOpts ...CallOption) (int32, error)
+	DetachReference(ctx context.Context, tenantCode string, fileID string, refType string, refID string, callOpts ...CallOption) (int32, error)
+	ListReferences(ctx context.Context, tenantCode string, fileID string, callOpts ...CallOption) ([]*v1.InternalFileReference, error)
+
+	// ========== 配额相关接口 ==========
+
+	GetQuota(ctx context.Context, tenantCode string, callOpts ...CallOption) (*v1.InternalQuotaInfo, error)
+	CheckQuota(ctx context.Context, tenantCode string, checkType CheckQuotaType, size int64, callOpts ...CallOption) (*CheckQuotaResult, error)
+	WatchQuota(ctx context.Context, tenantCode string, thresholdPct float64, cb func(*v1.InternalQuotaInfo), opts *WatchQuotaOptions) error
+
+	// ========== 租户初始化接口 ==========
+
+	InitTenant(ctx context.Context, tenantCode string, region string, callOpts ...CallOption) (*InitTenantResult, error)
+	InitTenantWithOptions(ctx context.Context, tenantCode string, opts *InitTenantOptions, callOpts ...CallOption) (*InitTenantResult, error)
+	GetTenantInitStatus(ctx context.Context, tenantCode string, callOpts ...CallOption) (*TenantInitStatus, error)
+}
+
+// 确保 ResourceClient 实现了 ResourceAPI 接口
+var _ ResourceAPI = (*ResourceClient)(nil)
@@ -0,0 +1,93 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+)
+
+// DefaultQuotaWatchInterval 配额监听的默认轮询间隔
+const DefaultQuotaWatchInterval = time.Minute
+
+// WatchQuotaOptions 配额监听选项
+type WatchQuotaOptions struct {
+	// 轮询间隔，默认1分钟
+	Interval time.Duration
+}
+
+// QuotaFetcher 获取租户配额的函数
+type QuotaFetcher func(ctx context.Context) (*v1.InternalQuotaInfo, error)
+
+// StorageUsagePercent 计算存储使用百分比（0-100），无限制配额返回0
+func StorageUsagePercent(quota *v1.InternalQuotaInfo) float64 {
+	if quota == nil || quota.StorageQuota <= 0 {
+		return 0
+	}
+	return float64(quota.StorageUsed) * 100 / float64(quota.StorageQuota)
+}
+
+// WatchQuota 监听租户存储使用量，超过阈值时回调
+//
+// 资源服务暂不支持流式推送，采用轮询方式；使用量从阈值以下上升到阈值及以上时回调一次，
+// 回落到阈值以下后再次超过会重新回调。首次查询即超过阈值时同样会回调
+//
+// 参数:
+//   - ctx: 上下文（取消后停止监听）
+//   - tenantCode: 租户ID
+//   - thresholdPct: 阈值百分比（0-100），如 80 表示使用超过80%
+//   - cb: 超过阈值时的回调
+//   - opts: 可选参数
+//
+// 返回:
+//   - error: 参数错误或 ctx 结束时的错误，单次查询失败不会中止监听
+//
+// 使用示例:
+//
+//	go client.WatchQuota(ctx, tenantCode, 80, func(q *resourcev1.InternalQuotaInfo) {
+//	    notifyMerchant(q.TenantCode, resource.StorageUsagePercent(q))
+//	}, nil)
+func (c *ResourceClient) WatchQuota(ctx context.Context, tenantCode string, thresholdPct float64, cb func(*v1.InternalQuotaInfo), opts *WatchQuotaOptions) error {
+	return WatchQuotaWithFetcher(ctx, func(ctx context.Context) (*v1.InternalQuotaInfo, error) {
+		return c.GetQuota(ctx, tenantCode)
+	}, thresholdPct, cb, opts)
+}
+
+// WatchQuotaWithFetcher 使用自定义的配额获取函数监听存储使用量
+//
+// 行为与 ResourceClient.WatchQuota 一致，便于接入缓存或测试替身
+func WatchQuotaWithFetcher(ctx context.Context, fetch QuotaFetcher, thresholdPct float64, cb func(*v1.InternalQuotaInfo), opts *WatchQuotaOptions) error {
+	if fetch == nil || cb == nil {
+		return fmt.Errorf("配额获取函数和回调不能为空")
+	}
+	if thresholdPct <= 0 || thresholdPct > 100 {
+		return fmt.Errorf("阈值百分比必须在(0, 100]之间，当前: %v", thresholdPct)
+	}
+
+	interval := DefaultQuotaWatchInterval
+	if opts != nil && opts.Interval > 0 {
+		interval = opts.Interval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	exceeded := false
+	for {
+		quota, err := fetch(ctx)
+		if err == nil && quota != nil {
+			over := quota.StorageQuota > 0 && StorageUsagePercent(quota) >= thresholdPct
+			if over && !exceeded {
+				cb(quota)
+			}
+			exceeded = over
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package resource
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+)
+
+func TestWatchQuotaWithFetcher(t *testing.T) {
+	// 使用量依次为 50% 85% 90% 60% 95%，阈值80%应在 85% 和 95% 时各回调一次
+	usages := []int64{50, 85, 90, 60, 95}
+	calls := 0
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	fetch := func(ctx context.Context) (*v1.InternalQuotaInfo, error) {
+		if calls >= len(usages) {
+			cancel()
+			return nil, errors.New("done")
+		}
+		used := usages[calls]
+		calls++
+		return &v1.InternalQuotaInfo{StorageQuota: 100, StorageUsed: used}, nil
+	}
+
+	var notified []int64
+	err := WatchQuotaWithFetcher(ctx, fetch, 80, func(q *v1.InternalQuotaInfo) {
+		notified = append(notified, q.StorageUsed)
+	}, &WatchQuotaOptions{Interval: time.Millisecond})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+	if len(notified) != 2 || notified[0] != 85 || notified[1] != 95 {
+		t.Errorf("Expected notifications at 85 and 95, got %v", notified)
+	}
+}
+
+func TestWatchQuotaWithFetcher_InvalidThreshold(t *testing.T) {
+	fetch := func(ctx context.Context) (*v1.InternalQuotaInfo, error) { return nil, nil }
+	if err := WatchQuotaWithFetcher(context.Background(), fetch, 120, func(*v1.InternalQuotaInfo) {}, nil); err == nil {
+		t.Error("Expected error for invalid threshold")
+	}
+}
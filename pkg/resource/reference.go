@@ -0,0 +1,143 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	middleware "github.com/heyinLab/common/pkg/middleware/grpc"
+)
+
+// AttachReference 记录文件被业务数据引用
+//
+// 资源服务据此判断上传文件是否仍在使用，无引用的文件可被平台安全回收
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantCode: 租户ID
+//   - fileID: 文件ID
+//   - refType: 引用方类型，如 "product"
+//   - refID: 引用方ID，如商品编码
+//
+// 返回:
+//   - int32: 文件当前的引用数
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	// 保存商品后记录主图引用
+//	_, err := client.AttachReference(ctx, tenantCode, product.ImageID, "product", product.Code)
+//
+// 说明:
+//   - 重复记录同一引用是幂等的
+func (c *ResourceClient) AttachReference(ctx context.Context, tenantCode string, fileID string, refType string, refID string, callOpts ...CallOption) (int32, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := validateReference(fileID, refType, refID); err != nil {
+		return 0, err
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	resp, err := c.client.InternalAttachReference(ctx, &v1.InternalAttachReferenceRequest{
+		TenantCode: tenantCode,
+		FileId:     fileID,
+		RefType:    refType,
+		RefId:      refID,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("记录文件引用失败: tenant_code=%s, file_id=%s, ref=%s/%s, error=%v", tenantCode, fileID, refType, refID, err)
+		return 0, err
+	}
+
+	return resp.ReferenceCount, nil
+}
+
+// DetachReference 删除文件引用
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantCode: 租户ID
+//   - fileID: 文件ID
+//   - refType: 引用方类型
+//   - refID: 引用方ID
+//
+// 返回:
+//   - int32: 文件剩余的引用数
+//   - error: 错误信息
+//
+// 说明:
+//   - 引用不存在时不报错
+func (c *ResourceClient) DetachReference(ctx context.Context, tenantCode string, fileID string, refType string, refID string, callOpts ...CallOption) (int32, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := validateReference(fileID, refType, refID); err != nil {
+		return 0, err
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	resp, err := c.client.InternalDetachReference(ctx, &v1.InternalDetachReferenceRequest{
+		TenantCode: tenantCode,
+		FileId:     fileID,
+		RefType:    refType,
+		RefId:      refID,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("删除文件引用失败: tenant_code=%s, file_id=%s, ref=%s/%s, error=%v", tenantCode, fileID, refType, refID, err)
+		return 0, err
+	}
+
+	return resp.ReferenceCount, nil
+}
+
+// ListReferences 查询文件的引用列表
+//
+// 参数:
+//   - ctx: 上下文
+//   - tenantCode: 租户ID
+//   - fileID: 文件ID
+//
+// 返回:
+//   - []*v1.InternalFileReference: 引用列表
+//   - error: 错误信息
+func (c *ResourceClient) ListReferences(ctx context.Context, tenantCode string, fileID string, callOpts ...CallOption) ([]*v1.InternalFileReference, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if fileID == "" {
+		return nil, fmt.Errorf("文件ID不能为空")
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
+
+	resp, err := c.client.InternalListReferences(ctx, &v1.InternalListReferencesRequest{
+		TenantCode: tenantCode,
+		FileId:     fileID,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("查询文件引用失败: tenant_code=%s, file_id=%s, error=%v", tenantCode, fileID, err)
+		return nil, err
+	}
+
+	return resp.References, nil
+}
+
+// validateReference 校验引用参数
+func validateReference(fileID string, refType string, refID string) error {
+	if fileID == "" {
+		return fmt.Errorf("文件ID不能为空")
+	}
+	if refType == "" || refID == "" {
+		return fmt.Errorf("引用方类型和ID不能为空")
+	}
+	return nil
+}
@@ -0,0 +1,930 @@
+// Package resourcetest 提供资源服务客户端的内存实现，供业务方单元测试使用
+package resourcetest
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/heyinLab/common/pkg/internal/fake"
+	"github.com/heyinLab/common/pkg/resource"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultURLBase 假客户端生成URL时的默认前缀
+const DefaultURLBase = "https://resource.fake"
+
+// Fixtures 假客户端的初始数据
+type Fixtures struct {
+	// 文件列表，以 Id 为key，TenantCode 用于租户隔离
+	Files []*v1.InternalFileInfo
+	// 文件内容（文件ID -> 内容），供 DownloadFile 使用
+	Contents map[string][]byte
+	// 文件变体（文件ID -> 变体状态列表）
+	Variants map[string][]*v1.InternalVariantStatus
+	// 租户配额（租户ID -> 配额信息），存在配额的租户视为已初始化
+	Quotas map[string]*v1.InternalQuotaInfo
+	// 生成URL的前缀，默认 DefaultURLBase
+	URLBase string
+}
+
+// FakeClient resource.ResourceAPI 的内存实现
+//
+// 所有数据保存在内存中，变体创建后立即完成，返回结果均为副本
+//
+// 使用示例:
+//
+//	fake := resourcetest.NewFakeClient(&resourcetest.Fixtures{
+//	    Files: []*resourcev1.InternalFileInfo{
+//	        {Id: "file_1", TenantCode: "T001", Filename: "logo.png", Size: 1024},
+//	    },
+//	})
+//	svc := NewProductService(fake)
+//
+//	// 模拟下游故障
+//	fake.SetError("GetFile", status.Error(codes.Unavailable, "unavailable"))
+type FakeClient struct {
+	mu       sync.Mutex
+	files    map[string]*v1.InternalFileInfo
+	contents map[string][]byte
+	variants map[string][]*v1.InternalVariantStatus
+	quotas   map[string]*v1.InternalQuotaInfo
+	archives map[string]*v1.InternalArchiveJob
+	refs     map[string][]*v1.InternalFileReference
+	tenants  map[string]*resource.TenantInitStatus
+	initKeys map[string]string
+	errors   fake.Errors
+	urlBase  string
+	seq      int
+}
+
+// 确保 FakeClient 实现了 ResourceAPI 接口
+var _ resource.ResourceAPI = (*FakeClient)(nil)
+
+// NewFakeClient 创建假客户端
+//
+// 参数:
+//   - fixtures: 初始数据（可选），会被复制，后续修改不影响假客户端
+//
+// 返回:
+//   - *FakeClient: 假客户端实例
+func NewFakeClient(fixtures *Fixtures) *FakeClient {
+	f := &FakeClient{
+		files:    make(map[string]*v1.InternalFileInfo),
+		contents: make(map[string][]byte),
+		variants: make(map[string][]*v1.InternalVariantStatus),
+		quotas:   make(map[string]*v1.InternalQuotaInfo),
+		archives: make(map[string]*v1.InternalArchiveJob),
+		refs:     make(map[string][]*v1.InternalFileReference),
+		tenants:  make(map[string]*resource.TenantInitStatus),
+		initKeys: make(map[string]string),
+		urlBase:  DefaultURLBase,
+	}
+	if fixtures == nil {
+		return f
+	}
+
+	if fixtures.URLBase != "" {
+		f.urlBase = fixtures.URLBase
+	}
+	for _, file := range fixtures.Files {
+		f.files[file.Id] = cloneFile(file)
+	}
+	for id, content := range fixtures.Contents {
+		f.contents[id] = append([]byte(nil), content...)
+	}
+	for id, variants := range fixtures.Variants {
+		f.variants[id] = cloneVariants(variants)
+	}
+	for tenantCode, quota := range fixtures.Quotas {
+		f.quotas[tenantCode] = proto.Clone(quota).(*v1.InternalQuotaInfo)
+	}
+
+	return f
+}
+
+// SetError 设置指定方法返回的错误，err 为 nil 时清除
+//
+// method 为 ResourceAPI 的方法名，如 "GetFile"、"GetFileUrls"
+func (f *FakeClient) SetError(method string, err error) {
+	f.errors.Set(method, err)
+}
+
+// AddFile 添加文件，返回文件ID（为空时自动生成）
+func (f *FakeClient) AddFile(file *v1.InternalFileInfo, content []byte) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file = cloneFile(file)
+	if file.Id == "" {
+		file.Id = f.nextID("file")
+	}
+	f.files[file.Id] = file
+	if content != nil {
+		f.contents[file.Id] = append([]byte(nil), content...)
+	}
+	return file.Id
+}
+
+// SetQuota 设置租户配额
+func (f *FakeClient) SetQuota(quota *v1.InternalQuotaInfo) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.quotas[quota.TenantCode] = proto.Clone(quota).(*v1.InternalQuotaInfo)
+}
+
+// Close 关闭客户端（空操作）
+func (f *FakeClient) Close() error {
+	return nil
+}
+
+// HealthCheck 健康检查，可通过 SetError("HealthCheck", err) 模拟服务不可用
+func (f *FakeClient) HealthCheck(ctx context.Context, callOpts ...resource.CallOption) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.errors.Get("HealthCheck")
+}
+
+// ========== 文件相关接口 ==========
+
+// GetFile 获取文件信息
+func (f *FakeClient) GetFile(ctx context.Context, tenantCode string, fileID string, callOpts ...resource.CallOption) (*v1.InternalFileInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("GetFile"); err != nil {
+		return nil, err
+	}
+
+	file, ok := f.lookup(tenantCode, fileID)
+	if !ok {
+		return nil, errFileNotFound(fileID)
+	}
+	return cloneFile(file), nil
+}
+
+// GetFiles 批量获取文件信息
+func (f *FakeClient) GetFiles(ctx context.Context, tenantCode string, fileIDs []string, callOpts ...resource.CallOption) (map[string]*v1.InternalFileInfo, []string, error) {
+	if len(fileIDs) > 100 {
+		return nil, nil, fmt.Errorf("文件ID数量不能超过100个，当前: %d", len(fileIDs))
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("GetFiles"); err != nil {
+		return nil, nil, err
+	}
+
+	files := make(map[string]*v1.InternalFileInfo, len(fileIDs))
+	var failedIDs []string
+	for _, id := range fileIDs {
+		file, ok := f.lookup(tenantCode, id)
+		if !ok {
+			failedIDs = append(failedIDs, id)
+			continue
+		}
+		files[id] = cloneFile(file)
+	}
+	return files, failedIDs, nil
+}
+
+// GetFileUrls 批量获取文件URL
+func (f *FakeClient) GetFileUrls(ctx context.Context, fileIDs []string, opts *resource.GetFileUrlsOptions, callOpts ...resource.CallOption) (map[string]*v1.InternalFileUrlInfo, error) {
+	if len(fileIDs) > resource.MaxFileUrlsBatchSize {
+		return nil, fmt.Errorf("文件ID数量不能超过100个，当前: %d", len(fileIDs))
+	}
+	return f.fileUrls("GetFileUrls", fileIDs, opts)
+}
+
+// GetFileUrlsAll 批量获取文件URL（不限数量）
+func (f *FakeClient) GetFileUrlsAll(ctx context.Context, fileIDs []string, opts *resource.GetFileUrlsOptions, callOpts ...resource.CallOption) (map[string]*v1.InternalFileUrlInfo, error) {
+	return f.fileUrls("GetFileUrlsAll", fileIDs, opts)
+}
+
+// GetFileUrl 获取单个文件URL
+func (f *FakeClient) GetFileUrl(ctx context.Context, fileID string, callOpts ...resource.CallOption) (string, error) {
+	results, err := f.fileUrls("GetFileUrl", []string{fileID}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	info := results[fileID]
+	if !info.Success {
+		return "", fmt.Errorf("获取文件URL失败: %s", info.Error)
+	}
+	return info.Url, nil
+}
+
+// GetFileVariantUrl 获取单个文件指定变体的URL
+func (f *FakeClient) GetFileVariantUrl(ctx context.Context, fileID string, variantID string, callOpts ...resource.CallOption) (string, error) {
+	results, err := f.fileUrls("GetFileVariantUrl", []string{fileID}, &resource.GetFileUrlsOptions{VariantIDs: []string{variantID}})
+	if err != nil {
+		return "", err
+	}
+
+	info := results[fileID]
+	if !info.Success {
+		return "", fmt.Errorf("获取文件URL失败: %s", info.Error)
+	}
+	url, ok := info.VariantUrls[variantID]
+	if !ok {
+		return "", fmt.Errorf("获取变体URL失败: 变体不存在, variant_id=%s", variantID)
+	}
+	return url, nil
+}
+
+// GetDownloadUrls 批量获取下载URL
+func (f *FakeClient) GetDownloadUrls(ctx context.Context, tenantCode string, files []resource.DownloadFileRequest, expiresIn int64, callOpts ...resource.CallOption) (map[string]*v1.InternalFileDownloadInfo, error) {
+	if len(files) > 50 {
+		return nil, fmt.Errorf("文件数量不能超过50个，当前: %d", len(files))
+	}
+	return f.downloadUrls("GetDownloadUrls", tenantCode, files, expiresIn)
+}
+
+// GetDownloadUrl 获取单个文件下载URL
+func (f *FakeClient) GetDownloadUrl(ctx context.Context, tenantCode string, fileID string, callOpts ...resource.CallOption) (string, error) {
+	results, err := f.downloadUrls("GetDownloadUrl", tenantCode, []resource.DownloadFileRequest{{FileID: fileID}}, resource.DefaultURLExpiresIn)
+	if err != nil {
+		return "", err
+	}
+
+	info := results[fileID]
+	if !info.Success {
+		return "", fmt.Errorf("获取下载URL失败: %s", info.Error)
+	}
+	return info.DownloadUrl, nil
+}
+
+// DownloadFile 将 Fixtures.Contents 中的文件内容写入 w
+func (f *FakeClient) DownloadFile(ctx context.Context, tenantCode string, fileID string, w io.Writer, opts *resource.DownloadOptions, callOpts ...resource.CallOption) (int64, error) {
+	f.mu.Lock()
+	if err := f.errors.Get("DownloadFile"); err != nil {
+		f.mu.Unlock()
+		return 0, err
+	}
+	_, ok := f.lookup(tenantCode, fileID)
+	content := f.contents[fileID]
+	f.mu.Unlock()
+
+	if !ok {
+		return 0, fmt.Errorf("获取下载URL失败: 文件不存在")
+	}
+
+	n, err := w.Write(content)
+	return int64(n), err
+}
+
+// CheckFileExists 按校验和检查文件是否存在
+func (f *FakeClient) CheckFileExists(ctx context.Context, tenantCode string, checksumSHA256 string, size int64, callOpts ...resource.CallOption) (bool, *v1.InternalFileInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("CheckFileExists"); err != nil {
+		return false, nil, err
+	}
+
+	for _, file := range f.files {
+		if file.TenantCode != tenantCode || file.ChecksumSha256 != checksumSHA256 {
+			continue
+		}
+		if size > 0 && file.Size != size {
+			continue
+		}
+		return true, cloneFile(file), nil
+	}
+	return false, nil, nil
+}
+
+// CheckFilesExist 按校验和批量检查文件是否存在
+func (f *FakeClient) CheckFilesExist(ctx context.Context, tenantCode string, items []resource.ChecksumSize, callOpts ...resource.CallOption) (map[string]*v1.InternalFileInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("CheckFilesExist"); err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*v1.InternalFileInfo)
+	for _, item := range items {
+		for _, file := range f.files {
+			if file.TenantCode != tenantCode || file.ChecksumSha256 != item.ChecksumSHA256 {
+				continue
+			}
+			if item.Size > 0 && file.Size != item.Size {
+				continue
+			}
+			result[item.ChecksumSHA256] = cloneFile(file)
+			break
+		}
+	}
+	return result, nil
+}
+
+// CopyFile 复制文件到目标租户
+func (f *FakeClient) CopyFile(ctx context.Context, srcTenantCode string, fileID string, dstTenantCode string, opts *resource.CopyOptions, callOpts ...resource.CallOption) (string, error) {
+	if fileID == "" {
+		return "", fmt.Errorf("文件ID不能为空")
+	}
+	if dstTenantCode == "" {
+		return "", fmt.Errorf("目标租户ID不能为空")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("CopyFile"); err != nil {
+		return "", err
+	}
+
+	src, ok := f.lookup(srcTenantCode, fileID)
+	if !ok {
+		return "", errFileNotFound(fileID)
+	}
+
+	dst := cloneFile(src)
+	dst.Id = f.nextID("file")
+	dst.TenantCode = dstTenantCode
+	dst.CreatedAt = timestamppb.Now()
+	dst.UpdatedAt = dst.CreatedAt
+	if opts != nil && opts.Filename != "" {
+		dst.Filename = opts.Filename
+	}
+	f.files[dst.Id] = dst
+
+	if content, ok := f.contents[fileID]; ok {
+		f.contents[dst.Id] = content
+	}
+	if opts != nil && opts.IncludeVariants {
+		if variants, ok := f.variants[fileID]; ok {
+			f.variants[dst.Id] = cloneVariants(variants)
+		}
+	}
+
+	return dst.Id, nil
+}
+
+// UpdateFileMetadata 更新文件元数据
+func (f *FakeClient) UpdateFileMetadata(ctx context.Context, tenantCode string, fileID string, opts *resource.UpdateFileMetadataOptions, callOpts ...resource.CallOption) (*v1.InternalFileInfo, error) {
+	if fileID == "" {
+		return nil, fmt.Errorf("文件ID不能为空")
+	}
+	if opts == nil {
+		return nil, fmt.Errorf("更新选项不能为空")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("UpdateFileMetadata"); err != nil {
+		return nil, err
+	}
+
+	file, ok := f.lookup(tenantCode, fileID)
+	if !ok {
+		return nil, errFileNotFound(fileID)
+	}
+
+	if opts.Filename != nil {
+		file.Filename = *opts.Filename
+	}
+	if opts.Tags != nil {
+		file.Tags = append([]string{}, opts.Tags...)
+	}
+	if opts.IsPublic != nil {
+		file.IsPublic = *opts.IsPublic
+	}
+	file.UpdatedAt = timestamppb.Now()
+
+	return cloneFile(file), nil
+}
+
+// ========== 变体相关接口 ==========
+
+// CreateVariants 生成文件变体（立即完成）
+func (f *FakeClient) CreateVariants(ctx context.Context, tenantCode string, fileID string, specs []resource.VariantSpec, callOpts ...resource.CallOption) ([]*v1.InternalVariantStatus, error) {
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("变体规格不能为空")
+	}
+	if len(specs) > 20 {
+		return nil, fmt.Errorf("变体规格数量不能超过20个，当前: %d", len(specs))
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("CreateVariants"); err != nil {
+		return nil, err
+	}
+
+	if _, ok := f.lookup(tenantCode, fileID); !ok {
+		return nil, errFileNotFound(fileID)
+	}
+
+	result := make([]*v1.InternalVariantStatus, 0, len(specs))
+	for _, spec := range specs {
+		variant := &v1.InternalVariantStatus{
+			VariantId: spec.VariantID,
+			Status:    resource.VariantStatusCompleted,
+			Url:       fmt.Sprintf("%s/files/%s/%s", f.urlBase, fileID, spec.VariantID),
+		}
+		f.putVariant(fileID, variant)
+		result = append(result, proto.Clone(variant).(*v1.InternalVariantStatus))
+	}
+	return result, nil
+}
+
+// GetVariantStatus 查询文件变体状态
+func (f *FakeClient) GetVariantStatus(ctx context.Context, tenantCode string, fileID string, variantIDs []string, callOpts ...resource.CallOption) ([]*v1.InternalVariantStatus, error) {
+	return f.variantStatus("GetVariantStatus", tenantCode, fileID, variantIDs)
+}
+
+// WaitForVariants 等待变体生成结束（假客户端中变体状态不会变化，直接返回当前状态）
+//
+// 与 ResourceClient 一致，没有变体或缺少 variantIDs 中的变体时视为尚未结束，阻塞直到 ctx 结束
+func (f *FakeClient) WaitForVariants(ctx context.Context, tenantCode string, fileID string, variantIDs []string, interval time.Duration, callOpts ...resource.CallOption) ([]*v1.InternalVariantStatus, error) {
+	variants, err := f.variantStatus("WaitForVariants", tenantCode, fileID, variantIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(variants) == 0 || len(variants) < len(variantIDs) {
+		<-ctx.Done()
+		return variants, ctx.Err()
+	}
+	return variants, nil
+}
+
+// ========== 打包下载接口 ==========
+
+// CreateArchive 创建打包任务（立即完成），不存在的文件记录在 FailedFileIds 中
+func (f *FakeClient) CreateArchive(ctx context.Context, tenantCode string, fileIDs []string, name string, callOpts ...resource.CallOption) (*v1.InternalArchiveJob, error) {
+	if len(fileIDs) == 0 {
+		return nil, fmt.Errorf("文件ID列表不能为空")
+	}
+	if len(fileIDs) > resource.MaxArchiveFiles {
+		return nil, fmt.Errorf("打包文件数量不能超过%d个，当前: %d", resource.MaxArchiveFiles, len(fileIDs))
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("CreateArchive"); err != nil {
+		return nil, err
+	}
+
+	if name == "" {
+		name = "archive.zip"
+	}
+	job := &v1.InternalArchiveJob{
+		JobId:     f.nextID("archive"),
+		Status:    resource.ArchiveStatusCompleted,
+		Name:      name,
+		ExpiresIn: resource.DefaultURLExpiresIn,
+	}
+	for _, id := range fileIDs {
+		file, ok := f.lookup(tenantCode, id)
+		if !ok {
+			job.FailedFileIds = append(job.FailedFileIds, id)
+			continue
+		}
+		job.FileCount++
+		job.Size += file.Size
+	}
+	job.DownloadUrl = fmt.Sprintf("%s/archives/%s/%s", f.urlBase, job.JobId, name)
+
+	f.archives[tenantCode+"|"+job.JobId] = job
+	return proto.Clone(job).(*v1.InternalArchiveJob), nil
+}
+
+// GetArchiveStatus 查询打包任务状态
+func (f *FakeClient) GetArchiveStatus(ctx context.Context, tenantCode string, jobID string, callOpts ...resource.CallOption) (*v1.InternalArchiveJob, error) {
+	return f.archiveStatus("GetArchiveStatus", tenantCode, jobID)
+}
+
+// WaitForArchive 等待打包任务结束（假客户端中任务创建即完成）
+func (f *FakeClient) WaitForArchive(ctx context.Context, tenantCode string, jobID string, interval time.Duration, callOpts ...resource.CallOption) (*v1.InternalArchiveJob, error) {
+	return f.archiveStatus("WaitForArchive", tenantCode, jobID)
+}
+
+// ========== 文件引用接口 ==========
+
+// AttachReference 记录文件引用，重复记录是幂等的
+func (f *FakeClient) AttachReference(ctx context.Context, tenantCode string, fileID string, refType string, refID string, callOpts ...resource.CallOption) (int32, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("AttachReference"); err != nil {
+		return 0, err
+	}
+
+	if _, ok := f.lookup(tenantCode, fileID); !ok {
+		return 0, errFileNotFound(fileID)
+	}
+
+	refs := f.refs[fileID]
+	for _, ref := range refs {
+		if ref.RefType == refType && ref.RefId == refID {
+			return int32(len(refs)), nil
+		}
+	}
+	f.refs[fileID] = append(refs, &v1.InternalFileReference{
+		FileId:    fileID,
+		RefType:   refType,
+		RefId:     refID,
+		CreatedAt: timestamppb.Now(),
+	})
+	return int32(len(f.refs[fileID])), nil
+}
+
+// DetachReference 删除文件引用，引用不存在时不报错
+func (f *FakeClient) DetachReference(ctx context.Context, tenantCode string, fileID string, refType string, refID string, callOpts ...resource.CallOption) (int32, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("DetachReference"); err != nil {
+		return 0, err
+	}
+
+	if _, ok := f.lookup(tenantCode, fileID); !ok {
+		return 0, errFileNotFound(fileID)
+	}
+
+	f.refs[fileID] = slices.DeleteFunc(f.refs[fileID], func(ref *v1.InternalFileReference) bool {
+		return ref.RefType == refType && ref.RefId == refID
+	})
+	return int32(len(f.refs[fileID])), nil
+}
+
+// ListReferences 查询文件的引用列表
+func (f *FakeClient) ListReferences(ctx context.Context, tenantCode string, fileID string, callOpts ...resource.CallOption) ([]*v1.InternalFileReference, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("ListReferences"); err != nil {
+		return nil, err
+	}
+
+	if _, ok := f.lookup(tenantCode, fileID); !ok {
+		return nil, errFileNotFound(fileID)
+	}
+
+	refs := f.refs[fileID]
+	result := make([]*v1.InternalFileReference, len(refs))
+	for i, ref := range refs {
+		result[i] = proto.Clone(ref).(*v1.InternalFileReference)
+	}
+	return result, nil
+}
+
+// ========== 配额相关接口 ==========
+
+// GetQuota 获取租户配额信息
+func (f *FakeClient) GetQuota(ctx context.Context, tenantCode string, callOpts ...resource.CallOption) (*v1.InternalQuotaInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("GetQuota"); err != nil {
+		return nil, err
+	}
+
+	quota, ok := f.quotas[tenantCode]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "租户未初始化: %s", tenantCode)
+	}
+	return proto.Clone(quota).(*v1.InternalQuotaInfo), nil
+}
+
+// CheckQuota 检查配额是否允许操作
+//
+// upload/storage 检查存储空间和文件数，download 检查每日带宽，配额为0表示无限制
+func (f *FakeClient) CheckQuota(ctx context.Context, tenantCode string, checkType resource.CheckQuotaType, size int64, callOpts ...resource.CallOption) (*resource.CheckQuotaResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("CheckQuota"); err != nil {
+		return nil, err
+	}
+
+	quota, ok := f.quotas[tenantCode]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "租户未初始化: %s", tenantCode)
+	}
+
+	result := &resource.CheckQuotaResult{
+		Allowed: true,
+		Quota:   proto.Clone(quota).(*v1.InternalQuotaInfo),
+	}
+
+	switch checkType {
+	case resource.CheckQuotaTypeUpload, resource.CheckQuotaTypeStorage:
+		if quota.StorageQuota > 0 && quota.StorageUsed+size > quota.StorageQuota {
+			result.Allowed = false
+			result.Reason = "存储空间不足"
+		} else if checkType == resource.CheckQuotaTypeUpload && quota.FileCountQuota > 0 && quota.FileCountUsed >= quota.FileCountQuota {
+			result.Allowed = false
+			result.Reason = "文件数量已达上限"
+		}
+	case resource.CheckQuotaTypeDownload:
+		if quota.BandwidthQuotaDaily > 0 && quota.BandwidthUsed+size > quota.BandwidthQuotaDaily {
+			result.Allowed = false
+			result.Reason = "今日带宽已用尽"
+		}
+	}
+
+	return result, nil
+}
+
+// WatchQuota 监听租户存储使用量，配额可通过 SetQuota 修改
+func (f *FakeClient) WatchQuota(ctx context.Context, tenantCode string, thresholdPct float64, cb func(*v1.InternalQuotaInfo), opts *resource.WatchQuotaOptions) error {
+	return resource.WatchQuotaWithFetcher(ctx, func(ctx context.Context) (*v1.InternalQuotaInfo, error) {
+		return f.GetQuota(ctx, tenantCode)
+	}, thresholdPct, cb, opts)
+}
+
+// ========== 租户初始化接口 ==========
+
+// InitTenant 初始化租户资源，重复初始化返回 AlreadyExists 错误
+func (f *FakeClient) InitTenant(ctx context.Context, tenantCode string, region string, callOpts ...resource.CallOption) (*resource.InitTenantResult, error) {
+	return f.initTenant("InitTenant", tenantCode, &resource.InitTenantOptions{Region: region})
+}
+
+// InitTenantWithOptions 初始化租户资源，相同幂等键或 AllowExisting 时返回已有结果
+func (f *FakeClient) InitTenantWithOptions(ctx context.Context, tenantCode string, opts *resource.InitTenantOptions, callOpts ...resource.CallOption) (*resource.InitTenantResult, error) {
+	if opts == nil {
+		opts = &resource.InitTenantOptions{}
+	}
+	return f.initTenant("InitTenantWithOptions", tenantCode, opts)
+}
+
+// GetTenantInitStatus 查询租户初始化状态，Fixtures.Quotas 中的租户视为已初始化
+func (f *FakeClient) GetTenantInitStatus(ctx context.Context, tenantCode string, callOpts ...resource.CallOption) (*resource.TenantInitStatus, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("GetTenantInitStatus"); err != nil {
+		return nil, err
+	}
+
+	if s, ok := f.tenants[tenantCode]; ok {
+		result := *s
+		return &result, nil
+	}
+	if quota, ok := f.quotas[tenantCode]; ok {
+		return &resource.TenantInitStatus{
+			Status:         resource.TenantInitStatusInitialized,
+			StorageQuota:   quota.StorageQuota,
+			FileCountQuota: quota.FileCountQuota,
+		}, nil
+	}
+	return &resource.TenantInitStatus{Status: resource.TenantInitStatusNotInitialized}, nil
+}
+
+// ========== 内部方法 ==========
+
+// initTenant 初始化租户
+func (f *FakeClient) initTenant(method string, tenantCode string, opts *resource.InitTenantOptions) (*resource.InitTenantResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get(method); err != nil {
+		return nil, err
+	}
+
+	if _, ok := f.quotas[tenantCode]; ok {
+		existing := f.tenants[tenantCode]
+		sameKey := opts.IdempotencyKey != "" && f.initKeys[tenantCode] == opts.IdempotencyKey
+		if !sameKey && !opts.AllowExisting {
+			return nil, status.Errorf(codes.AlreadyExists, "租户已初始化: %s", tenantCode)
+		}
+
+		result := &resource.InitTenantResult{
+			Success:            true,
+			Message:            "租户已初始化",
+			AlreadyInitialized: true,
+		}
+		if existing != nil {
+			result.BucketID = existing.BucketID
+			result.BucketName = existing.BucketName
+			result.StorageQuota = existing.StorageQuota
+			result.FileCountQuota = existing.FileCountQuota
+		}
+		return result, nil
+	}
+
+	region := opts.Region
+	if region == "" {
+		region = "sea"
+	}
+	f.quotas[tenantCode] = &v1.InternalQuotaInfo{TenantCode: tenantCode}
+	f.tenants[tenantCode] = &resource.TenantInitStatus{
+		Status:        resource.TenantInitStatusInitialized,
+		Region:        region,
+		BucketID:      f.nextID("bucket"),
+		BucketName:    fmt.Sprintf("%s-%s", region, tenantCode),
+		InitializedAt: time.Now(),
+	}
+	if opts.IdempotencyKey != "" {
+		f.initKeys[tenantCode] = opts.IdempotencyKey
+	}
+
+	return &resource.InitTenantResult{
+		Success:    true,
+		BucketID:   f.tenants[tenantCode].BucketID,
+		BucketName: f.tenants[tenantCode].BucketName,
+		Message:    "租户初始化成功",
+	}, nil
+}
+
+// lookup 按租户查找文件，调用方需持有锁
+func (f *FakeClient) lookup(tenantCode string, fileID string) (*v1.InternalFileInfo, bool) {
+	file, ok := f.files[fileID]
+	if !ok || file.TenantCode != tenantCode {
+		return nil, false
+	}
+	return file, true
+}
+
+// nextID 生成自增ID，调用方需持有锁
+func (f *FakeClient) nextID(prefix string) string {
+	f.seq++
+	return fmt.Sprintf("fake_%s_%d", prefix, f.seq)
+}
+
+// putVariant 新增或覆盖变体，调用方需持有锁
+func (f *FakeClient) putVariant(fileID string, variant *v1.InternalVariantStatus) {
+	variants := f.variants[fileID]
+	for i, v := range variants {
+		if v.VariantId == variant.VariantId {
+			variants[i] = variant
+			return
+		}
+	}
+	f.variants[fileID] = append(variants, variant)
+}
+
+// fileUrls 生成文件URL，URL查询不做租户隔离
+func (f *FakeClient) fileUrls(method string, fileIDs []string, opts *resource.GetFileUrlsOptions) (map[string]*v1.InternalFileUrlInfo, error) {
+	if opts == nil {
+		opts = &resource.GetFileUrlsOptions{}
+	}
+	expiresIn := opts.ExpiresIn
+	if expiresIn <= 0 {
+		expiresIn = resource.DefaultURLExpiresIn
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get(method); err != nil {
+		return nil, err
+	}
+
+	results := make(map[string]*v1.InternalFileUrlInfo, len(fileIDs))
+	for _, id := range fileIDs {
+		file, ok := f.files[id]
+		if !ok {
+			results[id] = &v1.InternalFileUrlInfo{Success: false, Error: "文件不存在"}
+			continue
+		}
+
+		info := &v1.InternalFileUrlInfo{
+			Url:         fmt.Sprintf("%s/files/%s", f.urlBase, id),
+			IsPublic:    file.IsPublic,
+			Filename:    file.Filename,
+			Size:        file.Size,
+			ContentType: file.ContentType,
+			Success:     true,
+		}
+		if !file.IsPublic {
+			info.ExpiresIn = expiresIn
+		}
+		if opts.IncludeVariants || len(opts.VariantIDs) > 0 {
+			info.VariantUrls = make(map[string]string)
+			for _, v := range f.variants[id] {
+				if v.Status != resource.VariantStatusCompleted {
+					continue
+				}
+				if len(opts.VariantIDs) > 0 && !slices.Contains(opts.VariantIDs, v.VariantId) {
+					continue
+				}
+				info.VariantUrls[v.VariantId] = v.Url
+			}
+		}
+		results[id] = info
+	}
+	return results, nil
+}
+
+// downloadUrls 生成下载URL
+func (f *FakeClient) downloadUrls(method string, tenantCode string, files []resource.DownloadFileRequest, expiresIn int64) (map[string]*v1.InternalFileDownloadInfo, error) {
+	if expiresIn <= 0 {
+		expiresIn = resource.DefaultURLExpiresIn
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get(method); err != nil {
+		return nil, err
+	}
+
+	results := make(map[string]*v1.InternalFileDownloadInfo, len(files))
+	for _, req := range files {
+		file, ok := f.lookup(tenantCode, req.FileID)
+		if !ok {
+			results[req.FileID] = &v1.InternalFileDownloadInfo{Success: false, Error: "文件不存在"}
+			continue
+		}
+
+		filename := file.Filename
+		if req.DownloadFilename != "" {
+			filename = req.DownloadFilename
+		}
+		url := fmt.Sprintf("%s/download/%s", f.urlBase, req.FileID)
+		if req.VariantID != "" {
+			url += "/" + req.VariantID
+		}
+
+		results[req.FileID] = &v1.InternalFileDownloadInfo{
+			DownloadUrl: url,
+			Filename:    filename,
+			Size:        file.Size,
+			ContentType: file.ContentType,
+			ExpiresIn:   expiresIn,
+			Success:     true,
+		}
+	}
+	return results, nil
+}
+
+// variantStatus 查询变体状态，variantIDs 为空时返回全部
+func (f *FakeClient) variantStatus(method string, tenantCode string, fileID string, variantIDs []string) ([]*v1.InternalVariantStatus, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get(method); err != nil {
+		return nil, err
+	}
+
+	if _, ok := f.lookup(tenantCode, fileID); !ok {
+		return nil, errFileNotFound(fileID)
+	}
+
+	variants := f.variants[fileID]
+	if len(variantIDs) == 0 {
+		return cloneVariants(variants), nil
+	}
+
+	result := make([]*v1.InternalVariantStatus, 0, len(variantIDs))
+	for _, id := range variantIDs {
+		for _, v := range variants {
+			if v.VariantId == id {
+				result = append(result, proto.Clone(v).(*v1.InternalVariantStatus))
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// archiveStatus 查询打包任务
+func (f *FakeClient) archiveStatus(method string, tenantCode string, jobID string) (*v1.InternalArchiveJob, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get(method); err != nil {
+		return nil, err
+	}
+
+	job, ok := f.archives[tenantCode+"|"+jobID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "打包任务不存在: %s", jobID)
+	}
+	return proto.Clone(job).(*v1.InternalArchiveJob), nil
+}
+
+// errFileNotFound 与资源服务一致的文件不存在错误
+func errFileNotFound(fileID string) error {
+	return status.Errorf(codes.NotFound, "文件不存在: %s", fileID)
+}
+
+func cloneFile(file *v1.InternalFileInfo) *v1.InternalFileInfo {
+	return proto.Clone(file).(*v1.InternalFileInfo)
+}
+
+func cloneVariants(variants []*v1.InternalVariantStatus) []*v1.InternalVariantStatus {
+	result := make([]*v1.InternalVariantStatus, len(variants))
+	for i, v := range variants {
+		result[i] = proto.Clone(v).(*v1.InternalVariantStatus)
+	}
+	return result
+}
@@ -0,0 +1,196 @@
+package resourcetest
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/resource/v1"
+	"github.com/heyinLab/common/pkg/resource"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newFixtureClient() *FakeClient {
+	return NewFakeClient(&Fixtures{
+		Files: []*v1.InternalFileInfo{
+			{Id: "file_1", TenantCode: "T001", Filename: "logo.png", Size: 5, ChecksumSha256: "abc"},
+			{Id: "file_2", TenantCode: "T002", Filename: "banner.png", IsPublic: true},
+		},
+		Contents: map[string][]byte{"file_1": []byte("hello")},
+		Quotas: map[string]*v1.InternalQuotaInfo{
+			"T001": {TenantCode: "T001", StorageQuota: 100, StorageUsed: 90},
+		},
+	})
+}
+
+func TestFakeClient_Files(t *testing.T) {
+	fake := newFixtureClient()
+	ctx := context.Background()
+
+	file, err := fake.GetFile(ctx, "T001", "file_1")
+	if err != nil || file.Filename != "logo.png" {
+		t.Fatalf("GetFile failed: file=%v, err=%v", file, err)
+	}
+
+	// 租户隔离
+	if _, err := fake.GetFile(ctx, "T001", "file_2"); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound, got %v", err)
+	}
+
+	// URL查询不做租户隔离
+	urls, err := fake.GetFileUrls(ctx, []string{"file_1", "file_2", "missing"}, nil)
+	if err != nil {
+		t.Fatalf("GetFileUrls failed: %v", err)
+	}
+	if !urls["file_1"].Success || urls["file_1"].ExpiresIn != resource.DefaultURLExpiresIn {
+		t.Errorf("Unexpected url info: %v", urls["file_1"])
+	}
+	if !urls["file_2"].IsPublic || urls["missing"].Success {
+		t.Errorf("Unexpected url results: %v", urls)
+	}
+
+	var buf bytes.Buffer
+	n, err := fake.DownloadFile(ctx, "T001", "file_1", &buf, nil)
+	if err != nil || n != 5 || buf.String() != "hello" {
+		t.Errorf("DownloadFile: n=%d, content=%q, err=%v", n, buf.String(), err)
+	}
+
+	newID, err := fake.CopyFile(ctx, "T001", "file_1", "T003", &resource.CopyOptions{Filename: "copy.png"})
+	if err != nil {
+		t.Fatalf("CopyFile failed: %v", err)
+	}
+	copied, err := fake.GetFile(ctx, "T003", newID)
+	if err != nil || copied.Filename != "copy.png" {
+		t.Errorf("Unexpected copied file: %v, err=%v", copied, err)
+	}
+
+	exists, _, err := fake.CheckFileExists(ctx, "T001", "abc", 5)
+	if err != nil || !exists {
+		t.Errorf("Expected file exists, got %v, err=%v", exists, err)
+	}
+}
+
+func TestFakeClient_QuotaAndTenant(t *testing.T) {
+	fake := newFixtureClient()
+	ctx := context.Background()
+
+	result, err := fake.CheckQuota(ctx, "T001", resource.CheckQuotaTypeUpload, 20)
+	if err != nil {
+		t.Fatalf("CheckQuota failed: %v", err)
+	}
+	if result.Allowed {
+		t.Error("Expected upload not allowed")
+	}
+
+	if _, err := fake.InitTenant(ctx, "T001", ""); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("Expected AlreadyExists, got %v", err)
+	}
+	if _, err := fake.InitTenant(ctx, "T004", "cn"); err != nil {
+		t.Errorf("InitTenant failed: %v", err)
+	}
+	if _, err := fake.GetQuota(ctx, "T004"); err != nil {
+		t.Errorf("GetQuota failed: %v", err)
+	}
+}
+
+func TestFakeClient_SetError(t *testing.T) {
+	fake := newFixtureClient()
+	ctx := context.Background()
+
+	fake.SetError("GetFile", status.Error(codes.Unavailable, "unavailable"))
+	if _, err := fake.GetFile(ctx, "T001", "file_1"); status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected Unavailable, got %v", err)
+	}
+
+	fake.SetError("GetFile", nil)
+	if _, err := fake.GetFile(ctx, "T001", "file_1"); err != nil {
+		t.Errorf("Expected error cleared, got %v", err)
+	}
+}
+
+func TestFakeClient_InitTenantIdempotent(t *testing.T) {
+	fake := NewFakeClient(nil)
+	ctx := context.Background()
+
+	st, err := fake.GetTenantInitStatus(ctx, "T001")
+	if err != nil || st.Initialized() {
+		t.Fatalf("Expected not initialized, got %v, err=%v", st, err)
+	}
+
+	opts := &resource.InitTenantOptions{Region: "cn", IdempotencyKey: "key-1"}
+	first, err := fake.InitTenantWithOptions(ctx, "T001", opts)
+	if err != nil {
+		t.Fatalf("InitTenantWithOptions failed: %v", err)
+	}
+
+	// 相同幂等键重试返回首次结果
+	retry, err := fake.InitTenantWithOptions(ctx, "T001", opts)
+	if err != nil || !retry.AlreadyInitialized || retry.BucketID != first.BucketID {
+		t.Errorf("Unexpected retry result: %v, err=%v", retry, err)
+	}
+
+	// 不同幂等键且不允许已存在时报错
+	if _, err := fake.InitTenantWithOptions(ctx, "T001", &resource.InitTenantOptions{IdempotencyKey: "key-2"}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("Expected AlreadyExists, got %v", err)
+	}
+
+	st, err = fake.GetTenantInitStatus(ctx, "T001")
+	if err != nil || !st.Initialized() || st.Region != "cn" {
+		t.Errorf("Unexpected status: %v, err=%v", st, err)
+	}
+}
+
+func TestFakeClient_GetFileVariantUrl(t *testing.T) {
+	fake := newFixtureClient()
+	ctx := context.Background()
+
+	if _, err := fake.CreateVariants(ctx, "T001", "file_1", []resource.VariantSpec{
+		{VariantID: "thumb", Type: resource.VariantTypeThumbnail},
+		{VariantID: "large", Type: resource.VariantTypeResize},
+	}); err != nil {
+		t.Fatalf("CreateVariants failed: %v", err)
+	}
+
+	url, err := fake.GetFileVariantUrl(ctx, "file_1", "thumb")
+	if err != nil || url != DefaultURLBase+"/files/file_1/thumb" {
+		t.Errorf("Unexpected variant url %q, err=%v", url, err)
+	}
+	if _, err := fake.GetFileVariantUrl(ctx, "file_1", "missing"); err == nil {
+		t.Error("Expected error for missing variant")
+	}
+
+	// 缺少的变体视为尚未结束，等待到 ctx 结束
+	waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if _, err := fake.WaitForVariants(waitCtx, "T001", "file_1", []string{"thumb", "missing"}, 0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded for missing variant, got %v", err)
+	}
+}
+
+func TestFakeClient_References(t *testing.T) {
+	fake := newFixtureClient()
+	ctx := context.Background()
+
+	if n, err := fake.AttachReference(ctx, "T001", "file_1", "product", "P001"); err != nil || n != 1 {
+		t.Fatalf("AttachReference: n=%d, err=%v", n, err)
+	}
+	// 幂等
+	if n, _ := fake.AttachReference(ctx, "T001", "file_1", "product", "P001"); n != 1 {
+		t.Errorf("Expected idempotent attach, got %d refs", n)
+	}
+	if n, _ := fake.AttachReference(ctx, "T001", "file_1", "article", "A001"); n != 2 {
+		t.Errorf("Expected 2 refs, got %d", n)
+	}
+
+	if n, err := fake.DetachReference(ctx, "T001", "file_1", "product", "P001"); err != nil || n != 1 {
+		t.Errorf("DetachReference: n=%d, err=%v", n, err)
+	}
+
+	refs, err := fake.ListReferences(ctx, "T001", "file_1")
+	if err != nil || len(refs) != 1 || refs[0].RefType != "article" {
+		t.Errorf("Unexpected refs %v, err=%v", refs, err)
+	}
+}
@@ -0,0 +1,41 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/heyinLab/common/pkg/middleware/auth"
+)
+
+// WithTenantFromContext 开启从认证信息中读取租户ID
+//
+// 开启后，租户相关方法在 tenantCode 参数为空时，从 auth.FromContext(ctx) 中读取租户ID，
+// 上下文中也没有租户ID时返回错误。InitTenant 等租户管理方法不受影响
+//
+// 使用示例:
+//
+//	client, err := resource.NewResourceClientWithDiscovery(config, discovery)
+//	client.WithTenantFromContext()
+//
+//	// handler 中无需再手动传递租户ID
+//	file, err := client.GetFile(ctx, "", fileID)
+//
+// 注意:
+//   - 应在客户端创建后、开始使用前调用
+func (c *ResourceClient) WithTenantFromContext() *ResourceClient {
+	c.tenantFromContext = true
+	return c
+}
+
+// resolveTenant 解析租户ID，显式传入的租户ID优先
+func (c *ResourceClient) resolveTenant(ctx context.Context, tenantCode string) (string, error) {
+	if tenantCode != "" || !c.tenantFromContext {
+		return tenantCode, nil
+	}
+
+	if claims, ok := auth.FromContext(ctx); ok && claims.TenantCode != "" {
+		return claims.TenantCode, nil
+	}
+
+	return "", fmt.Errorf("租户ID不能为空，且上下文中没有租户信息")
+}
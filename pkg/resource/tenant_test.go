@@ -0,0 +1,41 @@
+package resource
+
+import (
+	"context"
+	"testing"
+
+	"github.com/heyinLab/common/pkg/middleware/auth"
+)
+
+func TestResolveTenant(t *testing.T) {
+	client := newTestClient(&mockInternalClient{})
+	ctx := auth.NewContext(context.Background(), &auth.Claims{TenantCode: "T001"})
+
+	// 未开启时保持原样
+	if tenant, err := client.resolveTenant(ctx, ""); err != nil || tenant != "" {
+		t.Errorf("Expected empty tenant, got %q, err=%v", tenant, err)
+	}
+
+	client.WithTenantFromContext()
+	if tenant, err := client.resolveTenant(ctx, ""); err != nil || tenant != "T001" {
+		t.Errorf("Expected T001 from claims, got %q, err=%v", tenant, err)
+	}
+	if tenant, err := client.resolveTenant(ctx, "T002"); err != nil || tenant != "T002" {
+		t.Errorf("Expected explicit T002, got %q, err=%v", tenant, err)
+	}
+	if _, err := client.resolveTenant(context.Background(), ""); err == nil {
+		t.Error("Expected error without claims")
+	}
+}
+
+func TestCheckFilesExist_TenantFromContext(t *testing.T) {
+	mock := &mockCheckFilesClient{}
+	client := newTestClient(mock).WithTenantFromContext()
+
+	if _, err := client.CheckFilesExist(context.Background(), "", []ChecksumSize{{ChecksumSHA256: "file_0"}}); err == nil {
+		t.Error("Expected error without tenant")
+	}
+	if len(mock.batches) != 0 {
+		t.Errorf("Expected no requests, got %d", len(mock.batches))
+	}
+}
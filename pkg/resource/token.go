@@ -0,0 +1,151 @@
+package resource
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/heyinLab/common/pkg/utils/password"
+)
+
+// DownloadTokenParam 签名URL中携带下载令牌的查询参数名
+const DownloadTokenParam = "token"
+
+var (
+	// ErrInvalidDownloadToken 令牌格式错误或签名不匹配
+	ErrInvalidDownloadToken = errors.New("下载令牌无效")
+
+	// ErrDownloadTokenExpired 令牌已过期
+	ErrDownloadTokenExpired = errors.New("下载令牌已过期")
+)
+
+// DownloadToken 下载令牌内容
+type DownloadToken struct {
+	// 租户ID
+	TenantCode string `json:"tid"`
+	// 文件ID
+	FileID string `json:"fid"`
+	// 变体ID（下载变体时）
+	VariantID string `json:"vid,omitempty"`
+	// 过期时间（Unix秒），必填，未设置的令牌视为无效
+	ExpiresAt int64 `json:"exp"`
+}
+
+// SignDownloadToken 生成下载令牌
+//
+// 令牌格式为 base64url(JSON内容) + "." + hex(HMAC-SHA256)，与资源服务签发的格式一致，
+// 主要用于测试和需要自行签发URL的内部服务
+//
+// 参数:
+//   - token: 令牌内容
+//   - secret: 签名密钥（与资源服务共享）
+//
+// 返回:
+//   - string: 令牌字符串
+//   - error: 错误信息
+func SignDownloadToken(token *DownloadToken, secret string) (string, error) {
+	if token == nil || token.FileID == "" {
+		return "", fmt.Errorf("令牌文件ID不能为空")
+	}
+	if token.ExpiresAt <= 0 {
+		return "", fmt.Errorf("令牌过期时间不能为空")
+	}
+	if secret == "" {
+		return "", fmt.Errorf("签名密钥不能为空")
+	}
+
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	signature, err := password.NewHMACCrypto(secret).Encrypt(payload)
+	if err != nil {
+		return "", err
+	}
+
+	return payload + "." + signature, nil
+}
+
+// VerifyDownloadToken 校验下载令牌
+//
+// 在本地校验签名和有效期，无需调用资源服务
+//
+// 参数:
+//   - token: 令牌字符串
+//   - secret: 签名密钥（与资源服务共享）
+//
+// 返回:
+//   - *DownloadToken: 令牌内容，调用方应校验 TenantCode/FileID 与请求一致
+//   - error: ErrInvalidDownloadToken（含未设置过期时间）或 ErrDownloadTokenExpired
+//
+// 使用示例:
+//
+//	claims, err := resource.VerifyDownloadToken(r.URL.Query().Get("token"), secret)
+//	if err != nil || claims.FileID != fileID {
+//	    http.Error(w, "forbidden", http.StatusForbidden)
+//	    return
+//	}
+func VerifyDownloadToken(token string, secret string) (*DownloadToken, error) {
+	return verifyDownloadToken(token, secret, time.Now())
+}
+
+// verifyDownloadToken 按指定时间校验下载令牌
+func verifyDownloadToken(token string, secret string, now time.Time) (*DownloadToken, error) {
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok || payload == "" || signature == "" || secret == "" {
+		return nil, ErrInvalidDownloadToken
+	}
+
+	valid, err := password.NewHMACCrypto(secret).Verify(payload, signature)
+	if err != nil || !valid {
+		return nil, ErrInvalidDownloadToken
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, ErrInvalidDownloadToken
+	}
+
+	var claims DownloadToken
+	// 没有过期时间的令牌永久有效，视为无效令牌
+	if err := json.Unmarshal(data, &claims); err != nil || claims.FileID == "" || claims.ExpiresAt <= 0 {
+		return nil, ErrInvalidDownloadToken
+	}
+
+	if now.Unix() >= claims.ExpiresAt {
+		return &claims, ErrDownloadTokenExpired
+	}
+
+	return &claims, nil
+}
+
+// VerifySignedURL 校验签名URL
+//
+// 从URL查询参数 DownloadTokenParam 中读取令牌并校验
+//
+// 参数:
+//   - rawURL: 完整URL
+//   - secret: 签名密钥
+//
+// 返回:
+//   - *DownloadToken: 令牌内容
+//   - error: URL解析失败、缺少令牌或令牌无效
+func VerifySignedURL(rawURL string, secret string) (*DownloadToken, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("URL格式错误: %w", err)
+	}
+
+	token := u.Query().Get(DownloadTokenParam)
+	if token == "" {
+		return nil, ErrInvalidDownloadToken
+	}
+
+	return VerifyDownloadToken(token, secret)
+}
@@ -0,0 +1,67 @@
+package resource
+
+import (
+	"encoding/base64"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/heyinLab/common/pkg/utils/password"
+)
+
+func TestDownloadToken(t *testing.T) {
+	now := time.Now()
+	token, err := SignDownloadToken(&DownloadToken{
+		TenantCode: "T001",
+		FileID:     "file_1",
+		ExpiresAt:  now.Add(time.Hour).Unix(),
+	}, "secret")
+	if err != nil {
+		t.Fatalf("SignDownloadToken failed: %v", err)
+	}
+
+	claims, err := verifyDownloadToken(token, "secret", now)
+	if err != nil {
+		t.Fatalf("verifyDownloadToken failed: %v", err)
+	}
+	if claims.TenantCode != "T001" || claims.FileID != "file_1" {
+		t.Errorf("Unexpected claims: %+v", claims)
+	}
+
+	if _, err := verifyDownloadToken(token, "other", now); !errors.Is(err, ErrInvalidDownloadToken) {
+		t.Errorf("Expected ErrInvalidDownloadToken for wrong secret, got %v", err)
+	}
+	if _, err := verifyDownloadToken(token+"0", "secret", now); !errors.Is(err, ErrInvalidDownloadToken) {
+		t.Errorf("Expected ErrInvalidDownloadToken for tampered token, got %v", err)
+	}
+	if _, err := verifyDownloadToken(token, "secret", now.Add(2*time.Hour)); !errors.Is(err, ErrDownloadTokenExpired) {
+		t.Errorf("Expected ErrDownloadTokenExpired, got %v", err)
+	}
+
+	signed := "https://cdn.example.com/files/file_1?" + DownloadTokenParam + "=" + url.QueryEscape(token)
+	if _, err := VerifySignedURL(signed, "secret"); err != nil {
+		t.Errorf("VerifySignedURL failed: %v", err)
+	}
+	if _, err := VerifySignedURL("https://cdn.example.com/files/file_1", "secret"); !errors.Is(err, ErrInvalidDownloadToken) {
+		t.Errorf("Expected ErrInvalidDownloadToken without token, got %v", err)
+	}
+}
+
+func TestDownloadToken_MissingExpiry(t *testing.T) {
+	if _, err := SignDownloadToken(&DownloadToken{FileID: "file_1"}, "secret"); err == nil {
+		t.Error("Expected error when signing token without expiry")
+	}
+
+	// 绕过 SignDownloadToken 签发没有 exp 或 exp=0 的令牌
+	for _, claims := range []string{`{"tid":"T001","fid":"file_1"}`, `{"tid":"T001","fid":"file_1","exp":0}`} {
+		payload := base64.RawURLEncoding.EncodeToString([]byte(claims))
+		signature, err := password.NewHMACCrypto("secret").Encrypt(payload)
+		if err != nil {
+			t.Fatalf("sign: %v", err)
+		}
+		if _, err := verifyDownloadToken(payload+"."+signature, "secret", time.Now()); !errors.Is(err, ErrInvalidDownloadToken) {
+			t.Errorf("%s: expected ErrInvalidDownloadToken, got %v", claims, err)
+		}
+	}
+}
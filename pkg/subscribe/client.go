@@ -22,10 +22,17 @@ type Client struct {
 }
 
 // SubscribeClient 订阅服务业务客户端
+//
+// 调用超时由连接的 Timeout 中间件（config.Timeout）控制，可通过 middleware.WithCallTimeout 按次覆盖
 type SubscribeClient struct {
 	client v1.SubscriptionInternalServiceClient
 	logger *log.Helper
 	config *Config
+
+	// claimsTenant 租户Code为空时从认证信息中读取
+	claimsTenant bool
+	// quotaCallOpts 配额接口的默认调用选项
+	quotaCallOpts []CallOption
 }
 
 // NewClient 创建订阅服务客户端
@@ -87,6 +94,24 @@ func NewClientWithDiscovery(config *Config, discovery registry.Discovery) (*Clie
 	}, nil
 }
 
+// NewClientWithConn 基于已建立的连接创建订阅服务客户端
+//
+// 用于多个客户端共享连接（如 clients.Factory），连接由调用方管理，Close 不会关闭该连接
+func NewClientWithConn(config *Config, conn grpc.ClientConnInterface) *Client {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	logger := log.NewHelper(log.With(
+		log.GetLogger(),
+		"module", "subscribe-client",
+	))
+	return &Client{
+		config:          config,
+		logger:          logger,
+		subscribeClient: newSubscribeClient(conn, logger, config),
+	}
+}
+
 func (c *Client) Close() error {
 	if c.conn != nil {
 		return c.conn.Close()
@@ -97,7 +122,7 @@ func (c *Client) SubscribeClient() *SubscribeClient {
 	return c.subscribeClient
 }
 
-func newSubscribeClient(conn *grpc.ClientConn, logger *log.Helper, config *Config) *SubscribeClient {
+func newSubscribeClient(conn grpc.ClientConnInterface, logger *log.Helper, config *Config) *SubscribeClient {
 	return &SubscribeClient{
 		client: v1.NewSubscriptionInternalServiceClient(conn),
 		logger: logger,
@@ -105,23 +130,116 @@ func newSubscribeClient(conn *grpc.ClientConn, logger *log.Helper, config *Confi
 	}
 }
 
+// 订阅列表排序字段
+const (
+	SortByCreateTime = "create_time" // 按创建时间
+	SortByEndDate    = "end_date"    // 按到期时间
+)
+
+// 订阅列表排序方向
+const (
+	SortOrderAsc  = "asc"
+	SortOrderDesc = "desc"
+)
+
+type ListSubscriptionsOptions struct {
+	// 租户Code筛选
+	TenantCode string
+	// 产品编码筛选
+	ProductCode string
+	// 状态筛选
+	Status *v1.InternalSubscriptionStatus
+	// 是否试用期筛选
+	IsTrial *bool
+	// 页码，从1开始
+	Page int32
+	// 每页数量
+	PageSize int32
+	// 排序字段（SortByCreateTime, SortByEndDate）
+	SortBy string
+	// 排序方向（SortOrderAsc, SortOrderDesc）
+	SortOrder string
+}
+
+// GetSubscription 通过订阅Code获取订阅详情
+func (c *SubscribeClient) GetSubscription(ctx context.Context, subscriptionCode string) (*v1.InternalSubscriptionInfo, error) {
+	if subscriptionCode == "" {
+		return nil, fmt.Errorf("订阅Code不能为空")
+	}
+
+	resp, err := c.client.InternalGetSubscription(ctx, &v1.InternalGetSubscriptionRequest{
+		SubscriptionCode: subscriptionCode,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取订阅详情失败:subscription_code=%s err=%v", subscriptionCode, err)
+		return nil, err
+	}
+
+	return resp.Subscription, nil
+}
+
+// ListSubscriptions 分页获取订阅列表，返回值包含总数和分页信息
+func (c *SubscribeClient) ListSubscriptions(ctx context.Context, opts *ListSubscriptionsOptions) (*v1.InternalListSubscriptionsResponse, error) {
+	return c.listSubscriptions(ctx, newListSubscriptionsRequest(opts))
+}
+
 // GetTenantSubscriptions 获取商家指定产品订阅列表
+//
+// 需要按状态筛选、分页或排序时使用 ListSubscriptions
 func (c *SubscribeClient) GetTenantSubscriptions(ctx context.Context, tenantCode string, productCode string) ([]*v1.InternalSubscriptionInfo, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := c.client.InternalListSubscriptions(ctx, &v1.InternalListSubscriptionsRequest{
+	resp, err := c.listSubscriptions(ctx, &v1.InternalListSubscriptionsRequest{
 		TenantCode:  &tenantCode,
 		ProductCode: &productCode,
 	})
 	if err != nil {
-		c.logger.WithContext(ctx).Errorf("获取订阅列表失败:tenant_code=%s, product_code=%s,error=%v", tenantCode, productCode, err)
 		return nil, err
 	}
 
 	return resp.Subscriptions, nil
 }
 
+func newListSubscriptionsRequest(opts *ListSubscriptionsOptions) *v1.InternalListSubscriptionsRequest {
+	req := &v1.InternalListSubscriptionsRequest{}
+	if opts != nil {
+		if opts.TenantCode != "" {
+			req.TenantCode = &opts.TenantCode
+		}
+		if opts.ProductCode != "" {
+			req.ProductCode = &opts.ProductCode
+		}
+		if opts.Page > 0 {
+			req.Page = &opts.Page
+		}
+		if opts.PageSize > 0 {
+			req.PageSize = &opts.PageSize
+		}
+		if opts.SortBy != "" {
+			req.SortBy = &opts.SortBy
+		}
+		if opts.SortOrder != "" {
+			req.SortOrder = &opts.SortOrder
+		}
+		req.Status = opts.Status
+		req.IsTrial = opts.IsTrial
+	}
+	return req
+}
+
+func (c *SubscribeClient) listSubscriptions(ctx context.Context, req *v1.InternalListSubscriptionsRequest) (*v1.InternalListSubscriptionsResponse, error) {
+	resp, err := c.client.InternalListSubscriptions(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取订阅列表失败:tenant_code=%s, product_code=%s,error=%v", req.GetTenantCode(), req.GetProductCode(), err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
 type CreateSubscriptionOptions struct {
 	// 订阅开始时间
 	StartDate *timestamppb.Timestamp
@@ -155,9 +273,6 @@ func (c *SubscribeClient) CreateSubscription(ctx context.Context, productCode st
 		req.AutomaticRenewal = opts.AutomaticRenewal
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
-
 	resp, err := c.client.InternalCreateSubscription(ctx, req)
 	if err != nil {
 		c.logger.WithContext(ctx).Errorf("创建订阅失败:product_code=%s plan_code=:%s err=%v", productCode, planCode, err)
@@ -175,9 +290,6 @@ func (c *SubscribeClient) ReNewSubscription(ctx context.Context, productCode str
 		Order:       order,
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
-
 	resp, err := c.client.InternalReNewSubscription(ctx, req)
 	if err != nil {
 		c.logger.WithContext(ctx).Errorf("续订订阅失败:product_code=%s plan_code=:%s renew_time=:%s err=%v", productCode, planCode, reNewTime.String(), err)
@@ -212,9 +324,6 @@ func (c *SubscribeClient) UpgradeSubscription(ctx context.Context, productCode s
 		}
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
-
 	resp, err := c.client.InternalUpgradeSubscription(ctx, req)
 	if err != nil {
 		c.logger.WithContext(ctx).Errorf("升级订阅失败:product_code=%s plan_code=:%s err=%v", productCode, planCode, err)
@@ -224,10 +333,171 @@ func (c *SubscribeClient) UpgradeSubscription(ctx context.Context, productCode s
 	return resp.Subscription, nil
 }
 
+type DowngradeSubscriptionOptions struct {
+	// 是否立即生效，false 时在当前计费周期结束时生效
+	Immediate bool
+	// 指定生效时间，优先于 Immediate
+	EffectiveDate *timestamppb.Timestamp
+	// 订单信息（调整单）
+	Order *v1.InternalSubscriptionOrderInfo
+}
+
+// DowngradeResult 降级结果
+type DowngradeResult struct {
+	Subscription   *v1.InternalSubscriptionInfo // 订阅信息
+	EffectiveDate  *timestamppb.Timestamp       // 降级生效时间
+	ProratedCredit int64                        // 按比例折算的抵扣金额（分），用于生成调整账单
+	Currency       string                       // 货币单位
+}
+
+// DowngradeSubscription 降级订阅
+func (c *SubscribeClient) DowngradeSubscription(ctx context.Context, productCode string, planCode string, opts *DowngradeSubscriptionOptions) (*DowngradeResult, error) {
+	req := &v1.InternalDowngradeSubscriptionRequest{
+		ProductCode: productCode,
+		PlanCode:    planCode,
+	}
+	if opts != nil {
+		req.Immediate = opts.Immediate
+		req.EffectiveDate = opts.EffectiveDate
+		req.Order = opts.Order
+	}
+
+	resp, err := c.client.InternalDowngradeSubscription(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("降级订阅失败:product_code=%s plan_code=%s err=%v", productCode, planCode, err)
+		return nil, err
+	}
+
+	return &DowngradeResult{
+		Subscription:   resp.Subscription,
+		EffectiveDate:  resp.EffectiveDate,
+		ProratedCredit: resp.ProratedCredit,
+		Currency:       resp.Currency,
+	}, nil
+}
+
+type CancelSubscriptionOptions struct {
+	// 是否立即终止，false 时在当前计费周期结束时终止
+	Immediate bool
+	// 取消原因
+	Reason string
+}
+
+// CancelSubscription 取消订阅
+//
+// 默认在当前计费周期结束时终止（订阅保持可用，CancelAtPeriodEnd=true），
+// Immediate=true 时立即终止，用于退款流程
+func (c *SubscribeClient) CancelSubscription(ctx context.Context, productCode string, opts *CancelSubscriptionOptions) (*v1.InternalSubscriptionInfo, error) {
+	req := &v1.InternalCancelSubscriptionRequest{
+		ProductCode: productCode,
+	}
+	if opts != nil {
+		req.Immediate = opts.Immediate
+		req.Reason = opts.Reason
+	}
+
+	resp, err := c.client.InternalCancelSubscription(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("取消订阅失败:product_code=%s immediate=%t err=%v", productCode, req.Immediate, err)
+		return nil, err
+	}
+
+	return resp.Subscription, nil
+}
+
+type PauseSubscriptionOptions struct {
+	// 暂停生效时间，不填立即生效
+	EffectiveDate *timestamppb.Timestamp
+	// 自动恢复时间，不填需调用 ResumeSubscription 手动恢复
+	ResumeDate *timestamppb.Timestamp
+	// 暂停原因
+	Reason string
+}
+
+// PauseSubscription 暂停订阅
+//
+// 暂停期间不计用量、不消耗订阅时长，用于商户休假模式
+func (c *SubscribeClient) PauseSubscription(ctx context.Context, productCode string, opts *PauseSubscriptionOptions) (*v1.InternalSubscriptionInfo, error) {
+	req := &v1.InternalPauseSubscriptionRequest{
+		ProductCode: productCode,
+	}
+	if opts != nil {
+		req.EffectiveDate = opts.EffectiveDate
+		req.ResumeDate = opts.ResumeDate
+		req.Reason = opts.Reason
+	}
+
+	resp, err := c.client.InternalPauseSubscription(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("暂停订阅失败:product_code=%s err=%v", productCode, err)
+		return nil, err
+	}
+
+	return resp.Subscription, nil
+}
+
+type ResumeSubscriptionOptions struct {
+	// 恢复生效时间，不填立即生效
+	EffectiveDate *timestamppb.Timestamp
+}
+
+// ResumeSubscription 恢复已暂停的订阅
+func (c *SubscribeClient) ResumeSubscription(ctx context.Context, productCode string, opts *ResumeSubscriptionOptions) (*v1.InternalSubscriptionInfo, error) {
+	req := &v1.InternalResumeSubscriptionRequest{
+		ProductCode: productCode,
+	}
+	if opts != nil {
+		req.EffectiveDate = opts.EffectiveDate
+	}
+
+	resp, err := c.client.InternalResumeSubscription(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("恢复订阅失败:product_code=%s err=%v", productCode, err)
+		return nil, err
+	}
+
+	return resp.Subscription, nil
+}
+
+// ConvertTrial 试用订阅转为正式订阅
+func (c *SubscribeClient) ConvertTrial(ctx context.Context, productCode string, planCode string, order *v1.InternalSubscriptionOrderInfo) (*v1.InternalSubscriptionInfo, error) {
+	resp, err := c.client.InternalConvertTrial(ctx, &v1.InternalConvertTrialRequest{
+		ProductCode: productCode,
+		PlanCode:    planCode,
+		Order:       order,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("试用转正式失败:product_code=%s plan_code=%s err=%v", productCode, planCode, err)
+		return nil, err
+	}
+
+	return resp.Subscription, nil
+}
+
+// ExtendTrial 延长试用期
+func (c *SubscribeClient) ExtendTrial(ctx context.Context, productCode string, extendTime *durationpb.Duration) (*v1.InternalSubscriptionInfo, error) {
+	if extendTime.AsDuration() <= 0 {
+		return nil, fmt.Errorf("延长时长必须大于0")
+	}
+
+	resp, err := c.client.InternalExtendTrial(ctx, &v1.InternalExtendTrialRequest{
+		ProductCode: productCode,
+		ExtendTime:  extendTime,
+	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("延长试用期失败:product_code=%s extend_time=%s err=%v", productCode, extendTime.AsDuration(), err)
+		return nil, err
+	}
+
+	return resp.Subscription, nil
+}
+
 // 获取商户订阅状态
 func (c *SubscribeClient) InternalGetSubscriptionStats(ctx context.Context, tenantCode string) (*v1.InternalGetSubscriptionStatsResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
 
 	resp, err := c.client.InternalGetSubscriptionStats(ctx, &v1.InternalGetSubscriptionStatsRequest{TenantCode: tenantCode})
 	if err != nil {
@@ -240,9 +510,6 @@ func (c *SubscribeClient) InternalGetSubscriptionStats(ctx context.Context, tena
 
 func (c *SubscribeClient) InternalGetSubscriptionStatsByProductCode(ctx context.Context, productCode string) (
 	*v1.InternalGetSubscriptionStatsByProductCodeResponse, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
-
 	resp, err := c.client.InternalGetSubscriptionStatsByProductCode(ctx,
 		&v1.InternalGetSubscriptionStatsByProductCodeRequest{ProductCode: productCode})
 	if err != nil {
@@ -265,18 +532,27 @@ type QuotaResult struct {
 	UsagePercentage float64                   // 使用百分比
 	ErrorMessage    string                    // 错误信息
 	ErrorCode       v1.InternalQuotaErrorCode // 错误码
+	Replayed        bool                      // 是否为幂等重放（本次未重复扣减/释放）
+	Unit            string                    // 单位（个、GB、次），仅 GetUsage 返回
 }
 
 // Use 使用配额
-func (c *SubscribeClient) Use(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32) (*QuotaResult, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+//
+// 可通过 WithIdempotencyKey 设置幂等键，避免网络重试导致重复扣减
+func (c *SubscribeClient) Use(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32, callOpts ...CallOption) (*QuotaResult, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = c.quotaCallContext(ctx, callOpts)
 
 	resp, err := c.client.InternalCheckAndUseQuota(ctx, &v1.InternalCheckAndUseQuotaRequest{
-		TenantCode:   tenantCode,
-		ProductCode:  productCode,
-		DimensionKey: dimensionKey,
-		Amount:       amount,
+		TenantCode:     tenantCode,
+		ProductCode:    productCode,
+		DimensionKey:   dimensionKey,
+		Amount:         amount,
+		IdempotencyKey: idempotencyKeyFromContext(ctx),
 	})
 	if err != nil {
 		c.logger.WithContext(ctx).Errorf("配额使用失败: tenant=%s, product=%s, dimension=%s, err=%v",
@@ -294,32 +570,81 @@ func (c *SubscribeClient) Use(ctx context.Context, tenantCode, productCode, dime
 		IsUnlimited:     resp.IsUnlimited,
 		ErrorMessage:    resp.ErrorMessage,
 		ErrorCode:       resp.ErrorCode,
+		Replayed:        resp.Replayed,
 	}, nil
 }
 
 // MustUse 使用配额
-func (c *SubscribeClient) MustUse(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32) error {
-	result, err := c.Use(ctx, tenantCode, productCode, dimensionKey, amount)
+//
+// 配额不足时返回 *QuotaExceededError
+func (c *SubscribeClient) MustUse(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32, callOpts ...CallOption) error {
+	result, err := c.Use(ctx, tenantCode, productCode, dimensionKey, amount, callOpts...)
 	if err != nil {
 		return err
 	}
 	if !result.Success {
+		if result.ErrorCode == v1.InternalQuotaErrorCode_INTERNAL_QUOTA_ERROR_EXCEEDED {
+			return NewQuotaExceededError(result)
+		}
 		return fmt.Errorf("配额不足: %s", result.ErrorMessage)
 	}
 	return nil
 }
 
-// Release 释放配额
-func (c *SubscribeClient) Release(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32) (*QuotaResult, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+// Check 预检查配额，不扣减用量
+//
+// 返回的 QuotaResult.Success 表示是否允许使用 amount，QuotaRemaining 为当前剩余量，
+// 用于表单校验和预检向导（如"还可以添加3个商品"）。结果仅供展示，实际扣减仍需 Use/MustUse
+func (c *SubscribeClient) Check(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32, callOpts ...CallOption) (*QuotaResult, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := c.client.InternalReleaseQuota(ctx, &v1.InternalReleaseQuotaRequest{
+	ctx = c.quotaCallContext(ctx, callOpts)
+
+	resp, err := c.client.InternalCheckQuota(ctx, &v1.InternalCheckQuotaRequest{
 		TenantCode:   tenantCode,
 		ProductCode:  productCode,
 		DimensionKey: dimensionKey,
 		Amount:       amount,
 	})
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("配额预检查失败: tenant=%s, product=%s, dimension=%s, err=%v",
+			tenantCode, productCode, dimensionKey, err)
+		return nil, err
+	}
+
+	return &QuotaResult{
+		Success:        resp.Allowed,
+		DimensionKey:   resp.DimensionKey,
+		QuotaLimit:     resp.QuotaLimit,
+		QuotaUsed:      resp.QuotaUsed,
+		QuotaRemaining: resp.QuotaRemaining,
+		IsUnlimited:    resp.IsUnlimited,
+		ErrorMessage:   resp.ErrorMessage,
+		ErrorCode:      resp.ErrorCode,
+	}, nil
+}
+
+// Release 释放配额
+//
+// 可通过 WithIdempotencyKey 设置幂等键，避免网络重试导致重复释放
+func (c *SubscribeClient) Release(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32, callOpts ...CallOption) (*QuotaResult, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = c.quotaCallContext(ctx, callOpts)
+
+	resp, err := c.client.InternalReleaseQuota(ctx, &v1.InternalReleaseQuotaRequest{
+		TenantCode:     tenantCode,
+		ProductCode:    productCode,
+		DimensionKey:   dimensionKey,
+		Amount:         amount,
+		IdempotencyKey: idempotencyKeyFromContext(ctx),
+	})
 	if err != nil {
 		c.logger.WithContext(ctx).Errorf("配额释放失败: tenant=%s, product=%s, dimension=%s, err=%v",
 			tenantCode, productCode, dimensionKey, err)
@@ -332,13 +657,18 @@ func (c *SubscribeClient) Release(ctx context.Context, tenantCode, productCode,
 		QuotaUsed:       resp.QuotaUsedAfter,
 		QuotaUsedBefore: resp.QuotaUsedBefore,
 		ErrorMessage:    resp.ErrorMessage,
+		Replayed:        resp.Replayed,
 	}, nil
 }
 
 // GetUsage 查询配额使用情况
-func (c *SubscribeClient) GetUsage(ctx context.Context, tenantCode, productCode string, dimensionKey *string) ([]*QuotaResult, error) {
-	ctx, cancel := context.WithTimeout(ctx, c.config.Timeout)
-	defer cancel()
+func (c *SubscribeClient) GetUsage(ctx context.Context, tenantCode, productCode string, dimensionKey *string, callOpts ...CallOption) ([]*QuotaResult, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = middleware.ApplyCallOptions(ctx, callOpts...)
 
 	resp, err := c.client.InternalGetQuotaUsage(ctx, &v1.InternalGetQuotaUsageRequest{
 		TenantCode:   tenantCode,
@@ -361,6 +691,7 @@ func (c *SubscribeClient) GetUsage(ctx context.Context, tenantCode, productCode
 			QuotaRemaining:  u.QuotaRemaining,
 			IsUnlimited:     u.IsUnlimited,
 			UsagePercentage: u.UsagePercentage,
+			Unit:            u.GetUnit(),
 		})
 	}
 	return results, nil
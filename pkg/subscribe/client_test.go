@@ -220,3 +220,29 @@ func TestInternalGetSubscriptionStats(t *testing.T) {
 	}
 	t.Logf("获取商户订阅状态成功: %v", subscription)
 }
+
+func TestNewListSubscriptionsRequest(t *testing.T) {
+	req := newListSubscriptionsRequest(nil)
+	if req.TenantCode != nil || req.Page != nil || req.Status != nil {
+		t.Errorf("nil options should produce empty request, got %v", req)
+	}
+
+	status := v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_ACTIVE
+	req = newListSubscriptionsRequest(&ListSubscriptionsOptions{
+		TenantCode: "1001",
+		Status:     &status,
+		Page:       2,
+		PageSize:   20,
+		SortBy:     SortByEndDate,
+		SortOrder:  SortOrderDesc,
+	})
+	if req.GetTenantCode() != "1001" || req.ProductCode != nil {
+		t.Errorf("unexpected code filters: %v", req)
+	}
+	if req.GetStatus() != status || req.GetPage() != 2 || req.GetPageSize() != 20 {
+		t.Errorf("unexpected filters: %v", req)
+	}
+	if req.GetSortBy() != SortByEndDate || req.GetSortOrder() != SortOrderDesc {
+		t.Errorf("unexpected sort: %v", req)
+	}
+}
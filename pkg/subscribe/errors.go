@@ -0,0 +1,72 @@
+package subscribe
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ErrQuotaExceeded 配额不足
+//
+// MustUse 在配额不足时返回 *QuotaExceededError，可用 errors.Is(err, subscribe.ErrQuotaExceeded) 判断
+var ErrQuotaExceeded = errors.New(429, "QUOTA_EXCEEDED", "配额不足")
+
+// ErrSubscriptionRequired 租户没有可用的产品订阅（未订阅、已过期或已暂停）
+var ErrSubscriptionRequired = errors.New(403, "SUBSCRIPTION_REQUIRED", "未订阅该产品或订阅已失效")
+
+// ErrFeatureNotAvailable 当前套餐不包含该功能，metadata 中的 feature 为功能标识
+var ErrFeatureNotAvailable = errors.New(403, "FEATURE_NOT_AVAILABLE", "当前套餐不支持该功能")
+
+// QuotaExceededError 配额不足错误，携带维度和用量详情
+//
+// 使用示例:
+//
+//	err := client.MustUse(ctx, tenantCode, productCode, "goods_count", 1)
+//	var qe *subscribe.QuotaExceededError
+//	if errors.As(err, &qe) {
+//	    return fmt.Errorf("商品数量已达上限%d，请升级套餐", qe.Limit)
+//	}
+//
+// 该错误可解包为带 metadata 的 kratos 错误（429 QUOTA_EXCEEDED），
+// 直接返回给 HTTP/gRPC 层时会保留 dimension_key、limit、used、remaining
+type QuotaExceededError struct {
+	DimensionKey string // 维度标识
+	Limit        int32  // 配额上限
+	Used         int32  // 当前已使用量
+	Remaining    int32  // 剩余配额
+	Message      string // 服务端错误信息
+
+	err *errors.Error
+}
+
+// NewQuotaExceededError 根据配额操作结果创建配额不足错误
+func NewQuotaExceededError(result *QuotaResult) *QuotaExceededError {
+	e := &QuotaExceededError{
+		DimensionKey: result.DimensionKey,
+		Limit:        result.QuotaLimit,
+		Used:         result.QuotaUsed,
+		Remaining:    result.QuotaRemaining,
+		Message:      result.ErrorMessage,
+	}
+	e.err = errors.Clone(ErrQuotaExceeded).WithMetadata(map[string]string{
+		"dimension_key": e.DimensionKey,
+		"limit":         strconv.Itoa(int(e.Limit)),
+		"used":          strconv.Itoa(int(e.Used)),
+		"remaining":     strconv.Itoa(int(e.Remaining)),
+	})
+	if e.Message != "" {
+		e.err.Message = e.Message
+	}
+	return e
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("配额不足: dimension=%s, limit=%d, used=%d, remaining=%d, message=%s",
+		e.DimensionKey, e.Limit, e.Used, e.Remaining, e.Message)
+}
+
+// Unwrap 返回带 metadata 的 kratos 错误
+func (e *QuotaExceededError) Unwrap() error {
+	return e.err
+}
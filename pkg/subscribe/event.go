@@ -0,0 +1,139 @@
+package subscribe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/heyinLab/common/api/gen/go/subscribe/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// DefaultEventTopic 订阅服务发布生命周期事件的默认主题
+const DefaultEventTopic = "subscription.events"
+
+// Broker 消息订阅接口
+//
+// 由业务方适配具体的消息队列（Kafka、NATS、Redis Stream 等），handler 返回错误时
+// 由适配层决定是否重投
+type Broker interface {
+	Subscribe(topic string, handler func(ctx context.Context, payload []byte) error) error
+}
+
+// EventHandlerFunc 订阅事件处理函数
+type EventHandlerFunc func(ctx context.Context, event *v1.InternalSubscriptionEvent) error
+
+// EventHandlers 订阅事件回调，未设置的事件类型会被忽略
+type EventHandlers struct {
+	// 订阅创建
+	OnCreated EventHandlerFunc
+	// 订阅续费
+	OnRenewed EventHandlerFunc
+	// 订阅过期
+	OnExpired EventHandlerFunc
+	// 配额变更
+	OnQuotaChanged EventHandlerFunc
+}
+
+// EventConsumer 订阅生命周期事件消费者
+//
+// 解码订阅服务发布的事件（protojson 编码的 InternalSubscriptionEvent）并按类型分发到回调
+//
+// 使用示例:
+//
+//	consumer := subscribe.NewEventConsumer(broker, &subscribe.EventHandlers{
+//	    OnExpired: func(ctx context.Context, e *v1.InternalSubscriptionEvent) error {
+//	        statusCache.Invalidate(e.TenantCode, e.ProductCode)
+//	        return nil
+//	    },
+//	    OnQuotaChanged: func(ctx context.Context, e *v1.InternalSubscriptionEvent) error {
+//	        quotaCache.Invalidate(e.TenantCode, e.ProductCode)
+//	        return nil
+//	    },
+//	})
+//	if err := consumer.Start(); err != nil {
+//	    return err
+//	}
+type EventConsumer struct {
+	broker   Broker
+	handlers EventHandlers
+	topic    string
+	logger   *log.Helper
+}
+
+// NewEventConsumer 创建订阅事件消费者
+//
+// 参数:
+//   - broker: 消息订阅实现
+//   - handlers: 事件回调
+func NewEventConsumer(broker Broker, handlers *EventHandlers) *EventConsumer {
+	c := &EventConsumer{
+		broker: broker,
+		topic:  DefaultEventTopic,
+		logger: log.NewHelper(log.With(
+			log.GetLogger(),
+			"module", "subscribe-event-consumer",
+		)),
+	}
+	if handlers != nil {
+		c.handlers = *handlers
+	}
+	return c
+}
+
+// WithTopic 设置订阅主题，默认 DefaultEventTopic
+func (c *EventConsumer) WithTopic(topic string) *EventConsumer {
+	c.topic = topic
+	return c
+}
+
+// Start 订阅主题并开始消费
+func (c *EventConsumer) Start() error {
+	if c.broker == nil {
+		return fmt.Errorf("消息订阅实例不能为空")
+	}
+	if err := c.broker.Subscribe(c.topic, c.Handle); err != nil {
+		return fmt.Errorf("订阅事件主题失败: topic=%s, error=%w", c.topic, err)
+	}
+	return nil
+}
+
+// Handle 解码并分发单条事件消息
+//
+// 推送式消息队列可直接将该方法注册为消息处理函数。消息格式错误时返回错误，
+// 未知事件类型或未设置回调时忽略
+func (c *EventConsumer) Handle(ctx context.Context, payload []byte) error {
+	event := &v1.InternalSubscriptionEvent{}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(payload, event); err != nil {
+		c.logger.WithContext(ctx).Errorf("解析订阅事件失败: error=%v", err)
+		return fmt.Errorf("解析订阅事件失败: %w", err)
+	}
+
+	handler := c.handler(event.EventType)
+	if handler == nil {
+		return nil
+	}
+
+	if err := handler(ctx, event); err != nil {
+		c.logger.WithContext(ctx).Errorf("处理订阅事件失败: event_id=%s, event_type=%s, tenant_code=%s, product_code=%s, error=%v",
+			event.EventId, event.EventType, event.TenantCode, event.ProductCode, err)
+		return err
+	}
+	return nil
+}
+
+// handler 根据事件类型选择回调
+func (c *EventConsumer) handler(eventType v1.InternalSubscriptionEventType) EventHandlerFunc {
+	switch eventType {
+	case v1.InternalSubscriptionEventType_INTERNAL_SUBSCRIPTION_EVENT_TYPE_CREATED:
+		return c.handlers.OnCreated
+	case v1.InternalSubscriptionEventType_INTERNAL_SUBSCRIPTION_EVENT_TYPE_RENEWED:
+		return c.handlers.OnRenewed
+	case v1.InternalSubscriptionEventType_INTERNAL_SUBSCRIPTION_EVENT_TYPE_EXPIRED:
+		return c.handlers.OnExpired
+	case v1.InternalSubscriptionEventType_INTERNAL_SUBSCRIPTION_EVENT_TYPE_QUOTA_CHANGED:
+		return c.handlers.OnQuotaChanged
+	default:
+		return nil
+	}
+}
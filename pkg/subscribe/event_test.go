@@ -0,0 +1,63 @@
+package subscribe
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/subscribe/v1"
+)
+
+// mockBroker 记录订阅的主题和处理函数
+type mockBroker struct {
+	topic   string
+	handler func(ctx context.Context, payload []byte) error
+}
+
+func (b *mockBroker) Subscribe(topic string, handler func(ctx context.Context, payload []byte) error) error {
+	b.topic = topic
+	b.handler = handler
+	return nil
+}
+
+func TestEventConsumer(t *testing.T) {
+	broker := &mockBroker{}
+	var expired, quota []string
+	consumer := NewEventConsumer(broker, &EventHandlers{
+		OnExpired: func(ctx context.Context, e *v1.InternalSubscriptionEvent) error {
+			expired = append(expired, e.TenantCode)
+			return nil
+		},
+		OnQuotaChanged: func(ctx context.Context, e *v1.InternalSubscriptionEvent) error {
+			quota = append(quota, e.QuotaUsages[0].DimensionKey)
+			return nil
+		},
+	})
+	if err := consumer.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	if broker.topic != DefaultEventTopic {
+		t.Errorf("unexpected topic %q", broker.topic)
+	}
+
+	ctx := context.Background()
+	messages := []string{
+		`{"eventId":"1","eventType":"INTERNAL_SUBSCRIPTION_EVENT_TYPE_EXPIRED","tenantCode":"1001","productCode":"mall"}`,
+		`{"eventId":"2","eventType":"INTERNAL_SUBSCRIPTION_EVENT_TYPE_QUOTA_CHANGED","tenantCode":"1001","quotaUsages":[{"dimensionKey":"goods_count"}],"extra":1}`,
+		`{"eventId":"3","eventType":"INTERNAL_SUBSCRIPTION_EVENT_TYPE_CREATED","tenantCode":"1002"}`,
+	}
+	for _, msg := range messages {
+		if err := broker.handler(ctx, []byte(msg)); err != nil {
+			t.Fatalf("handle %s failed: %v", msg, err)
+		}
+	}
+	if len(expired) != 1 || expired[0] != "1001" {
+		t.Errorf("unexpected expired events: %v", expired)
+	}
+	if len(quota) != 1 || quota[0] != "goods_count" {
+		t.Errorf("unexpected quota events: %v", quota)
+	}
+
+	if err := consumer.Handle(ctx, []byte("not json")); err == nil {
+		t.Error("expected error for malformed payload")
+	}
+}
@@ -0,0 +1,199 @@
+package subscribe
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/middleware"
+	v1 "github.com/heyinLab/common/api/gen/go/subscribe/v1"
+	businessErrors "github.com/heyinLab/common/pkg/errors"
+	"github.com/heyinLab/common/pkg/internal/ttlcache"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// DefaultFeatureCacheTTL 功能开关判断结果的默认缓存时间
+const DefaultFeatureCacheTTL = 30 * time.Second
+
+// FeatureGateOption 功能开关中间件选项
+type FeatureGateOption func(*featureGate)
+
+// WithFeatureProductCode 指定产品编码
+//
+// 默认从请求上下文读取（auth.GetProductCode，仅 OpenAPI 和服务间调用有值），
+// 面向用户的接口需指定本服务所属的产品
+func WithFeatureProductCode(productCode string) FeatureGateOption {
+	return func(g *featureGate) {
+		g.productCode = productCode
+	}
+}
+
+// WithFeatureCacheTTL 设置判断结果的缓存时间，<=0 时不缓存
+func WithFeatureCacheTTL(ttl time.Duration) FeatureGateOption {
+	return func(g *featureGate) {
+		g.ttl = ttl
+	}
+}
+
+// WithFeatureGracePeriod 设置订阅到期后的宽限期，宽限期内功能仍可使用，与 StatusCache.WithGracePeriod 一致
+func WithFeatureGracePeriod(d time.Duration) FeatureGateOption {
+	return func(g *featureGate) {
+		g.gracePeriod = d
+	}
+}
+
+// FeatureGate 套餐功能开关中间件
+//
+// 检查当前租户的产品订阅是否可用，且套餐参数（订阅的配额快照）中 featureKey 为开启状态，
+// 否则拒绝请求。判断结果按 租户+产品 短暂缓存（至多 ttlcache.DefaultMaxEntries 个），
+// 套餐变更最多延迟一个缓存周期生效。订阅是否可用的判断与 StatusCache 相同，
+// 通过 WithFeatureGracePeriod 设置宽限期
+//
+// 参数值为 true、非 0 数字（含 -1 无限制）或可解析为以上值的字符串时视为开启
+//
+// 参数:
+//   - client: 订阅服务客户端
+//   - featureKey: 功能标识，即套餐规则键名
+//   - opts: 可选配置
+//
+// 返回的错误:
+//   - TENANT_MISSING: 认证信息中缺少租户（与 auth 中间件一致）
+//   - ErrSubscriptionRequired: 没有可用的订阅（403）
+//   - ErrFeatureNotAvailable: 套餐不包含该功能（403）
+//
+// 使用示例:
+//
+//	selector.Server(subscribe.FeatureGate(client.SubscribeClient(), "custom_domain",
+//	    subscribe.WithFeatureProductCode("mall"),
+//	)).Path("/api.mall.v1.Shop/BindDomain").Build()
+func FeatureGate(client *SubscribeClient, featureKey string, opts ...FeatureGateOption) middleware.Middleware {
+	return newFeatureGate(client, featureKey, opts).middleware()
+}
+
+// featureGate 功能开关判断
+type featureGate struct {
+	client      subscriptionLister
+	featureKey  string
+	productCode string
+	ttl         time.Duration
+	gracePeriod time.Duration
+	now         func() time.Time
+	cache       *ttlcache.Cache[string, featureDecision]
+}
+
+// featureDecision 缓存的判断结果
+type featureDecision struct {
+	subscribed bool
+	enabled    bool
+}
+
+func newFeatureGate(client subscriptionLister, featureKey string, opts []FeatureGateOption) *featureGate {
+	g := &featureGate{
+		client:     client,
+		featureKey: featureKey,
+		ttl:        DefaultFeatureCacheTTL,
+		now:        time.Now,
+		cache:      ttlcache.New[string, featureDecision](0),
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+func (g *featureGate) middleware() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if err := g.check(ctx); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// check 检查当前租户是否可以使用该功能
+func (g *featureGate) check(ctx context.Context) error {
+	tenantCode := claimsTenant(ctx)
+	if tenantCode == "" {
+		return businessErrors.ErrTenantMissing.ToKratos()
+	}
+	productCode := g.productCode
+	if productCode == "" {
+		productCode = auth.GetProductCode(ctx)
+	}
+	if productCode == "" {
+		return errors.InternalServer("PRODUCT_CODE_MISSING", "未指定产品编码")
+	}
+
+	decision, err := g.decide(ctx, tenantCode, productCode)
+	if err != nil {
+		return err
+	}
+	if !decision.subscribed {
+		return ErrSubscriptionRequired
+	}
+	if !decision.enabled {
+		return errors.Clone(ErrFeatureNotAvailable).WithMetadata(map[string]string{"feature": g.featureKey})
+	}
+	return nil
+}
+
+// decide 查询订阅并判断功能是否开启，结果按 租户+产品 缓存
+func (g *featureGate) decide(ctx context.Context, tenantCode, productCode string) (featureDecision, error) {
+	key := statusCacheKey(tenantCode, productCode)
+	if decision, ok := g.cache.Get(key); ok {
+		return decision, nil
+	}
+
+	subscriptions, err := g.client.GetTenantSubscriptions(ctx, tenantCode, productCode)
+	if err != nil {
+		return featureDecision{}, err
+	}
+
+	now := g.now()
+	decision := featureDecision{}
+	for _, sub := range subscriptions {
+		if !subscriptionState(sub, now, g.gracePeriod).Usable() {
+			continue
+		}
+		decision.subscribed = true
+		if featureEnabled(sub, g.featureKey) {
+			decision.enabled = true
+			break
+		}
+	}
+
+	if g.ttl > 0 {
+		g.cache.Set(key, decision, g.ttl)
+	}
+	return decision, nil
+}
+
+// featureEnabled 订阅的套餐参数中功能是否开启
+func featureEnabled(sub *v1.InternalSubscriptionInfo, featureKey string) bool {
+	value, ok := sub.GetQuotaSnapshot().GetFields()[featureKey]
+	if !ok {
+		return false
+	}
+	switch v := value.GetKind().(type) {
+	case *structpb.Value_BoolValue:
+		return v.BoolValue
+	case *structpb.Value_NumberValue:
+		return v.NumberValue != 0
+	case *structpb.Value_StringValue:
+		s := strings.TrimSpace(v.StringValue)
+		if b, err := strconv.ParseBool(s); err == nil {
+			return b
+		}
+		if n, err := strconv.ParseFloat(s, 64); err == nil {
+			return n != 0
+		}
+		return false
+	default:
+		return false
+	}
+}
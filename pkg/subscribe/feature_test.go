@@ -0,0 +1,109 @@
+package subscribe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	v1 "github.com/heyinLab/common/api/gen/go/subscribe/v1"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newSnapshotSubscription(status v1.InternalSubscriptionStatus, snapshot map[string]interface{}) *v1.InternalSubscriptionInfo {
+	s, _ := structpb.NewStruct(snapshot)
+	return &v1.InternalSubscriptionInfo{
+		Status:        status,
+		EndDate:       timestamppb.New(time.Now().Add(time.Hour)),
+		QuotaSnapshot: s,
+	}
+}
+
+func TestFeatureGate(t *testing.T) {
+	lister := &mockLister{subs: []*v1.InternalSubscriptionInfo{
+		newSnapshotSubscription(v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_ACTIVE, map[string]interface{}{
+			"custom_domain": true,
+			"api_access":    "1",
+			"white_label":   false,
+		}),
+	}}
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001"})
+	okHandler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+
+	for _, feature := range []string{"custom_domain", "api_access"} {
+		h := newFeatureGate(lister, feature, []FeatureGateOption{WithFeatureProductCode("mall")}).middleware()(okHandler)
+		if _, err := h(ctx, nil); err != nil {
+			t.Errorf("%s: expected allowed, got %v", feature, err)
+		}
+	}
+
+	for _, feature := range []string{"white_label", "missing"} {
+		h := newFeatureGate(lister, feature, []FeatureGateOption{WithFeatureProductCode("mall")}).middleware()(okHandler)
+		_, err := h(ctx, nil)
+		if e := errors.FromError(err); e.Reason != "FEATURE_NOT_AVAILABLE" || e.Metadata["feature"] != feature {
+			t.Errorf("%s: expected FEATURE_NOT_AVAILABLE, got %v", feature, err)
+		}
+	}
+
+	// 结果缓存，不重复查询订阅服务
+	gate := newFeatureGate(lister, "custom_domain", []FeatureGateOption{WithFeatureProductCode("mall")})
+	h := gate.middleware()(okHandler)
+	calls := lister.calls
+	h(ctx, nil)
+	h(ctx, nil)
+	if lister.calls != calls+1 {
+		t.Errorf("Expected 1 fetch, got %d", lister.calls-calls)
+	}
+
+	// 产品编码从上下文读取
+	openapi := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001", ProductCode: "mall"})
+	if _, err := newFeatureGate(lister, "custom_domain", nil).middleware()(okHandler)(openapi, nil); err != nil {
+		t.Errorf("Expected product code from claims, got %v", err)
+	}
+	if _, err := newFeatureGate(lister, "custom_domain", nil).middleware()(okHandler)(ctx, nil); errors.FromError(err).Reason != "PRODUCT_CODE_MISSING" {
+		t.Errorf("Expected PRODUCT_CODE_MISSING, got %v", err)
+	}
+}
+
+func TestFeatureGate_SubscriptionRequired(t *testing.T) {
+	lister := &mockLister{subs: []*v1.InternalSubscriptionInfo{
+		newSnapshotSubscription(v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_SUSPENDED, map[string]interface{}{
+			"custom_domain": true,
+		}),
+	}}
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001"})
+
+	h := newFeatureGate(lister, "custom_domain", []FeatureGateOption{WithFeatureProductCode("mall")}).middleware()(
+		func(context.Context, interface{}) (interface{}, error) { return "ok", nil })
+	if _, err := h(ctx, nil); !errors.Is(err, ErrSubscriptionRequired) {
+		t.Errorf("Expected ErrSubscriptionRequired, got %v", err)
+	}
+	if _, err := h(context.Background(), nil); errors.FromError(err).Reason != "TENANT_MISSING" {
+		t.Errorf("Expected TENANT_MISSING, got %v", err)
+	}
+}
+
+func TestFeatureGate_GracePeriod(t *testing.T) {
+	sub := newSnapshotSubscription(v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_ACTIVE, map[string]interface{}{
+		"custom_domain": true,
+	})
+	sub.EndDate = timestamppb.New(time.Now().Add(-time.Hour))
+	lister := &mockLister{subs: []*v1.InternalSubscriptionInfo{sub}}
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", TenantCode: "T001"})
+	okHandler := func(context.Context, interface{}) (interface{}, error) { return "ok", nil }
+
+	h := newFeatureGate(lister, "custom_domain", []FeatureGateOption{WithFeatureProductCode("mall")}).middleware()(okHandler)
+	if _, err := h(ctx, nil); !errors.Is(err, ErrSubscriptionRequired) {
+		t.Errorf("Expected ErrSubscriptionRequired after expiry, got %v", err)
+	}
+
+	h = newFeatureGate(lister, "custom_domain", []FeatureGateOption{
+		WithFeatureProductCode("mall"),
+		WithFeatureGracePeriod(24 * time.Hour),
+	}).middleware()(okHandler)
+	if _, err := h(ctx, nil); err != nil {
+		t.Errorf("Expected allowed within grace period, got %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package subscribe
+
+import (
+	"context"
+	"time"
+
+	middleware "github.com/heyinLab/common/pkg/middleware/grpc"
+)
+
+// CallOption 单次调用选项
+//
+// 用于配额接口（Use/MustUse/Release/Check），覆盖客户端配置中的超时、重试等参数，仅对当前调用生效。
+// 与 resource、product 相同，均为 middleware.CallOption，可与 middleware.CallHeader 等混用
+//
+// 使用示例:
+//
+//	// 以订单号作为幂等键，网络重试时不会重复扣减
+//	result, err := client.Use(ctx, tenantCode, productCode, "goods_count", 1,
+//	    subscribe.WithIdempotencyKey("order:"+orderNo),
+//	    subscribe.WithTimeout(300*time.Millisecond),
+//	    subscribe.WithRetry(2, 50*time.Millisecond))
+type CallOption = middleware.CallOption
+
+// idempotencyKeyCtx 幂等键的 context key
+type idempotencyKeyCtx struct{}
+
+// WithIdempotencyKey 设置幂等键，仅对 Use/MustUse/Release 生效
+//
+// 相同幂等键的重复请求由服务端去重，返回首次处理结果且 QuotaResult.Replayed=true
+func WithIdempotencyKey(key string) CallOption {
+	return middleware.CallValue(idempotencyKeyCtx{}, key)
+}
+
+// WithTimeout 设置单次调用的超时时间
+//
+// 超时时间是整个调用的总时间，包含全部重试和退避，超时后不再重试
+func WithTimeout(timeout time.Duration) CallOption {
+	return middleware.CallTimeout(timeout)
+}
+
+// WithRetry 设置单次调用的重试策略，仅对 Unavailable 等临时性错误重试
+//
+// 参数:
+//   - maxRetries: 最大重试次数（不含首次调用），0 表示不重试
+//   - backoff: 首次重试前的退避时间，之后按指数增长
+//
+// 说明:
+//   - Use/Release 重试时建议同时设置 WithIdempotencyKey，避免请求已到达服务端时重复扣减
+func WithRetry(maxRetries int, backoff time.Duration) CallOption {
+	return middleware.CallRetry(maxRetries, backoff)
+}
+
+// WithQuotaCallOptions 设置配额接口的默认调用选项
+//
+// 配额接口位于请求关键路径，通常需要比客户端默认配置更短的超时和更积极的重试。
+// 默认选项先于单次调用传入的选项生效，可被后者覆盖
+//
+// 使用示例:
+//
+//	quota := client.SubscribeClient().WithQuotaCallOptions(
+//	    subscribe.WithTimeout(300*time.Millisecond),
+//	    subscribe.WithRetry(2, 50*time.Millisecond),
+//	)
+func (c *SubscribeClient) WithQuotaCallOptions(opts ...CallOption) *SubscribeClient {
+	c.quotaCallOpts = append([]CallOption(nil), opts...)
+	return c
+}
+
+// quotaCallContext 将默认选项和单次调用选项写入配额接口的上下文
+//
+// 超时由连接的 Timeout 中间件控制，覆盖全部重试
+func (c *SubscribeClient) quotaCallContext(ctx context.Context, callOpts []CallOption) context.Context {
+	if len(c.quotaCallOpts) == 0 {
+		return middleware.ApplyCallOptions(ctx, callOpts...)
+	}
+	opts := make([]CallOption, 0, len(c.quotaCallOpts)+len(callOpts))
+	opts = append(opts, c.quotaCallOpts...)
+	opts = append(opts, callOpts...)
+	return middleware.ApplyCallOptions(ctx, opts...)
+}
+
+// idempotencyKeyFromContext 获取 WithIdempotencyKey 设置的幂等键，未设置时返回 nil
+func idempotencyKeyFromContext(ctx context.Context) *string {
+	key, _ := ctx.Value(idempotencyKeyCtx{}).(string)
+	if key == "" {
+		return nil
+	}
+	return &key
+}
@@ -0,0 +1,52 @@
+package subscribe
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	middleware "github.com/heyinLab/common/pkg/middleware/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestCallOptions(t *testing.T) {
+	c := (&SubscribeClient{}).WithQuotaCallOptions(WithTimeout(300*time.Millisecond), WithIdempotencyKey("default"))
+
+	ctx := c.quotaCallContext(context.Background(), nil)
+	if key := idempotencyKeyFromContext(ctx); key == nil || *key != "default" {
+		t.Errorf("defaults not applied: %v", key)
+	}
+
+	ctx = c.quotaCallContext(context.Background(), []CallOption{WithIdempotencyKey("k")})
+	if key := idempotencyKeyFromContext(ctx); key == nil || *key != "k" {
+		t.Errorf("per-call options should override defaults: %v", key)
+	}
+
+	ctx = (&SubscribeClient{}).quotaCallContext(context.Background(), nil)
+	if key := idempotencyKeyFromContext(ctx); key != nil {
+		t.Errorf("unexpected idempotency key: %v", *key)
+	}
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("deadline should be set by the Timeout middleware")
+	}
+
+	// 超时和重试由连接中的 Timeout、RetryPolicy 中间件生效
+	calls := 0
+	var deadline time.Time
+	handler := middleware.Timeout(10 * time.Second)(middleware.RetryPolicy(nil)(func(ctx context.Context, req interface{}) (interface{}, error) {
+		calls++
+		deadline, _ = ctx.Deadline()
+		if calls < 3 {
+			return nil, status.Error(codes.Unavailable, "unavailable")
+		}
+		return "ok", nil
+	}))
+	ctx = c.quotaCallContext(context.Background(), []CallOption{WithRetry(2, time.Millisecond)})
+	if _, err := handler(ctx, nil); err != nil || calls != 3 {
+		t.Errorf("expected success after retries, got %v after %d calls", err, calls)
+	}
+	if time.Until(deadline) > 300*time.Millisecond {
+		t.Errorf("default quota timeout not applied, deadline in %v", time.Until(deadline))
+	}
+}
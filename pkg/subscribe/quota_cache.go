@@ -0,0 +1,192 @@
+package subscribe
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultQuotaCacheTTL 配额用量缓存默认有效期
+	DefaultQuotaCacheTTL = 5 * time.Second
+
+	// quotaCacheMaxEntries 超过该条目数时写入前清理过期条目
+	quotaCacheMaxEntries = 10000
+)
+
+// QuotaAPI 配额操作接口
+//
+// SubscribeClient 和 CachedQuotaClient 均实现该接口，业务代码依赖接口即可按需切换缓存
+type QuotaAPI interface {
+	Use(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32, callOpts ...CallOption) (*QuotaResult, error)
+	MustUse(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32, callOpts ...CallOption) error
+	Check(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32, callOpts ...CallOption) (*QuotaResult, error)
+	Release(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32, callOpts ...CallOption) (*QuotaResult, error)
+	GetUsage(ctx context.Context, tenantCode, productCode string, dimensionKey *string, callOpts ...CallOption) ([]*QuotaResult, error)
+}
+
+var (
+	_ QuotaAPI = (*SubscribeClient)(nil)
+	_ QuotaAPI = (*CachedQuotaClient)(nil)
+)
+
+// usageCacheEntry 用量缓存条目
+type usageCacheEntry struct {
+	results  []*QuotaResult
+	expireAt time.Time
+}
+
+// CachedQuotaClient 带读缓存的配额客户端
+//
+// GetUsage 结果按 租户+产品+维度 缓存 ttl 时间；同一进程内通过本客户端调用
+// Use/MustUse/Release 后会立即失效对应租户和产品的缓存。其他进程的扣减在 ttl 内不可见，
+// 因此仅适用于展示类查询（如用量角标），扣减判断仍应使用 Use/MustUse
+//
+// 使用示例:
+//
+//	quota := subscribe.NewCachedQuotaClient(client.SubscribeClient(), 5*time.Second)
+//	usages, err := quota.GetUsage(ctx, tenantCode, "mall", nil)
+type CachedQuotaClient struct {
+	inner   QuotaAPI
+	ttl     time.Duration
+	mu      sync.RWMutex
+	entries map[string]*usageCacheEntry
+	// gen 每次 Invalidate 递增，查询期间发生失效时丢弃查询结果
+	gen uint64
+	now func() time.Time
+}
+
+// NewCachedQuotaClient 创建带读缓存的配额客户端
+//
+// 参数:
+//   - inner: 实际的配额客户端，通常为 Client.SubscribeClient()
+//   - ttl: 缓存有效期，<=0 时使用 DefaultQuotaCacheTTL
+func NewCachedQuotaClient(inner QuotaAPI, ttl time.Duration) *CachedQuotaClient {
+	if ttl <= 0 {
+		ttl = DefaultQuotaCacheTTL
+	}
+	return &CachedQuotaClient{
+		inner:   inner,
+		ttl:     ttl,
+		entries: make(map[string]*usageCacheEntry),
+		now:     time.Now,
+	}
+}
+
+// Use 使用配额，成功调用后失效对应缓存
+func (c *CachedQuotaClient) Use(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32, callOpts ...CallOption) (*QuotaResult, error) {
+	defer c.Invalidate(cacheTenant(ctx, tenantCode), productCode)
+	return c.inner.Use(ctx, tenantCode, productCode, dimensionKey, amount, callOpts...)
+}
+
+// MustUse 使用配额，调用后失效对应缓存
+func (c *CachedQuotaClient) MustUse(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32, callOpts ...CallOption) error {
+	defer c.Invalidate(cacheTenant(ctx, tenantCode), productCode)
+	return c.inner.MustUse(ctx, tenantCode, productCode, dimensionKey, amount, callOpts...)
+}
+
+// Check 预检查配额，不经过缓存
+func (c *CachedQuotaClient) Check(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32, callOpts ...CallOption) (*QuotaResult, error) {
+	return c.inner.Check(ctx, tenantCode, productCode, dimensionKey, amount, callOpts...)
+}
+
+// Release 释放配额，调用后失效对应缓存
+func (c *CachedQuotaClient) Release(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32, callOpts ...CallOption) (*QuotaResult, error) {
+	defer c.Invalidate(cacheTenant(ctx, tenantCode), productCode)
+	return c.inner.Release(ctx, tenantCode, productCode, dimensionKey, amount, callOpts...)
+}
+
+// GetUsage 查询配额使用情况，优先读取缓存
+//
+// 查询期间发生 Invalidate 时返回查询结果但不写入缓存，避免失效前的用量被缓存到 ttl 结束
+func (c *CachedQuotaClient) GetUsage(ctx context.Context, tenantCode, productCode string, dimensionKey *string, callOpts ...CallOption) ([]*QuotaResult, error) {
+	key := usageCacheKey(cacheTenant(ctx, tenantCode), productCode, dimensionKey)
+
+	c.mu.RLock()
+	entry, ok := c.entries[key]
+	gen := c.gen
+	c.mu.RUnlock()
+	if ok && c.now().Before(entry.expireAt) {
+		return cloneQuotaResults(entry.results), nil
+	}
+
+	results, err := c.inner.GetUsage(ctx, tenantCode, productCode, dimensionKey, callOpts...)
+	if err != nil {
+		return nil, err
+	}
+
+	now := c.now()
+	c.mu.Lock()
+	if c.gen != gen {
+		c.mu.Unlock()
+		return results, nil
+	}
+	if len(c.entries) >= quotaCacheMaxEntries {
+		for k, e := range c.entries {
+			if !now.Before(e.expireAt) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	c.entries[key] = &usageCacheEntry{
+		results:  cloneQuotaResults(results),
+		expireAt: now.Add(c.ttl),
+	}
+	c.mu.Unlock()
+
+	return results, nil
+}
+
+// Invalidate 失效指定租户和产品的全部用量缓存
+//
+// 用于其他途径（如订阅变更事件）得知用量变化时主动刷新
+func (c *CachedQuotaClient) Invalidate(tenantCode, productCode string) {
+	prefix := usageCachePrefix(tenantCode, productCode)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.gen++
+	for k := range c.entries {
+		if strings.HasPrefix(k, prefix) {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// cacheTenant 缓存使用的租户Code
+//
+// 内部客户端开启 WithClaimsTenant 时 tenantCode 可能为空，此时按上下文中的租户区分缓存
+func cacheTenant(ctx context.Context, tenantCode string) string {
+	if tenantCode == "" {
+		return claimsTenant(ctx)
+	}
+	return tenantCode
+}
+
+// usageCachePrefix 租户+产品缓存key前缀
+func usageCachePrefix(tenantCode, productCode string) string {
+	return tenantCode + "|" + productCode + "|"
+}
+
+// usageCacheKey 生成缓存key，未指定维度时使用 "*"
+func usageCacheKey(tenantCode, productCode string, dimensionKey *string) string {
+	dimension := "*"
+	if dimensionKey != nil {
+		dimension = *dimensionKey
+	}
+	return usageCachePrefix(tenantCode, productCode) + dimension
+}
+
+// cloneQuotaResults 复制结果，避免调用方修改缓存内容
+func cloneQuotaResults(results []*QuotaResult) []*QuotaResult {
+	cloned := make([]*QuotaResult, len(results))
+	for i, r := range results {
+		if r != nil {
+			copied := *r
+			r = &copied
+		}
+		cloned[i] = r
+	}
+	return cloned
+}
@@ -0,0 +1,96 @@
+package subscribe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/registry"
+	"google.golang.org/grpc"
+)
+
+// QuotaClient 绑定产品的配额客户端
+//
+// 业务服务通常只操作自身产品的配额，QuotaClient 固定 productCode，调用时无需重复传入
+//
+// 使用示例:
+//
+//	quota, err := subscribe.NewQuotaClientWithDiscovery(config, discovery, "mall")
+//	if err != nil {
+//	    return err
+//	}
+//	defer quota.Close()
+//
+//	err = quota.MustUse(ctx, tenantCode, "goods_count", 1)
+type QuotaClient struct {
+	productCode string
+	client      *SubscribeClient
+	// conn 通过 NewQuotaClientWithDiscovery 创建时持有，Close 时关闭
+	conn *grpc.ClientConn
+}
+
+// NewQuotaClientWithDiscovery 使用服务发现创建配额客户端
+//
+// 参数:
+//   - config: 服务配置，nil 时使用默认配置
+//   - discovery: 服务发现实例
+//   - productCode: 产品编码
+func NewQuotaClientWithDiscovery(config *Config, discovery registry.Discovery, productCode string) (*QuotaClient, error) {
+	if productCode == "" {
+		return nil, fmt.Errorf("产品编码不能为空")
+	}
+
+	client, err := NewClientWithDiscovery(config, discovery)
+	if err != nil {
+		return nil, err
+	}
+
+	quota := client.QuotaClient(productCode)
+	quota.conn = client.conn
+	return quota, nil
+}
+
+// QuotaClient 获取绑定产品的配额客户端，与 Client 共享连接
+func (c *Client) QuotaClient(productCode string) *QuotaClient {
+	return &QuotaClient{
+		productCode: productCode,
+		client:      c.subscribeClient,
+	}
+}
+
+// ProductCode 绑定的产品编码
+func (q *QuotaClient) ProductCode() string {
+	return q.productCode
+}
+
+// Close 关闭连接，通过 Client.QuotaClient 获取时由 Client 负责关闭
+func (q *QuotaClient) Close() error {
+	if q.conn != nil {
+		return q.conn.Close()
+	}
+	return nil
+}
+
+// Use 使用配额
+func (q *QuotaClient) Use(ctx context.Context, tenantCode, dimensionKey string, amount int32, callOpts ...CallOption) (*QuotaResult, error) {
+	return q.client.Use(ctx, tenantCode, q.productCode, dimensionKey, amount, callOpts...)
+}
+
+// MustUse 使用配额，配额不足时返回 *QuotaExceededError
+func (q *QuotaClient) MustUse(ctx context.Context, tenantCode, dimensionKey string, amount int32, callOpts ...CallOption) error {
+	return q.client.MustUse(ctx, tenantCode, q.productCode, dimensionKey, amount, callOpts...)
+}
+
+// Check 预检查配额，不扣减用量
+func (q *QuotaClient) Check(ctx context.Context, tenantCode, dimensionKey string, amount int32, callOpts ...CallOption) (*QuotaResult, error) {
+	return q.client.Check(ctx, tenantCode, q.productCode, dimensionKey, amount, callOpts...)
+}
+
+// Release 释放配额
+func (q *QuotaClient) Release(ctx context.Context, tenantCode, dimensionKey string, amount int32, callOpts ...CallOption) (*QuotaResult, error) {
+	return q.client.Release(ctx, tenantCode, q.productCode, dimensionKey, amount, callOpts...)
+}
+
+// GetUsage 查询配额使用情况，dimensionKey 为 nil 时返回全部维度
+func (q *QuotaClient) GetUsage(ctx context.Context, tenantCode string, dimensionKey *string, callOpts ...CallOption) ([]*QuotaResult, error) {
+	return q.client.GetUsage(ctx, tenantCode, q.productCode, dimensionKey, callOpts...)
+}
@@ -0,0 +1,258 @@
+package subscribe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/heyinLab/common/api/gen/go/subscribe/v1"
+	"google.golang.org/grpc"
+)
+
+// mockQuotaClient 模拟配额接口，按幂等键去重
+type mockQuotaClient struct {
+	v1.SubscriptionInternalServiceClient
+	used int32
+	keys map[string]bool
+}
+
+func (m *mockQuotaClient) InternalCheckAndUseQuota(ctx context.Context, in *v1.InternalCheckAndUseQuotaRequest, opts ...grpc.CallOption) (*v1.InternalCheckAndUseQuotaResponse, error) {
+	if key := in.GetIdempotencyKey(); key != "" {
+		if m.keys[key] {
+			return &v1.InternalCheckAndUseQuotaResponse{Success: true, DimensionKey: in.DimensionKey, QuotaUsedAfter: m.used, Replayed: true}, nil
+		}
+		m.keys[key] = true
+	}
+	before := m.used
+	m.used += in.Amount
+	return &v1.InternalCheckAndUseQuotaResponse{Success: true, DimensionKey: in.DimensionKey, QuotaUsedBefore: before, QuotaUsedAfter: m.used}, nil
+}
+
+func newTestSubscribeClient(client v1.SubscriptionInternalServiceClient) *SubscribeClient {
+	return &SubscribeClient{
+		client: client,
+		logger: log.NewHelper(log.DefaultLogger),
+		config: DefaultConfig(),
+	}
+}
+
+func TestUseIdempotencyKey(t *testing.T) {
+	mock := &mockQuotaClient{keys: map[string]bool{}}
+	client := newTestSubscribeClient(mock)
+	ctx := context.Background()
+
+	first, err := client.Use(ctx, "1001", "mall", "goods_count", 2, WithIdempotencyKey("order:1"))
+	if err != nil {
+		t.Fatalf("Use failed: %v", err)
+	}
+	if first.Replayed || first.QuotaUsed != 2 {
+		t.Errorf("unexpected first result: %+v", first)
+	}
+
+	retry, err := client.Use(ctx, "1001", "mall", "goods_count", 2, WithIdempotencyKey("order:1"))
+	if err != nil {
+		t.Fatalf("Use retry failed: %v", err)
+	}
+	if !retry.Replayed || mock.used != 2 {
+		t.Errorf("retry should be replayed without charging again, got %+v used=%d", retry, mock.used)
+	}
+
+	if _, err := client.Use(ctx, "1001", "mall", "goods_count", 1); err != nil {
+		t.Fatalf("Use without key failed: %v", err)
+	}
+	if mock.used != 3 {
+		t.Errorf("expected used=3, got %d", mock.used)
+	}
+}
+
+func TestMustUseQuotaExceeded(t *testing.T) {
+	client := newTestSubscribeClient(&mockExceededClient{})
+
+	err := client.MustUse(context.Background(), "1001", "mall", "goods_count", 1)
+	if !errors.Is(err, ErrQuotaExceeded) {
+		t.Fatalf("expected ErrQuotaExceeded, got %v", err)
+	}
+
+	var qe *QuotaExceededError
+	if !errors.As(err, &qe) {
+		t.Fatalf("expected *QuotaExceededError, got %T", err)
+	}
+	if qe.DimensionKey != "goods_count" || qe.Limit != 10 || qe.Used != 10 || qe.Remaining != 0 {
+		t.Errorf("unexpected details: %+v", qe)
+	}
+
+	kerr := kerrors.FromError(err)
+	if kerr.Code != 429 || kerr.Metadata["limit"] != "10" || kerr.Metadata["dimension_key"] != "goods_count" {
+		t.Errorf("unexpected kratos error: %v", kerr)
+	}
+}
+
+// mockExceededClient 模拟配额已用尽
+type mockExceededClient struct {
+	v1.SubscriptionInternalServiceClient
+}
+
+func (m *mockExceededClient) InternalCheckAndUseQuota(ctx context.Context, in *v1.InternalCheckAndUseQuotaRequest, opts ...grpc.CallOption) (*v1.InternalCheckAndUseQuotaResponse, error) {
+	return &v1.InternalCheckAndUseQuotaResponse{
+		Success:         false,
+		DimensionKey:    in.DimensionKey,
+		QuotaLimit:      10,
+		QuotaUsedBefore: 10,
+		QuotaUsedAfter:  10,
+		ErrorMessage:    "商品数量已达上限",
+		ErrorCode:       v1.InternalQuotaErrorCode_INTERNAL_QUOTA_ERROR_EXCEEDED,
+	}, nil
+}
+
+// countingQuotaAPI 统计 GetUsage 调用次数
+type countingQuotaAPI struct {
+	QuotaAPI
+	calls int
+	used  int32
+	// onGetUsage 在返回结果前调用，用于模拟查询期间发生的失效
+	onGetUsage func()
+}
+
+func (m *countingQuotaAPI) GetUsage(ctx context.Context, tenantCode, productCode string, dimensionKey *string, callOpts ...CallOption) ([]*QuotaResult, error) {
+	m.calls++
+	if m.onGetUsage != nil {
+		m.onGetUsage()
+	}
+	return []*QuotaResult{{Success: true, DimensionKey: "goods_count", QuotaUsed: m.used}}, nil
+}
+
+func (m *countingQuotaAPI) Use(ctx context.Context, tenantCode, productCode, dimensionKey string, amount int32, callOpts ...CallOption) (*QuotaResult, error) {
+	m.used += amount
+	return &QuotaResult{Success: true, DimensionKey: dimensionKey, QuotaUsed: m.used}, nil
+}
+
+func TestCachedQuotaClient(t *testing.T) {
+	inner := &countingQuotaAPI{}
+	cached := NewCachedQuotaClient(inner, time.Minute)
+	now := time.Now()
+	cached.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if _, err := cached.GetUsage(ctx, "1001", "mall", nil); err != nil {
+			t.Fatalf("GetUsage failed: %v", err)
+		}
+	}
+	if inner.calls != 1 {
+		t.Errorf("expected 1 inner call, got %d", inner.calls)
+	}
+
+	// 其他租户不共享缓存
+	if _, err := cached.GetUsage(ctx, "1002", "mall", nil); err != nil {
+		t.Fatalf("GetUsage failed: %v", err)
+	}
+	if inner.calls != 2 {
+		t.Errorf("expected 2 inner calls, got %d", inner.calls)
+	}
+
+	// Use 后失效缓存
+	if _, err := cached.Use(ctx, "1001", "mall", "goods_count", 1); err != nil {
+		t.Fatalf("Use failed: %v", err)
+	}
+	usages, err := cached.GetUsage(ctx, "1001", "mall", nil)
+	if err != nil {
+		t.Fatalf("GetUsage failed: %v", err)
+	}
+	if inner.calls != 3 || usages[0].QuotaUsed != 1 {
+		t.Errorf("expected refreshed usage after Use, calls=%d used=%d", inner.calls, usages[0].QuotaUsed)
+	}
+
+	// 过期后重新查询
+	now = now.Add(2 * time.Minute)
+	if _, err := cached.GetUsage(ctx, "1001", "mall", nil); err != nil {
+		t.Fatalf("GetUsage failed: %v", err)
+	}
+	if inner.calls != 4 {
+		t.Errorf("expected 4 inner calls after expiry, got %d", inner.calls)
+	}
+}
+
+func TestCachedQuotaClient_InvalidateDuringFetch(t *testing.T) {
+	inner := &countingQuotaAPI{}
+	cached := NewCachedQuotaClient(inner, time.Minute)
+	ctx := context.Background()
+
+	// 查询返回前用量发生变化，失效前的结果不应写入缓存
+	inner.onGetUsage = func() {
+		inner.onGetUsage = nil
+		inner.used++
+		cached.Invalidate("1001", "mall")
+	}
+	if _, err := cached.GetUsage(ctx, "1001", "mall", nil); err != nil {
+		t.Fatalf("GetUsage failed: %v", err)
+	}
+	usages, err := cached.GetUsage(ctx, "1001", "mall", nil)
+	if err != nil {
+		t.Fatalf("GetUsage failed: %v", err)
+	}
+	if inner.calls != 2 || usages[0].QuotaUsed != 1 {
+		t.Errorf("expected refetch after invalidation during fetch, calls=%d used=%d", inner.calls, usages[0].QuotaUsed)
+	}
+}
+
+func (m *mockQuotaClient) InternalCheckQuota(ctx context.Context, in *v1.InternalCheckQuotaRequest, opts ...grpc.CallOption) (*v1.InternalCheckQuotaResponse, error) {
+	const limit = 5
+	return &v1.InternalCheckQuotaResponse{
+		Allowed:        m.used+in.Amount <= limit,
+		DimensionKey:   in.DimensionKey,
+		QuotaLimit:     limit,
+		QuotaUsed:      m.used,
+		QuotaRemaining: limit - m.used,
+	}, nil
+}
+
+func TestCheckDoesNotConsume(t *testing.T) {
+	mock := &mockQuotaClient{keys: map[string]bool{}, used: 2}
+	client := newTestSubscribeClient(mock)
+	ctx := context.Background()
+
+	result, err := client.Check(ctx, "1001", "mall", "goods_count", 3)
+	if err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+	if !result.Success || result.QuotaRemaining != 3 {
+		t.Errorf("unexpected check result: %+v", result)
+	}
+
+	result, err = client.Check(ctx, "1001", "mall", "goods_count", 4)
+	if err != nil {
+		t.Fatalf("Check failed: %v", err)
+	}
+	if result.Success {
+		t.Errorf("expected not allowed, got %+v", result)
+	}
+	if mock.used != 2 {
+		t.Errorf("Check must not consume quota, used=%d", mock.used)
+	}
+}
+
+func TestQuotaClient(t *testing.T) {
+	mock := &mockQuotaClient{keys: map[string]bool{}}
+	client := &Client{subscribeClient: newTestSubscribeClient(mock)}
+
+	quota := client.QuotaClient("mall")
+	if quota.ProductCode() != "mall" {
+		t.Errorf("unexpected product code %q", quota.ProductCode())
+	}
+	if err := quota.MustUse(context.Background(), "1001", "goods_count", 2); err != nil {
+		t.Fatalf("MustUse failed: %v", err)
+	}
+	if mock.used != 2 {
+		t.Errorf("expected used=2, got %d", mock.used)
+	}
+	if err := quota.Close(); err != nil {
+		t.Errorf("Close on shared client should be no-op, got %v", err)
+	}
+
+	if _, err := NewQuotaClientWithDiscovery(nil, nil, ""); err == nil {
+		t.Error("expected error for empty product code")
+	}
+}
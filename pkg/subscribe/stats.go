@@ -0,0 +1,113 @@
+package subscribe
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/subscribe/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultPlanStatsPageSize 遍历套餐统计时的默认每页数量
+const DefaultPlanStatsPageSize = 100
+
+type ProductStatsOptions struct {
+	// 统计开始时间（新增、流失数量的统计区间）
+	StartTime *timestamppb.Timestamp
+	// 统计结束时间
+	EndTime *timestamppb.Timestamp
+	// 套餐统计页码，从1开始
+	Page int32
+	// 套餐统计每页数量
+	PageSize int32
+}
+
+// ProductStats 产品订阅统计
+type ProductStats struct {
+	ProductCode  string                              // 产品编码
+	ActiveCount  int32                               // 已订阅数量
+	TrialCount   int32                               // 试用中数量
+	NewCount     int32                               // 统计区间内新增数量
+	ChurnedCount int32                               // 统计区间内流失数量
+	Plans        []*v1.InternalPlanSubscriptionStats // 按套餐统计（当前页）
+	PlanTotal    int32                               // 套餐总数
+}
+
+// ChurnRate 流失率（百分比），流失数 / (已订阅数 + 流失数)
+func (s *ProductStats) ChurnRate() float64 {
+	base := s.ActiveCount + s.ChurnedCount
+	if base <= 0 {
+		return 0
+	}
+	return float64(s.ChurnedCount) / float64(base) * 100
+}
+
+// MonthRange 返回 t 所在自然月的统计区间 [月初, 下月初)
+//
+// 使用示例:
+//
+//	start, end := subscribe.MonthRange(time.Now())
+//	stats, err := client.GetProductStats(ctx, "mall", &subscribe.ProductStatsOptions{StartTime: start, EndTime: end})
+//	// stats.ChurnedCount 即本月流失数
+func MonthRange(t time.Time) (*timestamppb.Timestamp, *timestamppb.Timestamp) {
+	start := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	return timestamppb.New(start), timestamppb.New(start.AddDate(0, 1, 0))
+}
+
+// GetProductStats 获取产品的跨租户订阅统计，供平台分析服务使用
+func (c *SubscribeClient) GetProductStats(ctx context.Context, productCode string, opts *ProductStatsOptions) (*ProductStats, error) {
+	req := &v1.InternalGetSubscriptionStatsByProductCodeRequest{ProductCode: productCode}
+	if opts != nil {
+		req.StartTime = opts.StartTime
+		req.EndTime = opts.EndTime
+		if opts.Page > 0 {
+			req.Page = &opts.Page
+		}
+		if opts.PageSize > 0 {
+			req.PageSize = &opts.PageSize
+		}
+	}
+
+	resp, err := c.client.InternalGetSubscriptionStatsByProductCode(ctx, req)
+	if err != nil {
+		c.logger.WithContext(ctx).Errorf("获取产品订阅统计失败:product_code=%s err=%v", productCode, err)
+		return nil, err
+	}
+
+	return &ProductStats{
+		ProductCode:  productCode,
+		ActiveCount:  resp.ActiveCount,
+		TrialCount:   resp.TrialCount,
+		NewCount:     resp.NewCount,
+		ChurnedCount: resp.ChurnedCount,
+		Plans:        resp.PlanStats,
+		PlanTotal:    resp.PlanTotal,
+	}, nil
+}
+
+// GetAllPlanStats 分页遍历产品的全部套餐统计
+//
+// opts 中的 Page 被忽略，PageSize 为每次请求的数量（默认 DefaultPlanStatsPageSize）
+func (c *SubscribeClient) GetAllPlanStats(ctx context.Context, productCode string, opts *ProductStatsOptions) ([]*v1.InternalPlanSubscriptionStats, error) {
+	pageOpts := ProductStatsOptions{}
+	if opts != nil {
+		pageOpts = *opts
+	}
+	if pageOpts.PageSize <= 0 {
+		pageOpts.PageSize = DefaultPlanStatsPageSize
+	}
+
+	var plans []*v1.InternalPlanSubscriptionStats
+	for page := int32(1); ; page++ {
+		pageOpts.Page = page
+		stats, err := c.GetProductStats(ctx, productCode, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		plans = append(plans, stats.Plans...)
+		if len(stats.Plans) < int(pageOpts.PageSize) || int32(len(plans)) >= stats.PlanTotal {
+			return plans, nil
+		}
+	}
+}
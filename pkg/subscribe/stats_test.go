@@ -0,0 +1,57 @@
+package subscribe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/subscribe/v1"
+	"google.golang.org/grpc"
+)
+
+// mockStatsClient 模拟按页返回套餐统计
+type mockStatsClient struct {
+	v1.SubscriptionInternalServiceClient
+	plans int
+	calls int
+}
+
+func (m *mockStatsClient) InternalGetSubscriptionStatsByProductCode(ctx context.Context, in *v1.InternalGetSubscriptionStatsByProductCodeRequest, opts ...grpc.CallOption) (*v1.InternalGetSubscriptionStatsByProductCodeResponse, error) {
+	m.calls++
+	resp := &v1.InternalGetSubscriptionStatsByProductCodeResponse{ActiveCount: 90, ChurnedCount: 10, PlanTotal: int32(m.plans)}
+	start := int(in.GetPage()-1) * int(in.GetPageSize())
+	for i := start; i < m.plans && i < start+int(in.GetPageSize()); i++ {
+		resp.PlanStats = append(resp.PlanStats, &v1.InternalPlanSubscriptionStats{PlanCode: fmt.Sprintf("plan_%d", i)})
+	}
+	return resp, nil
+}
+
+func TestGetAllPlanStats(t *testing.T) {
+	mock := &mockStatsClient{plans: 5}
+	client := newTestSubscribeClient(mock)
+
+	plans, err := client.GetAllPlanStats(context.Background(), "mall", &ProductStatsOptions{PageSize: 2})
+	if err != nil {
+		t.Fatalf("GetAllPlanStats failed: %v", err)
+	}
+	if len(plans) != 5 || mock.calls != 3 {
+		t.Errorf("expected 5 plans in 3 calls, got %d plans in %d calls", len(plans), mock.calls)
+	}
+
+	stats, err := client.GetProductStats(context.Background(), "mall", nil)
+	if err != nil {
+		t.Fatalf("GetProductStats failed: %v", err)
+	}
+	if rate := stats.ChurnRate(); rate != 10 {
+		t.Errorf("expected churn rate 10, got %v", rate)
+	}
+}
+
+func TestMonthRange(t *testing.T) {
+	start, end := MonthRange(time.Date(2024, 12, 15, 10, 0, 0, 0, time.UTC))
+	if !start.AsTime().Equal(time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)) ||
+		!end.AsTime().Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected range %v - %v", start.AsTime(), end.AsTime())
+	}
+}
@@ -0,0 +1,367 @@
+package subscribe
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/heyinLab/common/api/gen/go/subscribe/v1"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// DefaultStatusRefreshInterval 订阅状态缓存默认刷新间隔
+	DefaultStatusRefreshInterval = time.Minute
+
+	// DefaultStatusCacheMaxEntries 订阅状态缓存默认最大条目数
+	DefaultStatusCacheMaxEntries = 10000
+
+	// DefaultStatusErrorCacheTTL 查询失败的默认缓存时间
+	DefaultStatusErrorCacheTTL = 5 * time.Second
+)
+
+// SubscriptionState 本地计算的订阅状态
+type SubscriptionState int
+
+const (
+	StateNone        SubscriptionState = iota // 无订阅
+	StateExpired                              // 已过期或已取消
+	StateSuspended                            // 已暂停
+	StateGracePeriod                          // 已到期但在宽限期内，仍可使用
+	StateActive                               // 使用中（含试用）
+)
+
+func (s SubscriptionState) String() string {
+	switch s {
+	case StateExpired:
+		return "expired"
+	case StateSuspended:
+		return "suspended"
+	case StateGracePeriod:
+		return "grace_period"
+	case StateActive:
+		return "active"
+	default:
+		return "none"
+	}
+}
+
+// Usable 是否可以使用（使用中或宽限期内）
+func (s SubscriptionState) Usable() bool {
+	return s == StateActive || s == StateGracePeriod
+}
+
+// StatusTransitionFunc 状态变化回调
+type StatusTransitionFunc func(tenantCode, productCode string, from, to SubscriptionState)
+
+// subscriptionLister 查询租户订阅列表
+type subscriptionLister interface {
+	GetTenantSubscriptions(ctx context.Context, tenantCode string, productCode string) ([]*v1.InternalSubscriptionInfo, error)
+}
+
+// StatusCache 订阅状态本地缓存
+//
+// 首次查询某个 租户+产品 时同步拉取订阅列表（并发未命中合并为一次查询），之后由后台协程按 refreshInterval 定期刷新，
+// 请求路径上的 IsActive 只读内存，不再调用订阅服务。状态在两次刷新之间可能滞后至多一个刷新间隔。
+//
+// 缓存至多保存 DefaultStatusCacheMaxEntries 个 租户+产品（可通过 WithMaxEntries 修改），
+// 超出时随机淘汰；查询失败的结果缓存 DefaultStatusErrorCacheTTL（可通过 WithErrorCacheTTL 修改），
+// 期间视为不可用，避免订阅服务故障时每次请求都调用订阅服务
+//
+// 使用示例:
+//
+//	cache := subscribe.NewStatusCache(client.SubscribeClient(), time.Minute).
+//	    WithGracePeriod(72 * time.Hour).
+//	    OnTransition(func(tenantCode, productCode string, from, to subscribe.SubscriptionState) {
+//	        if to == subscribe.StateExpired {
+//	            notifyExpired(tenantCode, productCode)
+//	        }
+//	    })
+//	defer cache.Close()
+//
+//	if !cache.IsActive(tenantCode, "mall") {
+//	    return ErrSubscriptionRequired
+//	}
+type StatusCache struct {
+	client   subscriptionLister
+	interval time.Duration
+	timeout  time.Duration
+	logger   *log.Helper
+
+	mu           sync.RWMutex
+	states       map[string]SubscriptionState
+	failures     map[string]time.Time // 查询失败的 租户+产品 -> 失败缓存到期时间
+	maxEntries   int
+	errorTTL     time.Duration
+	gracePeriod  time.Duration
+	onTransition StatusTransitionFunc
+	// group 合并同一 租户+产品 的并发未命中查询
+	group singleflight.Group
+
+	now  func() time.Time
+	stop chan struct{}
+	once sync.Once
+}
+
+// NewStatusCache 创建订阅状态缓存并启动后台刷新
+//
+// 参数:
+//   - client: 订阅服务客户端
+//   - refreshInterval: 刷新间隔，<=0 时使用 DefaultStatusRefreshInterval
+//
+// 说明:
+//   - 不再使用时需调用 Close 停止后台刷新
+func NewStatusCache(client *SubscribeClient, refreshInterval time.Duration) *StatusCache {
+	return newStatusCache(client, client.config.Timeout, client.logger, refreshInterval)
+}
+
+func newStatusCache(client subscriptionLister, timeout time.Duration, logger *log.Helper, refreshInterval time.Duration) *StatusCache {
+	if refreshInterval <= 0 {
+		refreshInterval = DefaultStatusRefreshInterval
+	}
+	s := &StatusCache{
+		client:     client,
+		interval:   refreshInterval,
+		timeout:    timeout,
+		logger:     logger,
+		states:     make(map[string]SubscriptionState),
+		failures:   make(map[string]time.Time),
+		maxEntries: DefaultStatusCacheMaxEntries,
+		errorTTL:   DefaultStatusErrorCacheTTL,
+		now:        time.Now,
+		stop:       make(chan struct{}),
+	}
+	go s.loop()
+	return s
+}
+
+// WithGracePeriod 设置到期后的宽限期，宽限期内 IsActive 仍返回 true
+func (s *StatusCache) WithGracePeriod(d time.Duration) *StatusCache {
+	s.mu.Lock()
+	s.gracePeriod = d
+	s.mu.Unlock()
+	return s
+}
+
+// WithMaxEntries 设置最大缓存条目数，<=0 时忽略
+func (s *StatusCache) WithMaxEntries(n int) *StatusCache {
+	if n > 0 {
+		s.mu.Lock()
+		s.maxEntries = n
+		s.mu.Unlock()
+	}
+	return s
+}
+
+// WithErrorCacheTTL 设置查询失败的缓存时间，<=0 时不缓存失败结果
+func (s *StatusCache) WithErrorCacheTTL(d time.Duration) *StatusCache {
+	s.mu.Lock()
+	s.errorTTL = d
+	s.mu.Unlock()
+	return s
+}
+
+// OnTransition 设置状态变化回调
+//
+// 刷新时某个 租户+产品 的状态发生变化（如 active → grace_period → expired）时调用，
+// 首次加载不触发。回调在刷新协程中同步执行，不应阻塞
+func (s *StatusCache) OnTransition(cb StatusTransitionFunc) *StatusCache {
+	s.mu.Lock()
+	s.onTransition = cb
+	s.mu.Unlock()
+	return s
+}
+
+// IsActive 租户的产品订阅是否可用（使用中或宽限期内）
+//
+// 缓存未命中时同步查询一次订阅服务，查询失败视为不可用，并在 DefaultStatusErrorCacheTTL 内不再查询
+func (s *StatusCache) IsActive(tenantCode, productCode string) bool {
+	return s.State(tenantCode, productCode).Usable()
+}
+
+// State 获取租户的产品订阅状态
+func (s *StatusCache) State(tenantCode, productCode string) SubscriptionState {
+	key := statusCacheKey(tenantCode, productCode)
+
+	s.mu.RLock()
+	state, ok := s.states[key]
+	failedUntil, failed := s.failures[key]
+	s.mu.RUnlock()
+	if ok {
+		return state
+	}
+	if failed && s.now().Before(failedUntil) {
+		return StateNone
+	}
+
+	v, _, _ := s.group.Do(key, func() (any, error) {
+		return s.load(key, tenantCode, productCode), nil
+	})
+	return v.(SubscriptionState)
+}
+
+// load 查询订阅服务并写入缓存，查询失败时记录失败缓存并返回 StateNone
+func (s *StatusCache) load(key, tenantCode, productCode string) SubscriptionState {
+	state, err := s.fetch(tenantCode, productCode)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err != nil {
+		if s.errorTTL > 0 {
+			now := s.now()
+			if len(s.failures) >= s.maxEntries {
+				for k, until := range s.failures {
+					if !now.Before(until) {
+						delete(s.failures, k)
+					}
+				}
+			}
+			putBounded(s.failures, key, now.Add(s.errorTTL), s.maxEntries)
+		}
+		return StateNone
+	}
+
+	delete(s.failures, key)
+	if cached, ok := s.states[key]; ok {
+		return cached
+	}
+	putBounded(s.states, key, state, s.maxEntries)
+	return state
+}
+
+// Invalidate 删除指定租户和产品的缓存，下次查询时重新拉取
+func (s *StatusCache) Invalidate(tenantCode, productCode string) {
+	key := statusCacheKey(tenantCode, productCode)
+
+	s.mu.Lock()
+	delete(s.states, key)
+	delete(s.failures, key)
+	s.mu.Unlock()
+}
+
+// Close 停止后台刷新
+func (s *StatusCache) Close() {
+	s.once.Do(func() {
+		close(s.stop)
+	})
+}
+
+// loop 定期刷新已缓存的状态
+func (s *StatusCache) loop() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+			s.refresh()
+		}
+	}
+}
+
+// refresh 刷新全部已缓存的 租户+产品，并触发状态变化回调
+func (s *StatusCache) refresh() {
+	s.mu.RLock()
+	keys := make([]string, 0, len(s.states))
+	for key := range s.states {
+		keys = append(keys, key)
+	}
+	s.mu.RUnlock()
+
+	for _, key := range keys {
+		tenantCode, productCode, _ := strings.Cut(key, "|")
+		state, err := s.fetch(tenantCode, productCode)
+		if err != nil {
+			// 刷新失败时保留旧状态
+			continue
+		}
+
+		s.mu.Lock()
+		from, ok := s.states[key]
+		if ok {
+			s.states[key] = state
+		}
+		cb := s.onTransition
+		s.mu.Unlock()
+
+		if ok && from != state && cb != nil {
+			cb(tenantCode, productCode, from, state)
+		}
+	}
+}
+
+// fetch 查询订阅服务并计算状态
+func (s *StatusCache) fetch(tenantCode, productCode string) (SubscriptionState, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+
+	subscriptions, err := s.client.GetTenantSubscriptions(ctx, tenantCode, productCode)
+	if err != nil {
+		s.logger.Errorf("刷新订阅状态失败:tenant_code=%s, product_code=%s, error=%v", tenantCode, productCode, err)
+		return StateNone, err
+	}
+
+	s.mu.RLock()
+	grace := s.gracePeriod
+	s.mu.RUnlock()
+
+	return resolveSubscriptionState(subscriptions, s.now(), grace), nil
+}
+
+// resolveSubscriptionState 根据订阅列表计算状态，多个订阅时取最优状态
+func resolveSubscriptionState(subscriptions []*v1.InternalSubscriptionInfo, now time.Time, grace time.Duration) SubscriptionState {
+	best := StateNone
+	for _, sub := range subscriptions {
+		if state := subscriptionState(sub, now, grace); state > best {
+			best = state
+		}
+	}
+	return best
+}
+
+// subscriptionState 计算单个订阅的状态
+func subscriptionState(sub *v1.InternalSubscriptionInfo, now time.Time, grace time.Duration) SubscriptionState {
+	switch sub.GetStatus() {
+	case v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_ACTIVE,
+		v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_TRIAL:
+		if sub.EndDate == nil {
+			return StateActive
+		}
+		end := sub.EndDate.AsTime()
+		if now.Before(end) {
+			return StateActive
+		}
+		if now.Before(end.Add(grace)) {
+			return StateGracePeriod
+		}
+		return StateExpired
+	case v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_SUSPENDED:
+		return StateSuspended
+	case v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_EXPIRED,
+		v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_CANCELLED:
+		return StateExpired
+	default:
+		return StateNone
+	}
+}
+
+// putBounded 写入新条目，条目数达到上限时先随机淘汰一个，调用方需持有写锁
+func putBounded[V any](m map[string]V, key string, value V, maxEntries int) {
+	if _, exists := m[key]; !exists && len(m) >= maxEntries {
+		for k := range m {
+			delete(m, k)
+			break
+		}
+	}
+	m[key] = value
+}
+
+// statusCacheKey 生成缓存key
+func statusCacheKey(tenantCode, productCode string) string {
+	return tenantCode + "|" + productCode
+}
@@ -0,0 +1,163 @@
+package subscribe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/heyinLab/common/api/gen/go/subscribe/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// mockLister 返回可修改的订阅列表
+type mockLister struct {
+	mu    sync.Mutex
+	subs  []*v1.InternalSubscriptionInfo
+	err   error
+	calls int
+	// block 非 nil 时查询阻塞到其关闭
+	block chan struct{}
+}
+
+func (m *mockLister) GetTenantSubscriptions(ctx context.Context, tenantCode string, productCode string) ([]*v1.InternalSubscriptionInfo, error) {
+	if m.block != nil {
+		<-m.block
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	return m.subs, nil
+}
+
+func TestStatusCache(t *testing.T) {
+	now := time.Now()
+	lister := &mockLister{subs: []*v1.InternalSubscriptionInfo{{
+		Status:  v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_ACTIVE,
+		EndDate: timestamppb.New(now.Add(time.Hour)),
+	}}}
+
+	var transitions []SubscriptionState
+	cache := newStatusCache(lister, time.Second, log.NewHelper(log.DefaultLogger), time.Hour).
+		WithGracePeriod(24 * time.Hour).
+		OnTransition(func(tenantCode, productCode string, from, to SubscriptionState) {
+			transitions = append(transitions, to)
+		})
+	defer cache.Close()
+	cache.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if !cache.IsActive("1001", "mall") {
+			t.Fatal("expected active")
+		}
+	}
+	if lister.calls != 1 {
+		t.Errorf("expected 1 fetch, got %d", lister.calls)
+	}
+
+	// 到期后进入宽限期
+	now = now.Add(2 * time.Hour)
+	cache.refresh()
+	if state := cache.State("1001", "mall"); state != StateGracePeriod || !state.Usable() {
+		t.Errorf("expected grace period, got %s", state)
+	}
+
+	// 宽限期结束
+	now = now.Add(48 * time.Hour)
+	cache.refresh()
+	if cache.IsActive("1001", "mall") {
+		t.Error("expected expired")
+	}
+
+	if len(transitions) != 2 || transitions[0] != StateGracePeriod || transitions[1] != StateExpired {
+		t.Errorf("unexpected transitions: %v", transitions)
+	}
+}
+
+func TestStatusCache_ErrorAndBound(t *testing.T) {
+	now := time.Now()
+	lister := &mockLister{err: errors.New("unavailable")}
+	cache := newStatusCache(lister, time.Second, log.NewHelper(log.DefaultLogger), time.Hour).
+		WithMaxEntries(3)
+	defer cache.Close()
+	cache.now = func() time.Time { return now }
+
+	// 查询失败的结果短暂缓存
+	for i := 0; i < 3; i++ {
+		if cache.IsActive("1001", "mall") {
+			t.Fatal("expected inactive on error")
+		}
+	}
+	if lister.calls != 1 {
+		t.Errorf("expected failure cached, got %d fetches", lister.calls)
+	}
+
+	now = now.Add(DefaultStatusErrorCacheTTL)
+	lister.err = nil
+	lister.subs = []*v1.InternalSubscriptionInfo{{Status: v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_ACTIVE}}
+	if !cache.IsActive("1001", "mall") {
+		t.Error("expected active after error cache expired")
+	}
+	if lister.calls != 2 || len(cache.failures) != 0 {
+		t.Errorf("expected refetch and failure cleared, got %d fetches, %d failures", lister.calls, len(cache.failures))
+	}
+
+	for i := 0; i < 10; i++ {
+		cache.State(fmt.Sprintf("%d", 2000+i), "mall")
+	}
+	if len(cache.states) > 3 {
+		t.Errorf("expected at most 3 entries, got %d", len(cache.states))
+	}
+}
+
+func TestResolveSubscriptionState(t *testing.T) {
+	now := time.Now()
+	subs := []*v1.InternalSubscriptionInfo{
+		{Status: v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_EXPIRED},
+		{Status: v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_TRIAL, EndDate: timestamppb.New(now.Add(time.Hour))},
+	}
+	if state := resolveSubscriptionState(subs, now, 0); state != StateActive {
+		t.Errorf("expected active, got %s", state)
+	}
+	if state := resolveSubscriptionState(nil, now, 0); state != StateNone {
+		t.Errorf("expected none, got %s", state)
+	}
+	suspended := []*v1.InternalSubscriptionInfo{{Status: v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_SUSPENDED}}
+	if state := resolveSubscriptionState(suspended, now, 0); state != StateSuspended || state.Usable() {
+		t.Errorf("expected suspended, got %s", state)
+	}
+}
+
+func TestStatusCache_ConcurrentMiss(t *testing.T) {
+	lister := &mockLister{
+		subs:  []*v1.InternalSubscriptionInfo{{Status: v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_ACTIVE}},
+		block: make(chan struct{}),
+	}
+	cache := newStatusCache(lister, time.Second, log.NewHelper(log.DefaultLogger), time.Hour)
+	defer cache.Close()
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if !cache.IsActive("1001", "mall") {
+				t.Error("expected active")
+			}
+		}()
+	}
+	// 等待全部协程进入查询后放行
+	time.Sleep(50 * time.Millisecond)
+	close(lister.block)
+	wg.Wait()
+
+	if lister.calls != 1 {
+		t.Errorf("expected concurrent misses to share 1 fetch, got %d", lister.calls)
+	}
+}
@@ -0,0 +1,52 @@
+package subscribe
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/heyinLab/common/pkg/middleware/auth"
+)
+
+// WithClaimsTenant 开启从认证信息中读取租户Code
+//
+// 开启后，带 tenantCode 参数的方法在参数为空时从 auth.FromContext(ctx) 中读取租户Code，
+// 显式传入的 tenantCode 始终优先；上下文中也没有租户Code时返回错误
+//
+// 使用示例:
+//
+//	quota := client.SubscribeClient().WithClaimsTenant()
+//	err := quota.MustUse(ctx, "", "mall", "goods_count", 1)
+//
+// 注意:
+//   - 应在客户端创建后、开始使用前调用
+func (c *SubscribeClient) WithClaimsTenant() *SubscribeClient {
+	c.claimsTenant = true
+	return c
+}
+
+// WithClaimsTenant 开启从认证信息中读取租户Code，见 SubscribeClient.WithClaimsTenant
+func (c *Client) WithClaimsTenant() *Client {
+	c.subscribeClient.WithClaimsTenant()
+	return c
+}
+
+// resolveTenant 解析租户Code，显式传入的租户Code优先
+func (c *SubscribeClient) resolveTenant(ctx context.Context, tenantCode string) (string, error) {
+	if tenantCode != "" || !c.claimsTenant {
+		return tenantCode, nil
+	}
+
+	if tenant := claimsTenant(ctx); tenant != "" {
+		return tenant, nil
+	}
+
+	return "", fmt.Errorf("租户Code不能为空，且上下文中没有租户信息")
+}
+
+// claimsTenant 从认证信息中读取租户Code
+func claimsTenant(ctx context.Context) string {
+	if claims, ok := auth.FromContext(ctx); ok {
+		return claims.TenantCode
+	}
+	return ""
+}
@@ -0,0 +1,43 @@
+package subscribe
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/subscribe/v1"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"google.golang.org/grpc"
+)
+
+// mockTenantClient 记录请求中的租户Code
+type mockTenantClient struct {
+	v1.SubscriptionInternalServiceClient
+	tenantCode string
+}
+
+func (m *mockTenantClient) InternalCheckAndUseQuota(ctx context.Context, in *v1.InternalCheckAndUseQuotaRequest, opts ...grpc.CallOption) (*v1.InternalCheckAndUseQuotaResponse, error) {
+	m.tenantCode = in.TenantCode
+	return &v1.InternalCheckAndUseQuotaResponse{Success: true}, nil
+}
+
+func TestWithClaimsTenant(t *testing.T) {
+	mock := &mockTenantClient{}
+	client := newTestSubscribeClient(mock)
+	ctx := auth.NewContext(context.Background(), &auth.Claims{TenantCode: "1001"})
+
+	// 未开启时保持原样
+	if _, err := client.Use(ctx, "", "mall", "goods_count", 1); err != nil || mock.tenantCode != "" {
+		t.Errorf("expected empty tenant, got %q, err=%v", mock.tenantCode, err)
+	}
+
+	client.WithClaimsTenant()
+	if _, err := client.Use(ctx, "", "mall", "goods_count", 1); err != nil || mock.tenantCode != "1001" {
+		t.Errorf("expected tenant from claims, got %q, err=%v", mock.tenantCode, err)
+	}
+	if _, err := client.Use(ctx, "1002", "mall", "goods_count", 1); err != nil || mock.tenantCode != "1002" {
+		t.Errorf("expected explicit tenant, got %q, err=%v", mock.tenantCode, err)
+	}
+	if _, err := client.Use(context.Background(), "", "mall", "goods_count", 1); err == nil {
+		t.Error("expected error without claims")
+	}
+}
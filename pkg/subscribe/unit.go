@@ -0,0 +1,184 @@
+package subscribe
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ByteSize 字节数，用于存储类维度的配额换算
+type ByteSize int64
+
+// 存储单位（按1024进制）
+const (
+	Byte     ByteSize = 1
+	Kilobyte          = 1024 * Byte
+	Megabyte          = 1024 * Kilobyte
+	Gigabyte          = 1024 * Megabyte
+	Terabyte          = 1024 * Gigabyte
+)
+
+// Bytes 构造字节数
+func Bytes(n int64) ByteSize { return ByteSize(n) }
+
+// Kilobytes 构造 n KB
+func Kilobytes(n int64) ByteSize { return ByteSize(n) * Kilobyte }
+
+// Megabytes 构造 n MB
+func Megabytes(n int64) ByteSize { return ByteSize(n) * Megabyte }
+
+// Gigabytes 构造 n GB
+func Gigabytes(n int64) ByteSize { return ByteSize(n) * Gigabyte }
+
+// Amount 换算为指定单位的配额数量，不足1个单位时向上取整
+//
+// 每次调用都会向上取整，适用于换算存储总量。按单个文件增减配额时，
+// 多次小额 Use 会累积取整误差（如 4 次 300 MB 记为 4 GB），应使用 AmountDelta 按总量换算:
+//
+//	amount, err := subscribe.Gigabytes(2).Amount(usage.Unit) // 单位为 MB 时为 2048
+func (s ByteSize) Amount(unit string) (int32, error) {
+	size, ok := unitBytes(unit)
+	if !ok {
+		return 0, fmt.Errorf("单位不是存储单位: %q", unit)
+	}
+	if s < 0 {
+		return 0, fmt.Errorf("存储大小不能为负数: %d", s)
+	}
+
+	amount := (int64(s) + size - 1) / size
+	if amount > math.MaxInt32 {
+		return 0, fmt.Errorf("存储大小超出配额范围: %d %s", amount, unit)
+	}
+	return int32(amount), nil
+}
+
+// AmountDelta 存储总量从 from 变为 to 时需要 Use（正数）或 Release（负数）的配额数量
+//
+// 按两个总量分别向上取整后求差，多次小额变更的配额合计始终等于总量取整后的值，不会累积取整误差。
+// 调用方需维护租户的存储总量（如文件大小合计）:
+//
+//	delta, err := subscribe.AmountDelta(totalBefore, totalBefore+fileSize, usage.Unit)
+//	if delta > 0 {
+//	    result, err := client.Use(ctx, tenantCode, "mall", "storage", delta)
+//	}
+func AmountDelta(from, to ByteSize, unit string) (int32, error) {
+	before, err := from.Amount(unit)
+	if err != nil {
+		return 0, err
+	}
+	after, err := to.Amount(unit)
+	if err != nil {
+		return 0, err
+	}
+	return after - before, nil
+}
+
+// String 格式化为易读的存储大小，如 "1.5 GB"
+func (s ByteSize) String() string {
+	units := []struct {
+		size ByteSize
+		name string
+	}{
+		{Terabyte, "TB"},
+		{Gigabyte, "GB"},
+		{Megabyte, "MB"},
+		{Kilobyte, "KB"},
+	}
+	for _, u := range units {
+		if s >= u.size || -s >= u.size {
+			value := strconv.FormatFloat(float64(s)/float64(u.size), 'f', 1, 64)
+			return strings.TrimSuffix(value, ".0") + " " + u.name
+		}
+	}
+	return strconv.FormatInt(int64(s), 10) + " B"
+}
+
+// IsByteUnit 维度单位是否为存储单位（B、KB、MB、GB、TB）
+func (r *QuotaResult) IsByteUnit() bool {
+	_, ok := unitBytes(r.Unit)
+	return ok
+}
+
+// LimitBytes 配额上限的字节数，非存储单位或无限制时 ok 为 false
+func (r *QuotaResult) LimitBytes() (ByteSize, bool) {
+	if r.IsUnlimited {
+		return 0, false
+	}
+	return r.toBytes(r.QuotaLimit)
+}
+
+// UsedBytes 已使用量的字节数，非存储单位时 ok 为 false
+func (r *QuotaResult) UsedBytes() (ByteSize, bool) {
+	return r.toBytes(r.QuotaUsed)
+}
+
+// RemainingBytes 剩余配额的字节数，非存储单位或无限制时 ok 为 false
+func (r *QuotaResult) RemainingBytes() (ByteSize, bool) {
+	if r.IsUnlimited {
+		return 0, false
+	}
+	return r.toBytes(r.QuotaRemaining)
+}
+
+// FormatLimit 格式化配额上限，如 "5 GB"、"100 个"、"无限制"
+//
+// 参数:
+//   - lang: 语言，"zh" 开头为中文，其他为英文，空字符串为中文
+func (r *QuotaResult) FormatLimit(lang string) string {
+	if r.IsUnlimited {
+		if isChinese(lang) {
+			return "无限制"
+		}
+		return "Unlimited"
+	}
+	return r.formatValue(r.QuotaLimit)
+}
+
+// FormatUsed 格式化已使用量，如 "1.5 GB"、"3 个"
+func (r *QuotaResult) FormatUsed() string {
+	return r.formatValue(r.QuotaUsed)
+}
+
+// toBytes 将维度单位的数量换算为字节数
+func (r *QuotaResult) toBytes(value int32) (ByteSize, bool) {
+	size, ok := unitBytes(r.Unit)
+	if !ok {
+		return 0, false
+	}
+	return ByteSize(int64(value) * size), true
+}
+
+// formatValue 存储单位格式化为易读大小，其他单位直接拼接
+func (r *QuotaResult) formatValue(value int32) string {
+	if size, ok := r.toBytes(value); ok {
+		return size.String()
+	}
+	if r.Unit == "" {
+		return strconv.Itoa(int(value))
+	}
+	return strconv.Itoa(int(value)) + " " + r.Unit
+}
+
+// unitBytes 存储单位对应的字节数
+func unitBytes(unit string) (int64, bool) {
+	switch strings.ToUpper(strings.TrimSpace(unit)) {
+	case "B":
+		return int64(Byte), true
+	case "KB":
+		return int64(Kilobyte), true
+	case "MB":
+		return int64(Megabyte), true
+	case "GB":
+		return int64(Gigabyte), true
+	case "TB":
+		return int64(Terabyte), true
+	default:
+		return 0, false
+	}
+}
+
+// isChinese 语言是否为中文
+func isChinese(lang string) bool {
+	return lang == "" || strings.HasPrefix(strings.ToLower(lang), "zh")
+}
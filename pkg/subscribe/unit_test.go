@@ -0,0 +1,82 @@
+package subscribe
+
+import "testing"
+
+func TestByteSizeAmount(t *testing.T) {
+	cases := []struct {
+		size ByteSize
+		unit string
+		want int32
+	}{
+		{Gigabytes(5), "GB", 5},
+		{Gigabytes(5), "MB", 5120},
+		{Megabytes(300), "GB", 1},
+		{Bytes(0), "MB", 0},
+		{Kilobytes(1), "kb", 1},
+	}
+	for _, c := range cases {
+		got, err := c.size.Amount(c.unit)
+		if err != nil || got != c.want {
+			t.Errorf("%v.Amount(%q) = %d, %v; want %d", c.size, c.unit, got, err, c.want)
+		}
+	}
+
+	if _, err := Gigabytes(1).Amount("个"); err == nil {
+		t.Error("expected error for non-byte unit")
+	}
+}
+
+func TestAmountDelta(t *testing.T) {
+	// 4 次 300 MB 合计 1200 MB，按总量取整为 2 GB
+	var total ByteSize
+	var used int32
+	for i := 0; i < 4; i++ {
+		delta, err := AmountDelta(total, total+Megabytes(300), "GB")
+		if err != nil {
+			t.Fatalf("AmountDelta failed: %v", err)
+		}
+		total += Megabytes(300)
+		used += delta
+	}
+	if used != 2 {
+		t.Errorf("expected 2 GB used, got %d", used)
+	}
+
+	if delta, _ := AmountDelta(total, total-Megabytes(300), "GB"); delta != -1 {
+		t.Errorf("expected release of 1 GB, got %d", delta)
+	}
+	if _, err := AmountDelta(0, Gigabytes(1), "个"); err == nil {
+		t.Error("expected error for non-byte unit")
+	}
+}
+
+func TestQuotaResultUnits(t *testing.T) {
+	storage := &QuotaResult{QuotaLimit: 5, QuotaUsed: 2, QuotaRemaining: 3, Unit: "GB"}
+	if remaining, ok := storage.RemainingBytes(); !ok || remaining != Gigabytes(3) {
+		t.Errorf("RemainingBytes = %v, %v", remaining, ok)
+	}
+	if got := storage.FormatLimit("zh"); got != "5 GB" {
+		t.Errorf("FormatLimit = %q", got)
+	}
+
+	mb := &QuotaResult{QuotaLimit: 1536, QuotaUsed: 512, Unit: "MB"}
+	if got := mb.FormatLimit("en"); got != "1.5 GB" {
+		t.Errorf("FormatLimit = %q", got)
+	}
+	if got := mb.FormatUsed(); got != "512 MB" {
+		t.Errorf("FormatUsed = %q", got)
+	}
+
+	count := &QuotaResult{QuotaLimit: 100, Unit: "个"}
+	if _, ok := count.RemainingBytes(); ok {
+		t.Error("count dimension should not convert to bytes")
+	}
+	if got := count.FormatLimit("zh"); got != "100 个" {
+		t.Errorf("FormatLimit = %q", got)
+	}
+
+	unlimited := &QuotaResult{IsUnlimited: true, Unit: "GB"}
+	if unlimited.FormatLimit("zh-CN") != "无限制" || unlimited.FormatLimit("en") != "Unlimited" {
+		t.Errorf("unexpected unlimited format")
+	}
+}
@@ -0,0 +1,173 @@
+package subscribe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	v1 "github.com/heyinLab/common/api/gen/go/subscribe/v1"
+)
+
+const (
+	// DefaultUsageConcurrency GetAllUsage 默认并发查询数
+	DefaultUsageConcurrency = 5
+
+	// usageSubscriptionsPageSize GetAllUsage 分页查询订阅列表的每页数量
+	usageSubscriptionsPageSize = 100
+
+	// usageSubscriptionsMaxPages GetAllUsage 分页查询订阅列表的最大页数，防止服务端分页异常时无限翻页
+	usageSubscriptionsMaxPages = 100
+)
+
+// ProductUsage 单个产品的配额使用情况
+type ProductUsage struct {
+	ProductCode      string                        // 产品编码
+	PlanCode         string                        // 套餐编码
+	SubscriptionCode string                        // 订阅编号
+	Status           v1.InternalSubscriptionStatus // 订阅状态
+	Usages           []*QuotaResult                // 各维度使用情况
+}
+
+// UsageReport 租户全部产品的配额使用汇总
+type UsageReport struct {
+	TenantCode string          // 租户Code
+	Products   []*ProductUsage // 按订阅列表顺序排列
+}
+
+// GetAllUsage 查询租户所有已订阅产品的配额使用情况
+//
+// 先分页查询租户的全部订阅，筛选使用中/试用中的产品，再并发查询每个产品的配额用量，
+// 用于商户后台"套餐用量"页面
+func (c *SubscribeClient) GetAllUsage(ctx context.Context, tenantCode string) (*UsageReport, error) {
+	tenantCode, err := c.resolveTenant(ctx, tenantCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if tenantCode == "" {
+		return nil, fmt.Errorf("租户Code不能为空")
+	}
+
+	subscriptions, err := c.listAllSubscriptions(ctx, &ListSubscriptionsOptions{TenantCode: tenantCode})
+	if err != nil {
+		return nil, err
+	}
+
+	products := activeProducts(subscriptions)
+	report := &UsageReport{
+		TenantCode: tenantCode,
+		Products:   products,
+	}
+	if len(products) == 0 {
+		return report, nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr error
+		sem      = make(chan struct{}, DefaultUsageConcurrency)
+	)
+
+	for _, product := range products {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(p *ProductUsage) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			usages, err := c.GetUsage(ctx, tenantCode, p.ProductCode, nil)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+					cancel()
+				}
+				mu.Unlock()
+				return
+			}
+			p.Usages = usages
+		}(product)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// listAllSubscriptions 分页遍历全部订阅，opts 中的 Page 和 PageSize 被忽略
+//
+// 返回不足一页时结束，服务端返回了总数时取满总数也结束。
+// 服务端忽略 Page 重复返回同一页时，按订阅编号去重，整页均已出现过即结束；
+// 超过 usageSubscriptionsMaxPages 页仍未结束时返回错误
+func (c *SubscribeClient) listAllSubscriptions(ctx context.Context, opts *ListSubscriptionsOptions) ([]*v1.InternalSubscriptionInfo, error) {
+	pageOpts := *opts
+	pageOpts.PageSize = usageSubscriptionsPageSize
+
+	var subscriptions []*v1.InternalSubscriptionInfo
+	seen := make(map[string]struct{})
+	for page := int32(1); page <= usageSubscriptionsMaxPages; page++ {
+		pageOpts.Page = page
+		resp, err := c.ListSubscriptions(ctx, &pageOpts)
+		if err != nil {
+			return nil, err
+		}
+
+		added := 0
+		for _, sub := range resp.Subscriptions {
+			if code := sub.GetSubscriptionCode(); code != "" {
+				if _, ok := seen[code]; ok {
+					continue
+				}
+				seen[code] = struct{}{}
+			}
+			subscriptions = append(subscriptions, sub)
+			added++
+		}
+		if added == 0 || len(resp.Subscriptions) < int(pageOpts.PageSize) || (resp.Total > 0 && int32(len(subscriptions)) >= resp.Total) {
+			return subscriptions, nil
+		}
+	}
+	return nil, fmt.Errorf("订阅列表超过%d页，已停止分页查询", usageSubscriptionsMaxPages)
+}
+
+// activeProducts 筛选使用中/试用中的订阅，每个产品只保留一条
+func activeProducts(subscriptions []*v1.InternalSubscriptionInfo) []*ProductUsage {
+	seen := make(map[string]struct{}, len(subscriptions))
+	products := make([]*ProductUsage, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		switch sub.GetStatus() {
+		case v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_ACTIVE,
+			v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_TRIAL:
+		default:
+			continue
+		}
+		if _, ok := seen[sub.ProductCode]; ok {
+			continue
+		}
+		seen[sub.ProductCode] = struct{}{}
+		products = append(products, &ProductUsage{
+			ProductCode:      sub.ProductCode,
+			PlanCode:         sub.PlanCode,
+			SubscriptionCode: sub.SubscriptionCode,
+			Status:           sub.Status,
+		})
+	}
+	return products
+}
@@ -0,0 +1,100 @@
+package subscribe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/subscribe/v1"
+	"google.golang.org/grpc"
+)
+
+// mockUsageClient 模拟订阅列表和配额查询，订阅列表按请求分页
+type mockUsageClient struct {
+	v1.SubscriptionInternalServiceClient
+	subscriptions []*v1.InternalSubscriptionInfo
+	pages         []int32
+	// ignorePage 模拟忽略 Page 参数、始终返回第一页的服务端
+	ignorePage bool
+}
+
+func (m *mockUsageClient) InternalListSubscriptions(ctx context.Context, in *v1.InternalListSubscriptionsRequest, opts ...grpc.CallOption) (*v1.InternalListSubscriptionsResponse, error) {
+	m.pages = append(m.pages, in.GetPage())
+	page := in.GetPage()
+	if m.ignorePage {
+		page = 1
+	}
+	start := min(int((page-1)*in.GetPageSize()), len(m.subscriptions))
+	end := min(start+int(in.GetPageSize()), len(m.subscriptions))
+	return &v1.InternalListSubscriptionsResponse{Subscriptions: m.subscriptions[start:end]}, nil
+}
+
+func (m *mockUsageClient) InternalGetQuotaUsage(ctx context.Context, in *v1.InternalGetQuotaUsageRequest, opts ...grpc.CallOption) (*v1.InternalGetQuotaUsageResponse, error) {
+	return &v1.InternalGetQuotaUsageResponse{Usages: []*v1.InternalQuotaUsageItem{
+		{DimensionKey: in.ProductCode + "_count", QuotaLimit: 10, QuotaUsed: 3},
+	}}, nil
+}
+
+func TestGetAllUsage(t *testing.T) {
+	// 第一页全部为已过期订阅，使用中的订阅在后续页
+	subscriptions := make([]*v1.InternalSubscriptionInfo, usageSubscriptionsPageSize)
+	for i := range subscriptions {
+		subscriptions[i] = &v1.InternalSubscriptionInfo{SubscriptionCode: fmt.Sprintf("S%03d", i), ProductCode: "crm", Status: v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_EXPIRED}
+	}
+	subscriptions = append(subscriptions,
+		&v1.InternalSubscriptionInfo{SubscriptionCode: "S100", ProductCode: "mall", PlanCode: "pro", Status: v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_ACTIVE},
+		&v1.InternalSubscriptionInfo{SubscriptionCode: "S101", ProductCode: "cms", PlanCode: "basic", Status: v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_TRIAL},
+		&v1.InternalSubscriptionInfo{SubscriptionCode: "S102", ProductCode: "mall", PlanCode: "basic", Status: v1.InternalSubscriptionStatus_INTERNAL_SUBSCRIPTION_STATUS_ACTIVE},
+	)
+	mock := &mockUsageClient{subscriptions: subscriptions}
+	client := newTestSubscribeClient(mock)
+
+	report, err := client.GetAllUsage(context.Background(), "1001")
+	if err != nil {
+		t.Fatalf("GetAllUsage failed: %v", err)
+	}
+	if len(mock.pages) != 2 {
+		t.Errorf("expected 2 pages, got %v", mock.pages)
+	}
+	if len(report.Products) != 2 {
+		t.Fatalf("expected 2 products, got %d", len(report.Products))
+	}
+	for _, p := range report.Products {
+		if len(p.Usages) != 1 || p.Usages[0].DimensionKey != p.ProductCode+"_count" {
+			t.Errorf("unexpected usages for %s: %+v", p.ProductCode, p.Usages)
+		}
+	}
+	if report.Products[0].ProductCode != "mall" || report.Products[0].PlanCode != "pro" {
+		t.Errorf("unexpected first product: %+v", report.Products[0])
+	}
+}
+
+func TestListAllSubscriptions_IgnoredPage(t *testing.T) {
+	subscriptions := make([]*v1.InternalSubscriptionInfo, usageSubscriptionsPageSize*2)
+	for i := range subscriptions {
+		subscriptions[i] = &v1.InternalSubscriptionInfo{SubscriptionCode: fmt.Sprintf("S%03d", i), ProductCode: "mall"}
+	}
+	mock := &mockUsageClient{subscriptions: subscriptions, ignorePage: true}
+	client := newTestSubscribeClient(mock)
+
+	// 服务端忽略 Page 且不返回总数，重复页不再新增订阅时结束
+	got, err := client.listAllSubscriptions(context.Background(), &ListSubscriptionsOptions{TenantCode: "1001"})
+	if err != nil {
+		t.Fatalf("listAllSubscriptions failed: %v", err)
+	}
+	if len(mock.pages) != 2 || len(got) != usageSubscriptionsPageSize {
+		t.Errorf("expected to stop after a repeated page, pages=%v got=%d", mock.pages, len(got))
+	}
+
+	// 无法去重时由最大页数兜底
+	for _, sub := range subscriptions {
+		sub.SubscriptionCode = ""
+	}
+	mock.pages = nil
+	if _, err := client.listAllSubscriptions(context.Background(), &ListSubscriptionsOptions{TenantCode: "1001"}); err == nil {
+		t.Error("expected error after max pages")
+	}
+	if len(mock.pages) != usageSubscriptionsMaxPages {
+		t.Errorf("expected %d pages, got %d", usageSubscriptionsMaxPages, len(mock.pages))
+	}
+}
@@ -0,0 +1,76 @@
+package system
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL 系统数据（国家、语言等）默认缓存有效期
+const DefaultCacheTTL = 10 * time.Minute
+
+// cacheSweepSize 缓存条目数达到该值时写入前清理过期条目
+const cacheSweepSize = 1024
+
+// cacheEntry 缓存条目
+type cacheEntry[V any] struct {
+	value    V
+	expireAt time.Time
+}
+
+// ttlCache 进程内 TTL 缓存
+//
+// 缓存值为共享对象，调用方不应修改
+type ttlCache[V any] struct {
+	ttl     time.Duration
+	mu      sync.RWMutex
+	entries map[string]cacheEntry[V]
+	now     func() time.Time
+}
+
+// newTTLCache 创建 TTL 缓存，ttl<=0 时使用 DefaultCacheTTL
+func newTTLCache[V any](ttl time.Duration) *ttlCache[V] {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	return &ttlCache[V]{
+		ttl:     ttl,
+		entries: make(map[string]cacheEntry[V]),
+		now:     time.Now,
+	}
+}
+
+// get 读取未过期的缓存
+func (c *ttlCache[V]) get(key string) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !c.now().Before(entry.expireAt) {
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// set 写入缓存
+func (c *ttlCache[V]) set(key string, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if len(c.entries) >= cacheSweepSize {
+		for k, entry := range c.entries {
+			if !now.Before(entry.expireAt) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	c.entries[key] = cacheEntry[V]{value: value, expireAt: now.Add(c.ttl)}
+}
+
+// clear 清空缓存
+func (c *ttlCache[V]) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	clear(c.entries)
+}
@@ -0,0 +1,57 @@
+package system
+
+import (
+	"context"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+)
+
+// ListTimezones 获取时区列表
+//
+// 结果在进程内缓存 DefaultCacheTTL，用于商户设置页和报表调度
+//
+// 返回:
+//   - []*v1.InternalTimezone: 时区列表，为缓存共享对象，请勿修改
+//   - error: 错误信息
+func (s *SystemClient) ListTimezones(ctx context.Context) ([]*v1.InternalTimezone, error) {
+	if timezones, ok := s.timezones.get(""); ok {
+		return timezones, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	resp, err := s.client.InternalListTimezones(ctx, &v1.InternalListTimezonesRequest{})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("获取时区列表失败:error=%v", err)
+		return nil, err
+	}
+
+	s.timezones.set("", resp.GetTimezones())
+	return resp.GetTimezones(), nil
+}
+
+// ListLocales 获取语言列表
+//
+// 结果在进程内缓存 DefaultCacheTTL
+//
+// 返回:
+//   - []*v1.InternalLocale: 语言列表（包含未启用的语言），为缓存共享对象，请勿修改
+//   - error: 错误信息
+func (s *SystemClient) ListLocales(ctx context.Context) ([]*v1.InternalLocale, error) {
+	if locales, ok := s.locales.get(""); ok {
+		return locales, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	resp, err := s.client.InternalListLocales(ctx, &v1.InternalListLocalesRequest{})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("获取语言列表失败:error=%v", err)
+		return nil, err
+	}
+
+	s.locales.set("", resp.GetLocales())
+	return resp.GetLocales(), nil
+}
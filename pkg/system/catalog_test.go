@@ -0,0 +1,57 @@
+package system
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+	"google.golang.org/grpc"
+)
+
+// mockCatalogClient 模拟时区和语言接口
+type mockCatalogClient struct {
+	mockSystemClient
+}
+
+func (m *mockCatalogClient) InternalListTimezones(_ context.Context, _ *v1.InternalListTimezonesRequest, _ ...grpc.CallOption) (*v1.InternalListTimezonesResponse, error) {
+	m.called("InternalListTimezones")
+	return &v1.InternalListTimezonesResponse{Timezones: []*v1.InternalTimezone{
+		{Id: "Asia/Shanghai", UtcOffset: "+08:00", OffsetSeconds: 8 * 3600},
+	}}, nil
+}
+
+func (m *mockCatalogClient) InternalListLocales(_ context.Context, _ *v1.InternalListLocalesRequest, _ ...grpc.CallOption) (*v1.InternalListLocalesResponse, error) {
+	m.called("InternalListLocales")
+	return &v1.InternalListLocalesResponse{Locales: []*v1.InternalLocale{
+		{Code: "zh-CN", Name: "简体中文", IsDefault: true},
+		{Code: "ar", Name: "العربية", Rtl: true},
+	}}, nil
+}
+
+func TestListTimezonesAndLocales(t *testing.T) {
+	mock := &mockCatalogClient{}
+	s := newTestSystemClient(mock)
+	ctx := context.Background()
+
+	for range 2 {
+		timezones, err := s.ListTimezones(ctx)
+		if err != nil {
+			t.Fatalf("ListTimezones failed: %v", err)
+		}
+		if len(timezones) != 1 || timezones[0].GetId() != "Asia/Shanghai" {
+			t.Errorf("Unexpected timezones: %v", timezones)
+		}
+
+		locales, err := s.ListLocales(ctx)
+		if err != nil {
+			t.Fatalf("ListLocales failed: %v", err)
+		}
+		if len(locales) != 2 || !locales[1].GetRtl() {
+			t.Errorf("Unexpected locales: %v", locales)
+		}
+	}
+
+	if mock.calls["InternalListTimezones"] != 1 || mock.calls["InternalListLocales"] != 1 {
+		t.Errorf("Expected cached results, got %v", mock.calls)
+	}
+}
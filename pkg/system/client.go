@@ -11,6 +11,7 @@ import (
 	"google.golang.org/grpc"
 )
 
+// Client 系统服务客户端
 type Client struct {
 	config       *Config
 	conn         *grpc.ClientConn
@@ -18,6 +19,7 @@ type Client struct {
 	systemClient *SystemClient
 }
 
+// NewClient 创建系统服务客户端（直连方式）
 func NewClient(config *Config) (*Client, error) {
 	if config == nil {
 		config = DefaultConfig()
@@ -42,6 +44,7 @@ func NewClient(config *Config) (*Client, error) {
 	}, nil
 }
 
+// NewClientWithDiscovery 创建带服务发现的系统服务客户端
 func NewClientWithDiscovery(config *Config, discovery registry.Discovery) (*Client, error) {
 	if config == nil {
 		config = DefaultConfig()
@@ -65,7 +68,7 @@ func NewClientWithDiscovery(config *Config, discovery registry.Discovery) (*Clie
 		return nil, fmt.Errorf("创建 gRPC 连接失败: %w", err)
 	}
 
-	logger.Infof("平台服务客户端连接成功 (服务发现): endpoint=%s, timeout=%v", config.Endpoint, config.Timeout)
+	logger.Infof("系统服务客户端连接成功 (服务发现): endpoint=%s, timeout=%v", config.Endpoint, config.Timeout)
 
 	return &Client{
 		config:       config,
@@ -75,6 +78,25 @@ func NewClientWithDiscovery(config *Config, discovery registry.Discovery) (*Clie
 	}, nil
 }
 
+// NewClientWithConn 基于已建立的连接创建系统服务客户端
+//
+// 用于多个客户端共享连接（如 clients.Factory），连接由调用方管理，Close 不会关闭该连接
+func NewClientWithConn(config *Config, conn grpc.ClientConnInterface) *Client {
+	if config == nil {
+		config = DefaultConfig()
+	}
+	logger := log.NewHelper(log.With(
+		log.GetLogger(),
+		"module", "system-client",
+	))
+	return &Client{
+		config:       config,
+		logger:       logger,
+		systemClient: newSystemClient(conn, logger, config),
+	}
+}
+
+// Close 关闭 gRPC 连接
 func (c *Client) Close() error {
 	if c.conn != nil {
 		return c.conn.Close()
@@ -82,24 +104,60 @@ func (c *Client) Close() error {
 	return nil
 }
 
+// SystemClient 返回系统服务接口客户端
+//
+// 业务代码建议以 SystemAPI 接口持有返回值，便于在单元测试中替换为 systemtest.FakeClient
 func (c *Client) SystemClient() *SystemClient {
 	return c.systemClient
 }
 
+// SystemClient 系统服务接口客户端，实现了 SystemAPI
 type SystemClient struct {
 	client v1.SystemInternalServiceClient
 	logger *log.Helper
 	config *Config
+
+	// 进程内缓存
+	countries  *ttlCache[[]*v1.InternalCountry]
+	currencies *ttlCache[[]*v1.InternalCurrency]
+	rates      *ttlCache[*ExchangeRate]
+	timezones  *ttlCache[[]*v1.InternalTimezone]
+	locales    *ttlCache[[]*v1.InternalLocale]
+	holidays   *ttlCache[[]*v1.InternalHoliday]
+	regions    *ttlCache[*v1.InternalRegionInfo]
 }
 
-func newSystemClient(conn *grpc.ClientConn, logger *log.Helper, config *Config) *SystemClient {
+func newSystemClient(conn grpc.ClientConnInterface, logger *log.Helper, config *Config) *SystemClient {
+	return newSystemClientWithService(v1.NewSystemInternalServiceClient(conn), logger, config)
+}
+
+// newSystemClientWithService 基于 gRPC 服务客户端创建 SystemClient
+func newSystemClientWithService(client v1.SystemInternalServiceClient, logger *log.Helper, config *Config) *SystemClient {
 	return &SystemClient{
-		client: v1.NewSystemInternalServiceClient(conn),
-		logger: logger,
-		config: config,
+		client:     client,
+		logger:     logger,
+		config:     config,
+		countries:  newTTLCache[[]*v1.InternalCountry](DefaultCacheTTL),
+		currencies: newTTLCache[[]*v1.InternalCurrency](DefaultCacheTTL),
+		rates:      newTTLCache[*ExchangeRate](DefaultExchangeRateCacheTTL),
+		timezones:  newTTLCache[[]*v1.InternalTimezone](DefaultCacheTTL),
+		locales:    newTTLCache[[]*v1.InternalLocale](DefaultCacheTTL),
+		holidays:   newTTLCache[[]*v1.InternalHoliday](DefaultCacheTTL),
+		regions:    newTTLCache[*v1.InternalRegionInfo](DefaultCacheTTL),
 	}
 }
 
+// InvalidateCache 清空进程内缓存，下次调用时重新从系统服务获取
+func (s *SystemClient) InvalidateCache() {
+	s.countries.clear()
+	s.currencies.clear()
+	s.rates.clear()
+	s.timezones.clear()
+	s.locales.clear()
+	s.holidays.clear()
+	s.regions.clear()
+}
+
 func (s *SystemClient) GetCountryInfo(ctx context.Context, countryCode string) (*v1.InternalCountry, error) {
 	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
 	defer cancel()
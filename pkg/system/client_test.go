@@ -25,7 +25,7 @@ func TestGetCountryInfo(t *testing.T) {
 	// 创建 Consul 服务发现
 	discovery := consul.New(consulClient)
 
-	// 创建平台服务客户端
+	// 创建系统服务客户端
 	client, err := NewClientWithDiscovery(DefaultConfig(), discovery)
 	if err != nil {
 		t.Fatalf("创建客户端失败: %v", err)
@@ -34,7 +34,7 @@ func TestGetCountryInfo(t *testing.T) {
 
 	// 测试获取订阅列表
 	ctx := context.Background()
-	country, err := client.systemClient.GetCountryInfo(ctx, "CN")
+	country, err := client.SystemClient().GetCountryInfo(ctx, "CN")
 	if err != nil {
 		t.Logf("获取国家失败（可能服务未启动）: %v", err)
 		t.Skip("跳过测试，服务可能未启动")
@@ -5,14 +5,14 @@ import (
 )
 
 const (
-	// DefaultServiceName 默认的平台服务名称（用于服务发现）
+	// DefaultServiceName 默认的系统服务名称（用于服务发现）
 	DefaultServiceName = "system-server"
 )
 
-// Config 平台服务客户端配置
+// Config 系统服务客户端配置
 type Config = common.ServiceConfig
 
-// DefaultConfig 返回默认的平台服务客户端配置
+// DefaultConfig 返回默认的系统服务客户端配置
 //
 // 默认配置:
 //   - Endpoint: "discovery:///system-server"
@@ -0,0 +1,243 @@
+package system
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+)
+
+// ErrCountryNotFound 国家不存在
+var ErrCountryNotFound = errors.New("国家不存在")
+
+// ListCountriesOptions 国家列表查询选项
+type ListCountriesOptions struct {
+	// Locale 语言（如 zh-CN、en-US），决定返回的国家名称，为空时使用默认语言
+	Locale string
+	// Region 按所属区域过滤
+	Region *v1.InternalRegion
+	// Enabled 按是否启用过滤
+	Enabled *bool
+}
+
+// ListCountries 获取国家列表
+//
+// 每种语言的完整国家列表在进程内缓存 DefaultCacheTTL，过滤在本地完成，
+// 适合地址表单、物流配置等高频读取场景
+//
+// 参数:
+//   - opts: 查询选项，可以为 nil
+//
+// 返回:
+//   - []*v1.InternalCountry: 国家列表，按服务端顺序返回，为缓存共享对象，请勿修改
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	enabled := true
+//	countries, err := client.SystemClient().ListCountries(ctx, &system.ListCountriesOptions{
+//	    Locale:  "zh-CN",
+//	    Enabled: &enabled,
+//	})
+func (s *SystemClient) ListCountries(ctx context.Context, opts *ListCountriesOptions) ([]*v1.InternalCountry, error) {
+	var locale string
+	if opts != nil {
+		locale = opts.Locale
+	}
+
+	countries, err := s.allCountries(ctx, locale)
+	if err != nil {
+		return nil, err
+	}
+	return FilterCountries(countries, opts), nil
+}
+
+// FilterCountries 按区域和启用状态过滤国家列表（忽略 Locale）
+//
+// 未指定过滤条件时直接返回 countries
+func FilterCountries(countries []*v1.InternalCountry, opts *ListCountriesOptions) []*v1.InternalCountry {
+	if opts == nil || (opts.Region == nil && opts.Enabled == nil) {
+		return countries
+	}
+
+	result := make([]*v1.InternalCountry, 0, len(countries))
+	for _, country := range countries {
+		if opts.Region != nil && country.GetRegion() != *opts.Region {
+			continue
+		}
+		if opts.Enabled != nil && country.GetIsActive() != *opts.Enabled {
+			continue
+		}
+		result = append(result, country)
+	}
+	return result
+}
+
+// allCountries 获取指定语言的完整国家列表，优先读取缓存
+func (s *SystemClient) allCountries(ctx context.Context, locale string) ([]*v1.InternalCountry, error) {
+	if countries, ok := s.countries.get(locale); ok {
+		return countries, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	req := &v1.InternalListCountriesRequest{}
+	if locale != "" {
+		req.Locale = &locale
+	}
+	resp, err := s.client.InternalListCountries(ctx, req)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("获取国家列表失败:locale=%s,error=%v", locale, err)
+		return nil, err
+	}
+
+	s.countries.set(locale, resp.GetCountries())
+	return resp.GetCountries(), nil
+}
+
+// GetCountries 批量获取国家信息
+//
+// 基于缓存的国家列表（默认语言）查找，国家代码不区分大小写，不存在的代码会被忽略
+//
+// 参数:
+//   - codes: 国家代码列表 (ISO 3166-1 alpha-2)
+//
+// 返回:
+//   - map[string]*v1.InternalCountry: 以大写国家代码为键的国家信息
+//   - error: 错误信息
+func (s *SystemClient) GetCountries(ctx context.Context, codes []string) (map[string]*v1.InternalCountry, error) {
+	result := make(map[string]*v1.InternalCountry, len(codes))
+	if len(codes) == 0 {
+		return result, nil
+	}
+
+	countries, err := s.allCountries(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		wanted[strings.ToUpper(code)] = struct{}{}
+	}
+	for _, country := range countries {
+		code := strings.ToUpper(country.GetCode())
+		if _, ok := wanted[code]; ok {
+			result[code] = country
+		}
+	}
+	return result, nil
+}
+
+// CountryByPhonePrefix 根据电话区号查找国家
+//
+// prefix 可以是区号（+86、0086）或完整号码（+8613800138000），按最长前缀匹配；
+// 多个国家共用区号时（如 +1），优先返回默认国家，其次是已启用且排序靠前的国家
+//
+// 参数:
+//   - prefix: 电话区号或完整号码
+//
+// 返回:
+//   - *v1.InternalCountry: 国家信息
+//   - error: 未匹配时返回 ErrCountryNotFound
+//
+// 使用示例:
+//
+//	country, err := client.SystemClient().CountryByPhonePrefix(ctx, "+8613800138000")
+func (s *SystemClient) CountryByPhonePrefix(ctx context.Context, prefix string) (*v1.InternalCountry, error) {
+	if normalizePhonePrefix(prefix) == "" {
+		return nil, fmt.Errorf("电话区号不能为空")
+	}
+
+	countries, err := s.allCountries(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	country := FindCountryByPhonePrefix(countries, prefix)
+	if country == nil {
+		return nil, fmt.Errorf("%w: 区号 %s", ErrCountryNotFound, prefix)
+	}
+	return country, nil
+}
+
+// FindCountryByPhonePrefix 在国家列表中按电话区号查找国家，规则与 CountryByPhonePrefix 一致
+//
+// 未匹配时返回 nil
+func FindCountryByPhonePrefix(countries []*v1.InternalCountry, prefix string) *v1.InternalCountry {
+	number := normalizePhonePrefix(prefix)
+	if number == "" {
+		return nil
+	}
+
+	var best *v1.InternalCountry
+	var bestLen int
+	for _, country := range countries {
+		code := normalizePhonePrefix(country.GetPhonePrefix())
+		if code == "" || !strings.HasPrefix(number, code) {
+			continue
+		}
+		if best == nil || len(code) > bestLen || (len(code) == bestLen && preferCountry(country, best)) {
+			best, bestLen = country, len(code)
+		}
+	}
+	return best
+}
+
+// PhonePrefixByCountry 获取国家的电话区号
+//
+// 参数:
+//   - code: 国家代码，不区分大小写
+//
+// 返回:
+//   - string: 电话区号，统一为 +86 格式
+//   - error: 国家不存在或未配置区号时返回 ErrCountryNotFound
+func (s *SystemClient) PhonePrefixByCountry(ctx context.Context, code string) (string, error) {
+	countries, err := s.GetCountries(ctx, []string{code})
+	if err != nil {
+		return "", err
+	}
+
+	prefix := PhonePrefixOf(countries[strings.ToUpper(code)])
+	if prefix == "" {
+		return "", fmt.Errorf("%w: %s", ErrCountryNotFound, code)
+	}
+	return prefix, nil
+}
+
+// PhonePrefixOf 返回国家的电话区号，统一为 +86 格式，未配置时返回空字符串
+func PhonePrefixOf(country *v1.InternalCountry) string {
+	digits := normalizePhonePrefix(country.GetPhonePrefix())
+	if digits == "" {
+		return ""
+	}
+	return "+" + digits
+}
+
+// normalizePhonePrefix 去掉区号中的 +、前导 00 和分隔符，只保留数字
+func normalizePhonePrefix(prefix string) string {
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, prefix)
+	if !strings.HasPrefix(strings.TrimSpace(prefix), "+") {
+		digits = strings.TrimPrefix(digits, "00")
+	}
+	return digits
+}
+
+// preferCountry 共用区号时 a 是否优先于 b
+func preferCountry(a, b *v1.InternalCountry) bool {
+	if a.GetIsDefault() != b.GetIsDefault() {
+		return a.GetIsDefault()
+	}
+	if a.GetIsActive() != b.GetIsActive() {
+		return a.GetIsActive()
+	}
+	return a.GetSort() < b.GetSort()
+}
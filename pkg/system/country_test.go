@@ -0,0 +1,164 @@
+package system
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+	"google.golang.org/grpc"
+)
+
+// mockSystemClient 模拟系统服务，仅实现测试用到的方法
+type mockSystemClient struct {
+	v1.SystemInternalServiceClient
+
+	countries map[string][]*v1.InternalCountry
+	calls     map[string]int
+}
+
+func (m *mockSystemClient) called(method string) {
+	if m.calls == nil {
+		m.calls = make(map[string]int)
+	}
+	m.calls[method]++
+}
+
+func (m *mockSystemClient) InternalListCountries(_ context.Context, in *v1.InternalListCountriesRequest, _ ...grpc.CallOption) (*v1.InternalListCountriesResponse, error) {
+	m.called("InternalListCountries")
+	return &v1.InternalListCountriesResponse{Countries: m.countries[in.GetLocale()]}, nil
+}
+
+func newTestSystemClient(client v1.SystemInternalServiceClient) *SystemClient {
+	return newSystemClientWithService(client, log.NewHelper(log.DefaultLogger), DefaultConfig())
+}
+
+func newMockCountries() map[string][]*v1.InternalCountry {
+	return map[string][]*v1.InternalCountry{
+		"zh-CN": {
+			{Code: "CN", Name: "中国", Region: v1.InternalRegion_INTERNAL_ASIA, IsActive: true},
+			{Code: "JP", Name: "日本", Region: v1.InternalRegion_INTERNAL_ASIA, IsActive: false},
+			{Code: "DE", Name: "德国", Region: v1.InternalRegion_INTERNAL_EUROPE, IsActive: true},
+		},
+		"en-US": {
+			{Code: "CN", Name: "China", Region: v1.InternalRegion_INTERNAL_ASIA, IsActive: true},
+		},
+	}
+}
+
+func TestListCountries(t *testing.T) {
+	mock := &mockSystemClient{countries: newMockCountries()}
+	s := newTestSystemClient(mock)
+	ctx := context.Background()
+
+	all, err := s.ListCountries(ctx, &ListCountriesOptions{Locale: "zh-CN"})
+	if err != nil {
+		t.Fatalf("ListCountries failed: %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("Expected 3 countries, got %d", len(all))
+	}
+
+	asia := v1.InternalRegion_INTERNAL_ASIA
+	enabled := true
+	filtered, err := s.ListCountries(ctx, &ListCountriesOptions{Locale: "zh-CN", Region: &asia, Enabled: &enabled})
+	if err != nil {
+		t.Fatalf("ListCountries failed: %v", err)
+	}
+	if len(filtered) != 1 || filtered[0].GetCode() != "CN" {
+		t.Errorf("Unexpected filtered countries: %v", filtered)
+	}
+	if mock.calls["InternalListCountries"] != 1 {
+		t.Errorf("Expected cached list, got %d calls", mock.calls["InternalListCountries"])
+	}
+
+	en, err := s.ListCountries(ctx, &ListCountriesOptions{Locale: "en-US"})
+	if err != nil {
+		t.Fatalf("ListCountries failed: %v", err)
+	}
+	if len(en) != 1 || en[0].GetName() != "China" || mock.calls["InternalListCountries"] != 2 {
+		t.Errorf("Expected separate cache per locale: %v, calls=%d", en, mock.calls["InternalListCountries"])
+	}
+
+	s.InvalidateCache()
+	if _, err := s.ListCountries(ctx, &ListCountriesOptions{Locale: "zh-CN"}); err != nil {
+		t.Fatalf("ListCountries failed: %v", err)
+	}
+	if mock.calls["InternalListCountries"] != 3 {
+		t.Errorf("Expected reload after invalidate, got %d calls", mock.calls["InternalListCountries"])
+	}
+}
+
+func TestTTLCacheExpire(t *testing.T) {
+	now := time.Now()
+	c := newTTLCache[int](time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.set("k", 1)
+	if v, ok := c.get("k"); !ok || v != 1 {
+		t.Errorf("Expected cached value, got %v %v", v, ok)
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := c.get("k"); ok {
+		t.Error("Expected cache entry to expire")
+	}
+}
+
+func TestGetCountriesAndPhonePrefix(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+	mock := &mockSystemClient{countries: map[string][]*v1.InternalCountry{
+		"": {
+			{Code: "CN", PhonePrefix: ptr("+86"), IsActive: true},
+			{Code: "CA", PhonePrefix: ptr("+1"), IsActive: true, Sort: 2},
+			{Code: "US", PhonePrefix: ptr("+1"), IsActive: true, IsDefault: true, Sort: 1},
+			{Code: "AG", PhonePrefix: ptr("+1-268"), IsActive: true},
+			{Code: "AQ"},
+		},
+	}}
+	s := newTestSystemClient(mock)
+	ctx := context.Background()
+
+	countries, err := s.GetCountries(ctx, []string{"cn", "US", "XX"})
+	if err != nil {
+		t.Fatalf("GetCountries failed: %v", err)
+	}
+	if len(countries) != 2 || countries["CN"] == nil || countries["US"] == nil {
+		t.Errorf("Unexpected countries: %v", countries)
+	}
+
+	cases := map[string]string{
+		"+86":             "CN",
+		"0086":            "CN",
+		"+8613800138000":  "CN",
+		"+1":              "US",
+		"+1 415 555 0100": "US",
+		"+1268 555 0100":  "AG",
+	}
+	for prefix, want := range cases {
+		country, err := s.CountryByPhonePrefix(ctx, prefix)
+		if err != nil {
+			t.Errorf("CountryByPhonePrefix(%q) failed: %v", prefix, err)
+			continue
+		}
+		if country.GetCode() != want {
+			t.Errorf("CountryByPhonePrefix(%q) = %s, want %s", prefix, country.GetCode(), want)
+		}
+	}
+	if _, err := s.CountryByPhonePrefix(ctx, "+999"); !errors.Is(err, ErrCountryNotFound) {
+		t.Errorf("Expected ErrCountryNotFound, got %v", err)
+	}
+
+	prefix, err := s.PhonePrefixByCountry(ctx, "ag")
+	if err != nil || prefix != "+1268" {
+		t.Errorf("Unexpected prefix: %q, %v", prefix, err)
+	}
+	if _, err := s.PhonePrefixByCountry(ctx, "AQ"); !errors.Is(err, ErrCountryNotFound) {
+		t.Errorf("Expected ErrCountryNotFound for country without prefix, got %v", err)
+	}
+	if mock.calls["InternalListCountries"] != 1 {
+		t.Errorf("Expected cached country list, got %d calls", mock.calls["InternalListCountries"])
+	}
+}
@@ -0,0 +1,158 @@
+package system
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DefaultExchangeRateCacheTTL 汇率默认缓存有效期
+const DefaultExchangeRateCacheTTL = time.Minute
+
+// ErrCurrencyNotFound 货币不存在
+var ErrCurrencyNotFound = errors.New("货币不存在")
+
+// ExchangeRate 汇率
+type ExchangeRate struct {
+	// From 源货币代码
+	From string
+	// To 目标货币代码
+	To string
+	// Rate 1 单位源货币可兑换的目标货币数量
+	Rate decimal.Decimal
+	// EffectiveAt 汇率生效时间
+	EffectiveAt time.Time
+	// Source 汇率来源
+	Source string
+}
+
+// Convert 将源货币金额换算为目标货币金额（不做舍入）
+func (r *ExchangeRate) Convert(amount decimal.Decimal) decimal.Decimal {
+	return amount.Mul(r.Rate)
+}
+
+// ListCurrencies 获取货币列表
+//
+// 结果在进程内缓存 DefaultCacheTTL
+//
+// 返回:
+//   - []*v1.InternalCurrency: 货币列表，为缓存共享对象，请勿修改
+//   - error: 错误信息
+func (s *SystemClient) ListCurrencies(ctx context.Context) ([]*v1.InternalCurrency, error) {
+	if currencies, ok := s.currencies.get(""); ok {
+		return currencies, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	resp, err := s.client.InternalListCurrencies(ctx, &v1.InternalListCurrenciesRequest{})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("获取货币列表失败:error=%v", err)
+		return nil, err
+	}
+
+	s.currencies.set("", resp.GetCurrencies())
+	return resp.GetCurrencies(), nil
+}
+
+// GetCurrency 获取货币信息
+//
+// 基于缓存的货币列表查找，货币代码不区分大小写
+//
+// 参数:
+//   - code: 货币代码 (ISO 4217)，如 CNY
+//
+// 返回:
+//   - *v1.InternalCurrency: 货币信息
+//   - error: 不存在时返回 ErrCurrencyNotFound
+func (s *SystemClient) GetCurrency(ctx context.Context, code string) (*v1.InternalCurrency, error) {
+	currencies, err := s.ListCurrencies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, currency := range currencies {
+		if strings.EqualFold(currency.GetCode(), code) {
+			return currency, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrCurrencyNotFound, code)
+}
+
+// GetExchangeRate 获取汇率
+//
+// 结果按货币对和时间点（精确到分钟）缓存 DefaultExchangeRateCacheTTL，
+// 相同货币之间直接返回 1，不调用系统服务
+//
+// 参数:
+//   - from: 源货币代码
+//   - to: 目标货币代码
+//   - at: 查询时间点，零值表示最新汇率
+//
+// 返回:
+//   - *ExchangeRate: 汇率
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	rate, err := client.SystemClient().GetExchangeRate(ctx, "USD", "CNY", time.Time{})
+//	if err != nil {
+//	    return err
+//	}
+//	cny := rate.Convert(decimal.NewFromInt(100))
+func (s *SystemClient) GetExchangeRate(ctx context.Context, from, to string, at time.Time) (*ExchangeRate, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == "" || to == "" {
+		return nil, fmt.Errorf("货币代码不能为空")
+	}
+	if from == to {
+		return &ExchangeRate{From: from, To: to, Rate: decimal.NewFromInt(1), EffectiveAt: at}, nil
+	}
+
+	key := from + "|" + to
+	if !at.IsZero() {
+		at = at.Truncate(time.Minute)
+		key += "|" + at.UTC().Format(time.RFC3339)
+	}
+	if rate, ok := s.rates.get(key); ok {
+		return rate, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	req := &v1.InternalGetExchangeRateRequest{From: from, To: to}
+	if !at.IsZero() {
+		req.At = timestamppb.New(at)
+	}
+	resp, err := s.client.InternalGetExchangeRate(ctx, req)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("获取汇率失败:from=%s,to=%s,error=%v", from, to, err)
+		return nil, err
+	}
+
+	value, err := decimal.NewFromString(resp.GetRate())
+	if err != nil {
+		return nil, fmt.Errorf("汇率格式错误: %s", resp.GetRate())
+	}
+
+	rate := &ExchangeRate{
+		From:   from,
+		To:     to,
+		Rate:   value,
+		Source: resp.GetSource(),
+	}
+	if resp.GetEffectiveAt() != nil {
+		rate.EffectiveAt = resp.GetEffectiveAt().AsTime()
+	}
+	s.rates.set(key, rate)
+
+	return rate, nil
+}
@@ -0,0 +1,88 @@
+package system
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc"
+)
+
+// mockCurrencyClient 模拟货币和汇率接口
+type mockCurrencyClient struct {
+	mockSystemClient
+
+	rateReqs []*v1.InternalGetExchangeRateRequest
+}
+
+func (m *mockCurrencyClient) InternalListCurrencies(_ context.Context, _ *v1.InternalListCurrenciesRequest, _ ...grpc.CallOption) (*v1.InternalListCurrenciesResponse, error) {
+	m.called("InternalListCurrencies")
+	return &v1.InternalListCurrenciesResponse{Currencies: []*v1.InternalCurrency{
+		{Code: "CNY", Symbol: "¥", DecimalPlaces: 2},
+		{Code: "JPY", Symbol: "¥", DecimalPlaces: 0},
+	}}, nil
+}
+
+func (m *mockCurrencyClient) InternalGetExchangeRate(_ context.Context, in *v1.InternalGetExchangeRateRequest, _ ...grpc.CallOption) (*v1.InternalGetExchangeRateResponse, error) {
+	m.rateReqs = append(m.rateReqs, in)
+	return &v1.InternalGetExchangeRateResponse{Rate: "7.1", Source: "test"}, nil
+}
+
+func TestCurrencies(t *testing.T) {
+	mock := &mockCurrencyClient{}
+	s := newTestSystemClient(mock)
+	ctx := context.Background()
+
+	currency, err := s.GetCurrency(ctx, "jpy")
+	if err != nil {
+		t.Fatalf("GetCurrency failed: %v", err)
+	}
+	if currency.GetDecimalPlaces() != 0 {
+		t.Errorf("Unexpected currency: %v", currency)
+	}
+	if _, err := s.GetCurrency(ctx, "XXX"); !errors.Is(err, ErrCurrencyNotFound) {
+		t.Errorf("Expected ErrCurrencyNotFound, got %v", err)
+	}
+	if mock.calls["InternalListCurrencies"] != 1 {
+		t.Errorf("Expected cached currencies, got %d calls", mock.calls["InternalListCurrencies"])
+	}
+}
+
+func TestGetExchangeRate(t *testing.T) {
+	mock := &mockCurrencyClient{}
+	s := newTestSystemClient(mock)
+	ctx := context.Background()
+
+	rate, err := s.GetExchangeRate(ctx, "usd", "cny", time.Time{})
+	if err != nil {
+		t.Fatalf("GetExchangeRate failed: %v", err)
+	}
+	if !rate.Convert(decimal.NewFromInt(100)).Equal(decimal.NewFromInt(710)) {
+		t.Errorf("Unexpected conversion: %s", rate.Convert(decimal.NewFromInt(100)))
+	}
+	if _, err := s.GetExchangeRate(ctx, "USD", "CNY", time.Time{}); err != nil {
+		t.Fatalf("GetExchangeRate failed: %v", err)
+	}
+	if len(mock.rateReqs) != 1 || mock.rateReqs[0].GetFrom() != "USD" || mock.rateReqs[0].At != nil {
+		t.Errorf("Expected one cached latest-rate request, got %v", mock.rateReqs)
+	}
+
+	at := time.Date(2026, 1, 1, 8, 30, 45, 0, time.UTC)
+	if _, err := s.GetExchangeRate(ctx, "USD", "CNY", at); err != nil {
+		t.Fatalf("GetExchangeRate failed: %v", err)
+	}
+	if _, err := s.GetExchangeRate(ctx, "USD", "CNY", at.Add(10*time.Second)); err != nil {
+		t.Fatalf("GetExchangeRate failed: %v", err)
+	}
+	if len(mock.rateReqs) != 2 || !mock.rateReqs[1].GetAt().AsTime().Equal(at.Truncate(time.Minute)) {
+		t.Errorf("Expected historical rate cached per minute, got %v", mock.rateReqs)
+	}
+
+	same, err := s.GetExchangeRate(ctx, "CNY", "cny", time.Time{})
+	if err != nil || !same.Rate.Equal(decimal.NewFromInt(1)) || len(mock.rateReqs) != 2 {
+		t.Errorf("Expected identity rate without RPC, got %v %v", same, err)
+	}
+}
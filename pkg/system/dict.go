@@ -0,0 +1,141 @@
+package system
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+	"github.com/heyinLab/common/pkg/internal/poll"
+)
+
+// DefaultDictWatchInterval 字典变更轮询的默认间隔
+const DefaultDictWatchInterval = 30 * time.Second
+
+// ErrDictNotFound 字典不存在
+var ErrDictNotFound = errors.New("字典不存在")
+
+// DictChangeFunc 字典变更回调，参数为变更后的完整字典
+type DictChangeFunc func(dict *v1.InternalDict)
+
+// DictGetter 按版本获取字典的函数
+//
+// ifNoneMatch 与服务端版本一致时返回 notModified=true，此时 dict 为空
+type DictGetter func(ctx context.Context, dictCode string, ifNoneMatch string) (dict *v1.InternalDict, notModified bool, err error)
+
+// GetDict 获取字典
+//
+// 平台统一维护的枚举（如订单取消原因、行业分类），长期使用时建议配合 WatchDict 自动刷新
+//
+// 参数:
+//   - dictCode: 字典编码
+//
+// 返回:
+//   - *v1.InternalDict: 字典（包含未启用的字典项）
+//   - error: 不存在时返回 ErrDictNotFound
+//
+// 使用示例:
+//
+//	dict, err := client.SystemClient().GetDict(ctx, "order_cancel_reason")
+func (s *SystemClient) GetDict(ctx context.Context, dictCode string) (*v1.InternalDict, error) {
+	dict, _, err := s.getDict(ctx, dictCode, "")
+	return dict, err
+}
+
+// WatchDict 监听字典变更
+//
+// 按 DefaultDictWatchInterval 轮询字典，携带上次的版本号，服务端版本未变化时不返回内容；
+// 服务端未返回版本号时在本地根据字典内容计算。启动时先同步拉取一次并回调，之后仅在版本变化时回调。
+// 方法会阻塞直到 ctx 取消
+//
+// 参数:
+//   - ctx: 上下文，取消后停止监听
+//   - dictCode: 字典编码
+//   - onChange: 变更回调（在监听协程中同步调用）
+//
+// 返回:
+//   - error: 首次拉取失败时返回错误；ctx 取消时返回 nil
+//
+// 使用示例:
+//
+//	var reasons atomic.Pointer[v1.InternalDict]
+//	go func() {
+//	    err := client.SystemClient().WatchDict(ctx, "order_cancel_reason", func(dict *v1.InternalDict) {
+//	        reasons.Store(dict)
+//	    })
+//	    if err != nil {
+//	        log.Errorf("监听字典变更失败: %v", err)
+//	    }
+//	}()
+//
+// 说明:
+//   - 字典被删除或查询失败时不回调，调用方继续使用最近一次收到的字典，失败原因见 GetDict 的错误日志
+func (s *SystemClient) WatchDict(ctx context.Context, dictCode string, onChange DictChangeFunc) error {
+	return WatchDictWithGetter(ctx, s.getDict, dictCode, onChange, DefaultDictWatchInterval)
+}
+
+// WatchDictWithGetter 使用自定义的字典获取函数监听字典变更
+//
+// 行为与 SystemClient.WatchDict 一致，便于自定义轮询间隔或接入测试替身
+//
+// 参数:
+//   - interval: 轮询间隔，<=0 时使用 DefaultDictWatchInterval
+func WatchDictWithGetter(ctx context.Context, get DictGetter, dictCode string, onChange DictChangeFunc, interval time.Duration) error {
+	if get == nil {
+		return fmt.Errorf("字典获取函数不能为空")
+	}
+	if dictCode == "" {
+		return fmt.Errorf("字典编码不能为空")
+	}
+	if onChange == nil {
+		return fmt.Errorf("变更回调不能为空")
+	}
+	if interval <= 0 {
+		interval = DefaultDictWatchInterval
+	}
+
+	return poll.Watch(ctx, interval, func(ctx context.Context, version string) (*v1.InternalDict, string, bool, error) {
+		dict, notModified, err := get(ctx, dictCode, version)
+		if err != nil || notModified {
+			return nil, version, notModified, err
+		}
+		return dict, dictVersion(dict), false, nil
+	}, onChange)
+}
+
+// getDict 按版本获取字典
+func (s *SystemClient) getDict(ctx context.Context, dictCode string, ifNoneMatch string) (*v1.InternalDict, bool, error) {
+	if dictCode == "" {
+		return nil, false, fmt.Errorf("字典编码不能为空")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	req := &v1.InternalGetDictRequest{DictCode: dictCode}
+	if ifNoneMatch != "" {
+		req.IfNoneMatch = &ifNoneMatch
+	}
+	resp, err := s.client.InternalGetDict(ctx, req)
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("获取字典失败:code=%s,error=%v", dictCode, err)
+		return nil, false, err
+	}
+	if resp.GetNotModified() {
+		return nil, true, nil
+	}
+	if resp.GetDict() == nil {
+		return nil, false, fmt.Errorf("%w: %s", ErrDictNotFound, dictCode)
+	}
+
+	return resp.GetDict(), false, nil
+}
+
+// dictVersion 返回字典版本号，服务端未返回时根据内容计算
+func dictVersion(dict *v1.InternalDict) string {
+	if dict.GetVersion() != "" {
+		return dict.GetVersion()
+	}
+	return poll.Fingerprint(dict)
+}
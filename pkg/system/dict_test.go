@@ -0,0 +1,106 @@
+package system
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+	"google.golang.org/grpc"
+)
+
+// mockDictClient 模拟字典接口，支持 if_none_match
+type mockDictClient struct {
+	mockSystemClient
+
+	mu    sync.Mutex
+	dicts map[string]*v1.InternalDict
+}
+
+func (m *mockDictClient) InternalGetDict(_ context.Context, in *v1.InternalGetDictRequest, _ ...grpc.CallOption) (*v1.InternalGetDictResponse, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	dict, ok := m.dicts[in.GetDictCode()]
+	if !ok {
+		return &v1.InternalGetDictResponse{}, nil
+	}
+	if in.IfNoneMatch != nil && in.GetIfNoneMatch() == dict.GetVersion() {
+		return &v1.InternalGetDictResponse{NotModified: true}, nil
+	}
+	return &v1.InternalGetDictResponse{Dict: dict}, nil
+}
+
+func (m *mockDictClient) put(dict *v1.InternalDict) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.dicts[dict.GetCode()] = dict
+}
+
+func TestGetDict(t *testing.T) {
+	mock := &mockDictClient{dicts: map[string]*v1.InternalDict{
+		"reason": {Code: "reason", Version: "1", Items: []*v1.InternalDictItem{{Value: "out_of_stock", Label: "缺货"}}},
+	}}
+	s := newTestSystemClient(mock)
+
+	dict, err := s.GetDict(context.Background(), "reason")
+	if err != nil {
+		t.Fatalf("GetDict failed: %v", err)
+	}
+	if len(dict.GetItems()) != 1 || dict.GetItems()[0].GetLabel() != "缺货" {
+		t.Errorf("Unexpected dict: %v", dict)
+	}
+
+	if _, err := s.GetDict(context.Background(), "missing"); !errors.Is(err, ErrDictNotFound) {
+		t.Errorf("Expected ErrDictNotFound, got %v", err)
+	}
+}
+
+func TestWatchDict(t *testing.T) {
+	mock := &mockDictClient{dicts: map[string]*v1.InternalDict{
+		"reason": {Code: "reason", Version: "1"},
+	}}
+	s := newTestSystemClient(mock)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan string, 10)
+	done := make(chan error, 1)
+	go func() {
+		done <- WatchDictWithGetter(ctx, s.getDict, "reason", func(dict *v1.InternalDict) {
+			changes <- dict.GetVersion()
+		}, 5*time.Millisecond)
+	}()
+
+	if v := <-changes; v != "1" {
+		t.Fatalf("Expected initial version 1, got %s", v)
+	}
+
+	mock.put(&v1.InternalDict{Code: "reason", Version: "2"})
+	select {
+	case v := <-changes:
+		if v != "2" {
+			t.Errorf("Expected version 2, got %s", v)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected change callback")
+	}
+
+	// 版本未变化时不回调
+	time.Sleep(20 * time.Millisecond)
+	if len(changes) != 0 {
+		t.Errorf("Unexpected callbacks: %d", len(changes))
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Expected nil on cancel, got %v", err)
+	}
+
+	if err := s.WatchDict(context.Background(), "missing", func(*v1.InternalDict) {}); !errors.Is(err, ErrDictNotFound) {
+		t.Errorf("Expected ErrDictNotFound on first fetch, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package system
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+)
+
+// ListHolidays 获取国家指定年份的节假日
+//
+// 供 SLA 计算、配送时效预估等场景统一使用，结果按国家和年份在进程内缓存 DefaultCacheTTL
+//
+// 参数:
+//   - countryCode: 国家代码 (ISO 3166-1 alpha-2)，不区分大小写
+//   - year: 年份
+//
+// 返回:
+//   - []*v1.InternalHoliday: 按日期升序的节假日（包含调休上班日），为缓存共享对象，请勿修改
+//   - error: 错误信息
+//
+// 使用示例:
+//
+//	holidays, err := client.SystemClient().ListHolidays(ctx, "CN", 2026)
+//	if err != nil {
+//	    return err
+//	}
+//	for _, h := range holidays {
+//	    if h.GetType() == v1.InternalHolidayType_INTERNAL_HOLIDAY_TYPE_HOLIDAY {
+//	        offDays[h.GetDate()] = true
+//	    }
+//	}
+func (s *SystemClient) ListHolidays(ctx context.Context, countryCode string, year int) ([]*v1.InternalHoliday, error) {
+	countryCode = strings.ToUpper(countryCode)
+	if countryCode == "" {
+		return nil, fmt.Errorf("国家代码不能为空")
+	}
+	if year <= 0 {
+		return nil, fmt.Errorf("年份无效: %d", year)
+	}
+
+	key := fmt.Sprintf("%s|%d", countryCode, year)
+	if holidays, ok := s.holidays.get(key); ok {
+		return holidays, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	resp, err := s.client.InternalListHolidays(ctx, &v1.InternalListHolidaysRequest{
+		CountryCode: countryCode,
+		Year:        int32(year),
+	})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("获取节假日失败:country=%s,year=%d,error=%v", countryCode, year, err)
+		return nil, err
+	}
+
+	s.holidays.set(key, resp.GetHolidays())
+	return resp.GetHolidays(), nil
+}
@@ -0,0 +1,62 @@
+package system
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+	"google.golang.org/grpc"
+)
+
+// mockHolidayClient 模拟节假日接口
+type mockHolidayClient struct {
+	mockSystemClient
+
+	reqs []*v1.InternalListHolidaysRequest
+}
+
+func (m *mockHolidayClient) InternalListHolidays(_ context.Context, in *v1.InternalListHolidaysRequest, _ ...grpc.CallOption) (*v1.InternalListHolidaysResponse, error) {
+	m.called("InternalListHolidays")
+	m.reqs = append(m.reqs, in)
+	if in.GetCountryCode() != "CN" {
+		return &v1.InternalListHolidaysResponse{}, nil
+	}
+	return &v1.InternalListHolidaysResponse{Holidays: []*v1.InternalHoliday{
+		{Date: "2026-09-27", Name: "国庆节调休", Type: v1.InternalHolidayType_INTERNAL_HOLIDAY_TYPE_WORKDAY},
+		{Date: "2026-10-01", Name: "国庆节", Type: v1.InternalHolidayType_INTERNAL_HOLIDAY_TYPE_HOLIDAY},
+	}}, nil
+}
+
+func TestListHolidays(t *testing.T) {
+	mock := &mockHolidayClient{}
+	s := newTestSystemClient(mock)
+	ctx := context.Background()
+
+	for _, code := range []string{"cn", "CN"} {
+		holidays, err := s.ListHolidays(ctx, code, 2026)
+		if err != nil {
+			t.Fatalf("ListHolidays failed: %v", err)
+		}
+		if len(holidays) != 2 || holidays[1].GetName() != "国庆节" {
+			t.Errorf("Unexpected holidays: %v", holidays)
+		}
+	}
+	if mock.calls["InternalListHolidays"] != 1 || mock.reqs[0].GetCountryCode() != "CN" || mock.reqs[0].GetYear() != 2026 {
+		t.Errorf("Expected one cached request for CN/2026, got %v", mock.reqs)
+	}
+
+	// 不同年份单独缓存
+	if _, err := s.ListHolidays(ctx, "CN", 2027); err != nil {
+		t.Fatalf("ListHolidays failed: %v", err)
+	}
+	if mock.calls["InternalListHolidays"] != 2 {
+		t.Errorf("Expected separate cache entry per year, got %d calls", mock.calls["InternalListHolidays"])
+	}
+
+	if _, err := s.ListHolidays(ctx, "", 2026); err == nil {
+		t.Error("Expected error for empty country code")
+	}
+	if _, err := s.ListHolidays(ctx, "CN", 0); err == nil {
+		t.Error("Expected error for invalid year")
+	}
+}
@@ -0,0 +1,62 @@
+package system
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+)
+
+// SystemAPI 系统服务客户端接口
+//
+// SystemClient 实现了该接口，业务代码依赖该接口即可在单元测试中
+// 使用 systemtest.NewFakeClient 替换真实客户端
+//
+// 使用示例:
+//
+//	type AddressService struct {
+//	    system system.SystemAPI
+//	}
+//
+//	// 生产环境
+//	svc := &AddressService{system: client.SystemClient()}
+//
+//	// 单元测试
+//	svc := &AddressService{system: systemtest.NewFakeClient(fixtures)}
+type SystemAPI interface {
+	// ========== 国家相关接口 ==========
+
+	GetCountryInfo(ctx context.Context, countryCode string) (*v1.InternalCountry, error)
+	ListCountries(ctx context.Context, opts *ListCountriesOptions) ([]*v1.InternalCountry, error)
+	GetCountries(ctx context.Context, codes []string) (map[string]*v1.InternalCountry, error)
+	CountryByPhonePrefix(ctx context.Context, prefix string) (*v1.InternalCountry, error)
+	PhonePrefixByCountry(ctx context.Context, code string) (string, error)
+
+	// ========== 货币相关接口 ==========
+
+	ListCurrencies(ctx context.Context) ([]*v1.InternalCurrency, error)
+	GetCurrency(ctx context.Context, code string) (*v1.InternalCurrency, error)
+	GetExchangeRate(ctx context.Context, from, to string, at time.Time) (*ExchangeRate, error)
+
+	// ========== 时区与语言接口 ==========
+
+	ListTimezones(ctx context.Context) ([]*v1.InternalTimezone, error)
+	ListLocales(ctx context.Context) ([]*v1.InternalLocale, error)
+
+	// ========== 节假日接口 ==========
+
+	ListHolidays(ctx context.Context, countryCode string, year int) ([]*v1.InternalHoliday, error)
+
+	// ========== 区域接口 ==========
+
+	ResolveRegion(ctx context.Context) (*v1.InternalRegionInfo, error)
+	GetRegion(ctx context.Context, regionName string) (*v1.InternalRegionInfo, error)
+
+	// ========== 字典接口 ==========
+
+	GetDict(ctx context.Context, dictCode string) (*v1.InternalDict, error)
+	WatchDict(ctx context.Context, dictCode string, onChange DictChangeFunc) error
+}
+
+// 确保 SystemClient 实现了 SystemAPI 接口
+var _ SystemAPI = (*SystemClient)(nil)
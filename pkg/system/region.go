@@ -0,0 +1,71 @@
+package system
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+)
+
+// ErrRegionNotFound 区域不存在
+var ErrRegionNotFound = errors.New("区域不存在")
+
+// ResolveRegion 根据上下文中的区域解析部署区域信息
+//
+// 读取 Claims 中的 RegionName 并调用 GetRegion，用于按数据驻留区路由存储、
+// 设置默认货币和拼接 CDN 地址，替代各服务自行维护的区域映射表
+//
+// 返回:
+//   - *v1.InternalRegionInfo: 区域信息，为缓存共享对象，请勿修改
+//   - error: ctx 中缺少区域信息或区域不存在时返回包装了 ErrRegionNotFound 的错误，可通过 errors.Is 判断
+//
+// 使用示例:
+//
+//	region, err := client.SystemClient().ResolveRegion(ctx)
+//	if err != nil {
+//	    return err
+//	}
+//	url := "https://" + region.GetCdnDomain() + "/" + objectKey
+func (s *SystemClient) ResolveRegion(ctx context.Context) (*v1.InternalRegionInfo, error) {
+	claims, ok := auth.FromContext(ctx)
+	if !ok || claims.RegionName == "" {
+		return nil, fmt.Errorf("%w: 上下文中缺少区域信息", ErrRegionNotFound)
+	}
+	return s.GetRegion(ctx, claims.RegionName)
+}
+
+// GetRegion 获取部署区域信息
+//
+// 结果按区域名称在进程内缓存 DefaultCacheTTL，适用于没有认证信息的后台任务
+//
+// 参数:
+//   - regionName: 区域名称，如 cn-east
+//
+// 返回:
+//   - *v1.InternalRegionInfo: 区域信息，为缓存共享对象，请勿修改
+//   - error: 不存在时返回 ErrRegionNotFound
+func (s *SystemClient) GetRegion(ctx context.Context, regionName string) (*v1.InternalRegionInfo, error) {
+	if regionName == "" {
+		return nil, fmt.Errorf("区域名称不能为空")
+	}
+	if region, ok := s.regions.get(regionName); ok {
+		return region, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.config.Timeout)
+	defer cancel()
+
+	resp, err := s.client.InternalGetRegionInfo(ctx, &v1.InternalGetRegionInfoRequest{RegionName: regionName})
+	if err != nil {
+		s.logger.WithContext(ctx).Errorf("获取区域信息失败:region=%s,error=%v", regionName, err)
+		return nil, err
+	}
+	if resp.GetRegion() == nil {
+		return nil, fmt.Errorf("%w: %s", ErrRegionNotFound, regionName)
+	}
+
+	s.regions.set(regionName, resp.GetRegion())
+	return resp.GetRegion(), nil
+}
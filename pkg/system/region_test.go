@@ -0,0 +1,55 @@
+package system
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"google.golang.org/grpc"
+)
+
+// mockRegionClient 模拟区域信息接口
+type mockRegionClient struct {
+	mockSystemClient
+}
+
+func (m *mockRegionClient) InternalGetRegionInfo(_ context.Context, in *v1.InternalGetRegionInfoRequest, _ ...grpc.CallOption) (*v1.InternalGetRegionInfoResponse, error) {
+	m.called("InternalGetRegionInfo")
+	if in.GetRegionName() != "cn-east" {
+		return &v1.InternalGetRegionInfoResponse{}, nil
+	}
+	return &v1.InternalGetRegionInfoResponse{Region: &v1.InternalRegionInfo{
+		Name:              "cn-east",
+		DataResidencyZone: "CN",
+		DefaultCurrency:   "CNY",
+		CdnDomain:         "cdn-cn.example.com",
+	}}, nil
+}
+
+func TestResolveRegion(t *testing.T) {
+	mock := &mockRegionClient{}
+	s := newTestSystemClient(mock)
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", RegionName: "cn-east"})
+
+	for range 2 {
+		region, err := s.ResolveRegion(ctx)
+		if err != nil {
+			t.Fatalf("ResolveRegion failed: %v", err)
+		}
+		if region.GetDataResidencyZone() != "CN" || region.GetCdnDomain() != "cdn-cn.example.com" {
+			t.Errorf("Unexpected region: %v", region)
+		}
+	}
+	if mock.calls["InternalGetRegionInfo"] != 1 {
+		t.Errorf("Expected cached region, got %d calls", mock.calls["InternalGetRegionInfo"])
+	}
+
+	if _, err := s.ResolveRegion(context.Background()); !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("Expected ErrRegionNotFound without region claim, got %v", err)
+	}
+	if _, err := s.GetRegion(ctx, "eu-west"); !errors.Is(err, ErrRegionNotFound) {
+		t.Errorf("Expected ErrRegionNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,423 @@
+// Package systemtest 提供系统服务客户端的内存实现，供业务方单元测试使用
+package systemtest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+	"github.com/heyinLab/common/pkg/internal/fake"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/system"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultDictWatchInterval 假客户端 WatchDict 的默认轮询间隔
+const DefaultDictWatchInterval = 10 * time.Millisecond
+
+// Fixtures 假客户端的初始数据
+type Fixtures struct {
+	// 国家列表，以 Code 为key，保持给定顺序
+	Countries []*v1.InternalCountry
+	// 货币列表，以 Code 为key
+	Currencies []*v1.InternalCurrency
+	// 汇率（"USD/CNY" -> "7.1"），不存在反向汇率时不会自动推算
+	ExchangeRates map[string]string
+	// 时区列表
+	Timezones []*v1.InternalTimezone
+	// 语言列表
+	Locales []*v1.InternalLocale
+	// 节假日（国家代码 -> 节假日），按 Date 的年份筛选
+	Holidays map[string][]*v1.InternalHoliday
+	// 部署区域列表，以 Name 为key
+	Regions []*v1.InternalRegionInfo
+	// 字典列表，以 Code 为key
+	Dicts []*v1.InternalDict
+	// WatchDict 轮询间隔，默认 DefaultDictWatchInterval
+	DictWatchInterval time.Duration
+}
+
+// FakeClient system.SystemAPI 的内存实现
+//
+// 所有数据保存在内存中，返回结果均为副本；ListCountries 忽略 Locale，只按区域和启用状态过滤
+//
+// 使用示例:
+//
+//	fake := systemtest.NewFakeClient(&systemtest.Fixtures{
+//	    Countries: []*systemv1.InternalCountry{
+//	        {Code: "CN", Name: "中国", PhonePrefix: proto.String("+86"), IsActive: true},
+//	    },
+//	    ExchangeRates: map[string]string{"USD/CNY": "7.1"},
+//	})
+//	svc := NewAddressService(fake)
+//
+//	// 模拟下游故障
+//	fake.SetError("ListCountries", status.Error(codes.Unavailable, "unavailable"))
+type FakeClient struct {
+	mu            sync.Mutex
+	countries     []*v1.InternalCountry
+	currencies    []*v1.InternalCurrency
+	rates         map[string]decimal.Decimal
+	timezones     []*v1.InternalTimezone
+	locales       []*v1.InternalLocale
+	holidays      map[string][]*v1.InternalHoliday
+	regions       map[string]*v1.InternalRegionInfo
+	dicts         map[string]*v1.InternalDict
+	errors        fake.Errors
+	watchInterval time.Duration
+}
+
+// 确保 FakeClient 实现了 SystemAPI 接口
+var _ system.SystemAPI = (*FakeClient)(nil)
+
+// NewFakeClient 创建假客户端
+//
+// 参数:
+//   - fixtures: 初始数据（可选），会被复制，后续修改不影响假客户端
+//
+// 返回:
+//   - *FakeClient: 假客户端实例
+//
+// 注意: ExchangeRates 中格式错误的汇率会导致 panic
+func NewFakeClient(fixtures *Fixtures) *FakeClient {
+	f := &FakeClient{
+		rates:         make(map[string]decimal.Decimal),
+		holidays:      make(map[string][]*v1.InternalHoliday),
+		regions:       make(map[string]*v1.InternalRegionInfo),
+		dicts:         make(map[string]*v1.InternalDict),
+		watchInterval: DefaultDictWatchInterval,
+	}
+	if fixtures == nil {
+		return f
+	}
+
+	f.countries = fake.CloneAll(fixtures.Countries)
+	f.currencies = fake.CloneAll(fixtures.Currencies)
+	f.timezones = fake.CloneAll(fixtures.Timezones)
+	f.locales = fake.CloneAll(fixtures.Locales)
+	for pair, rate := range fixtures.ExchangeRates {
+		from, to, ok := strings.Cut(pair, "/")
+		if !ok {
+			panic(fmt.Sprintf("systemtest: 汇率货币对格式错误: %s", pair))
+		}
+		f.rates[rateKey(from, to)] = decimal.RequireFromString(rate)
+	}
+	for code, holidays := range fixtures.Holidays {
+		f.holidays[strings.ToUpper(code)] = fake.CloneAll(holidays)
+	}
+	for _, region := range fixtures.Regions {
+		f.regions[region.Name] = fake.Clone(region)
+	}
+	for _, dict := range fixtures.Dicts {
+		f.dicts[dict.Code] = fake.Clone(dict)
+	}
+	if fixtures.DictWatchInterval > 0 {
+		f.watchInterval = fixtures.DictWatchInterval
+	}
+
+	return f
+}
+
+// SetError 设置指定方法返回的错误，err 为 nil 时清除
+//
+// method 为 SystemAPI 的方法名，如 "GetExchangeRate"、"ListCountries"
+func (f *FakeClient) SetError(method string, err error) {
+	f.errors.Set(method, err)
+}
+
+// PutCountry 添加或替换国家
+func (f *FakeClient) PutCountry(country *v1.InternalCountry) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i, c := range f.countries {
+		if strings.EqualFold(c.GetCode(), country.GetCode()) {
+			f.countries[i] = fake.Clone(country)
+			return
+		}
+	}
+	f.countries = append(f.countries, fake.Clone(country))
+}
+
+// SetExchangeRate 设置汇率，rate 格式错误时 panic
+func (f *FakeClient) SetExchangeRate(from, to, rate string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.rates[rateKey(from, to)] = decimal.RequireFromString(rate)
+}
+
+// PutDict 添加或替换字典，内容变化后 WatchDict 会收到变更
+func (f *FakeClient) PutDict(dict *v1.InternalDict) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.dicts[dict.Code] = fake.Clone(dict)
+}
+
+// ========== 国家相关接口 ==========
+
+// GetCountryInfo 获取国家信息，不存在时返回 NotFound
+func (f *FakeClient) GetCountryInfo(ctx context.Context, countryCode string) (*v1.InternalCountry, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("GetCountryInfo"); err != nil {
+		return nil, err
+	}
+	for _, country := range f.countries {
+		if strings.EqualFold(country.GetCode(), countryCode) {
+			return fake.Clone(country), nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "国家不存在: %s", countryCode)
+}
+
+// ListCountries 获取国家列表，按区域和启用状态过滤
+func (f *FakeClient) ListCountries(ctx context.Context, opts *system.ListCountriesOptions) ([]*v1.InternalCountry, error) {
+	countries, err := f.listCountries("ListCountries")
+	if err != nil {
+		return nil, err
+	}
+	return system.FilterCountries(countries, opts), nil
+}
+
+// GetCountries 批量获取国家信息，不存在的代码会被忽略
+func (f *FakeClient) GetCountries(ctx context.Context, codes []string) (map[string]*v1.InternalCountry, error) {
+	countries, err := f.listCountries("GetCountries")
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*v1.InternalCountry, len(codes))
+	for _, code := range codes {
+		code = strings.ToUpper(code)
+		for _, country := range countries {
+			if strings.ToUpper(country.GetCode()) == code {
+				result[code] = country
+				break
+			}
+		}
+	}
+	return result, nil
+}
+
+// CountryByPhonePrefix 根据电话区号查找国家，匹配规则与 SystemClient 一致
+func (f *FakeClient) CountryByPhonePrefix(ctx context.Context, prefix string) (*v1.InternalCountry, error) {
+	countries, err := f.listCountries("CountryByPhonePrefix")
+	if err != nil {
+		return nil, err
+	}
+
+	country := system.FindCountryByPhonePrefix(countries, prefix)
+	if country == nil {
+		return nil, fmt.Errorf("%w: 区号 %s", system.ErrCountryNotFound, prefix)
+	}
+	return country, nil
+}
+
+// PhonePrefixByCountry 获取国家的电话区号
+func (f *FakeClient) PhonePrefixByCountry(ctx context.Context, code string) (string, error) {
+	countries, err := f.listCountries("PhonePrefixByCountry")
+	if err != nil {
+		return "", err
+	}
+
+	for _, country := range countries {
+		if strings.EqualFold(country.GetCode(), code) {
+			if prefix := system.PhonePrefixOf(country); prefix != "" {
+				return prefix, nil
+			}
+			break
+		}
+	}
+	return "", fmt.Errorf("%w: %s", system.ErrCountryNotFound, code)
+}
+
+// ========== 货币相关接口 ==========
+
+// ListCurrencies 获取货币列表
+func (f *FakeClient) ListCurrencies(ctx context.Context) ([]*v1.InternalCurrency, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("ListCurrencies"); err != nil {
+		return nil, err
+	}
+	return fake.CloneAll(f.currencies), nil
+}
+
+// GetCurrency 获取货币信息，不存在时返回 system.ErrCurrencyNotFound
+func (f *FakeClient) GetCurrency(ctx context.Context, code string) (*v1.InternalCurrency, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("GetCurrency"); err != nil {
+		return nil, err
+	}
+	for _, currency := range f.currencies {
+		if strings.EqualFold(currency.GetCode(), code) {
+			return fake.Clone(currency), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", system.ErrCurrencyNotFound, code)
+}
+
+// GetExchangeRate 获取汇率，忽略查询时间点；未设置的货币对返回 NotFound
+func (f *FakeClient) GetExchangeRate(ctx context.Context, from, to string, at time.Time) (*system.ExchangeRate, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == "" || to == "" {
+		return nil, fmt.Errorf("货币代码不能为空")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("GetExchangeRate"); err != nil {
+		return nil, err
+	}
+	if from == to {
+		return &system.ExchangeRate{From: from, To: to, Rate: decimal.NewFromInt(1), EffectiveAt: at}, nil
+	}
+	rate, ok := f.rates[rateKey(from, to)]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "汇率不存在: %s/%s", from, to)
+	}
+	return &system.ExchangeRate{From: from, To: to, Rate: rate, EffectiveAt: at, Source: "fake"}, nil
+}
+
+// ========== 时区与语言接口 ==========
+
+// ListTimezones 获取时区列表
+func (f *FakeClient) ListTimezones(ctx context.Context) ([]*v1.InternalTimezone, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("ListTimezones"); err != nil {
+		return nil, err
+	}
+	return fake.CloneAll(f.timezones), nil
+}
+
+// ListLocales 获取语言列表
+func (f *FakeClient) ListLocales(ctx context.Context) ([]*v1.InternalLocale, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("ListLocales"); err != nil {
+		return nil, err
+	}
+	return fake.CloneAll(f.locales), nil
+}
+
+// ========== 节假日接口 ==========
+
+// ListHolidays 获取国家指定年份的节假日
+func (f *FakeClient) ListHolidays(ctx context.Context, countryCode string, year int) ([]*v1.InternalHoliday, error) {
+	if countryCode == "" {
+		return nil, fmt.Errorf("国家代码不能为空")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get("ListHolidays"); err != nil {
+		return nil, err
+	}
+	prefix := fmt.Sprintf("%04d-", year)
+	result := make([]*v1.InternalHoliday, 0)
+	for _, holiday := range f.holidays[strings.ToUpper(countryCode)] {
+		if strings.HasPrefix(holiday.GetDate(), prefix) {
+			result = append(result, fake.Clone(holiday))
+		}
+	}
+	return result, nil
+}
+
+// ========== 区域接口 ==========
+
+// ResolveRegion 根据 ctx 中 Claims 的 RegionName 获取区域信息
+func (f *FakeClient) ResolveRegion(ctx context.Context) (*v1.InternalRegionInfo, error) {
+	claims, ok := auth.FromContext(ctx)
+	if !ok || claims.RegionName == "" {
+		return nil, fmt.Errorf("%w: 上下文中缺少区域信息", system.ErrRegionNotFound)
+	}
+	return f.getRegion("ResolveRegion", claims.RegionName)
+}
+
+// GetRegion 获取区域信息，不存在时返回 system.ErrRegionNotFound
+func (f *FakeClient) GetRegion(ctx context.Context, regionName string) (*v1.InternalRegionInfo, error) {
+	if regionName == "" {
+		return nil, fmt.Errorf("区域名称不能为空")
+	}
+	return f.getRegion("GetRegion", regionName)
+}
+
+func (f *FakeClient) getRegion(method, regionName string) (*v1.InternalRegionInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get(method); err != nil {
+		return nil, err
+	}
+	region, ok := f.regions[regionName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", system.ErrRegionNotFound, regionName)
+	}
+	return fake.Clone(region), nil
+}
+
+// ========== 字典接口 ==========
+
+// GetDict 获取字典，不存在时返回 system.ErrDictNotFound
+func (f *FakeClient) GetDict(ctx context.Context, dictCode string) (*v1.InternalDict, error) {
+	dict, _, err := f.getDict("GetDict", dictCode)
+	return dict, err
+}
+
+// WatchDict 监听字典变更，字典可通过 PutDict 修改
+//
+// 按 Fixtures.DictWatchInterval 轮询，首次拉取失败时返回错误
+func (f *FakeClient) WatchDict(ctx context.Context, dictCode string, onChange system.DictChangeFunc) error {
+	return system.WatchDictWithGetter(ctx, func(ctx context.Context, dictCode string, ifNoneMatch string) (*v1.InternalDict, bool, error) {
+		return f.getDict("WatchDict", dictCode)
+	}, dictCode, onChange, f.watchInterval)
+}
+
+func (f *FakeClient) getDict(method, dictCode string) (*v1.InternalDict, bool, error) {
+	if dictCode == "" {
+		return nil, false, fmt.Errorf("字典编码不能为空")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get(method); err != nil {
+		return nil, false, err
+	}
+	dict, ok := f.dicts[dictCode]
+	if !ok {
+		return nil, false, fmt.Errorf("%w: %s", system.ErrDictNotFound, dictCode)
+	}
+	return fake.Clone(dict), false, nil
+}
+
+// listCountries 返回国家列表副本
+func (f *FakeClient) listCountries(method string) ([]*v1.InternalCountry, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.errors.Get(method); err != nil {
+		return nil, err
+	}
+	return fake.CloneAll(f.countries), nil
+}
+
+func rateKey(from, to string) string {
+	return strings.ToUpper(from) + "/" + strings.ToUpper(to)
+}
@@ -0,0 +1,153 @@
+package systemtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/heyinLab/common/api/gen/go/system/v1"
+	"github.com/heyinLab/common/pkg/middleware/auth"
+	"github.com/heyinLab/common/pkg/system"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func newFixtureClient() *FakeClient {
+	return NewFakeClient(&Fixtures{
+		Countries: []*v1.InternalCountry{
+			{Code: "CN", Name: "中国", PhonePrefix: proto.String("+86"), Region: v1.InternalRegion_INTERNAL_ASIA, IsActive: true},
+			{Code: "US", Name: "美国", PhonePrefix: proto.String("+1"), Region: v1.InternalRegion_INTERNAL_NORTH_AMERICA, IsActive: true, IsDefault: true},
+			{Code: "CA", Name: "加拿大", PhonePrefix: proto.String("+1"), Region: v1.InternalRegion_INTERNAL_NORTH_AMERICA},
+		},
+		Currencies:    []*v1.InternalCurrency{{Code: "CNY", DecimalPlaces: 2}, {Code: "USD", DecimalPlaces: 2}},
+		ExchangeRates: map[string]string{"usd/cny": "7.1"},
+		Dicts:         []*v1.InternalDict{{Code: "reason", Items: []*v1.InternalDictItem{{Value: "a"}}}},
+		Regions:       []*v1.InternalRegionInfo{{Name: "cn-east", DefaultCurrency: "CNY"}},
+		Holidays: map[string][]*v1.InternalHoliday{"CN": {
+			{Date: "2025-10-01", Name: "国庆节", Type: v1.InternalHolidayType_INTERNAL_HOLIDAY_TYPE_HOLIDAY},
+			{Date: "2026-10-01", Name: "国庆节", Type: v1.InternalHolidayType_INTERNAL_HOLIDAY_TYPE_HOLIDAY},
+		}},
+	})
+}
+
+func TestFakeClient_Countries(t *testing.T) {
+	fake := newFixtureClient()
+	ctx := context.Background()
+
+	region := v1.InternalRegion_INTERNAL_NORTH_AMERICA
+	enabled := true
+	list, err := fake.ListCountries(ctx, &system.ListCountriesOptions{Region: &region, Enabled: &enabled})
+	if err != nil || len(list) != 1 || list[0].Code != "US" {
+		t.Errorf("Unexpected ListCountries: %v, %v", list, err)
+	}
+
+	countries, err := fake.GetCountries(ctx, []string{"cn", "XX"})
+	if err != nil || len(countries) != 1 || countries["CN"] == nil {
+		t.Errorf("Unexpected GetCountries: %v, %v", countries, err)
+	}
+
+	country, err := fake.CountryByPhonePrefix(ctx, "+14155550100")
+	if err != nil || country.Code != "US" {
+		t.Errorf("Unexpected CountryByPhonePrefix: %v, %v", country, err)
+	}
+	if prefix, err := fake.PhonePrefixByCountry(ctx, "cn"); err != nil || prefix != "+86" {
+		t.Errorf("Unexpected PhonePrefixByCountry: %q, %v", prefix, err)
+	}
+	if _, err := fake.PhonePrefixByCountry(ctx, "JP"); !errors.Is(err, system.ErrCountryNotFound) {
+		t.Errorf("Expected ErrCountryNotFound, got %v", err)
+	}
+	if holidays, err := fake.ListHolidays(ctx, "cn", 2026); err != nil || len(holidays) != 1 || holidays[0].Date != "2026-10-01" {
+		t.Errorf("Unexpected ListHolidays: %v, %v", holidays, err)
+	}
+	if _, err := fake.GetCountryInfo(ctx, "JP"); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound, got %v", err)
+	}
+
+	// 返回副本，修改不影响假客户端
+	country.Name = "changed"
+	if info, _ := fake.GetCountryInfo(ctx, "US"); info.Name != "美国" {
+		t.Errorf("Expected fake data to be isolated, got %q", info.Name)
+	}
+}
+
+func TestFakeClient_Currencies(t *testing.T) {
+	fake := newFixtureClient()
+	ctx := context.Background()
+
+	rate, err := fake.GetExchangeRate(ctx, "USD", "CNY", time.Time{})
+	if err != nil || !rate.Convert(decimal.NewFromInt(100)).Equal(decimal.NewFromInt(710)) {
+		t.Errorf("Unexpected GetExchangeRate: %v, %v", rate, err)
+	}
+	if _, err := fake.GetExchangeRate(ctx, "CNY", "USD", time.Time{}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for missing pair, got %v", err)
+	}
+	fake.SetExchangeRate("CNY", "USD", "0.14")
+	if _, err := fake.GetExchangeRate(ctx, "CNY", "USD", time.Time{}); err != nil {
+		t.Errorf("GetExchangeRate failed: %v", err)
+	}
+
+	if _, err := fake.GetCurrency(ctx, "EUR"); !errors.Is(err, system.ErrCurrencyNotFound) {
+		t.Errorf("Expected ErrCurrencyNotFound, got %v", err)
+	}
+
+	fake.SetError("ListCurrencies", status.Error(codes.Unavailable, "unavailable"))
+	if _, err := fake.ListCurrencies(ctx); status.Code(err) != codes.Unavailable {
+		t.Errorf("Expected injected error, got %v", err)
+	}
+	fake.SetError("ListCurrencies", nil)
+	if list, err := fake.ListCurrencies(ctx); err != nil || len(list) != 2 {
+		t.Errorf("Unexpected ListCurrencies: %v, %v", list, err)
+	}
+}
+
+func TestFakeClient_ResolveRegion(t *testing.T) {
+	fake := newFixtureClient()
+
+	ctx := auth.NewContext(context.Background(), &auth.Claims{UserCode: "U001", RegionName: "cn-east"})
+	region, err := fake.ResolveRegion(ctx)
+	if err != nil || region.DefaultCurrency != "CNY" {
+		t.Errorf("Unexpected ResolveRegion: %v, %v", region, err)
+	}
+	if _, err := fake.ResolveRegion(context.Background()); err == nil {
+		t.Error("Expected error without claims")
+	}
+	if _, err := fake.GetRegion(ctx, "eu-west"); !errors.Is(err, system.ErrRegionNotFound) {
+		t.Errorf("Expected ErrRegionNotFound, got %v", err)
+	}
+}
+
+func TestFakeClient_WatchDict(t *testing.T) {
+	fake := newFixtureClient()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes := make(chan *v1.InternalDict, 4)
+	done := make(chan error, 1)
+	go func() {
+		done <- fake.WatchDict(ctx, "reason", func(dict *v1.InternalDict) { changes <- dict })
+	}()
+
+	if dict := <-changes; len(dict.Items) != 1 {
+		t.Fatalf("Unexpected initial dict: %v", dict)
+	}
+	fake.PutDict(&v1.InternalDict{Code: "reason", Items: []*v1.InternalDictItem{{Value: "a"}, {Value: "b"}}})
+	select {
+	case dict := <-changes:
+		if len(dict.Items) != 2 {
+			t.Errorf("Unexpected changed dict: %v", dict)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected dict change")
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("WatchDict returned error: %v", err)
+	}
+	if _, err := fake.GetDict(context.Background(), "missing"); !errors.Is(err, system.ErrDictNotFound) {
+		t.Errorf("Expected ErrDictNotFound, got %v", err)
+	}
+}